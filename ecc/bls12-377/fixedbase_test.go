@@ -0,0 +1,298 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12377
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+)
+
+func TestFixedBaseC(t *testing.T) {
+	if lastC(fixedBaseC) > fixedBaseC {
+		t.Fatal("the last window of the fixed-base tables is larger than the others")
+	}
+}
+
+func TestFixedBaseTableG1(t *testing.T) {
+	t.Parallel()
+
+	var base G1Affine
+	base.ScalarMultiplication(&g1GenAff, big.NewInt(42))
+	table := NewFixedBaseTableG1(&base)
+	if b := table.Base(); !b.Equal(&base) {
+		t.Fatal("wrong base")
+	}
+
+	// edge cases: 0, 1, -1, r-1, r, a scalar larger than r and random scalars
+	r := fr.Modulus()
+	scalars := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		big.NewInt(-1),
+		new(big.Int).Sub(r, big.NewInt(1)),
+		new(big.Int).Set(r),
+		new(big.Int).Lsh(r, 3),
+	}
+	for i := 0; i < 10; i++ {
+		var e fr.Element
+		e.SetRandom()
+		scalars = append(scalars, e.BigInt(new(big.Int)))
+	}
+
+	check := func(table *FixedBaseTableG1) {
+		t.Helper()
+		for _, s := range scalars {
+			var expected, res G1Affine
+			expected.ScalarMultiplication(&base, s)
+			res.ScalarMultiplicationFixedBase(table, s)
+			if !res.Equal(&expected) {
+				t.Fatalf("ScalarMultiplicationFixedBase doesn't match ScalarMultiplication for s = %s", s)
+			}
+		}
+	}
+	check(table)
+
+	// serialization round trip
+	for _, raw := range []bool{false, true} {
+		var buf bytes.Buffer
+		var err error
+		var n int64
+		if raw {
+			n, err = table.WriteRawTo(&buf)
+		} else {
+			n, err = table.WriteTo(&buf)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var _table FixedBaseTableG1
+		m, err := _table.ReadFrom(bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != m || n != int64(buf.Len()) {
+			t.Fatal("bytes read don't match bytes written")
+		}
+		check(&_table)
+
+		// a table of the other group can't be read
+		var other FixedBaseTableG2
+		if _, err := other.ReadFrom(bytes.NewReader(buf.Bytes())); err != ecc.ErrHeaderObject {
+			t.Fatal("expected ecc.ErrHeaderObject, got", err)
+		}
+	}
+}
+
+func TestScalarMultiplicationBaseG1(t *testing.T) {
+	t.Parallel()
+
+	r := fr.Modulus()
+	scalars := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		big.NewInt(-1),
+		new(big.Int).Sub(r, big.NewInt(1)),
+		new(big.Int).Lsh(r, 3),
+	}
+	for i := 0; i < 10; i++ {
+		var e fr.Element
+		e.SetRandom()
+		scalars = append(scalars, e.BigInt(new(big.Int)))
+	}
+	for _, s := range scalars {
+		var expected, res G1Affine
+		expected.ScalarMultiplication(&g1GenAff, s)
+		res.ScalarMultiplicationBase(s)
+		if !res.Equal(&expected) {
+			t.Fatalf("ScalarMultiplicationBase doesn't match ScalarMultiplication for s = %s", s)
+		}
+	}
+	if getG1BaseTable() != getG1BaseTable() {
+		t.Fatal("the generator table must be built once")
+	}
+}
+
+func BenchmarkFixedBaseTableG1(b *testing.B) {
+	var s fr.Element
+	s.SetRandom()
+	var scalar big.Int
+	s.BigInt(&scalar)
+
+	b.Run("NewFixedBaseTable", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			NewFixedBaseTableG1(&g1GenAff)
+		}
+	})
+
+	table := NewFixedBaseTableG1(&g1GenAff)
+	var res G1Jac
+	b.Run("ScalarMultiplicationFixedBase", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.ScalarMultiplicationFixedBase(table, &scalar)
+		}
+	})
+	b.Run("ScalarMultiplication", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.ScalarMultiplication(&g1Gen, &scalar)
+		}
+	})
+	var resAff G1Affine
+	resAff.ScalarMultiplicationBase(&scalar)
+	b.Run("ScalarMultiplicationBase", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			resAff.ScalarMultiplicationBase(&scalar)
+		}
+	})
+}
+
+func TestFixedBaseTableG2(t *testing.T) {
+	t.Parallel()
+
+	var base G2Affine
+	base.ScalarMultiplication(&g2GenAff, big.NewInt(42))
+	table := NewFixedBaseTableG2(&base)
+	if b := table.Base(); !b.Equal(&base) {
+		t.Fatal("wrong base")
+	}
+
+	// edge cases: 0, 1, -1, r-1, r, a scalar larger than r and random scalars
+	r := fr.Modulus()
+	scalars := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		big.NewInt(-1),
+		new(big.Int).Sub(r, big.NewInt(1)),
+		new(big.Int).Set(r),
+		new(big.Int).Lsh(r, 3),
+	}
+	for i := 0; i < 10; i++ {
+		var e fr.Element
+		e.SetRandom()
+		scalars = append(scalars, e.BigInt(new(big.Int)))
+	}
+
+	check := func(table *FixedBaseTableG2) {
+		t.Helper()
+		for _, s := range scalars {
+			var expected, res G2Affine
+			expected.ScalarMultiplication(&base, s)
+			res.ScalarMultiplicationFixedBase(table, s)
+			if !res.Equal(&expected) {
+				t.Fatalf("ScalarMultiplicationFixedBase doesn't match ScalarMultiplication for s = %s", s)
+			}
+		}
+	}
+	check(table)
+
+	// serialization round trip
+	for _, raw := range []bool{false, true} {
+		var buf bytes.Buffer
+		var err error
+		var n int64
+		if raw {
+			n, err = table.WriteRawTo(&buf)
+		} else {
+			n, err = table.WriteTo(&buf)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var _table FixedBaseTableG2
+		m, err := _table.ReadFrom(bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != m || n != int64(buf.Len()) {
+			t.Fatal("bytes read don't match bytes written")
+		}
+		check(&_table)
+
+		// a table of the other group can't be read
+		var other FixedBaseTableG1
+		if _, err := other.ReadFrom(bytes.NewReader(buf.Bytes())); err != ecc.ErrHeaderObject {
+			t.Fatal("expected ecc.ErrHeaderObject, got", err)
+		}
+	}
+}
+
+func TestScalarMultiplicationBaseG2(t *testing.T) {
+	t.Parallel()
+
+	r := fr.Modulus()
+	scalars := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		big.NewInt(-1),
+		new(big.Int).Sub(r, big.NewInt(1)),
+		new(big.Int).Lsh(r, 3),
+	}
+	for i := 0; i < 10; i++ {
+		var e fr.Element
+		e.SetRandom()
+		scalars = append(scalars, e.BigInt(new(big.Int)))
+	}
+	for _, s := range scalars {
+		var expected, res G2Affine
+		expected.ScalarMultiplication(&g2GenAff, s)
+		res.ScalarMultiplicationBase(s)
+		if !res.Equal(&expected) {
+			t.Fatalf("ScalarMultiplicationBase doesn't match ScalarMultiplication for s = %s", s)
+		}
+	}
+	if getG2BaseTable() != getG2BaseTable() {
+		t.Fatal("the generator table must be built once")
+	}
+}
+
+func BenchmarkFixedBaseTableG2(b *testing.B) {
+	var s fr.Element
+	s.SetRandom()
+	var scalar big.Int
+	s.BigInt(&scalar)
+
+	b.Run("NewFixedBaseTable", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			NewFixedBaseTableG2(&g2GenAff)
+		}
+	})
+
+	table := NewFixedBaseTableG2(&g2GenAff)
+	var res G2Jac
+	b.Run("ScalarMultiplicationFixedBase", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.ScalarMultiplicationFixedBase(table, &scalar)
+		}
+	})
+	b.Run("ScalarMultiplication", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.ScalarMultiplication(&g2Gen, &scalar)
+		}
+	})
+	var resAff G2Affine
+	resAff.ScalarMultiplicationBase(&scalar)
+	b.Run("ScalarMultiplicationBase", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			resAff.ScalarMultiplicationBase(&scalar)
+		}
+	})
+}
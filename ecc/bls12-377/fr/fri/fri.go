@@ -24,11 +24,12 @@ import (
 	"math/big"
 	"math/bits"
 
-	"github.com/consensys/gnark-crypto/accumulator/merkletree"
 	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr/fft"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr/fieldtree"
 	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/internal/parallel"
 )
 
 var (
@@ -50,18 +51,17 @@ var twoInv fr.Element
 // Digest commitment of a polynomial.
 type Digest []byte
 
-// merkleProof helper structure to build the merkle proof
-// At each round, two contiguous values from the evaluated polynomial
-// are queried. For one value, the full Merkle path will be provided.
-// For the neighbor value, only the leaf is provided (so ProofSet will
-// be empty), since the Merkle path is the same as for the first value.
+// MerkleProof is the opening of a fiber {x, -x} of a folded polynomial.
+// The two evaluations of a fiber are stored in the same leaf of the Merkle
+// tree (p(x) ∥ p(-x)), so a single Merkle path opens both.
 type MerkleProof struct {
 
 	// Merkle root
 	MerkleRoot []byte
 
-	// ProofSet stores [leaf ∥ node_1 ∥ .. ∥ merkleRoot ], where the leaf is not
-	// hashed.
+	// ProofSet stores [leaf ∥ node_1 ∥ .. ∥ node_n ], where the leaf is not
+	// hashed and the nodes are the Merkle path of the leaf (see fieldtree.Proof).
+	// The leaf is the concatenation of the encodings of p(x) and p(-x).
 	ProofSet [][]byte
 
 	// number of leaves of the tree.
@@ -99,14 +99,14 @@ const (
 // It consists of a list of Interactions between the prover and the verifier,
 // where each interaction contains a challenge provided by the verifier, as
 // well as MerkleProofs for the queries of the verifier. The Merkle proofs
-// correspond to the openings of the i-th folded polynomial at 2 points that
-// belong to the same fiber of x -> x².
+// correspond to the openings of the i-th folded polynomial at the 2 points
+// of a fiber of x -> x², which share a leaf of the Merkle tree.
 type Round struct {
 
 	// stores the Interactions between the prover and the verifier.
-	// Each interaction results in a set or merkle proofs, corresponding
-	// to the queries of the verifier.
-	Interactions [][2]MerkleProof
+	// Each interaction results in a Merkle proof, corresponding
+	// to the query of the verifier.
+	Interactions []MerkleProof
 
 	// evaluation stores the evaluation of the fully folded polynomial.
 	// The fully folded polynomial is constant, and is evaluated on a
@@ -144,6 +144,10 @@ type Iopp interface {
 	// verification fails.
 	VerifyProofOfProximity(proof ProofOfProximity) error
 
+	// BatchVerifyProofOfProximity verifies several proofs of proximity. It returns an error
+	// and the index of the first failing proof if the verification fails, -1 otherwise.
+	BatchVerifyProofOfProximity(proofs []ProofOfProximity) (int, error)
+
 	// Opens a polynomial at gⁱ where i = position.
 	Open(p []fr.Element, position uint64) (OpeningProof, error)
 
@@ -164,7 +168,20 @@ func init() {
 func (iopp IOPP) New(size uint64, h hash.Hash) Iopp {
 	switch iopp {
 	case RADIX_2_FRI:
-		return newRadixTwoFri(size, h)
+		return newRadixTwoFri(size, h, nil)
+	default:
+		panic("iopp name is not recognized")
+	}
+}
+
+// NewWithHashFunc creates a new IOPP capable to handle degree(size) polynomials, where newHash
+// returns new instances of the hash function. Unlike New, the verifier can then use several hash
+// functions concurrently: VerifyProofOfProximity checks the queries in parallel and
+// BatchVerifyProofOfProximity verifies the proofs in parallel.
+func (iopp IOPP) NewWithHashFunc(size uint64, newHash func() hash.Hash) Iopp {
+	switch iopp {
+	case RADIX_2_FRI:
+		return newRadixTwoFri(size, newHash(), newHash)
 	default:
 		panic("iopp name is not recognized")
 	}
@@ -184,9 +201,17 @@ type radixTwoFri struct {
 	// domain used to build the Reed Solomon code from the given polynomial.
 	// The size of the domain is ρ*size_polynomial.
 	domain *fft.Domain
+
+	// newHash, if set, returns new instances of the hash function, for the
+	// parallel verifications
+	newHash func() hash.Hash
+
+	// labels of the challenges of the Fiat Shamir transcript: x0,...,x{nbSteps-1}
+	// for the foldings, s0 for the queries
+	labels []string
 }
 
-func newRadixTwoFri(size uint64, h hash.Hash) radixTwoFri {
+func newRadixTwoFri(size uint64, h hash.Hash, newHash func() hash.Hash) radixTwoFri {
 
 	var res radixTwoFri
 
@@ -203,6 +228,14 @@ func newRadixTwoFri(size uint64, h hash.Hash) radixTwoFri {
 
 	// hash function
 	res.h = h
+	res.newHash = newHash
+
+	// Fiat Shamir labels
+	res.labels = make([]string, nbSteps+1)
+	for i := 0; i < nbSteps; i++ {
+		res.labels[i] = fmt.Sprintf("x%d", i)
+	}
+	res.labels[nbSteps] = "s0"
 
 	return res
 }
@@ -256,6 +289,49 @@ func sort(evaluations []fr.Element) []fr.Element {
 	return q
 }
 
+// fiberLeaf returns the leaf of the Merkle tree storing the fiber {a, b}
+func fiberLeaf(a, b *fr.Element) []byte {
+	return fieldtree.LeafBytes([]fr.Element{*a, *b})
+}
+
+// fiberFromLeaf returns the fiber stored in leaf, see fiberLeaf
+func fiberFromLeaf(leaf []byte) (a, b fr.Element, err error) {
+	if len(leaf) != 2*fr.Bytes {
+		return a, b, ErrMerklePath
+	}
+	if err = a.SetBytesCanonical(leaf[:fr.Bytes]); err != nil {
+		return
+	}
+	err = b.SetBytesCanonical(leaf[fr.Bytes:])
+	return
+}
+
+// newFiberTree returns a Merkle tree whose leaves are the fibers of the sorted
+// evaluations of a polynomial.
+func newFiberTree(h hash.Hash, sorted []fr.Element) (*fieldtree.Tree, error) {
+	return fieldtree.NewFromIterator(h, len(sorted)/2, func(k int) []fr.Element {
+		return sorted[2*k : 2*k+2]
+	})
+}
+
+// openFiber returns the Merkle proof of the fiber at index, in the format of
+// MerkleProof: [leaf ∥ node_1 ∥ .. ∥ node_n]
+func openFiber(t *fieldtree.Tree, sorted []fr.Element, index uint64) ([][]byte, error) {
+	proof, err := t.Open(index)
+	if err != nil {
+		return nil, err
+	}
+	proofSet := make([][]byte, 0, len(proof.Path)+1)
+	proofSet = append(proofSet, fiberLeaf(&sorted[2*index], &sorted[2*index+1]))
+	return append(proofSet, proof.Path...), nil
+}
+
+// verifyFiber checks the Merkle proof of the fiber (a, b) at index, see openFiber
+func verifyFiber(h hash.Hash, root []byte, a, b fr.Element, proofSet [][]byte, index, numLeaves uint64) bool {
+	proof := fieldtree.Proof{Index: index, Path: proofSet[1:]}
+	return fieldtree.Verify(h, root, []fr.Element{a, b}, proof, numLeaves) == nil
+}
+
 // Opens a polynomial at gⁱ where i = position.
 func (s radixTwoFri) Open(p []fr.Element, position uint64) (OpeningProof, error) {
 
@@ -277,19 +353,21 @@ func (s radixTwoFri) Open(p []fr.Element, position uint64) (OpeningProof, error)
 	// build the Merkle proof, we the position is converted to fit the sorted polynomial
 	pos := convertCanonicalSorted(int(position), len(q))
 
-	tree := merkletree.New(s.h)
-	err := tree.SetIndex(uint64(pos))
+	// (the leaf containing pos is the fiber pos/2)
+	index := uint64(pos / 2)
+	tree, err := newFiberTree(s.h, q)
 	if err != nil {
 		return OpeningProof{}, err
 	}
-	for i := 0; i < len(q); i++ {
-		tree.Push(q[i].Marshal())
-	}
 	var res OpeningProof
-	res.merkleRoot, res.ProofSet, res.index, res.numLeaves = tree.Prove()
+	res.ProofSet, err = openFiber(tree, q, index)
+	if err != nil {
+		return OpeningProof{}, err
+	}
+	res.merkleRoot, res.index, res.numLeaves = tree.Root(), index, tree.NbLeaves()
 
-	// set the claimed value, which is the first entry of the Merkle proof
-	res.ClaimedValue.SetBytes(res.ProofSet[0])
+	// set the claimed value, which is the entry of the leaf corresponding to pos
+	res.ClaimedValue.Set(&q[pos])
 
 	return res, nil
 }
@@ -303,17 +381,12 @@ func (s radixTwoFri) Open(p []fr.Element, position uint64) (OpeningProof, error)
 // those should be equal, if not an error is raised.
 func (s radixTwoFri) VerifyOpening(position uint64, openingProof OpeningProof, pp ProofOfProximity) error {
 
-	// To query the Merkle path, we look at the first series of Interactions, and check whether it's the point
-	// at 'position' or its neighbor that contains the full Merkle path.
-	var fullMerkleProof int
-	if len(pp.Rounds[0].Interactions[0][0].ProofSet) > len(pp.Rounds[0].Interactions[0][1].ProofSet) {
-		fullMerkleProof = 0
-	} else {
-		fullMerkleProof = 1
+	if len(pp.Rounds) == 0 || len(pp.Rounds[0].Interactions) == 0 {
+		return ErrMerkleRoot
 	}
 
 	// check that the merkle roots coincide
-	if !bytes.Equal(openingProof.merkleRoot, pp.Rounds[0].Interactions[0][fullMerkleProof].MerkleRoot) {
+	if !bytes.Equal(openingProof.merkleRoot, pp.Rounds[0].Interactions[0].MerkleRoot) {
 		return ErrMerkleRoot
 	}
 
@@ -321,9 +394,22 @@ func (s radixTwoFri) VerifyOpening(position uint64, openingProof OpeningProof, p
 	sizePoly := s.domain.Cardinality
 	pos := convertCanonicalSorted(int(position), int(sizePoly))
 
-	// check the Merkle proof
-	res := merkletree.VerifyProof(s.h, openingProof.merkleRoot, openingProof.ProofSet, uint64(pos), openingProof.numLeaves)
-	if !res {
+	// check the Merkle proof of the fiber, and that the claimed value is in the fiber
+	if len(openingProof.ProofSet) == 0 {
+		return ErrMerklePath
+	}
+	a, b, err := fiberFromLeaf(openingProof.ProofSet[0])
+	if err != nil {
+		return err
+	}
+	claimed := a
+	if pos%2 == 1 {
+		claimed = b
+	}
+	if !claimed.Equal(&openingProof.ClaimedValue) {
+		return ErrMerklePath
+	}
+	if !verifyFiber(s.h, openingProof.merkleRoot, a, b, openingProof.ProofSet, uint64(pos/2), openingProof.numLeaves) {
 		return ErrMerklePath
 	}
 	return nil
@@ -373,7 +459,7 @@ func (s radixTwoFri) buildProofOfProximitySingleRound(salt fr.Element, p []fr.El
 
 	// the proof will contain nbSteps Interactions
 	var res Round
-	res.Interactions = make([][2]MerkleProof, s.nbSteps)
+	res.Interactions = make([]MerkleProof, s.nbSteps)
 
 	// Fiat Shamir transcript to derive the challenges. The xᵢ are used to fold the
 	// polynomials.
@@ -381,11 +467,7 @@ func (s radixTwoFri) buildProofOfProximitySingleRound(salt fr.Element, p []fr.El
 	// xᵢ∈ Fᵣ to the prover. The prover expresses F in Fᵣ[X,Y]/<Y-X²> as
 	// P₀(Y)+X P₁(Y) where P₀, P₁ are of degree n/2, and he then folds the polynomial
 	// by replacing x by xᵢ.
-	xis := make([]string, s.nbSteps+1)
-	for i := 0; i < s.nbSteps; i++ {
-		xis[i] = fmt.Sprintf("x%d", i)
-	}
-	xis[s.nbSteps] = "s0"
+	xis := s.labels
 	fs := fiatshamir.NewTranscript(s.h, xis...)
 
 	// the salt is binded to the first challenge, to ensure the challenges
@@ -410,17 +492,21 @@ func (s radixTwoFri) buildProofOfProximitySingleRound(salt fr.Element, p []fr.El
 	var gInv fr.Element
 	gInv.Set(&s.domain.GeneratorInv)
 
+	// the trees are kept to open the queries
+	trees := make([]*fieldtree.Tree, s.nbSteps)
+
 	for i := 0; i < s.nbSteps; i++ {
 
 		evalsAtRound[i] = sort(_p)
 
 		// compute the root hash, needed to derive xi
-		t := merkletree.New(s.h)
-		for k := 0; k < len(_p); k++ {
-			t.Push(evalsAtRound[i][k].Marshal())
+		t, err := newFiberTree(s.h, evalsAtRound[i])
+		if err != nil {
+			return res, err
 		}
+		trees[i] = t
 		rh := t.Root()
-		err := fs.Bind(xis[i], rh)
+		err = fs.Bind(xis[i], rh)
 		if err != nil {
 			return res, err
 		}
@@ -465,35 +551,12 @@ func (s radixTwoFri) buildProofOfProximitySingleRound(salt fr.Element, p []fr.El
 
 	for i := 0; i < s.nbSteps; i++ {
 
-		// build proofs of queries at s[i]
-		t := merkletree.New(s.h)
-		err := t.SetIndex(uint64(si[i]))
-		if err != nil {
-			return res, err
-		}
-		for k := 0; k < len(evalsAtRound[i]); k++ {
-			t.Push(evalsAtRound[i][k].Marshal())
-		}
-		mr, ProofSet, _, numLeaves := t.Prove()
-
-		// c denotes the entry that contains the full Merkle proof. The entry 1-c will
-		// only contain 2 elements, which are the neighbor point, and the hash of the
-		// first point. The remaining of the Merkle path is common to both the original
-		// point and its neighbor.
-		c := si[i] % 2
-		res.Interactions[i][c] = MerkleProof{mr, ProofSet, numLeaves}
-		res.Interactions[i][1-c] = MerkleProof{
-			mr,
-			make([][]byte, 2),
-			numLeaves,
-		}
-		res.Interactions[i][1-c].ProofSet[0] = evalsAtRound[i][si[i]+1-2*c].Marshal()
-		s.h.Reset()
-		_, err = s.h.Write(res.Interactions[i][c].ProofSet[0])
+		// build proofs of queries at s[i], that is the fiber si[i]/2
+		proofSet, err := openFiber(trees[i], evalsAtRound[i], uint64(si[i]/2))
 		if err != nil {
 			return res, err
 		}
-		res.Interactions[i][1-c].ProofSet[1] = s.h.Sum(nil)
+		res.Interactions[i] = MerkleProof{trees[i].Root(), proofSet, trees[i].NbLeaves()}
 
 	}
 
@@ -532,31 +595,33 @@ func (s radixTwoFri) BuildProofOfProximity(p []fr.Element) (ProofOfProximity, er
 
 // verifyProofOfProximitySingleRound verifies the proof of proximity. It returns an error if the
 // verification fails.
-func (s radixTwoFri) verifyProofOfProximitySingleRound(salt fr.Element, proof Round) error {
+//
+// h is used for the Fiat Shamir transcript; if parallelQueries is true (and s has a hash constructor),
+// the queries are checked in parallel.
+func (s radixTwoFri) verifyProofOfProximitySingleRound(h hash.Hash, salt fr.Element, proof Round, parallelQueries bool) error {
 
-	// Fiat Shamir transcript to derive the challenges
-	xis := make([]string, s.nbSteps+1)
-	for i := 0; i < s.nbSteps; i++ {
-		xis[i] = fmt.Sprintf("x%d", i)
+	if len(proof.Interactions) != s.nbSteps {
+		return ErrProximityTestFolding
 	}
-	xis[s.nbSteps] = "s0"
-	fs := fiatshamir.NewTranscript(s.h, xis...)
+
+	// Fiat Shamir transcript to derive the challenges
+	fs := fiatshamir.NewTranscript(h, s.labels...)
 
 	xi := make([]fr.Element, s.nbSteps)
 
 	// the salt is binded to the first challenge, to ensure the challenges
 	// are different at each round.
-	err := fs.Bind(xis[0], salt.Marshal())
+	err := fs.Bind(s.labels[0], salt.Marshal())
 	if err != nil {
 		return err
 	}
 
 	for i := 0; i < s.nbSteps; i++ {
-		err := fs.Bind(xis[i], proof.Interactions[i][0].MerkleRoot)
+		err := fs.Bind(s.labels[i], proof.Interactions[i].MerkleRoot)
 		if err != nil {
 			return err
 		}
-		bxi, err := fs.ComputeChallenge(xis[i])
+		bxi, err := fs.ComputeChallenge(s.labels[i])
 		if err != nil {
 			return err
 		}
@@ -564,17 +629,11 @@ func (s radixTwoFri) verifyProofOfProximitySingleRound(salt fr.Element, proof Ro
 	}
 
 	// derive the verifier queries
-	// for i := 0; i < len(proof.evaluation); i++ {
-	// 	err := fs.Bind(xis[s.nbSteps], proof.evaluation[i].Marshal())
-	// 	if err != nil {
-	// 		return err
-	// 	}
-	// }
-	err = fs.Bind(xis[s.nbSteps], proof.Evaluation.Marshal())
+	err = fs.Bind(s.labels[s.nbSteps], proof.Evaluation.Marshal())
 	if err != nil {
 		return err
 	}
-	binSeed, err := fs.ComputeChallenge(xis[s.nbSteps])
+	binSeed, err := fs.ComputeChallenge(s.labels[s.nbSteps])
 	if err != nil {
 		return err
 	}
@@ -584,92 +643,82 @@ func (s radixTwoFri) verifyProofOfProximitySingleRound(salt fr.Element, proof Ro
 	bPos.Mod(&bPos, &bCardinality)
 	si := s.deriveQueriesPositions(int(bPos.Uint64()), int(s.domain.Cardinality))
 
-	// for each round check the Merkle proof and the correctness of the folding
-
-	// current size of the polynomial
-	var accGInv fr.Element
-	accGInv.Set(&s.domain.GeneratorInv)
-	for i := 0; i < s.nbSteps; i++ {
-
-		// correctness of Merkle proof
-		// c is the entry containing the full Merkle proof.
-		c := si[i] % 2
-		res := merkletree.VerifyProof(
-			s.h,
-			proof.Interactions[i][c].MerkleRoot,
-			proof.Interactions[i][c].ProofSet,
-			uint64(si[i]),
-			proof.Interactions[i][c].numLeaves,
-		)
-		if !res {
-			return ErrMerklePath
+	// for each round check the Merkle proof and the correctness of the folding.
+	// The steps are independent once the challenges are derived.
+	if !parallelQueries || s.newHash == nil {
+		for i := 0; i < s.nbSteps; i++ {
+			if err := s.verifyStep(h, proof, xi, si, i); err != nil {
+				return err
+			}
 		}
+		return nil
+	}
 
-		// we verify the Merkle proof for the neighbor query, to do that we have
-		// to pick the full Merkle proof of the first entry, stripped off of the leaf and
-		// the first node. We replace the leaf and the first node by the leaf and the first
-		// node of the partial Merkle proof, since the leaf and the first node of both proofs
-		// are the only entries that differ.
-		ProofSet := make([][]byte, len(proof.Interactions[i][c].ProofSet))
-		copy(ProofSet[2:], proof.Interactions[i][c].ProofSet[2:])
-		ProofSet[0] = proof.Interactions[i][1-c].ProofSet[0]
-		ProofSet[1] = proof.Interactions[i][1-c].ProofSet[1]
-		res = merkletree.VerifyProof(
-			s.h,
-			proof.Interactions[i][1-c].MerkleRoot,
-			ProofSet,
-			uint64(si[i]+1-2*c),
-			proof.Interactions[i][1-c].numLeaves,
-		)
-		if !res {
-			return ErrMerklePath
+	errs := make([]error, s.nbSteps)
+	parallel.Execute(s.nbSteps, func(start, end int) {
+		h := s.newHash()
+		for i := start; i < end; i++ {
+			errs[i] = s.verifyStep(h, proof, xi, si, i)
 		}
-
-		// correctness of the folding
-		if i < s.nbSteps-1 {
-
-			var fe, fo, l, r, fn fr.Element
-
-			// l = P(gⁱ), r = P(g^{i+n/2})
-			l.SetBytes(proof.Interactions[i][0].ProofSet[0])
-			r.SetBytes(proof.Interactions[i][1].ProofSet[0])
-
-			// (g^{si[i]}, g^{si[i]+1}) is the fiber of g^{2*si[i]}. The system to solve
-			// (for P₀(g^{2si[i]}), P₀(g^{2si[i]}) ) is:
-			// P(g^{si[i]}) = P₀(g^{2si[i]}) +  g^{si[i]/2}*P₀(g^{2si[i]})
-			// P(g^{si[i]+1}) = P₀(g^{2si[i]}) -  g^{si[i]/2}*P₀(g^{2si[i]})
-			bm := big.NewInt(int64(si[i] / 2))
-			var ginv fr.Element
-			ginv.Exp(accGInv, bm)
-			fe.Add(&l, &r)                                      // P₁(g²ⁱ) (to be multiplied by 2⁻¹)
-			fo.Sub(&l, &r).Mul(&fo, &ginv)                      // P₀(g²ⁱ) (to be multiplied by 2⁻¹)
-			fo.Mul(&fo, &xi[i]).Add(&fo, &fe).Mul(&fo, &twoInv) // P₀(g²ⁱ) + xᵢ * P₁(g²ⁱ)
-
-			fn.SetBytes(proof.Interactions[i+1][si[i+1]%2].ProofSet[0])
-
-			if !fo.Equal(&fn) {
-				return ErrProximityTestFolding
-			}
-
-			// next inverse generator
-			accGInv.Square(&accGInv)
+	})
+	for _, err := range errs {
+		if err != nil {
+			return err
 		}
-
 	}
+	return nil
+}
 
-	// last transition
-	var fe, fo, l, r fr.Element
-
-	l.SetBytes(proof.Interactions[s.nbSteps-1][0].ProofSet[0])
-	r.SetBytes(proof.Interactions[s.nbSteps-1][1].ProofSet[0])
+// verifyStep checks the Merkle proofs of the queries of the i-th step of a round, and the
+// correctness of the folding between the i-th and the (i+1)-th steps (or the final evaluation).
+// xi are the folding challenges, si the positions of the queries.
+func (s radixTwoFri) verifyStep(h hash.Hash, proof Round, xi []fr.Element, si []int, i int) error {
 
-	_si := si[s.nbSteps-1] / 2
+	// correctness of the Merkle proof of the fiber si[i]/2
+	if len(proof.Interactions[i].ProofSet) == 0 {
+		return ErrMerklePath
+	}
 
-	accGInv.Exp(accGInv, big.NewInt(int64(_si)))
+	// l = P(gⁱ), r = P(g^{i+n/2})
+	l, r, err := fiberFromLeaf(proof.Interactions[i].ProofSet[0])
+	if err != nil {
+		return err
+	}
+	if !verifyFiber(h, proof.Interactions[i].MerkleRoot, l, r, proof.Interactions[i].ProofSet, uint64(si[i]/2), proof.Interactions[i].numLeaves) {
+		return ErrMerklePath
+	}
 
-	fe.Add(&l, &r)                                                // P₁(g²ⁱ) (to be multiplied by 2⁻¹)
-	fo.Sub(&l, &r).Mul(&fo, &accGInv)                             // P₀(g²ⁱ) (to be multiplied by 2⁻¹)
-	fo.Mul(&fo, &xi[s.nbSteps-1]).Add(&fo, &fe).Mul(&fo, &twoInv) // P₀(g²ⁱ) + xᵢ * P₁(g²ⁱ)
+	// correctness of the folding
+	var fe, fo fr.Element
+
+	// (g^{si[i]}, g^{si[i]+1}) is the fiber of g^{2*si[i]}. The system to solve
+	// (for P₀(g^{2si[i]}), P₀(g^{2si[i]}) ) is:
+	// P(g^{si[i]}) = P₀(g^{2si[i]}) +  g^{si[i]/2}*P₀(g^{2si[i]})
+	// P(g^{si[i]+1}) = P₀(g^{2si[i]}) -  g^{si[i]/2}*P₀(g^{2si[i]})
+	// where g is the generator of the domain of the i-th step, g⁻¹ = (generator of the domain)^{-2ⁱ}
+	var ginv fr.Element
+	e := new(big.Int).Lsh(big.NewInt(int64(si[i]/2)), uint(i))
+	ginv.Exp(s.domain.GeneratorInv, e)
+	fe.Add(&l, &r)                                      // P₁(g²ⁱ) (to be multiplied by 2⁻¹)
+	fo.Sub(&l, &r).Mul(&fo, &ginv)                      // P₀(g²ⁱ) (to be multiplied by 2⁻¹)
+	fo.Mul(&fo, &xi[i]).Add(&fo, &fe).Mul(&fo, &twoInv) // P₀(g²ⁱ) + xᵢ * P₁(g²ⁱ)
+
+	if i < s.nbSteps-1 {
+		if len(proof.Interactions[i+1].ProofSet) == 0 {
+			return ErrMerklePath
+		}
+		fn, fnNeighbor, err := fiberFromLeaf(proof.Interactions[i+1].ProofSet[0])
+		if err != nil {
+			return err
+		}
+		if si[i+1]%2 == 1 {
+			fn = fnNeighbor
+		}
+		if !fo.Equal(&fn) {
+			return ErrProximityTestFolding
+		}
+		return nil
+	}
 
 	// Last step: the final evaluation should be the evaluation of a degree 0 polynomial,
 	// so it must be constant.
@@ -681,13 +730,21 @@ func (s radixTwoFri) verifyProofOfProximitySingleRound(salt fr.Element, proof Ro
 }
 
 // VerifyProofOfProximity verifies the proof, by checking each interaction one
-// by one.
+// by one. If the IOPP was created with NewWithHashFunc, the queries are checked in parallel.
 func (s radixTwoFri) VerifyProofOfProximity(proof ProofOfProximity) error {
+	return s.verifyProofOfProximity(s.h, proof, true)
+}
+
+func (s radixTwoFri) verifyProofOfProximity(h hash.Hash, proof ProofOfProximity, parallelQueries bool) error {
+
+	if len(proof.Rounds) != nbRounds {
+		return ErrProximityTestFolding
+	}
 
 	var salt, one fr.Element
 	one.SetOne()
 	for i := 0; i < nbRounds; i++ {
-		err := s.verifyProofOfProximitySingleRound(salt, proof.Rounds[i])
+		err := s.verifyProofOfProximitySingleRound(h, salt, proof.Rounds[i], parallelQueries)
 		if err != nil {
 			return err
 		}
@@ -696,3 +753,34 @@ func (s radixTwoFri) VerifyProofOfProximity(proof ProofOfProximity) error {
 	return nil
 
 }
+
+// BatchVerifyProofOfProximity verifies several proofs of proximity for the same IOPP. It returns
+// an error if any of them fails, and the index of the first failing proof.
+//
+// If the IOPP was created with NewWithHashFunc, the proofs are verified in parallel, each with its
+// own hash function; otherwise they are verified one after the other.
+func (s radixTwoFri) BatchVerifyProofOfProximity(proofs []ProofOfProximity) (int, error) {
+
+	if s.newHash == nil {
+		for i := range proofs {
+			if err := s.verifyProofOfProximity(s.h, proofs[i], false); err != nil {
+				return i, err
+			}
+		}
+		return -1, nil
+	}
+
+	errs := make([]error, len(proofs))
+	parallel.Execute(len(proofs), func(start, end int) {
+		h := s.newHash()
+		for i := start; i < end; i++ {
+			errs[i] = s.verifyProofOfProximity(h, proofs[i], false)
+		}
+	})
+	for i, err := range errs {
+		if err != nil {
+			return i, err
+		}
+	}
+	return -1, nil
+}
@@ -0,0 +1,142 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package iop
+
+import (
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr/fft"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// BarycentricWeights returns the evaluations at z of the Lagrange polynomials of domain, in
+// Regular layout: wᵢ = Lᵢ(z), where Lᵢ(ωʲ) = δᵢⱼ in Lagrange basis, and Lᵢ(g*ωʲ) = δᵢⱼ in
+// LagrangeCoset basis (g is domain.FrMultiplicativeGen).
+//
+// A polynomial p of size domain.Cardinality, in the given basis and in Regular layout,
+// then evaluates to p(z) = ∑ᵢ pᵢ*wᵢ; the weights are computed once and shared by all the
+// polynomials opened at z. In Lagrange basis,
+//
+//	Lᵢ(z) = (zⁿ-1)/n * ωⁱ/(z-ωⁱ)
+func BarycentricWeights(z fr.Element, basis Basis, domain *fft.Domain) ([]fr.Element, error) {
+	switch basis {
+	case Lagrange:
+	case LagrangeCoset:
+		z.Mul(&z, &domain.FrMultiplicativeGenInv)
+	default:
+		return nil, ErrMustBeLagrangeOrLagrangeCoset
+	}
+	return lagrangeWeights(z, domain), nil
+}
+
+// BatchEvaluate returns the evaluations at z of the polynomials, res[i] = polys[i](z), see
+// Polynomial.Evaluate.
+//
+// The polynomials in Lagrange or LagrangeCoset basis must have domain.Cardinality coefficients.
+// Their barycentric weights are computed once per basis (and shift), and each evaluation is then
+// an inner product with the coefficients.
+func BatchEvaluate(polys []*Polynomial, z fr.Element, domain *fft.Domain) ([]fr.Element, error) {
+
+	type weightsKey struct {
+		basis       Basis
+		shift, size int
+	}
+	weights := make(map[weightsKey][]fr.Element)
+	for _, p := range polys {
+		if p.Basis == Canonical {
+			continue
+		}
+		if p.coefficients.Len() != int(domain.Cardinality) {
+			return nil, ErrInconsistentSizeDomain
+		}
+		k := weightsKey{basis: p.Basis, shift: p.shift}
+		if p.shift != 0 {
+			k.size = p.size
+		}
+		if _, ok := weights[k]; ok {
+			continue
+		}
+		w, err := BarycentricWeights(p.shiftPoint(z), p.Basis, domain)
+		if err != nil {
+			return nil, err
+		}
+		weights[k] = w
+	}
+
+	res := make([]fr.Element, len(polys))
+	parallel.Execute(len(polys), func(start, end int) {
+		for i := start; i < end; i++ {
+			p := polys[i]
+			if p.Basis == Canonical {
+				res[i] = p.polynomial.evaluate(p.shiftPoint(z))
+				continue
+			}
+			k := weightsKey{basis: p.Basis, shift: p.shift}
+			if p.shift != 0 {
+				k.size = p.size
+			}
+			res[i] = p.polynomial.innerProduct(weights[k])
+		}
+	})
+	return res, nil
+}
+
+// lagrangeWeights returns Lᵢ(x) = (xⁿ-1)/n * ωⁱ/(x-ωⁱ) for the roots of unity ωⁱ of domain.
+// If x = ωʲ, the weights are the indicator of j.
+func lagrangeWeights(x fr.Element, domain *fft.Domain) []fr.Element {
+	roots := domain.RootsOfUnity()
+	res := make([]fr.Element, len(roots))
+	for i := range res {
+		res[i].Sub(&x, &roots[i])
+		if res[i].IsZero() {
+			res = make([]fr.Element, len(roots))
+			res[i].SetOne()
+			return res
+		}
+	}
+	res = fr.BatchInvert(res)
+
+	var c, one fr.Element
+	one.SetOne()
+	c.Exp(x, big.NewInt(int64(len(roots)))).Sub(&c, &one).Mul(&c, &domain.CardinalityInv)
+	parallel.Execute(len(res), func(start, end int) {
+		for i := start; i < end; i++ {
+			res[i].Mul(&res[i], &roots[i]).Mul(&res[i], &c)
+		}
+	})
+	return res
+}
+
+// innerProduct returns ∑ᵢ pᵢ*wᵢ, where the weights are in Regular layout.
+func (p *polynomial) innerProduct(w []fr.Element) fr.Element {
+	var res, tmp fr.Element
+	if p.Layout == Regular {
+		for i := range w {
+			tmp.Mul(&(*p.coefficients)[i], &w[i])
+			res.Add(&res, &tmp)
+		}
+		return res
+	}
+	nn := uint64(64 - bits.TrailingZeros(uint(len(w))))
+	for i := range w {
+		tmp.Mul(&(*p.coefficients)[bits.Reverse64(uint64(i))>>nn], &w[i])
+		res.Add(&res, &tmp)
+	}
+	return res
+}
@@ -68,6 +68,91 @@ func getPermutedPolynomials(sizePolynomials, nbPolynomials int) ([]*Polynomial,
 
 }
 
+func TestBuildRatioShuffledVectorsOnCoset(t *testing.T) {
+
+	sizePolynomials := 8
+	nbPolynomials := 4
+	numerator, denominator, _ := getPermutedPolynomials(sizePolynomials, nbPolynomials)
+	domain := fft.NewDomain(uint64(sizePolynomials))
+	var beta fr.Element
+	beta.SetRandom()
+
+	// the permuted vectors are the evaluations on the coset, in regular
+	// or bit reversed layout
+	for i := 0; i < nbPolynomials; i++ {
+		numerator[i].Basis = LagrangeCoset
+		denominator[i].Basis = LagrangeCoset
+		denominator[i].ToBitReverse()
+	}
+	backupNumerator := make([]*Polynomial, nbPolynomials)
+	backupDenominator := make([]*Polynomial, nbPolynomials)
+	for i := 0; i < nbPolynomials; i++ {
+		backupNumerator[i] = numerator[i].Clone()
+		backupDenominator[i] = denominator[i].Clone()
+	}
+
+	ratio, err := BuildRatioShuffledVectorsOnCoset(numerator, denominator, beta, Form{Basis: LagrangeCoset, Layout: Regular}, domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the entries are the partial products of the evaluations on the coset
+	var expected, a fr.Element
+	expected.SetOne()
+	for j := 0; j < sizePolynomials; j++ {
+		if !ratio.Coefficients()[j].Equal(&expected) {
+			t.Fatalf("wrong accumulated ratio at index %d", j)
+		}
+		for i := 0; i < nbPolynomials; i++ {
+			a.Sub(&beta, &backupNumerator[i].Clone().ToRegular().Coefficients()[j])
+			expected.Mul(&expected, &a)
+			a.Sub(&beta, &backupDenominator[i].Clone().ToRegular().Coefficients()[j])
+			expected.Div(&expected, &a)
+		}
+	}
+	if !expected.IsOne() {
+		t.Fatal("accumulating ratio is not equal to one")
+	}
+
+	// the inputs were already on the coset: they are left untouched
+	for i := 0; i < nbPolynomials; i++ {
+		if numerator[i].Basis != LagrangeCoset || !cmpCoefficents(numerator[i].coefficients, backupNumerator[i].coefficients) {
+			t.Fatal("the numerator shouldn't be modified")
+		}
+	}
+
+	// the ratio doesn't depend on the form of the inputs, and can be
+	// returned in any form
+	for i := 0; i < nbPolynomials; i++ {
+		numerator[i] = backupNumerator[i].Clone().ToCanonical(domain)
+		denominator[i] = backupDenominator[i].Clone().ToLagrange(domain).ToRegular()
+	}
+	for _, form := range []Form{
+		{Basis: Canonical, Layout: Regular},
+		{Basis: Canonical, Layout: BitReverse},
+		{Basis: Lagrange, Layout: Regular},
+		{Basis: LagrangeCoset, Layout: BitReverse},
+	} {
+		_numerator := make([]*Polynomial, nbPolynomials)
+		_denominator := make([]*Polynomial, nbPolynomials)
+		for i := 0; i < nbPolynomials; i++ {
+			_numerator[i] = numerator[i].Clone()
+			_denominator[i] = denominator[i].Clone()
+		}
+		_ratio, err := BuildRatioShuffledVectorsOnCoset(_numerator, _denominator, beta, form, domain)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _ratio.Form != form {
+			t.Fatal("the ratio is not in the expected form")
+		}
+		_ratio.ToLagrangeCoset(domain).ToRegular()
+		if !cmpCoefficents(_ratio.coefficients, ratio.coefficients) {
+			t.Fatalf("wrong ratio in form %v", form)
+		}
+	}
+}
+
 func TestBuildRatioShuffledVectors(t *testing.T) {
 
 	// generate random vectors, interpreted in Lagrange form,
@@ -188,6 +273,122 @@ func TestBuildRatioShuffledVectors(t *testing.T) {
 
 }
 
+func TestBuildRatioCustom(t *testing.T) {
+
+	sizePolynomials := 8
+	nbPolynomials := 4
+	numerator, denominator, _ := getPermutedPolynomials(sizePolynomials, nbPolynomials)
+	domain := fft.NewDomain(uint64(sizePolynomials))
+	var beta fr.Element
+	beta.SetRandom()
+
+	expectedForm := Form{Basis: Lagrange, Layout: Regular}
+	columns := make([]*Polynomial, 2*nbPolynomials)
+	for i := 0; i < nbPolynomials; i++ {
+		columns[i] = numerator[i].Clone()
+		columns[nbPolynomials+i] = denominator[i].Clone().ToBitReverse()
+	}
+	expected, err := BuildRatioShuffledVectors(numerator, denominator, beta, expectedForm, domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the row terms Πᵢ(β-Pᵢ) and Πᵢ(β-Qᵢ) of BuildRatioShuffledVectors
+	product := func(values []fr.Element) fr.Element {
+		var res, a fr.Element
+		res.SetOne()
+		for i := range values {
+			a.Sub(&beta, &values[i])
+			res.Mul(&res, &a)
+		}
+		return res
+	}
+	num := func(_ int, values []fr.Element) fr.Element { return product(values[:nbPolynomials]) }
+	den := func(_ int, values []fr.Element) fr.Element { return product(values[nbPolynomials:]) }
+
+	ratio, err := BuildRatioCustom(columns, num, den, expectedForm, domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cmpCoefficents(ratio.coefficients, expected.coefficients) {
+		t.Fatal("coefficients of ratio are not consistent")
+	}
+
+	// canonical output
+	ratio, err = BuildRatioCustom(columns, num, den, Form{Basis: Canonical, Layout: Regular}, domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ratio.ToLagrange(domain).ToRegular()
+	if !cmpCoefficents(ratio.coefficients, expected.coefficients) {
+		t.Fatal("coefficients of ratio are not consistent")
+	}
+
+	// zero denominator
+	zero := func(i int, _ []fr.Element) fr.Element {
+		var res fr.Element
+		if i != 3 {
+			res.SetOne()
+		}
+		return res
+	}
+	if _, err := BuildRatioCustom(columns, num, zero, expectedForm, domain); err != ErrZeroRowTerm {
+		t.Fatal("expected ErrZeroRowTerm, got", err)
+	}
+}
+
+func TestBuildRunningSum(t *testing.T) {
+
+	sizePolynomials := 8
+	domain := fft.NewDomain(uint64(sizePolynomials))
+	columns := []*Polynomial{
+		NewPolynomial(randomVector(sizePolynomials), Form{Basis: Lagrange, Layout: Regular}),
+		NewPolynomial(randomVector(sizePolynomials), Form{Basis: Lagrange, Layout: BitReverse}),
+	}
+	var beta fr.Element
+	beta.SetRandom()
+
+	// Σᵢ m(ωⁱ)/(β-f(ωⁱ)), with the multiplicities m and the values f in the columns
+	num := func(_ int, values []fr.Element) fr.Element { return values[0] }
+	den := func(_ int, values []fr.Element) fr.Element {
+		var res fr.Element
+		res.Sub(&beta, &values[1])
+		return res
+	}
+	sum, err := BuildRunningSum(columns, num, den, Form{Basis: Lagrange, Layout: Regular}, domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !sum.Coefficients()[0].IsZero() {
+		t.Fatal("the running sum should start at zero")
+	}
+	for i := 0; i < sizePolynomials-1; i++ {
+		// S(ωⁱ⁺¹)-S(ωⁱ) = m(ωⁱ)/(β-f(ωⁱ))
+		var a, b fr.Element
+		a.Sub(&sum.Coefficients()[i+1], &sum.Coefficients()[i])
+		m, f := columns[0].GetCoeff(i), columns[1].GetCoeff(i)
+		b.Sub(&beta, &f).Mul(&b, &a)
+		if !b.Equal(&m) {
+			t.Fatal("wrong running sum")
+		}
+	}
+
+	// a nil denominator is 1
+	sum, err = BuildRunningSum(columns, num, nil, Form{Basis: Lagrange, Layout: Regular}, domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expected fr.Element
+	for i := 0; i < sizePolynomials-1; i++ {
+		m := columns[0].GetCoeff(i)
+		expected.Add(&expected, &m)
+	}
+	if !sum.Coefficients()[sizePolynomials-1].Equal(&expected) {
+		t.Fatal("wrong running sum")
+	}
+}
+
 // sizePolynomial*nbPolynomial must be divisible by 2.
 // The function generates a list of nbPolynomials (P_i) of size n=sizePolynomials
 // such that [P₁ ∥ .. ∥ P₂ ] is invariant under the permutation
@@ -324,3 +525,25 @@ func TestBuildRatioCopyConstraint(t *testing.T) {
 		}
 	}
 }
+
+func TestSupportIdentityPermutationCoset(t *testing.T) {
+
+	const nbCopies = 4
+	domain := fft.NewDomain(8)
+	support := getSupportIdentityPermutation(nbCopies, domain)
+	n := int(domain.Cardinality)
+
+	for k := nbCopies - 1; k >= 0; k-- {
+		coset := SupportIdentityPermutationCoset(k, domain)
+		for i := range coset {
+			if !coset[i].Equal(&support[k*n+i]) {
+				t.Fatalf("coset %d doesn't match the support of the identity permutation", k)
+			}
+		}
+		// the cosets don't share the cached roots of unity
+		coset[0].SetUint64(42)
+	}
+	if roots := domain.RootsOfUnity(); !roots[0].IsOne() {
+		t.Fatal("the cached roots of unity were modified")
+	}
+}
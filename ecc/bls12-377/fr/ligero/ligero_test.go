@@ -0,0 +1,153 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ligero
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr/polynomial"
+)
+
+func TestOpening(t *testing.T) {
+	t.Parallel()
+
+	for nbVars := 0; nbVars <= 9; nbVars++ {
+		params, err := NewParams(nbVars, 4, 20, sha256.New)
+		if err != nil {
+			t.Fatal(err)
+		}
+		evaluations := make(fr.Vector, 1<<nbVars).MustSetRandom()
+		point := make(fr.Vector, nbVars).MustSetRandom()
+
+		digest, state, err := params.Commit(evaluations)
+		if err != nil {
+			t.Fatal(err)
+		}
+		proof, err := params.Open(state, point, sha256.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := polynomial.MultiLin(evaluations).Evaluate(point, nil); !proof.ClaimedValue.Equal(&expected) {
+			t.Fatal("wrong claimed value")
+		}
+		if err := params.Verify(digest, &proof, point, sha256.New()); err != nil {
+			t.Fatal(err)
+		}
+
+		// wrong claimed value
+		one := fr.One()
+		wrongProof := proof
+		wrongProof.ClaimedValue.Add(&wrongProof.ClaimedValue, &one)
+		if err := params.Verify(digest, &wrongProof, point, sha256.New()); err != ErrEvaluationTest {
+			t.Fatal("verifying a wrong claimed value should fail")
+		}
+
+		// consistent claimed value, but wrong evaluation vector
+		wrongProof = proof
+		wrongProof.Evaluation = make([]fr.Element, len(proof.Evaluation))
+		copy(wrongProof.Evaluation, proof.Evaluation)
+		wrongProof.Evaluation[0].Add(&wrongProof.Evaluation[0], &one)
+		eqColumns := polynomial.EqVector(point[nbVars/2:])
+		wrongProof.ClaimedValue.Add(&proof.ClaimedValue, &eqColumns[0])
+		if err := params.Verify(digest, &wrongProof, point, sha256.New()); err == nil {
+			t.Fatal("verifying a wrong evaluation vector should fail")
+		}
+
+		// wrong column
+		wrongProof = proof
+		wrongProof.Columns = make([][]fr.Element, len(proof.Columns))
+		copy(wrongProof.Columns, proof.Columns)
+		wrongProof.Columns[0] = make(fr.Vector, params.NbRows).MustSetRandom()
+		if err := params.Verify(digest, &wrongProof, point, sha256.New()); err != ErrMerklePath {
+			t.Fatal("verifying a wrong column should fail")
+		}
+
+		// wrong commitment
+		otherDigest, _, err := params.Commit(make(fr.Vector, 1<<nbVars).MustSetRandom())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := params.Verify(otherDigest, &proof, point, sha256.New()); err == nil {
+			t.Fatal("verifying against a wrong commitment should fail")
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewParams(4, 3, 10, sha256.New); err != ErrInvalidParameters {
+		t.Fatal("expected ErrInvalidParameters, got", err)
+	}
+	if _, err := NewParams(4, 2, 0, sha256.New); err != ErrInvalidParameters {
+		t.Fatal("expected ErrInvalidParameters, got", err)
+	}
+
+	params, err := NewParams(4, 2, 10, sha256.New)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := params.Commit(make(fr.Vector, 8).MustSetRandom()); err != ErrInvalidNbEvaluations {
+		t.Fatal("expected ErrInvalidNbEvaluations, got", err)
+	}
+	digest, state, err := params.Commit(make(fr.Vector, 16).MustSetRandom())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := params.Open(state, make(fr.Vector, 3).MustSetRandom(), sha256.New()); err != ErrInvalidPoint {
+		t.Fatal("expected ErrInvalidPoint, got", err)
+	}
+	point := make(fr.Vector, 4).MustSetRandom()
+	proof, err := params.Open(state, point, sha256.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof.Columns = proof.Columns[1:]
+	if err := params.Verify(digest, &proof, point, sha256.New()); err != ErrInvalidProof {
+		t.Fatal("expected ErrInvalidProof, got", err)
+	}
+}
+
+func BenchmarkOpening(b *testing.B) {
+	const nbVars = 16
+	params, err := NewParams(nbVars, 4, 128, sha256.New)
+	if err != nil {
+		b.Fatal(err)
+	}
+	evaluations := make(fr.Vector, 1<<nbVars).MustSetRandom()
+	point := make(fr.Vector, nbVars).MustSetRandom()
+	digest, state, _ := params.Commit(evaluations)
+	proof, _ := params.Open(state, point, sha256.New())
+
+	b.Run("Commit", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _, _ = params.Commit(evaluations)
+		}
+	})
+	b.Run("Open", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = params.Open(state, point, sha256.New())
+		}
+	})
+	b.Run("Verify", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = params.Verify(digest, &proof, point, sha256.New())
+		}
+	})
+}
@@ -17,7 +17,6 @@
 package plookup
 
 import (
-	"math/big"
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
@@ -35,7 +34,7 @@ func TestLookupVector(t *testing.T) {
 		fvector[i].Set(&lookupVector[(4*i+1)%8])
 	}
 
-	kzgSrs, err := kzg.NewSRS(64, big.NewInt(13))
+	kzgSrs, err := kzg.NewSRSInsecure(64, []byte("gnark-crypto tests"))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -72,7 +71,7 @@ func TestLookupVector(t *testing.T) {
 
 func TestLookupTable(t *testing.T) {
 
-	kzgSrs, err := kzg.NewSRS(64, big.NewInt(13))
+	kzgSrs, err := kzg.NewSRSInsecure(64, []byte("gnark-crypto tests"))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -124,7 +123,7 @@ func BenchmarkPlookup(b *testing.B) {
 	srsSize := 1 << 15
 	polySize := 1 << 14
 
-	kzgSrs, _ := kzg.NewSRS(uint64(srsSize), big.NewInt(13))
+	kzgSrs, _ := kzg.NewSRSInsecure(uint64(srsSize), []byte("gnark-crypto tests"))
 	a := make(fr.Vector, polySize)
 	c := make(fr.Vector, polySize)
 
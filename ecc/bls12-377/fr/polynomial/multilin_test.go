@@ -137,3 +137,18 @@ func TestFoldedEqTable(t *testing.T) {
 	}
 
 }
+
+func TestEqVector(t *testing.T) {
+	q := make([]fr.Element, 3)
+	for i := range q {
+		q[i].SetInt64(int64(2*i + 3))
+	}
+
+	eq := EqVector(q)
+	for i := range eq {
+		// Eq(q, i) is the value at q of the polynomial that is 1 at i and 0 elsewhere
+		indicator := make(MultiLin, len(eq))
+		indicator[i].SetOne()
+		assert.Equal(t, indicator.Evaluate(q, nil), eq[i], "EqVector disagrees with the indicator polynomials", i)
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package whir
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr/polynomial"
+)
+
+func TestMonomial(t *testing.T) {
+	t.Parallel()
+
+	const nbVars = 5
+	evaluations := make(fr.Vector, 1<<nbVars).MustSetRandom()
+	coefficients := make([]fr.Element, len(evaluations))
+	copy(coefficients, evaluations)
+	toMonomial(coefficients)
+
+	point := make(fr.Vector, nbVars).MustSetRandom()
+	expected := polynomial.MultiLin(evaluations).Evaluate(point, nil)
+	if v := evalMonomial(coefficients, point); !v.Equal(&expected) {
+		t.Fatal("wrong evaluation in the monomial basis")
+	}
+
+	// the multilinear polynomial at pow(z) is the univariate polynomial at z
+	var z fr.Element
+	z.SetRandom()
+	expected = horner(coefficients, &z)
+	if v := evalMonomial(coefficients, powVector(z, nbVars)); !v.Equal(&expected) {
+		t.Fatal("wrong evaluation at pow(z)")
+	}
+}
+
+func TestOpening(t *testing.T) {
+	t.Parallel()
+
+	for foldingFactor := 1; foldingFactor <= 3; foldingFactor++ {
+		for nbVars := 0; nbVars <= 8; nbVars++ {
+			params, err := NewParams(nbVars, 4, foldingFactor, 10, sha256.New)
+			if err != nil {
+				t.Fatal(err)
+			}
+			evaluations := make(fr.Vector, 1<<nbVars).MustSetRandom()
+			point := make(fr.Vector, nbVars).MustSetRandom()
+
+			digest, state, err := params.Commit(evaluations)
+			if err != nil {
+				t.Fatal(err)
+			}
+			proof, err := params.Open(state, point, sha256.New())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if expected := polynomial.MultiLin(evaluations).Evaluate(point, nil); !proof.ClaimedValue.Equal(&expected) {
+				t.Fatal("wrong claimed value")
+			}
+			if err := params.Verify(digest, &proof, point, sha256.New()); err != nil {
+				t.Fatal(err)
+			}
+
+			// wrong claimed value
+			one := fr.One()
+			wrongProof := proof
+			wrongProof.ClaimedValue.Add(&wrongProof.ClaimedValue, &one)
+			if err := params.Verify(digest, &wrongProof, point, sha256.New()); err == nil {
+				t.Fatal("verifying a wrong claimed value should fail")
+			}
+
+			// wrong final polynomial
+			wrongProof = proof
+			wrongProof.FinalPolynomial = make([]fr.Element, len(proof.FinalPolynomial))
+			copy(wrongProof.FinalPolynomial, proof.FinalPolynomial)
+			wrongProof.FinalPolynomial[0].Add(&wrongProof.FinalPolynomial[0], &one)
+			if err := params.Verify(digest, &wrongProof, point, sha256.New()); err == nil {
+				t.Fatal("verifying a wrong final polynomial should fail")
+			}
+
+			// wrong fiber
+			wrongProof = proof
+			wrongProof.Rounds = make([]Round, len(proof.Rounds))
+			copy(wrongProof.Rounds, proof.Rounds)
+			fibers := make([][]fr.Element, len(proof.Rounds[0].Fibers))
+			copy(fibers, proof.Rounds[0].Fibers)
+			fibers[0] = make(fr.Vector, len(fibers[0])).MustSetRandom()
+			wrongProof.Rounds[0].Fibers = fibers
+			if err := params.Verify(digest, &wrongProof, point, sha256.New()); err != ErrMerklePath {
+				t.Fatal("verifying a wrong fiber should fail")
+			}
+
+			// wrong commitment
+			otherDigest, _, err := params.Commit(make(fr.Vector, 1<<nbVars).MustSetRandom())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := params.Verify(otherDigest, &proof, point, sha256.New()); err == nil {
+				t.Fatal("verifying against a wrong commitment should fail")
+			}
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewParams(4, 3, 2, 10, sha256.New); err != ErrInvalidParameters {
+		t.Fatal("expected ErrInvalidParameters, got", err)
+	}
+	if _, err := NewParams(4, 2, 0, 10, sha256.New); err != ErrInvalidParameters {
+		t.Fatal("expected ErrInvalidParameters, got", err)
+	}
+	if _, err := NewParams(4, 2, 2, 0, sha256.New); err != ErrInvalidParameters {
+		t.Fatal("expected ErrInvalidParameters, got", err)
+	}
+
+	params, err := NewParams(4, 2, 1, 10, sha256.New)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := params.Commit(make(fr.Vector, 8).MustSetRandom()); err != ErrInvalidNbEvaluations {
+		t.Fatal("expected ErrInvalidNbEvaluations, got", err)
+	}
+	digest, state, err := params.Commit(make(fr.Vector, 16).MustSetRandom())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := params.Open(state, make(fr.Vector, 3).MustSetRandom(), sha256.New()); err != ErrInvalidPoint {
+		t.Fatal("expected ErrInvalidPoint, got", err)
+	}
+	point := make(fr.Vector, 4).MustSetRandom()
+	proof, err := params.Open(state, point, sha256.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof.Rounds = proof.Rounds[1:]
+	if err := params.Verify(digest, &proof, point, sha256.New()); err != ErrInvalidProof {
+		t.Fatal("expected ErrInvalidProof, got", err)
+	}
+}
+
+func BenchmarkOpening(b *testing.B) {
+	const nbVars = 16
+	params, err := NewParams(nbVars, 4, 4, 64, sha256.New)
+	if err != nil {
+		b.Fatal(err)
+	}
+	evaluations := make(fr.Vector, 1<<nbVars).MustSetRandom()
+	point := make(fr.Vector, nbVars).MustSetRandom()
+	digest, state, _ := params.Commit(evaluations)
+	proof, _ := params.Open(state, point, sha256.New())
+
+	b.Run("Commit", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _, _ = params.Commit(evaluations)
+		}
+	})
+	b.Run("Open", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = params.Open(state, point, sha256.New())
+		}
+	})
+	b.Run("Verify", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = params.Verify(digest, &proof, point, sha256.New())
+		}
+	})
+}
@@ -0,0 +1,324 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12377
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fp"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+)
+
+// randomGT returns n random elements of GT
+func randomGT(n int) []GT {
+	res := make([]GT, n)
+	for i := range res {
+		res[i].SetRandom()
+		res[i] = FinalExponentiation(&res[i])
+	}
+	return res
+}
+
+// naiveMultiExpGT returns ∏ basesᵢ^scalarsᵢ using the windowed GT.Exp
+func naiveMultiExpGT(bases []GT, scalars []fr.Element) GT {
+	var res, tmp GT
+	var k big.Int
+	res.SetOne()
+	for i := range bases {
+		scalars[i].BigInt(&k)
+		tmp.Exp(bases[i], &k)
+		res.Mul(&res, &tmp)
+	}
+	return res
+}
+
+func TestMultiExpGT(t *testing.T) {
+	t.Parallel()
+
+	sizes := []int{1, 3, 17, 130}
+	if testing.Short() {
+		sizes = []int{1, 3, 17}
+	}
+	for _, n := range sizes {
+		bases := randomGT(n)
+		scalars := make([]fr.Element, n)
+		for i := range scalars {
+			scalars[i].SetRandom()
+		}
+		// edge cases: zero, one and -1 (max digits)
+		scalars[0].SetZero()
+		if n > 2 {
+			scalars[1].SetOne()
+			scalars[2].SetOne().Neg(&scalars[2])
+		}
+
+		expected := naiveMultiExpGT(bases, scalars)
+		for _, nbTasks := range []int{1, 4} {
+			res, err := MultiExpGT(bases, scalars, ecc.MultiExpConfig{NbTasks: nbTasks})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !res.Equal(&expected) {
+				t.Fatalf("MultiExpGT(%d bases, %d tasks) doesn't match the naive product", n, nbTasks)
+			}
+		}
+	}
+}
+
+func TestMultiExpGTScalarBits(t *testing.T) {
+	t.Parallel()
+
+	const n = 20
+	bases := randomGT(n)
+	scalars := make([]fr.Element, n)
+	for i := range scalars {
+		scalars[i].SetUint64(uint64(i)*0xbeef + 0xff)
+	}
+	expected := naiveMultiExpGT(bases, scalars)
+
+	res, err := MultiExpGT(bases, scalars, ecc.MultiExpConfig{ScalarBits: 24})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Equal(&expected) {
+		t.Fatal("MultiExpGT with small scalars doesn't match the naive product")
+	}
+
+	if _, err := MultiExpGT(bases, scalars, ecc.MultiExpConfig{ScalarBits: 8}); err == nil {
+		t.Fatal("expected an error, scalars don't fit on ScalarBits")
+	}
+}
+
+func TestMultiExpGTErrors(t *testing.T) {
+	t.Parallel()
+
+	res, err := MultiExpGT(nil, nil, ecc.MultiExpConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.IsOne() {
+		t.Fatal("empty MultiExpGT should be 1")
+	}
+
+	if _, err := MultiExpGT(randomGT(2), make([]fr.Element, 1), ecc.MultiExpConfig{}); err != errGTLength {
+		t.Fatal("expected a length mismatch error")
+	}
+	if _, err := BatchExpGT(randomGT(2), make([]fr.Element, 1)); err != errGTLength {
+		t.Fatal("expected a length mismatch error")
+	}
+}
+
+func TestBatchExpGT(t *testing.T) {
+	t.Parallel()
+
+	const n = 5
+	bases := randomGT(n)
+	scalars := make([]fr.Element, n)
+	for i := range scalars {
+		scalars[i].SetRandom()
+	}
+
+	res, err := BatchExpGT(bases, scalars)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expected GT
+	var k big.Int
+	for i := range bases {
+		scalars[i].BigInt(&k)
+		expected.Exp(bases[i], &k)
+		if !res[i].Equal(&expected) {
+			t.Fatalf("BatchExpGT mismatch at index %d", i)
+		}
+	}
+}
+
+func TestFrobeniusGT(t *testing.T) {
+	t.Parallel()
+
+	z := randomGT(1)[0]
+	// z^(pᵏ) with the exponent reduced modulo r
+	var e big.Int
+	e.SetInt64(1)
+	for k := 0; k < 30; k++ {
+		var expected GT
+		expected.Exp(z, &e)
+		if res := FrobeniusGT(&z, k); !res.Equal(&expected) {
+			t.Fatalf("FrobeniusGT(z, %d) != z^(p^%d)", k, k)
+		}
+		e.Mul(&e, fp.Modulus()).Mod(&e, fr.Modulus())
+	}
+	res := FrobeniusGT(&z, -1)
+	if res = FrobeniusGT(&res, 1); !res.Equal(&z) {
+		t.Fatal("FrobeniusGT(FrobeniusGT(z, -1), 1) != z")
+	}
+}
+
+func TestBatchIsInSubGroupGT(t *testing.T) {
+	t.Parallel()
+
+	elements := randomGT(20)
+	if !BatchIsInSubGroupGT(elements) {
+		t.Fatal("pairing outputs must be in GT")
+	}
+	if !BatchIsInSubGroupGT(nil) {
+		t.Fatal("an empty list is in GT")
+	}
+
+	// an element of the extension field which is not in GT
+	var z GT
+	z.SetRandom()
+	elements[7] = z
+	if z.IsInSubGroup() || BatchIsInSubGroupGT(elements) {
+		t.Fatal("a random element of the extension field should not be in GT")
+	}
+
+	elements[7] = GT{}
+	if BatchIsInSubGroupGT(elements) {
+		t.Fatal("0 is not in GT")
+	}
+}
+
+func TestBatchEqualGT(t *testing.T) {
+	t.Parallel()
+
+	a := randomGT(10)
+	b := make([]GT, len(a))
+	copy(b, a)
+	if !BatchEqualGT(a, b) {
+		t.Fatal("equal lists should be equal")
+	}
+	if BatchEqualGT(a, b[1:]) {
+		t.Fatal("lists of different lengths should not be equal")
+	}
+	b[9].Square(&b[9])
+	if BatchEqualGT(a, b) {
+		t.Fatal("lists with different elements should not be equal")
+	}
+}
+
+func TestHashGTToField(t *testing.T) {
+	t.Parallel()
+
+	z := randomGT(2)
+	dst := []byte("gnark-crypto/test/gt")
+
+	h0, err := HashGTToField(&z[0], dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the hash only depends on the canonical encoding
+	b := z[0].Bytes()
+	var _z GT
+	if err := _z.SetBytes(b[:]); err != nil {
+		t.Fatal(err)
+	}
+	_h0, err := HashGTToField(&_z, dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := fr.Hash(b[:], dst, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !h0.Equal(&_h0) || !h0.Equal(&expected[0]) {
+		t.Fatal("the hash of an element must be the hash of its canonical encoding")
+	}
+
+	h1, err := HashGTToField(&z[1], dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h0.Equal(&h1) {
+		t.Fatal("different elements must have different hashes")
+	}
+	h2, err := HashGTToField(&z[0], []byte("gnark-crypto/test/other"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h0.Equal(&h2) {
+		t.Fatal("the hash must depend on the domain separation tag")
+	}
+}
+
+func TestBindGT(t *testing.T) {
+	t.Parallel()
+
+	z := randomGT(2)
+	challenge := func(z *GT) []byte {
+		ts := fiatshamir.NewTranscript(sha256.New(), "gamma")
+		if err := BindGT(ts, "gamma", z); err != nil {
+			t.Fatal(err)
+		}
+		res, err := ts.ComputeChallenge("gamma")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+
+	// same as binding the canonical encoding
+	ts := fiatshamir.NewTranscript(sha256.New(), "gamma")
+	b := z[0].Bytes()
+	if err := ts.Bind("gamma", b[:]); err != nil {
+		t.Fatal(err)
+	}
+	expected, err := ts.ComputeChallenge("gamma")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(challenge(&z[0]), expected) {
+		t.Fatal("BindGT must bind the canonical encoding")
+	}
+	if bytes.Equal(challenge(&z[0]), challenge(&z[1])) {
+		t.Fatal("different elements must give different challenges")
+	}
+}
+
+func BenchmarkMultiExpGT(b *testing.B) {
+	const n = 1 << 7
+	bases := randomGT(n)
+	scalars := make([]fr.Element, n)
+	for i := range scalars {
+		scalars[i].SetRandom()
+	}
+
+	b.Run("MultiExpGT", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _ = MultiExpGT(bases, scalars, ecc.MultiExpConfig{})
+		}
+	})
+
+	b.Run("BatchExpGT and product", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			exps, _ := BatchExpGT(bases, scalars)
+			var res GT
+			res.SetOne()
+			for j := range exps {
+				res.Mul(&res, &exps[j])
+			}
+		}
+	})
+}
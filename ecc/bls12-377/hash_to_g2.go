@@ -19,6 +19,7 @@ package bls12377
 import (
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fp"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/internal/fptower"
+	"github.com/consensys/gnark-crypto/internal/parallel"
 
 	"math/big"
 )
@@ -512,7 +513,11 @@ func g2IsogenyYDenominator(dst *fptower.E2, x *fptower.E2) {
 		x)
 }
 
-func g2Isogeny(p *G2Affine) {
+// G2Isogeny maps, in place, a point of the curve E' isogenous to the G2 curve (the
+// target of MapToCurve2) to the G2 curve.
+// It can be used with MapToCurve2 and ClearCofactor to build custom
+// hash-to-curve constructions.
+func G2Isogeny(p *G2Affine) {
 
 	den := make([]fptower.E2, 2)
 
@@ -528,11 +533,35 @@ func g2Isogeny(p *G2Affine) {
 	p.Y.Mul(&p.Y, &den[1])
 }
 
-// g2SqrtRatio computes the square root of u/v and returns 0 iff u/v was indeed a quadratic residue
+// g2BatchIsogeny applies G2Isogeny to all points, with a single batch inversion.
+func g2BatchIsogeny(points []G2Affine) {
+	den := make([]fptower.E2, 2*len(points))
+	parallel.Execute(len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			p := &points[i]
+			g2IsogenyYDenominator(&den[2*i+1], &p.X)
+			g2IsogenyXDenominator(&den[2*i], &p.X)
+
+			g2IsogenyYNumerator(&p.Y, &p.X, &p.Y)
+			g2IsogenyXNumerator(&p.X, &p.X)
+		}
+	})
+
+	den = fptower.BatchInvertE2(den)
+
+	parallel.Execute(len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			points[i].X.Mul(&points[i].X, &den[2*i])
+			points[i].Y.Mul(&points[i].Y, &den[2*i+1])
+		}
+	})
+}
+
+// G2SqrtRatio computes the square root of u/v and returns 0 iff u/v was indeed a quadratic residue
 // if not, we get sqrt(Z * u / v). Recall that Z is non-residue
 // If v = 0, u/v is meaningless and the output is unspecified, without raising an error.
 // The main idea is that since the computation of the square root involves taking large powers of u/v, the inversion of v can be avoided
-func g2SqrtRatio(z *fptower.E2, u *fptower.E2, v *fptower.E2) uint64 {
+func G2SqrtRatio(z *fptower.E2, u *fptower.E2, v *fptower.E2) uint64 {
 
 	// https://www.ietf.org/archive/id/draft-irtf-cfrg-hash-to-curve-16.html#name-sqrt_ratio-for-any-field
 
@@ -616,6 +645,16 @@ func g2MulByZ(z *fptower.E2, x *fptower.E2) {
 // MapToCurve2 implements the SSWU map
 // No cofactor clearing or isogeny
 func MapToCurve2(u *fptower.E2) G2Affine {
+	var res G2Affine
+	var den fptower.E2
+	g2MapToCurveFraction(&res, &den, u)
+	res.X.Div(&res.X, &den) // 25.   x = x / tv4
+	return res
+}
+
+// g2MapToCurveFraction implements the SSWU map, without the final division:
+// the result is (p.X/den, p.Y), so that batch hashing can share the inversions.
+func g2MapToCurveFraction(p *G2Affine, den *fptower.E2, u *fptower.E2) {
 
 	var sswuIsoCurveCoeffA = fptower.E2{
 		A0: fp.Element{4274545572028848265, 14157081418478689358, 13123833976752631407, 4466041663276938746, 9062541850312583986, 90030181981586611},
@@ -673,7 +712,7 @@ func MapToCurve2(u *fptower.E2) G2Affine {
 	x.Mul(&tv1, &tv3) // 17.   x = tv1 * tv3
 
 	var y1 fptower.E2
-	gx1NSquare := g2SqrtRatio(&y1, &tv2, &tv6) // 18. (is_gx1_square, y1) = sqrt_ratio(tv2, tv6)
+	gx1NSquare := G2SqrtRatio(&y1, &tv2, &tv6) // 18. (is_gx1_square, y1) = sqrt_ratio(tv2, tv6)
 
 	var y fptower.E2
 	y.Mul(&tv1, u) // 19.   y = tv1 * u
@@ -684,14 +723,14 @@ func MapToCurve2(u *fptower.E2) G2Affine {
 	y.Select(int(gx1NSquare), &y1, &y)  // 22.   y = CMOV(y, y1, is_gx1_square)
 
 	y1.Neg(&y)
-	y.Select(int(g2Sgn0(u)^g2Sgn0(&y)), &y, &y1)
+	y.Select(int(G2Sgn0(u)^G2Sgn0(&y)), &y, &y1)
 
 	// 23.  e1 = sgn0(u) == sgn0(y)
 	// 24.   y = CMOV(-y, y, e1)
 
-	x.Div(&x, &tv4) // 25.   x = x / tv4
-
-	return G2Affine{x, y}
+	p.X = x
+	p.Y = y
+	*den = tv4
 }
 
 func g2EvalPolynomial(z *fptower.E2, monic bool, coefficients []fptower.E2, x *fptower.E2) {
@@ -709,11 +748,11 @@ func g2EvalPolynomial(z *fptower.E2, monic bool, coefficients []fptower.E2, x *f
 	z.Set(&dst)
 }
 
-// g2Sgn0 is an algebraic substitute for the notion of sign in ordered fields
+// G2Sgn0 is an algebraic substitute for the notion of sign in ordered fields
 // Namely, every non-zero quadratic residue in a finite field of characteristic =/= 2 has exactly two square roots, one of each sign
 // https://www.ietf.org/archive/id/draft-irtf-cfrg-hash-to-curve-16.html#name-the-sgn0-function
 // The sign of an element is not obviously related to that of its Montgomery form
-func g2Sgn0(z *fptower.E2) uint64 {
+func G2Sgn0(z *fptower.E2) uint64 {
 
 	nonMont := z.Bits()
 
@@ -741,7 +780,7 @@ func g2Sgn0(z *fptower.E2) uint64 {
 func MapToG2(u fptower.E2) G2Affine {
 	res := MapToCurve2(&u)
 	//this is in an isogenous curve
-	g2Isogeny(&res)
+	G2Isogeny(&res)
 	res.ClearCofactor(&res)
 	return res
 }
@@ -764,7 +803,7 @@ func EncodeToG2(msg, dst []byte) (G2Affine, error) {
 	})
 
 	//this is in an isogenous curve
-	g2Isogeny(&res)
+	G2Isogeny(&res)
 	res.ClearCofactor(&res)
 	return res, nil
 }
@@ -789,8 +828,8 @@ func HashToG2(msg, dst []byte) (G2Affine, error) {
 	})
 
 	//TODO (perf): Add in E' first, then apply isogeny
-	g2Isogeny(&Q0)
-	g2Isogeny(&Q1)
+	G2Isogeny(&Q0)
+	G2Isogeny(&Q1)
 
 	var _Q0, _Q1 G2Jac
 	_Q0.FromAffine(&Q0)
@@ -802,6 +841,62 @@ func HashToG2(msg, dst []byte) (G2Affine, error) {
 	return Q1, nil
 }
 
+// BatchHashToG2 hashes each message to a point on the G2 curve, as HashToG2.
+//
+// The field inversions of the maps, of the isogenies and of the conversions to affine
+// coordinates are shared across messages with batch inversions, and the cofactor clearing is done in
+// Jacobian coordinates.
+func BatchHashToG2(msgs [][]byte, dst []byte) ([]G2Affine, error) {
+	n := len(msgs)
+	u := make([]fptower.E2, 2*n)
+	for i := range msgs {
+		e, err := fp.Hash(msgs[i], dst, 2*2)
+		if err != nil {
+			return nil, err
+		}
+
+		u[2*i] = fptower.E2{
+			A0: e[0],
+			A1: e[1],
+		}
+		u[2*i+1] = fptower.E2{
+			A0: e[2+0],
+			A1: e[2+1],
+		}
+
+	}
+
+	// Q[2i], Q[2i+1] are the images of the two field elements of message i
+	Q := make([]G2Affine, 2*n)
+	den := make([]fptower.E2, 2*n)
+	parallel.Execute(2*n, func(start, end int) {
+		for i := start; i < end; i++ {
+			g2MapToCurveFraction(&Q[i], &den[i], &u[i])
+		}
+	})
+	den = fptower.BatchInvertE2(den)
+	parallel.Execute(2*n, func(start, end int) {
+		for i := start; i < end; i++ {
+			Q[i].X.Mul(&Q[i].X, &den[i])
+		}
+	})
+	//these are in an isogenous curve
+	g2BatchIsogeny(Q)
+
+	res := make([]G2Jac, n)
+	parallel.Execute(n, func(start, end int) {
+		var q1 G2Jac
+		for i := start; i < end; i++ {
+			res[i].FromAffine(&Q[2*i])
+			q1.FromAffine(&Q[2*i+1])
+			res[i].AddAssign(&q1)
+			res[i].ClearCofactor(&res[i])
+		}
+	})
+
+	return BatchJacobianToAffineG2(res), nil
+}
+
 func g2NotZero(x *fptower.E2) uint64 {
 	//Assuming G1 is over Fp and that if hashing is available for G2, it also is for G1
 	return g1NotZero(&x.A0) | g1NotZero(&x.A1)
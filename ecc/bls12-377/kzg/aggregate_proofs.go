@@ -0,0 +1,484 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash"
+	"math/big"
+	"math/bits"
+	"strconv"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/fiat-shamir"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrAggregationKeySize     = errors.New("the aggregation key is smaller than the number of proofs")
+	ErrVerifyAggregatedProofs = errors.New("can't verify aggregated opening proofs")
+)
+
+// aggregationKeyDST is the domain separation tag of the points of the aggregation key
+const aggregationKeyDST = "KZG-AGGREGATION-KEY-V01"
+
+// AggregationKey is the commitment key of the inner pairing product argument of
+// AggregateProofs: points Vᵢ of G₂ hashed to the curve from their index, so that nobody
+// knows their discrete logarithms, and the key needs no trusted setup.
+type AggregationKey struct {
+	V []bls12377.G2Affine
+}
+
+// NewAggregationKey returns the aggregation key for up to n opening proofs. The key of a
+// larger n extends the one of a smaller n, so a single key can serve all the sizes.
+func NewAggregationKey(n int) (AggregationKey, error) {
+	if n <= 0 {
+		return AggregationKey{}, ErrZeroNbDigests
+	}
+	size := int(ecc.NextPowerOfTwo(uint64(n)))
+	res := AggregationKey{V: make([]bls12377.G2Affine, size)}
+	var (
+		errLock  sync.Mutex
+		firstErr error
+	)
+	parallel.Execute(size, func(start, end int) {
+		var msg [8]byte
+		for i := start; i < end; i++ {
+			binary.BigEndian.PutUint64(msg[:], uint64(i))
+			var err error
+			if res.V[i], err = bls12377.HashToG2(msg[:], []byte(aggregationKeyDST)); err != nil {
+				errLock.Lock()
+				firstErr = err
+				errLock.Unlock()
+				return
+			}
+		}
+	})
+	if firstErr != nil {
+		return AggregationKey{}, firstErr
+	}
+	return res, nil
+}
+
+// AggregatedProofs aggregates existing opening proofs (Cᵢ, zᵢ, yᵢ, Hᵢ), i.e. Hᵢ proves that
+// the polynomial committed in Cᵢ evaluates to yᵢ at zᵢ, possibly computed by different provers.
+// The aggregator only needs the proofs, not the polynomials.
+//
+// With a challenge r, the openings hold if (up to a negligible probability)
+//
+//	e(∑ᵢrⁱ(Cᵢ-[yᵢ]G₁) + B, G₂) == e(A, [α]G₂), where A = ∑ᵢrⁱHᵢ and B = ∑ᵢrⁱzᵢHᵢ.
+//
+// The Hᵢ are committed in T = ∏ᵢe(Hᵢ, Vᵢ) before r is derived, and A and B are proven to be
+// inner products of the committed Hᵢ with the multi-exponentiation inner pairing product
+// argument (MIPP) of [BMMTV21], as in SnarkPack [GMN21]: each round halves the vectors, so the
+// proof holds O(log n) elements of GT and G₁, instead of n points of G₁. The verifier still
+// reads the n statements (Cᵢ, zᵢ, yᵢ), and folds the key in a multi-exponentiation of size n.
+//
+// implements io.ReaderFrom and io.WriterTo
+//
+// [BMMTV21]: https://eprint.iacr.org/2019/1177.pdf
+// [GMN21]: https://eprint.iacr.org/2021/529.pdf
+type AggregatedProofs struct {
+	// T commitment ∏ᵢe(Hᵢ, Vᵢ) to the opening proofs
+	T bls12377.GT
+
+	// A, B inner products ∑ᵢrⁱHᵢ and ∑ᵢrⁱzᵢHᵢ
+	A, B bls12377.G1Affine
+
+	// LT, RT cross commitments of the rounds of the argument
+	LT, RT []bls12377.GT
+
+	// LA, RA, LB, RB cross inner products of the rounds of the argument
+	LA, RA, LB, RB []bls12377.G1Affine
+
+	// H opening proof obtained by folding the Hᵢ
+	H bls12377.G1Affine
+}
+
+// AggregateProofs aggregates the opening proofs[i] of the polynomials committed in digests[i],
+// at points[i], into a single proof (see AggregatedProofs).
+//
+// * dataTranscript extra data that might be needed to derive the challenges
+func AggregateProofs(key AggregationKey, digests []Digest, points []fr.Element, proofs []OpeningProof, hf hash.Hash, dataTranscript ...[]byte) (AggregatedProofs, error) {
+
+	// check for invalid sizes
+	n := len(proofs)
+	if len(digests) != n {
+		return AggregatedProofs{}, ErrInvalidNbDigests
+	}
+	if len(points) != n {
+		return AggregatedProofs{}, ErrInvalidNbPoints
+	}
+	if n == 0 {
+		return AggregatedProofs{}, ErrZeroNbDigests
+	}
+	size := int(ecc.NextPowerOfTwo(uint64(n)))
+	if len(key.V) < size {
+		return AggregatedProofs{}, ErrAggregationKeySize
+	}
+
+	// the vectors are padded with points at infinity, which don't contribute to the products
+	h := make([]bls12377.G1Affine, size)
+	v := make([]bls12377.G2Affine, size)
+	claimedValues := make([]fr.Element, n)
+	for i := range proofs {
+		h[i] = proofs[i].H
+		claimedValues[i] = proofs[i].ClaimedValue
+	}
+	copy(v, key.V[:size])
+
+	var res AggregatedProofs
+	var err error
+	if res.T, err = bls12377.Pair(h, v); err != nil {
+		return AggregatedProofs{}, err
+	}
+
+	// derive r, bound to the openings and to the commitment to the proofs
+	nbRounds := bits.TrailingZeros(uint(size))
+	fs := newAggregatedProofsTranscript(hf, nbRounds)
+	r, err := deriveAggregatedProofsChallenge(fs, digests, points, claimedValues, &res.T, dataTranscript)
+	if err != nil {
+		return AggregatedProofs{}, err
+	}
+	a, b := aggregatedProofsScalars(r, points, size)
+	if _, err := res.A.MultiExp(h, a, ecc.MultiExpConfig{}); err != nil {
+		return AggregatedProofs{}, err
+	}
+	if _, err := res.B.MultiExp(h, b, ecc.MultiExpConfig{}); err != nil {
+		return AggregatedProofs{}, err
+	}
+
+	res.LT = make([]bls12377.GT, nbRounds)
+	res.RT = make([]bls12377.GT, nbRounds)
+	res.LA = make([]bls12377.G1Affine, nbRounds)
+	res.RA = make([]bls12377.G1Affine, nbRounds)
+	res.LB = make([]bls12377.G1Affine, nbRounds)
+	res.RB = make([]bls12377.G1Affine, nbRounds)
+	for j := 0; j < nbRounds; j++ {
+		m := len(h) / 2
+		hL, hR, vL, vR := h[:m], h[m:], v[:m], v[m:]
+		aL, aR, bL, bR := a[:m], a[m:], b[:m], b[m:]
+
+		// cross terms: L = (⟨hR, vL⟩, ⟨aL, hR⟩, ⟨bL, hR⟩) and R = (⟨hL, vR⟩, ⟨aR, hL⟩, ⟨bR, hL⟩)
+		if res.LT[j], err = bls12377.Pair(hR, vL); err != nil {
+			return AggregatedProofs{}, err
+		}
+		if res.RT[j], err = bls12377.Pair(hL, vR); err != nil {
+			return AggregatedProofs{}, err
+		}
+		for _, c := range []struct {
+			res     *bls12377.G1Affine
+			points  []bls12377.G1Affine
+			scalars []fr.Element
+		}{
+			{&res.LA[j], hR, aL}, {&res.RA[j], hL, aR}, {&res.LB[j], hR, bL}, {&res.RB[j], hL, bR},
+		} {
+			if _, err := c.res.MultiExp(c.points, c.scalars, ecc.MultiExpConfig{}); err != nil {
+				return AggregatedProofs{}, err
+			}
+		}
+
+		x, err := deriveAggregatedProofsRound(fs, j, &res)
+		if err != nil {
+			return AggregatedProofs{}, err
+		}
+		var xInv fr.Element
+		xInv.Inverse(&x)
+
+		// h ← hL + x·hR, v ← vL + x⁻¹·vR, a ← aL + x⁻¹·aR, b ← bL + x⁻¹·bR
+		h = foldG1(hL, hR, &x)
+		v = foldG2(vL, vR, &xInv)
+		for i := 0; i < m; i++ {
+			var t fr.Element
+			t.Mul(&aR[i], &xInv)
+			aL[i].Add(&aL[i], &t)
+			t.Mul(&bR[i], &xInv)
+			bL[i].Add(&bL[i], &t)
+		}
+		a, b = aL, bL
+	}
+	res.H = h[0]
+
+	return res, nil
+}
+
+// VerifyAggregatedProofs verifies an aggregation of the opening proofs of the polynomials
+// committed in digests, at points, to claimedValues.
+//
+// * dataTranscript extra data that might be needed to derive the challenges
+func VerifyAggregatedProofs(key AggregationKey, digests []Digest, points, claimedValues []fr.Element, proof *AggregatedProofs, hf hash.Hash, vk VerifyingKey, dataTranscript ...[]byte) error {
+
+	// check for invalid sizes
+	n := len(digests)
+	if len(points) != n {
+		return ErrInvalidNbPoints
+	}
+	if len(claimedValues) != n {
+		return ErrInvalidNbClaimedValues
+	}
+	if n == 0 {
+		return ErrZeroNbDigests
+	}
+	size := int(ecc.NextPowerOfTwo(uint64(n)))
+	if len(key.V) < size {
+		return ErrAggregationKeySize
+	}
+	nbRounds := bits.TrailingZeros(uint(size))
+	if len(proof.LT) != nbRounds || len(proof.RT) != nbRounds || len(proof.LA) != nbRounds ||
+		len(proof.RA) != nbRounds || len(proof.LB) != nbRounds || len(proof.RB) != nbRounds {
+		return ErrVerifyAggregatedProofs
+	}
+	gts := make([]bls12377.GT, 0, 2*nbRounds+1)
+	gts = append(append(append(gts, proof.T), proof.LT...), proof.RT...)
+	if !bls12377.BatchIsInSubGroupGT(gts) {
+		return ErrVerifyAggregatedProofs
+	}
+
+	// derive the challenges
+	fs := newAggregatedProofsTranscript(hf, nbRounds)
+	r, err := deriveAggregatedProofsChallenge(fs, digests, points, claimedValues, &proof.T, dataTranscript)
+	if err != nil {
+		return err
+	}
+	xs := make([]fr.Element, nbRounds)
+	for j := range xs {
+		if xs[j], err = deriveAggregatedProofsRound(fs, j, proof); err != nil {
+			return err
+		}
+	}
+	xInvs := fr.BatchInvert(xs)
+
+	// fold the statement: T ← LTˣ·T·RTˣ⁻¹, A ← x·LA + A + x⁻¹·RA, B ← x·LB + B + x⁻¹·RB
+	t := proof.T
+	var accA, accB, tmp bls12377.G1Jac
+	accA.FromAffine(&proof.A)
+	accB.FromAffine(&proof.B)
+	for j := range xs {
+		var x, xInv big.Int
+		xs[j].BigInt(&x)
+		xInvs[j].BigInt(&xInv)
+		var lt, rt bls12377.GT
+		lt.Exp(proof.LT[j], &x)
+		rt.Exp(proof.RT[j], &xInv)
+		t.Mul(&t, &lt).Mul(&t, &rt)
+		for _, c := range []struct {
+			acc  *bls12377.G1Jac
+			l, r *bls12377.G1Affine
+		}{
+			{&accA, &proof.LA[j], &proof.RA[j]},
+			{&accB, &proof.LB[j], &proof.RB[j]},
+		} {
+			tmp.FromAffine(c.l)
+			c.acc.AddAssign(tmp.ScalarMultiplication(&tmp, &x))
+			tmp.FromAffine(c.r)
+			c.acc.AddAssign(tmp.ScalarMultiplication(&tmp, &xInv))
+		}
+	}
+
+	// the final key and scalars are ∑ᵢsᵢVᵢ, ∑ᵢsᵢaᵢ and ∑ᵢsᵢbᵢ, where sᵢ is the product of the
+	// x⁻¹ of the rounds in which i was in the right half
+	s := make([]fr.Element, 1, size)
+	s[0].SetOne()
+	for j := nbRounds - 1; j >= 0; j-- {
+		m := len(s)
+		s = s[:2*m]
+		for i := 0; i < m; i++ {
+			s[m+i].Mul(&s[i], &xInvs[j])
+		}
+	}
+	var vFinal bls12377.G2Affine
+	if _, err := vFinal.MultiExp(key.V[:size], s, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+	a, b := aggregatedProofsScalars(r, points, size)
+	var aFinal, bFinal big.Int
+	sa, sb := innerProduct(s, a), innerProduct(s, b)
+	sa.BigInt(&aFinal)
+	sb.BigInt(&bFinal)
+
+	// T == e(H, V), A == aH and B == bH
+	tFinal, err := bls12377.Pair([]bls12377.G1Affine{proof.H}, []bls12377.G2Affine{vFinal})
+	if err != nil {
+		return err
+	}
+	if !t.Equal(&tFinal) {
+		return ErrVerifyAggregatedProofs
+	}
+	var expected bls12377.G1Jac
+	expected.FromAffine(&proof.H)
+	if !accA.Equal(tmp.ScalarMultiplication(&expected, &aFinal)) ||
+		!accB.Equal(tmp.ScalarMultiplication(&expected, &bFinal)) {
+		return ErrVerifyAggregatedProofs
+	}
+
+	// e(∑ᵢrⁱ(Cᵢ-[yᵢ]G₁) + B, G₂)·e(-A, [α]G₂) == 1
+	bases := make([]bls12377.G1Affine, n+2)
+	scalars := make([]fr.Element, n+2)
+	copy(bases, digests)
+	copy(scalars, a[:n])
+	for i := 0; i < n; i++ {
+		var ay fr.Element
+		ay.Mul(&a[i], &claimedValues[i])
+		scalars[n].Sub(&scalars[n], &ay)
+	}
+	bases[n].Set(&vk.G1)
+	bases[n+1].Set(&proof.B)
+	scalars[n+1].SetOne()
+	var lhs bls12377.G1Affine
+	if _, err := lhs.MultiExp(bases, scalars, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+	var negA bls12377.G1Affine
+	negA.Neg(&proof.A)
+
+	check, err := bls12377.PairingCheckFixedQ(
+		[]bls12377.G1Affine{lhs, negA},
+		vk.Lines[:],
+	)
+	if err != nil {
+		return err
+	}
+	if !check {
+		return ErrVerifyAggregatedProofs
+	}
+	return nil
+}
+
+// aggregatedProofsScalars returns the vectors (rⁱ) and (rⁱzᵢ), padded with zeros to size, so
+// that the padding of the proofs doesn't contribute to A and B
+func aggregatedProofsScalars(r fr.Element, points []fr.Element, size int) (a, b []fr.Element) {
+	a = make([]fr.Element, size)
+	b = make([]fr.Element, size)
+	a[0].SetOne()
+	for i := 1; i < len(points); i++ {
+		a[i].Mul(&a[i-1], &r)
+	}
+	for i := range points {
+		b[i].Mul(&a[i], &points[i])
+	}
+	return a, b
+}
+
+// innerProduct returns ⟨a, b⟩
+func innerProduct(a, b []fr.Element) fr.Element {
+	var res, t fr.Element
+	for i := range a {
+		t.Mul(&a[i], &b[i])
+		res.Add(&res, &t)
+	}
+	return res
+}
+
+// foldG1 returns l + x·r
+func foldG1(l, r []bls12377.G1Affine, x *fr.Element) []bls12377.G1Affine {
+	var bx big.Int
+	x.BigInt(&bx)
+	res := make([]bls12377.G1Jac, len(l))
+	parallel.Execute(len(l), func(start, end int) {
+		for i := start; i < end; i++ {
+			res[i].FromAffine(&r[i])
+			res[i].ScalarMultiplication(&res[i], &bx).AddMixed(&l[i])
+		}
+	})
+	return bls12377.BatchJacobianToAffineG1(res)
+}
+
+// foldG2 returns l + x·r
+func foldG2(l, r []bls12377.G2Affine, x *fr.Element) []bls12377.G2Affine {
+	var bx big.Int
+	x.BigInt(&bx)
+	res := make([]bls12377.G2Jac, len(l))
+	parallel.Execute(len(l), func(start, end int) {
+		for i := start; i < end; i++ {
+			res[i].FromAffine(&r[i])
+			res[i].ScalarMultiplication(&res[i], &bx).AddMixed(&l[i])
+		}
+	})
+	return bls12377.BatchJacobianToAffineG2(res)
+}
+
+// newAggregatedProofsTranscript returns the transcript of the challenges r, x₀, ..., x_{nbRounds-1}
+func newAggregatedProofsTranscript(hf hash.Hash, nbRounds int) *fiatshamir.Transcript {
+	names := make([]string, nbRounds+1)
+	names[0] = "r"
+	for j := 0; j < nbRounds; j++ {
+		names[j+1] = "x" + strconv.Itoa(j)
+	}
+	return fiatshamir.NewTranscript(hf, names...)
+}
+
+// deriveAggregatedProofsChallenge derives r, bound to the openings and to the commitment t to
+// the proofs
+func deriveAggregatedProofsChallenge(fs *fiatshamir.Transcript, digests []Digest, points, claimedValues []fr.Element, t *bls12377.GT, dataTranscript [][]byte) (fr.Element, error) {
+	if err := fs.BindLength("r", len(digests)); err != nil {
+		return fr.Element{}, err
+	}
+	for i := range digests {
+		for _, b := range [][]byte{digests[i].Marshal(), points[i].Marshal(), claimedValues[i].Marshal()} {
+			if err := fs.Bind("r", b); err != nil {
+				return fr.Element{}, err
+			}
+		}
+	}
+	for i := range dataTranscript {
+		if err := fs.BindBytes("r", dataTranscript[i]); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	if err := bls12377.BindGT(fs, "r", t); err != nil {
+		return fr.Element{}, err
+	}
+	return deriveAggregationChallenge(fs, "r")
+}
+
+// deriveAggregatedProofsRound derives the challenge of round j, bound to the inner products A
+// and B (first round) and to the cross terms of the round
+func deriveAggregatedProofsRound(fs *fiatshamir.Transcript, j int, proof *AggregatedProofs) (fr.Element, error) {
+	name := "x" + strconv.Itoa(j)
+	if j == 0 {
+		if err := fs.Bind(name, proof.A.Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+		if err := fs.Bind(name, proof.B.Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	for _, t := range []*bls12377.GT{&proof.LT[j], &proof.RT[j]} {
+		if err := bls12377.BindGT(fs, name, t); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	for _, p := range []*bls12377.G1Affine{&proof.LA[j], &proof.RA[j], &proof.LB[j], &proof.RB[j]} {
+		if err := fs.Bind(name, p.Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	x, err := deriveAggregationChallenge(fs, name)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	if x.IsZero() {
+		// happens with negligible probability
+		return fr.Element{}, ErrVerifyAggregatedProofs
+	}
+	return x, nil
+}
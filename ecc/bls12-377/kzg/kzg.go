@@ -17,15 +17,23 @@
 package kzg
 
 import (
+	"bytes"
+	"encoding/binary"
 	"errors"
 	"hash"
+	"io"
+	"math"
 	"math/big"
+	"runtime"
 	"sync"
 
 	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr/fft"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr/iop"
 	"github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/internal/insecure"
 
 	"github.com/consensys/gnark-crypto/internal/parallel"
 )
@@ -37,14 +45,27 @@ var (
 	ErrVerifyOpeningProof            = errors.New("can't verify opening proof")
 	ErrVerifyBatchOpeningSinglePoint = errors.New("can't verify batch opening proof at single point")
 	ErrMinSRSSize                    = errors.New("minimum srs size is 2")
+	ErrSRSExtensionSize              = errors.New("srs extension must be larger than the current srs")
+	ErrInvalidSRSExtension           = errors.New("srs extension is not consistent with the current srs")
+	ErrSRSSliceRange                 = errors.New("invalid srs slice range")
+	ErrInvalidSRSSlice               = errors.New("srs slice is not consistent with the verifying key")
+	ErrInvalidSRSG2                  = errors.New("srs G2 powers are not consistent with the verifying key")
+	ErrInsecureSRS                   = errors.New("insecure srs is only available in tests or with the " + insecure.BuildTag + " build tag")
+	ErrInvalidNbClaimedValues        = errors.New("number of claimed values is not the same as the number of polynomials")
 )
 
 // Digest commitment of a polynomial.
 type Digest = bls12377.G1Affine
 
 // ProvingKey used to create or open commitments
+//
+// G2 holds the powers [αⁱ]G₂ needed by some protocols (degree checks, Marlin-style
+// commitments, bilinear accumulators...). It is empty unless requested with WithG2Powers,
+// or imported from a ceremony with more than two G₂ powers (see Contribution.SRS), and it
+// is serialized along with the G₁ powers when not empty.
 type ProvingKey struct {
 	G1 []bls12377.G1Affine // [G₁ [α]G₁ , [α²]G₁, ... ]
+	G2 []bls12377.G2Affine // optional, [G₂ [α]G₂ , [α²]G₂, ... ]
 }
 
 // VerifyingKey used to verify opening proofs
@@ -73,20 +94,100 @@ func eval(p []fr.Element, point fr.Element) fr.Element {
 	return res
 }
 
+// insecureSRSDst is the domain separation tag used to derive α in NewSRSInsecure
+const insecureSRSDst = "gnark-crypto/kzg/insecure-srs"
+
+// NewSRSInsecure returns a SRS whose α is derived from seed, so that it is
+// reproducible across runs. Anyone knowing the seed knows α and can forge
+// proofs: it is meant for tests and benchmarks only.
+//
+// It returns ErrInsecureSRS unless it runs in a test binary or the binary was
+// built with the insecure_srs tag.
+func NewSRSInsecure(size uint64, seed []byte, opts ...SRSOption) (*SRS, error) {
+	if !insecure.Allowed() {
+		return nil, ErrInsecureSRS
+	}
+	alpha, err := insecureAlpha(seed)
+	if err != nil {
+		return nil, err
+	}
+	var bAlpha big.Int
+	return NewSRS(size, alpha.BigInt(&bAlpha), opts...)
+}
+
+// insecureAlpha returns the α of the SRS generated by NewSRSInsecure from seed
+func insecureAlpha(seed []byte) (fr.Element, error) {
+	alpha, err := fr.Hash(seed, []byte(insecureSRSDst), 1)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	return alpha[0], nil
+}
+
+// SRSOption configures NewSRS and NewSRSInsecure
+type SRSOption func(*srsConfig)
+
+type srsConfig struct {
+	nbG2     uint64
+	progress func(done, total uint64)
+}
+
+// WithG2Powers makes NewSRS also compute the powers [αⁱ]G₂ for 0 ≤ i < n, in
+// srs.Pk.G2, for the schemes which need more than the two points of the verifying key.
+func WithG2Powers(n uint64) SRSOption {
+	return func(cfg *srsConfig) {
+		cfg.nbG2 = n
+	}
+}
+
+// WithProgress makes NewSRS call f as the points are computed, with the number of
+// points (G₁ and G₂) done so far and the total. The calls are sequential, and the
+// last one has done == total.
+func WithProgress(f func(done, total uint64)) SRSOption {
+	return func(cfg *srsConfig) {
+		cfg.progress = f
+	}
+}
+
+// srsChunkSize is the number of points computed between two calls to the
+// progress callback of NewSRS
+const srsChunkSize = 1 << 16
+
 // NewSRS returns a new SRS using alpha as randomness source
 //
-// In production, a SRS generated through MPC should be used.
+// In production, a SRS generated through MPC should be used. In tests, use
+// NewSRSInsecure.
 //
 // Set Alpha = -1 to generate quickly a balanced, valid SRS (useful for benchmarking).
 //
+// The powers of α are computed in parallel, each task starting from an exponentiation,
+// and the points by chunks of batch scalar multiplications, between which the
+// WithProgress callback is called.
+//
 // implements io.ReaderFrom and io.WriterTo
-func NewSRS(size uint64, bAlpha *big.Int) (*SRS, error) {
+func NewSRS(size uint64, bAlpha *big.Int, opts ...SRSOption) (*SRS, error) {
 
 	if size < 2 {
 		return nil, ErrMinSRSSize
 	}
+	var cfg srsConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	total := size + cfg.nbG2
+	var done uint64
+	report := func(n uint64) {
+		done += n
+		if cfg.progress != nil {
+			cfg.progress(done, total)
+		}
+	}
+
 	var srs SRS
 	srs.Pk.G1 = make([]bls12377.G1Affine, size)
+	if cfg.nbG2 != 0 {
+		srs.Pk.G2 = make([]bls12377.G2Affine, cfg.nbG2)
+	}
 
 	var alpha fr.Element
 	alpha.SetBigInt(bAlpha)
@@ -109,20 +210,27 @@ func NewSRS(size uint64, bAlpha *big.Int) (*SRS, error) {
 		t.BigInt(&bt)
 
 		var g [4]bls12377.G1Affine
+		var h [4]bls12377.G2Affine
 		g[0] = gen1Aff
+		h[0] = gen2Aff
 		for i := 1; i < 4; i++ {
 			g[i].ScalarMultiplication(&g[i-1], &bt)
+			h[i].ScalarMultiplication(&h[i-1], &bt)
 		}
 		parallel.Execute(int(size), func(start, end int) {
 			for i := start; i < int(end); i++ {
 				srs.Pk.G1[i] = g[i%4]
 			}
 		})
+		for i := range srs.Pk.G2 {
+			srs.Pk.G2[i] = h[i%4]
+		}
 		srs.Vk.G1 = gen1Aff
 		srs.Vk.G2[0] = gen2Aff
-		srs.Vk.G2[1].ScalarMultiplication(&srs.Vk.G2[0], &bt)
+		srs.Vk.G2[1] = h[1]
 		srs.Vk.Lines[0] = bls12377.PrecomputeLines(srs.Vk.G2[0])
 		srs.Vk.Lines[1] = bls12377.PrecomputeLines(srs.Vk.G2[1])
+		report(total)
 		return &srs, nil
 	}
 	srs.Pk.G1[0] = gen1Aff
@@ -131,18 +239,250 @@ func NewSRS(size uint64, bAlpha *big.Int) (*SRS, error) {
 	srs.Vk.G2[1].ScalarMultiplication(&gen2Aff, bAlpha)
 	srs.Vk.Lines[0] = bls12377.PrecomputeLines(srs.Vk.G2[0])
 	srs.Vk.Lines[1] = bls12377.PrecomputeLines(srs.Vk.G2[1])
+	report(1)
 
-	alphas := make([]fr.Element, size-1)
-	alphas[0] = alpha
-	for i := 1; i < len(alphas); i++ {
-		alphas[i].Mul(&alphas[i-1], &alpha)
+	// alphas[i] = αⁱ⁺¹
+	nbAlphas := size - 1
+	if cfg.nbG2 > size {
+		nbAlphas = cfg.nbG2 - 1
+	}
+	alphas := powersOfAlpha(alpha, nbAlphas)
+
+	for start := uint64(1); start < size; start += srsChunkSize {
+		end := start + srsChunkSize
+		if end > size {
+			end = size
+		}
+		g1s := bls12377.BatchScalarMultiplicationG1(&gen1Aff, alphas[start-1:end-1])
+		copy(srs.Pk.G1[start:end], g1s)
+		report(end - start)
+	}
+
+	if cfg.nbG2 != 0 {
+		srs.Pk.G2[0] = gen2Aff
+		report(1)
+		for start := uint64(1); start < cfg.nbG2; start += srsChunkSize {
+			end := start + srsChunkSize
+			if end > cfg.nbG2 {
+				end = cfg.nbG2
+			}
+			g2s := bls12377.BatchScalarMultiplicationG2(&gen2Aff, alphas[start-1:end-1])
+			copy(srs.Pk.G2[start:end], g2s)
+			report(end - start)
+		}
 	}
-	g1s := bls12377.BatchScalarMultiplicationG1(&gen1Aff, alphas)
-	copy(srs.Pk.G1[1:], g1s)
 
 	return &srs, nil
 }
 
+// powersOfAlpha returns [α, α², …, αⁿ]. The range is split between the tasks, each
+// of which starts from an exponentiation.
+func powersOfAlpha(alpha fr.Element, n uint64) []fr.Element {
+	alphas := make([]fr.Element, n)
+	parallel.Execute(int(n), func(start, end int) {
+		if start >= end {
+			return
+		}
+		alphas[start].Exp(alpha, new(big.Int).SetUint64(uint64(start)+1))
+		for i := start + 1; i < end; i++ {
+			alphas[i].Mul(&alphas[i-1], &alpha)
+		}
+	})
+	return alphas
+}
+
+// checkG2Powers returns ErrInvalidSRSG2 if the optional powers srs.Pk.G2 don't start with
+// the two points of the verifying key
+func (srs *SRS) checkG2Powers() error {
+	n := len(srs.Pk.G2)
+	if n == 0 {
+		return nil
+	}
+	if !srs.Pk.G2[0].Equal(&srs.Vk.G2[0]) || (n > 1 && !srs.Pk.G2[1].Equal(&srs.Vk.G2[1])) {
+		return ErrInvalidSRSG2
+	}
+	return nil
+}
+
+// Extend grows srs.Pk.G1 to newSize points, reading the missing powers
+// [αⁱ]G₁, len(srs.Pk.G1) ≤ i < newSize, from contribution. contribution is typically
+// a ceremony transcript positioned right after the powers already in srs; the points
+// are concatenated, compressed or not, without a length prefix.
+//
+// The powers already in srs are neither re-read nor re-validated. The new points are
+// subgroup checked, and a randomized pairing check ensures they extend the current
+// powers: e(∑ rᵢ[αⁱ]G₁, [α]G₂) = e(∑ rᵢ[αⁱ⁺¹]G₁, G₂) for len(srs.Pk.G1)-1 ≤ i < newSize-1.
+//
+// srs is left unchanged if an error is returned.
+func (srs *SRS) Extend(newSize uint64, contribution io.Reader) error {
+	size := uint64(len(srs.Pk.G1))
+	if size < 2 {
+		return ErrMinSRSSize
+	}
+	if newSize <= size || newSize-size > math.MaxUint32 {
+		return ErrSRSExtensionSize
+	}
+
+	// the points are not length-prefixed; prepend the length so that the decoder reads
+	// them as a slice, in parallel, and defers the subgroup checks to a single pass.
+	var bLen [4]byte
+	binary.BigEndian.PutUint32(bLen[:], uint32(newSize-size))
+	dec := bls12377.NewDecoder(io.MultiReader(bytes.NewReader(bLen[:]), contribution), bls12377.BatchSubgroupChecks())
+	var powers []bls12377.G1Affine
+	if err := dec.Decode(&powers); err != nil {
+		return err
+	}
+	if err := dec.CheckSubGroups(); err != nil {
+		return err
+	}
+
+	// link the new powers to the last known one
+	if err := checkPowers(append([]bls12377.G1Affine{srs.Pk.G1[size-1]}, powers...), &srs.Vk); err != nil {
+		return err
+	}
+
+	if uint64(cap(srs.Pk.G1)) >= newSize {
+		srs.Pk.G1 = srs.Pk.G1[:newSize]
+	} else {
+		g1 := make([]bls12377.G1Affine, newSize)
+		copy(g1, srs.Pk.G1)
+		srs.Pk.G1 = g1
+	}
+	copy(srs.Pk.G1[size:], powers)
+
+	return nil
+}
+
+// checkPowers returns an error if powers is not of the form [xαⁱ]G₁, 0 ≤ i < len(powers)
+// for some x, where [α]G₂ = vk.G2[1].
+func checkPowers(powers []bls12377.G1Affine, vk *VerifyingKey) error {
+	n := len(powers) - 1
+	r := make([]fr.Element, n)
+	for i := range r {
+		if _, err := r[i].SetRandom(); err != nil {
+			return err
+		}
+	}
+
+	// ∑ rᵢ[xαⁱ]G₁ and ∑ rᵢ[xαⁱ⁺¹]G₁
+	var left, right bls12377.G1Affine
+	config := ecc.MultiExpConfig{}
+	if _, err := left.MultiExp(powers[:n], r, config); err != nil {
+		return err
+	}
+	if _, err := right.MultiExp(powers[1:], r, config); err != nil {
+		return err
+	}
+	right.Neg(&right)
+
+	ok, err := bls12377.PairingCheck(
+		[]bls12377.G1Affine{left, right},
+		[]bls12377.G2Affine{vk.G2[1], vk.G2[0]},
+	)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrInvalidSRSExtension
+	}
+	return nil
+}
+
+// SRSSlice is a contiguous subset [αⁱ]G₁, From ≤ i < From+len(G1), of the powers of an
+// SRS, along with the data needed to check it against the VerifyingKey alone.
+//
+// A slice lets a prover download only the powers it needs: committing to the
+// coefficients p[From:From+len(G1)] with ProvingKey{G1: slice.G1} yields the share of
+// the commitment of p corresponding to these coefficients.
+//
+// implements io.ReaderFrom and io.WriterTo
+type SRSSlice struct {
+	From uint64
+	G1   []bls12377.G1Affine
+
+	// Proof = ∑_{i<From}[αⁱ]G₁ = [(α^From-1)/(α-1)]G₁ links G1[0] to the generator
+	Proof bls12377.G1Affine
+}
+
+// Slice returns the powers [αⁱ]G₁, from ≤ i < to, of srs along with a consistency
+// proof, so that a party holding only srs.Vk can check them (see SRSSlice.Verify).
+//
+// The returned slice shares its points with srs.
+func (srs *SRS) Slice(from, to uint64) (SRSSlice, error) {
+	if from >= to || to > uint64(len(srs.Pk.G1)) {
+		return SRSSlice{}, ErrSRSSliceRange
+	}
+
+	res := SRSSlice{
+		From: from,
+		G1:   srs.Pk.G1[from:to],
+	}
+
+	// Proof = ∑_{i<from}[αⁱ]G₁, partial sums are computed in parallel
+	var proof bls12377.G1Jac
+	var lock sync.Mutex
+	parallel.Execute(int(from), func(start, end int) {
+		var acc bls12377.G1Jac
+		for i := start; i < end; i++ {
+			acc.AddMixed(&srs.Pk.G1[i])
+		}
+		lock.Lock()
+		proof.AddAssign(&acc)
+		lock.Unlock()
+	})
+	res.Proof.FromJacobian(&proof)
+
+	return res, nil
+}
+
+// Verify checks that slice holds consecutive powers of the α of vk, starting at
+// [α^From]G₁. It performs two pairing checks:
+//   - e(∑ rᵢ[α^(From+i)]G₁, [α]G₂) = e(∑ rᵢ[α^(From+i+1)]G₁, G₂) for random rᵢ, i.e. the
+//     points are consecutive powers of α;
+//   - e(G1[0] - G₁ + Proof, G₂) = e(Proof, [α]G₂), i.e. G1[0] - G₁ = (α-1)·Proof.
+//
+// The points are assumed to be in the correct subgroup (as ensured by ReadFrom).
+func (slice *SRSSlice) Verify(vk *VerifyingKey) error {
+	if len(slice.G1) == 0 {
+		return ErrSRSSliceRange
+	}
+	if slice.From == 0 && !slice.Proof.IsInfinity() {
+		return ErrInvalidSRSSlice
+	}
+
+	if len(slice.G1) > 1 {
+		if err := checkPowers(slice.G1, vk); err != nil {
+			if err == ErrInvalidSRSExtension {
+				return ErrInvalidSRSSlice
+			}
+			return err
+		}
+	}
+
+	var anchor, proof bls12377.G1Jac
+	anchor.FromAffine(&vk.G1)
+	anchor.Neg(&anchor).AddMixed(&slice.G1[0]).AddMixed(&slice.Proof)
+	proof.FromAffine(&slice.Proof)
+	proof.Neg(&proof)
+	var anchorAff, proofAff bls12377.G1Affine
+	anchorAff.FromJacobian(&anchor)
+	proofAff.FromJacobian(&proof)
+
+	// the Miller loop scales the lines in place, work on a copy
+	lines := vk.Lines
+	ok, err := bls12377.PairingCheckFixedQ(
+		[]bls12377.G1Affine{anchorAff, proofAff},
+		lines[:],
+	)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrInvalidSRSSlice
+	}
+	return nil
+}
+
 // OpeningProof KZG proof for opening at a single point.
 //
 // implements io.ReaderFrom and io.WriterTo
@@ -168,34 +508,90 @@ type BatchOpeningProof struct {
 // Commit commits to a polynomial using a multi exponentiation with the SRS.
 // It is assumed that the polynomial is in canonical form, in Montgomery form.
 func Commit(p []fr.Element, pk ProvingKey, nbTasks ...int) (Digest, error) {
+	resJac, err := CommitJac(p, pk, nbTasks...)
+	if err != nil {
+		return Digest{}, err
+	}
+	var res Digest
+	res.FromJacobian(&resJac)
+	return res, nil
+}
+
+// CommitJac is Commit, returning the commitment in Jacobian coordinates. It saves the
+// final conversion to affine coordinates (a field inversion) when the caller combines
+// the commitment with other points right away.
+func CommitJac(p []fr.Element, pk ProvingKey, nbTasks ...int) (bls12377.G1Jac, error) {
+	defer ecc.StartRegion(ecc.BLS12_377, ecc.OpCommit, len(p)).End()
 
 	if len(p) == 0 || len(p) > len(pk.G1) {
-		return Digest{}, ErrInvalidPolynomialSize
+		return bls12377.G1Jac{}, ErrInvalidPolynomialSize
 	}
 
-	var res bls12377.G1Affine
+	var res bls12377.G1Jac
 
 	config := ecc.MultiExpConfig{}
 	if len(nbTasks) > 0 {
 		config.NbTasks = nbTasks[0]
 	}
 	if _, err := res.MultiExp(pk.G1[:len(p)], p, config); err != nil {
-		return Digest{}, err
+		return bls12377.G1Jac{}, err
 	}
 
 	return res, nil
 }
 
+// CommitPolynomial commits to p, in any form, using a multi exponentiation with the SRS.
+// p is not modified. The shift of p is ignored: the commitment is to the underlying polynomial.
+//
+// Polynomials in canonical form are committed without any conversion (up to a copy of the
+// coefficients in bit-reversed layout). Polynomials in Lagrange or Lagrange coset form are
+// converted to canonical form on a copy, using domain if provided, or a domain of the
+// size of p otherwise (with the default coset shift).
+func CommitPolynomial(p *iop.Polynomial, pk ProvingKey, domain *fft.Domain, nbTasks ...int) (Digest, error) {
+	coeffs := p.Coefficients()
+
+	if p.Basis != iop.Canonical {
+		if domain == nil {
+			domain = fft.NewDomain(uint64(len(coeffs)))
+		}
+		// ToCanonical grows the polynomial to the domain size if needed
+		p = p.Clone(int(domain.Cardinality))
+		p.ToCanonical(domain, nbTasks...)
+		coeffs = p.Coefficients()
+		if p.Layout == iop.BitReverse {
+			fft.BitReverse(coeffs)
+		}
+	} else if p.Layout == iop.BitReverse {
+		coeffs = make([]fr.Element, len(coeffs))
+		copy(coeffs, p.Coefficients())
+		fft.BitReverse(coeffs)
+	}
+
+	return Commit(coeffs, pk, nbTasks...)
+}
+
 // Open computes an opening proof of polynomial p at given point.
 // fft.Domain Cardinality must be larger than p.Degree()
 func Open(p []fr.Element, point fr.Element, pk ProvingKey) (OpeningProof, error) {
 	if len(p) == 0 || len(p) > len(pk.G1) {
 		return OpeningProof{}, ErrInvalidPolynomialSize
 	}
+	return OpenWithValue(p, point, eval(p, point), pk)
+}
+
+// OpenWithValue is Open, where claimedValue = p(point) has already been computed
+// by the caller (e.g. while evaluating the constraints of a proof system), which saves
+// an evaluation of p.
+//
+// claimedValue is not checked: if it is not p(point), the proof won't verify.
+func OpenWithValue(p []fr.Element, point, claimedValue fr.Element, pk ProvingKey) (OpeningProof, error) {
+	if len(p) == 0 || len(p) > len(pk.G1) {
+		return OpeningProof{}, ErrInvalidPolynomialSize
+	}
 
 	// build the proof
 	res := OpeningProof{
-		ClaimedValue: eval(p, point),
+		ClaimedValue: claimedValue,
 	}
 
 	// compute H
@@ -215,32 +611,35 @@ func Open(p []fr.Element, point fr.Element, pk ProvingKey) (OpeningProof, error)
 }
 
 // Verify verifies a KZG opening proof at a single point
+//
+// When vk.G1 is the generator of G₁ (as in the SRS built by NewSRS or by a ceremony),
+// [f(a)]G₁ is computed with the precomputed table of ScalarMultiplicationBase.
 func Verify(commitment *Digest, proof *OpeningProof, point fr.Element, vk VerifyingKey) error {
 
 	// [f(a)]G₁
-	var claimedValueG1Aff bls12377.G1Jac
+	var claimedValueG1 bls12377.G1Jac
 	var claimedValueBigInt big.Int
 	proof.ClaimedValue.BigInt(&claimedValueBigInt)
-	claimedValueG1Aff.ScalarMultiplicationAffine(&vk.G1, &claimedValueBigInt)
-
-	// [f(α) - f(a)]G₁
-	var fminusfaG1Jac bls12377.G1Jac
-	fminusfaG1Jac.FromAffine(commitment)
-	fminusfaG1Jac.SubAssign(&claimedValueG1Aff)
-
-	// [-H(α)]G₁
-	var negH bls12377.G1Affine
-	negH.Neg(&proof.H)
+	if _, _, g1, _ := bls12377.Generators(); vk.G1.Equal(&g1) {
+		claimedValueG1.ScalarMultiplicationBase(&claimedValueBigInt)
+	} else {
+		claimedValueG1.ScalarMultiplicationAffine(&vk.G1, &claimedValueBigInt)
+	}
 
 	// [f(α) - f(a) + a*H(α)]G₁
 	var totalG1 bls12377.G1Jac
 	var pointBigInt big.Int
 	point.BigInt(&pointBigInt)
 	totalG1.ScalarMultiplicationAffine(&proof.H, &pointBigInt)
-	totalG1.AddAssign(&fminusfaG1Jac)
+	totalG1.SubAssign(&claimedValueG1)
+	totalG1.AddMixed(commitment)
 	var totalG1Aff bls12377.G1Affine
 	totalG1Aff.FromJacobian(&totalG1)
 
+	// [-H(α)]G₁
+	var negH bls12377.G1Affine
+	negH.Neg(&proof.H)
+
 	// e([f(α)-f(a)+aH(α)]G₁], G₂).e([-H(α)]G₁, [α]G₂) == 1
 	check, err := bls12377.PairingCheckFixedQ(
 		[]bls12377.G1Affine{totalG1Aff, negH},
@@ -265,38 +664,65 @@ func Verify(commitment *Digest, proof *OpeningProof, point fr.Element, vk Verify
 func BatchOpenSinglePoint(polynomials [][]fr.Element, digests []Digest, point fr.Element, hf hash.Hash, pk ProvingKey, dataTranscript ...[]byte) (BatchOpeningProof, error) {
 
 	// check for invalid sizes
-	nbDigests := len(digests)
-	if nbDigests != len(polynomials) {
+	if len(digests) != len(polynomials) {
 		return BatchOpeningProof{}, ErrInvalidNbDigests
 	}
-
-	// TODO ensure the polynomials are of the same size
-	largestPoly := -1
 	for _, p := range polynomials {
 		if len(p) == 0 || len(p) > len(pk.G1) {
 			return BatchOpeningProof{}, ErrInvalidPolynomialSize
 		}
-		if len(p) > largestPoly {
-			largestPoly = len(p)
-		}
 	}
 
-	var res BatchOpeningProof
-
 	// compute the purported values
-	res.ClaimedValues = make([]fr.Element, len(polynomials))
+	claimedValues := make([]fr.Element, len(polynomials))
 	var wg sync.WaitGroup
 	wg.Add(len(polynomials))
 	for i := 0; i < len(polynomials); i++ {
 		go func(_i int) {
-			res.ClaimedValues[_i] = eval(polynomials[_i], point)
+			claimedValues[_i] = eval(polynomials[_i], point)
 			wg.Done()
 		}(i)
 	}
 
-	// wait for polynomial evaluations to be completed (res.ClaimedValues)
+	// wait for polynomial evaluations to be completed (claimedValues)
 	wg.Wait()
 
+	return BatchOpenSinglePointWithValues(polynomials, digests, claimedValues, point, hf, pk, dataTranscript...)
+}
+
+// BatchOpenSinglePointWithValues is BatchOpenSinglePoint, where claimedValues[i] = polynomials[i](point)
+// have already been computed by the caller, which saves an evaluation of each polynomial.
+//
+// claimedValues are not checked: if one of them is wrong, the proof won't verify.
+// The proof holds a copy of claimedValues.
+func BatchOpenSinglePointWithValues(polynomials [][]fr.Element, digests []Digest, claimedValues []fr.Element, point fr.Element, hf hash.Hash, pk ProvingKey, dataTranscript ...[]byte) (BatchOpeningProof, error) {
+
+	// check for invalid sizes
+	nbDigests := len(digests)
+	if nbDigests != len(polynomials) {
+		return BatchOpeningProof{}, ErrInvalidNbDigests
+	}
+	if len(claimedValues) != len(polynomials) {
+		return BatchOpeningProof{}, ErrInvalidNbClaimedValues
+	}
+
+	// TODO ensure the polynomials are of the same size
+	largestPoly := -1
+	for _, p := range polynomials {
+		if len(p) == 0 || len(p) > len(pk.G1) {
+			return BatchOpeningProof{}, ErrInvalidPolynomialSize
+		}
+		if len(p) > largestPoly {
+			largestPoly = len(p)
+		}
+	}
+
+	var res BatchOpeningProof
+
+	// the purported values
+	res.ClaimedValues = make([]fr.Element, len(claimedValues))
+	copy(res.ClaimedValues, claimedValues)
+
 	// derive the challenge γ, binded to the point and the commitments
 	gamma, err := deriveGamma(point, digests, res.ClaimedValues, hf, dataTranscript...)
 	if err != nil {
@@ -316,26 +742,12 @@ func BatchOpenSinglePoint(polynomials [][]fr.Element, digests []Digest, point fr
 	}()
 
 	// compute ∑ᵢγⁱfᵢ
-	// note: if we are willing to parallelize that, we could clone the poly and scale them by
-	// gamma n in parallel, before reducing into foldedPolynomials
-	foldedPolynomials := make([]fr.Element, largestPoly)
-	copy(foldedPolynomials, polynomials[0])
 	gammas := make([]fr.Element, len(polynomials))
-	gammas[0] = gamma
+	gammas[0].SetOne()
 	for i := 1; i < len(polynomials); i++ {
 		gammas[i].Mul(&gammas[i-1], &gamma)
 	}
-
-	for i := 1; i < len(polynomials); i++ {
-		i := i
-		parallel.Execute(len(polynomials[i]), func(start, end int) {
-			var pj fr.Element
-			for j := start; j < end; j++ {
-				pj.Mul(&polynomials[i][j], &gammas[i-1])
-				foldedPolynomials[j].Add(&foldedPolynomials[j], &pj)
-			}
-		})
-	}
+	foldedPolynomials := linearCombination(polynomials, gammas, largestPoly)
 
 	// compute H
 	<-chSumGammai
@@ -350,6 +762,51 @@ func BatchOpenSinglePoint(polynomials [][]fr.Element, digests []Digest, point fr
 	return res, nil
 }
 
+// linearCombinationChunkSize is the number of coefficients of the result a worker
+// accumulates all the polynomials into before moving on, small enough for the chunk
+// to stay in cache.
+const linearCombinationChunkSize = 1024
+
+// linearCombination returns ∑ᵢcᵢpᵢ, of size size ≥ len(pᵢ).
+//
+// The coefficients range is split among the workers, each of which owns its part of
+// the result: a worker scales and accumulates all the polynomials chunk by chunk, so
+// that no synchronization nor reduction of partial results is needed.
+func linearCombination(polynomials [][]fr.Element, c []fr.Element, size int) []fr.Element {
+	res := make([]fr.Element, size)
+	nbChunks := (size + linearCombinationChunkSize - 1) / linearCombinationChunkSize
+	parallel.Execute(nbChunks, func(start, end int) {
+		var tmp fr.Element
+		for chunk := start; chunk < end; chunk++ {
+			from := chunk * linearCombinationChunkSize
+			to := from + linearCombinationChunkSize
+			if to > size {
+				to = size
+			}
+			for i, p := range polynomials {
+				if from >= len(p) {
+					continue
+				}
+				pTo := to
+				if pTo > len(p) {
+					pTo = len(p)
+				}
+				if c[i].IsOne() {
+					for j := from; j < pTo; j++ {
+						res[j].Add(&res[j], &p[j])
+					}
+					continue
+				}
+				for j := from; j < pTo; j++ {
+					tmp.Mul(&p[j], &c[i])
+					res[j].Add(&res[j], &tmp)
+				}
+			}
+		}
+	})
+	return res
+}
+
 // FoldProof fold the digests and the proofs in batchOpeningProof using Fiat Shamir
 // to obtain an opening proof at a single point.
 //
@@ -415,13 +872,45 @@ func BatchVerifySinglePoint(digests []Digest, batchOpeningProof *BatchOpeningPro
 
 }
 
+// BatchVerifyOption configures how BatchVerifyMultiPoints samples the coefficients λᵢ
+// of the random linear combination of the proofs.
+type BatchVerifyOption func(*batchVerifyConfig)
+
+type batchVerifyConfig struct {
+	randomness     io.Reader
+	hf             hash.Hash
+	dataTranscript [][]byte
+}
+
+// WithRandomness samples the coefficients λᵢ from r instead of the package-level source of
+// randomness (see random.SetReader), e.g. a seeded stream to make the verification reproducible. r must be unpredictable to the prover.
+func WithRandomness(r io.Reader) BatchVerifyOption {
+	return func(c *batchVerifyConfig) {
+		c.randomness = r
+		c.hf = nil
+	}
+}
+
+// WithTranscript derives the coefficients λᵢ = λⁱ with Fiat Shamir, where λ is bound to the
+// points, the digests, the proofs and dataTranscript. The verification is then deterministic
+// (e.g. for consensus), and can be replayed from the public data alone.
+func WithTranscript(hf hash.Hash, dataTranscript ...[]byte) BatchVerifyOption {
+	return func(c *batchVerifyConfig) {
+		c.hf = hf
+		c.dataTranscript = dataTranscript
+		c.randomness = nil
+	}
+}
+
 // BatchVerifyMultiPoints batch verifies a list of opening proofs at different points.
 // The purpose of the batching is to have only one pairing for verifying several proofs.
 //
 // * digests list of committed polynomials
 // * proofs list of opening proofs, one for each digest
 // * points the list of points at which the opening are done
-func BatchVerifyMultiPoints(digests []Digest, proofs []OpeningProof, points []fr.Element, vk VerifyingKey) error {
+// * opts set how the folding coefficients are sampled (random.Reader by default, see
+// WithRandomness and WithTranscript); if several are given, the last one wins.
+func BatchVerifyMultiPoints(digests []Digest, proofs []OpeningProof, points []fr.Element, vk VerifyingKey, opts ...BatchVerifyOption) error {
 
 	// check consistency nb proogs vs nb digests
 	if len(digests) != len(proofs) || len(digests) != len(points) {
@@ -439,80 +928,74 @@ func BatchVerifyMultiPoints(digests []Digest, proofs []OpeningProof, points []fr
 	}
 
 	// sample random numbers λᵢ for sampling
-	randomNumbers := make([]fr.Element, len(digests))
-	randomNumbers[0].SetOne()
-	for i := 1; i < len(randomNumbers); i++ {
-		_, err := randomNumbers[i].SetRandom()
-		if err != nil {
-			return err
-		}
-	}
-
-	// fold the committed quotients compute ∑ᵢλᵢ[Hᵢ(α)]G₁
-	var foldedQuotients bls12377.G1Affine
-	quotients := make([]bls12377.G1Affine, len(proofs))
-	for i := 0; i < len(randomNumbers); i++ {
-		quotients[i].Set(&proofs[i].H)
+	var cfg batchVerifyConfig
+	for _, opt := range opts {
+		opt(&cfg)
 	}
-	config := ecc.MultiExpConfig{}
-	if _, err := foldedQuotients.MultiExp(quotients, randomNumbers, config); err != nil {
-		return err
-	}
-
-	// fold digests and evals
-	evals := make([]fr.Element, len(digests))
-	for i := 0; i < len(randomNumbers); i++ {
-		evals[i].Set(&proofs[i].ClaimedValue)
-	}
-
-	// fold the digests: ∑ᵢλᵢ[f_i(α)]G₁
-	// fold the evals  : ∑ᵢλᵢfᵢ(aᵢ)
-	foldedDigests, foldedEvals, err := fold(digests, evals, randomNumbers)
+	randomNumbers, err := sampleFoldingCoefficients(&cfg, digests, proofs, points)
 	if err != nil {
 		return err
 	}
 
-	// compute commitment to folded Eval  [∑ᵢλᵢfᵢ(aᵢ)]G₁
-	var foldedEvalsCommit bls12377.G1Affine
-	var foldedEvalsBigInt big.Int
-	foldedEvals.BigInt(&foldedEvalsBigInt)
-	foldedEvalsCommit.ScalarMultiplication(&vk.G1, &foldedEvalsBigInt)
-
-	// compute foldedDigests = ∑ᵢλᵢ[fᵢ(α)]G₁ - [∑ᵢλᵢfᵢ(aᵢ)]G₁
-	foldedDigests.Sub(&foldedDigests, &foldedEvalsCommit)
-
-	// combien the points and the quotients using γᵢ
-	// ∑ᵢλᵢ[p_i]([Hᵢ(α)]G₁)
-	var foldedPointsQuotients bls12377.G1Affine
-	for i := 0; i < len(randomNumbers); i++ {
-		randomNumbers[i].Mul(&randomNumbers[i], &points[i])
-	}
-	_, err = foldedPointsQuotients.MultiExp(quotients, randomNumbers, config)
+	// e([∑ᵢλᵢ(fᵢ(α) - fᵢ(pᵢ) + pᵢHᵢ(α))]G₁, G₂).e([-∑ᵢλᵢ[Hᵢ(α)]G₁), [α]G₂) == 1
+	inputs, err := PairingInputs(digests, proofs, points, randomNumbers, vk)
 	if err != nil {
 		return err
 	}
+	return CheckPairingInputs(inputs, vk)
+}
 
-	// ∑ᵢλᵢ[f_i(α)]G₁ - [∑ᵢλᵢfᵢ(aᵢ)]G₁ + ∑ᵢλᵢ[p_i]([Hᵢ(α)]G₁)
-	// = [∑ᵢλᵢf_i(α) - ∑ᵢλᵢfᵢ(aᵢ) + ∑ᵢλᵢpᵢHᵢ(α)]G₁
-	foldedDigests.Add(&foldedDigests, &foldedPointsQuotients)
-
-	// -∑ᵢλᵢ[Qᵢ(α)]G₁
-	foldedQuotients.Neg(&foldedQuotients)
-
-	// pairing check
-	// e([∑ᵢλᵢ(fᵢ(α) - fᵢ(pᵢ) + pᵢHᵢ(α))]G₁, G₂).e([-∑ᵢλᵢ[Hᵢ(α)]G₁), [α]G₂)
-	check, err := bls12377.PairingCheckFixedQ(
-		[]bls12377.G1Affine{foldedDigests, foldedQuotients},
-		vk.Lines[:],
-	)
-	if err != nil {
-		return err
-	}
-	if !check {
-		return ErrVerifyOpeningProof
+// sampleFoldingCoefficients returns the coefficients λᵢ used by BatchVerifyMultiPoints,
+// with λ₀ = 1.
+func sampleFoldingCoefficients(cfg *batchVerifyConfig, digests []Digest, proofs []OpeningProof, points []fr.Element) ([]fr.Element, error) {
+	res := make([]fr.Element, len(digests))
+	res[0].SetOne()
+
+	switch {
+	case cfg.hf != nil:
+		fs := fiatshamir.NewTranscript(cfg.hf, "lambda")
+		for i := range digests {
+			for _, b := range [][]byte{points[i].Marshal(), digests[i].Marshal(), proofs[i].H.Marshal(), proofs[i].ClaimedValue.Marshal()} {
+				if err := fs.Bind("lambda", b); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if err := fs.BindLength("lambda", len(cfg.dataTranscript)); err != nil {
+			return nil, err
+		}
+		for i := range cfg.dataTranscript {
+			if err := fs.BindBytes("lambda", cfg.dataTranscript[i]); err != nil {
+				return nil, err
+			}
+		}
+		lambdaBigInt, err := fs.ComputeChallengeElement("lambda", fr.Modulus())
+		if err != nil {
+			return nil, err
+		}
+		var lambda fr.Element
+		lambda.SetBigInt(lambdaBigInt)
+		for i := 1; i < len(res); i++ {
+			res[i].Mul(&res[i-1], &lambda)
+		}
+	case cfg.randomness != nil:
+		// read 128 more bits than the size of r, to make the bias of the reduction negligible
+		buf := make([]byte, fr.Bytes+16)
+		for i := 1; i < len(res); i++ {
+			if _, err := io.ReadFull(cfg.randomness, buf); err != nil {
+				return nil, err
+			}
+			res[i].SetBytes(buf)
+		}
+	default:
+		for i := 1; i < len(res); i++ {
+			if _, err := res[i].SetRandom(); err != nil {
+				return nil, err
+			}
+		}
 	}
-	return nil
 
+	return res, nil
 }
 
 // fold folds digests and evaluations using the list of factors as random numbers.
@@ -549,34 +1032,44 @@ func fold(di []Digest, fai []fr.Element, ci []fr.Element) (Digest, fr.Element, e
 // deriveGamma derives a challenge using Fiat Shamir to fold proofs.
 func deriveGamma(point fr.Element, digests []Digest, claimedValues []fr.Element, hf hash.Hash, dataTranscript ...[]byte) (fr.Element, error) {
 
-	// derive the challenge gamma, binded to the point and the commitments
+	// derive the challenge gamma, binded to the point and the commitments.
+	// The points and the field elements have fixed size encodings, and the number of digests
+	// and the data transcript are length prefixed, so that the binding is unambiguous.
 	fs := fiatshamir.NewTranscript(hf, "gamma")
 	if err := fs.Bind("gamma", point.Marshal()); err != nil {
 		return fr.Element{}, err
 	}
+	if err := fs.BindLength("gamma", len(digests)); err != nil {
+		return fr.Element{}, err
+	}
 	for i := range digests {
 		if err := fs.Bind("gamma", digests[i].Marshal()); err != nil {
 			return fr.Element{}, err
 		}
 	}
+	if err := fs.BindLength("gamma", len(claimedValues)); err != nil {
+		return fr.Element{}, err
+	}
 	for i := range claimedValues {
 		if err := fs.Bind("gamma", claimedValues[i].Marshal()); err != nil {
 			return fr.Element{}, err
 		}
 	}
-
+	if err := fs.BindLength("gamma", len(dataTranscript)); err != nil {
+		return fr.Element{}, err
+	}
 	for i := 0; i < len(dataTranscript); i++ {
-		if err := fs.Bind("gamma", dataTranscript[i]); err != nil {
+		if err := fs.BindBytes("gamma", dataTranscript[i]); err != nil {
 			return fr.Element{}, err
 		}
 	}
 
-	gammaByte, err := fs.ComputeChallenge("gamma")
+	gammaBigInt, err := fs.ComputeChallengeElement("gamma", fr.Modulus())
 	if err != nil {
 		return fr.Element{}, err
 	}
 	var gamma fr.Element
-	gamma.SetBytes(gammaByte)
+	gamma.SetBigInt(gammaBigInt)
 
 	return gamma, nil
 }
@@ -584,18 +1077,91 @@ func deriveGamma(point fr.Element, digests []Digest, claimedValues []fr.Element,
 // dividePolyByXminusA computes (f-f(a))/(x-a), in canonical basis, in regular form
 // f memory is re-used for the result
 func dividePolyByXminusA(f []fr.Element, fa, a fr.Element) []fr.Element {
+	return dividePolyByXminusAParallel(f, fa, a, runtime.NumCPU())
+}
+
+// dividePolyByXminusAParallel is dividePolyByXminusA, using at most nbTasks go routines
+func dividePolyByXminusAParallel(f []fr.Element, fa, a fr.Element, nbTasks int) []fr.Element {
 
 	// first we compute f-f(a)
 	f[0].Sub(&f[0], &fa)
 
-	// now we use synthetic division to divide by x-a
-	var t fr.Element
-	for i := len(f) - 2; i >= 0; i-- {
-		t.Mul(&f[i+1], &a)
+	// now we use synthetic division to divide by x-a:
+	// f[i] ← f[i] + a⋅f[i+1], from the highest degree down to 0.
+	//
+	// this is a linear recurrence, which we parallelize by splitting f in blocks [start, end):
+	// 	1. each block runs the recurrence as if f[end] was 0 → f[i] = gᵢ
+	// 	2. the actual values at the bottom of the blocks are propagated, from the top block down:
+	// 	   f[start] = g_start + a^{end-start}⋅f[end]
+	// 	3. each block adds the contribution of the block above: f[i] = gᵢ + a^{end-i}⋅f[end]
+	nbBlocks := nbTasks
+	if nbBlocks < 1 || len(f) < minBlockSizeDivision*2 {
+		nbBlocks = 1
+	} else if nbBlocks > len(f)/minBlockSizeDivision {
+		nbBlocks = len(f) / minBlockSizeDivision
+	}
+	blockStart := func(b int) int { return b * len(f) / nbBlocks }
+
+	// aPows[b] = a^{size of block b}
+	aPows := make([]fr.Element, nbBlocks)
+
+	// 1. local recurrences
+	var wg sync.WaitGroup
+	wg.Add(nbBlocks)
+	for b := 0; b < nbBlocks; b++ {
+		go func(b int) {
+			defer wg.Done()
+			start, end := blockStart(b), blockStart(b+1)
+			var t fr.Element
+			for i := end - 2; i >= start; i-- {
+				t.Mul(&f[i+1], &a)
+				f[i].Add(&f[i], &t)
+			}
+			if b != nbBlocks-1 {
+				aPows[b].Exp(a, big.NewInt(int64(end-start)))
+			}
+		}(b)
+	}
+	wg.Wait()
 
-		f[i].Add(&f[i], &t)
+	if nbBlocks == 1 {
+		// the result is of degree deg(f)-1
+		return f[1:]
 	}
 
+	// 2. carries, from the top block down; the top block is already correct.
+	// carries[b] = f[end of block b], the (correct) value at the bottom of block b+1
+	carries := make([]fr.Element, nbBlocks)
+	for b := nbBlocks - 2; b >= 0; b-- {
+		carries[b] = f[blockStart(b+1)]
+		if b != nbBlocks-2 {
+			// the bottom of block b+1 is not corrected yet
+			var t fr.Element
+			t.Mul(&aPows[b+1], &carries[b+1])
+			carries[b].Add(&carries[b], &t)
+		}
+	}
+
+	// 3. add the contributions of the blocks above
+	wg.Add(nbBlocks - 1)
+	for b := 0; b < nbBlocks-1; b++ {
+		go func(b int) {
+			defer wg.Done()
+			start, end := blockStart(b), blockStart(b+1)
+			var t, aPow fr.Element
+			aPow = a
+			for i := end - 1; i >= start; i-- {
+				t.Mul(&aPow, &carries[b])
+				f[i].Add(&f[i], &t)
+				aPow.Mul(&aPow, &a)
+			}
+		}(b)
+	}
+	wg.Wait()
+
 	// the result is of degree deg(f)-1
 	return f[1:]
 }
+
+// minBlockSizeDivision is the minimum number of coefficients per go routine in dividePolyByXminusA
+const minBlockSizeDivision = 1 << 12
@@ -0,0 +1,89 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr/pcs"
+	"github.com/stretchr/testify/require"
+)
+
+// otherProof is an opening proof of another scheme
+type otherProof struct{}
+
+func (otherProof) Evaluation() fr.Element { return fr.Element{} }
+
+// openAndVerify is written against pcs.Scheme only
+func openAndVerify(scheme pcs.Scheme, polynomials [][]fr.Element, point fr.Element) error {
+	commitments := make([]pcs.Commitment, len(polynomials))
+	for i := range polynomials {
+		var err error
+		if commitments[i], err = scheme.Commit(polynomials[i]); err != nil {
+			return err
+		}
+	}
+
+	proof, err := scheme.Open(polynomials[0], point)
+	if err != nil {
+		return err
+	}
+	if err := scheme.Verify(commitments[0], proof, point); err != nil {
+		return err
+	}
+
+	hf := sha256.New()
+	batchProof, err := scheme.BatchOpen(polynomials, commitments, point, hf)
+	if err != nil {
+		return err
+	}
+	hf.Reset()
+	return scheme.BatchVerify(commitments, batchProof, point, hf)
+}
+
+func TestScheme(t *testing.T) {
+	assert := require.New(t)
+
+	scheme := NewScheme(testSrs)
+	polynomials := make([][]fr.Element, 5)
+	for i := range polynomials {
+		polynomials[i] = randomPolynomial(20 + i)
+	}
+	var point fr.Element
+	point.SetRandom()
+	assert.NoError(openAndVerify(scheme, polynomials, point))
+
+	// the verifier only needs the verifying key
+	verifier := &Scheme{Vk: testSrs.Vk}
+	commitment, err := scheme.Commit(polynomials[0])
+	assert.NoError(err)
+	proof, err := scheme.Open(polynomials[0], point)
+	assert.NoError(err)
+	assert.Equal(eval(polynomials[0], point), proof.Evaluation())
+	assert.NoError(verifier.Verify(commitment, proof, point))
+
+	// wrong claimed value
+	wrong := *proof.(*OpeningProof)
+	wrong.ClaimedValue.SetOne()
+	assert.Error(verifier.Verify(commitment, &wrong, point))
+
+	// values from another scheme are rejected
+	assert.ErrorIs(verifier.Verify(&commitment, proof, point), pcs.ErrCommitmentType)
+	assert.ErrorIs(verifier.Verify(commitment, otherProof{}, point), pcs.ErrProofType)
+}
@@ -0,0 +1,294 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"hash"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/fiat-shamir"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// ZeromorphProof is an opening proof of a multilinear polynomial f in n variables at a point u,
+// following Zeromorph [KT23].
+//
+// The multilinear identity f - f(u) = ∑ₖ(Xₖ-uₖ)qₖ(X₀, …, Xₖ₋₁), where Xₖ is the variable of
+// the k-th bit of the index (that is uₖ = point[n-1-k]), maps to the univariate identity
+//
+//	f(X) - f(u)Φₙ(X) = ∑ₖ(X^{2ᵏ}Φₙ₋ₖ₋₁(X^{2ᵏ⁺¹}) - uₖΦₙ₋ₖ(X^{2ᵏ}))qₖ(X)
+//
+// where Φₘ(X) = ∑_{i<2ᵐ}Xⁱ, and qₖ is committed to as a polynomial of degree < 2ᵏ.
+// The identity is checked at a random point x, together with the degree bounds of the
+// qₖ: they are shifted to degree < N = 2ⁿ in the batched quotient q = ∑ₖyᵏX^{N-2ᵏ}qₖ.
+// The prover then opens ζ + z·Z at x to 0 with a single KZG proof, where
+//
+//	ζ = q - ∑ₖyᵏx^{N-2ᵏ}qₖ
+//	Z = f - f(u)Φₙ(x) - ∑ₖ(x^{2ᵏ}Φₙ₋ₖ₋₁(x^{2ᵏ⁺¹}) - uₖΦₙ₋ₖ(x^{2ᵏ}))qₖ
+//
+// and the verifier computes the commitment to ζ + z·Z from the commitments to f, q and the qₖ.
+//
+// The degree check relies on the SRS: it is sound when the batched quotient can't be committed
+// to with degree ≥ N, that is when the proving key holds exactly N powers of α. With a larger
+// SRS, use GeminiProof, or a dedicated SRS of size N.
+//
+// [KT23]: https://eprint.iacr.org/2023/917.pdf
+type ZeromorphProof struct {
+	// Quotients commitments to q₀, …, qₙ₋₁
+	Quotients []Digest
+
+	// BatchedQuotient commitment to ∑ₖyᵏX^{N-2ᵏ}qₖ
+	BatchedQuotient Digest
+
+	// H commitment to (ζ + z·Z)/(X-x)
+	H Digest
+
+	// ClaimedValue purported value f(u)
+	ClaimedValue fr.Element
+}
+
+// ZeromorphOpen computes an opening proof of the multilinear polynomial given by its evaluations
+// on the hypercube, committed in digest, at point.
+//
+// * dataTranscript extra data that might be needed to derive the challenges
+func ZeromorphOpen(evaluations []fr.Element, digest Digest, point []fr.Element, hf hash.Hash, pk ProvingKey, dataTranscript ...[]byte) (ZeromorphProof, error) {
+	n, err := nbVariables(len(evaluations), len(point))
+	if err != nil {
+		return ZeromorphProof{}, err
+	}
+	if len(evaluations) > len(pk.G1) {
+		return ZeromorphProof{}, ErrInvalidPolynomialSize
+	}
+	N := len(evaluations)
+
+	// qₖ = f(X₀, …, Xₖ₋₁, 1, uₖ₊₁, …) - f(X₀, …, Xₖ₋₁, 0, uₖ₊₁, …), from the last variable down
+	quotients := make([][]fr.Element, n)
+	g := make([]fr.Element, N)
+	copy(g, evaluations)
+	for k := n - 1; k >= 0; k-- {
+		half := 1 << k
+		q := make([]fr.Element, half)
+		u := point[n-1-k]
+		parallel.Execute(half, func(start, end int) {
+			var t fr.Element
+			for l := start; l < end; l++ {
+				q[l].Sub(&g[half+l], &g[l])
+				t.Mul(&q[l], &u)
+				g[l].Add(&g[l], &t)
+			}
+		})
+		quotients[k] = q
+		g = g[:half]
+	}
+
+	res := ZeromorphProof{
+		Quotients:    make([]Digest, n),
+		ClaimedValue: g[0],
+	}
+	for k := range quotients {
+		if res.Quotients[k], err = Commit(quotients[k], pk); err != nil {
+			return ZeromorphProof{}, err
+		}
+	}
+
+	fs := fiatshamir.NewTranscript(hf, "y", "x", "z")
+	y, err := deriveAggregationChallenge(fs, "y", bindMultilinearOpening(&digest, point, &res.ClaimedValue, res.Quotients, dataTranscript)...)
+	if err != nil {
+		return ZeromorphProof{}, err
+	}
+
+	// q = ∑ₖyᵏX^{N-2ᵏ}qₖ
+	batched := make([]fr.Element, N)
+	var yk fr.Element
+	yk.SetOne()
+	for k := range quotients {
+		q := quotients[k]
+		offset := N - len(q)
+		parallel.Execute(len(q), func(start, end int) {
+			var t fr.Element
+			for l := start; l < end; l++ {
+				t.Mul(&q[l], &yk)
+				batched[offset+l].Add(&batched[offset+l], &t)
+			}
+		})
+		yk.Mul(&yk, &y)
+	}
+	if res.BatchedQuotient, err = Commit(batched, pk); err != nil {
+		return ZeromorphProof{}, err
+	}
+
+	x, err := deriveAggregationChallenge(fs, "x", res.BatchedQuotient.Marshal())
+	if err != nil {
+		return ZeromorphProof{}, err
+	}
+	z, err := deriveAggregationChallenge(fs, "z")
+	if err != nil {
+		return ZeromorphProof{}, err
+	}
+
+	// ζ + z·Z = q + z·f - z·f(u)Φₙ(x) - ∑ₖcₖqₖ
+	coeffs, phi := zeromorphCoefficients(point, x, y, z)
+	p := batched
+	parallel.Execute(N, func(start, end int) {
+		var t fr.Element
+		for i := start; i < end; i++ {
+			t.Mul(&evaluations[i], &z)
+			p[i].Add(&p[i], &t)
+		}
+	})
+	var t fr.Element
+	t.Mul(&res.ClaimedValue, &z).Mul(&t, &phi)
+	p[0].Sub(&p[0], &t)
+	for k := range quotients {
+		q := quotients[k]
+		parallel.Execute(len(q), func(start, end int) {
+			var t fr.Element
+			for l := start; l < end; l++ {
+				t.Mul(&q[l], &coeffs[k])
+				p[l].Sub(&p[l], &t)
+			}
+		})
+	}
+
+	// H = [(ζ + z·Z)(α)/(α-x)]G₁, (ζ + z·Z)(x) = 0
+	var zero fr.Element
+	if res.H, err = Commit(dividePolyByXminusA(p, zero, x), pk); err != nil {
+		return ZeromorphProof{}, err
+	}
+
+	return res, nil
+}
+
+// ZeromorphVerify verifies a ZeromorphProof of the multilinear polynomial committed in digest,
+// at point, with a single pairing check:
+//
+//	e([q] + z[f] - z·f(u)Φₙ(x)G₁ - ∑ₖcₖ[qₖ] + x·H, G₂)·e(-H, [α]G₂) == 1
+//
+// * dataTranscript extra data that might be needed to derive the challenges
+func ZeromorphVerify(digest *Digest, proof *ZeromorphProof, point []fr.Element, hf hash.Hash, vk VerifyingKey, dataTranscript ...[]byte) error {
+	n := len(point)
+	if n == 0 {
+		return ErrInvalidMultilinearSize
+	}
+	if len(proof.Quotients) != n {
+		return ErrVerifyOpeningProof
+	}
+
+	fs := fiatshamir.NewTranscript(hf, "y", "x", "z")
+	y, err := deriveAggregationChallenge(fs, "y", bindMultilinearOpening(digest, point, &proof.ClaimedValue, proof.Quotients, dataTranscript)...)
+	if err != nil {
+		return err
+	}
+	x, err := deriveAggregationChallenge(fs, "x", proof.BatchedQuotient.Marshal())
+	if err != nil {
+		return err
+	}
+	z, err := deriveAggregationChallenge(fs, "z")
+	if err != nil {
+		return err
+	}
+
+	coeffs, phi := zeromorphCoefficients(point, x, y, z)
+
+	// [q] + z[f] - z·f(u)Φₙ(x)G₁ - ∑ₖcₖ[qₖ] + x·H
+	bases := make([]bls12377.G1Affine, n+4)
+	scalars := make([]fr.Element, n+4)
+	copy(bases, proof.Quotients)
+	for k := 0; k < n; k++ {
+		scalars[k].Neg(&coeffs[k])
+	}
+	bases[n].Set(&proof.BatchedQuotient)
+	scalars[n].SetOne()
+	bases[n+1].Set(digest)
+	scalars[n+1].Set(&z)
+	bases[n+2].Set(&vk.G1)
+	scalars[n+2].Mul(&proof.ClaimedValue, &z).Mul(&scalars[n+2], &phi).Neg(&scalars[n+2])
+	bases[n+3].Set(&proof.H)
+	scalars[n+3].Set(&x)
+
+	var lhs bls12377.G1Affine
+	if _, err := lhs.MultiExp(bases, scalars, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+
+	// -H
+	var negH bls12377.G1Affine
+	negH.Neg(&proof.H)
+
+	check, err := bls12377.PairingCheckFixedQ(
+		[]bls12377.G1Affine{lhs, negH},
+		vk.Lines[:],
+	)
+	if err != nil {
+		return err
+	}
+	if !check {
+		return ErrVerifyOpeningProof
+	}
+	return nil
+}
+
+// zeromorphCoefficients returns the coefficients cₖ = yᵏx^{N-2ᵏ} + z(x^{2ᵏ}Φₙ₋ₖ₋₁(x^{2ᵏ⁺¹}) - uₖΦₙ₋ₖ(x^{2ᵏ}))
+// of the qₖ in ζ + z·Z, and Φₙ(x).
+func zeromorphCoefficients(point []fr.Element, x, y, z fr.Element) ([]fr.Element, fr.Element) {
+	n := len(point)
+
+	// squares[i] = x^{2ⁱ}
+	squares := make([]fr.Element, n)
+	squares[0] = x
+	for i := 1; i < n; i++ {
+		squares[i].Square(&squares[i-1])
+	}
+
+	// from k = n-1 down, with Φₘ(X) = ∏_{i<m}(1+X^{2ⁱ}), phi = Φₙ₋ₖ(x^{2ᵏ}) = ∏_{k≤i<n}(1+x^{2ⁱ})
+	coeffs := make([]fr.Element, n)
+	var phi, one fr.Element
+	phi.SetOne()
+	one.SetOne()
+	for k := n - 1; k >= 0; k-- {
+		// x^{2ᵏ}Φₙ₋ₖ₋₁(x^{2ᵏ⁺¹})
+		var c, t fr.Element
+		c.Mul(&squares[k], &phi)
+
+		t.Add(&one, &squares[k])
+		phi.Mul(&phi, &t)
+
+		// - uₖΦₙ₋ₖ(x^{2ᵏ})
+		t.Mul(&point[n-1-k], &phi)
+		c.Sub(&c, &t)
+		coeffs[k].Mul(&c, &z)
+	}
+
+	// + yᵏx^{N-2ᵏ}, with x^{N-2ᵏ} = ∏_{k≤i<n}x^{2ⁱ}
+	pows := make([]fr.Element, n)
+	pows[n-1] = squares[n-1]
+	for k := n - 2; k >= 0; k-- {
+		pows[k].Mul(&pows[k+1], &squares[k])
+	}
+	var yk, t fr.Element
+	yk.SetOne()
+	for k := 0; k < n; k++ {
+		t.Mul(&yk, &pows[k])
+		coeffs[k].Add(&coeffs[k], &t)
+		yk.Mul(&yk, &y)
+	}
+
+	return coeffs, phi
+}
@@ -0,0 +1,196 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12377
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+)
+
+func TestMultiExpTableG1(t *testing.T) {
+	t.Parallel()
+
+	const nbBases = 37
+	bases := make([]G1Affine, nbBases)
+	scalars := make([]fr.Element, nbBases)
+	var s big.Int
+	for i := range bases {
+		s.SetUint64(uint64(i + 1))
+		bases[i].ScalarMultiplication(&g1GenAff, &s)
+		scalars[i].SetRandom()
+	}
+	bases[5].setInfinity()
+	scalars[7].SetZero()
+	scalars[8].SetOne()
+	scalars[9].SetOne().Neg(&scalars[9])
+
+	var expected G1Jac
+	if _, err := expected.MultiExp(bases, scalars, ecc.MultiExpConfig{}); err != nil {
+		t.Fatal(err)
+	}
+
+	table := NewMultiExpTableG1(bases)
+	for _, nbTasks := range []int{0, 1, 5} {
+		var r G1Jac
+		if _, err := r.MultiExpWithTable(table, scalars, ecc.MultiExpConfig{NbTasks: nbTasks}); err != nil {
+			t.Fatal(err)
+		}
+		if !r.Equal(&expected) {
+			t.Fatal("MultiExpWithTable doesn't match MultiExp")
+		}
+	}
+	canonical := append(fr.Vector(nil), scalars...).IntoCanonical()
+	var r G1Jac
+	if _, err := r.MultiExpWithTable(table, canonical, ecc.MultiExpConfig{ScalarsCanonical: true}); err != nil {
+		t.Fatal(err)
+	}
+	if !r.Equal(&expected) {
+		t.Fatal("MultiExpWithTable with canonical scalars doesn't match MultiExp")
+	}
+	if _, err := new(G1Jac).MultiExpWithTable(table, scalars[1:], ecc.MultiExpConfig{}); err == nil {
+		t.Fatal("expected an error for len(scalars) != NbBases()")
+	}
+
+	// serialization round trip
+	for _, raw := range []bool{false, true} {
+		var buf bytes.Buffer
+		var err error
+		var n int64
+		if raw {
+			n, err = table.WriteRawTo(&buf)
+		} else {
+			n, err = table.WriteTo(&buf)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var _table MultiExpTableG1
+		m, err := _table.ReadFrom(bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != m || n != int64(buf.Len()) {
+			t.Fatal("bytes read don't match bytes written")
+		}
+		if _table.NbBases() != nbBases {
+			t.Fatal("wrong number of bases")
+		}
+		var r G1Jac
+		if _, err := r.MultiExpWithTable(&_table, scalars, ecc.MultiExpConfig{}); err != nil {
+			t.Fatal(err)
+		}
+		if !r.Equal(&expected) {
+			t.Fatal("MultiExpWithTable doesn't match MultiExp after serialization")
+		}
+
+		// a table of the other group can't be read
+		var other MultiExpTableG2
+		if _, err := other.ReadFrom(bytes.NewReader(buf.Bytes())); err != ecc.ErrHeaderObject {
+			t.Fatal("expected ecc.ErrHeaderObject, got", err)
+		}
+	}
+}
+
+func TestMultiExpTableG2(t *testing.T) {
+	t.Parallel()
+
+	const nbBases = 37
+	bases := make([]G2Affine, nbBases)
+	scalars := make([]fr.Element, nbBases)
+	var s big.Int
+	for i := range bases {
+		s.SetUint64(uint64(i + 1))
+		bases[i].ScalarMultiplication(&g2GenAff, &s)
+		scalars[i].SetRandom()
+	}
+	bases[5].setInfinity()
+	scalars[7].SetZero()
+	scalars[8].SetOne()
+	scalars[9].SetOne().Neg(&scalars[9])
+
+	var expected G2Jac
+	if _, err := expected.MultiExp(bases, scalars, ecc.MultiExpConfig{}); err != nil {
+		t.Fatal(err)
+	}
+
+	table := NewMultiExpTableG2(bases)
+	for _, nbTasks := range []int{0, 1, 5} {
+		var r G2Jac
+		if _, err := r.MultiExpWithTable(table, scalars, ecc.MultiExpConfig{NbTasks: nbTasks}); err != nil {
+			t.Fatal(err)
+		}
+		if !r.Equal(&expected) {
+			t.Fatal("MultiExpWithTable doesn't match MultiExp")
+		}
+	}
+	canonical := append(fr.Vector(nil), scalars...).IntoCanonical()
+	var r G2Jac
+	if _, err := r.MultiExpWithTable(table, canonical, ecc.MultiExpConfig{ScalarsCanonical: true}); err != nil {
+		t.Fatal(err)
+	}
+	if !r.Equal(&expected) {
+		t.Fatal("MultiExpWithTable with canonical scalars doesn't match MultiExp")
+	}
+	if _, err := new(G2Jac).MultiExpWithTable(table, scalars[1:], ecc.MultiExpConfig{}); err == nil {
+		t.Fatal("expected an error for len(scalars) != NbBases()")
+	}
+
+	// serialization round trip
+	for _, raw := range []bool{false, true} {
+		var buf bytes.Buffer
+		var err error
+		var n int64
+		if raw {
+			n, err = table.WriteRawTo(&buf)
+		} else {
+			n, err = table.WriteTo(&buf)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var _table MultiExpTableG2
+		m, err := _table.ReadFrom(bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != m || n != int64(buf.Len()) {
+			t.Fatal("bytes read don't match bytes written")
+		}
+		if _table.NbBases() != nbBases {
+			t.Fatal("wrong number of bases")
+		}
+		var r G2Jac
+		if _, err := r.MultiExpWithTable(&_table, scalars, ecc.MultiExpConfig{}); err != nil {
+			t.Fatal(err)
+		}
+		if !r.Equal(&expected) {
+			t.Fatal("MultiExpWithTable doesn't match MultiExp after serialization")
+		}
+
+		// a table of the other group can't be read
+		var other MultiExpTableG1
+		if _, err := other.ReadFrom(bytes.NewReader(buf.Bytes())); err != ecc.ErrHeaderObject {
+			t.Fatal("expected ecc.ErrHeaderObject, got", err)
+		}
+	}
+}
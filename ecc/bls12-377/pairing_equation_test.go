@@ -0,0 +1,149 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12377
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+)
+
+// pairingEquation returns the terms of e([a]g₁, [b]g₂)⋅e([c]g₁, g₂)⁻¹ = 1, which holds iff
+// c = a⋅b, with the G2 point g₂ shared by two terms
+func pairingEquation(a, b, c *fr.Element) []PairingTerm {
+	_, _, g1, g2 := Generators()
+	var ab, x fr.Element
+	ab.Mul(a, b)
+	x.Sub(c, &ab)
+	var _a, _b, _c, _ab, _x big.Int
+	a.BigInt(&_a)
+	b.BigInt(&_b)
+	c.BigInt(&_c)
+	ab.BigInt(&_ab)
+	x.BigInt(&_x)
+
+	terms := make([]PairingTerm, 4)
+	terms[0].P.ScalarMultiplication(&g1, &_a)
+	terms[0].Q.ScalarMultiplication(&g2, &_b)
+	// e([ab]g₁, g₂)⁻¹ split as e([ab]g₁, g₂)⁻¹⋅e([c-ab]g₁, g₂)⁻¹
+	terms[1].P.ScalarMultiplication(&g1, &_ab)
+	terms[1].Q.Set(&g2)
+	terms[1].Neg = true
+	terms[2].P.ScalarMultiplication(&g1, &_x)
+	terms[2].Q.Set(&g2)
+	terms[2].Neg = true
+	// e(∞, g₂) = 1
+	terms[3].Q.Set(&g2)
+	return terms
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("failing reader")
+}
+
+func TestVerifyPairingEquation(t *testing.T) {
+	t.Parallel()
+
+	var a, b, c fr.Element
+	a.SetRandom()
+	b.SetRandom()
+	c.Mul(&a, &b)
+
+	ok, err := VerifyPairingEquation(pairingEquation(&a, &b, &c))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("valid equation rejected")
+	}
+
+	var wrong fr.Element
+	wrong.Add(&c, new(fr.Element).SetOne())
+	ok, err = VerifyPairingEquation(pairingEquation(&a, &b, &wrong))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Fatal("invalid equation accepted")
+	}
+
+	// the terms of e(P, Q)⋅e(P, Q)⁻¹ cancel out entirely
+	_, _, g1, g2 := Generators()
+	ok, err = VerifyPairingEquation([]PairingTerm{
+		{P: g1, Q: g2},
+		{P: g1, Q: g2, Neg: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("e(P, Q)⋅e(P, Q)⁻¹ = 1 rejected")
+	}
+
+	// empty product
+	if ok, err = VerifyPairingEquation(nil); err != nil || !ok {
+		t.Fatal("empty equation rejected")
+	}
+}
+
+func TestVerifyPairingEquations(t *testing.T) {
+	t.Parallel()
+
+	const n = 3
+	equations := make([][]PairingTerm, n)
+	for i := range equations {
+		var a, b, c fr.Element
+		a.SetRandom()
+		b.SetRandom()
+		c.Mul(&a, &b)
+		equations[i] = pairingEquation(&a, &b, &c)
+	}
+
+	ok, err := VerifyPairingEquations(equations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("valid equations rejected")
+	}
+
+	// each invalid equation is detected, including the first one (ρ₀ = 1)
+	for i := range equations {
+		valid := equations[i]
+		var a, b, c fr.Element
+		a.SetRandom()
+		b.SetRandom()
+		c.SetRandom()
+		equations[i] = pairingEquation(&a, &b, &c)
+		ok, err := VerifyPairingEquations(equations)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok {
+			t.Fatalf("invalid equation %d accepted", i)
+		}
+		equations[i] = valid
+	}
+
+	if _, err := VerifyPairingEquations(equations, WithPairingEquationRandomness(failingReader{})); err == nil {
+		t.Fatal("expected an error from the source of randomness")
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12377
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc"
+)
+
+var (
+	errPrecomputationLength  = errors.New("the number of G1 points doesn't match the number of fixed G2 points")
+	errInvalidPrecomputation = errors.New("invalid pairing precomputation encoding")
+)
+
+// PairingPrecomputation holds the parts of pairing product equations that only depend on fixed
+// inputs, e.g. a verifying key: the precomputed lines of fixed G2 points (see PrecomputeLines),
+// and a fixed target pairing product, e.g. e(α, β).
+//
+// It is built once, and can then be used concurrently to check equations
+// ∏ e(Pᵢ, Qᵢ)⋅∏ e(P'ⱼ, Fixedⱼ) = Target with a single final exponentiation. For example, with
+// the Groth16 verifying key (α, β, γ, δ), the verification e(A, B)⋅e(C, -δ)⋅e(vkₓ, -γ) = e(α, β)
+// is
+//
+//	pp, _ := NewPairingPrecomputation([]G2Affine{-δ, -γ}, []G1Affine{α}, []G2Affine{β})
+//	ok, err := pp.Check([]G1Affine{A}, []G2Affine{B}, []G1Affine{C, vkₓ})
+type PairingPrecomputation struct {
+	Fixed  []G2Affine // fixed G2 points
+	Target GT         // fixed target pairing product
+
+	lines [][2][len(LoopCounter) - 1]LineEvaluationAff
+}
+
+// NewPairingPrecomputation precomputes the lines of the fixed G2 points, and the target
+// ∏ e(targetPᵢ, targetQᵢ) (1 if targetP and targetQ are empty).
+//
+// This function doesn't check that the inputs are in the correct subgroup. See IsInSubGroup.
+func NewPairingPrecomputation(fixed []G2Affine, targetP []G1Affine, targetQ []G2Affine) (*PairingPrecomputation, error) {
+	var pp PairingPrecomputation
+	pp.Fixed = make([]G2Affine, len(fixed))
+	copy(pp.Fixed, fixed)
+	pp.precomputeLines()
+
+	if len(targetP) == 0 && len(targetQ) == 0 {
+		pp.Target.SetOne()
+		return &pp, nil
+	}
+	var err error
+	if pp.Target, err = Pair(targetP, targetQ); err != nil {
+		return nil, err
+	}
+	return &pp, nil
+}
+
+func (pp *PairingPrecomputation) precomputeLines() {
+	pp.lines = make([][2][len(LoopCounter) - 1]LineEvaluationAff, len(pp.Fixed))
+	for i := range pp.Fixed {
+		pp.lines[i] = PrecomputeLines(pp.Fixed[i])
+	}
+}
+
+// Pair returns ∏ e(Pᵢ, Qᵢ)⋅∏ e(fixedPⱼ, pp.Fixedⱼ), with a single final exponentiation.
+//
+// This function doesn't check that the inputs are in the correct subgroup. See IsInSubGroup.
+func (pp *PairingPrecomputation) Pair(P []G1Affine, Q []G2Affine, fixedP []G1Affine) (GT, error) {
+	if len(fixedP) != len(pp.Fixed) {
+		return GT{}, errPrecomputationLength
+	}
+	var f GT
+	f.SetOne()
+	if len(P) != 0 || len(Q) != 0 {
+		var err error
+		if f, err = MillerLoop(P, Q); err != nil {
+			return GT{}, err
+		}
+	}
+	if len(fixedP) != 0 {
+		// MillerLoopFixedQ overwrites the lines
+		lines := make([][2][len(LoopCounter) - 1]LineEvaluationAff, len(pp.lines))
+		copy(lines, pp.lines)
+		fFixed, err := MillerLoopFixedQ(fixedP, lines)
+		if err != nil {
+			return GT{}, err
+		}
+		f.Mul(&f, &fFixed)
+	}
+	return FinalExponentiation(&f), nil
+}
+
+// Check returns true if ∏ e(Pᵢ, Qᵢ)⋅∏ e(fixedPⱼ, pp.Fixedⱼ) = pp.Target, see Pair.
+//
+// This function doesn't check that the inputs are in the correct subgroup. See IsInSubGroup.
+func (pp *PairingPrecomputation) Check(P []G1Affine, Q []G2Affine, fixedP []G1Affine) (bool, error) {
+	f, err := pp.Pair(P, Q, fixedP)
+	if err != nil {
+		return false, err
+	}
+	return f.Equal(&pp.Target), nil
+}
+
+// WriteTo writes the binary encoding of pp to w: an ecc.Header, the number of fixed points,
+// the compressed fixed points and the target. The lines are not written, they are recomputed
+// by ReadFrom.
+func (pp *PairingPrecomputation) WriteTo(w io.Writer) (int64, error) {
+	h := ecc.NewHeader(ecc.BLS12_377, ecc.ObjectPairingPrecomputation, 0)
+	hn, err := h.WriteTo(w)
+	if err != nil {
+		return hn, err
+	}
+
+	buf := make([]byte, 4, 4+len(pp.Fixed)*SizeOfG2AffineCompressed+SizeOfGT)
+	binary.BigEndian.PutUint32(buf, uint32(len(pp.Fixed)))
+	for i := range pp.Fixed {
+		b := pp.Fixed[i].Bytes()
+		buf = append(buf, b[:]...)
+	}
+	target := pp.Target.Bytes()
+	buf = append(buf, target[:]...)
+	n, err := w.Write(buf)
+	return hn + int64(n), err
+}
+
+// ReadFrom decodes pp from r, checks that the fixed points are in G2 and that the target is in
+// GT, and recomputes the lines.
+func (pp *PairingPrecomputation) ReadFrom(r io.Reader) (int64, error) {
+	h, r, hn, err := ecc.ReadHeader(r)
+	if err != nil {
+		return hn, err
+	}
+	if h == nil {
+		return hn, errInvalidPrecomputation
+	}
+	if err := h.Check(ecc.BLS12_377, ecc.ObjectPairingPrecomputation); err != nil {
+		return hn, err
+	}
+
+	var buf [4]byte
+	n, err := io.ReadFull(r, buf[:])
+	read := hn + int64(n)
+	if err != nil {
+		return read, err
+	}
+	nbFixed := binary.BigEndian.Uint32(buf[:])
+	if nbFixed > 1<<16 {
+		return read, errInvalidPrecomputation
+	}
+
+	data := make([]byte, int(nbFixed)*SizeOfG2AffineCompressed+SizeOfGT)
+	n, err = io.ReadFull(r, data)
+	read += int64(n)
+	if err != nil {
+		return read, err
+	}
+	fixed := make([]G2Affine, nbFixed)
+	for i := range fixed {
+		if _, err := fixed[i].SetBytes(data[i*SizeOfG2AffineCompressed:]); err != nil {
+			return read, err
+		}
+	}
+	var target GT
+	if err := target.SetBytes(data[len(fixed)*SizeOfG2AffineCompressed:]); err != nil {
+		return read, err
+	}
+	if !target.IsInSubGroup() {
+		return read, errInvalidPrecomputation
+	}
+
+	pp.Fixed = fixed
+	pp.Target = target
+	pp.precomputeLines()
+	return read, nil
+}
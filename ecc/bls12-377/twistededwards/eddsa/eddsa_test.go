@@ -17,7 +17,9 @@
 package eddsa
 
 import (
+	"bytes"
 	"crypto/sha256"
+	"encoding/json"
 	"math/big"
 	"math/rand"
 	"testing"
@@ -180,6 +182,74 @@ func TestSerialization(t *testing.T) {
 	}
 }
 
+func TestSerializationJSONCBOR(t *testing.T) {
+
+	src := rand.NewSource(0)
+	r := rand.New(src) //#nosec G404 weak rng is fine here
+
+	privKey, err := GenerateKey(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey := privKey.PublicKey
+
+	var frMsg fr.Element
+	frMsg.SetRandom()
+	msgBin := frMsg.Bytes()
+	sigBin, err := privKey.Sign(msgBin[:], hash.MIMC_BLS12_377.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sig Signature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		t.Fatal(err)
+	}
+
+	// json
+	var pub PublicKey
+	b, err := json.Marshal(&pubKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(b, &pub); err != nil {
+		t.Fatal(err)
+	}
+	if !pub.Equal(&pubKey) {
+		t.Fatal("public key JSON round trip failed")
+	}
+	var s Signature
+	if b, err = json.Marshal(&sig); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(b, &s); err != nil {
+		t.Fatal(err)
+	}
+	if !s.R.Equal(&sig.R) || s.S != sig.S {
+		t.Fatal("signature JSON round trip failed")
+	}
+
+	// cbor
+	pub, s = PublicKey{}, Signature{}
+	if b, err = pubKey.MarshalCBOR(); err != nil {
+		t.Fatal(err)
+	}
+	if err := pub.UnmarshalCBOR(b); err != nil {
+		t.Fatal(err)
+	}
+	if !pub.Equal(&pubKey) {
+		t.Fatal("public key CBOR round trip failed")
+	}
+	if b, err = sig.MarshalCBOR(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.UnmarshalCBOR(b); err != nil {
+		t.Fatal(err)
+	}
+	if !s.R.Equal(&sig.R) || s.S != sig.S {
+		t.Fatal("signature CBOR round trip failed")
+	}
+}
+
 func TestEddsaMIMC(t *testing.T) {
 
 	src := rand.NewSource(0)
@@ -223,6 +293,45 @@ func TestEddsaMIMC(t *testing.T) {
 
 }
 
+func TestEddsaScalarBlinding(t *testing.T) {
+
+	src := rand.NewSource(0)
+	r := rand.New(src) //#nosec G404 weak rng is fine here
+
+	privKey, err := GenerateKey(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey := privKey.PublicKey
+	hFunc := hash.MIMC_BLS12_377.New()
+
+	var frMsg fr.Element
+	frMsg.SetString("44717650746155748460101257525078853138837311576962212923649547644148297035978")
+	msgBin := frMsg.Bytes()
+	signature, err := privKey.Sign(msgBin[:], hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// blinding doesn't change the (deterministic) signature
+	privKey.SetScalarBlinding(true)
+	blindedSignature, err := privKey.Sign(msgBin[:], hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(signature, blindedSignature) {
+		t.Fatal("signatures with and without scalar blinding should be equal")
+	}
+
+	res, err := pubKey.Verify(blindedSignature, msgBin[:], hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res {
+		t.Fatal("Verify correct signature should return true")
+	}
+}
+
 func TestEddsaSHA256(t *testing.T) {
 
 	src := rand.NewSource(0)
@@ -266,6 +375,76 @@ func TestEddsaSHA256(t *testing.T) {
 
 // benchmarks
 
+func TestBatchGenerateKeys(t *testing.T) {
+
+	const n = 17
+
+	// the batch must derive the same keys as GenerateKey from the same stream
+	privs, err := BatchGenerateKeys(rand.New(rand.NewSource(0)), n) //#nosec G404 weak rng is fine here
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := rand.New(rand.NewSource(0)) //#nosec G404 weak rng is fine here
+	scalars := make([]big.Int, n)
+	for i := 0; i < n; i++ {
+		expected, err := GenerateKey(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(privs[i].Bytes(), expected.Bytes()) {
+			t.Fatalf("key %d: BatchGenerateKeys doesn't match GenerateKey", i)
+		}
+		scalars[i].SetBytes(expected.scalar[:])
+	}
+
+	pubs := BatchGeneratePublicKeys(scalars)
+	if len(pubs) != n {
+		t.Fatal("wrong number of public keys")
+	}
+	for i := range pubs {
+		if !pubs[i].Equal(&privs[i].PublicKey) {
+			t.Fatalf("key %d: BatchGeneratePublicKeys doesn't match GenerateKey", i)
+		}
+	}
+
+	// the keys must be usable
+	hFunc := sha256.New()
+	msg := []byte("batch")
+	sig, err := privs[n-1].Sign(msg, hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err := pubs[n-1].Verify(sig, msg, hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("signature of a batch generated key should verify")
+	}
+
+	// not enough randomness
+	if _, err := BatchGenerateKeys(bytes.NewReader(make([]byte, 32*n-1)), n); err == nil {
+		t.Fatal("expected an error for a short source of randomness")
+	}
+}
+
+func BenchmarkBatchGenerateKeys(b *testing.B) {
+	const n = 1024
+	r := rand.New(rand.NewSource(0)) //#nosec G404 weak rng is fine here
+	b.Run("GenerateKey", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < n; j++ {
+				_, _ = GenerateKey(r)
+			}
+		}
+	})
+	b.Run("BatchGenerateKeys", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = BatchGenerateKeys(r, n)
+		}
+	})
+}
+
 func BenchmarkVerify(b *testing.B) {
 
 	src := rand.NewSource(0)
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/twistededwards"
+	"github.com/consensys/gnark-crypto/internal/marshal"
 	"io"
 	"math/big"
 )
@@ -99,8 +100,8 @@ func (privKey *PrivateKey) SetBytes(buf []byte) (int, error) {
 	}
 	subtle.ConstantTimeCopy(1, privKey.scalar[:], buf[sizeFr:2*sizeFr])
 	n += sizeFr
-	subtle.ConstantTimeCopy(1, privKey.randSrc[:], buf[2*sizeFr:])
-	n += sizeFr
+	subtle.ConstantTimeCopy(1, privKey.randSrc[:], buf[2*sizeFr:sizePrivateKey])
+	n += len(privKey.randSrc)
 	return n, nil
 }
 
@@ -175,3 +176,73 @@ func (sig *Signature) SetBytes(buf []byte) (int, error) {
 	n += sizeFr
 	return n, nil
 }
+
+// MarshalJSON implements json.Marshaler. The public key is encoded as a
+// 0x-prefixed hex string of its binary representation (see Bytes).
+func (pk *PublicKey) MarshalJSON() ([]byte, error) {
+	return marshal.MarshalHexJSON(pk.Bytes())
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (pk *PublicKey) UnmarshalJSON(data []byte) error {
+	buf, err := marshal.UnmarshalHexJSON(data)
+	if err != nil {
+		return err
+	}
+	return pk.setBytesExact(buf)
+}
+
+// MarshalCBOR returns the binary representation of the public key (see Bytes)
+// encoded as a CBOR byte string.
+func (pk *PublicKey) MarshalCBOR() ([]byte, error) {
+	return marshal.MarshalCBORBytes(pk.Bytes()), nil
+}
+
+// UnmarshalCBOR decodes a public key encoded with MarshalCBOR
+func (pk *PublicKey) UnmarshalCBOR(data []byte) error {
+	buf, err := marshal.UnmarshalCBORBytes(data)
+	if err != nil {
+		return err
+	}
+	return pk.setBytesExact(buf)
+}
+
+func (pk *PublicKey) setBytesExact(buf []byte) error {
+	if len(buf) != sizePublicKey {
+		return errWrongSize
+	}
+	_, err := pk.SetBytes(buf)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler. The signature is encoded as a
+// 0x-prefixed hex string of its binary representation (see Bytes).
+func (sig *Signature) MarshalJSON() ([]byte, error) {
+	return marshal.MarshalHexJSON(sig.Bytes())
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (sig *Signature) UnmarshalJSON(data []byte) error {
+	buf, err := marshal.UnmarshalHexJSON(data)
+	if err != nil {
+		return err
+	}
+	_, err = sig.SetBytes(buf)
+	return err
+}
+
+// MarshalCBOR returns the binary representation of the signature (see Bytes)
+// encoded as a CBOR byte string.
+func (sig *Signature) MarshalCBOR() ([]byte, error) {
+	return marshal.MarshalCBORBytes(sig.Bytes()), nil
+}
+
+// UnmarshalCBOR decodes a signature encoded with MarshalCBOR
+func (sig *Signature) UnmarshalCBOR(data []byte) error {
+	buf, err := marshal.UnmarshalCBORBytes(data)
+	if err != nil {
+		return err
+	}
+	_, err = sig.SetBytes(buf)
+	return err
+}
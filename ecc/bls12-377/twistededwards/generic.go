@@ -0,0 +1,153 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package twistededwards
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	edwards "github.com/consensys/gnark-crypto/ecc/twistededwards"
+)
+
+var (
+	errNotOnCurve    = errors.New("point not on the twisted Edwards curve")
+	errCurveMismatch = errors.New("twisted Edwards point from a different curve")
+)
+
+// Curve returns the twisted Edwards curve on bls12-377/Fr as a generic edwards.Curve
+func Curve() edwards.Curve {
+	return curve{}
+}
+
+// curve implements edwards.Curve
+type curve struct{}
+
+func (curve) ID() edwards.ID {
+	return edwards.BLS12_377
+}
+
+func (curve) Params() edwards.Params {
+	c := GetEdwardsCurve()
+	res := edwards.Params{
+		A:        new(big.Int),
+		D:        new(big.Int),
+		Cofactor: new(big.Int),
+		Order:    new(big.Int).Set(&c.Order),
+	}
+	c.A.BigInt(res.A)
+	c.D.BigInt(res.D)
+	c.Cofactor.BigInt(res.Cofactor)
+	return res
+}
+
+func (curve) BaseFieldModulus() *big.Int {
+	return fr.Modulus()
+}
+
+func (curve) Base() edwards.Point {
+	return &point{GetEdwardsCurve().Base}
+}
+
+func (curve) NewPoint() edwards.Point {
+	var p point
+	p.setInfinity()
+	return &p
+}
+
+// point wraps a PointAffine to implement edwards.Point
+type point struct {
+	PointAffine
+}
+
+// toPoint returns the PointAffine wrapped in p, and panics if p belongs to another curve
+func toPoint(p edwards.Point) *PointAffine {
+	res, ok := p.(*point)
+	if !ok {
+		panic(errCurveMismatch)
+	}
+	return &res.PointAffine
+}
+
+func (p *point) Set(p1 edwards.Point) edwards.Point {
+	p.PointAffine.Set(toPoint(p1))
+	return p
+}
+
+func (p *point) Equal(p1 edwards.Point) bool {
+	return p.PointAffine.Equal(toPoint(p1))
+}
+
+func (p *point) Neg(p1 edwards.Point) edwards.Point {
+	p.PointAffine.Neg(toPoint(p1))
+	return p
+}
+
+func (p *point) Add(p1, p2 edwards.Point) edwards.Point {
+	p.PointAffine.Add(toPoint(p1), toPoint(p2))
+	return p
+}
+
+func (p *point) Double(p1 edwards.Point) edwards.Point {
+	p.PointAffine.Double(toPoint(p1))
+	return p
+}
+
+func (p *point) ScalarMultiplication(p1 edwards.Point, scalar *big.Int) edwards.Point {
+	p.PointAffine.ScalarMultiplication(toPoint(p1), scalar)
+	return p
+}
+
+func (p *point) ClearCofactor(p1 edwards.Point) edwards.Point {
+	p.PointAffine.ClearCofactor(toPoint(p1))
+	return p
+}
+
+func (p *point) Coordinates() (x, y *big.Int) {
+	x, y = new(big.Int), new(big.Int)
+	p.X.BigInt(x)
+	p.Y.BigInt(y)
+	return
+}
+
+func (p *point) SetCoordinates(x, y *big.Int) error {
+	var q PointAffine
+	q.X.SetBigInt(x)
+	q.Y.SetBigInt(y)
+	if !q.IsOnCurve() {
+		return errNotOnCurve
+	}
+	p.PointAffine = q
+	return nil
+}
+
+func (p *point) Bytes() []byte {
+	return p.Marshal()
+}
+
+func (p *point) SetBytes(buf []byte) (int, error) {
+	var q PointAffine
+	n, err := q.SetBytes(buf)
+	if err != nil {
+		return n, err
+	}
+	if !q.IsOnCurve() {
+		return n, errNotOnCurve
+	}
+	p.PointAffine = q
+	return n, nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package twistededwards
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestGenericCurve(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	c := Curve()
+	params := GetEdwardsCurve()
+
+	properties.Property("generic operations should match the PointAffine ones", prop.ForAll(
+		func(s1, s2 big.Int) bool {
+			var p1, p2, sum, dbl PointAffine
+			p1.ScalarMultiplication(&params.Base, &s1)
+			p2.ScalarMultiplication(&params.Base, &s2)
+			sum.Add(&p1, &p2)
+			dbl.Double(&p1)
+
+			g1 := c.Base().ScalarMultiplication(c.Base(), &s1)
+			g2 := c.NewPoint().ScalarMultiplication(c.Base(), &s2)
+			gSum := c.NewPoint().Add(g1, g2)
+			gDbl := c.NewPoint().Double(g1)
+
+			x, y := gSum.Coordinates()
+			var xSum, ySum big.Int
+			sum.X.BigInt(&xSum)
+			sum.Y.BigInt(&ySum)
+
+			x2, y2 := gDbl.Coordinates()
+			var xDbl, yDbl big.Int
+			dbl.X.BigInt(&xDbl)
+			dbl.Y.BigInt(&yDbl)
+
+			return x.Cmp(&xSum) == 0 && y.Cmp(&ySum) == 0 &&
+				x2.Cmp(&xDbl) == 0 && y2.Cmp(&yDbl) == 0 &&
+				c.NewPoint().Add(g1, c.NewPoint().Neg(g1)).IsZero() &&
+				gSum.IsInSubGroup()
+		},
+		GenBigInt(),
+		GenBigInt(),
+	))
+
+	properties.Property("generic SetBytes(Bytes()) should stay the same", prop.ForAll(
+		func(s big.Int) bool {
+			p := c.NewPoint().ScalarMultiplication(c.Base(), &s)
+			q := c.NewPoint()
+			n, err := q.SetBytes(p.Bytes())
+			return err == nil && n == fr.Bytes && q.Equal(p)
+		},
+		GenBigInt(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestGenericSubGroup(t *testing.T) {
+	t.Parallel()
+
+	c := Curve()
+	p := c.Params()
+	params := GetEdwardsCurve()
+	if p.Order.Cmp(&params.Order) != 0 || c.BaseFieldModulus().Cmp(fr.Modulus()) != 0 {
+		t.Fatal("wrong curve parameters")
+	}
+
+	base := c.Base()
+	if !base.IsOnCurve() || !base.IsInSubGroup() || base.IsZero() {
+		t.Fatal("the base point should be in the prime subgroup")
+	}
+	if !c.NewPoint().ScalarMultiplication(base, p.Order).IsZero() {
+		t.Fatal("[order]base should be the identity")
+	}
+
+	// (0, -1) has order 2
+	var minusOne big.Int
+	minusOne.Sub(fr.Modulus(), big.NewInt(1))
+	lowOrder := c.NewPoint()
+	if err := lowOrder.SetCoordinates(big.NewInt(0), &minusOne); err != nil {
+		t.Fatal(err)
+	}
+	if lowOrder.IsInSubGroup() {
+		t.Fatal("(0, -1) is not in the prime subgroup")
+	}
+	if !c.NewPoint().ClearCofactor(lowOrder).IsZero() {
+		t.Fatal("clearing the cofactor of (0, -1) should give the identity")
+	}
+
+	// base + (0, -1) is on the curve, outside of the prime subgroup
+	q := c.NewPoint().Add(base, lowOrder)
+	if !q.IsOnCurve() || q.IsInSubGroup() {
+		t.Fatal("base + (0, -1) is not in the prime subgroup")
+	}
+	if !c.NewPoint().ClearCofactor(q).IsInSubGroup() {
+		t.Fatal("clearing the cofactor should map to the prime subgroup")
+	}
+
+	if err := c.NewPoint().SetCoordinates(big.NewInt(1), big.NewInt(1)); err == nil {
+		t.Fatal("(1, 1) is not on the curve")
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12378
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+)
+
+func TestScalarMultiplicationConstantTime(t *testing.T) {
+	t.Parallel()
+
+	var base G1Affine
+	base.ScalarMultiplication(&g1GenAff, big.NewInt(42))
+
+	// edge cases: 0, 1, -1, r-1, r, a scalar larger than r and random scalars
+	r := fr.Modulus()
+	scalars := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		big.NewInt(-1),
+		new(big.Int).Sub(r, big.NewInt(1)),
+		new(big.Int).Set(r),
+		new(big.Int).Lsh(r, 3),
+	}
+	for i := 0; i < 10; i++ {
+		var e fr.Element
+		e.SetRandom()
+		scalars = append(scalars, e.BigInt(new(big.Int)))
+	}
+
+	for _, s := range scalars {
+		var expected, res G1Affine
+		expected.ScalarMultiplicationVartime(&base, s)
+		res.ScalarMultiplicationConstantTime(&base, s)
+		if !res.Equal(&expected) {
+			t.Fatalf("ScalarMultiplicationConstantTime doesn't match ScalarMultiplicationVartime for s = %s", s)
+		}
+
+		var expectedJac, resJac, baseJac G1Jac
+		baseJac.FromAffine(&base)
+		expectedJac.ScalarMultiplicationVartime(&baseJac, s)
+		resJac.ScalarMultiplicationConstantTime(&baseJac, s)
+		if !resJac.Equal(&expectedJac) {
+			t.Fatalf("[Jacobian] ScalarMultiplicationConstantTime doesn't match ScalarMultiplicationVartime for s = %s", s)
+		}
+	}
+
+	// the point at infinity
+	var inf, res G1Affine
+	res.ScalarMultiplicationConstantTime(&inf, scalars[len(scalars)-1])
+	if !res.IsInfinity() {
+		t.Fatal("[s]O should be O")
+	}
+}
+
+func BenchmarkScalarMultiplicationConstantTime(b *testing.B) {
+	var s fr.Element
+	s.SetRandom()
+	var scalar big.Int
+	s.BigInt(&scalar)
+
+	var res G1Affine
+	b.Run("ScalarMultiplicationConstantTime", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.ScalarMultiplicationConstantTime(&g1GenAff, &scalar)
+		}
+	})
+	b.Run("ScalarMultiplicationVartime", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.ScalarMultiplicationVartime(&g1GenAff, &scalar)
+		}
+	})
+}
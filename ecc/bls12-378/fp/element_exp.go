@@ -1038,3 +1038,597 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	return z
 }
+
+// expByInverseExp is equivalent to z.Exp(x, 3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f76a822c00009948a1ffffffffff)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByInverseExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10      = 2*1
+	//	_11      = 1 + _10
+	//	_101     = _10 + _11
+	//	_111     = _10 + _101
+	//	_1001    = _10 + _111
+	//	_1011    = _10 + _1001
+	//	_1101    = _10 + _1011
+	//	_1111    = _10 + _1101
+	//	_11110   = 2*_1111
+	//	_11111   = 1 + _11110
+	//	_1111100 = _11111 << 2
+	//	_1111111 = _11 + _1111100
+	//	i15      = _1111100 << 2
+	//	i16      = _111 + i15
+	//	x9       = _1111 + i15
+	//	i39      = ((i16 << 5 + _1011) << 6 + 1) << 9
+	//	i56      = ((_1011 + i39) << 6 + _1101) << 8 + _1001
+	//	i69      = ((i56 << 3 + _101) << 5 + _11) << 3
+	//	i86      = ((1 + i69) << 8 + _101) << 6 + _1111
+	//	i102     = ((i86 << 6 + _1011) << 5 + _1011) << 3
+	//	i120     = ((_101 + i102) << 6 + _1001) << 9 + _111
+	//	i140     = ((i120 << 5 + _1101) << 4 + 1) << 9
+	//	i151     = ((_1111 + i140) << 2 + 1) << 6 + _101
+	//	i175     = ((i151 << 5 + 1) << 11 + _1011) << 6
+	//	i186     = ((_1111 + i175) << 5 + _1011) << 3 + _11
+	//	i201     = ((i186 << 3 + 1) << 6 + _111) << 4
+	//	i216     = ((1 + i201) << 7 + 1) << 5 + _11
+	//	i231     = ((i216 << 6 + _1001) << 3 + _101) << 4
+	//	i247     = ((_11 + i231) << 11 + _1101) << 2 + _11
+	//	i267     = (2*(i247 << 8 + _1101) + 1) << 9
+	//	i283     = ((_1101 + i267) << 7 + _101) << 6 + _1001
+	//	i302     = ((i283 << 5 + _111) << 2 + 1) << 10
+	//	i315     = ((x9 + i302) << 5 + _111) << 5 + _1001
+	//	i334     = ((i315 << 8 + _1111111) << 4 + _111) << 5
+	//	i347     = ((_1101 + i334) << 4 + _101) << 6 + 1
+	//	i382     = ((i347 << 7 + _1011) << 22 + _1001) << 4
+	//	i396     = ((_1001 + i382) << 5 + _1001) << 6 + _101
+	//	i429     = ((i396 << 13 + x9) << 9 + x9) << 9
+	//	return     ((x9 + i429) << 9 + x9) << 5 + _11111
+	//
+	// Operations: 375 squares 71 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0 = new(Element)
+		t1 = new(Element)
+		t2 = new(Element)
+		t3 = new(Element)
+		t4 = new(Element)
+		t5 = new(Element)
+		t6 = new(Element)
+		t7 = new(Element)
+		t8 = new(Element)
+		t9 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9 Element
+	// Step 1: z = x^0x2
+	z.Square(&x)
+
+	// Step 2: t7 = x^0x3
+	t7.Mul(&x, z)
+
+	// Step 3: t1 = x^0x5
+	t1.Mul(z, t7)
+
+	// Step 4: t5 = x^0x7
+	t5.Mul(z, t1)
+
+	// Step 5: t2 = x^0x9
+	t2.Mul(z, t5)
+
+	// Step 6: t3 = x^0xb
+	t3.Mul(z, t2)
+
+	// Step 7: t4 = x^0xd
+	t4.Mul(z, t3)
+
+	// Step 8: t8 = x^0xf
+	t8.Mul(z, t4)
+
+	// Step 9: z = x^0x1e
+	z.Square(t8)
+
+	// Step 10: z = x^0x1f
+	z.Mul(&x, z)
+
+	// Step 12: t0 = x^0x7c
+	t0.Square(z)
+	for s := 1; s < 2; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 13: t6 = x^0x7f
+	t6.Mul(t7, t0)
+
+	// Step 15: t0 = x^0x1f0
+	for s := 0; s < 2; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 16: t9 = x^0x1f7
+	t9.Mul(t5, t0)
+
+	// Step 17: t0 = x^0x1ff
+	t0.Mul(t8, t0)
+
+	// Step 22: t9 = x^0x3ee0
+	for s := 0; s < 5; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 23: t9 = x^0x3eeb
+	t9.Mul(t3, t9)
+
+	// Step 29: t9 = x^0xfbac0
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 30: t9 = x^0xfbac1
+	t9.Mul(&x, t9)
+
+	// Step 39: t9 = x^0x1f758200
+	for s := 0; s < 9; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 40: t9 = x^0x1f75820b
+	t9.Mul(t3, t9)
+
+	// Step 46: t9 = x^0x7dd6082c0
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 47: t9 = x^0x7dd6082cd
+	t9.Mul(t4, t9)
+
+	// Step 55: t9 = x^0x7dd6082cd00
+	for s := 0; s < 8; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 56: t9 = x^0x7dd6082cd09
+	t9.Mul(t2, t9)
+
+	// Step 59: t9 = x^0x3eeb04166848
+	for s := 0; s < 3; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 60: t9 = x^0x3eeb0416684d
+	t9.Mul(t1, t9)
+
+	// Step 65: t9 = x^0x7dd6082cd09a0
+	for s := 0; s < 5; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 66: t9 = x^0x7dd6082cd09a3
+	t9.Mul(t7, t9)
+
+	// Step 69: t9 = x^0x3eeb0416684d18
+	for s := 0; s < 3; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 70: t9 = x^0x3eeb0416684d19
+	t9.Mul(&x, t9)
+
+	// Step 78: t9 = x^0x3eeb0416684d1900
+	for s := 0; s < 8; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 79: t9 = x^0x3eeb0416684d1905
+	t9.Mul(t1, t9)
+
+	// Step 85: t9 = x^0xfbac1059a13464140
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 86: t9 = x^0xfbac1059a1346414f
+	t9.Mul(t8, t9)
+
+	// Step 92: t9 = x^0x3eeb0416684d19053c0
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 93: t9 = x^0x3eeb0416684d19053cb
+	t9.Mul(t3, t9)
+
+	// Step 98: t9 = x^0x7dd6082cd09a320a7960
+	for s := 0; s < 5; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 99: t9 = x^0x7dd6082cd09a320a796b
+	t9.Mul(t3, t9)
+
+	// Step 102: t9 = x^0x3eeb0416684d19053cb58
+	for s := 0; s < 3; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 103: t9 = x^0x3eeb0416684d19053cb5d
+	t9.Mul(t1, t9)
+
+	// Step 109: t9 = x^0xfbac1059a1346414f2d740
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 110: t9 = x^0xfbac1059a1346414f2d749
+	t9.Mul(t2, t9)
+
+	// Step 119: t9 = x^0x1f75820b34268c829e5ae9200
+	for s := 0; s < 9; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 120: t9 = x^0x1f75820b34268c829e5ae9207
+	t9.Mul(t5, t9)
+
+	// Step 125: t9 = x^0x3eeb0416684d19053cb5d240e0
+	for s := 0; s < 5; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 126: t9 = x^0x3eeb0416684d19053cb5d240ed
+	t9.Mul(t4, t9)
+
+	// Step 130: t9 = x^0x3eeb0416684d19053cb5d240ed0
+	for s := 0; s < 4; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 131: t9 = x^0x3eeb0416684d19053cb5d240ed1
+	t9.Mul(&x, t9)
+
+	// Step 140: t9 = x^0x7dd6082cd09a320a796ba481da200
+	for s := 0; s < 9; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 141: t9 = x^0x7dd6082cd09a320a796ba481da20f
+	t9.Mul(t8, t9)
+
+	// Step 143: t9 = x^0x1f75820b34268c829e5ae92076883c
+	for s := 0; s < 2; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 144: t9 = x^0x1f75820b34268c829e5ae92076883d
+	t9.Mul(&x, t9)
+
+	// Step 150: t9 = x^0x7dd6082cd09a320a796ba481da20f40
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 151: t9 = x^0x7dd6082cd09a320a796ba481da20f45
+	t9.Mul(t1, t9)
+
+	// Step 156: t9 = x^0xfbac1059a1346414f2d74903b441e8a0
+	for s := 0; s < 5; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 157: t9 = x^0xfbac1059a1346414f2d74903b441e8a1
+	t9.Mul(&x, t9)
+
+	// Step 168: t9 = x^0x7dd6082cd09a320a796ba481da20f450800
+	for s := 0; s < 11; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 169: t9 = x^0x7dd6082cd09a320a796ba481da20f45080b
+	t9.Mul(t3, t9)
+
+	// Step 175: t9 = x^0x1f75820b34268c829e5ae92076883d14202c0
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 176: t8 = x^0x1f75820b34268c829e5ae92076883d14202cf
+	t8.Mul(t8, t9)
+
+	// Step 181: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059e0
+	for s := 0; s < 5; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 182: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb
+	t8.Mul(t3, t8)
+
+	// Step 185: t8 = x^0x1f75820b34268c829e5ae92076883d14202cf58
+	for s := 0; s < 3; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 186: t8 = x^0x1f75820b34268c829e5ae92076883d14202cf5b
+	t8.Mul(t7, t8)
+
+	// Step 189: t8 = x^0xfbac1059a1346414f2d74903b441e8a10167ad8
+	for s := 0; s < 3; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 190: t8 = x^0xfbac1059a1346414f2d74903b441e8a10167ad9
+	t8.Mul(&x, t8)
+
+	// Step 196: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb640
+	for s := 0; s < 6; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 197: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647
+	t8.Mul(t5, t8)
+
+	// Step 201: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6470
+	for s := 0; s < 4; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 202: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6471
+	t8.Mul(&x, t8)
+
+	// Step 209: t8 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23880
+	for s := 0; s < 7; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 210: t8 = x^0x1f75820b34268c829e5ae92076883d14202cf5b23881
+	t8.Mul(&x, t8)
+
+	// Step 215: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6471020
+	for s := 0; s < 5; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 216: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6471023
+	t8.Mul(t7, t8)
+
+	// Step 222: t8 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c0
+	for s := 0; s < 6; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 223: t8 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9
+	t8.Mul(t2, t8)
+
+	// Step 226: t8 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e204648
+	for s := 0; s < 3; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 227: t8 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d
+	t8.Mul(t1, t8)
+
+	// Step 231: t8 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d0
+	for s := 0; s < 4; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 232: t8 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d3
+	t8.Mul(t7, t8)
+
+	// Step 243: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb6471023269800
+	for s := 0; s < 11; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 244: t8 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980d
+	t8.Mul(t4, t8)
+
+	// Step 246: t8 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a6034
+	for s := 0; s < 2; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 247: t7 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a6037
+	t7.Mul(t7, t8)
+
+	// Step 255: t7 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a603700
+	for s := 0; s < 8; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 256: t7 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d
+	t7.Mul(t4, t7)
+
+	// Step 257: t7 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1a
+	t7.Square(t7)
+
+	// Step 258: t7 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b
+	t7.Mul(&x, t7)
+
+	// Step 267: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc3600
+	for s := 0; s < 9; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 268: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d
+	t7.Mul(t4, t7)
+
+	// Step 275: t7 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b0680
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 276: t7 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b0685
+	t7.Mul(t1, t7)
+
+	// Step 282: t7 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a140
+	for s := 0; s < 6; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 283: t7 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a149
+	t7.Mul(t2, t7)
+
+	// Step 288: t7 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d8342920
+	for s := 0; s < 5; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 289: t7 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d8342927
+	t7.Mul(t5, t7)
+
+	// Step 291: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49c
+	for s := 0; s < 2; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 292: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d
+	t7.Mul(&x, t7)
+
+	// Step 302: t7 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d8342927400
+	for s := 0; s < 10; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 303: t7 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429275ff
+	t7.Mul(t0, t7)
+
+	// Step 308: t7 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe0
+	for s := 0; s < 5; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 309: t7 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe7
+	t7.Mul(t5, t7)
+
+	// Step 314: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce0
+	for s := 0; s < 5; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 315: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce9
+	t7.Mul(t2, t7)
+
+	// Step 323: t7 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce900
+	for s := 0; s < 8; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 324: t6 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f
+	t6.Mul(t6, t7)
+
+	// Step 328: t6 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f0
+	for s := 0; s < 4; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 329: t5 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f7
+	t5.Mul(t5, t6)
+
+	// Step 334: t5 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2fee0
+	for s := 0; s < 5; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 335: t4 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed
+	t4.Mul(t4, t5)
+
+	// Step 339: t4 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed0
+	for s := 0; s < 4; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 340: t4 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed5
+	t4.Mul(t1, t4)
+
+	// Step 346: t4 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb540
+	for s := 0; s < 6; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 347: t4 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb541
+	t4.Mul(&x, t4)
+
+	// Step 354: t4 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429275ff3a5fddaa080
+	for s := 0; s < 7; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 355: t3 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429275ff3a5fddaa08b
+	t3.Mul(t3, t4)
+
+	// Step 377: t3 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f76a822c00000
+	for s := 0; s < 22; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 378: t3 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f76a822c00009
+	t3.Mul(t2, t3)
+
+	// Step 382: t3 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f76a822c000090
+	for s := 0; s < 4; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 383: t3 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f76a822c000099
+	t3.Mul(t2, t3)
+
+	// Step 388: t3 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed504580001320
+	for s := 0; s < 5; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 389: t2 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed504580001329
+	t2.Mul(t2, t3)
+
+	// Step 395: t2 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca40
+	for s := 0; s < 6; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 396: t1 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca45
+	t1.Mul(t1, t2)
+
+	// Step 409: t1 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f76a822c00009948a000
+	for s := 0; s < 13; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 410: t1 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f76a822c00009948a1ff
+	t1.Mul(t0, t1)
+
+	// Step 419: t1 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed504580001329143fe00
+	for s := 0; s < 9; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 420: t1 = x^0x7dd6082cd09a320a796ba481da20f45080b3d6c8e20464d301b86c1a1493aff9d2feed504580001329143ffff
+	t1.Mul(t0, t1)
+
+	// Step 429: t1 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429275ff3a5fddaa08b00002652287fffe00
+	for s := 0; s < 9; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 430: t1 = x^0xfbac1059a1346414f2d74903b441e8a10167ad91c408c9a60370d83429275ff3a5fddaa08b00002652287ffffff
+	t1.Mul(t0, t1)
+
+	// Step 439: t1 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca450ffffffe00
+	for s := 0; s < 9; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 440: t0 = x^0x1f75820b34268c829e5ae92076883d14202cf5b238811934c06e1b068524ebfe74bfbb5411600004ca450fffffffff
+	t0.Mul(t0, t1)
+
+	// Step 445: t0 = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f76a822c00009948a1ffffffffe0
+	for s := 0; s < 5; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 446: z = x^0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f76a822c00009948a1ffffffffff
+	z.Mul(z, t0)
+
+	return z
+}
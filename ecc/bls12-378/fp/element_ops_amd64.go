@@ -19,6 +19,21 @@
 
 package fp
 
+import (
+	"reflect"
+
+	"github.com/consensys/gnark-crypto/field/codepath"
+)
+
+// the multiplication is selected at init time, see supportAdx
+func init() {
+	path := "amd64 assembly (ADX, BMI2)"
+	if !supportAdx {
+		path = "amd64 assembly (without ADX)"
+	}
+	codepath.Register(reflect.TypeOf(Element{}).PkgPath(), path)
+}
+
 //go:noescape
 func MulBy3(x *Element)
 
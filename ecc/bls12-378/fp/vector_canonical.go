@@ -0,0 +1,199 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+var errCanonicalVectorLength = errors.New("vectors must have the same length")
+
+// CanonicalVector is a slice of Element stored in canonical form: the limbs of
+// each entry are the little endian words of its regular (non-Montgomery) representative,
+// as returned by Element.Bits.
+//
+// The methods of Element other than Add, Sub, Neg, Equal and IsZero assume the
+// Montgomery form and must not be used on the entries of a CanonicalVector.
+//
+// It is meant for data that mostly flows between serialization and multi-scalar
+// multiplications (which take regular form scalars with ScalarsMont set to false):
+// encoding and decoding a CanonicalVector don't convert the elements, and the
+// arithmetic below works on the canonical form directly.
+type CanonicalVector []Element
+
+// IntoCanonical converts vector to canonical form, in place, and returns it as a
+// CanonicalVector which shares its memory.
+func (vector Vector) IntoCanonical() CanonicalVector {
+	execute(len(vector), func(start, end int) {
+		for i := start; i < end; i++ {
+			vector[i].fromMont()
+		}
+	})
+	return CanonicalVector(vector)
+}
+
+// IntoMont converts vector to Montgomery form, in place, and returns it as a
+// Vector which shares its memory.
+func (vector CanonicalVector) IntoMont() Vector {
+	execute(len(vector), func(start, end int) {
+		for i := start; i < end; i++ {
+			vector[i].toMont()
+		}
+	})
+	return Vector(vector)
+}
+
+// Add sets vector to a + b, entry-wise, and returns it.
+//
+// The modular addition doesn't depend on the representation, so this costs the same
+// as on a Vector.
+func (vector CanonicalVector) Add(a, b CanonicalVector) CanonicalVector {
+	if len(a) != len(b) || len(a) != len(vector) {
+		panic(errCanonicalVectorLength)
+	}
+	for i := range vector {
+		vector[i].Add(&a[i], &b[i])
+	}
+	return vector
+}
+
+// Sub sets vector to a - b, entry-wise, and returns it.
+func (vector CanonicalVector) Sub(a, b CanonicalVector) CanonicalVector {
+	if len(a) != len(b) || len(a) != len(vector) {
+		panic(errCanonicalVectorLength)
+	}
+	for i := range vector {
+		vector[i].Sub(&a[i], &b[i])
+	}
+	return vector
+}
+
+// ScalarMul sets vector to b·a, entry-wise, and returns it; b is a regular
+// Element, in Montgomery form.
+//
+// The Montgomery product of a canonical and a Montgomery form element is the canonical
+// form of their product, so this costs a single multiplication per entry.
+func (vector CanonicalVector) ScalarMul(a CanonicalVector, b *Element) CanonicalVector {
+	if len(a) != len(vector) {
+		panic(errCanonicalVectorLength)
+	}
+	execute(len(vector), func(start, end int) {
+		for i := start; i < end; i++ {
+			vector[i].Mul(&a[i], b)
+		}
+	})
+	return vector
+}
+
+// Mul sets vector to the entry-wise product of a and b, and returns it.
+//
+// It costs two multiplications per entry, as one of the operands is moved to the
+// Montgomery form first; prefer ScalarMul or a Vector when the data allows it.
+func (vector CanonicalVector) Mul(a, b CanonicalVector) CanonicalVector {
+	if len(a) != len(b) || len(a) != len(vector) {
+		panic(errCanonicalVectorLength)
+	}
+	execute(len(vector), func(start, end int) {
+		var t Element
+		for i := start; i < end; i++ {
+			t.Mul(&b[i], &rSquare)
+			vector[i].Mul(&a[i], &t)
+		}
+	})
+	return vector
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler
+func (vector *CanonicalVector) MarshalBinary() (data []byte, err error) {
+	var buf bytes.Buffer
+
+	if _, err = vector.WriteTo(&buf); err != nil {
+		return
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (vector *CanonicalVector) UnmarshalBinary(data []byte) error {
+	r := bytes.NewReader(data)
+	_, err := vector.ReadFrom(r)
+	return err
+}
+
+// WriteTo implements io.WriterTo and writes the vector in the same format as
+// Vector.WriteTo: the length as a big endian uint32, followed by the big endian
+// encoding of the elements.
+func (vector *CanonicalVector) WriteTo(w io.Writer) (int64, error) {
+	buf := make([]byte, 4+len(*vector)*Bytes)
+	binary.BigEndian.PutUint32(buf[:4], uint32(len(*vector)))
+	for i := range *vector {
+		putCanonical(buf[4+i*Bytes:4+(i+1)*Bytes], &(*vector)[i])
+	}
+	n, err := w.Write(buf)
+	return int64(n), err
+}
+
+// ReadFrom implements io.ReaderFrom and reads a vector in the format of
+// Vector.WriteTo. The elements are checked to be smaller than the modulus, but
+// not converted.
+func (vector *CanonicalVector) ReadFrom(r io.Reader) (int64, error) {
+	var buf [4]byte
+	if read, err := io.ReadFull(r, buf[:]); err != nil {
+		return int64(read), err
+	}
+	sliceLen := binary.BigEndian.Uint32(buf[:])
+
+	n := int64(4)
+	(*vector) = make(CanonicalVector, sliceLen)
+	if sliceLen == 0 {
+		return n, nil
+	}
+
+	b := make([]byte, int(sliceLen)*Bytes)
+	read, err := io.ReadFull(r, b)
+	n += int64(read)
+	if err != nil {
+		return n, err
+	}
+	for i := range *vector {
+		z := &(*vector)[i]
+		bi := b[i*Bytes : (i+1)*Bytes]
+		z[0] = binary.BigEndian.Uint64(bi[40:48])
+		z[1] = binary.BigEndian.Uint64(bi[32:40])
+		z[2] = binary.BigEndian.Uint64(bi[24:32])
+		z[3] = binary.BigEndian.Uint64(bi[16:24])
+		z[4] = binary.BigEndian.Uint64(bi[8:16])
+		z[5] = binary.BigEndian.Uint64(bi[0:8])
+		if !z.smallerThanModulus() {
+			return n, errors.New("invalid fp.Element encoding")
+		}
+	}
+	return n, nil
+}
+
+// putCanonical writes the big endian encoding of the canonical form element z to b.
+func putCanonical(b []byte, z *Element) {
+	binary.BigEndian.PutUint64(b[40:48], z[0])
+	binary.BigEndian.PutUint64(b[32:40], z[1])
+	binary.BigEndian.PutUint64(b[24:32], z[2])
+	binary.BigEndian.PutUint64(b[16:24], z[3])
+	binary.BigEndian.PutUint64(b[8:16], z[4])
+	binary.BigEndian.PutUint64(b[0:8], z[5])
+}
@@ -15,5 +15,5 @@
 // Code generated by consensys/gnark-crypto DO NOT EDIT
 
 // Package iop provides an API to computations common
-// to iop backends (permutation, quotient).
+// to iop backends (permutation, quotient, univariate sumcheck).
 package iop
@@ -71,8 +71,7 @@ func evaluateXnMinusOneDomainBigCoset(domains [2]*fft.Domain) []fr.Element {
 
 	res := make([]fr.Element, ratio)
 
-	expo := big.NewInt(int64(domains[0].Cardinality))
-	res[0].Exp(domains[1].FrMultiplicativeGen, expo)
+	res[0] = shiftPower(domains[1], int(domains[0].Cardinality))
 
 	var t fr.Element
 	t.Exp(domains[1].Generator, big.NewInt(int64(domains[0].Cardinality)))
@@ -0,0 +1,228 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package iop
+
+import (
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr/fft"
+)
+
+// representationKey identifies a representation of a polynomial: its coefficients in
+// Canonical basis (domain is nil), or its evaluations on a domain (Lagrange basis) or on
+// the coset of a domain (LagrangeCoset basis).
+type representationKey struct {
+	domain *fft.Domain
+	basis  Basis
+}
+
+// KeepRepresentations enables the cache of the representations of p, and returns p.
+//
+// The conversions (ToCanonical, ToLagrange, ToLagrangeCoset) then keep a copy of the representation
+// they convert from, and restore the representation they convert to from the cache when it's there,
+// instead of running an FFT. For instance, a polynomial alternating between a commitment
+// (Canonical basis) and the evaluation of constraints (LagrangeCoset basis) is transformed once
+// in each direction. The restored representation has the layout the conversion would produce.
+// It costs a copy of the coefficients per cached representation.
+//
+// The cache is shared by the shallow clones of p. It is invalidated by Blind and ReadFrom, but not
+// when the coefficients are modified directly: call InvalidateRepresentations in this case.
+func (p *Polynomial) KeepRepresentations() *Polynomial {
+	p.keepRepresentations = true
+	return p
+}
+
+// InvalidateRepresentations clears the representations of p cached by the conversions
+// (see KeepRepresentations), EvaluateDomain and EvaluateCoset.
+func (p *Polynomial) InvalidateRepresentations() {
+	p.representations = nil
+}
+
+// cachedRepresentation returns the cached representation of p for key, in Regular layout, if any
+func (p *polynomial) cachedRepresentation(key representationKey) (*Polynomial, bool) {
+	r, ok := p.representations[key]
+	return r, ok
+}
+
+func (p *polynomial) cacheRepresentation(key representationKey, r *Polynomial) {
+	if p.representations == nil {
+		p.representations = make(map[representationKey]*Polynomial)
+	}
+	p.representations[key] = r
+}
+
+// convertFromCache is called by the conversions of p to the given basis, on d, when the
+// representations of p are kept: it caches the current representation of p, and sets p to the
+// cached representation in the target basis if there is one.
+func (p *Polynomial) convertFromCache(d *fft.Domain, basis Basis) bool {
+	if !p.keepRepresentations || p.Basis == basis {
+		return false
+	}
+
+	// cache the current representation
+	key := representationKey{basis: p.Basis}
+	if p.Basis != Canonical {
+		key.domain = d
+	}
+	if _, ok := p.cachedRepresentation(key); !ok {
+		p.cacheRepresentation(key, p.Clone().ToRegular())
+	}
+
+	// restore the target representation
+	key = representationKey{basis: basis}
+	if basis != Canonical {
+		key.domain = d
+	}
+	r, ok := p.cachedRepresentation(key)
+	if !ok {
+		return false
+	}
+	// conversions from or to Canonical basis flip the layout, the others keep it
+	layout := p.Layout
+	if p.Basis == Canonical || basis == Canonical {
+		layout = (Regular | BitReverse) ^ p.Layout
+	}
+	n := r.coefficients.Len()
+	if m := p.coefficients.Len(); m > n {
+		n = m
+	}
+	if int(d.Cardinality) > n {
+		n = int(d.Cardinality)
+	}
+	coeffs := (*p.coefficients)[:0]
+	coeffs = append(coeffs, *r.coefficients...)
+	coeffs = append(coeffs, make(fr.Vector, n-len(coeffs))...)
+	*p.coefficients = coeffs
+	p.Form = Form{Basis: basis, Layout: Regular}
+	if layout == BitReverse {
+		p.ToBitReverse()
+	}
+	return true
+}
+
+// EvaluateDomain returns the evaluations of p on the domain d, that is p in Lagrange basis,
+// in the requested layout. p is left unchanged.
+//
+// See EvaluateCoset.
+func (p *Polynomial) EvaluateDomain(d *fft.Domain, layout Layout) (*Polynomial, error) {
+	return p.evaluateOn(d, Lagrange, layout)
+}
+
+// EvaluateCoset returns the evaluations of p on the coset of the domain d, that is p in
+// LagrangeCoset basis, in the requested layout. p is left unchanged.
+//
+// Unlike ToLagrangeCoset, p can be in any form, and:
+//   - if p has few non-zero coefficients compared to the size of the domain (e.g. a selector of small
+//     degree), it is evaluated point by point instead of with an FFT;
+//   - the evaluations are cached in p (and shared by its shallow clones), so that later calls with the
+//     same domain don't recompute them. The cache is cleared by Blind and ReadFrom, but not when the
+//     coefficients are modified directly: call InvalidateRepresentations in this case.
+//
+// The result is a new polynomial, with the same size and blinded size as p; as in the conversions,
+// the shift of p is ignored.
+// If p is not in Canonical basis, it must be defined on d (it must have d.Cardinality coefficients).
+func (p *Polynomial) EvaluateCoset(d *fft.Domain, layout Layout) (*Polynomial, error) {
+	return p.evaluateOn(d, LagrangeCoset, layout)
+}
+
+func (p *Polynomial) evaluateOn(d *fft.Domain, basis Basis, layout Layout) (*Polynomial, error) {
+	if err := p.Validate(d); err != nil {
+		return nil, err
+	}
+	key := representationKey{domain: d, basis: basis}
+
+	e, ok := p.cachedRepresentation(key)
+	if !ok {
+		e = p.computeEvaluations(d, basis)
+		p.cacheRepresentation(key, e)
+	}
+
+	res := e.Clone()
+	res.size = p.size
+	res.blindedSize = p.blindedSize
+	if layout == BitReverse {
+		res.ToBitReverse()
+	}
+	return res, nil
+}
+
+// computeEvaluations returns the evaluations of p on d (basis == Lagrange) or on its coset
+// (basis == LagrangeCoset), in Regular layout.
+func (p *Polynomial) computeEvaluations(d *fft.Domain, basis Basis) *Polynomial {
+	n := int(d.Cardinality)
+
+	// already in the requested basis
+	if p.Basis == basis {
+		return p.Clone().ToRegular()
+	}
+
+	// canonical coefficients, in Regular layout
+	c := p.polynomial
+	if p.Basis != Canonical {
+		q := p.Clone()
+		q.ToCanonical(d)
+		c = q.polynomial
+	}
+	coeffs := *c.coefficients
+	get := func(i int) *fr.Element { return &coeffs[i] }
+	if c.Layout == BitReverse {
+		nn := uint64(64 - bits.TrailingZeros(uint(len(coeffs))))
+		get = func(i int) *fr.Element { return &coeffs[bits.Reverse64(uint64(i))>>nn] }
+	}
+
+	// number of coefficients, up to the last non-zero one
+	nbCoeffs := len(coeffs)
+	for nbCoeffs > 0 && get(nbCoeffs-1).IsZero() {
+		nbCoeffs--
+	}
+
+	res := make([]fr.Element, n)
+	if nbCoeffs <= bits.TrailingZeros(uint(n))/2 {
+		// evaluation point by point, n*nbCoeffs multiplications instead of (n/2)*log(n) for the FFT
+		var shift fr.Element
+		shift.SetOne()
+		if basis == LagrangeCoset {
+			shift.Set(&d.FrMultiplicativeGen)
+		}
+		parallel.Execute(n, func(start, end int) {
+			var x fr.Element
+			x.Exp(d.Generator, big.NewInt(int64(start))).Mul(&x, &shift)
+			for i := start; i < end; i++ {
+				for j := nbCoeffs - 1; j >= 0; j-- {
+					res[i].Mul(&res[i], &x).Add(&res[i], get(j))
+				}
+				x.Mul(&x, &d.Generator)
+			}
+		})
+	} else {
+		for i := 0; i < nbCoeffs; i++ {
+			res[i].Set(get(i))
+		}
+		if basis == LagrangeCoset {
+			d.FFT(res, fft.DIF, fft.OnCoset())
+		} else {
+			d.FFT(res, fft.DIF)
+		}
+		fft.BitReverse(res)
+	}
+
+	return NewPolynomial(&res, Form{Basis: basis, Layout: Regular})
+}
@@ -0,0 +1,99 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package iop
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr/fft"
+)
+
+// ErrFormMismatch is returned when the coefficients of a polynomial are not
+// consistent with its declared form.
+var ErrFormMismatch = errors.New("the coefficients are not consistent with the declared form of the polynomial")
+
+// Validate checks that p is a well formed polynomial on the domain d: its Form is one of the
+// supported Basis/Layout pairs, Size <= BlindedSize <= number of coefficients, and the number of
+// coefficients is d.Cardinality in Lagrange and LagrangeCoset bases, at most d.Cardinality in
+// Canonical basis.
+//
+// When the package is built with the iopdebug build tag, the conversions (ToLagrange, ToCanonical
+// and ToLagrangeCoset) validate their input and spot-check their output: p is evaluated at a random
+// point from its declared form before and after the conversion (with the barycentric formula in
+// Lagrange bases), and they panic on a mismatch.
+func (p *Polynomial) Validate(d *fft.Domain) error {
+	switch p.Form {
+	case canonicalRegular, canonicalBitReverse,
+		lagrangeRegular, lagrangeBitReverse,
+		lagrangeCosetRegular, lagrangeCosetBitReverse:
+	default:
+		return ErrFormMismatch
+	}
+	n := p.coefficients.Len()
+	if p.size > p.blindedSize || p.blindedSize > n {
+		return ErrInconsistentSize
+	}
+	if p.Basis == Canonical {
+		if d != nil && uint64(n) > d.Cardinality {
+			return ErrInconsistentSizeDomain
+		}
+	} else if d == nil || uint64(n) != d.Cardinality {
+		return ErrInconsistentSizeDomain
+	}
+	return nil
+}
+
+// evaluateForm evaluates the polynomial held by p at z, in its declared form:
+// with Horner's rule in Canonical basis, with the barycentric formula on d (or its coset)
+// in Lagrange and LagrangeCoset bases. The shift of p is ignored.
+func (p *Polynomial) evaluateForm(d *fft.Domain, z fr.Element) fr.Element {
+	if p.Basis == Canonical {
+		return p.polynomial.evaluate(z)
+	}
+
+	if p.Basis == LagrangeCoset {
+		z.Mul(&z, &d.FrMultiplicativeGenInv)
+	}
+	return p.polynomial.evaluateLagrange(z, d)
+}
+
+// debugCheckpoint validates p and returns a random point and the evaluation of p at this point,
+// to be compared with debugCheck after a conversion. It is a no-op unless the package is built
+// with the iopdebug build tag.
+func debugCheckpoint(p *Polynomial, d *fft.Domain) (z, v fr.Element) {
+	if !debug {
+		return
+	}
+	if err := p.Validate(d); err != nil {
+		panic(fmt.Sprintf("iop: invalid polynomial (form %v, size %d, blinded size %d): %v", p.Form, p.size, p.blindedSize, err))
+	}
+	z.SetRandom()
+	v = p.evaluateForm(d, z)
+	return
+}
+
+// debugCheck panics if the evaluation of p at z differs from v, see debugCheckpoint.
+func debugCheck(p *Polynomial, d *fft.Domain, z, v fr.Element) {
+	if !debug {
+		return
+	}
+	if w := p.evaluateForm(d, z); !w.Equal(&v) {
+		panic(fmt.Sprintf("iop: the conversion to form %v doesn't preserve the polynomial: %v", p.Form, ErrFormMismatch))
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package pcs defines a common interface for univariate polynomial commitment schemes
+// over fr, so that protocols can be written once and run with any of them.
+//
+// The commitments and proofs are opaque: their concrete types are those of the scheme, and
+// a scheme rejects the ones produced by another scheme. The setup of a scheme (SRS, domain,
+// hash function...) is specific to it and held by the value implementing Scheme; see for
+// instance kzg.Scheme.
+package pcs
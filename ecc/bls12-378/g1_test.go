@@ -17,6 +17,7 @@
 package bls12378
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"math/rand"
@@ -53,6 +54,21 @@ func TestG1AffineEndomorphism(t *testing.T) {
 		GenFp(),
 	))
 
+	properties.Property("[BLS12-378] check that Phi matches phi in affine and Jacobian coordinates", prop.ForAll(
+		func(a fp.Element) bool {
+			var p, res1, res2 G1Jac
+			var res3 G1Affine
+			g := MapToG1(a)
+			p.FromAffine(&g)
+			res1.phi(&p)
+			res2.Phi(&p)
+			res3.Phi(&g)
+
+			return res1.Equal(&res2) && res2.Equal(new(G1Jac).FromAffine(&res3))
+		},
+		GenFp(),
+	))
+
 	properties.Property("[BLS12-378] check that phi^2(P) + phi(P) + P = 0", prop.ForAll(
 		func(a fp.Element) bool {
 			var p, res, tmp G1Jac
@@ -221,15 +237,18 @@ func TestG1AffineConversions(t *testing.T) {
 		GenFp(),
 		GenFp(),
 	))
+
 	properties.Property("[BLS12-378] BatchJacobianToAffineG1 and FromJacobian should output the same result", prop.ForAll(
 		func(a, b fp.Element) bool {
 			g1 := fuzzG1Jac(&g1Gen, a)
 			g2 := fuzzG1Jac(&g1Gen, b)
+			var inf G1Jac
+			inf.Set(&g1Infinity)
 			var op1, op2 G1Affine
 			op1.FromJacobian(&g1)
 			op2.FromJacobian(&g2)
-			baseTableAff := BatchJacobianToAffineG1([]G1Jac{g1, g2})
-			return op1.Equal(&baseTableAff[0]) && op2.Equal(&baseTableAff[1])
+			baseTableAff := BatchJacobianToAffineG1([]G1Jac{g1, inf, g2})
+			return op1.Equal(&baseTableAff[0]) && baseTableAff[1].IsInfinity() && op2.Equal(&baseTableAff[2])
 		},
 		GenFp(),
 		GenFp(),
@@ -425,6 +444,28 @@ func TestG1AffineOps(t *testing.T) {
 		genScalar,
 	))
 
+	properties.Property("[BLS12-378] ScalarMultiplicationBlinded and ScalarMultiplication should output the same result", prop.ForAll(
+		func(s fr.Element) bool {
+
+			var r big.Int
+			var op1, op3 G1Jac
+			var op2 G1Affine
+			s.BigInt(&r)
+			op1.ScalarMultiplication(&g1Gen, &r)
+			if _, err := op2.ScalarMultiplicationBlinded(&g1GenAff, &r); err != nil {
+				return false
+			}
+			// negative scalar
+			r.Neg(&r)
+			if _, err := op3.ScalarMultiplicationBlinded(&g1Gen, &r); err != nil {
+				return false
+			}
+			op3.AddAssign(&op1)
+			return op1.Equal(new(G1Jac).FromAffine(&op2)) && op3.Z.IsZero()
+
+		},
+		genScalar,
+	))
 	properties.Property("[BLS12-378] JointScalarMultiplicationBase and ScalarMultiplication should output the same results", prop.ForAll(
 		func(s1, s2 fr.Element) bool {
 
@@ -537,6 +578,37 @@ func TestG1AffineBatchScalarMultiplication(t *testing.T) {
 // ------------------------------------------------------------
 // benches
 
+func TestG1AffineFormat(t *testing.T) {
+	t.Parallel()
+
+	var s big.Int
+	s.SetUint64(rand.Uint64()) //#nosec G404 -- This is a false positive
+	var p G1Affine
+	p.ScalarMultiplication(&g1GenAff, &s)
+
+	if fmt.Sprintf("%v", &p) != p.String() || fmt.Sprintf("%+v", &p) != p.Dump() {
+		t.Fatal("fmt must print String and Dump")
+	}
+	raw := p.RawBytes()
+	if fmt.Sprintf("%#x", &p) != "0x"+hex.EncodeToString(raw[:]) {
+		t.Fatal("fmt must print the hexadecimal of RawBytes")
+	}
+
+	var q G1Affine
+	if _, err := q.SetText(fmt.Sprintf("%#x", &p)); err != nil {
+		t.Fatal(err)
+	}
+	if !q.Equal(&p) {
+		t.Fatal("SetText doesn't parse back the hexadecimal")
+	}
+	if _, err := q.SetText(fmt.Sprintf("%x", &p) + "00"); err == nil {
+		t.Fatal("trailing bytes must be rejected")
+	}
+	if _, err := q.SetText("not hex"); err == nil || !q.Equal(&p) {
+		t.Fatal("invalid text must be rejected, leaving the point unchanged")
+	}
+}
+
 func BenchmarkG1JacIsInSubGroup(b *testing.B) {
 	var a G1Jac
 	a.Set(&g1Gen)
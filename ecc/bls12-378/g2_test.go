@@ -17,11 +17,13 @@
 package bls12378
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"math/rand"
 	"testing"
 
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fp"
 	"github.com/consensys/gnark-crypto/ecc/bls12-378/internal/fptower"
 
 	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
@@ -53,6 +55,21 @@ func TestG2AffineEndomorphism(t *testing.T) {
 		GenE2(),
 	))
 
+	properties.Property("[BLS12-378] check that Phi matches phi in affine and Jacobian coordinates", prop.ForAll(
+		func(a fptower.E2) bool {
+			var p, res1, res2 G2Jac
+			var res3 G2Affine
+			g := MapToG2(a)
+			p.FromAffine(&g)
+			res1.phi(&p)
+			res2.Phi(&p)
+			res3.Phi(&g)
+
+			return res1.Equal(&res2) && res2.Equal(new(G2Jac).FromAffine(&res3))
+		},
+		GenE2(),
+	))
+
 	properties.Property("[BLS12-378] check that phi^2(P) + phi(P) + P = 0", prop.ForAll(
 		func(a fptower.E2) bool {
 			var p, res, tmp G2Jac
@@ -68,6 +85,21 @@ func TestG2AffineEndomorphism(t *testing.T) {
 		GenE2(),
 	))
 
+	properties.Property("[BLS12-378] check that Psi(P) = p * P", prop.ForAll(
+		func(a fptower.E2) bool {
+			var p, res1, res2 G2Jac
+			var res3 G2Affine
+			g := MapToG2(a)
+			p.FromAffine(&g)
+			res1.Psi(&p)
+			res2.mulWindowed(&p, fp.Modulus())
+			res3.Psi(&g)
+
+			return p.IsInSubGroup() && res1.Equal(&res2) && res1.Equal(new(G2Jac).FromAffine(&res3))
+		},
+		GenE2(),
+	))
+
 	properties.Property("[BLS12-378] check that psi^2(P) = -phi(P)", prop.ForAll(
 		func(a fptower.E2) bool {
 			var p, res1, res2 G2Jac
@@ -236,6 +268,22 @@ func TestG2AffineConversions(t *testing.T) {
 		GenE2(),
 	))
 
+	properties.Property("[BLS12-378] BatchJacobianToAffineG2 and FromJacobian should output the same result", prop.ForAll(
+		func(a, b fptower.E2) bool {
+			g1 := fuzzG2Jac(&g2Gen, a)
+			g2 := fuzzG2Jac(&g2Gen, b)
+			var inf G2Jac
+			inf.Set(&g2Infinity)
+			var op1, op2 G2Affine
+			op1.FromJacobian(&g1)
+			op2.FromJacobian(&g2)
+			baseTableAff := BatchJacobianToAffineG2([]G2Jac{g1, inf, g2})
+			return op1.Equal(&baseTableAff[0]) && baseTableAff[1].IsInfinity() && op2.Equal(&baseTableAff[2])
+		},
+		GenE2(),
+		GenE2(),
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
@@ -434,6 +482,29 @@ func TestG2AffineOps(t *testing.T) {
 		genScalar,
 	))
 
+	properties.Property("[BLS12-378] ScalarMultiplicationBlinded and ScalarMultiplication should output the same result", prop.ForAll(
+		func(s fr.Element) bool {
+
+			var r big.Int
+			var op1, op3 G2Jac
+			var op2 G2Affine
+			s.BigInt(&r)
+			op1.ScalarMultiplication(&g2Gen, &r)
+			if _, err := op2.ScalarMultiplicationBlinded(&g2GenAff, &r); err != nil {
+				return false
+			}
+			// negative scalar
+			r.Neg(&r)
+			if _, err := op3.ScalarMultiplicationBlinded(&g2Gen, &r); err != nil {
+				return false
+			}
+			op3.AddAssign(&op1)
+			return op1.Equal(new(G2Jac).FromAffine(&op2)) && op3.Z.IsZero()
+
+		},
+		genScalar,
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
@@ -469,6 +540,19 @@ func TestG2AffineCofactorCleaning(t *testing.T) {
 			return point.IsOnCurve() && pointCleared.IsInSubGroup() && !pointCleared.Equal(&infinity)
 		},
 	))
+
+	properties.Property("[BLS12-378] mulBySeed should match the scalar multiplication by the seed", prop.ForAll(
+		func(a fptower.E2) bool {
+			var p, res1, res2 G2Jac
+			g := MapToG2(a)
+			p.FromAffine(&g)
+			res1.mulBySeed(&p)
+			res2.mulWindowed(&p, &xGen)
+
+			return res1.Equal(&res2)
+		},
+		GenE2(),
+	))
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 
 }
@@ -526,6 +610,37 @@ func TestG2AffineBatchScalarMultiplication(t *testing.T) {
 // ------------------------------------------------------------
 // benches
 
+func TestG2AffineFormat(t *testing.T) {
+	t.Parallel()
+
+	var s big.Int
+	s.SetUint64(rand.Uint64()) //#nosec G404 -- This is a false positive
+	var p G2Affine
+	p.ScalarMultiplication(&g2GenAff, &s)
+
+	if fmt.Sprintf("%v", &p) != p.String() || fmt.Sprintf("%+v", &p) != p.Dump() {
+		t.Fatal("fmt must print String and Dump")
+	}
+	raw := p.RawBytes()
+	if fmt.Sprintf("%#x", &p) != "0x"+hex.EncodeToString(raw[:]) {
+		t.Fatal("fmt must print the hexadecimal of RawBytes")
+	}
+
+	var q G2Affine
+	if _, err := q.SetText(fmt.Sprintf("%#x", &p)); err != nil {
+		t.Fatal(err)
+	}
+	if !q.Equal(&p) {
+		t.Fatal("SetText doesn't parse back the hexadecimal")
+	}
+	if _, err := q.SetText(fmt.Sprintf("%x", &p) + "00"); err == nil {
+		t.Fatal("trailing bytes must be rejected")
+	}
+	if _, err := q.SetText("not hex"); err == nil || !q.Equal(&p) {
+		t.Fatal("invalid text must be rejected, leaving the point unchanged")
+	}
+}
+
 func BenchmarkG2JacIsInSubGroup(b *testing.B) {
 	var a G2Jac
 	a.Set(&g2Gen)
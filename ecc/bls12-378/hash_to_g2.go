@@ -124,12 +124,13 @@ func HashToG2(msg, dst []byte) (G2Affine, error) {
 	u0.A1.Set(&u[1])
 	u1.A0.Set(&u[2])
 	u1.A1.Set(&u[3])
-	Q0 := MapToG2(u0)
-	Q1 := MapToG2(u1)
+	Q0 := MapToCurve2(u0)
+	Q1 := MapToCurve2(u1)
 	var _Q0, _Q1, _res G2Jac
 	_Q0.FromAffine(&Q0)
 	_Q1.FromAffine(&Q1)
-	_res.Set(&_Q1).AddAssign(&_Q0)
+	// the cofactor clearing is linear: clear it once on the sum
+	_res.Set(&_Q1).AddAssign(&_Q0).ClearCofactor(&_res)
 	res.FromJacobian(&_res)
 	return res, nil
 }
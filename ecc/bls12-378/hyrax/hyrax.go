@@ -0,0 +1,385 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package hyrax
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash"
+	"math/big"
+	"math/bits"
+	"strconv"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	curve "github.com/consensys/gnark-crypto/ecc/bls12-378"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr/polynomial"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrInvalidNbEvaluations = errors.New("the number of evaluations must be a power of two")
+	ErrKeyTooSmall          = errors.New("the key doesn't have enough generators for the polynomial")
+	ErrInvalidPoint         = errors.New("the number of coordinates of the point doesn't match the polynomial")
+	ErrInvalidProof         = errors.New("the opening proof doesn't have the expected size")
+	ErrVerifyOpeningProof   = errors.New("can't verify opening proof")
+	errZeroChallenge        = errors.New("the challenge of the inner product argument is zero")
+)
+
+// generatorsDST is the domain separation tag used to derive the generators of the keys
+const generatorsDST = "HYRAX_GENERATORS_BLS12_378"
+
+// Key holds the generators of the Pedersen commitments of the rows, and the generator U binding
+// the inner products in the opening proofs.
+type Key struct {
+	G []curve.G1Affine
+	U curve.G1Affine
+}
+
+// Commitment to a multilinear polynomial: the Pedersen commitments of the rows of its evaluations
+type Commitment []curve.G1Affine
+
+// OpeningProof proves the evaluation of a multilinear polynomial at a point
+type OpeningProof struct {
+	// L and R are the cross terms of the rounds of the inner product argument
+	L, R []curve.G1Affine
+
+	// A is the combined row once fully folded
+	A fr.Element
+
+	// ClaimedValue is the value of the polynomial at the point
+	ClaimedValue fr.Element
+}
+
+// NewKey returns a key to commit to multilinear polynomials in up to nbVars variables.
+//
+// The generators are hashed to the curve (HashToG1) from their index, so that nobody knows
+// their discrete logarithms; keys of different sizes share their first generators.
+func NewKey(nbVars int) (Key, error) {
+	_, nbCols := dimensions(nbVars)
+	msgs := make([][]byte, nbCols+1)
+	for i := 0; i < nbCols; i++ {
+		msgs[i] = binary.BigEndian.AppendUint64(nil, uint64(i))
+	}
+	msgs[nbCols] = []byte("U")
+
+	points, err := curve.BatchHashToG1(msgs, []byte(generatorsDST))
+	if err != nil {
+		return Key{}, err
+	}
+	return Key{G: points[:nbCols], U: points[nbCols]}, nil
+}
+
+// dimensions returns the number of rows and columns of the matrix of the evaluations of a
+// polynomial in nbVars variables: 2^⌊nbVars/2⌋ rows and 2^⌈nbVars/2⌉ columns.
+func dimensions(nbVars int) (nbRows, nbCols int) {
+	return 1 << (nbVars / 2), 1 << (nbVars - nbVars/2)
+}
+
+// Commit commits to the multilinear polynomial given by its evaluations on the boolean
+// hypercube {0,1}ⁿ, where the first variable is the most significant bit of the index (as
+// polynomial.MultiLin).
+func Commit(evaluations []fr.Element, key Key) (Commitment, error) {
+	n := len(evaluations)
+	if n == 0 || n&(n-1) != 0 {
+		return nil, ErrInvalidNbEvaluations
+	}
+	nbRows, nbCols := dimensions(bits.TrailingZeros(uint(n)))
+	if nbCols > len(key.G) {
+		return nil, ErrKeyTooSmall
+	}
+
+	res := make(Commitment, nbRows)
+	for i := range res {
+		if _, err := res[i].MultiExp(key.G[:nbCols], evaluations[i*nbCols:(i+1)*nbCols], ecc.MultiExpConfig{}); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// Open computes an opening proof of the polynomial given by its evaluations at point, along with
+// the value of the polynomial at point.
+//
+// The commitment must be the commitment to the evaluations; it is bound to the Fiat-Shamir
+// challenges, computed with hf.
+func Open(evaluations []fr.Element, point []fr.Element, commitment Commitment, key Key, hf hash.Hash) (OpeningProof, error) {
+	n := len(evaluations)
+	if n == 0 || n&(n-1) != 0 {
+		return OpeningProof{}, ErrInvalidNbEvaluations
+	}
+	if len(point) != bits.TrailingZeros(uint(n)) {
+		return OpeningProof{}, ErrInvalidPoint
+	}
+	nbRows, nbCols := dimensions(len(point))
+	if nbCols > len(key.G) {
+		return OpeningProof{}, ErrKeyTooSmall
+	}
+	if len(commitment) != nbRows {
+		return OpeningProof{}, ErrInvalidPoint
+	}
+	nbRounds := bits.TrailingZeros(uint(nbCols))
+	zL, zR := point[:len(point)/2], point[len(point)/2:]
+
+	// a = eq(z_L)ᵀ⋅M, the combination of the rows
+	eqL := polynomial.EqVector(zL)
+	a := make([]fr.Element, nbCols)
+	parallel.Execute(nbCols, func(start, end int) {
+		var tmp fr.Element
+		for i := 0; i < nbRows; i++ {
+			for j := start; j < end; j++ {
+				tmp.Mul(&eqL[i], &evaluations[i*nbCols+j])
+				a[j].Add(&a[j], &tmp)
+			}
+		}
+	})
+	b := polynomial.EqVector(zR)
+
+	var proof OpeningProof
+	proof.ClaimedValue = innerProduct(a, b)
+
+	fs := newTranscript(hf, nbRounds)
+	x, err := deriveX(fs, commitment, point, &proof.ClaimedValue)
+	if err != nil {
+		return OpeningProof{}, err
+	}
+	var uPrime curve.G1Affine
+	uPrime.ScalarMultiplication(&key.U, x.BigInt(new(big.Int)))
+
+	// inner product argument: the prover shows the knowledge of a such that
+	// <a, G> = ∑ eq(z_L)ᵢ⋅Commitmentᵢ and <a, b> = ClaimedValue
+	g := make([]curve.G1Affine, nbCols)
+	copy(g, key.G[:nbCols])
+	proof.L = make([]curve.G1Affine, nbRounds)
+	proof.R = make([]curve.G1Affine, nbRounds)
+	for j := 0; j < nbRounds; j++ {
+		m := len(a) / 2
+		aLo, aHi := a[:m], a[m:]
+		bLo, bHi := b[:m], b[m:]
+		gLo, gHi := g[:m], g[m:]
+
+		// L = <a_lo, G_hi> + <a_lo, b_hi>⋅U', R = <a_hi, G_lo> + <a_hi, b_lo>⋅U'
+		cL, cR := innerProduct(aLo, bHi), innerProduct(aHi, bLo)
+		if err := crossTerm(&proof.L[j], gHi, aLo, &uPrime, &cL); err != nil {
+			return OpeningProof{}, err
+		}
+		if err := crossTerm(&proof.R[j], gLo, aHi, &uPrime, &cR); err != nil {
+			return OpeningProof{}, err
+		}
+
+		u, err := deriveU(fs, j, &proof.L[j], &proof.R[j])
+		if err != nil {
+			return OpeningProof{}, err
+		}
+		var uInv fr.Element
+		uInv.Inverse(&u)
+
+		// a' = u⋅a_lo + u⁻¹⋅a_hi, b' = u⁻¹⋅b_lo + u⋅b_hi, G' = u⁻¹⋅G_lo + u⋅G_hi
+		var bu, buInv big.Int
+		u.BigInt(&bu)
+		uInv.BigInt(&buInv)
+		parallel.Execute(m, func(start, end int) {
+			var tmp fr.Element
+			var gLoJac, gHiJac curve.G1Jac
+			for i := start; i < end; i++ {
+				aLo[i].Mul(&aLo[i], &u)
+				tmp.Mul(&aHi[i], &uInv)
+				aLo[i].Add(&aLo[i], &tmp)
+
+				bLo[i].Mul(&bLo[i], &uInv)
+				tmp.Mul(&bHi[i], &u)
+				bLo[i].Add(&bLo[i], &tmp)
+
+				gLoJac.FromAffine(&gLo[i])
+				gLoJac.ScalarMultiplication(&gLoJac, &buInv)
+				gHiJac.FromAffine(&gHi[i])
+				gHiJac.ScalarMultiplication(&gHiJac, &bu)
+				gLoJac.AddAssign(&gHiJac)
+				gLo[i].FromJacobian(&gLoJac)
+			}
+		})
+		a, b, g = aLo, bLo, gLo
+	}
+	proof.A = a[0]
+
+	return proof, nil
+}
+
+// Verify verifies that proof is a valid opening proof at point of the polynomial committed to
+// in commitment, for the value proof.ClaimedValue.
+//
+// The inner product argument is checked with a single multi-scalar multiplication of the row
+// commitments, the cross terms and the generators.
+func Verify(commitment Commitment, proof *OpeningProof, point []fr.Element, key Key, hf hash.Hash) error {
+	nbRows, nbCols := dimensions(len(point))
+	if nbCols > len(key.G) {
+		return ErrKeyTooSmall
+	}
+	if len(commitment) != nbRows {
+		return ErrInvalidPoint
+	}
+	nbRounds := bits.TrailingZeros(uint(nbCols))
+	if len(proof.L) != nbRounds || len(proof.R) != nbRounds {
+		return ErrInvalidProof
+	}
+	zL, zR := point[:len(point)/2], point[len(point)/2:]
+
+	fs := newTranscript(hf, nbRounds)
+	x, err := deriveX(fs, commitment, point, &proof.ClaimedValue)
+	if err != nil {
+		return err
+	}
+	u := make([]fr.Element, nbRounds)
+	for j := range u {
+		if u[j], err = deriveU(fs, j, &proof.L[j], &proof.R[j]); err != nil {
+			return err
+		}
+	}
+	uInv := fr.BatchInvert(u)
+
+	// the folded generator is <s, G> and the folded b is <s, eq(z_R)>, where
+	// sᵢ = ∏ⱼ u_j^{±1}, with the sign given by the j-th most significant bit of i
+	s := []fr.Element{fr.One()}
+	var bFinal fr.Element
+	bFinal.SetOne()
+	for j := range u {
+		next := make([]fr.Element, 2*len(s))
+		for i := range s {
+			next[2*i].Mul(&s[i], &uInv[j])
+			next[2*i+1].Mul(&s[i], &u[j])
+		}
+		s = next
+
+		// u_j⁻¹⋅(1 - z_j) + u_j⋅z_j
+		var t, tmp fr.Element
+		t.SetOne().Sub(&t, &zR[j]).Mul(&t, &uInv[j])
+		tmp.Mul(&u[j], &zR[j])
+		t.Add(&t, &tmp)
+		bFinal.Mul(&bFinal, &t)
+	}
+
+	// ∑ eq(z_L)ᵢ⋅Commitmentᵢ + ∑ u_j²⋅L_j + ∑ u_j⁻²⋅R_j - A⋅<s, G> + x⋅(ClaimedValue - A⋅b)⋅U = 0
+	points := make([]curve.G1Affine, 0, nbRows+2*nbRounds+nbCols+1)
+	scalars := make([]fr.Element, 0, cap(points))
+	points = append(points, commitment...)
+	scalars = append(scalars, polynomial.EqVector(zL)...)
+	for j := range u {
+		var u2, uInv2 fr.Element
+		u2.Square(&u[j])
+		uInv2.Square(&uInv[j])
+		points = append(points, proof.L[j], proof.R[j])
+		scalars = append(scalars, u2, uInv2)
+	}
+	points = append(points, key.G[:nbCols]...)
+	for i := range s {
+		s[i].Mul(&s[i], &proof.A).Neg(&s[i])
+	}
+	scalars = append(scalars, s...)
+	var cU fr.Element
+	cU.Mul(&proof.A, &bFinal)
+	cU.Sub(&proof.ClaimedValue, &cU).Mul(&cU, &x)
+	points = append(points, key.U)
+	scalars = append(scalars, cU)
+
+	var res curve.G1Jac
+	if _, err := res.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+	if !res.Z.IsZero() {
+		return ErrVerifyOpeningProof
+	}
+	return nil
+}
+
+// innerProduct returns <a, b>
+func innerProduct(a, b []fr.Element) fr.Element {
+	var res, tmp fr.Element
+	for i := range a {
+		tmp.Mul(&a[i], &b[i])
+		res.Add(&res, &tmp)
+	}
+	return res
+}
+
+// crossTerm sets res to <scalars, points> + c⋅u
+func crossTerm(res *curve.G1Affine, points []curve.G1Affine, scalars []fr.Element, u *curve.G1Affine, c *fr.Element) error {
+	_points := make([]curve.G1Affine, len(points)+1)
+	_scalars := make([]fr.Element, len(scalars)+1)
+	copy(_points, points)
+	copy(_scalars, scalars)
+	_points[len(points)] = *u
+	_scalars[len(scalars)] = *c
+	_, err := res.MultiExp(_points, _scalars, ecc.MultiExpConfig{})
+	return err
+}
+
+// newTranscript returns the Fiat-Shamir transcript of an opening proof with nbRounds rounds
+func newTranscript(hf hash.Hash, nbRounds int) *fiatshamir.Transcript {
+	ids := make([]string, nbRounds+1)
+	ids[0] = "x"
+	for j := 0; j < nbRounds; j++ {
+		ids[j+1] = "u" + strconv.Itoa(j)
+	}
+	return fiatshamir.NewTranscript(hf, ids...)
+}
+
+// deriveX derives the challenge x, bound to the commitment, the point and the claimed value,
+// that randomizes the generator U of the inner product argument
+func deriveX(fs *fiatshamir.Transcript, commitment Commitment, point []fr.Element, claimedValue *fr.Element) (fr.Element, error) {
+	for i := range commitment {
+		b := commitment[i].RawBytes()
+		if err := fs.Bind("x", b[:]); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	for i := range point {
+		if err := fs.Bind("x", point[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	if err := fs.Bind("x", claimedValue.Marshal()); err != nil {
+		return fr.Element{}, err
+	}
+	return computeChallenge(fs, "x")
+}
+
+// deriveU derives the challenge of the j-th round of the inner product argument
+func deriveU(fs *fiatshamir.Transcript, j int, L, R *curve.G1Affine) (fr.Element, error) {
+	id := "u" + strconv.Itoa(j)
+	bL, bR := L.RawBytes(), R.RawBytes()
+	if err := fs.Bind(id, bL[:]); err != nil {
+		return fr.Element{}, err
+	}
+	if err := fs.Bind(id, bR[:]); err != nil {
+		return fr.Element{}, err
+	}
+	return computeChallenge(fs, id)
+}
+
+func computeChallenge(fs *fiatshamir.Transcript, id string) (fr.Element, error) {
+	b, err := fs.ComputeChallenge(id)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var res fr.Element
+	res.SetBytes(b)
+	if res.IsZero() {
+		return fr.Element{}, errZeroChallenge
+	}
+	return res, nil
+}
@@ -0,0 +1,167 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package hyrax
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+)
+
+// evaluate evaluates the multilinear polynomial given by its evaluations on the hypercube at
+// point, folding the first variable first
+func evaluate(evaluations []fr.Element, point []fr.Element) fr.Element {
+	e := make([]fr.Element, len(evaluations))
+	copy(e, evaluations)
+	for _, r := range point {
+		m := len(e) / 2
+		for i := 0; i < m; i++ {
+			var tmp fr.Element
+			tmp.Sub(&e[i+m], &e[i]).Mul(&tmp, &r)
+			e[i].Add(&e[i], &tmp)
+		}
+		e = e[:m]
+	}
+	return e[0]
+}
+
+func TestOpening(t *testing.T) {
+	t.Parallel()
+
+	key, err := NewKey(7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for nbVars := 0; nbVars <= 7; nbVars++ {
+		evaluations := make(fr.Vector, 1<<nbVars).MustSetRandom()
+		point := make(fr.Vector, nbVars).MustSetRandom()
+
+		commitment, err := Commit(evaluations, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		proof, err := Open(evaluations, point, commitment, key, sha256.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := evaluate(evaluations, point); !proof.ClaimedValue.Equal(&expected) {
+			t.Fatal("wrong claimed value")
+		}
+		if err := Verify(commitment, &proof, point, key, sha256.New()); err != nil {
+			t.Fatal(err)
+		}
+
+		// wrong claimed value
+		one := fr.One()
+		wrongProof := proof
+		wrongProof.ClaimedValue.Add(&wrongProof.ClaimedValue, &one)
+		if err := Verify(commitment, &wrongProof, point, key, sha256.New()); err != ErrVerifyOpeningProof {
+			t.Fatal("verifying a wrong claimed value should fail")
+		}
+
+		// wrong final value
+		wrongProof = proof
+		wrongProof.A.SetRandom()
+		if err := Verify(commitment, &wrongProof, point, key, sha256.New()); err != ErrVerifyOpeningProof {
+			t.Fatal("verifying a wrong folded value should fail")
+		}
+
+		// wrong commitment
+		wrongCommitment := make(Commitment, len(commitment))
+		copy(wrongCommitment, commitment)
+		wrongCommitment[0].Double(&wrongCommitment[0])
+		if err := Verify(wrongCommitment, &proof, point, key, sha256.New()); err != ErrVerifyOpeningProof {
+			t.Fatal("verifying against a wrong commitment should fail")
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	t.Parallel()
+
+	key, err := NewKey(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Commit(make(fr.Vector, 3).MustSetRandom(), key); err != ErrInvalidNbEvaluations {
+		t.Fatal("expected ErrInvalidNbEvaluations, got", err)
+	}
+	if _, err := Commit(make(fr.Vector, 1<<5).MustSetRandom(), key); err != ErrKeyTooSmall {
+		t.Fatal("expected ErrKeyTooSmall, got", err)
+	}
+
+	evaluations := make(fr.Vector, 1<<4).MustSetRandom()
+	commitment, err := Commit(evaluations, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Open(evaluations, make(fr.Vector, 3).MustSetRandom(), commitment, key, sha256.New()); err != ErrInvalidPoint {
+		t.Fatal("expected ErrInvalidPoint, got", err)
+	}
+	proof, err := Open(evaluations, make(fr.Vector, 4).MustSetRandom(), commitment, key, sha256.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof.L = proof.L[1:]
+	if err := Verify(commitment, &proof, make(fr.Vector, 4).MustSetRandom(), key, sha256.New()); err != ErrInvalidProof {
+		t.Fatal("expected ErrInvalidProof, got", err)
+	}
+
+	// keys of different sizes share their first generators
+	small, err := NewKey(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range small.G {
+		if !small.G[i].Equal(&key.G[i]) {
+			t.Fatal("the generators should not depend on the size of the key")
+		}
+	}
+	if !small.U.Equal(&key.U) {
+		t.Fatal("U should not depend on the size of the key")
+	}
+}
+
+func BenchmarkOpening(b *testing.B) {
+	const nbVars = 12
+	key, err := NewKey(nbVars)
+	if err != nil {
+		b.Fatal(err)
+	}
+	evaluations := make(fr.Vector, 1<<nbVars).MustSetRandom()
+	point := make(fr.Vector, nbVars).MustSetRandom()
+	commitment, _ := Commit(evaluations, key)
+	proof, _ := Open(evaluations, point, commitment, key, sha256.New())
+
+	b.Run("Commit", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = Commit(evaluations, key)
+		}
+	})
+	b.Run("Open", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = Open(evaluations, point, commitment, key, sha256.New())
+		}
+	})
+	b.Run("Verify", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = Verify(commitment, &proof, point, key, sha256.New())
+		}
+	})
+}
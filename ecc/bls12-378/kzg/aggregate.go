@@ -0,0 +1,299 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"errors"
+	"hash"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/consensys/gnark-crypto/fiat-shamir"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrInvalidNbPoints         = errors.New("number of points is not the same as the number of polynomials")
+	ErrVerifyAggregatedOpening = errors.New("can't verify aggregated opening proof")
+)
+
+// AggregatedOpeningProof is an opening proof of many polynomials, each at its own point.
+// Whatever the number of openings, it holds two points of G₁ instead of one point per
+// opening, but it also holds the claimed values, so its size is still linear in the number
+// of openings (one element of 𝔽r each, against one point of G₁ for separate proofs).
+//
+// It is not an aggregation of existing OpeningProof: the prover computes it from the
+// polynomials themselves, so whoever aggregates must hold all of them. To aggregate proofs
+// computed by others, see AggregateProofs.
+//
+// The aggregation follows the multi-point opening of [BDFG20] (SHPLONK): with fᵢ opened at zᵢ
+// to yᵢ and challenges γ, ρ,
+//
+//	h = ∑ᵢγⁱ(fᵢ-yᵢ)/(X-zᵢ)
+//	L = ∑ᵢγⁱ/(ρ-zᵢ)·(fᵢ-yᵢ) - h, which vanishes at ρ
+//
+// and the proof consists of W = [h(α)]G₁ and W' = [L(α)/(α-ρ)]G₁.
+//
+// implements io.ReaderFrom and io.WriterTo
+//
+// [BDFG20]: https://eprint.iacr.org/2020/081.pdf
+type AggregatedOpeningProof struct {
+	// W commitment to ∑ᵢγⁱ(fᵢ-yᵢ)/(X-zᵢ)
+	W bls12378.G1Affine
+
+	// WPrime commitment to L/(X-ρ)
+	WPrime bls12378.G1Affine
+
+	// ClaimedValues purported values fᵢ(zᵢ)
+	ClaimedValues []fr.Element
+}
+
+// AggregateOpen computes an aggregated opening proof of polynomials[i] at points[i], for
+// all i. The points need not be distinct. The caller must hold every polynomial; to
+// aggregate existing OpeningProof, see AggregateProofs.
+//
+// * digests is the list of committed polynomials to open, needed to derive the challenges using Fiat Shamir.
+// * dataTranscript extra data that might be needed to derive the challenges
+func AggregateOpen(polynomials [][]fr.Element, digests []Digest, points []fr.Element, hf hash.Hash, pk ProvingKey, dataTranscript ...[]byte) (AggregatedOpeningProof, error) {
+
+	// check for invalid sizes
+	if len(digests) != len(polynomials) {
+		return AggregatedOpeningProof{}, ErrInvalidNbDigests
+	}
+	if len(points) != len(polynomials) {
+		return AggregatedOpeningProof{}, ErrInvalidNbPoints
+	}
+	if len(polynomials) == 0 {
+		return AggregatedOpeningProof{}, ErrZeroNbDigests
+	}
+	largestPoly := 0
+	for _, p := range polynomials {
+		if len(p) == 0 || len(p) > len(pk.G1) {
+			return AggregatedOpeningProof{}, ErrInvalidPolynomialSize
+		}
+		if len(p) > largestPoly {
+			largestPoly = len(p)
+		}
+	}
+
+	var res AggregatedOpeningProof
+
+	// compute the purported values
+	res.ClaimedValues = make([]fr.Element, len(polynomials))
+	var wg sync.WaitGroup
+	wg.Add(len(polynomials))
+	for i := 0; i < len(polynomials); i++ {
+		go func(_i int) {
+			res.ClaimedValues[_i] = eval(polynomials[_i], points[_i])
+			wg.Done()
+		}(i)
+	}
+	wg.Wait()
+
+	// derive γ, bound to the points, the commitments and the claimed values
+	fs := fiatshamir.NewTranscript(hf, "gamma", "rho")
+	gamma, err := deriveAggregationChallenge(fs, "gamma", bindOpenings(points, digests, res.ClaimedValues, dataTranscript)...)
+	if err != nil {
+		return AggregatedOpeningProof{}, err
+	}
+	gammas := make([]fr.Element, len(polynomials))
+	gammas[0].SetOne()
+	for i := 1; i < len(gammas); i++ {
+		gammas[i].Mul(&gammas[i-1], &gamma)
+	}
+
+	// h = ∑ᵢγⁱ(fᵢ-yᵢ)/(X-zᵢ)
+	h := make([]fr.Element, largestPoly)
+	q := make([]fr.Element, largestPoly)
+	for i := range polynomials {
+		q = q[:len(polynomials[i])]
+		copy(q, polynomials[i])
+		qi := dividePolyByXminusA(q, res.ClaimedValues[i], points[i])
+		parallel.Execute(len(qi), func(start, end int) {
+			var t fr.Element
+			for j := start; j < end; j++ {
+				t.Mul(&qi[j], &gammas[i])
+				h[j].Add(&h[j], &t)
+			}
+		})
+	}
+	res.W, err = Commit(h, pk)
+	if err != nil {
+		return AggregatedOpeningProof{}, err
+	}
+
+	// derive ρ, bound to γ and W
+	rho, err := deriveAggregationChallenge(fs, "rho", res.W.Marshal())
+	if err != nil {
+		return AggregatedOpeningProof{}, err
+	}
+
+	// L = ∑ᵢγⁱ/(ρ-zᵢ)·(fᵢ-yᵢ) - h
+	coeffs, err := aggregationCoefficients(gammas, points, rho)
+	if err != nil {
+		return AggregatedOpeningProof{}, err
+	}
+	l := h
+	for j := range l {
+		l[j].Neg(&l[j])
+	}
+	for i := range polynomials {
+		var cy fr.Element
+		cy.Mul(&coeffs[i], &res.ClaimedValues[i])
+		l[0].Sub(&l[0], &cy)
+		p := polynomials[i]
+		parallel.Execute(len(p), func(start, end int) {
+			var t fr.Element
+			for j := start; j < end; j++ {
+				t.Mul(&p[j], &coeffs[i])
+				l[j].Add(&l[j], &t)
+			}
+		})
+	}
+
+	// W' = [L(α)/(α-ρ)]G₁, L(ρ) = 0
+	var zero fr.Element
+	res.WPrime, err = Commit(dividePolyByXminusA(l, zero, rho), pk)
+	if err != nil {
+		return AggregatedOpeningProof{}, err
+	}
+
+	return res, nil
+}
+
+// VerifyAggregated verifies an aggregated opening proof of the polynomials committed in digests,
+// at points, with a single pairing check:
+//
+//	e(∑ᵢγⁱ/(ρ-zᵢ)·([fᵢ(α)]G₁-[yᵢ]G₁) - W + ρW', G₂)·e(-W', [α]G₂) == 1
+//
+// * dataTranscript extra data that might be needed to derive the challenges
+func VerifyAggregated(digests []Digest, proof *AggregatedOpeningProof, points []fr.Element, hf hash.Hash, vk VerifyingKey, dataTranscript ...[]byte) error {
+
+	// check for invalid sizes
+	if len(digests) != len(proof.ClaimedValues) {
+		return ErrInvalidNbDigests
+	}
+	if len(points) != len(digests) {
+		return ErrInvalidNbPoints
+	}
+	if len(digests) == 0 {
+		return ErrZeroNbDigests
+	}
+
+	// derive the challenges
+	fs := fiatshamir.NewTranscript(hf, "gamma", "rho")
+	gamma, err := deriveAggregationChallenge(fs, "gamma", bindOpenings(points, digests, proof.ClaimedValues, dataTranscript)...)
+	if err != nil {
+		return err
+	}
+	rho, err := deriveAggregationChallenge(fs, "rho", proof.W.Marshal())
+	if err != nil {
+		return err
+	}
+	gammas := make([]fr.Element, len(digests))
+	gammas[0].SetOne()
+	for i := 1; i < len(gammas); i++ {
+		gammas[i].Mul(&gammas[i-1], &gamma)
+	}
+	coeffs, err := aggregationCoefficients(gammas, points, rho)
+	if err != nil {
+		return err
+	}
+
+	// [L(α) + ρW'(α)]G₁ = ∑ᵢcᵢ[fᵢ(α)]G₁ - [∑ᵢcᵢyᵢ]G₁ - W + ρW'
+	n := len(digests)
+	bases := make([]bls12378.G1Affine, n+3)
+	scalars := make([]fr.Element, n+3)
+	copy(bases, digests)
+	copy(scalars, coeffs)
+	for i := 0; i < n; i++ {
+		var cy fr.Element
+		cy.Mul(&coeffs[i], &proof.ClaimedValues[i])
+		scalars[n].Sub(&scalars[n], &cy)
+	}
+	bases[n].Set(&vk.G1)
+	bases[n+1].Set(&proof.W)
+	scalars[n+1].SetOne().Neg(&scalars[n+1])
+	bases[n+2].Set(&proof.WPrime)
+	scalars[n+2].Set(&rho)
+
+	var lhs bls12378.G1Affine
+	if _, err := lhs.MultiExp(bases, scalars, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+
+	// -W'
+	var negWPrime bls12378.G1Affine
+	negWPrime.Neg(&proof.WPrime)
+
+	check, err := bls12378.PairingCheckFixedQ(
+		[]bls12378.G1Affine{lhs, negWPrime},
+		vk.Lines[:],
+	)
+	if err != nil {
+		return err
+	}
+	if !check {
+		return ErrVerifyAggregatedOpening
+	}
+	return nil
+}
+
+// aggregationCoefficients returns γⁱ/(ρ-zᵢ), given the γⁱ
+func aggregationCoefficients(gammas, points []fr.Element, rho fr.Element) ([]fr.Element, error) {
+	res := make([]fr.Element, len(points))
+	for i := range points {
+		res[i].Sub(&rho, &points[i])
+		if res[i].IsZero() {
+			// happens with negligible probability
+			return nil, ErrVerifyAggregatedOpening
+		}
+	}
+	res = fr.BatchInvert(res)
+	for i := range res {
+		res[i].Mul(&res[i], &gammas[i])
+	}
+	return res, nil
+}
+
+// bindOpenings returns the data the first aggregation challenge is bound to
+func bindOpenings(points []fr.Element, digests []Digest, claimedValues []fr.Element, dataTranscript [][]byte) [][]byte {
+	res := make([][]byte, 0, 3*len(points)+len(dataTranscript))
+	for i := range points {
+		res = append(res, points[i].Marshal(), digests[i].Marshal(), claimedValues[i].Marshal())
+	}
+	return append(res, dataTranscript...)
+}
+
+// deriveAggregationChallenge binds data to the challenge name and computes it
+func deriveAggregationChallenge(fs *fiatshamir.Transcript, name string, data ...[]byte) (fr.Element, error) {
+	for i := range data {
+		if err := fs.Bind(name, data[i]); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	c, err := fs.ComputeChallengeElement(name, fr.Modulus())
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var res fr.Element
+	res.SetBigInt(c)
+	return res, nil
+}
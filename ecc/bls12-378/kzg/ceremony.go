@@ -0,0 +1,265 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"sync/atomic"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrContributionSize      = errors.New("contribution doesn't have the expected number of powers")
+	ErrContributionPoint     = errors.New("contribution has a point not in the prime subgroup")
+	ErrContributionZero      = errors.New("contribution has a zero secret")
+	ErrContributionHash      = errors.New("contribution isn't bound to the previous one")
+	ErrContributionUpdate    = errors.New("contribution doesn't update the previous powers with its public key")
+	ErrContributionPowers    = errors.New("contribution powers are not consecutive powers of the same secret")
+	ErrContributionGenerator = errors.New("contribution powers don't start with the generators")
+)
+
+// Contribution is the state of a powers-of-tau ceremony after a contribution.
+//
+// A contributor samples a secret x and updates the powers [τⁱ]G of the previous
+// contribution to [(xτ)ⁱ]G. PublicKey = [x]G₂ allows to check the update, and PreviousHash
+// chains the contributions of the transcript.
+//
+// The SRS of the ceremony is given by the last contribution, see Contribution.SRS.
+type Contribution struct {
+	// G1 [τⁱ]G₁, 0 ≤ i < len(G1)
+	G1 []bls12378.G1Affine
+
+	// G2 [τⁱ]G₂, 0 ≤ i < len(G2)
+	G2 []bls12378.G2Affine
+
+	// PublicKey [x]G₂, where x is the secret of the contributor
+	PublicKey bls12378.G2Affine
+
+	// PreviousHash is the Hash of the previous contribution, empty for the first one
+	PreviousHash []byte
+}
+
+// Hash returns the hash of c with h, to which the next contribution must be bound
+// (see Contribution.PreviousHash).
+func (c *Contribution) Hash(h hash.Hash) []byte {
+	h.Reset()
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(len(c.G1)))
+	h.Write(buf[:])
+	binary.BigEndian.PutUint64(buf[:], uint64(len(c.G2)))
+	h.Write(buf[:])
+	for i := range c.G1 {
+		b := c.G1[i].RawBytes()
+		h.Write(b[:])
+	}
+	for i := range c.G2 {
+		b := c.G2[i].RawBytes()
+		h.Write(b[:])
+	}
+	b := c.PublicKey.RawBytes()
+	h.Write(b[:])
+	h.Write(c.PreviousHash)
+	return h.Sum(nil)
+}
+
+// SRS returns the SRS given by the powers of c. c should have been verified first,
+// with VerifyTranscript.
+//
+// If c has more than the two G₂ powers of the verifying key, they are all kept in
+// srs.Pk.G2.
+func (c *Contribution) SRS() (*SRS, error) {
+	if len(c.G1) < 2 || len(c.G2) < 2 {
+		return nil, ErrContributionSize
+	}
+	var srs SRS
+	srs.Pk.G1 = make([]bls12378.G1Affine, len(c.G1))
+	copy(srs.Pk.G1, c.G1)
+	if len(c.G2) > 2 {
+		srs.Pk.G2 = make([]bls12378.G2Affine, len(c.G2))
+		copy(srs.Pk.G2, c.G2)
+	}
+	srs.Vk.G1 = c.G1[0]
+	srs.Vk.G2[0] = c.G2[0]
+	srs.Vk.G2[1] = c.G2[1]
+	srs.Vk.Lines[0] = bls12378.PrecomputeLines(srs.Vk.G2[0])
+	srs.Vk.Lines[1] = bls12378.PrecomputeLines(srs.Vk.G2[1])
+	return &srs, nil
+}
+
+// VerifyTranscript verifies the full chain of contributions of a powers-of-tau ceremony,
+// starting from the generators (τ = 1). See VerifyContribution for the checks of each
+// contribution; the returned error identifies the first invalid contribution.
+func VerifyTranscript(contributions []Contribution, h hash.Hash) error {
+	for i := range contributions {
+		var prev *Contribution
+		if i != 0 {
+			prev = &contributions[i-1]
+		}
+		if err := VerifyContribution(prev, &contributions[i], h); err != nil {
+			return fmt.Errorf("contribution %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// VerifyContribution checks that next is a valid contribution on top of prev, that is
+//   - next has as many powers as prev, at least 2 in G₁ and G₂, in the prime subgroups,
+//     starting with the generators,
+//   - next.PreviousHash is the hash of prev,
+//   - the secret of next is not zero (PublicKey and [τ]G₁ are not zero),
+//   - the powers of prev are updated with the public key: e(next.[τ]G₁, G₂) = e(prev.[τ]G₁, PublicKey),
+//   - the powers of next are consecutive powers of the same τ, checked with random linear
+//     combinations: e(∑ᵢrᵢ[τⁱ]G₁, [τ]G₂) = e(∑ᵢrᵢ[τⁱ⁺¹]G₁, G₂), and likewise in G₂.
+//
+// prev is nil for the first contribution, which is checked on top of the generators.
+func VerifyContribution(prev, next *Contribution, h hash.Hash) error {
+	_, _, g1, g2 := bls12378.Generators()
+
+	// sizes
+	if len(next.G1) < 2 || len(next.G2) < 2 {
+		return ErrContributionSize
+	}
+	if prev != nil && (len(next.G1) != len(prev.G1) || len(next.G2) != len(prev.G2)) {
+		return ErrContributionSize
+	}
+
+	// hash chaining
+	if prev == nil {
+		if len(next.PreviousHash) != 0 {
+			return ErrContributionHash
+		}
+	} else if !bytes.Equal(next.PreviousHash, prev.Hash(h)) {
+		return ErrContributionHash
+	}
+
+	// subgroup checks
+	var nbErrs uint64
+	parallel.Execute(len(next.G1), func(start, end int) {
+		for i := start; i < end; i++ {
+			if !next.G1[i].IsInSubGroup() {
+				atomic.AddUint64(&nbErrs, 1)
+				return
+			}
+		}
+	})
+	for i := range next.G2 {
+		if !next.G2[i].IsInSubGroup() {
+			nbErrs++
+		}
+	}
+	if nbErrs != 0 || !next.PublicKey.IsInSubGroup() {
+		return ErrContributionPoint
+	}
+	if !next.G1[0].Equal(&g1) || !next.G2[0].Equal(&g2) {
+		return ErrContributionGenerator
+	}
+
+	// non-zero secret
+	if next.PublicKey.IsInfinity() || next.G1[1].IsInfinity() || next.G2[1].IsInfinity() {
+		return ErrContributionZero
+	}
+
+	// update of the previous powers: e(next.[τ]G₁, G₂) = e(prev.[τ]G₁, [x]G₂)
+	prevTau := g1
+	if prev != nil {
+		prevTau = prev.G1[1]
+	}
+	var negTau bls12378.G1Affine
+	negTau.Neg(&next.G1[1])
+	ok, err := bls12378.PairingCheck(
+		[]bls12378.G1Affine{negTau, prevTau},
+		[]bls12378.G2Affine{g2, next.PublicKey},
+	)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrContributionUpdate
+	}
+
+	// consecutive powers in G₁
+	r, err := randomCoefficients(len(next.G1) - 1)
+	if err != nil {
+		return err
+	}
+	var left, right bls12378.G1Affine
+	config := ecc.MultiExpConfig{}
+	if _, err := left.MultiExp(next.G1[:len(next.G1)-1], r, config); err != nil {
+		return err
+	}
+	if _, err := right.MultiExp(next.G1[1:], r, config); err != nil {
+		return err
+	}
+	right.Neg(&right)
+	ok, err = bls12378.PairingCheck(
+		[]bls12378.G1Affine{left, right},
+		[]bls12378.G2Affine{next.G2[1], g2},
+	)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrContributionPowers
+	}
+
+	// consecutive powers in G₂
+	r, err = randomCoefficients(len(next.G2) - 1)
+	if err != nil {
+		return err
+	}
+	var left2, right2 bls12378.G2Affine
+	if _, err := left2.MultiExp(next.G2[:len(next.G2)-1], r, config); err != nil {
+		return err
+	}
+	if _, err := right2.MultiExp(next.G2[1:], r, config); err != nil {
+		return err
+	}
+	var negG1 bls12378.G1Affine
+	negG1.Neg(&g1)
+	ok, err = bls12378.PairingCheck(
+		[]bls12378.G1Affine{next.G1[1], negG1},
+		[]bls12378.G2Affine{left2, right2},
+	)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrContributionPowers
+	}
+
+	return nil
+}
+
+// randomCoefficients returns n random elements of fr
+func randomCoefficients(n int) ([]fr.Element, error) {
+	r := make([]fr.Element, n)
+	for i := range r {
+		if _, err := r[i].SetRandom(); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
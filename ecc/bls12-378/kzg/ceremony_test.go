@@ -0,0 +1,149 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+)
+
+// contribute returns the contribution of the secret x on top of prev (nil for the first one)
+func contribute(prev *Contribution, x fr.Element, nbG1, nbG2 int) Contribution {
+	_, _, g1, g2 := bls12378.Generators()
+	var res Contribution
+	if prev != nil {
+		res.PreviousHash = prev.Hash(sha256.New())
+	}
+
+	// xⁱ
+	powers := make([]fr.Element, nbG1)
+	powers[0].SetOne()
+	for i := 1; i < nbG1; i++ {
+		powers[i].Mul(&powers[i-1], &x)
+	}
+	var k big.Int
+	res.G1 = make([]bls12378.G1Affine, nbG1)
+	res.G2 = make([]bls12378.G2Affine, nbG2)
+	for i := range res.G1 {
+		powers[i].BigInt(&k)
+		if prev == nil {
+			res.G1[i].ScalarMultiplication(&g1, &k)
+		} else {
+			res.G1[i].ScalarMultiplication(&prev.G1[i], &k)
+		}
+	}
+	for i := range res.G2 {
+		powers[i].BigInt(&k)
+		if prev == nil {
+			res.G2[i].ScalarMultiplication(&g2, &k)
+		} else {
+			res.G2[i].ScalarMultiplication(&prev.G2[i], &k)
+		}
+	}
+	x.BigInt(&k)
+	res.PublicKey.ScalarMultiplication(&g2, &k)
+	return res
+}
+
+func TestVerifyTranscript(t *testing.T) {
+	const nbG1, nbG2 = 17, 3
+	hf := sha256.New()
+
+	contributions := make([]Contribution, 3)
+	for i := range contributions {
+		var prev *Contribution
+		if i != 0 {
+			prev = &contributions[i-1]
+		}
+		var x fr.Element
+		x.SetRandom()
+		contributions[i] = contribute(prev, x, nbG1, nbG2)
+	}
+
+	if err := VerifyTranscript(contributions, hf); err != nil {
+		t.Fatal(err)
+	}
+
+	// the SRS of the last contribution can be used
+	srs, err := contributions[len(contributions)-1].SRS()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(srs.Pk.G2) != nbG2 || !srs.Pk.G2[2].Equal(&contributions[len(contributions)-1].G2[2]) {
+		t.Fatal("the G₂ powers of the transcript must be kept in the SRS")
+	}
+	f := randomPolynomial(nbG1)
+	digest, err := Commit(f, srs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var point fr.Element
+	point.SetRandom()
+	proof, err := Open(f, point, srs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Verify(&digest, &proof, point, srs.Vk); err != nil {
+		t.Fatal(err)
+	}
+
+	// tampered transcripts
+	tamper := func(name string, expected error, f func(c []Contribution)) {
+		t.Helper()
+		c := make([]Contribution, len(contributions))
+		for i := range contributions {
+			c[i] = contributions[i]
+			c[i].G1 = append([]bls12378.G1Affine{}, contributions[i].G1...)
+			c[i].G2 = append([]bls12378.G2Affine{}, contributions[i].G2...)
+		}
+		f(c)
+		if err := VerifyTranscript(c, hf); !errors.Is(err, expected) {
+			t.Fatalf("%s: expected %v, got %v", name, expected, err)
+		}
+	}
+	tamper("broken hash chain", ErrContributionHash, func(c []Contribution) {
+		c[2].PreviousHash = c[0].Hash(hf)
+	})
+	tamper("first contribution with a previous hash", ErrContributionHash, func(c []Contribution) {
+		c[0].PreviousHash = []byte{1}
+	})
+	tamper("wrong public key", ErrContributionUpdate, func(c []Contribution) {
+		c[1].PublicKey = c[0].PublicKey
+	})
+	tamper("inconsistent power in G1", ErrContributionPowers, func(c []Contribution) {
+		c[2].G1[5].Add(&c[2].G1[5], &c[2].G1[0])
+	})
+	tamper("inconsistent power in G2", ErrContributionPowers, func(c []Contribution) {
+		c[2].G2[2].Add(&c[2].G2[2], &c[2].G2[0])
+	})
+	tamper("missing power", ErrContributionSize, func(c []Contribution) {
+		c[1].G1 = c[1].G1[:nbG1-1]
+	})
+	tamper("zero secret", ErrContributionZero, func(c []Contribution) {
+		var zero fr.Element
+		c[1] = contribute(&c[0], zero, nbG1, nbG2)
+		c[2].PreviousHash = c[1].Hash(hf)
+	})
+	tamper("wrong generator", ErrContributionGenerator, func(c []Contribution) {
+		c[0].G1[0].Double(&c[0].G1[0])
+	})
+}
@@ -0,0 +1,200 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+)
+
+// BatchOpeningProofs is a list of batch opening proofs at the same point, e.g. the proofs of
+// the rounds of a protocol, or of many instances of the same circuit, opened at a shared
+// challenge. Its encoding (WriteTo) stores the point once, and the claimed values with the
+// compact encoding of BatchOpeningProof.WriteCompactTo, relative to the previous proof.
+//
+// implements io.ReaderFrom and io.WriterTo
+type BatchOpeningProofs struct {
+	// Point at which all the proofs are opened
+	Point fr.Element
+
+	// Proofs opening proofs at Point
+	Proofs []BatchOpeningProof
+}
+
+// In the compact encoding, each claimed value is described by a 2-bit tag, packed in
+// uint64 words (value i in word i/32, at bits 2(i%32)), and only the values tagged
+// tagExplicit are written, after the tags.
+const (
+	tagZero     = iota // the value is 0
+	tagPrevious        // the value is the one at the same index in the previous proof
+	tagExplicit        // the value follows the tags
+)
+
+const nbTagsPerWord = 32
+
+// WriteCompactTo writes the binary encoding of the proof, with the compact encoding of the
+// claimed values: zero values take 2 bits instead of fr.Bytes bytes. It is read back
+// by ReadFrom.
+func (proof *BatchOpeningProof) WriteCompactTo(w io.Writer) (int64, error) {
+	writeTo := func(w io.Writer, options ...func(*bls12378.Encoder)) (int64, error) {
+		enc := bls12378.NewEncoder(w, options...)
+		err := proof.encodeCompact(enc, nil)
+		return enc.BytesWritten(), err
+	}
+	return writeWithFlags(w, ecc.ObjectBatchOpeningProof, ecc.FlagCompactEncoding, writeTo, false)
+}
+
+// encodeCompact encodes H and the claimed values, where values equal to the ones at the
+// same index in previous are not repeated.
+func (proof *BatchOpeningProof) encodeCompact(enc *bls12378.Encoder, previous []fr.Element) error {
+	n := len(proof.ClaimedValues)
+	tags := make([]uint64, (n+nbTagsPerWord-1)/nbTagsPerWord)
+	for i := range proof.ClaimedValues {
+		var tag uint64
+		switch {
+		case proof.ClaimedValues[i].IsZero():
+			tag = tagZero
+		case i < len(previous) && proof.ClaimedValues[i].Equal(&previous[i]):
+			tag = tagPrevious
+		default:
+			tag = tagExplicit
+		}
+		tags[i/nbTagsPerWord] |= tag << (2 * (i % nbTagsPerWord))
+	}
+
+	toEncode := []interface{}{
+		&proof.H,
+		uint32(n),
+		tags,
+	}
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+	}
+	for i := range proof.ClaimedValues {
+		if (tags[i/nbTagsPerWord]>>(2*(i%nbTagsPerWord)))&3 != tagExplicit {
+			continue
+		}
+		if err := enc.Encode(&proof.ClaimedValues[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeCompact decodes a proof encoded with encodeCompact, with the same previous values.
+func (proof *BatchOpeningProof) decodeCompact(dec *bls12378.Decoder, previous []fr.Element) error {
+	var n uint32
+	var tags []uint64
+	for _, v := range []interface{}{&proof.H, &n, &tags} {
+		if err := dec.Decode(v); err != nil {
+			return err
+		}
+	}
+	if uint64(len(tags)) != (uint64(n)+nbTagsPerWord-1)/nbTagsPerWord {
+		return errInvalidProofEncoding
+	}
+	// the unused bits of the last word must be 0
+	if r := n % nbTagsPerWord; r != 0 && tags[len(tags)-1]>>(2*r) != 0 {
+		return errInvalidProofEncoding
+	}
+
+	proof.ClaimedValues = make([]fr.Element, n)
+	for i := range proof.ClaimedValues {
+		switch (tags[i/nbTagsPerWord] >> (2 * (i % nbTagsPerWord))) & 3 {
+		case tagZero:
+		case tagPrevious:
+			if i >= len(previous) {
+				return errInvalidProofEncoding
+			}
+			proof.ClaimedValues[i] = previous[i]
+		case tagExplicit:
+			if err := dec.Decode(&proof.ClaimedValues[i]); err != nil {
+				return err
+			}
+		default:
+			return errInvalidProofEncoding
+		}
+	}
+	return nil
+}
+
+// WriteTo writes the binary encoding of the proofs
+func (proofs *BatchOpeningProofs) WriteTo(w io.Writer) (int64, error) {
+	return writeWithHeader(w, ecc.ObjectBatchOpeningProofs, proofs.writeTo, false)
+}
+
+func (proofs *BatchOpeningProofs) writeTo(w io.Writer, options ...func(*bls12378.Encoder)) (int64, error) {
+	enc := bls12378.NewEncoder(w, options...)
+	if err := enc.Encode(&proofs.Point); err != nil {
+		return enc.BytesWritten(), err
+	}
+	if err := enc.Encode(uint32(len(proofs.Proofs))); err != nil {
+		return enc.BytesWritten(), err
+	}
+	var previous []fr.Element
+	for i := range proofs.Proofs {
+		if err := proofs.Proofs[i].encodeCompact(enc, previous); err != nil {
+			return enc.BytesWritten(), err
+		}
+		previous = proofs.Proofs[i].ClaimedValues
+	}
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes BatchOpeningProofs data from reader.
+func (proofs *BatchOpeningProofs) ReadFrom(r io.Reader) (int64, error) {
+	return proofs.readFrom(r)
+}
+
+// LenientReadFrom decodes BatchOpeningProofs data from reader, accepting non-canonical encodings
+// (see bls12378.NonCanonicalEncodings).
+func (proofs *BatchOpeningProofs) LenientReadFrom(r io.Reader) (int64, error) {
+	return proofs.readFrom(r, bls12378.NonCanonicalEncodings())
+}
+
+func (proofs *BatchOpeningProofs) readFrom(r io.Reader, options ...func(*bls12378.Decoder)) (int64, error) {
+	r, hn, err := readHeader(r, ecc.ObjectBatchOpeningProofs)
+	if err != nil {
+		return hn, err
+	}
+
+	dec := bls12378.NewDecoder(r, options...)
+	var n uint32
+	for _, v := range []interface{}{&proofs.Point, &n} {
+		if err := dec.Decode(v); err != nil {
+			return hn + dec.BytesRead(), err
+		}
+	}
+
+	// the number of proofs is not trusted to allocate them at once
+	proofs.Proofs = proofs.Proofs[:0]
+	var previous []fr.Element
+	for i := uint32(0); i < n; i++ {
+		var proof BatchOpeningProof
+		if err := proof.decodeCompact(dec, previous); err != nil {
+			return hn + dec.BytesRead(), err
+		}
+		proofs.Proofs = append(proofs.Proofs, proof)
+		previous = proof.ClaimedValues
+	}
+	return hn + dec.BytesRead(), nil
+}
@@ -0,0 +1,190 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fflonk
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/kzg"
+)
+
+var (
+	ErrNbPolynomials      = errors.New("the number of polynomials must be positive")
+	ErrNbClaimedValues    = errors.New("number of claimed values is not the same as the number of polynomials")
+	ErrMissingG2Powers    = errors.New("the srs doesn't have the G2 powers needed for this number of polynomials")
+	ErrVerifyOpeningProof = errors.New("can't verify fflonk opening proof")
+)
+
+// VerifyingKey is the part of the SRS needed to verify the openings of t combined polynomials
+type VerifyingKey struct {
+	G1 []bls12378.G1Affine  // [G₁, [α]G₁, …, [αᵗ⁻¹]G₁]
+	G2 [2]bls12378.G2Affine // [G₂, [αᵗ]G₂]
+}
+
+// NewVerifyingKey returns the VerifyingKey for nbPolynomials combined polynomials. srs must
+// have at least nbPolynomials+1 powers in srs.Pk.G2.
+func NewVerifyingKey(srs *kzg.SRS, nbPolynomials int) (VerifyingKey, error) {
+	if nbPolynomials <= 0 {
+		return VerifyingKey{}, ErrNbPolynomials
+	}
+	if len(srs.Pk.G2) <= nbPolynomials {
+		return VerifyingKey{}, ErrMissingG2Powers
+	}
+	if len(srs.Pk.G1) < nbPolynomials {
+		return VerifyingKey{}, kzg.ErrInvalidPolynomialSize
+	}
+	var vk VerifyingKey
+	vk.G1 = make([]bls12378.G1Affine, nbPolynomials)
+	copy(vk.G1, srs.Pk.G1)
+	vk.G2[0] = srs.Pk.G2[0]
+	vk.G2[1] = srs.Pk.G2[nbPolynomials]
+	return vk, nil
+}
+
+// OpeningProof is the opening proof of combined polynomials f₀, …, fₜ₋₁ at a point z
+type OpeningProof struct {
+	// H commitment to the quotient (g(X) - ∑ⱼ fⱼ(z)Xʲ)/(Xᵗ - z)
+	H bls12378.G1Affine
+
+	// ClaimedValues fⱼ(z), in the order of the polynomials
+	ClaimedValues []fr.Element
+}
+
+// Combine returns g(X) = ∑ⱼ fⱼ(Xᵗ)Xʲ, where fⱼ = polynomials[j] and t = len(polynomials).
+// Its coefficients interleave the ones of the polynomials, which may have different sizes:
+// g[it + j] = fⱼ[i].
+func Combine(polynomials [][]fr.Element) []fr.Element {
+	t := len(polynomials)
+	m := 0
+	for i := range polynomials {
+		if len(polynomials[i]) > m {
+			m = len(polynomials[i])
+		}
+	}
+	g := make([]fr.Element, t*m)
+	for j := range polynomials {
+		for i := range polynomials[j] {
+			g[i*t+j] = polynomials[j][i]
+		}
+	}
+	return g
+}
+
+// Commit returns the commitment to the combination of polynomials (see Combine). The
+// SRS must have t·m powers in G₁, where m is the size of the largest polynomial.
+func Commit(polynomials [][]fr.Element, pk kzg.ProvingKey, nbTasks ...int) (kzg.Digest, error) {
+	if len(polynomials) == 0 {
+		return kzg.Digest{}, ErrNbPolynomials
+	}
+	return kzg.Commit(Combine(polynomials), pk, nbTasks...)
+}
+
+// Open computes the opening proof of all the polynomials at point, that is the opening
+// of their combination at the t-th roots of point.
+func Open(polynomials [][]fr.Element, point fr.Element, pk kzg.ProvingKey) (OpeningProof, error) {
+	t := len(polynomials)
+	if t == 0 {
+		return OpeningProof{}, ErrNbPolynomials
+	}
+	g := Combine(polynomials)
+	if len(g) == 0 || len(g) > len(pk.G1) {
+		return OpeningProof{}, kzg.ErrInvalidPolynomialSize
+	}
+
+	res := OpeningProof{
+		ClaimedValues: make([]fr.Element, t),
+	}
+	for j := range polynomials {
+		res.ClaimedValues[j] = eval(polynomials[j], point)
+	}
+
+	// g(X) = h(X)(Xᵗ - z) + ∑ⱼ fⱼ(z)Xʲ, so gᵢ = hᵢ₋ₜ - z·hᵢ for i ≥ t
+	h := make([]fr.Element, len(g)-t)
+	var tmp fr.Element
+	for i := len(g) - 1; i >= t; i-- {
+		h[i-t] = g[i]
+		if i < len(h) {
+			tmp.Mul(&point, &h[i])
+			h[i-t].Add(&h[i-t], &tmp)
+		}
+	}
+
+	// the polynomials are constants, H is the point at infinity
+	if len(h) == 0 {
+		return res, nil
+	}
+
+	var err error
+	if res.H, err = kzg.Commit(h, pk); err != nil {
+		return OpeningProof{}, err
+	}
+	return res, nil
+}
+
+// Verify verifies the opening proof of the polynomials combined in commitment at point.
+// vk must have been built for len(proof.ClaimedValues) polynomials.
+func Verify(commitment *kzg.Digest, proof *OpeningProof, point fr.Element, vk VerifyingKey) error {
+	t := len(vk.G1)
+	if t == 0 {
+		return ErrNbPolynomials
+	}
+	if len(proof.ClaimedValues) != t {
+		return ErrNbClaimedValues
+	}
+
+	// [r(α)]G₁ where r(X) = ∑ⱼ fⱼ(z)Xʲ
+	var r bls12378.G1Jac
+	if _, err := r.MultiExp(vk.G1, proof.ClaimedValues, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+
+	// e([g(α) - r(α) + zH(α)]G₁, G₂)·e([-H(α)]G₁, [αᵗ]G₂) == 1
+	var left, tmp bls12378.G1Jac
+	left.FromAffine(commitment)
+	left.SubAssign(&r)
+	tmp.ScalarMultiplicationAffine(&proof.H, point.BigInt(new(big.Int)))
+	left.AddAssign(&tmp)
+
+	var leftAff, negH bls12378.G1Affine
+	leftAff.FromJacobian(&left)
+	negH.Neg(&proof.H)
+
+	ok, err := bls12378.PairingCheck(
+		[]bls12378.G1Affine{leftAff, negH},
+		vk.G2[:],
+	)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrVerifyOpeningProof
+	}
+	return nil
+}
+
+// eval returns p(point) where p is interpreted as a polynomial ∑_{i<len(p)}p[i]Xⁱ
+func eval(p []fr.Element, point fr.Element) fr.Element {
+	var res fr.Element
+	for i := len(p) - 1; i >= 0; i-- {
+		res.Mul(&res, &point).Add(&res, &p[i])
+	}
+	return res
+}
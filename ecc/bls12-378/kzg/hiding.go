@@ -0,0 +1,161 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+)
+
+// valueCommitmentDST is the domain separation tag H is hashed to G₁ with
+const valueCommitmentDST = "gnark-crypto/kzg/value-commitment"
+
+var valueCommitmentBase struct {
+	once sync.Once
+	h    bls12378.G1Affine
+}
+
+// ValueCommitmentBase returns H, the second base of the Pedersen commitments of the hidden
+// values: a point of G₁ hashed from a fixed tag, whose discrete logarithm in base G₁ and
+// relation to the SRS are unknown.
+func ValueCommitmentBase() bls12378.G1Affine {
+	valueCommitmentBase.once.Do(func() {
+		var err error
+		valueCommitmentBase.h, err = bls12378.HashToG1(nil, []byte(valueCommitmentDST))
+		if err != nil {
+			panic(err)
+		}
+	})
+	return valueCommitmentBase.h
+}
+
+// CommitValue returns the Pedersen commitment [value]G₁ + [blinding]H to value, where G₁ is the
+// generator vk.G1 of the SRS and H is ValueCommitmentBase().
+func CommitValue(value, blinding fr.Element, vk VerifyingKey) bls12378.G1Affine {
+	h := ValueCommitmentBase()
+	var v, b big.Int
+	value.BigInt(&v)
+	blinding.BigInt(&b)
+
+	var res, tmp bls12378.G1Jac
+	res.ScalarMultiplicationAffine(&vk.G1, &v)
+	tmp.ScalarMultiplicationAffine(&h, &b)
+	res.AddAssign(&tmp)
+
+	var resAff bls12378.G1Affine
+	resAff.FromJacobian(&res)
+	return resAff
+}
+
+// HidingOpeningProof is a KZG opening proof of a polynomial f at a point z which doesn't reveal
+// the value f(z): the value is committed to in ValueCommitment, a Pedersen commitment (see
+// CommitValue), and the proof shows that it opens to f(z).
+//
+// With π the quotient of a regular opening proof, the prover draws a random s and sends
+// H = π + [s]H and T = [s(α-z) + r]G₂, where r is the blinding of ValueCommitment. The verifier
+// checks that e([f(α)]G₁ - ValueCommitment, G₂) = e(H, [α-z]G₂)·e(-H, T), where the second H is
+// ValueCommitmentBase(). H and T are uniformly distributed under this relation, which hides f(z)
+// as well as ValueCommitment does.
+type HidingOpeningProof struct {
+	// ValueCommitment is the Pedersen commitment to the value f(z)
+	ValueCommitment bls12378.G1Affine
+
+	// H is the blinded quotient
+	H bls12378.G1Affine
+
+	// T is the blinding term in G₂
+	T bls12378.G2Affine
+}
+
+// OpenHiding computes a HidingOpeningProof of p at point, where the value p(point) is committed
+// to with the given blinding (which must be random, and secret as long as the value is).
+//
+// Unlike Open, it needs the verifying key of the SRS to compute T.
+func OpenHiding(p []fr.Element, point, blinding fr.Element, pk ProvingKey, vk VerifyingKey) (HidingOpeningProof, error) {
+	value := eval(p, point)
+	proof, err := OpenWithValue(p, point, value, pk)
+	if err != nil {
+		return HidingOpeningProof{}, err
+	}
+
+	var s fr.Element
+	if _, err := s.SetRandom(); err != nil {
+		return HidingOpeningProof{}, err
+	}
+
+	res := HidingOpeningProof{
+		ValueCommitment: CommitValue(value, blinding, vk),
+	}
+
+	// H = π + [s]H
+	h := ValueCommitmentBase()
+	var sBigInt big.Int
+	s.BigInt(&sBigInt)
+	res.H.ScalarMultiplication(&h, &sBigInt)
+	res.H.Add(&res.H, &proof.H)
+
+	// T = [s]([α]G₂) + [r - s⋅z]G₂
+	var t fr.Element
+	t.Mul(&s, &point).Sub(&blinding, &t)
+	var tBigInt big.Int
+	t.BigInt(&tBigInt)
+	var tmp bls12378.G2Affine
+	res.T.ScalarMultiplication(&vk.G2[1], &sBigInt)
+	tmp.ScalarMultiplication(&vk.G2[0], &tBigInt)
+	res.T.Add(&res.T, &tmp)
+
+	return res, nil
+}
+
+// VerifyHiding verifies a HidingOpeningProof: that proof.ValueCommitment is a commitment to the
+// value at point of the polynomial committed to in commitment.
+func VerifyHiding(commitment *Digest, proof *HidingOpeningProof, point fr.Element, vk VerifyingKey) error {
+	if !proof.H.IsInSubGroup() || !proof.T.IsInSubGroup() || !proof.ValueCommitment.IsInSubGroup() {
+		return ErrVerifyOpeningProof
+	}
+
+	// e([f(α)]G₁ - Y + [z]H, G₂)⋅e(-H, [α]G₂)⋅e(B, T) == 1, with Y the value commitment
+	// and B = ValueCommitmentBase()
+	var lhs, tmp bls12378.G1Jac
+	var pointBigInt big.Int
+	point.BigInt(&pointBigInt)
+	lhs.ScalarMultiplicationAffine(&proof.H, &pointBigInt)
+	tmp.FromAffine(commitment)
+	lhs.AddAssign(&tmp)
+	tmp.FromAffine(&proof.ValueCommitment)
+	lhs.SubAssign(&tmp)
+
+	var lhsAff, negH bls12378.G1Affine
+	lhsAff.FromJacobian(&lhs)
+	negH.Neg(&proof.H)
+	base := ValueCommitmentBase()
+
+	check, err := bls12378.PairingCheck(
+		[]bls12378.G1Affine{lhsAff, negH, base},
+		[]bls12378.G2Affine{vk.G2[0], vk.G2[1], proof.T},
+	)
+	if err != nil {
+		return err
+	}
+	if !check {
+		return ErrVerifyOpeningProof
+	}
+	return nil
+}
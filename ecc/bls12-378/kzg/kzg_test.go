@@ -17,7 +17,9 @@
 package kzg
 
 import (
+	"bytes"
 	"crypto/sha256"
+	"encoding/json"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"math/big"
@@ -27,18 +29,19 @@ import (
 	"github.com/consensys/gnark-crypto/ecc/bls12-378"
 	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
 	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr/fft"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr/iop"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
 
 	"github.com/consensys/gnark-crypto/utils"
 )
 
 // Test SRS re-used across tests of the KZG scheme
 var testSrs *SRS
-var bAlpha *big.Int
+var testSeed = []byte("gnark-crypto kzg tests")
 
 func init() {
 	const srsSize = 230
-	bAlpha = new(big.Int).SetInt64(42) // randomise ?
-	testSrs, _ = NewSRS(ecc.NextPowerOfTwo(srsSize), bAlpha)
+	testSrs, _ = NewSRSInsecure(ecc.NextPowerOfTwo(srsSize), testSeed)
 }
 
 func TestToLagrangeG1(t *testing.T) {
@@ -54,8 +57,9 @@ func TestToLagrangeG1(t *testing.T) {
 	w, err := fr.Generator(uint64(size))
 	assert.NoError(err)
 
-	var li, n, d, one, acc, alpha fr.Element
-	alpha.SetBigInt(bAlpha)
+	alpha, err := insecureAlpha(testSeed)
+	assert.NoError(err)
+	var li, n, d, one, acc fr.Element
 	li.SetUint64(uint64(size)).Inverse(&li)
 	one.SetOne()
 	n.Exp(alpha, big.NewInt(int64(size))).Sub(&n, &one)
@@ -112,6 +116,71 @@ func TestCommitLagrange(t *testing.T) {
 	assert.True(digestCanonical.Equal(&digestLagrange), "error CommitLagrange")
 }
 
+func TestCommitPolynomial(t *testing.T) {
+	assert := require.New(t)
+
+	const size = 64
+	coeffs := make([]fr.Element, size)
+	for i := range coeffs {
+		coeffs[i].SetRandom()
+	}
+	expected, err := Commit(coeffs, testSrs.Pk)
+	assert.NoError(err)
+
+	domain := fft.NewDomain(size)
+	for _, basis := range []iop.Basis{iop.Canonical, iop.Lagrange, iop.LagrangeCoset} {
+		for _, layout := range []iop.Layout{iop.Regular, iop.BitReverse} {
+			c := make([]fr.Element, size)
+			copy(c, coeffs)
+			p := iop.NewPolynomial(&c, iop.Form{Basis: iop.Canonical, Layout: iop.Regular})
+			switch basis {
+			case iop.Lagrange:
+				p.ToLagrange(domain)
+			case iop.LagrangeCoset:
+				p.ToLagrangeCoset(domain)
+			}
+			if layout == iop.Regular {
+				p.ToRegular()
+			} else {
+				p.ToBitReverse()
+			}
+			before := p.Clone()
+
+			digest, err := CommitPolynomial(p, testSrs.Pk, nil)
+			assert.NoError(err)
+			assert.True(expected.Equal(&digest), "basis %d, layout %d: wrong commitment", basis, layout)
+			assert.Equal(before.Form, p.Form, "polynomial form should not change")
+			assert.Equal(before.Coefficients(), p.Coefficients(), "polynomial should not be modified")
+		}
+	}
+}
+
+func TestDividePolyByXminusAParallel(t *testing.T) {
+	// large enough to be split in blocks processed in parallel
+	const pSize = 7*minBlockSizeDivision + 17
+	pol := randomPolynomial(pSize)
+	var a, fa fr.Element
+	a.SetRandom()
+	fa.SetRandom()
+
+	// reference: sequential synthetic division
+	expected := make([]fr.Element, pSize)
+	copy(expected, pol)
+	expected[0].Sub(&expected[0], &fa)
+	var tmp fr.Element
+	for i := pSize - 2; i >= 0; i-- {
+		tmp.Mul(&expected[i+1], &a)
+		expected[i].Add(&expected[i], &tmp)
+	}
+
+	for _, nbTasks := range []int{1, 2, 3, 7, 64} {
+		_pol := make([]fr.Element, pSize)
+		copy(_pol, pol)
+		h := dividePolyByXminusAParallel(_pol, fa, a, nbTasks)
+		require.Equal(t, expected[1:], h, "nbTasks=%d", nbTasks)
+	}
+}
+
 func TestDividePolyByXminusA(t *testing.T) {
 
 	const pSize = 230
@@ -155,12 +224,340 @@ func TestDividePolyByXminusA(t *testing.T) {
 
 func TestSerializationSRS(t *testing.T) {
 	// create a SRS
-	srs, err := NewSRS(64, new(big.Int).SetInt64(42))
+	srs, err := NewSRSInsecure(64, testSeed)
 	assert.NoError(t, err)
 	t.Run("proving key round-trip", utils.SerializationRoundTrip(&srs.Pk))
 	t.Run("proving key raw round-trip", utils.SerializationRoundTripRaw(&srs.Pk))
 	t.Run("verifying key round-trip", utils.SerializationRoundTrip(&srs.Vk))
 	t.Run("whole SRS round-trip", utils.SerializationRoundTrip(srs))
+	t.Run("whole SRS raw round-trip", utils.SerializationRoundTripRaw(srs))
+
+	// with the optional G₂ powers
+	srs, err = NewSRSInsecure(64, testSeed, WithG2Powers(5))
+	assert.NoError(t, err)
+	t.Run("extended proving key round-trip", utils.SerializationRoundTrip(&srs.Pk))
+	t.Run("extended proving key raw round-trip", utils.SerializationRoundTripRaw(&srs.Pk))
+	t.Run("extended SRS round-trip", utils.SerializationRoundTrip(srs))
+	t.Run("extended SRS raw round-trip", utils.SerializationRoundTripRaw(srs))
+}
+
+func TestSerializationSRSExtendedG2(t *testing.T) {
+	assert := require.New(t)
+
+	// without G₂ powers, the encoding is unchanged
+	var buf bytes.Buffer
+	_, err := testSrs.WriteTo(&buf)
+	assert.NoError(err)
+	h, _, _, err := ecc.ReadHeader(bytes.NewReader(buf.Bytes()))
+	assert.NoError(err)
+	assert.Zero(h.Flags & ecc.FlagExtendedG2)
+
+	srs, err := NewSRSInsecure(16, testSeed, WithG2Powers(4))
+	assert.NoError(err)
+	buf.Reset()
+	_, err = srs.WriteTo(&buf)
+	assert.NoError(err)
+	h, _, _, err = ecc.ReadHeader(bytes.NewReader(buf.Bytes()))
+	assert.NoError(err)
+	assert.NotZero(h.Flags & ecc.FlagExtendedG2)
+
+	var read SRS
+	_, err = read.ReadFrom(bytes.NewReader(buf.Bytes()))
+	assert.NoError(err)
+	assert.Equal(srs.Pk.G2, read.Pk.G2)
+
+	// the G₂ powers must match the verifying key
+	srs.Pk.G2[1] = srs.Pk.G2[2]
+	buf.Reset()
+	_, err = srs.WriteTo(&buf)
+	assert.NoError(err)
+	_, err = read.ReadFrom(bytes.NewReader(buf.Bytes()))
+	assert.ErrorIs(err, ErrInvalidSRSG2)
+}
+
+func TestNewSRSInsecure(t *testing.T) {
+	assert := require.New(t)
+
+	// the SRS only depends on the seed
+	srs, err := NewSRSInsecure(64, testSeed)
+	assert.NoError(err)
+	for i := range srs.Pk.G1 {
+		assert.True(srs.Pk.G1[i].Equal(&testSrs.Pk.G1[i]), "srs differs")
+	}
+	assert.True(srs.Vk.G2[1].Equal(&testSrs.Vk.G2[1]), "srs differs")
+
+	alpha, err := insecureAlpha(testSeed)
+	assert.NoError(err)
+	var bAlpha big.Int
+	expected, err := NewSRS(64, alpha.BigInt(&bAlpha))
+	assert.NoError(err)
+	assert.True(expected.Pk.G1[63].Equal(&srs.Pk.G1[63]), "α doesn't match the seed")
+
+	other, err := NewSRSInsecure(64, []byte("another seed"))
+	assert.NoError(err)
+	assert.False(other.Pk.G1[1].Equal(&srs.Pk.G1[1]), "different seeds must give different srs")
+}
+
+func TestNewSRSOptions(t *testing.T) {
+	assert := require.New(t)
+
+	const nbG2 = 70
+	var calls, last uint64
+	srs, err := NewSRSInsecure(64, testSeed, WithG2Powers(nbG2), WithProgress(func(done, total uint64) {
+		assert.Equal(uint64(64+nbG2), total)
+		assert.Greater(done, last, "progress must increase")
+		calls++
+		last = done
+	}))
+	assert.NoError(err)
+	assert.NotZero(calls)
+	assert.Equal(uint64(64+nbG2), last, "the last call must have done == total")
+
+	// the options don't change the G₁ powers
+	for i := range srs.Pk.G1 {
+		assert.True(srs.Pk.G1[i].Equal(&testSrs.Pk.G1[i]), "srs differs")
+	}
+
+	alpha, err := insecureAlpha(testSeed)
+	assert.NoError(err)
+	assert.Equal(nbG2, len(srs.Pk.G2))
+	assert.True(srs.Pk.G2[0].Equal(&srs.Vk.G2[0]))
+	assert.True(srs.Pk.G2[1].Equal(&srs.Vk.G2[1]))
+	for _, i := range []int{2, 17, 63, nbG2 - 1} {
+		var ai fr.Element
+		var bai big.Int
+		ai.Exp(alpha, big.NewInt(int64(i)))
+		var expected bls12378.G2Affine
+		expected.ScalarMultiplication(&srs.Vk.G2[0], ai.BigInt(&bai))
+		assert.True(expected.Equal(&srs.Pk.G2[i]), "wrong G₂ power %d", i)
+	}
+
+	// quick SRS
+	quick, err := NewSRS(64, big.NewInt(-1), WithG2Powers(8))
+	assert.NoError(err)
+	assert.True(quick.Pk.G2[1].Equal(&quick.Vk.G2[1]))
+	assert.True(quick.Pk.G2[5].Equal(&quick.Vk.G2[1]))
+	assert.True(quick.Pk.G2[4].Equal(&quick.Vk.G2[0]))
+}
+
+func TestSRSExtend(t *testing.T) {
+	assert := require.New(t)
+
+	const size, newSize = 32, 80
+	full, err := NewSRSInsecure(newSize, testSeed)
+	assert.NoError(err)
+
+	// ceremony transcripts concatenate the points, compressed or not
+	var contribution bytes.Buffer
+	for i := size; i < newSize; i++ {
+		var b []byte
+		if i%2 == 0 {
+			buf := full.Pk.G1[i].Bytes()
+			b = buf[:]
+		} else {
+			buf := full.Pk.G1[i].RawBytes()
+			b = buf[:]
+		}
+		contribution.Write(b)
+	}
+	transcript := contribution.Bytes()
+
+	srs, err := NewSRSInsecure(size, testSeed)
+	assert.NoError(err)
+	assert.ErrorIs(srs.Extend(size, bytes.NewReader(transcript)), ErrSRSExtensionSize)
+	assert.Error(srs.Extend(newSize+1, bytes.NewReader(transcript)), "truncated contribution")
+	assert.Equal(size, len(srs.Pk.G1))
+
+	assert.NoError(srs.Extend(newSize, bytes.NewReader(transcript)))
+	assert.Equal(newSize, len(srs.Pk.G1))
+	for i := range full.Pk.G1 {
+		assert.True(full.Pk.G1[i].Equal(&srs.Pk.G1[i]), "extended srs differs")
+	}
+
+	// powers of another α must be rejected
+	srs, err = NewSRSInsecure(size, testSeed)
+	assert.NoError(err)
+	other, err := NewSRSInsecure(newSize, []byte("another seed"))
+	assert.NoError(err)
+	contribution.Reset()
+	for i := size; i < newSize; i++ {
+		buf := other.Pk.G1[i].Bytes()
+		contribution.Write(buf[:])
+	}
+	assert.ErrorIs(srs.Extend(newSize, &contribution), ErrInvalidSRSExtension)
+	assert.Equal(size, len(srs.Pk.G1))
+}
+
+func TestSRSSlice(t *testing.T) {
+	assert := require.New(t)
+
+	_, err := testSrs.Slice(10, 10)
+	assert.ErrorIs(err, ErrSRSSliceRange)
+	_, err = testSrs.Slice(0, uint64(len(testSrs.Pk.G1))+1)
+	assert.ErrorIs(err, ErrSRSSliceRange)
+
+	froms := []uint64{0, 0, 1, 17, 64}
+	tos := []uint64{1, 40, 2, 18, 200}
+	for i := range froms {
+		slice, err := testSrs.Slice(froms[i], tos[i])
+		assert.NoError(err)
+		assert.NoError(slice.Verify(&testSrs.Vk), "slice [%d, %d)", froms[i], tos[i])
+
+		// round-trip through the serialized form
+		var buf bytes.Buffer
+		_, err = slice.WriteTo(&buf)
+		assert.NoError(err)
+		var read SRSSlice
+		_, err = read.ReadFrom(&buf)
+		assert.NoError(err)
+		assert.Equal(slice.From, read.From)
+		assert.True(slice.Proof.Equal(&read.Proof))
+		assert.NoError(read.Verify(&testSrs.Vk))
+	}
+
+	// a slice claiming another position must be rejected
+	slice, err := testSrs.Slice(20, 40)
+	assert.NoError(err)
+	shifted, err := testSrs.Slice(21, 41)
+	assert.NoError(err)
+	shifted.Proof = slice.Proof
+	assert.ErrorIs(shifted.Verify(&testSrs.Vk), ErrInvalidSRSSlice)
+	shifted.From = 0
+	shifted.Proof = bls12378.G1Affine{}
+	assert.ErrorIs(shifted.Verify(&testSrs.Vk), ErrInvalidSRSSlice)
+
+	// powers of another α must be rejected
+	other, err := NewSRSInsecure(64, []byte("another seed"))
+	assert.NoError(err)
+	slice, err = other.Slice(10, 30)
+	assert.NoError(err)
+	assert.ErrorIs(slice.Verify(&testSrs.Vk), ErrInvalidSRSSlice)
+
+	// commitments computed from slices add up to the commitment of the polynomial
+	p := randomPolynomial(60)
+	expected, err := Commit(p, testSrs.Pk)
+	assert.NoError(err)
+	var sum bls12378.G1Jac
+	for from := 0; from < len(p); from += 25 {
+		to := from + 25
+		if to > len(p) {
+			to = len(p)
+		}
+		slice, err := testSrs.Slice(uint64(from), uint64(to))
+		assert.NoError(err)
+		assert.NoError(slice.Verify(&testSrs.Vk))
+		share, err := Commit(p[from:to], ProvingKey{G1: slice.G1})
+		assert.NoError(err)
+		sum.AddMixed(&share)
+	}
+	var sumAff bls12378.G1Affine
+	sumAff.FromJacobian(&sum)
+	assert.True(expected.Equal(&sumAff), "commitment shares do not add up")
+}
+
+func TestSerializationHeader(t *testing.T) {
+	assert := require.New(t)
+
+	// legacy encoding (without header) must still be readable
+	var buf bytes.Buffer
+	n, err := testSrs.writeTo(&buf)
+	assert.NoError(err)
+	var srs SRS
+	m, err := srs.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(n, m)
+	assert.Equal(testSrs, &srs)
+
+	// the header adds ecc.SizeOfHeader bytes to the encoding
+	buf.Reset()
+	m, err = testSrs.WriteTo(&buf)
+	assert.NoError(err)
+	assert.Equal(n+ecc.SizeOfHeader, m)
+
+	// object type mismatch
+	var proof OpeningProof
+	_, err = proof.ReadFrom(&buf)
+	assert.ErrorIs(err, ecc.ErrHeaderObject)
+
+	// curve mismatch
+	buf.Reset()
+	h := ecc.NewHeader(ecc.UNKNOWN, ecc.ObjectOpeningProof, 0)
+	_, err = h.WriteTo(&buf)
+	assert.NoError(err)
+	_, err = proof.ReadFrom(&buf)
+	assert.ErrorIs(err, ecc.ErrHeaderCurve)
+}
+
+func TestSerializationJSONCBOR(t *testing.T) {
+	assert := require.New(t)
+
+	var proof OpeningProof
+	_, _, g1, _ := bls12378.Generators()
+	proof.H.Set(&g1)
+	proof.ClaimedValue.SetRandom()
+
+	var batchProof BatchOpeningProof
+	batchProof.H.Set(&g1)
+	batchProof.ClaimedValues = make([]fr.Element, 5)
+	for i := range batchProof.ClaimedValues {
+		batchProof.ClaimedValues[i].SetRandom()
+	}
+
+	// json
+	b, err := json.Marshal(&proof)
+	assert.NoError(err)
+	var _proof OpeningProof
+	assert.NoError(json.Unmarshal(b, &_proof))
+	assert.Equal(proof, _proof)
+
+	b, err = json.Marshal(&batchProof)
+	assert.NoError(err)
+	var _batchProof BatchOpeningProof
+	assert.NoError(json.Unmarshal(b, &_batchProof))
+	assert.Equal(batchProof, _batchProof)
+
+	// cbor
+	b, err = proof.MarshalCBOR()
+	assert.NoError(err)
+	_proof = OpeningProof{}
+	assert.NoError(_proof.UnmarshalCBOR(b))
+	assert.Equal(proof, _proof)
+
+	b, err = batchProof.MarshalCBOR()
+	assert.NoError(err)
+	_batchProof = BatchOpeningProof{}
+	assert.NoError(_batchProof.UnmarshalCBOR(b))
+	assert.Equal(batchProof, _batchProof)
+
+	// trailing bytes are rejected
+	b = append(b, 0)
+	assert.Error(_batchProof.UnmarshalCBOR(b))
+}
+
+func TestSerializationNonCanonical(t *testing.T) {
+	assert := require.New(t)
+
+	var proof OpeningProof
+	_, _, g1, _ := bls12378.Generators()
+	proof.H.Set(&g1)
+	proof.ClaimedValue.SetOne()
+
+	var buf bytes.Buffer
+	_, err := proof.WriteTo(&buf)
+	assert.NoError(err)
+
+	// replace the claimed value with modulus + 1
+	b := buf.Bytes()
+	new(big.Int).Add(fr.Modulus(), big.NewInt(1)).FillBytes(b[len(b)-fr.Bytes:])
+
+	var _proof OpeningProof
+	_, err = _proof.ReadFrom(bytes.NewReader(b))
+	assert.Error(err, "non-canonical encoding should be rejected")
+
+	n, err := _proof.LenientReadFrom(bytes.NewReader(b))
+	assert.NoError(err)
+	assert.Equal(int64(len(b)), n)
+	assert.Equal(proof, _proof)
 }
 
 func TestCommit(t *testing.T) {
@@ -180,8 +577,10 @@ func TestCommit(t *testing.T) {
 	kzgCommit.Unmarshal(_kzgCommit.Marshal())
 
 	// check commitment using manual commit
-	var x fr.Element
-	x.SetString("42")
+	x, err := insecureAlpha(testSeed)
+	if err != nil {
+		t.Fatal(err)
+	}
 	fx := eval(f, x)
 	var fxbi big.Int
 	fx.BigInt(&fxbi)
@@ -196,6 +595,25 @@ func TestCommit(t *testing.T) {
 
 }
 
+func TestCommitJac(t *testing.T) {
+	assert := require.New(t)
+
+	f := randomPolynomial(60)
+	expected, err := Commit(f, testSrs.Pk)
+	assert.NoError(err)
+
+	resJac, err := CommitJac(f, testSrs.Pk)
+	assert.NoError(err)
+	var res bls12378.G1Affine
+	res.FromJacobian(&resJac)
+	assert.True(expected.Equal(&res))
+
+	_, err = CommitJac(nil, testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+	_, err = CommitJac(make([]fr.Element, len(testSrs.Pk.G1)+1), testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+}
+
 func TestVerifySinglePoint(t *testing.T) {
 
 	// create a polynomial
@@ -247,6 +665,155 @@ func TestVerifySinglePoint(t *testing.T) {
 	}
 }
 
+func TestVerifySinglePointOtherG1(t *testing.T) {
+
+	// an SRS whose G₁ powers are [c·αⁱ]G₁, so that vk.G1 is not the generator
+	var c fr.Element
+	c.SetRandom()
+	var cBigInt big.Int
+	c.BigInt(&cBigInt)
+	var srs SRS
+	srs.Vk = testSrs.Vk
+	srs.Vk.G1.ScalarMultiplication(&testSrs.Vk.G1, &cBigInt)
+	srs.Pk.G1 = make([]bls12378.G1Affine, 64)
+	for i := range srs.Pk.G1 {
+		srs.Pk.G1[i].ScalarMultiplication(&testSrs.Pk.G1[i], &cBigInt)
+	}
+
+	f := randomPolynomial(60)
+	digest, err := Commit(f, srs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var point fr.Element
+	point.SetRandom()
+	proof, err := Open(f, point, srs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Verify(&digest, &proof, point, srs.Vk); err != nil {
+		t.Fatal(err)
+	}
+
+	proof.ClaimedValue.Double(&proof.ClaimedValue)
+	if err := Verify(&digest, &proof, point, srs.Vk); err == nil {
+		t.Fatal("verifying wrong proof should have failed")
+	}
+}
+
+func TestOpenWithValue(t *testing.T) {
+
+	f := randomPolynomial(60)
+	digest, err := Commit(f, testSrs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var point fr.Element
+	point.SetRandom()
+	claimedValue := eval(f, point)
+
+	proof, err := OpenWithValue(f, point, claimedValue, testSrs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := Open(f, point, testSrs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proof.H.Equal(&expected.H) || !proof.ClaimedValue.Equal(&expected.ClaimedValue) {
+		t.Fatal("OpenWithValue and Open should produce the same proof")
+	}
+	if err := Verify(&digest, &proof, point, testSrs.Vk); err != nil {
+		t.Fatal(err)
+	}
+
+	// a wrong hint gives a proof that doesn't verify
+	var wrong fr.Element
+	wrong.SetOne().Add(&wrong, &claimedValue)
+	proof, err = OpenWithValue(f, point, wrong, testSrs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Verify(&digest, &proof, point, testSrs.Vk); err == nil {
+		t.Fatal("verifying a proof with a wrong claimed value should have failed")
+	}
+}
+
+func TestLinearCombination(t *testing.T) {
+	assert := require.New(t)
+
+	// sizes straddling the chunks, the first polynomial not being the largest
+	sizes := []int{3 * linearCombinationChunkSize / 2, 5, 2*linearCombinationChunkSize + 7, linearCombinationChunkSize}
+	polynomials := make([][]fr.Element, len(sizes))
+	c := make([]fr.Element, len(sizes))
+	for i := range polynomials {
+		polynomials[i] = randomPolynomial(sizes[i])
+		c[i].SetRandom()
+	}
+	c[2].SetOne()
+
+	res := linearCombination(polynomials, c, sizes[2])
+	assert.Equal(sizes[2], len(res))
+
+	var expected, tmp fr.Element
+	for j := range res {
+		expected.SetZero()
+		for i, p := range polynomials {
+			if j < len(p) {
+				tmp.Mul(&p[j], &c[i])
+				expected.Add(&expected, &tmp)
+			}
+		}
+		assert.True(expected.Equal(&res[j]), "coefficient %d", j)
+	}
+}
+
+func TestBatchOpenSinglePointWithValues(t *testing.T) {
+
+	f := make([][]fr.Element, 5)
+	for i := range f {
+		f[i] = randomPolynomial(40)
+	}
+	digests := make([]Digest, len(f))
+	for i := range f {
+		digests[i], _ = Commit(f[i], testSrs.Pk)
+	}
+	hf := sha256.New()
+
+	var point fr.Element
+	point.SetRandom()
+	claimedValues := make([]fr.Element, len(f))
+	for i := range f {
+		claimedValues[i] = eval(f[i], point)
+	}
+
+	proof, err := BatchOpenSinglePointWithValues(f, digests, claimedValues, point, hf, testSrs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := BatchOpenSinglePoint(f, digests, point, hf, testSrs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proof.H.Equal(&expected.H) {
+		t.Fatal("BatchOpenSinglePointWithValues and BatchOpenSinglePoint should produce the same proof")
+	}
+	if err := BatchVerifySinglePoint(digests, &proof, point, hf, testSrs.Vk); err != nil {
+		t.Fatal(err)
+	}
+
+	// the proof doesn't alias the claimed values
+	claimedValues[0].SetZero()
+	if err := BatchVerifySinglePoint(digests, &proof, point, hf, testSrs.Vk); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := BatchOpenSinglePointWithValues(f, digests, claimedValues[1:], point, hf, testSrs.Pk); err != ErrInvalidNbClaimedValues {
+		t.Fatal("expected ErrInvalidNbClaimedValues, got", err)
+	}
+}
+
 func TestVerifySinglePointQuickSRS(t *testing.T) {
 
 	size := 64
@@ -363,6 +930,40 @@ func TestBatchVerifySinglePoint(t *testing.T) {
 	}
 }
 
+func TestBatchVerifySinglePointChallengeBits(t *testing.T) {
+
+	f := make([][]fr.Element, 3)
+	digests := make([]Digest, len(f))
+	for i := range f {
+		f[i] = randomPolynomial(40)
+		digests[i], _ = Commit(f[i], testSrs.Pk)
+	}
+	var point fr.Element
+	point.SetString("4321")
+
+	// the folding challenge is truncated to 128 bits on both sides
+	hf := fiatshamir.WithChallengeBits(sha256.New(), 128)
+	proof, err := BatchOpenSinglePoint(f, digests, point, hf, testSrs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gamma, err := deriveGamma(point, digests, proof.ClaimedValues, hf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gamma.BigInt(new(big.Int)).BitLen() > 128 {
+		t.Fatal("the challenge should be truncated to 128 bits")
+	}
+	if err := BatchVerifySinglePoint(digests, &proof, point, hf, testSrs.Vk); err != nil {
+		t.Fatal(err)
+	}
+
+	// a verifier with full challenges rejects the proof
+	if err := BatchVerifySinglePoint(digests, &proof, point, sha256.New(), testSrs.Vk); err == nil {
+		t.Fatal("verifying with another challenge length should fail")
+	}
+}
+
 func TestBatchVerifyMultiPoints(t *testing.T) {
 
 	// create polynomials
@@ -410,6 +1011,44 @@ func TestBatchVerifyMultiPoints(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	// the folding coefficients can come from a transcript or a given randomness source
+	seed := make([]byte, 64*(fr.Bytes+16))
+	for i := range seed {
+		seed[i] = byte(i)
+	}
+	options := map[string]BatchVerifyOption{
+		"transcript": WithTranscript(sha256.New(), []byte("data")),
+		"randomness": WithRandomness(bytes.NewReader(seed)),
+	}
+	for name, opt := range options {
+		if err := BatchVerifyMultiPoints(foldedDigests, proofs, points, testSrs.Vk, opt); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+	}
+
+	// the coefficients derived from a transcript are deterministic
+	{
+		var cfg1, cfg2 batchVerifyConfig
+		WithTranscript(sha256.New())(&cfg1)
+		WithTranscript(sha256.New())(&cfg2)
+		l1, err := sampleFoldingCoefficients(&cfg1, foldedDigests, proofs, points)
+		if err != nil {
+			t.Fatal(err)
+		}
+		l2, err := sampleFoldingCoefficients(&cfg2, foldedDigests, proofs, points)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !l1[1].Equal(&l2[1]) || l1[1].IsOne() {
+			t.Fatal("the folding coefficients should be derived deterministically from the transcript")
+		}
+	}
+
+	// a randomness source too short is an error
+	if err := BatchVerifyMultiPoints(foldedDigests, proofs, points, testSrs.Vk, WithRandomness(bytes.NewReader(nil))); err == nil {
+		t.Fatal("expected an error, the randomness source is empty")
+	}
+
 	{
 		// batch verify tampered folded proofs
 		proofs[0].ClaimedValue.Double(&proofs[0].ClaimedValue)
@@ -418,6 +1057,10 @@ func TestBatchVerifyMultiPoints(t *testing.T) {
 		if err == nil {
 			t.Fatal(err)
 		}
+		err = BatchVerifyMultiPoints(foldedDigests, proofs, points, testSrs.Vk, WithTranscript(sha256.New()))
+		if err == nil {
+			t.Fatal("verifying tampered proofs with a transcript should have failed")
+		}
 	}
 	{
 		// batch verify tampered folded proofs with quotients set to infinity
@@ -441,7 +1084,7 @@ func BenchmarkSRSGen(b *testing.B) {
 	b.Run("real SRS", func(b *testing.B) {
 		b.ResetTimer()
 		for i := 0; i < b.N; i++ {
-			NewSRS(ecc.NextPowerOfTwo(benchSize), new(big.Int).SetInt64(42))
+			NewSRSInsecure(ecc.NextPowerOfTwo(benchSize), testSeed)
 		}
 	})
 	b.Run("quick SRS", func(b *testing.B) {
@@ -450,12 +1093,18 @@ func BenchmarkSRSGen(b *testing.B) {
 			NewSRS(ecc.NextPowerOfTwo(benchSize), big.NewInt(-1))
 		}
 	})
+	b.Run("real SRS with G2 powers", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			NewSRSInsecure(ecc.NextPowerOfTwo(benchSize), testSeed, WithG2Powers(64))
+		}
+	})
 }
 
 func BenchmarkKZGCommit(b *testing.B) {
 
 	b.Run("real SRS", func(b *testing.B) {
-		srs, err := NewSRS(ecc.NextPowerOfTwo(benchSize), new(big.Int).SetInt64(42))
+		srs, err := NewSRSInsecure(ecc.NextPowerOfTwo(benchSize), testSeed)
 		assert.NoError(b, err)
 		// random polynomial
 		p := randomPolynomial(benchSize / 2)
@@ -500,7 +1149,7 @@ func BenchmarkDivideByXMinusA(b *testing.B) {
 }
 
 func BenchmarkKZGOpen(b *testing.B) {
-	srs, err := NewSRS(ecc.NextPowerOfTwo(benchSize), new(big.Int).SetInt64(42))
+	srs, err := NewSRSInsecure(ecc.NextPowerOfTwo(benchSize), testSeed)
 	assert.NoError(b, err)
 
 	// random polynomial
@@ -515,7 +1164,7 @@ func BenchmarkKZGOpen(b *testing.B) {
 }
 
 func BenchmarkKZGVerify(b *testing.B) {
-	srs, err := NewSRS(ecc.NextPowerOfTwo(benchSize), new(big.Int).SetInt64(42))
+	srs, err := NewSRSInsecure(ecc.NextPowerOfTwo(benchSize), testSeed)
 	assert.NoError(b, err)
 
 	// random polynomial
@@ -538,7 +1187,7 @@ func BenchmarkKZGVerify(b *testing.B) {
 }
 
 func BenchmarkKZGBatchOpen10(b *testing.B) {
-	srs, err := NewSRS(ecc.NextPowerOfTwo(benchSize), new(big.Int).SetInt64(42))
+	srs, err := NewSRSInsecure(ecc.NextPowerOfTwo(benchSize), testSeed)
 	assert.NoError(b, err)
 
 	// 10 random polynomials
@@ -566,7 +1215,7 @@ func BenchmarkKZGBatchOpen10(b *testing.B) {
 }
 
 func BenchmarkKZGBatchVerify10(b *testing.B) {
-	srs, err := NewSRS(ecc.NextPowerOfTwo(benchSize), new(big.Int).SetInt64(42))
+	srs, err := NewSRSInsecure(ecc.NextPowerOfTwo(benchSize), testSeed)
 	if err != nil {
 		b.Fatal(err)
 	}
@@ -0,0 +1,67 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"errors"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+)
+
+// Multilinear polynomial commitments.
+//
+// A multilinear polynomial f in n variables is given by its 2ⁿ evaluations on the boolean
+// hypercube, and committed to as the univariate polynomial ∑ᵢf(i)Xⁱ with Commit: the
+// evaluations are the coefficients. As in polynomial.MultiLin, point[0] is the variable of the
+// most significant bit of the index i, and point[n-1] the variable of its least significant bit.
+//
+// GeminiOpen and ZeromorphOpen reduce the opening of f at a point of 𝔽ⁿ to openings of
+// univariate polynomials, so that multilinear proof systems (e.g. HyperPlonk) can use the KZG
+// scheme of this package.
+
+var (
+	ErrInvalidMultilinearSize = errors.New("number of evaluations of a multilinear polynomial must be a power of two, at least 2")
+	ErrInvalidNbVariables     = errors.New("number of coordinates of the point is not the number of variables of the polynomial")
+)
+
+// nbVariables returns the number of variables of a multilinear polynomial with nbEvaluations
+// evaluations on the hypercube, checked against the number of coordinates of the point
+func nbVariables(nbEvaluations, nbCoordinates int) (int, error) {
+	if nbEvaluations < 2 || nbEvaluations&(nbEvaluations-1) != 0 {
+		return 0, ErrInvalidMultilinearSize
+	}
+	n := bits.TrailingZeros(uint(nbEvaluations))
+	if n != nbCoordinates {
+		return 0, ErrInvalidNbVariables
+	}
+	return n, nil
+}
+
+// bindMultilinearOpening returns the data the first challenge of a multilinear opening is bound to
+func bindMultilinearOpening(digest *Digest, point []fr.Element, claimedValue *fr.Element, digests []Digest, dataTranscript [][]byte) [][]byte {
+	res := make([][]byte, 0, len(point)+len(digests)+len(dataTranscript)+2)
+	res = append(res, digest.Marshal())
+	for i := range point {
+		res = append(res, point[i].Marshal())
+	}
+	res = append(res, claimedValue.Marshal())
+	for i := range digests {
+		res = append(res, digests[i].Marshal())
+	}
+	return append(res, dataTranscript...)
+}
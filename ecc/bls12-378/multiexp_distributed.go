@@ -0,0 +1,358 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12378
+
+import (
+	"errors"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+)
+
+var (
+	errShardSize        = errors.New("the number of bases and scalars doesn't match the shard")
+	errPartialsCoverage = errors.New("the partial results don't cover the multi-exponentiation exactly once")
+)
+
+// PartialMultiExpG1 is the result of a worker on its shard of a distributed
+// multi-exponentiation (see ecc.PartitionMultiExp).
+//
+// implements io.ReaderFrom and io.WriterTo
+type PartialMultiExpG1 struct {
+	Shard  ecc.MultiExpShard
+	Result G1Affine // ∑ scalars[i]·points[i], Shard.Start ≤ i < Shard.End
+}
+
+// NewPartialMultiExpG1 computes the share of shard of a multi-exponentiation.
+// points and scalars are the bases and scalars of the shard only, so that a worker
+// needs nothing else than its share of the inputs.
+func NewPartialMultiExpG1(shard ecc.MultiExpShard, points []G1Affine, scalars []fr.Element, config ecc.MultiExpConfig) (PartialMultiExpG1, error) {
+	if shard.End < shard.Start || len(points) != shard.Len() || len(scalars) != shard.Len() {
+		return PartialMultiExpG1{}, errShardSize
+	}
+	res := PartialMultiExpG1{Shard: shard}
+	if len(points) == 0 {
+		return res, nil
+	}
+	if _, err := res.Result.MultiExp(points, scalars, config); err != nil {
+		return PartialMultiExpG1{}, err
+	}
+	return res, nil
+}
+
+// CombinePartialMultiExpG1 returns the result of a multi-exponentiation of size n
+// from the partial results of the workers, given in any order. It returns an error if
+// the shards of the partial results don't partition [0, n); empty shards are ignored.
+func CombinePartialMultiExpG1(n uint64, partials []PartialMultiExpG1) (G1Affine, error) {
+	order := make([]int, len(partials))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return partials[order[i]].Shard.Start < partials[order[j]].Shard.Start
+	})
+
+	var next uint64
+	var acc G1Jac
+	for _, i := range order {
+		shard := partials[i].Shard
+		if shard.Start != next || shard.End < shard.Start {
+			return G1Affine{}, errPartialsCoverage
+		}
+		acc.AddMixed(&partials[i].Result)
+		next = shard.End
+	}
+	if next != n {
+		return G1Affine{}, errPartialsCoverage
+	}
+
+	var res G1Affine
+	res.FromJacobian(&acc)
+	return res, nil
+}
+
+// MultiExpSessionG1 computes a multi-exponentiation whose bases and scalars arrive
+// in chunks, e.g. scalars streamed from another machine: each chunk is reduced to its partial
+// sum (with the bucket method of MultiExp) as soon as it is added, so that the computation
+// overlaps with the transfer and decoding of the next chunks.
+//
+// Add can be called concurrently. The zero value is not usable, see NewMultiExpSessionG1.
+type MultiExpSessionG1 struct {
+	config   ecc.MultiExpConfig
+	lock     sync.Mutex
+	partials []G1Jac
+	n        int
+}
+
+// NewMultiExpSessionG1 returns an empty session, whose chunks are computed with config
+func NewMultiExpSessionG1(config ecc.MultiExpConfig) *MultiExpSessionG1 {
+	return &MultiExpSessionG1{config: config}
+}
+
+// Add computes and returns the partial sum ∑ scalars[i]·points[i] of a chunk, and keeps it
+// for Result.
+func (s *MultiExpSessionG1) Add(points []G1Affine, scalars []fr.Element) (G1Jac, error) {
+	var partial G1Jac
+	if len(points) != 0 || len(scalars) != 0 {
+		if _, err := partial.MultiExp(points, scalars, s.config); err != nil {
+			return partial, err
+		}
+	}
+
+	s.lock.Lock()
+	s.partials = append(s.partials, partial)
+	s.n += len(points)
+	s.lock.Unlock()
+	return partial, nil
+}
+
+// Partials returns the partial sums of the chunks added so far, in the order their
+// computation ended.
+func (s *MultiExpSessionG1) Partials() []G1Jac {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	res := make([]G1Jac, len(s.partials))
+	copy(res, s.partials)
+	return res
+}
+
+// Len returns the number of bases and scalars added so far
+func (s *MultiExpSessionG1) Len() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.n
+}
+
+// Result returns the multi-exponentiation of all the chunks added so far, that is the sum of
+// their partial sums (see CombineMultiExpG1).
+func (s *MultiExpSessionG1) Result() G1Jac {
+	return CombineMultiExpG1(s.Partials())
+}
+
+// CombineMultiExpG1 returns the sum of partial results of a multi-exponentiation
+// (e.g. returned by MultiExpSessionG1.Add), given in any order.
+func CombineMultiExpG1(partials []G1Jac) G1Jac {
+	var res G1Jac
+	res.Set(&g1Infinity)
+	for i := range partials {
+		res.AddAssign(&partials[i])
+	}
+	return res
+}
+
+// WriteTo writes the binary encoding of the partial result to w
+func (p *PartialMultiExpG1) WriteTo(w io.Writer) (int64, error) {
+	h := ecc.NewHeader(ecc.BLS12_378, ecc.ObjectPartialMultiExpG1, 0)
+	hn, err := h.WriteTo(w)
+	if err != nil {
+		return hn, err
+	}
+
+	enc := NewEncoder(w)
+	for _, v := range []interface{}{p.Shard.Start, p.Shard.End, &p.Result} {
+		if err := enc.Encode(v); err != nil {
+			return hn + enc.BytesWritten(), err
+		}
+	}
+	return hn + enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes a partial result from r. The point is checked to be in the
+// correct subgroup.
+func (p *PartialMultiExpG1) ReadFrom(r io.Reader) (int64, error) {
+	h, r, hn, err := ecc.ReadHeader(r)
+	if err != nil {
+		return hn, err
+	}
+	if err := h.Check(ecc.BLS12_378, ecc.ObjectPartialMultiExpG1); err != nil {
+		return hn, err
+	}
+
+	dec := NewDecoder(r)
+	for _, v := range []interface{}{&p.Shard.Start, &p.Shard.End, &p.Result} {
+		if err := dec.Decode(v); err != nil {
+			return hn + dec.BytesRead(), err
+		}
+	}
+	return hn + dec.BytesRead(), nil
+}
+
+// PartialMultiExpG2 is the result of a worker on its shard of a distributed
+// multi-exponentiation (see ecc.PartitionMultiExp).
+//
+// implements io.ReaderFrom and io.WriterTo
+type PartialMultiExpG2 struct {
+	Shard  ecc.MultiExpShard
+	Result G2Affine // ∑ scalars[i]·points[i], Shard.Start ≤ i < Shard.End
+}
+
+// NewPartialMultiExpG2 computes the share of shard of a multi-exponentiation.
+// points and scalars are the bases and scalars of the shard only, so that a worker
+// needs nothing else than its share of the inputs.
+func NewPartialMultiExpG2(shard ecc.MultiExpShard, points []G2Affine, scalars []fr.Element, config ecc.MultiExpConfig) (PartialMultiExpG2, error) {
+	if shard.End < shard.Start || len(points) != shard.Len() || len(scalars) != shard.Len() {
+		return PartialMultiExpG2{}, errShardSize
+	}
+	res := PartialMultiExpG2{Shard: shard}
+	if len(points) == 0 {
+		return res, nil
+	}
+	if _, err := res.Result.MultiExp(points, scalars, config); err != nil {
+		return PartialMultiExpG2{}, err
+	}
+	return res, nil
+}
+
+// CombinePartialMultiExpG2 returns the result of a multi-exponentiation of size n
+// from the partial results of the workers, given in any order. It returns an error if
+// the shards of the partial results don't partition [0, n); empty shards are ignored.
+func CombinePartialMultiExpG2(n uint64, partials []PartialMultiExpG2) (G2Affine, error) {
+	order := make([]int, len(partials))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return partials[order[i]].Shard.Start < partials[order[j]].Shard.Start
+	})
+
+	var next uint64
+	var acc G2Jac
+	for _, i := range order {
+		shard := partials[i].Shard
+		if shard.Start != next || shard.End < shard.Start {
+			return G2Affine{}, errPartialsCoverage
+		}
+		acc.AddMixed(&partials[i].Result)
+		next = shard.End
+	}
+	if next != n {
+		return G2Affine{}, errPartialsCoverage
+	}
+
+	var res G2Affine
+	res.FromJacobian(&acc)
+	return res, nil
+}
+
+// MultiExpSessionG2 computes a multi-exponentiation whose bases and scalars arrive
+// in chunks, e.g. scalars streamed from another machine: each chunk is reduced to its partial
+// sum (with the bucket method of MultiExp) as soon as it is added, so that the computation
+// overlaps with the transfer and decoding of the next chunks.
+//
+// Add can be called concurrently. The zero value is not usable, see NewMultiExpSessionG2.
+type MultiExpSessionG2 struct {
+	config   ecc.MultiExpConfig
+	lock     sync.Mutex
+	partials []G2Jac
+	n        int
+}
+
+// NewMultiExpSessionG2 returns an empty session, whose chunks are computed with config
+func NewMultiExpSessionG2(config ecc.MultiExpConfig) *MultiExpSessionG2 {
+	return &MultiExpSessionG2{config: config}
+}
+
+// Add computes and returns the partial sum ∑ scalars[i]·points[i] of a chunk, and keeps it
+// for Result.
+func (s *MultiExpSessionG2) Add(points []G2Affine, scalars []fr.Element) (G2Jac, error) {
+	var partial G2Jac
+	if len(points) != 0 || len(scalars) != 0 {
+		if _, err := partial.MultiExp(points, scalars, s.config); err != nil {
+			return partial, err
+		}
+	}
+
+	s.lock.Lock()
+	s.partials = append(s.partials, partial)
+	s.n += len(points)
+	s.lock.Unlock()
+	return partial, nil
+}
+
+// Partials returns the partial sums of the chunks added so far, in the order their
+// computation ended.
+func (s *MultiExpSessionG2) Partials() []G2Jac {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	res := make([]G2Jac, len(s.partials))
+	copy(res, s.partials)
+	return res
+}
+
+// Len returns the number of bases and scalars added so far
+func (s *MultiExpSessionG2) Len() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.n
+}
+
+// Result returns the multi-exponentiation of all the chunks added so far, that is the sum of
+// their partial sums (see CombineMultiExpG2).
+func (s *MultiExpSessionG2) Result() G2Jac {
+	return CombineMultiExpG2(s.Partials())
+}
+
+// CombineMultiExpG2 returns the sum of partial results of a multi-exponentiation
+// (e.g. returned by MultiExpSessionG2.Add), given in any order.
+func CombineMultiExpG2(partials []G2Jac) G2Jac {
+	var res G2Jac
+	res.Set(&g2Infinity)
+	for i := range partials {
+		res.AddAssign(&partials[i])
+	}
+	return res
+}
+
+// WriteTo writes the binary encoding of the partial result to w
+func (p *PartialMultiExpG2) WriteTo(w io.Writer) (int64, error) {
+	h := ecc.NewHeader(ecc.BLS12_378, ecc.ObjectPartialMultiExpG2, 0)
+	hn, err := h.WriteTo(w)
+	if err != nil {
+		return hn, err
+	}
+
+	enc := NewEncoder(w)
+	for _, v := range []interface{}{p.Shard.Start, p.Shard.End, &p.Result} {
+		if err := enc.Encode(v); err != nil {
+			return hn + enc.BytesWritten(), err
+		}
+	}
+	return hn + enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes a partial result from r. The point is checked to be in the
+// correct subgroup.
+func (p *PartialMultiExpG2) ReadFrom(r io.Reader) (int64, error) {
+	h, r, hn, err := ecc.ReadHeader(r)
+	if err != nil {
+		return hn, err
+	}
+	if err := h.Check(ecc.BLS12_378, ecc.ObjectPartialMultiExpG2); err != nil {
+		return hn, err
+	}
+
+	dec := NewDecoder(r)
+	for _, v := range []interface{}{&p.Shard.Start, &p.Shard.End, &p.Result} {
+		if err := dec.Decode(v); err != nil {
+			return hn + dec.BytesRead(), err
+		}
+	}
+	return hn + dec.BytesRead(), nil
+}
@@ -98,6 +98,96 @@ func TestMultiExpG1(t *testing.T) {
 		genScalar,
 	))
 
+	properties.Property("[G1] Multi exponentiation with small scalars (config.ScalarBits) should be consistent with default multiexp", prop.ForAll(
+		func(mixer fr.Element) bool {
+			var sampleScalars [nbSamples]fr.Element
+			m := mixer.Bits()
+			for i := 1; i <= nbSamples; i++ {
+				sampleScalars[i-1].SetUint64((m[0] + uint64(i)) & 0xffffffff)
+			}
+			sampleScalars[3].SetUint64(0xffffffff)
+
+			var expected G1Jac
+			expected.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{})
+
+			ok := true
+			for _, nbBits := range []int{32, 33, 64} {
+				var r G1Jac
+				_, err := r.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{ScalarBits: nbBits})
+				ok = ok && err == nil && r.Equal(&expected)
+			}
+
+			// scalars larger than the bound are rejected
+			var r G1Jac
+			_, err := r.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{ScalarBits: 31})
+			return ok && err != nil
+		},
+		genScalar,
+	))
+
+	properties.Property("[G1] Multi exponentiation with canonical scalars (config.ScalarsCanonical) should be consistent with default multiexp", prop.ForAll(
+		func(mixer fr.Element) bool {
+			var sampleScalars [nbSamples]fr.Element
+			for i := 1; i <= nbSamples; i++ {
+				sampleScalars[i-1].SetUint64(uint64(i)).
+					Mul(&sampleScalars[i-1], &mixer)
+			}
+			sampleScalars[3].SetZero()
+
+			var expected G1Jac
+			expected.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{})
+
+			canonical := append(fr.Vector(nil), sampleScalars[:]...).IntoCanonical()
+			var r, rSmall G1Jac
+			_, err := r.MultiExp(samplePoints[:], canonical, ecc.MultiExpConfig{ScalarsCanonical: true, DeduplicateScalars: true})
+			if err != nil || !r.Equal(&expected) {
+				return false
+			}
+
+			// small scalars
+			var small [nbSamples]fr.Element
+			for i := range small {
+				small[i].SetUint64(uint64(i) * 0xfff1)
+			}
+			expected.MultiExp(samplePoints[:], small[:], ecc.MultiExpConfig{})
+			canonical = append(fr.Vector(nil), small[:]...).IntoCanonical()
+			_, err = rSmall.MultiExp(samplePoints[:], canonical, ecc.MultiExpConfig{ScalarsCanonical: true, ScalarBits: 32})
+			return err == nil && rSmall.Equal(&expected)
+		},
+		genScalar,
+	))
+
+	properties.Property("[G1] Multi exponentiation with scalar deduplication should be consistent with default multiexp", prop.ForAll(
+		func(mixer fr.Element) bool {
+			// few distinct scalars, including 0 and 1
+			var sampleScalars [nbSamples]fr.Element
+			for i := 0; i < nbSamples; i++ {
+				switch i % 4 {
+				case 0:
+					sampleScalars[i].SetZero()
+				case 1:
+					sampleScalars[i].SetOne()
+				default:
+					sampleScalars[i].SetUint64(uint64(i%3)).Add(&sampleScalars[i], &mixer)
+				}
+			}
+
+			var expected, r G1Jac
+			expected.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{})
+			_, err := r.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{DeduplicateScalars: true})
+			if err != nil || !r.Equal(&expected) {
+				return false
+			}
+
+			// all scalars are zero
+			var zeros [nbSamples]fr.Element
+			expected.MultiExp(samplePoints[:], zeros[:], ecc.MultiExpConfig{})
+			_, err = r.MultiExp(samplePoints[:], zeros[:], ecc.MultiExpConfig{DeduplicateScalars: true})
+			return err == nil && r.Equal(&expected)
+		},
+		genScalar,
+	))
+
 	// cRange is generated from template and contains the available parameters for the multiexp window size
 	cRange := []uint64{2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
 	if testing.Short() {
@@ -514,6 +604,96 @@ func TestMultiExpG2(t *testing.T) {
 		genScalar,
 	))
 
+	properties.Property("[G2] Multi exponentiation with small scalars (config.ScalarBits) should be consistent with default multiexp", prop.ForAll(
+		func(mixer fr.Element) bool {
+			var sampleScalars [nbSamples]fr.Element
+			m := mixer.Bits()
+			for i := 1; i <= nbSamples; i++ {
+				sampleScalars[i-1].SetUint64((m[0] + uint64(i)) & 0xffffffff)
+			}
+			sampleScalars[3].SetUint64(0xffffffff)
+
+			var expected G2Jac
+			expected.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{})
+
+			ok := true
+			for _, nbBits := range []int{32, 33, 64} {
+				var r G2Jac
+				_, err := r.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{ScalarBits: nbBits})
+				ok = ok && err == nil && r.Equal(&expected)
+			}
+
+			// scalars larger than the bound are rejected
+			var r G2Jac
+			_, err := r.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{ScalarBits: 31})
+			return ok && err != nil
+		},
+		genScalar,
+	))
+
+	properties.Property("[G2] Multi exponentiation with canonical scalars (config.ScalarsCanonical) should be consistent with default multiexp", prop.ForAll(
+		func(mixer fr.Element) bool {
+			var sampleScalars [nbSamples]fr.Element
+			for i := 1; i <= nbSamples; i++ {
+				sampleScalars[i-1].SetUint64(uint64(i)).
+					Mul(&sampleScalars[i-1], &mixer)
+			}
+			sampleScalars[3].SetZero()
+
+			var expected G2Jac
+			expected.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{})
+
+			canonical := append(fr.Vector(nil), sampleScalars[:]...).IntoCanonical()
+			var r, rSmall G2Jac
+			_, err := r.MultiExp(samplePoints[:], canonical, ecc.MultiExpConfig{ScalarsCanonical: true, DeduplicateScalars: true})
+			if err != nil || !r.Equal(&expected) {
+				return false
+			}
+
+			// small scalars
+			var small [nbSamples]fr.Element
+			for i := range small {
+				small[i].SetUint64(uint64(i) * 0xfff1)
+			}
+			expected.MultiExp(samplePoints[:], small[:], ecc.MultiExpConfig{})
+			canonical = append(fr.Vector(nil), small[:]...).IntoCanonical()
+			_, err = rSmall.MultiExp(samplePoints[:], canonical, ecc.MultiExpConfig{ScalarsCanonical: true, ScalarBits: 32})
+			return err == nil && rSmall.Equal(&expected)
+		},
+		genScalar,
+	))
+
+	properties.Property("[G2] Multi exponentiation with scalar deduplication should be consistent with default multiexp", prop.ForAll(
+		func(mixer fr.Element) bool {
+			// few distinct scalars, including 0 and 1
+			var sampleScalars [nbSamples]fr.Element
+			for i := 0; i < nbSamples; i++ {
+				switch i % 4 {
+				case 0:
+					sampleScalars[i].SetZero()
+				case 1:
+					sampleScalars[i].SetOne()
+				default:
+					sampleScalars[i].SetUint64(uint64(i%3)).Add(&sampleScalars[i], &mixer)
+				}
+			}
+
+			var expected, r G2Jac
+			expected.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{})
+			_, err := r.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{DeduplicateScalars: true})
+			if err != nil || !r.Equal(&expected) {
+				return false
+			}
+
+			// all scalars are zero
+			var zeros [nbSamples]fr.Element
+			expected.MultiExp(samplePoints[:], zeros[:], ecc.MultiExpConfig{})
+			_, err = r.MultiExp(samplePoints[:], zeros[:], ecc.MultiExpConfig{DeduplicateScalars: true})
+			return err == nil && r.Equal(&expected)
+		},
+		genScalar,
+	))
+
 	// cRange is generated from template and contains the available parameters for the multiexp window size
 	// for g2, CI suffers with large c size since it needs to allocate a lot of memory for the buckets.
 	// test only "odd" and "even" (ie windows size divide word size vs not)
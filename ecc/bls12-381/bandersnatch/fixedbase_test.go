@@ -0,0 +1,158 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bandersnatch
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+)
+
+func TestFixedBaseTable(t *testing.T) {
+	t.Parallel()
+
+	c := GetEdwardsCurve()
+	var base PointAffine
+	base.ScalarMultiplication(&c.Base, big.NewInt(42))
+	table := NewFixedBaseTable(&base)
+	if b := table.Base(); !b.Equal(&base) {
+		t.Fatal("wrong base")
+	}
+
+	// edge cases: 0, 1, -1, order-1, order, a scalar larger than the order and random scalars
+	scalars := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		big.NewInt(-1),
+		new(big.Int).Sub(&c.Order, big.NewInt(1)),
+		new(big.Int).Set(&c.Order),
+		new(big.Int).Lsh(&c.Order, 3),
+	}
+	for i := 0; i < 10; i++ {
+		var s big.Int
+		s.SetBytes(bytes.Repeat([]byte{byte(17*i + 5), 0xff}, 16))
+		scalars = append(scalars, &s)
+	}
+
+	check := func(table *FixedBaseTable) {
+		t.Helper()
+		for _, s := range scalars {
+			var k big.Int
+			k.Mod(s, &c.Order)
+			var expected, res PointAffine
+			expected.ScalarMultiplication(&base, &k)
+			res.ScalarMultiplicationFixedBase(table, s)
+			if !res.Equal(&expected) {
+				t.Fatalf("ScalarMultiplicationFixedBase doesn't match ScalarMultiplication for s = %s", s)
+			}
+		}
+	}
+	check(table)
+
+	// generator table
+	for _, s := range scalars[:4] {
+		var k big.Int
+		k.Mod(s, &c.Order)
+		var expected, res PointAffine
+		expected.ScalarMultiplication(&c.Base, &k)
+		res.ScalarMultiplicationBase(s)
+		if !res.Equal(&expected) {
+			t.Fatalf("ScalarMultiplicationBase doesn't match ScalarMultiplication for s = %s", s)
+		}
+	}
+
+	// batch
+	_scalars := make([]big.Int, len(scalars))
+	for i := range scalars {
+		_scalars[i].Set(scalars[i])
+	}
+	batch := BatchScalarMultiplicationFixedBase(table, _scalars)
+	batchBase := BatchScalarMultiplicationBase(_scalars)
+	for i, s := range scalars {
+		var expected PointAffine
+		expected.ScalarMultiplicationFixedBase(table, s)
+		if !batch[i].Equal(&expected) {
+			t.Fatalf("BatchScalarMultiplicationFixedBase doesn't match ScalarMultiplicationFixedBase for s = %s", s)
+		}
+		expected.ScalarMultiplicationBase(s)
+		if !batchBase[i].Equal(&expected) {
+			t.Fatalf("BatchScalarMultiplicationBase doesn't match ScalarMultiplicationBase for s = %s", s)
+		}
+	}
+	if len(BatchScalarMultiplicationBase(nil)) != 0 {
+		t.Fatal("expected no points for no scalars")
+	}
+
+	// serialization round trip
+	var buf bytes.Buffer
+	n, err := table.WriteTo(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var _table FixedBaseTable
+	m, err := _table.ReadFrom(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != m || n != int64(buf.Len()) {
+		t.Fatal("bytes read don't match bytes written")
+	}
+	check(&_table)
+
+	// corrupted point
+	data := buf.Bytes()
+	data[ecc.SizeOfHeader+8] ^= 0x01
+	if _, err := _table.ReadFrom(bytes.NewReader(data)); err == nil {
+		t.Fatal("expected an error for a corrupted table")
+	}
+}
+
+func BenchmarkFixedBaseTable(b *testing.B) {
+	c := GetEdwardsCurve()
+	var s big.Int
+	s.Sub(&c.Order, big.NewInt(12345))
+
+	b.Run("NewFixedBaseTable", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			NewFixedBaseTable(&c.Base)
+		}
+	})
+
+	var res PointAffine
+	res.ScalarMultiplicationBase(&s)
+	b.Run("ScalarMultiplicationBase", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.ScalarMultiplicationBase(&s)
+		}
+	})
+	scalars := make([]big.Int, 1024)
+	for i := range scalars {
+		scalars[i].Sub(&s, big.NewInt(int64(i)))
+	}
+	b.Run("BatchScalarMultiplicationBase/1024", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			BatchScalarMultiplicationBase(scalars)
+		}
+	})
+	b.Run("ScalarMultiplication", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.ScalarMultiplication(&c.Base, &s)
+		}
+	})
+}
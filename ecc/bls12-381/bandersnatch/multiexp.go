@@ -0,0 +1,257 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bandersnatch
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+	"runtime"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// Extended coordinates (X:Y:T:Z), with x = X/Z, y = Y/Z and x*y = T/Z, are the fastest
+// representation for sums of many points: PointExtended.Add costs 9 multiplications and is
+// unified (it also computes doublings), and it has no exceptional case on the prime-order
+// subgroup. Projective coordinates (X:Y:Z) save the T coordinate when the points are mostly doubled.
+
+var errMultiExpLength = errors.New("len(points) != len(scalars)")
+
+// FromProj sets p in extended coordinates from p1 in projective coordinates
+func (p *PointExtended) FromProj(p1 *PointProj) *PointExtended {
+	p.X.Mul(&p1.X, &p1.Z)
+	p.Y.Mul(&p1.Y, &p1.Z)
+	p.T.Mul(&p1.X, &p1.Y)
+	p.Z.Square(&p1.Z)
+	return p
+}
+
+// FromExtended sets p in projective coordinates from p1 in extended coordinates
+func (p *PointProj) FromExtended(p1 *PointExtended) *PointProj {
+	p.X.Set(&p1.X)
+	p.Y.Set(&p1.Y)
+	p.Z.Set(&p1.Z)
+	return p
+}
+
+// BatchExtendedToAffine converts points in extended coordinates to affine coordinates,
+// with a single field inversion (Montgomery's trick).
+//
+// The Z coordinates must not be zero, which is always the case for points of the curve.
+func BatchExtendedToAffine(points []PointExtended) []PointAffine {
+	zs := make([]fr.Element, len(points))
+	for i := range points {
+		zs[i] = points[i].Z
+	}
+	zs = fr.BatchInvert(zs)
+
+	res := make([]PointAffine, len(points))
+	parallel.Execute(len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			res[i].X.Mul(&points[i].X, &zs[i])
+			res[i].Y.Mul(&points[i].Y, &zs[i])
+		}
+	})
+	return res
+}
+
+// BatchProjToAffine converts points in projective coordinates to affine coordinates,
+// with a single field inversion (Montgomery's trick).
+//
+// The Z coordinates must not be zero, which is always the case for points of the curve.
+func BatchProjToAffine(points []PointProj) []PointAffine {
+	zs := make([]fr.Element, len(points))
+	for i := range points {
+		zs[i] = points[i].Z
+	}
+	zs = fr.BatchInvert(zs)
+
+	res := make([]PointAffine, len(points))
+	parallel.Execute(len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			res[i].X.Mul(&points[i].X, &zs[i])
+			res[i].Y.Mul(&points[i].Y, &zs[i])
+		}
+	})
+	return res
+}
+
+// BatchFromAffine converts points in affine coordinates to extended coordinates
+func BatchFromAffine(points []PointAffine) []PointExtended {
+	res := make([]PointExtended, len(points))
+	parallel.Execute(len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			res[i].FromAffine(&points[i])
+		}
+	})
+	return res
+}
+
+// MultiExp computes and returns p = ∑ [scalarsᵢ]pointsᵢ, see PointExtended.MultiExp
+func (p *PointAffine) MultiExp(points []PointAffine, scalars []*big.Int, config ecc.MultiExpConfig) (*PointAffine, error) {
+	var _p PointExtended
+	if _, err := _p.MultiExp(points, scalars, config); err != nil {
+		return nil, err
+	}
+	p.FromExtended(&_p)
+	return p, nil
+}
+
+// MultiExp computes and returns p = ∑ [scalarsᵢ]pointsᵢ (multi-scalar multiplication).
+//
+// It uses the bucket method with signed digits, in extended coordinates. The windows of the scalars
+// are processed in parallel on config.NbTasks go routines; the other fields of config are ignored.
+//
+// The scalars are reduced modulo the order of the curve, and the points must be in the prime
+// subgroup.
+func (p *PointExtended) MultiExp(points []PointAffine, scalars []*big.Int, config ecc.MultiExpConfig) (*PointExtended, error) {
+	if len(points) != len(scalars) {
+		return nil, errMultiExpLength
+	}
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU()
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+	n := len(points)
+	if n == 0 {
+		return p.setInfinity(), nil
+	}
+
+	initOnce.Do(initCurveParams)
+	c, nbWindows := bestCMultiExp(n, curveParams.Order.BitLen())
+	digits := signedDigits(scalars, c, nbWindows, config.NbTasks)
+	bases := BatchFromAffine(points)
+
+	// for each window, ∑ [digitᵢ]basesᵢ
+	nbBuckets := 1 << (c - 1)
+	windows := make([]PointExtended, nbWindows)
+	parallel.Execute(nbWindows, func(start, end int) {
+		buckets := make([]PointExtended, nbBuckets)
+		used := make([]bool, nbBuckets)
+		var neg PointExtended
+		for w := start; w < end; w++ {
+			for k := range used {
+				used[k] = false
+			}
+			for i, d := range digits[w*n : (w+1)*n] {
+				switch {
+				case d > 0:
+					b := d - 1
+					if used[b] {
+						buckets[b].Add(&buckets[b], &bases[i])
+					} else {
+						buckets[b].Set(&bases[i])
+						used[b] = true
+					}
+				case d < 0:
+					b := -d - 1
+					neg.Neg(&bases[i])
+					if used[b] {
+						buckets[b].Add(&buckets[b], &neg)
+					} else {
+						buckets[b].Set(&neg)
+						used[b] = true
+					}
+				}
+			}
+
+			// ∑ [k+1]bucketₖ with a running sum
+			var runningSum PointExtended
+			runningSum.setInfinity()
+			windows[w].setInfinity()
+			for k := nbBuckets - 1; k >= 0; k-- {
+				if used[k] {
+					runningSum.Add(&runningSum, &buckets[k])
+				}
+				windows[w].Add(&windows[w], &runningSum)
+			}
+		}
+	}, config.NbTasks)
+
+	// ∑ [2^{c*j}]windowⱼ
+	var res PointExtended
+	res.Set(&windows[nbWindows-1])
+	for j := nbWindows - 2; j >= 0; j-- {
+		for l := 0; l < c; l++ {
+			res.Double(&res)
+		}
+		res.Add(&res, &windows[j])
+	}
+
+	return p.Set(&res), nil
+}
+
+// bestCMultiExp returns the window size minimizing the number of additions of MultiExp, that is
+// (number of windows) * (number of points + 2 * number of buckets), and the number of windows.
+// The last window holds the nbBits mod c ≤ c-1 most significant bits.
+func bestCMultiExp(nbPoints, nbBits int) (c, nbWindows int) {
+	bestCost := 0
+	for _c := 2; _c <= 16; _c++ {
+		_nbWindows := nbBits/_c + 1
+		cost := _nbWindows * (nbPoints + (1 << _c))
+		if bestCost == 0 || cost < bestCost {
+			c, nbWindows, bestCost = _c, _nbWindows, cost
+		}
+	}
+	return
+}
+
+// signedDigits returns the signed digits in [-2^{c-1}, 2^{c-1}] of the scalars reduced modulo
+// the order of the curve, in base 2^c. digits[w*len(scalars)+i] is the w-th digit of scalars[i].
+func signedDigits(scalars []*big.Int, c, nbWindows, nbTasks int) []int {
+	n := len(scalars)
+	digits := make([]int, nbWindows*n)
+	half := 1 << (c - 1)
+	parallel.Execute(n, func(start, end int) {
+		var k big.Int
+		for i := start; i < end; i++ {
+			k.Mod(scalars[i], &curveParams.Order)
+			words := k.Bits()
+			carry := 0
+			for w := 0; w < nbWindows; w++ {
+				d := int(windowBits(words, w*c, c)) + carry
+				carry = 0
+				// the last window holds at most c-1 bits (see bestCMultiExp), so its digit is
+				// at most 2^{c-1} with the carry
+				if d >= half && w != nbWindows-1 {
+					d -= 1 << c
+					carry = 1
+				}
+				digits[w*n+i] = d
+			}
+		}
+	}, nbTasks)
+	return digits
+}
+
+// windowBits returns the c bits of the little-endian words starting at bit start
+func windowBits(words []big.Word, start, c int) uint64 {
+	const wordSize = bits.UintSize
+	i, s := start/wordSize, start%wordSize
+	if i >= len(words) {
+		return 0
+	}
+	v := uint64(words[i]) >> s
+	if s+c > wordSize && i+1 < len(words) {
+		v |= uint64(words[i+1]) << (wordSize - s)
+	}
+	return v & (1<<c - 1)
+}
@@ -0,0 +1,209 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bandersnatch
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// randomPoints returns n random points of the prime subgroup, and their discrete logarithms
+func randomPoints(n int) ([]PointAffine, []big.Int) {
+	params := GetEdwardsCurve()
+	points := make([]PointAffine, n)
+	logs := make([]big.Int, n)
+	for i := range points {
+		var r fr.Element
+		r.SetRandom()
+		r.BigInt(&logs[i])
+		logs[i].Mod(&logs[i], &params.Order)
+		points[i].ScalarMultiplication(&params.Base, &logs[i])
+	}
+	return points, logs
+}
+
+func TestCoordinatesConversions(t *testing.T) {
+	t.Parallel()
+
+	points, _ := randomPoints(10)
+	extended := BatchFromAffine(points)
+	proj := make([]PointProj, len(points))
+	for i := range points {
+		// move away from Z = 1
+		extended[i].Double(&extended[i])
+		proj[i].FromAffine(&points[i])
+		proj[i].Double(&proj[i])
+	}
+
+	fromExtended := BatchExtendedToAffine(extended)
+	fromProj := BatchProjToAffine(proj)
+	for i := range points {
+		var expected PointAffine
+		expected.Double(&points[i])
+		if !fromExtended[i].Equal(&expected) {
+			t.Fatal("BatchExtendedToAffine doesn't match FromExtended")
+		}
+		if !fromProj[i].Equal(&expected) {
+			t.Fatal("BatchProjToAffine doesn't match FromProj")
+		}
+
+		var e PointExtended
+		var p PointProj
+		var a PointAffine
+		e.FromProj(&proj[i])
+		if !a.FromExtended(&e).Equal(&expected) {
+			t.Fatal("PointExtended.FromProj is wrong")
+		}
+		p.FromExtended(&extended[i])
+		if !a.FromProj(&p).Equal(&expected) {
+			t.Fatal("PointProj.FromExtended is wrong")
+		}
+	}
+}
+
+func TestUnifiedAdd(t *testing.T) {
+	t.Parallel()
+
+	points, _ := randomPoints(5)
+	for i := range points {
+		var p, double, sum PointExtended
+		p.FromAffine(&points[i])
+		double.Double(&p)
+		sum.Add(&p, &p)
+		if !sum.Equal(&double) {
+			t.Fatal("Add(p, p) doesn't match Double(p)")
+		}
+		var zero PointExtended
+		zero.setInfinity()
+		sum.Add(&p, &zero)
+		if !sum.Equal(&p) {
+			t.Fatal("Add(p, 0) doesn't match p")
+		}
+		zero.Neg(&p)
+		sum.Add(&p, &zero)
+		if !sum.IsZero() {
+			t.Fatal("Add(p, -p) isn't 0")
+		}
+	}
+}
+
+func TestMultiExp(t *testing.T) {
+	t.Parallel()
+
+	params := GetEdwardsCurve()
+	sizes := []int{1, 5, 33, 257}
+	if testing.Short() {
+		sizes = []int{1, 5, 33}
+	}
+	for _, n := range sizes {
+		points, logs := randomPoints(n)
+		scalars := make([]*big.Int, n)
+		for i := range scalars {
+			var r fr.Element
+			r.SetRandom()
+			scalars[i] = r.BigInt(new(big.Int))
+		}
+		// edge cases: 0, -1, order, order-1, a scalar larger than the order, and repeated
+		// points and digits (doublings in the buckets)
+		scalars[0].SetUint64(0)
+		if n > 4 {
+			scalars[1].SetInt64(-1)
+			scalars[2].Set(&params.Order)
+			scalars[3].Sub(&params.Order, big.NewInt(1))
+			scalars[4].Lsh(&params.Order, 2).Add(scalars[4], big.NewInt(3))
+		}
+		if n > 32 {
+			for i := 20; i < 32; i++ {
+				points[i] = points[19]
+				logs[i] = logs[19]
+				scalars[i].Set(scalars[19])
+			}
+		}
+
+		// ∑ scalarsᵢ * logsᵢ
+		var expectedScalar, tmp big.Int
+		for i := range scalars {
+			tmp.Mul(scalars[i], &logs[i])
+			expectedScalar.Add(&expectedScalar, &tmp)
+		}
+		expectedScalar.Mod(&expectedScalar, &params.Order)
+		var expected PointAffine
+		expected.ScalarMultiplication(&params.Base, &expectedScalar)
+
+		for _, nbTasks := range []int{1, 4} {
+			var res PointAffine
+			if _, err := res.MultiExp(points, scalars, ecc.MultiExpConfig{NbTasks: nbTasks}); err != nil {
+				t.Fatal(err)
+			}
+			if !res.Equal(&expected) {
+				t.Fatalf("MultiExp(%d points, %d tasks) doesn't match the naive sum", n, nbTasks)
+			}
+		}
+	}
+}
+
+func TestMultiExpErrors(t *testing.T) {
+	t.Parallel()
+
+	var res PointExtended
+	if _, err := res.MultiExp(nil, nil, ecc.MultiExpConfig{}); err != nil {
+		t.Fatal(err)
+	}
+	if !res.IsZero() {
+		t.Fatal("empty MultiExp should be 0")
+	}
+
+	points, _ := randomPoints(2)
+	if _, err := res.MultiExp(points, []*big.Int{big.NewInt(1)}, ecc.MultiExpConfig{}); err != errMultiExpLength {
+		t.Fatal("expected a length mismatch error")
+	}
+}
+
+func BenchmarkMultiExp(b *testing.B) {
+	const n = 1 << 10
+	points, _ := randomPoints(n)
+	scalars := make([]*big.Int, n)
+	for i := range scalars {
+		var r fr.Element
+		r.SetRandom()
+		scalars[i] = r.BigInt(new(big.Int))
+	}
+
+	b.Run("MultiExp", func(b *testing.B) {
+		var res PointExtended
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _ = res.MultiExp(points, scalars, ecc.MultiExpConfig{})
+		}
+	})
+
+	b.Run("ScalarMultiplication and Add", func(b *testing.B) {
+		bases := BatchFromAffine(points)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			var res, tmp PointExtended
+			res.setInfinity()
+			for j := range bases {
+				tmp.ScalarMultiplication(&bases[j], scalars[j])
+				res.Add(&res, &tmp)
+			}
+		}
+	})
+}
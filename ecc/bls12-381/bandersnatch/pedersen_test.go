@@ -0,0 +1,190 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bandersnatch
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// pedersenHashNaive computes the hash of bits with a scalar multiplication per segment
+func pedersenHashNaive(h *PedersenHasher, bits []bool) PointAffine {
+	c := pedersenChunksPerSegment()
+	for len(bits)%3 != 0 {
+		bits = append(bits, false)
+	}
+	nbChunks := len(bits) / 3
+
+	var res, tmp PointAffine
+	res.setInfinity()
+	for i := 0; i*c < nbChunks; i++ {
+		// ⟨Mᵢ⟩ = ∑ⱼ enc(mⱼ)·2⁴ʲ
+		var m, enc big.Int
+		for j := 0; j < c && i*c+j < nbChunks; j++ {
+			s := bits[3*(i*c+j):]
+			k := int64(1)
+			if s[0] {
+				k++
+			}
+			if s[1] {
+				k += 2
+			}
+			if s[2] {
+				k = -k
+			}
+			enc.SetInt64(k)
+			enc.Lsh(&enc, uint(4*j))
+			m.Add(&m, &enc)
+		}
+		m.Mod(&m, &curveParams.Order)
+		g := h.Generator(i)
+		tmp.ScalarMultiplication(&g, &m)
+		res.Add(&res, &tmp)
+	}
+	return res
+}
+
+func randomBits(r *rand.Rand, n int) []bool {
+	bits := make([]bool, n)
+	for i := range bits {
+		bits[i] = r.Intn(2) == 1
+	}
+	return bits
+}
+
+func TestPedersenHash(t *testing.T) {
+	t.Parallel()
+
+	h, err := NewPedersenHasher([]byte("gnark-crypto pedersen tests"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := pedersenChunksPerSegment()
+	if c == 0 {
+		t.Fatal("segments must have at least one chunk")
+	}
+
+	r := rand.New(rand.NewSource(42)) //#nosec G404 -- deterministic test inputs
+	for _, n := range []int{0, 1, 3, 8, 3*c - 1, 3 * c, 3*c + 1, 7*c + 2} {
+		bits := randomBits(r, n)
+		expected := pedersenHashNaive(h, bits)
+		if res := h.HashBits(bits); !res.Equal(&expected) {
+			t.Fatalf("%d bits: wrong hash", n)
+		}
+
+		// the same message, written in several parts
+		d := h.NewDigest()
+		for i := 0; i < n; i += 5 {
+			end := i + 5
+			if end > n {
+				end = n
+			}
+			d.WriteBits(bits[i:end])
+		}
+		if res := d.SumPoint(); !res.Equal(&expected) {
+			t.Fatalf("%d bits: wrong hash of the message written in parts", n)
+		}
+		// SumPoint doesn't change the state of the digest
+		if res := d.SumPoint(); !res.Equal(&expected) {
+			t.Fatalf("%d bits: SumPoint changed the digest", n)
+		}
+		if sum := d.Sum(nil); len(sum) != d.Size() {
+			t.Fatal("wrong digest size")
+		}
+	}
+}
+
+func TestPedersenHashBytes(t *testing.T) {
+	t.Parallel()
+
+	h, err := NewPedersenHasher([]byte("gnark-crypto pedersen tests"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the bits of each byte are hashed least significant first
+	msg := []byte{0x01, 0xf0, 0x5a}
+	var bits []bool
+	for _, b := range msg {
+		for k := 0; k < 8; k++ {
+			bits = append(bits, (b>>k)&1 == 1)
+		}
+	}
+	expected := h.HashBits(bits)
+	if res := h.Hash(msg); !res.Equal(&expected) {
+		t.Fatal("Hash doesn't match HashBits")
+	}
+
+	d := h.NewDigest()
+	_, _ = d.Write(msg[:1])
+	_, _ = d.Write(msg[1:])
+	if res := d.SumPoint(); !res.Equal(&expected) {
+		t.Fatal("wrong hash of the message written in parts")
+	}
+	d.Reset()
+	if res := d.SumPoint(); !res.IsZero() {
+		t.Fatal("the hash of the empty message is the identity")
+	}
+
+	// padding: the last chunk is completed with zeros
+	padded := append(append([]bool{}, bits[:22]...), false, false)
+	if res, expected := h.HashBits(bits[:22]), h.HashBits(padded); !res.Equal(&expected) {
+		t.Fatal("the message should be padded with zeros")
+	}
+
+	// another domain gives other generators
+	other, err := NewPedersenHasher([]byte("gnark-crypto pedersen tests 2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res := other.Hash(msg); res.Equal(&expected) {
+		t.Fatal("hashes with different domains should differ")
+	}
+}
+
+func TestPedersenGenerators(t *testing.T) {
+	t.Parallel()
+
+	h, err := NewPedersenHasher([]byte("gnark-crypto pedersen tests"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	g0, g1 := h.Generator(0), h.Generator(1)
+	if !g0.IsInSubGroup() || !g1.IsInSubGroup() || g0.IsZero() || g0.Equal(&g1) {
+		t.Fatal("generators should be distinct points of the prime subgroup")
+	}
+
+	for _, domain := range [][]byte{nil, make([]byte, 256)} {
+		if _, err := NewPedersenHasher(domain); err != errPedersenDomain {
+			t.Fatalf("expected errPedersenDomain, got %v", err)
+		}
+	}
+}
+
+func BenchmarkPedersenHash(b *testing.B) {
+	h, err := NewPedersenHasher([]byte("gnark-crypto pedersen tests"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	msg := make([]byte, 64)
+	h.Hash(msg)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h.Hash(msg)
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bbs
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/random"
+)
+
+const (
+	sizeFr        = fr.Bytes
+	sizePublicKey = bls12381.SizeOfG2AffineCompressed
+	sizeSignature = bls12381.SizeOfG1AffineCompressed + 2*sizeFr
+)
+
+// dstGenerators is the domain separation tag of the hash-to-curve of the generators
+var dstGenerators = []byte("BBS_BLS12_381_GENERATORS_")
+
+var (
+	ErrNbMessages       = errors.New("the number of messages doesn't match the number of generators")
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+// Generators are the generators of G1 of the signatures of a fixed number of messages:
+// a signature on m₁, ..., mₗ commits to the messages as g₁ + [s]H0 + ∑ [mᵢ]Hᵢ.
+type Generators struct {
+	H0 bls12381.G1Affine
+	H  []bls12381.G1Affine
+}
+
+// NewGenerators returns the generators of the signatures of nbMessages messages.
+//
+// The generators are derived deterministically with HashToG1, so that the signers and the
+// verifiers compute the same generators, and nobody knows a discrete logarithm relation between
+// them. The generators of nbMessages messages are a prefix of those of more messages.
+func NewGenerators(nbMessages int) (*Generators, error) {
+	if nbMessages < 0 {
+		return nil, ErrNbMessages
+	}
+	var gens Generators
+	gens.H = make([]bls12381.G1Affine, nbMessages)
+	var err error
+	var buf [4]byte
+	// H0 is derived from the index 0, Hᵢ from the index i
+	for i := 0; i <= nbMessages; i++ {
+		binary.BigEndian.PutUint32(buf[:], uint32(i))
+		var h bls12381.G1Affine
+		if h, err = bls12381.HashToG1(buf[:], dstGenerators); err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			gens.H0 = h
+		} else {
+			gens.H[i-1] = h
+		}
+	}
+	return &gens, nil
+}
+
+// PublicKey represents a BBS+ public key W = [x]g₂
+type PublicKey struct {
+	W bls12381.G2Affine
+}
+
+// PrivateKey represents a BBS+ private key
+type PrivateKey struct {
+	PublicKey  PublicKey
+	scalar     fr.Element // secret scalar x
+	randomness io.Reader  // see SetRandomness
+}
+
+// Signature represents a BBS+ signature (A, e, s) on messages m₁, ..., mₗ, where
+// A = [1/(x+e)](g₁ + [s]H0 + ∑ [mᵢ]Hᵢ)
+type Signature struct {
+	A    bls12381.G1Affine
+	E, S fr.Element
+}
+
+// GenerateKey generates a public and private key pair, reading the secret scalar from rand.
+func GenerateKey(rand io.Reader) (*PrivateKey, error) {
+	var privateKey PrivateKey
+	for privateKey.scalar.IsZero() {
+		if _, err := privateKey.scalar.SetRandomFrom(rand); err != nil {
+			return nil, err
+		}
+	}
+	var x big.Int
+	privateKey.scalar.BigInt(&x)
+	_, _, _, g2 := bls12381.Generators()
+	privateKey.PublicKey.W.ScalarMultiplication(&g2, &x)
+	return &privateKey, nil
+}
+
+// Public returns the public key associated to the private key.
+func (privKey *PrivateKey) Public() *PublicKey {
+	var pub PublicKey
+	pub.W.Set(&privKey.PublicKey.W)
+	return &pub
+}
+
+// SetRandomness sets the source of the random e and s of the signatures of privKey. If r is nil
+// (default), they are read from the package-level source of randomness (see random.SetReader).
+func (privKey *PrivateKey) SetRandomness(r io.Reader) {
+	privKey.randomness = r
+}
+
+// commit returns g₁ + [s]H0 + ∑ [mᵢ]Hᵢ
+func commit(gens *Generators, s *fr.Element, messages []fr.Element) (bls12381.G1Affine, error) {
+	var res bls12381.G1Affine
+	if len(messages) != len(gens.H) {
+		return res, ErrNbMessages
+	}
+	points := make([]bls12381.G1Affine, 0, len(messages)+1)
+	scalars := make([]fr.Element, 0, len(messages)+1)
+	points = append(append(points, gens.H0), gens.H...)
+	scalars = append(append(scalars, *s), messages...)
+	res, err := msm(points, scalars)
+	if err != nil {
+		return res, err
+	}
+	_, _, g1, _ := bls12381.Generators()
+	res.Add(&res, &g1)
+	return res, nil
+}
+
+// Sign signs the messages, one per generator of gens.
+func (privKey *PrivateKey) Sign(gens *Generators, messages []fr.Element) (*Signature, error) {
+	rand := privKey.randomness
+	if rand == nil {
+		rand = random.Reader()
+	}
+
+	var sig Signature
+	if _, err := sig.S.SetRandomFrom(rand); err != nil {
+		return nil, err
+	}
+	B, err := commit(gens, &sig.S, messages)
+	if err != nil {
+		return nil, err
+	}
+
+	// A = [1/(x+e)]B, with x+e ≠ 0
+	var xe fr.Element
+	for xe.IsZero() {
+		if _, err := sig.E.SetRandomFrom(rand); err != nil {
+			return nil, err
+		}
+		xe.Add(&privKey.scalar, &sig.E)
+	}
+	xe.Inverse(&xe)
+	var k big.Int
+	xe.BigInt(&k)
+	sig.A.ScalarMultiplicationConstantTime(&B, &k)
+
+	return &sig, nil
+}
+
+// Verify verifies the signature sig on the messages, one per generator of gens:
+// e(A, W + [e]g₂) = e(g₁ + [s]H0 + ∑ [mᵢ]Hᵢ, g₂).
+//
+// It returns false, nil if the signature is invalid, and an error if the number of messages
+// doesn't match gens.
+func (pub *PublicKey) Verify(gens *Generators, messages []fr.Element, sig *Signature) (bool, error) {
+	if sig.A.IsInfinity() || !sig.A.IsInSubGroup() {
+		return false, nil
+	}
+	B, err := commit(gens, &sig.S, messages)
+	if err != nil {
+		return false, err
+	}
+
+	// e(A, W)⋅e([e]A - B, g₂) = 1
+	var eA bls12381.G1Affine
+	var k big.Int
+	sig.E.BigInt(&k)
+	eA.ScalarMultiplicationVartime(&sig.A, &k).Sub(&eA, &B)
+	_, _, _, g2 := bls12381.Generators()
+	return bls12381.VerifyPairingEquation([]bls12381.PairingTerm{
+		{P: sig.A, Q: pub.W},
+		{P: eA, Q: g2},
+	})
+}
@@ -0,0 +1,174 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bbs
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// randomMessages returns n random messages
+func randomMessages(n int) []fr.Element {
+	messages := make([]fr.Element, n)
+	for i := range messages {
+		messages[i].SetRandom()
+	}
+	return messages
+}
+
+func TestSignVerify(t *testing.T) {
+	t.Parallel()
+
+	const n = 5
+	gens, err := NewGenerators(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privKey, err := GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub := privKey.Public()
+	messages := randomMessages(n)
+
+	sig, err := privKey.Sign(gens, messages)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := pub.Verify(gens, messages, sig); err != nil || !ok {
+		t.Fatal("valid signature rejected", err)
+	}
+
+	// wrong message
+	messages[2].SetRandom()
+	if ok, err := pub.Verify(gens, messages, sig); err != nil || ok {
+		t.Fatal("signature on other messages accepted", err)
+	}
+	messages[2], messages[3] = messages[3], messages[2]
+	if ok, err := pub.Verify(gens, messages, sig); err != nil || ok {
+		t.Fatal("signature on permuted messages accepted", err)
+	}
+
+	// wrong key
+	otherKey, err := GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err = privKey.Sign(gens, messages)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := otherKey.Public().Verify(gens, messages, sig); err != nil || ok {
+		t.Fatal("signature accepted with another public key", err)
+	}
+
+	// tampered signature
+	sig.S.SetRandom()
+	if ok, err := pub.Verify(gens, messages, sig); err != nil || ok {
+		t.Fatal("tampered signature accepted", err)
+	}
+
+	// wrong number of messages
+	if _, err := privKey.Sign(gens, messages[:n-1]); err != ErrNbMessages {
+		t.Fatal("expected ErrNbMessages, got", err)
+	}
+	if _, err := pub.Verify(gens, append(messages, fr.Element{}), sig); err != ErrNbMessages {
+		t.Fatal("expected ErrNbMessages, got", err)
+	}
+}
+
+func TestNewGenerators(t *testing.T) {
+	t.Parallel()
+
+	gens3, err := NewGenerators(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gens5, err := NewGenerators(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gens3.H0.Equal(&gens5.H0) {
+		t.Fatal("H0 depends on the number of messages")
+	}
+	for i := range gens3.H {
+		if !gens3.H[i].Equal(&gens5.H[i]) {
+			t.Fatal("the generators of 3 messages are not a prefix of those of 5 messages")
+		}
+		if gens3.H[i].Equal(&gens3.H0) || !gens3.H[i].IsInSubGroup() {
+			t.Fatal("invalid generator")
+		}
+	}
+	if _, err := NewGenerators(-1); err != ErrNbMessages {
+		t.Fatal("expected ErrNbMessages, got", err)
+	}
+}
+
+func TestSetRandomness(t *testing.T) {
+	t.Parallel()
+
+	gens, err := NewGenerators(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privKey, err := GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	messages := randomMessages(2)
+
+	seed := make([]byte, 1<<10)
+	privKey.SetRandomness(bytes.NewReader(seed))
+	sig1, err := privKey.Sign(gens, messages)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privKey.SetRandomness(bytes.NewReader(seed))
+	sig2, err := privKey.Sign(gens, messages)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(sig1.Bytes(), sig2.Bytes()) {
+		t.Fatal("the signatures should be the same with the same randomness")
+	}
+	if ok, err := privKey.Public().Verify(gens, messages, sig1); err != nil || !ok {
+		t.Fatal("valid signature rejected", err)
+	}
+}
+
+func BenchmarkSignVerify(b *testing.B) {
+	const n = 10
+	gens, _ := NewGenerators(n)
+	privKey, _ := GenerateKey(rand.Reader)
+	pub := privKey.Public()
+	messages := randomMessages(n)
+	sig, _ := privKey.Sign(gens, messages)
+
+	b.Run("Sign", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = privKey.Sign(gens, messages)
+		}
+	})
+	b.Run("Verify", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = pub.Verify(gens, messages, sig)
+		}
+	})
+}
@@ -0,0 +1,31 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package bbs provides BBS+ signatures on the bls12-381 curve.
+//
+// A BBS+ signature signs a vector of messages (elements of fr) at once. The holder of a signature
+// can then prove, in zero-knowledge, the knowledge of a signature on messages of which only
+// a chosen subset is disclosed (selective disclosure), e.g. for verifiable credentials.
+//
+// The public keys are in G2 and the signatures in G1. The generators of G1 the messages are
+// committed with are derived with hash-to-curve (see NewGenerators), so that nobody knows a
+// discrete logarithm relation between them.
+//
+// Documentation:
+//   - M. H. Au, W. Susilo and Y. Mu, Constant-Size Dynamic k-TAA: https://eprint.iacr.org/2008/136.pdf
+//   - J. Camenisch, M. Drijvers and A. Lehmann, Anonymous Attestation Using the Strong Diffie Hellman
+//     Assumption Revisited, section 4.3: https://eprint.iacr.org/2016/663.pdf
+package bbs
@@ -0,0 +1,78 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bbs
+
+import (
+	"errors"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+var errScalarNotReduced = errors.New("scalar is not reduced modulo r")
+
+// Bytes returns the binary representation of the public key: the compressed point W
+func (pk *PublicKey) Bytes() []byte {
+	b := pk.W.Bytes()
+	return b[:]
+}
+
+// SetBytes sets pk from its binary representation in buf, checking that the point is in G2.
+// It returns the number of bytes read from the buffer.
+func (pk *PublicKey) SetBytes(buf []byte) (int, error) {
+	if len(buf) < sizePublicKey {
+		return 0, io.ErrShortBuffer
+	}
+	return pk.W.SetBytes(buf[:sizePublicKey])
+}
+
+// Bytes returns the binary representation of the signature: the compressed point A, then e and s
+// as big endian integers.
+func (sig *Signature) Bytes() []byte {
+	res := make([]byte, 0, sizeSignature)
+	a := sig.A.Bytes()
+	e := sig.E.Bytes()
+	s := sig.S.Bytes()
+	res = append(res, a[:]...)
+	res = append(res, e[:]...)
+	res = append(res, s[:]...)
+	return res
+}
+
+// SetBytes sets sig from its binary representation in buf, checking that A is in G1 and is not
+// the point at infinity, and that e and s are reduced.
+// It returns the number of bytes read from the buffer.
+func (sig *Signature) SetBytes(buf []byte) (int, error) {
+	if len(buf) < sizeSignature {
+		return 0, io.ErrShortBuffer
+	}
+	n, err := sig.A.SetBytes(buf[:bls12381.SizeOfG1AffineCompressed])
+	if err != nil {
+		return n, err
+	}
+	if sig.A.IsInfinity() {
+		return n, ErrInvalidSignature
+	}
+	for _, x := range []*fr.Element{&sig.E, &sig.S} {
+		if err := x.SetBytesCanonical(buf[n : n+sizeFr]); err != nil {
+			return n, errScalarNotReduced
+		}
+		n += sizeFr
+	}
+	return n, nil
+}
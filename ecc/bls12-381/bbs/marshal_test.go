@@ -0,0 +1,65 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bbs
+
+import (
+	"crypto/rand"
+	"io"
+	"testing"
+)
+
+func TestMarshal(t *testing.T) {
+	t.Parallel()
+
+	gens, err := NewGenerators(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privKey, err := GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	messages := randomMessages(2)
+	sig, err := privKey.Sign(gens, messages)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var pub PublicKey
+	if n, err := pub.SetBytes(privKey.Public().Bytes()); err != nil || n != sizePublicKey {
+		t.Fatal("public key round trip failed", err)
+	}
+	var _sig Signature
+	b := sig.Bytes()
+	if n, err := _sig.SetBytes(b); err != nil || n != sizeSignature {
+		t.Fatal("signature round trip failed", err)
+	}
+	if ok, err := pub.Verify(gens, messages, &_sig); err != nil || !ok {
+		t.Fatal("the decoded signature is rejected", err)
+	}
+
+	if _, err := _sig.SetBytes(b[:sizeSignature-1]); err != io.ErrShortBuffer {
+		t.Fatal("expected io.ErrShortBuffer, got", err)
+	}
+	// e = 2²⁵⁶-1 is not reduced
+	for i := sizeSignature - 2*sizeFr; i < sizeSignature-sizeFr; i++ {
+		b[i] = 0xff
+	}
+	if _, err := _sig.SetBytes(b); err != errScalarNotReduced {
+		t.Fatal("expected errScalarNotReduced, got", err)
+	}
+}
@@ -0,0 +1,269 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bbs
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/random"
+)
+
+// dstChallenge is the domain separation tag of the Fiat-Shamir challenge of the proofs
+var dstChallenge = []byte("BBS_BLS12_381_PROOF_CHALLENGE_")
+
+var ErrDisclosedIndices = errors.New("the disclosed indices must be increasing and smaller than the number of messages")
+
+// Proof is a zero-knowledge proof of knowledge of a signature (A, e, s) on messages m₁, ..., mₗ,
+// of which only the disclosed ones are revealed (CDL16, section 4.3).
+//
+// With random r₁ ≠ 0 and r₂, the prover reveals A' = [r₁]A, Ā = [r₁](B - [e]A) = [x]A' and
+// D = [r₁]B - [r₂]H0, where B = g₁ + [s]H0 + ∑ [mᵢ]Hᵢ, and proves the knowledge of e, r₂,
+// r₃ = 1/r₁, s' = s - r₂r₃ and of the undisclosed messages such that
+//
+//	Ā - D = [-e]A' + [r₂]H0
+//	g₁ + ∑_{disclosed} [mᵢ]Hᵢ = [r₃]D + [-s']H0 + ∑_{undisclosed} [-mᵢ]Hᵢ
+//
+// with a Schnorr proof made non-interactive with Fiat-Shamir.
+type Proof struct {
+	APrime, ABar, D bls12381.G1Affine
+
+	C                fr.Element   // challenge
+	Ze, Zr2, Zr3, Zs fr.Element   // responses for -e, r₂, r₃ and -s'
+	Zm               []fr.Element // responses for the undisclosed -mᵢ, in increasing index order
+}
+
+// NewProof returns a proof of knowledge of the signature sig of pub on the messages, disclosing
+// the messages of indices disclosed (increasing, 0-based). The nonce (e.g. sent by the verifier)
+// is bound to the proof to prevent replays.
+//
+// The random values of the proof are read from the package-level source of randomness
+// (see random.SetReader). The signature is not checked.
+func NewProof(pub *PublicKey, gens *Generators, sig *Signature, messages []fr.Element, disclosed []int, nonce []byte) (*Proof, error) {
+	if err := checkDisclosed(disclosed, len(messages)); err != nil {
+		return nil, err
+	}
+	B, err := commit(gens, &sig.S, messages)
+	if err != nil {
+		return nil, err
+	}
+	rand := random.Reader()
+
+	var r1, r2, r3 fr.Element
+	for r1.IsZero() {
+		if _, err := r1.SetRandomFrom(rand); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := r2.SetRandomFrom(rand); err != nil {
+		return nil, err
+	}
+	r3.Inverse(&r1)
+
+	var proof Proof
+	var negE, negSPrime fr.Element
+	negE.Neg(&sig.E)
+	negSPrime.Mul(&r2, &r3).Sub(&negSPrime, &sig.S)
+
+	// A' = [r₁]A, Ā = [-e]A' + [r₁]B, D = [r₁]B - [r₂]H0
+	var negR2 fr.Element
+	negR2.Neg(&r2)
+	if proof.APrime, err = msm([]bls12381.G1Affine{sig.A}, []fr.Element{r1}); err != nil {
+		return nil, err
+	}
+	if proof.ABar, err = msm([]bls12381.G1Affine{proof.APrime, B}, []fr.Element{negE, r1}); err != nil {
+		return nil, err
+	}
+	if proof.D, err = msm([]bls12381.G1Affine{B, gens.H0}, []fr.Element{r1, negR2}); err != nil {
+		return nil, err
+	}
+
+	// commitments of the Schnorr proof
+	hidden := undisclosed(disclosed, len(messages))
+	blindings := make([]fr.Element, 4+len(hidden)) // ρe, ρr₂, ρr₃, ρs, ρmᵢ
+	for i := range blindings {
+		if _, err := blindings[i].SetRandomFrom(rand); err != nil {
+			return nil, err
+		}
+	}
+	T1, err := msm([]bls12381.G1Affine{proof.APrime, gens.H0}, blindings[:2])
+	if err != nil {
+		return nil, err
+	}
+	points := make([]bls12381.G1Affine, 0, 2+len(hidden))
+	points = append(points, proof.D, gens.H0)
+	for _, i := range hidden {
+		points = append(points, gens.H[i])
+	}
+	T2, err := msm(points, blindings[2:])
+	if err != nil {
+		return nil, err
+	}
+
+	proof.C = challenge(pub, &proof, &T1, &T2, len(messages), disclosed, messages, nonce)
+
+	// responses zᵢ = ρᵢ + c⋅wᵢ
+	respond := func(z, rho, w *fr.Element) {
+		z.Mul(&proof.C, w).Add(z, rho)
+	}
+	respond(&proof.Ze, &blindings[0], &negE)
+	respond(&proof.Zr2, &blindings[1], &r2)
+	respond(&proof.Zr3, &blindings[2], &r3)
+	respond(&proof.Zs, &blindings[3], &negSPrime)
+	proof.Zm = make([]fr.Element, len(hidden))
+	for j, i := range hidden {
+		var negM fr.Element
+		negM.Neg(&messages[i])
+		respond(&proof.Zm[j], &blindings[4+j], &negM)
+	}
+
+	return &proof, nil
+}
+
+// VerifyProof verifies a proof of knowledge of a signature of pub on messages, with one message
+// per generator of gens, of which the messages of indices disclosed (increasing, 0-based) are
+// disclosedMessages.
+//
+// It returns false, nil if the proof is invalid, and an error if the inputs are inconsistent.
+func (pub *PublicKey) VerifyProof(gens *Generators, proof *Proof, disclosed []int, disclosedMessages []fr.Element, nonce []byte) (bool, error) {
+	nbMessages := len(gens.H)
+	if err := checkDisclosed(disclosed, nbMessages); err != nil {
+		return false, err
+	}
+	if len(disclosed) != len(disclosedMessages) {
+		return false, ErrDisclosedIndices
+	}
+	hidden := undisclosed(disclosed, nbMessages)
+	if len(proof.Zm) != len(hidden) {
+		return false, nil
+	}
+	if proof.APrime.IsInfinity() || !proof.APrime.IsInSubGroup() || !proof.ABar.IsInSubGroup() || !proof.D.IsInSubGroup() {
+		return false, nil
+	}
+
+	// messages indexed as the generators, the undisclosed ones are unused
+	messages := make([]fr.Element, nbMessages)
+	for j, i := range disclosed {
+		messages[i] = disclosedMessages[j]
+	}
+
+	// T1 = [ze]A' + [zr₂]H0 - [c](Ā - D)
+	var negC fr.Element
+	negC.Neg(&proof.C)
+	var abarD bls12381.G1Affine
+	abarD.Sub(&proof.ABar, &proof.D)
+	T1, err := msm([]bls12381.G1Affine{proof.APrime, gens.H0, abarD}, []fr.Element{proof.Ze, proof.Zr2, negC})
+	if err != nil {
+		return false, err
+	}
+
+	// T2 = [zr₃]D + [zs]H0 + ∑_{undisclosed} [zmᵢ]Hᵢ - [c](g₁ + ∑_{disclosed} [mᵢ]Hᵢ)
+	_, _, g1, _ := bls12381.Generators()
+	points := make([]bls12381.G1Affine, 0, 3+nbMessages)
+	scalars := make([]fr.Element, 0, 3+nbMessages)
+	points = append(points, proof.D, gens.H0, g1)
+	scalars = append(scalars, proof.Zr3, proof.Zs, negC)
+	for j, i := range hidden {
+		points = append(points, gens.H[i])
+		scalars = append(scalars, proof.Zm[j])
+	}
+	for _, i := range disclosed {
+		var s fr.Element
+		s.Mul(&negC, &messages[i])
+		points = append(points, gens.H[i])
+		scalars = append(scalars, s)
+	}
+	T2, err := msm(points, scalars)
+	if err != nil {
+		return false, err
+	}
+
+	c := challenge(pub, proof, &T1, &T2, nbMessages, disclosed, messages, nonce)
+	if !c.Equal(&proof.C) {
+		return false, nil
+	}
+
+	// e(A', W) = e(Ā, g₂)
+	_, _, _, g2 := bls12381.Generators()
+	return bls12381.VerifyPairingEquation([]bls12381.PairingTerm{
+		{P: proof.APrime, Q: pub.W},
+		{P: proof.ABar, Q: g2, Neg: true},
+	})
+}
+
+// challenge returns the Fiat-Shamir challenge of the proof, bound to the public key, the revealed
+// points, the commitments T1 and T2, the disclosed messages (messages is indexed as the
+// generators) and the nonce.
+func challenge(pub *PublicKey, proof *Proof, T1, T2 *bls12381.G1Affine, nbMessages int, disclosed []int, messages []fr.Element, nonce []byte) fr.Element {
+	var buf []byte
+	w := pub.W.Bytes()
+	buf = append(buf, w[:]...)
+	for _, p := range []*bls12381.G1Affine{&proof.APrime, &proof.ABar, &proof.D, T1, T2} {
+		b := p.Bytes()
+		buf = append(buf, b[:]...)
+	}
+	buf = binary.BigEndian.AppendUint32(buf, uint32(nbMessages))
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(disclosed)))
+	for _, i := range disclosed {
+		buf = binary.BigEndian.AppendUint32(buf, uint32(i))
+		b := messages[i].Bytes()
+		buf = append(buf, b[:]...)
+	}
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(nonce)))
+	buf = append(buf, nonce...)
+
+	c, err := fr.Hash(buf, dstChallenge, 1)
+	if err != nil {
+		// the length of the output is fixed
+		panic(err)
+	}
+	return c[0]
+}
+
+// checkDisclosed checks that the disclosed indices are increasing and smaller than nbMessages
+func checkDisclosed(disclosed []int, nbMessages int) error {
+	for j, i := range disclosed {
+		if i < 0 || i >= nbMessages || (j > 0 && i <= disclosed[j-1]) {
+			return ErrDisclosedIndices
+		}
+	}
+	return nil
+}
+
+// undisclosed returns the increasing indices in [0, nbMessages) that are not disclosed
+func undisclosed(disclosed []int, nbMessages int) []int {
+	res := make([]int, 0, nbMessages-len(disclosed))
+	j := 0
+	for i := 0; i < nbMessages; i++ {
+		if j < len(disclosed) && disclosed[j] == i {
+			j++
+			continue
+		}
+		res = append(res, i)
+	}
+	return res
+}
+
+// msm returns ∑ [scalarsᵢ]pointsᵢ
+func msm(points []bls12381.G1Affine, scalars []fr.Element) (bls12381.G1Affine, error) {
+	var res bls12381.G1Affine
+	_, err := res.MultiExp(points, scalars, ecc.MultiExpConfig{})
+	return res, err
+}
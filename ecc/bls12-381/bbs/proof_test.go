@@ -0,0 +1,160 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bbs
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+func TestProof(t *testing.T) {
+	t.Parallel()
+
+	const n = 6
+	gens, err := NewGenerators(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privKey, err := GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub := privKey.Public()
+	messages := randomMessages(n)
+	sig, err := privKey.Sign(gens, messages)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nonce := []byte("nonce")
+
+	for _, disclosed := range [][]int{nil, {0}, {1, 4}, {0, 2, 3, 5}, {0, 1, 2, 3, 4, 5}} {
+		disclosedMessages := make([]fr.Element, len(disclosed))
+		for j, i := range disclosed {
+			disclosedMessages[j] = messages[i]
+		}
+
+		proof, err := NewProof(pub, gens, sig, messages, disclosed, nonce)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(proof.Zm) != n-len(disclosed) {
+			t.Fatal("wrong number of responses for the undisclosed messages")
+		}
+		if ok, err := pub.VerifyProof(gens, proof, disclosed, disclosedMessages, nonce); err != nil || !ok {
+			t.Fatalf("valid proof rejected (disclosed %v): %v", disclosed, err)
+		}
+
+		// other nonce
+		if ok, err := pub.VerifyProof(gens, proof, disclosed, disclosedMessages, []byte("other nonce")); err != nil || ok {
+			t.Fatal("proof accepted with another nonce", err)
+		}
+
+		// other public key
+		otherKey, err := GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok, err := otherKey.Public().VerifyProof(gens, proof, disclosed, disclosedMessages, nonce); err != nil || ok {
+			t.Fatal("proof accepted with another public key", err)
+		}
+
+		if len(disclosed) == 0 {
+			continue
+		}
+
+		// wrong disclosed message
+		disclosedMessages[0].SetRandom()
+		if ok, err := pub.VerifyProof(gens, proof, disclosed, disclosedMessages, nonce); err != nil || ok {
+			t.Fatal("proof accepted with a wrong disclosed message", err)
+		}
+	}
+
+	// a proof on a signature of other messages is rejected
+	forged := randomMessages(n)
+	proof, err := NewProof(pub, gens, sig, forged, []int{0}, nonce)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := pub.VerifyProof(gens, proof, []int{0}, forged[:1], nonce); err != nil || ok {
+		t.Fatal("proof on a signature of other messages accepted", err)
+	}
+
+	// tampered proof
+	proof, err = NewProof(pub, gens, sig, messages, []int{1}, nonce)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof.Zs.SetRandom()
+	if ok, err := pub.VerifyProof(gens, proof, []int{1}, messages[1:2], nonce); err != nil || ok {
+		t.Fatal("tampered proof accepted", err)
+	}
+}
+
+func TestProofErrors(t *testing.T) {
+	t.Parallel()
+
+	const n = 3
+	gens, _ := NewGenerators(n)
+	privKey, _ := GenerateKey(rand.Reader)
+	pub := privKey.Public()
+	messages := randomMessages(n)
+	sig, _ := privKey.Sign(gens, messages)
+
+	for _, disclosed := range [][]int{
+		{-1}, {3}, {1, 1}, {2, 0},
+	} {
+		if _, err := NewProof(pub, gens, sig, messages, disclosed, nil); err != ErrDisclosedIndices {
+			t.Fatalf("expected ErrDisclosedIndices for %v, got %v", disclosed, err)
+		}
+	}
+
+	proof, err := NewProof(pub, gens, sig, messages, []int{0}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pub.VerifyProof(gens, proof, []int{0}, messages[:2], nil); err != ErrDisclosedIndices {
+		t.Fatal("expected ErrDisclosedIndices, got", err)
+	}
+	if _, err := NewProof(pub, gens, sig, messages[:2], nil, nil); err != ErrNbMessages {
+		t.Fatal("expected ErrNbMessages, got", err)
+	}
+}
+
+func BenchmarkProof(b *testing.B) {
+	const n = 10
+	gens, _ := NewGenerators(n)
+	privKey, _ := GenerateKey(rand.Reader)
+	pub := privKey.Public()
+	messages := randomMessages(n)
+	sig, _ := privKey.Sign(gens, messages)
+	disclosed := []int{0, 3, 7}
+	disclosedMessages := []fr.Element{messages[0], messages[3], messages[7]}
+	proof, _ := NewProof(pub, gens, sig, messages, disclosed, nil)
+
+	b.Run("NewProof", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = NewProof(pub, gens, sig, messages, disclosed, nil)
+		}
+	})
+	b.Run("VerifyProof", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = pub.VerifyProof(gens, proof, disclosed, disclosedMessages, nil)
+		}
+	})
+}
@@ -0,0 +1,284 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bls
+
+import (
+	"crypto/subtle"
+	"errors"
+	"hash"
+	"io"
+	"math/big"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/signature"
+)
+
+// Variant selects the groups of the public keys and of the signatures of a Scheme, and so
+// their encodings.
+type Variant uint8
+
+const (
+	// MinPk has public keys in G1 and signatures in G2
+	MinPk Variant = iota
+	// MinSig has public keys in G2 and signatures in G1
+	MinSig
+)
+
+const (
+	// SizeG1 is the size in bytes of an encoded point of G1: a MinPk public key, or a MinSig signature
+	SizeG1 = bls12381.SizeOfG1AffineCompressed
+
+	// SizeG2 is the size in bytes of an encoded point of G2: a MinSig public key, or a MinPk signature
+	SizeG2 = bls12381.SizeOfG2AffineCompressed
+
+	// SizeScalar is the size in bytes of an encoded secret scalar
+	SizeScalar = fr.Bytes
+)
+
+var (
+	errInvalidVariant    = errors.New("invalid BLS variant")
+	errInvalidDST        = errors.New("the domain separation tag must have between 1 and 255 bytes")
+	errNoScheme          = errors.New("the key has no scheme, it must be created by a Scheme")
+	errWrongSize         = errors.New("wrong size buffer")
+	errInfinity          = errors.New("the public key is the point at infinity")
+	errZeroScalar        = errors.New("the secret scalar is zero")
+	errPublicKeyMismatch = errors.New("the public key doesn't match the secret scalar")
+)
+
+// Scheme is an instantiation of BLS signatures: a variant, and the domain separation tag of
+// the hash of the messages to the curve.
+type Scheme struct {
+	variant Variant
+	dst     []byte
+}
+
+// The proof of possession schemes of the draft, with their ciphersuite as domain separation tag.
+var (
+	// MinPkPoP is the scheme of Ethereum consensus
+	MinPkPoP = mustNewScheme(MinPk, "BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+	// MinSigPoP is its counterpart with short signatures
+	MinSigPoP = mustNewScheme(MinSig, "BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_POP_")
+)
+
+// NewScheme returns the scheme of the given variant, hashing the messages to the curve with the
+// domain separation tag dst (the ciphersuite ID of the draft, e.g.
+// "BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_AUG_" for the augmented MinPk scheme of Chia).
+func NewScheme(variant Variant, dst []byte) (*Scheme, error) {
+	if variant != MinPk && variant != MinSig {
+		return nil, errInvalidVariant
+	}
+	if len(dst) == 0 || len(dst) > 255 {
+		return nil, errInvalidDST
+	}
+	return &Scheme{variant: variant, dst: append([]byte(nil), dst...)}, nil
+}
+
+func mustNewScheme(variant Variant, dst string) *Scheme {
+	s, err := NewScheme(variant, []byte(dst))
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Variant returns the variant of the scheme
+func (s *Scheme) Variant() Variant {
+	return s.variant
+}
+
+// PublicKeySize returns the size in bytes of an encoded public key of the scheme
+func (s *Scheme) PublicKeySize() int {
+	if s.variant == MinPk {
+		return SizeG1
+	}
+	return SizeG2
+}
+
+// SignatureSize returns the size in bytes of an encoded signature of the scheme
+func (s *Scheme) SignatureSize() int {
+	if s.variant == MinPk {
+		return SizeG2
+	}
+	return SizeG1
+}
+
+// PublicKey is a BLS public key: a point of G1 (MinPk) or of G2 (MinSig)
+type PublicKey struct {
+	scheme *Scheme
+	g1     bls12381.G1Affine
+	g2     bls12381.G2Affine
+}
+
+// PrivateKey is a BLS private key
+type PrivateKey struct {
+	PublicKey PublicKey
+	scalar    fr.Element
+}
+
+// NewPublicKey returns an empty public key of the scheme, to be set with SetBytes
+func (s *Scheme) NewPublicKey() *PublicKey {
+	return &PublicKey{scheme: s}
+}
+
+// NewPrivateKey returns an empty private key of the scheme, to be set with SetBytes
+func (s *Scheme) NewPrivateKey() *PrivateKey {
+	return &PrivateKey{PublicKey: PublicKey{scheme: s}}
+}
+
+// GenerateKey generates a key pair of the scheme, with a secret scalar derived from 48 bytes
+// read from r.
+func (s *Scheme) GenerateKey(r io.Reader) (*PrivateKey, error) {
+	var buf [SizeScalar + 16]byte
+	for {
+		if _, err := io.ReadFull(r, buf[:]); err != nil {
+			return nil, err
+		}
+		var k fr.Element
+		k.SetBytes(buf[:]) // reduced modulo r, with a negligible bias
+		if !k.IsZero() {
+			return s.newKey(k), nil
+		}
+	}
+}
+
+// NewKeyFromScalar returns the key pair of the scheme of secret scalar b, encoded in big endian
+// on SizeScalar bytes.
+func (s *Scheme) NewKeyFromScalar(b []byte) (*PrivateKey, error) {
+	if len(b) != SizeScalar {
+		return nil, errWrongSize
+	}
+	var k fr.Element
+	if err := k.SetBytesCanonical(b); err != nil {
+		return nil, err
+	}
+	if k.IsZero() {
+		return nil, errZeroScalar
+	}
+	return s.newKey(k), nil
+}
+
+func (s *Scheme) newKey(k fr.Element) *PrivateKey {
+	res := s.NewPrivateKey()
+	res.scalar = k
+	var bk big.Int
+	k.BigInt(&bk)
+	if s.variant == MinPk {
+		res.PublicKey.g1.ScalarMultiplicationBase(&bk)
+	} else {
+		res.PublicKey.g2.ScalarMultiplicationBase(&bk)
+	}
+	return res
+}
+
+// Public returns the public key associated to the private key
+func (privKey *PrivateKey) Public() signature.PublicKey {
+	pub := privKey.PublicKey
+	return &pub
+}
+
+// Sign signs message: the signature is the secret scalar times the hash of the message to G2
+// (MinPk) or to G1 (MinSig), encoded in compressed form. If hFunc is not nil, the message is
+// hashed with it first.
+func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error) {
+	s := privKey.PublicKey.scheme
+	if s == nil {
+		return nil, errNoScheme
+	}
+	message = preHash(message, hFunc)
+	var k big.Int
+	privKey.scalar.BigInt(&k)
+	if s.variant == MinPk {
+		h, err := bls12381.HashToG2(message, s.dst)
+		if err != nil {
+			return nil, err
+		}
+		var sig bls12381.G2Affine
+		sig.ScalarMultiplication(&h, &k)
+		b := sig.Bytes()
+		return b[:], nil
+	}
+	h, err := bls12381.HashToG1(message, s.dst)
+	if err != nil {
+		return nil, err
+	}
+	var sig bls12381.G1Affine
+	sig.ScalarMultiplicationConstantTime(&h, &k)
+	b := sig.Bytes()
+	return b[:], nil
+}
+
+// Verify verifies that sigBin is a signature of message by the public key. The signature is
+// decoded from its compressed form, and checked to be in its subgroup. If hFunc is not nil,
+// the message is hashed with it first.
+func (pub *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, error) {
+	s := pub.scheme
+	if s == nil {
+		return false, errNoScheme
+	}
+	if len(sigBin) != s.SignatureSize() {
+		return false, errWrongSize
+	}
+	message = preHash(message, hFunc)
+	_, _, g1, g2 := bls12381.Generators()
+	if s.variant == MinPk {
+		// e(pk, H(m)) == e(G₁, σ)
+		var sig bls12381.G2Affine
+		if _, err := sig.SetBytes(sigBin); err != nil {
+			return false, err
+		}
+		h, err := bls12381.HashToG2(message, s.dst)
+		if err != nil {
+			return false, err
+		}
+		g1.Neg(&g1)
+		return bls12381.PairingCheck([]bls12381.G1Affine{pub.g1, g1}, []bls12381.G2Affine{h, sig})
+	}
+	// e(H(m), pk) == e(σ, G₂)
+	var sig bls12381.G1Affine
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		return false, err
+	}
+	h, err := bls12381.HashToG1(message, s.dst)
+	if err != nil {
+		return false, err
+	}
+	sig.Neg(&sig)
+	return bls12381.PairingCheck([]bls12381.G1Affine{h, sig}, []bls12381.G2Affine{pub.g2, g2})
+}
+
+// Equal compares 2 public keys, of the same scheme
+func (pub *PublicKey) Equal(x signature.PublicKey) bool {
+	xx, ok := x.(*PublicKey)
+	if !ok || pub.scheme == nil || xx.scheme == nil {
+		return false
+	}
+	if pub.scheme.variant != xx.scheme.variant || subtle.ConstantTimeCompare(pub.scheme.dst, xx.scheme.dst) != 1 {
+		return false
+	}
+	if pub.scheme.variant == MinPk {
+		return pub.g1.Equal(&xx.g1)
+	}
+	return pub.g2.Equal(&xx.g2)
+}
+
+func preHash(message []byte, hFunc hash.Hash) []byte {
+	if hFunc == nil {
+		return message
+	}
+	hFunc.Reset()
+	hFunc.Write(message)
+	return hFunc.Sum(nil)
+}
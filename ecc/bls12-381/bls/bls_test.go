@@ -0,0 +1,167 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bls
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"testing"
+)
+
+func TestSignVerify(t *testing.T) {
+	msg := []byte("testing BLS signatures")
+	for _, s := range []*Scheme{MinPkPoP, MinSigPoP} {
+		privKey, err := s.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pubKey := privKey.Public()
+		if len(pubKey.Bytes()) != s.PublicKeySize() {
+			t.Fatal("wrong public key size")
+		}
+
+		for _, hFunc := range []hash.Hash{nil, sha256.New()} {
+			sig, err := privKey.Sign(msg, hFunc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(sig) != s.SignatureSize() {
+				t.Fatal("wrong signature size")
+			}
+			if ok, err := pubKey.Verify(sig, msg, hFunc); err != nil || !ok {
+				t.Fatal("the signature should verify", err)
+			}
+			if ok, _ := pubKey.Verify(sig, []byte("another message"), hFunc); ok {
+				t.Fatal("the signature of another message shouldn't verify")
+			}
+		}
+
+		// another domain separation tag
+		other, err := NewScheme(s.Variant(), []byte("OTHER_DST"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		sig, err := privKey.Sign(msg, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		otherPub := other.NewPublicKey()
+		if _, err := otherPub.SetBytes(pubKey.Bytes()); err != nil {
+			t.Fatal(err)
+		}
+		if ok, _ := otherPub.Verify(sig, msg, nil); ok {
+			t.Fatal("the signature shouldn't verify with another domain separation tag")
+		}
+		if otherPub.Equal(pubKey) {
+			t.Fatal("the public keys of different schemes shouldn't be equal")
+		}
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	for _, s := range []*Scheme{MinPkPoP, MinSigPoP} {
+		privKey, err := s.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the public key is decoded from its abscissa
+		pubKey := s.NewPublicKey()
+		n, err := pubKey.SetBytes(privKey.PublicKey.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != s.PublicKeySize() || !pubKey.Equal(&privKey.PublicKey) {
+			t.Fatal("public key round trip failed")
+		}
+
+		_privKey := s.NewPrivateKey()
+		n, err = _privKey.SetBytes(privKey.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != s.PublicKeySize()+SizeScalar || !bytes.Equal(_privKey.Bytes(), privKey.Bytes()) {
+			t.Fatal("private key round trip failed")
+		}
+
+		// the public key must match the scalar
+		otherKey, err := s.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mismatch := append(otherKey.PublicKey.Bytes(), privKey.Bytes()[s.PublicKeySize():]...)
+		if _, err := s.NewPrivateKey().SetBytes(mismatch); err != errPublicKeyMismatch {
+			t.Fatal("expected errPublicKeyMismatch, got", err)
+		}
+
+		// the point at infinity isn't a public key
+		infinity := make([]byte, s.PublicKeySize())
+		infinity[0] = 0xc0
+		if _, err := s.NewPublicKey().SetBytes(infinity); err != errInfinity {
+			t.Fatal("expected errInfinity, got", err)
+		}
+		if _, err := s.NewPublicKey().SetBytes(infinity[1:]); err != errWrongSize {
+			t.Fatal("expected errWrongSize, got", err)
+		}
+
+		// the keys must be created by a scheme
+		if _, err := new(PublicKey).SetBytes(privKey.PublicKey.Bytes()); err != errNoScheme {
+			t.Fatal("expected errNoScheme, got", err)
+		}
+	}
+}
+
+func TestNewScheme(t *testing.T) {
+	if _, err := NewScheme(MinSig+1, []byte("DST")); err != errInvalidVariant {
+		t.Fatal("expected errInvalidVariant, got", err)
+	}
+	if _, err := NewScheme(MinPk, nil); err != errInvalidDST {
+		t.Fatal("expected errInvalidDST, got", err)
+	}
+}
+
+// TestEth2Vector checks a signature of the MinPk proof of possession scheme against the
+// consensus specs of Ethereum (tests/general/phase0/bls/sign).
+func TestEth2Vector(t *testing.T) {
+	sk, _ := hex.DecodeString("263dbd792f5b1be47ed85f8938c0f29586af0d3ac7b977f21c278fe1462040e3")
+	expectedPk, _ := hex.DecodeString("a491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a")
+	expectedSig, _ := hex.DecodeString("b6ed936746e01f8ecf281f020953fbf1f01debd5657c4a383940b020b26507f6076334f91e2366c96e9ab279fb5158090352ea1c5b0c9274504f4f0e7053af24802e51e4568d164fe986834f41e55c8e850ce1f98458c0cfc9ab380b55285a55")
+	msg := make([]byte, 32)
+
+	privKey, err := MinPkPoP.NewKeyFromScalar(sk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(privKey.PublicKey.Bytes(), expectedPk) {
+		t.Fatal("public key mismatch")
+	}
+	sig, err := privKey.Sign(msg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(sig, expectedSig) {
+		t.Fatal("signature mismatch")
+	}
+	pubKey := MinPkPoP.NewPublicKey()
+	if _, err := pubKey.SetBytes(expectedPk); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := pubKey.Verify(expectedSig, msg, nil); err != nil || !ok {
+		t.Fatal("the reference signature should verify", err)
+	}
+}
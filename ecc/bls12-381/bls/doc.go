@@ -0,0 +1,35 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bls implements BLS signatures on BLS12-381, following
+// https://datatracker.ietf.org/doc/draft-irtf-cfrg-bls-signature/.
+//
+// A Scheme selects the variant, that is the groups of the public keys and of the signatures,
+// and the domain separation tag of the hash of the messages to the curve:
+//
+//   - MinPk: public keys in G1 (48 bytes), signatures in G2 (96 bytes), as in Ethereum
+//     consensus and Chia;
+//   - MinSig: public keys in G2 (96 bytes), signatures in G1 (48 bytes).
+//
+// Public keys and signatures are encoded in the compressed (x-only) form of their group, the
+// ZCash serialization format of bls12381.G1Affine.Bytes and bls12381.G2Affine.Bytes: the
+// abscissa, with flags giving the sign of the ordinate, which is recovered when decoding. The
+// decoded points are checked to be in their prime order subgroup, and public keys at infinity
+// are rejected.
+//
+// # Warning
+//
+// This code has not been audited and is provided as-is. In particular, there is no security
+// guarantees such as constant time implementation or side-channel attack resistance.
+package bls
@@ -0,0 +1,98 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bls
+
+import (
+	"crypto/subtle"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// Bytes returns the compressed encoding of the public key: SizeG1 bytes for MinPk, SizeG2
+// bytes for MinSig.
+func (pub *PublicKey) Bytes() []byte {
+	if pub.scheme != nil && pub.scheme.variant == MinSig {
+		b := pub.g2.Bytes()
+		return b[:]
+	}
+	b := pub.g1.Bytes()
+	return b[:]
+}
+
+// SetBytes sets the public key, of the scheme it was created by, from its compressed
+// encoding: the ordinate is recovered from the abscissa, and the point is checked to be in its
+// subgroup and not at infinity. It returns the number of bytes read.
+func (pub *PublicKey) SetBytes(buf []byte) (int, error) {
+	if pub.scheme == nil {
+		return 0, errNoScheme
+	}
+	size := pub.scheme.PublicKeySize()
+	if len(buf) < size {
+		return 0, errWrongSize
+	}
+	if pub.scheme.variant == MinPk {
+		if _, err := pub.g1.SetBytes(buf[:size]); err != nil {
+			return 0, err
+		}
+		if pub.g1.IsInfinity() {
+			return 0, errInfinity
+		}
+		return size, nil
+	}
+	if _, err := pub.g2.SetBytes(buf[:size]); err != nil {
+		return 0, err
+	}
+	if pub.g2.IsInfinity() {
+		return 0, errInfinity
+	}
+	return size, nil
+}
+
+// Bytes returns the encoding of the private key, as publicKey||scalar, where publicKey is as
+// PublicKey.Bytes and scalar is in big endian on SizeScalar bytes.
+func (privKey *PrivateKey) Bytes() []byte {
+	k := privKey.scalar.Bytes()
+	return append(privKey.PublicKey.Bytes(), k[:]...)
+}
+
+// SetBytes sets the private key, of the scheme it was created by, from its encoding (see
+// Bytes), and checks that the public key matches the scalar. It returns the number of bytes
+// read.
+func (privKey *PrivateKey) SetBytes(buf []byte) (int, error) {
+	s := privKey.PublicKey.scheme
+	if s == nil {
+		return 0, errNoScheme
+	}
+	n, err := privKey.PublicKey.SetBytes(buf)
+	if err != nil {
+		return 0, err
+	}
+	if len(buf) < n+SizeScalar {
+		return 0, errWrongSize
+	}
+	var k fr.Element
+	if err := k.SetBytesCanonical(buf[n : n+SizeScalar]); err != nil {
+		return 0, err
+	}
+	if k.IsZero() {
+		return 0, errZeroScalar
+	}
+	expected := s.newKey(k)
+	if subtle.ConstantTimeCompare(expected.PublicKey.Bytes(), buf[:n]) != 1 {
+		return 0, errPublicKeyMismatch
+	}
+	privKey.scalar = k
+	return n + SizeScalar, nil
+}
@@ -23,9 +23,21 @@
 // Security: estimated 126-bit level following [https://eprint.iacr.org/2019/885.pdf]
 // (r is 255 bits and p¹² is 4569 bits)
 //
+// # Serialization
+//
+// G1Affine.Bytes and G2Affine.Bytes return the 48 and 96 bytes compressed (x-only) encodings
+// of the ZCash serialization format, and SetBytes recovers y from the sign flag. These are the
+// public key and signature encodings of BLS signatures in Ethereum consensus (public keys in G1,
+// signatures in G2) and Chia, and of the minimal-signature-size variant (public keys in G2,
+// signatures in G1). RawBytes returns the uncompressed encodings.
+//
+// G1Affine.SetX and G2Affine.SetX recover a point from its abscissa and the sign of its
+// ordinate. The BLS signatures of the bls subpackage pick, per scheme, the group of their
+// public keys and signatures, and so which of these encodings they use.
+//
 // # Warning
 //
-// This code has been partially audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+// This code has been partially audited and is provided as-is. In particular, only G1Affine.ScalarMultiplicationConstantTime (used by the ECDSA signing and key generation) is meant to run in constant time; the other operations, ScalarMultiplication included, run in variable time.
 package bls12381
 
 import (
@@ -19,7 +19,6 @@ package ecdsa
 import (
 	"crypto/aes"
 	"crypto/cipher"
-	"crypto/rand"
 	"crypto/sha512"
 	"crypto/subtle"
 	"hash"
@@ -29,6 +28,7 @@ import (
 	"github.com/consensys/gnark-crypto/ecc/bls12-381"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/random"
 	"github.com/consensys/gnark-crypto/signature"
 )
 
@@ -50,8 +50,10 @@ type PublicKey struct {
 
 // PrivateKey represents an ECDSA private key
 type PrivateKey struct {
-	PublicKey PublicKey
-	scalar    [sizeFr]byte // secret scalar, in big Endian
+	PublicKey  PublicKey
+	scalar     [sizeFr]byte // secret scalar, in big Endian
+	blinding   bool         // see SetScalarBlinding
+	randomness io.Reader    // see SetRandomness
 }
 
 // Signature represents an ECDSA signature
@@ -89,10 +91,53 @@ func GenerateKey(rand io.Reader) (*PrivateKey, error) {
 
 	privateKey := new(PrivateKey)
 	k.FillBytes(privateKey.scalar[:sizeFr])
-	privateKey.PublicKey.A.ScalarMultiplication(&g, k)
+	privateKey.PublicKey.A.ScalarMultiplicationConstantTime(&g, k)
 	return privateKey, nil
 }
 
+// SetScalarBlinding enables or disables the blinding of the secret nonce in the
+// scalar multiplication of the signing operations (see bls12381.G1Affine.ScalarMultiplicationBlinded).
+//
+// Blinding is a hardening against side-channel attacks for keys used in shared or
+// adversarial environments; it makes signing slower. It is disabled by default, and
+// doesn't change the signatures.
+func (privKey *PrivateKey) SetScalarBlinding(enabled bool) {
+	privKey.blinding = enabled
+}
+
+// SetRandomness sets the source of the entropy mixed into the secret nonces of the signing
+// operations of privKey, e.g. an HSM-backed source of entropy or a DRBG. If r is nil (default),
+// the entropy is read from the package-level source of randomness (see random.SetReader).
+//
+// The nonces are derived from the private key and the message along with the entropy, so that a
+// biased or even constant r doesn't leak the private key (the signatures become deterministic).
+func (privKey *PrivateKey) SetRandomness(r io.Reader) {
+	privKey.randomness = r
+}
+
+// mulBase returns [k]g, where g is the generator of G1, blinding k if privKey.blinding is set
+func (privKey *PrivateKey) mulBase(k *big.Int) (bls12381.G1Affine, error) {
+	var P bls12381.G1Affine
+	if !privKey.blinding {
+		// k is secret: use the constant-time scalar multiplication
+		_, _, g, _ := bls12381.Generators()
+		P.ScalarMultiplicationConstantTime(&g, k)
+		return P, nil
+	}
+	_, _, g, _ := bls12381.Generators()
+	_, err := P.ScalarMultiplicationBlinded(&g, k)
+	return P, err
+}
+
+// inverseNonce sets res = k⁻¹ (mod order). The nonce k is secret, so it is inverted in constant
+// time (see fr.Element.InverseConstantTime).
+func inverseNonce(res, k *big.Int) {
+	var e fr.Element
+	e.SetBigInt(k)
+	e.InverseConstantTime(&e)
+	e.BigInt(res)
+}
+
 // HashToInt converts a hash value to an integer. Per FIPS 186-4, Section 6.4,
 // we use the left-most bits of the hash to match the bit-length of the order of
 // the curve. This also performs Step 5 of SEC 1, Version 2.0, Section 4.1.3.
@@ -136,9 +181,13 @@ func nonce(privateKey *PrivateKey, hash []byte) (csprng *cipher.StreamReader, er
 	// [Coron]: https://cs.nyu.edu/~dodis/ps/merkle.pdf
 	// [Larsson]: https://web.archive.org/web/20040719170906/https://www.nada.kth.se/kurser/kth/2D1441/semteo03/lecturenotes/assump.pdf
 
-	// Get 256 bits of entropy from rand.
+	// Get 256 bits of entropy from the source of randomness of the key.
+	r := privateKey.randomness
+	if r == nil {
+		r = random.Reader()
+	}
 	entropy := make([]byte, 32)
-	_, err = io.ReadFull(rand.Reader, entropy)
+	_, err = io.ReadFull(r, entropy)
 	if err != nil {
 		return
 
@@ -206,9 +255,11 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 				return nil, err
 			}
 
-			var P bls12381.G1Affine
-			P.ScalarMultiplicationBase(k)
-			kInv.ModInverse(k, order)
+			P, err := privKey.mulBase(k)
+			if err != nil {
+				return nil, err
+			}
+			inverseNonce(kInv, k)
 
 			P.X.BigInt(r)
 
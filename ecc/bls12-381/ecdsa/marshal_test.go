@@ -18,7 +18,9 @@ package ecdsa
 
 import (
 	"crypto/rand"
+	"crypto/sha256"
 	"crypto/subtle"
+	"encoding/json"
 	"testing"
 
 	"github.com/leanovate/gopter"
@@ -62,3 +64,69 @@ func TestSerialization(t *testing.T) {
 
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
+
+func TestSerializationJSONCBOR(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := GenerateKey(rand.Reader)
+	sigBin, err := privKey.Sign([]byte("message"), sha256.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sig Signature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("json", func(t *testing.T) {
+		var pub PublicKey
+		b, err := json.Marshal(&privKey.PublicKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(b, &pub); err != nil {
+			t.Fatal(err)
+		}
+		if !pub.Equal(&privKey.PublicKey) {
+			t.Fatal("public key JSON round trip failed")
+		}
+
+		var s Signature
+		b, err = json.Marshal(&sig)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(b, &s); err != nil {
+			t.Fatal(err)
+		}
+		if s != sig {
+			t.Fatal("signature JSON round trip failed")
+		}
+	})
+
+	t.Run("cbor", func(t *testing.T) {
+		var pub PublicKey
+		b, err := privKey.PublicKey.MarshalCBOR()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := pub.UnmarshalCBOR(b); err != nil {
+			t.Fatal(err)
+		}
+		if !pub.Equal(&privKey.PublicKey) {
+			t.Fatal("public key CBOR round trip failed")
+		}
+
+		var s Signature
+		b, err = sig.MarshalCBOR()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := s.UnmarshalCBOR(b); err != nil {
+			t.Fatal(err)
+		}
+		if s != sig {
+			t.Fatal("signature CBOR round trip failed")
+		}
+	})
+}
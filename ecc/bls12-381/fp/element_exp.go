@@ -1098,3 +1098,542 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	return z
 }
+
+// expByInverseExp is equivalent to z.Exp(x, 1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaa9)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByInverseExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_100      = 2*_10
+	//	_1000     = 2*_100
+	//	_1001     = 1 + _1000
+	//	_1011     = _10 + _1001
+	//	_1101     = _10 + _1011
+	//	_10001    = _100 + _1101
+	//	_10100    = _1001 + _1011
+	//	_11001    = _1000 + _10001
+	//	_11010    = 1 + _11001
+	//	_110100   = 2*_11010
+	//	_110110   = _10 + _110100
+	//	_110111   = 1 + _110110
+	//	_1001101  = _11001 + _110100
+	//	_1001111  = _10 + _1001101
+	//	_1010101  = _1000 + _1001101
+	//	_1011101  = _1000 + _1010101
+	//	_1100111  = _11010 + _1001101
+	//	_1101001  = _10 + _1100111
+	//	_1110111  = _11010 + _1011101
+	//	_1111011  = _100 + _1110111
+	//	_10001001 = _110100 + _1010101
+	//	_10010101 = _11010 + _1111011
+	//	_10010111 = _10 + _10010101
+	//	_10101001 = _10100 + _10010101
+	//	_10110001 = _1000 + _10101001
+	//	_10111111 = _110110 + _10001001
+	//	_11000011 = _100 + _10111111
+	//	_11010000 = _1101 + _11000011
+	//	_11010111 = _10100 + _11000011
+	//	_11100001 = _10001 + _11010000
+	//	_11100101 = _100 + _11100001
+	//	_11101011 = _10100 + _11010111
+	//	_11110101 = _10100 + _11100001
+	//	_11111111 = _10100 + _11101011
+	//	i57       = ((_10111111 + _11100001) << 8 + _10001) << 11 + _11110101
+	//	i85       = ((i57 << 11 + _11100101) << 8 + _11111111) << 7
+	//	i107      = ((_1001101 + i85) << 9 + _1101001) << 10 + _10110001
+	//	i131      = ((i107 << 7 + _1011101) << 9 + _1111011) << 6
+	//	i154      = ((_11001 + i131) << 11 + _1101001) << 9 + _11101011
+	//	i182      = ((i154 << 10 + _11010111) << 6 + _11001) << 10
+	//	i205      = ((_1110111 + i182) << 9 + _10010111) << 11 + _1001111
+	//	i235      = ((i205 << 10 + _11100001) << 9 + _10001001) << 9
+	//	i256      = ((_10111111 + i235) << 8 + _1100111) << 10 + _11000011
+	//	i284      = ((i256 << 9 + _10010101) << 12 + _1111011) << 5
+	//	i305      = ((_1011 + i284) << 11 + _1111011) << 7 + _1001
+	//	i337      = ((i305 << 13 + _11110101) << 9 + _10111111) << 8
+	//	i359      = ((_11111111 + i337) << 8 + _11101011) << 11 + _10101001
+	//	i383      = ((i359 << 8 + _11111111) << 8 + _11111111) << 6
+	//	i405      = ((_110111 + i383) << 10 + _11111111) << 9 + _11111111
+	//	i431      = ((i405 << 8 + _11111111) << 8 + _11111111) << 8
+	//	return      ((_11111111 + i431) << 7 + _1010101) << 9 + _10101001
+	//
+	// Operations: 376 squares 74 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+		t15 = new(Element)
+		t16 = new(Element)
+		t17 = new(Element)
+		t18 = new(Element)
+		t19 = new(Element)
+		t20 = new(Element)
+		t21 = new(Element)
+		t22 = new(Element)
+		t23 = new(Element)
+		t24 = new(Element)
+		t25 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14,t15,t16,t17,t18,t19,t20,t21,t22,t23,t24,t25 Element
+	// Step 1: z = x^0x2
+	z.Square(&x)
+
+	// Step 2: t3 = x^0x4
+	t3.Square(z)
+
+	// Step 3: t10 = x^0x8
+	t10.Square(t3)
+
+	// Step 4: t6 = x^0x9
+	t6.Mul(&x, t10)
+
+	// Step 5: t8 = x^0xb
+	t8.Mul(z, t6)
+
+	// Step 6: t5 = x^0xd
+	t5.Mul(z, t8)
+
+	// Step 7: t24 = x^0x11
+	t24.Mul(t3, t5)
+
+	// Step 8: t1 = x^0x14
+	t1.Mul(t6, t8)
+
+	// Step 9: t17 = x^0x19
+	t17.Mul(t10, t24)
+
+	// Step 10: t9 = x^0x1a
+	t9.Mul(&x, t17)
+
+	// Step 11: t12 = x^0x34
+	t12.Square(t9)
+
+	// Step 12: t4 = x^0x36
+	t4.Mul(z, t12)
+
+	// Step 13: t2 = x^0x37
+	t2.Mul(&x, t4)
+
+	// Step 14: t22 = x^0x4d
+	t22.Mul(t17, t12)
+
+	// Step 15: t14 = x^0x4f
+	t14.Mul(z, t22)
+
+	// Step 16: t0 = x^0x55
+	t0.Mul(t10, t22)
+
+	// Step 17: t20 = x^0x5d
+	t20.Mul(t10, t0)
+
+	// Step 18: t11 = x^0x67
+	t11.Mul(t9, t22)
+
+	// Step 19: t19 = x^0x69
+	t19.Mul(z, t11)
+
+	// Step 20: t16 = x^0x77
+	t16.Mul(t9, t20)
+
+	// Step 21: t7 = x^0x7b
+	t7.Mul(t3, t16)
+
+	// Step 22: t12 = x^0x89
+	t12.Mul(t12, t0)
+
+	// Step 23: t9 = x^0x95
+	t9.Mul(t9, t7)
+
+	// Step 24: t15 = x^0x97
+	t15.Mul(z, t9)
+
+	// Step 25: z = x^0xa9
+	z.Mul(t1, t9)
+
+	// Step 26: t21 = x^0xb1
+	t21.Mul(t10, z)
+
+	// Step 27: t4 = x^0xbf
+	t4.Mul(t4, t12)
+
+	// Step 28: t10 = x^0xc3
+	t10.Mul(t3, t4)
+
+	// Step 29: t5 = x^0xd0
+	t5.Mul(t5, t10)
+
+	// Step 30: t18 = x^0xd7
+	t18.Mul(t1, t10)
+
+	// Step 31: t13 = x^0xe1
+	t13.Mul(t24, t5)
+
+	// Step 32: t23 = x^0xe5
+	t23.Mul(t3, t13)
+
+	// Step 33: t3 = x^0xeb
+	t3.Mul(t1, t18)
+
+	// Step 34: t5 = x^0xf5
+	t5.Mul(t1, t13)
+
+	// Step 35: t1 = x^0xff
+	t1.Mul(t1, t3)
+
+	// Step 36: t25 = x^0x1a0
+	t25.Mul(t4, t13)
+
+	// Step 44: t25 = x^0x1a000
+	for s := 0; s < 8; s++ {
+		t25.Square(t25)
+	}
+
+	// Step 45: t24 = x^0x1a011
+	t24.Mul(t24, t25)
+
+	// Step 56: t24 = x^0xd008800
+	for s := 0; s < 11; s++ {
+		t24.Square(t24)
+	}
+
+	// Step 57: t24 = x^0xd0088f5
+	t24.Mul(t5, t24)
+
+	// Step 68: t24 = x^0x680447a800
+	for s := 0; s < 11; s++ {
+		t24.Square(t24)
+	}
+
+	// Step 69: t23 = x^0x680447a8e5
+	t23.Mul(t23, t24)
+
+	// Step 77: t23 = x^0x680447a8e500
+	for s := 0; s < 8; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 78: t23 = x^0x680447a8e5ff
+	t23.Mul(t1, t23)
+
+	// Step 85: t23 = x^0x340223d472ff80
+	for s := 0; s < 7; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 86: t22 = x^0x340223d472ffcd
+	t22.Mul(t22, t23)
+
+	// Step 95: t22 = x^0x680447a8e5ff9a00
+	for s := 0; s < 9; s++ {
+		t22.Square(t22)
+	}
+
+	// Step 96: t22 = x^0x680447a8e5ff9a69
+	t22.Mul(t19, t22)
+
+	// Step 106: t22 = x^0x1a0111ea397fe69a400
+	for s := 0; s < 10; s++ {
+		t22.Square(t22)
+	}
+
+	// Step 107: t21 = x^0x1a0111ea397fe69a4b1
+	t21.Mul(t21, t22)
+
+	// Step 114: t21 = x^0xd0088f51cbff34d25880
+	for s := 0; s < 7; s++ {
+		t21.Square(t21)
+	}
+
+	// Step 115: t20 = x^0xd0088f51cbff34d258dd
+	t20.Mul(t20, t21)
+
+	// Step 124: t20 = x^0x1a0111ea397fe69a4b1ba00
+	for s := 0; s < 9; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 125: t20 = x^0x1a0111ea397fe69a4b1ba7b
+	t20.Mul(t7, t20)
+
+	// Step 131: t20 = x^0x680447a8e5ff9a692c6e9ec0
+	for s := 0; s < 6; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 132: t20 = x^0x680447a8e5ff9a692c6e9ed9
+	t20.Mul(t17, t20)
+
+	// Step 143: t20 = x^0x340223d472ffcd3496374f6c800
+	for s := 0; s < 11; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 144: t19 = x^0x340223d472ffcd3496374f6c869
+	t19.Mul(t19, t20)
+
+	// Step 153: t19 = x^0x680447a8e5ff9a692c6e9ed90d200
+	for s := 0; s < 9; s++ {
+		t19.Square(t19)
+	}
+
+	// Step 154: t19 = x^0x680447a8e5ff9a692c6e9ed90d2eb
+	t19.Mul(t3, t19)
+
+	// Step 164: t19 = x^0x1a0111ea397fe69a4b1ba7b6434bac00
+	for s := 0; s < 10; s++ {
+		t19.Square(t19)
+	}
+
+	// Step 165: t18 = x^0x1a0111ea397fe69a4b1ba7b6434bacd7
+	t18.Mul(t18, t19)
+
+	// Step 171: t18 = x^0x680447a8e5ff9a692c6e9ed90d2eb35c0
+	for s := 0; s < 6; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 172: t17 = x^0x680447a8e5ff9a692c6e9ed90d2eb35d9
+	t17.Mul(t17, t18)
+
+	// Step 182: t17 = x^0x1a0111ea397fe69a4b1ba7b6434bacd76400
+	for s := 0; s < 10; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 183: t16 = x^0x1a0111ea397fe69a4b1ba7b6434bacd76477
+	t16.Mul(t16, t17)
+
+	// Step 192: t16 = x^0x340223d472ffcd3496374f6c869759aec8ee00
+	for s := 0; s < 9; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 193: t15 = x^0x340223d472ffcd3496374f6c869759aec8ee97
+	t15.Mul(t15, t16)
+
+	// Step 204: t15 = x^0x1a0111ea397fe69a4b1ba7b6434bacd764774b800
+	for s := 0; s < 11; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 205: t14 = x^0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f
+	t14.Mul(t14, t15)
+
+	// Step 215: t14 = x^0x680447a8e5ff9a692c6e9ed90d2eb35d91dd2e13c00
+	for s := 0; s < 10; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 216: t13 = x^0x680447a8e5ff9a692c6e9ed90d2eb35d91dd2e13ce1
+	t13.Mul(t13, t14)
+
+	// Step 225: t13 = x^0xd0088f51cbff34d258dd3db21a5d66bb23ba5c279c200
+	for s := 0; s < 9; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 226: t12 = x^0xd0088f51cbff34d258dd3db21a5d66bb23ba5c279c289
+	t12.Mul(t12, t13)
+
+	// Step 235: t12 = x^0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f3851200
+	for s := 0; s < 9; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 236: t12 = x^0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf
+	t12.Mul(t4, t12)
+
+	// Step 244: t12 = x^0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf00
+	for s := 0; s < 8; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 245: t11 = x^0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf67
+	t11.Mul(t11, t12)
+
+	// Step 255: t11 = x^0x680447a8e5ff9a692c6e9ed90d2eb35d91dd2e13ce144afd9c00
+	for s := 0; s < 10; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 256: t10 = x^0x680447a8e5ff9a692c6e9ed90d2eb35d91dd2e13ce144afd9cc3
+	t10.Mul(t10, t11)
+
+	// Step 265: t10 = x^0xd0088f51cbff34d258dd3db21a5d66bb23ba5c279c2895fb398600
+	for s := 0; s < 9; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 266: t9 = x^0xd0088f51cbff34d258dd3db21a5d66bb23ba5c279c2895fb398695
+	t9.Mul(t9, t10)
+
+	// Step 278: t9 = x^0xd0088f51cbff34d258dd3db21a5d66bb23ba5c279c2895fb398695000
+	for s := 0; s < 12; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 279: t9 = x^0xd0088f51cbff34d258dd3db21a5d66bb23ba5c279c2895fb39869507b
+	t9.Mul(t7, t9)
+
+	// Step 284: t9 = x^0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f60
+	for s := 0; s < 5; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 285: t8 = x^0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b
+	t8.Mul(t8, t9)
+
+	// Step 296: t8 = x^0xd0088f51cbff34d258dd3db21a5d66bb23ba5c279c2895fb39869507b5800
+	for s := 0; s < 11; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 297: t7 = x^0xd0088f51cbff34d258dd3db21a5d66bb23ba5c279c2895fb39869507b587b
+	t7.Mul(t7, t8)
+
+	// Step 304: t7 = x^0x680447a8e5ff9a692c6e9ed90d2eb35d91dd2e13ce144afd9cc34a83dac3d80
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 305: t6 = x^0x680447a8e5ff9a692c6e9ed90d2eb35d91dd2e13ce144afd9cc34a83dac3d89
+	t6.Mul(t6, t7)
+
+	// Step 318: t6 = x^0xd0088f51cbff34d258dd3db21a5d66bb23ba5c279c2895fb39869507b587b12000
+	for s := 0; s < 13; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 319: t5 = x^0xd0088f51cbff34d258dd3db21a5d66bb23ba5c279c2895fb39869507b587b120f5
+	t5.Mul(t5, t6)
+
+	// Step 328: t5 = x^0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241ea00
+	for s := 0; s < 9; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 329: t4 = x^0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabf
+	t4.Mul(t4, t5)
+
+	// Step 337: t4 = x^0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabf00
+	for s := 0; s < 8; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 338: t4 = x^0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfff
+	t4.Mul(t1, t4)
+
+	// Step 346: t4 = x^0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfff00
+	for s := 0; s < 8; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 347: t3 = x^0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb
+	t3.Mul(t3, t4)
+
+	// Step 358: t3 = x^0xd0088f51cbff34d258dd3db21a5d66bb23ba5c279c2895fb39869507b587b120f55ffff5800
+	for s := 0; s < 11; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 359: t3 = x^0xd0088f51cbff34d258dd3db21a5d66bb23ba5c279c2895fb39869507b587b120f55ffff58a9
+	t3.Mul(z, t3)
+
+	// Step 367: t3 = x^0xd0088f51cbff34d258dd3db21a5d66bb23ba5c279c2895fb39869507b587b120f55ffff58a900
+	for s := 0; s < 8; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 368: t3 = x^0xd0088f51cbff34d258dd3db21a5d66bb23ba5c279c2895fb39869507b587b120f55ffff58a9ff
+	t3.Mul(t1, t3)
+
+	// Step 376: t3 = x^0xd0088f51cbff34d258dd3db21a5d66bb23ba5c279c2895fb39869507b587b120f55ffff58a9ff00
+	for s := 0; s < 8; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 377: t3 = x^0xd0088f51cbff34d258dd3db21a5d66bb23ba5c279c2895fb39869507b587b120f55ffff58a9ffff
+	t3.Mul(t1, t3)
+
+	// Step 383: t3 = x^0x340223d472ffcd3496374f6c869759aec8ee9709e70a257ece61a541ed61ec483d57fffd62a7fffc0
+	for s := 0; s < 6; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 384: t2 = x^0x340223d472ffcd3496374f6c869759aec8ee9709e70a257ece61a541ed61ec483d57fffd62a7ffff7
+	t2.Mul(t2, t3)
+
+	// Step 394: t2 = x^0xd0088f51cbff34d258dd3db21a5d66bb23ba5c279c2895fb39869507b587b120f55ffff58a9ffffdc00
+	for s := 0; s < 10; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 395: t2 = x^0xd0088f51cbff34d258dd3db21a5d66bb23ba5c279c2895fb39869507b587b120f55ffff58a9ffffdcff
+	t2.Mul(t1, t2)
+
+	// Step 404: t2 = x^0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9fe00
+	for s := 0; s < 9; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 405: t2 = x^0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feff
+	t2.Mul(t1, t2)
+
+	// Step 413: t2 = x^0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feff00
+	for s := 0; s < 8; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 414: t2 = x^0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffff
+	t2.Mul(t1, t2)
+
+	// Step 422: t2 = x^0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffff00
+	for s := 0; s < 8; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 423: t2 = x^0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffff
+	t2.Mul(t1, t2)
+
+	// Step 431: t2 = x^0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffff00
+	for s := 0; s < 8; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 432: t1 = x^0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffff
+	t1.Mul(t1, t2)
+
+	// Step 439: t1 = x^0xd0088f51cbff34d258dd3db21a5d66bb23ba5c279c2895fb39869507b587b120f55ffff58a9ffffdcff7fffffff80
+	for s := 0; s < 7; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 440: t0 = x^0xd0088f51cbff34d258dd3db21a5d66bb23ba5c279c2895fb39869507b587b120f55ffff58a9ffffdcff7fffffffd5
+	t0.Mul(t0, t1)
+
+	// Step 449: t0 = x^0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaa00
+	for s := 0; s < 9; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 450: z = x^0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaa9
+	z.Mul(z, t0)
+
+	return z
+}
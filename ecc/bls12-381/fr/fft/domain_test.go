@@ -20,6 +20,8 @@ import (
 	"bytes"
 	"reflect"
 	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
 )
 
 func TestDomainSerialization(t *testing.T) {
@@ -45,3 +47,118 @@ func TestDomainSerialization(t *testing.T) {
 		t.Fatal("Domain.SetBytes(Bytes()) failed")
 	}
 }
+func TestDomainTables(t *testing.T) {
+
+	const n = 1 << 5
+	domains := map[string]*Domain{
+		"precomputed":     NewDomain(n),
+		"not precomputed": NewDomain(n, WithoutPrecompute()),
+	}
+	// a domain built without NewDomain has nowhere to cache the tables
+	ref := NewDomain(n)
+	domains["zero value"] = &Domain{
+		Cardinality:            n,
+		Generator:              ref.Generator,
+		GeneratorInv:           ref.GeneratorInv,
+		FrMultiplicativeGen:    ref.FrMultiplicativeGen,
+		FrMultiplicativeGenInv: ref.FrMultiplicativeGenInv,
+	}
+
+	for name, domain := range domains {
+		tables := []struct {
+			name  string
+			w     fr.Element
+			table func() []fr.Element
+		}{
+			{"RootsOfUnity", domain.Generator, domain.RootsOfUnity},
+			{"RootsOfUnityInv", domain.GeneratorInv, domain.RootsOfUnityInv},
+			{"ShiftPowers", domain.FrMultiplicativeGen, domain.ShiftPowers},
+			{"ShiftPowersInv", domain.FrMultiplicativeGenInv, domain.ShiftPowersInv},
+		}
+		for _, tt := range tables {
+			table := tt.table()
+			if len(table) != n {
+				t.Fatalf("%s (%s): wrong size", tt.name, name)
+			}
+			var acc fr.Element
+			acc.SetOne()
+			for i := range table {
+				if !table[i].Equal(&acc) {
+					t.Fatalf("%s (%s): wrong power at index %d", tt.name, name, i)
+				}
+				acc.Mul(&acc, &tt.w)
+			}
+			// the tables are cached
+			if name != "zero value" && &tt.table()[0] != &table[0] {
+				t.Fatalf("%s (%s): the table is recomputed", tt.name, name)
+			}
+		}
+	}
+}
+
+func TestDomainWithGenerator(t *testing.T) {
+
+	const n = 1 << 4
+	ref := NewDomain(n)
+
+	// domain ordered by ω⁻¹, on a custom coset
+	var shift fr.Element
+	shift.Square(&ref.FrMultiplicativeGen)
+	domain := NewDomain(n, WithGenerator(ref.GeneratorInv), WithShift(shift))
+	if !domain.Generator.Equal(&ref.GeneratorInv) || !domain.GeneratorInv.Equal(&ref.Generator) {
+		t.Fatal("the generator was not set")
+	}
+
+	// the FFT evaluates the polynomial at shift*ω⁻ⁱ
+	coeffs := make([]fr.Element, n)
+	for i := range coeffs {
+		coeffs[i].SetRandom()
+	}
+	evals := make([]fr.Element, n)
+	copy(evals, coeffs)
+	domain.FFT(evals, DIF, OnCoset())
+	BitReverse(evals)
+
+	var x fr.Element
+	x.Set(&shift)
+	for i := range evals {
+		var expected fr.Element
+		for j := n - 1; j >= 0; j-- {
+			expected.Mul(&expected, &x).Add(&expected, &coeffs[j])
+		}
+		if !evals[i].Equal(&expected) {
+			t.Fatalf("wrong evaluation at shift*ω^-%d", i)
+		}
+		x.Mul(&x, &ref.GeneratorInv)
+	}
+
+	BitReverse(evals)
+	domain.FFTInverse(evals, DIT, OnCoset())
+	for i := range evals {
+		if !evals[i].Equal(&coeffs[i]) {
+			t.Fatal("FFTInverse(FFT) is not the identity")
+		}
+	}
+
+	// invalid generators and shifts
+	var zero, one, square fr.Element
+	one.SetOne()
+	square.Square(&ref.Generator)
+	invalid := map[string][]DomainOption{
+		"generator of order n/2": {WithGenerator(square)},
+		"generator 1":            {WithGenerator(one)},
+		"generator of order 2n":  {WithGenerator(NewDomain(2 * n).Generator)},
+		"zero shift":             {WithShift(zero)},
+		"shift in the domain":    {WithShift(ref.Generator)},
+	}
+	for name, opts := range invalid {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("%s: NewDomain should panic", name)
+				}
+			}()
+			NewDomain(n, opts...)
+		}()
+	}
+}
@@ -40,6 +40,7 @@ const butterflyThreshold = 16
 // if decimation == DIT (decimation in time), the input must be in bit-reversed order
 // if decimation == DIF (decimation in frequency), the output will be in bit-reversed order
 func (domain *Domain) FFT(a []fr.Element, decimation Decimation, opts ...Option) {
+	defer ecc.StartRegion(ecc.BLS12_381, ecc.OpFFT, len(a)).End()
 
 	opt := fftOptions(opts...)
 
@@ -111,12 +112,57 @@ func (domain *Domain) FFT(a []fr.Element, decimation Decimation, opts ...Option)
 	}
 }
 
+// FFTColumns computes the FFT (see FFT) of each column of m, in place. The number of
+// rows of m must be the cardinality of the domain.
+//
+// The columns are transformed in parallel, the tasks left when there are fewer columns
+// than tasks being shared among the FFTs of the columns.
+func (domain *Domain) FFTColumns(m *fr.Matrix, decimation Decimation, opts ...Option) {
+	forEachColumn(m, opts, func(a []fr.Element, opts ...Option) {
+		domain.FFT(a, decimation, opts...)
+	})
+}
+
+// FFTInverseColumns computes the inverse FFT (see FFTInverse) of each column of m, in
+// place. The number of rows of m must be the cardinality of the domain.
+func (domain *Domain) FFTInverseColumns(m *fr.Matrix, decimation Decimation, opts ...Option) {
+	forEachColumn(m, opts, func(a []fr.Element, opts ...Option) {
+		domain.FFTInverse(a, decimation, opts...)
+	})
+}
+
+// forEachColumn runs transform on the columns of m in parallel, splitting the number
+// of tasks set in opts among the columns.
+func forEachColumn(m *fr.Matrix, opts []Option, transform func([]fr.Element, ...Option)) {
+	nbCols := m.NbCols()
+	if nbCols == 0 {
+		return
+	}
+	nbTasks := fftOptions(opts...).nbTasks
+	nbTasksPerColumn := nbTasks / nbCols
+	if nbTasksPerColumn < 1 {
+		nbTasksPerColumn = 1
+	}
+	if nbTasks > nbCols {
+		nbTasks = nbCols
+	}
+	// don't modify the caller's options
+	opts = append(opts[:len(opts):len(opts)], WithNbTasks(nbTasksPerColumn))
+
+	parallel.Execute(nbCols, func(start, end int) {
+		for j := start; j < end; j++ {
+			transform(m.Column(j), opts...)
+		}
+	}, nbTasks)
+}
+
 // FFTInverse computes (recursively) the inverse discrete Fourier transform of a and stores the result in a
 // if decimation == DIT (decimation in time), the input must be in bit-reversed order
 // if decimation == DIF (decimation in frequency), the output will be in bit-reversed order
 // coset sets the shift of the fft (0 = no shift, standard fft)
 // len(a) must be a power of 2, and w must be a len(a)th root of unity in field F.
 func (domain *Domain) FFTInverse(a []fr.Element, decimation Decimation, opts ...Option) {
+	defer ecc.StartRegion(ecc.BLS12_381, ecc.OpFFTInverse, len(a)).End()
 	opt := fftOptions(opts...)
 
 	// find the stage where we should stop spawning go routines in our recursive calls
@@ -0,0 +1,198 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// FFTStrided computes the discrete Fourier transform of the strided view
+//
+//	v[i] = a[offset+i*stride], 0 <= i < domain.Cardinality
+//
+// of a, in place, e.g. for a column of a row-major matrix, without copying it into a temporary slice.
+// It has the same semantic as FFT on v (decimation, coset and number of tasks), with an
+// iterative radix-2 algorithm; on contiguous data (stride == 1), FFT is faster.
+func (domain *Domain) FFTStrided(a []fr.Element, offset, stride int, decimation Decimation, opts ...Option) {
+	opt := fftOptions(opts...)
+	v := newStridedView(a, offset, stride, domain.Cardinality)
+
+	if opt.coset {
+		if decimation == DIT {
+			// the input is in bit reversed order
+			v.scale(domain.cosetExpTable(false), true, nil, opt.nbTasks)
+		} else {
+			v.scale(domain.cosetExpTable(false), false, nil, opt.nbTasks)
+		}
+	}
+
+	twiddles := domain.stridedTwiddles(false)
+	switch decimation {
+	case DIF:
+		v.difFFT(twiddles, opt.nbTasks)
+	case DIT:
+		v.ditFFT(twiddles, opt.nbTasks)
+	default:
+		panic("not implemented")
+	}
+}
+
+// FFTInverseStrided computes the inverse discrete Fourier transform of the strided view
+//
+//	v[i] = a[offset+i*stride], 0 <= i < domain.Cardinality
+//
+// of a, in place. It has the same semantic as FFTInverse on v, see FFTStrided.
+func (domain *Domain) FFTInverseStrided(a []fr.Element, offset, stride int, decimation Decimation, opts ...Option) {
+	opt := fftOptions(opts...)
+	v := newStridedView(a, offset, stride, domain.Cardinality)
+
+	twiddlesInv := domain.stridedTwiddles(true)
+	switch decimation {
+	case DIF:
+		v.difFFT(twiddlesInv, opt.nbTasks)
+	case DIT:
+		v.ditFFT(twiddlesInv, opt.nbTasks)
+	default:
+		panic("not implemented")
+	}
+
+	// scale by CardinalityInv (and the inverse coset table)
+	if !opt.coset {
+		v.scale(nil, false, &domain.CardinalityInv, opt.nbTasks)
+		return
+	}
+	// with DIF, the output is in bit reversed order
+	v.scale(domain.cosetExpTable(true), decimation == DIF, &domain.CardinalityInv, opt.nbTasks)
+}
+
+// stridedView is the view v[i] = a[offset+i*stride], 0 <= i < n, of a
+type stridedView struct {
+	a              []fr.Element
+	offset, stride int
+	n              int
+}
+
+func newStridedView(a []fr.Element, offset, stride int, n uint64) stridedView {
+	if offset < 0 || stride < 1 || offset+(int(n)-1)*stride >= len(a) {
+		panic("fft: strided view out of range")
+	}
+	return stridedView{a: a, offset: offset, stride: stride, n: int(n)}
+}
+
+func (v *stridedView) at(i int) *fr.Element {
+	return &v.a[v.offset+i*v.stride]
+}
+
+// scale multiplies v[i] by table[i] (table[bitReverse(i)] if bitReversed) if table is not nil,
+// and by c if c is not nil.
+func (v *stridedView) scale(table []fr.Element, bitReversed bool, c *fr.Element, nbTasks int) {
+	nn := uint64(64 - bits.TrailingZeros64(uint64(v.n)))
+	parallel.Execute(v.n, func(start, end int) {
+		for i := start; i < end; i++ {
+			e := v.at(i)
+			if table != nil {
+				j := i
+				if bitReversed {
+					j = int(bits.Reverse64(uint64(i)) >> nn)
+				}
+				e.Mul(e, &table[j])
+			}
+			if c != nil {
+				e.Mul(e, c)
+			}
+		}
+	}, nbTasks)
+}
+
+// difFFT is an iterative radix-2 decimation in frequency FFT on v;
+// the output is in bit reversed order.
+func (v *stridedView) difFFT(twiddles [][]fr.Element, nbTasks int) {
+	for stage, m := 0, v.n>>1; m >= 1; stage, m = stage+1, m>>1 {
+		w := twiddles[stage]
+		// n/2 butterflies per stage, on (k, k+m) with k = 2m*block + j
+		parallel.Execute(v.n>>1, func(start, end int) {
+			var t fr.Element
+			for b := start; b < end; b++ {
+				j := b & (m - 1)
+				k := (b-j)<<1 + j
+				x, y := v.at(k), v.at(k+m)
+				t.Sub(x, y)
+				x.Add(x, y)
+				y.Mul(&t, &w[j])
+			}
+		}, nbTasks)
+	}
+}
+
+// ditFFT is an iterative radix-2 decimation in time FFT on v;
+// the input must be in bit reversed order.
+func (v *stridedView) ditFFT(twiddles [][]fr.Element, nbTasks int) {
+	stage := bits.TrailingZeros64(uint64(v.n)) - 1
+	for m := 1; m < v.n; stage, m = stage-1, m<<1 {
+		w := twiddles[stage]
+		parallel.Execute(v.n>>1, func(start, end int) {
+			var t fr.Element
+			for b := start; b < end; b++ {
+				j := b & (m - 1)
+				k := (b-j)<<1 + j
+				x, y := v.at(k), v.at(k+m)
+				t.Mul(y, &w[j])
+				y.Sub(x, &t)
+				x.Add(x, &t)
+			}
+		}, nbTasks)
+	}
+}
+
+// stridedTwiddles returns the twiddles of all the stages; twiddles[s][j] = ω^(j*2^s)
+func (domain *Domain) stridedTwiddles(inverse bool) [][]fr.Element {
+	if domain.withPrecompute {
+		if inverse {
+			return domain.twiddlesInv
+		}
+		return domain.twiddles
+	}
+	w := domain.Generator
+	if inverse {
+		w = domain.GeneratorInv
+	}
+	nbStages := uint64(bits.TrailingZeros64(domain.Cardinality))
+	twiddles := make([][]fr.Element, nbStages)
+	buildTwiddles(twiddles, w, nbStages)
+	return twiddles
+}
+
+// cosetExpTable returns the powers of the coset generator (or of its inverse)
+func (domain *Domain) cosetExpTable(inverse bool) []fr.Element {
+	if domain.withPrecompute {
+		if inverse {
+			return domain.cosetTableInv
+		}
+		return domain.cosetTable
+	}
+	g := domain.FrMultiplicativeGen
+	if inverse {
+		g = domain.FrMultiplicativeGenInv
+	}
+	table := make([]fr.Element, domain.Cardinality)
+	BuildExpTable(g, table)
+	return table
+}
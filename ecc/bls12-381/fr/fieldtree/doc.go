@@ -0,0 +1,29 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package fieldtree provides a Merkle tree whose leaves are vectors of field elements.
+//
+// The nodes are hashed as in accumulator/merkletree: a leaf is hashed as H(leaf), the leaf
+// being the concatenation of the big endian encodings of its elements, and a node as
+// H(left ∥ right). The hash function can be a byte oriented one (SHA-256, Keccak...) or a
+// field native one, such as MiMC, whose digests are field elements.
+//
+// The number of leaves must be a power of two, so that the depth of the tree, hence the length
+// of the Merkle paths, is fixed by the number of leaves. The tree can be built from a function
+// returning the leaves one at a time, so that they don't need to be materialized, and it
+// supports single and batch openings. It is used by the fri package to commit to the folded
+// polynomials.
+package fieldtree
@@ -0,0 +1,257 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fieldtree
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"hash"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/accumulator/merkletree"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/mimc"
+)
+
+func randomLeaves(nbLeaves, leafSize int) [][]fr.Element {
+	res := make([][]fr.Element, nbLeaves)
+	for i := range res {
+		res[i] = make([]fr.Element, leafSize)
+		for j := range res[i] {
+			res[i][j].SetRandom()
+		}
+	}
+	return res
+}
+
+func TestTree(t *testing.T) {
+	t.Parallel()
+
+	newMiMC := func() hash.Hash { return mimc.NewMiMC() }
+	for _, newHash := range []func() hash.Hash{sha256.New, newMiMC} {
+		for _, nbLeaves := range []int{1, 2, 16} {
+			leaves := randomLeaves(nbLeaves, 3)
+			tree, err := New(newHash(), leaves)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// same root as accumulator/merkletree
+			mt := merkletree.New(newHash())
+			for i := range leaves {
+				mt.Push(LeafBytes(leaves[i]))
+			}
+			if !bytes.Equal(mt.Root(), tree.Root()) {
+				t.Fatal("the root doesn't match the one of merkletree")
+			}
+
+			for i := range leaves {
+				proof, err := tree.Open(uint64(i))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := Verify(newHash(), tree.Root(), leaves[i], proof, tree.NbLeaves()); err != nil {
+					t.Fatal(err)
+				}
+
+				// wrong leaf
+				if err := Verify(newHash(), tree.Root(), leaves[(i+1)%nbLeaves], proof, tree.NbLeaves()); nbLeaves > 1 && err != ErrInvalidProof {
+					t.Fatal("verifying a wrong leaf should fail")
+				}
+
+				// wrong number of leaves
+				if err := Verify(newHash(), tree.Root(), leaves[i], proof, 2*tree.NbLeaves()); err != ErrInvalidProof {
+					t.Fatal("verifying with a wrong number of leaves should fail")
+				}
+			}
+		}
+	}
+}
+
+func TestVectorMerkleRoot(t *testing.T) {
+	t.Parallel()
+
+	// fr.Vector.MerkleRoot computes the same root as accumulator/merkletree over the
+	// chunks of the vector, hence as Tree when the number of chunks is a power of two
+	const leafSize = 3
+	for _, nbLeaves := range []int{1, 5, 7, 16} {
+		leaves := randomLeaves(nbLeaves, leafSize)
+		mt := merkletree.New(sha256.New())
+		v := make(fr.Vector, 0, nbLeaves*leafSize)
+		for i := range leaves {
+			mt.Push(LeafBytes(leaves[i]))
+			v = append(v, leaves[i]...)
+		}
+		root, err := v.MerkleRoot(sha256.New(), leafSize)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(root, mt.Root()) {
+			t.Fatalf("%d leaves: roots differ", nbLeaves)
+		}
+	}
+}
+
+func TestBatchOpening(t *testing.T) {
+	t.Parallel()
+
+	const nbLeaves = 32
+	leaves := randomLeaves(nbLeaves, 2)
+	tree, err := New(sha256.New(), leaves)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// unsorted, with duplicates and siblings
+	indices := []uint64{17, 3, 2, 30, 17, 0, 31}
+	proof, err := tree.BatchOpen(indices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opened := make([][]fr.Element, len(indices))
+	for i, index := range indices {
+		opened[i] = leaves[index]
+	}
+	if err := BatchVerify(sha256.New(), tree.Root(), opened, proof, nbLeaves); err != nil {
+		t.Fatal(err)
+	}
+
+	// the batch proof is smaller than the single ones
+	if len(proof.Nodes) >= 5*5 {
+		t.Fatal("the batch proof should share the common nodes")
+	}
+
+	// a single opening
+	proof, err = tree.BatchOpen([]uint64{9})
+	if err != nil {
+		t.Fatal(err)
+	}
+	single, err := tree.Open(9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(proof.Nodes) != len(single.Path) {
+		t.Fatal("a batch opening of one leaf should be a Merkle path")
+	}
+	for i := range proof.Nodes {
+		if !bytes.Equal(proof.Nodes[i], single.Path[i]) {
+			t.Fatal("a batch opening of one leaf should be a Merkle path")
+		}
+	}
+
+	// wrong leaf
+	proof, err = tree.BatchOpen(indices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrong := make([][]fr.Element, len(opened))
+	copy(wrong, opened)
+	wrong[1] = leaves[4]
+	if err := BatchVerify(sha256.New(), tree.Root(), wrong, proof, nbLeaves); err != ErrInvalidProof {
+		t.Fatal("verifying a wrong leaf should fail")
+	}
+
+	// inconsistent duplicates
+	copy(wrong, opened)
+	wrong[4] = leaves[16]
+	if err := BatchVerify(sha256.New(), tree.Root(), wrong, proof, nbLeaves); err != ErrInvalidProof {
+		t.Fatal("opening a leaf to two values should fail")
+	}
+
+	// missing and extra nodes
+	short := proof
+	short.Nodes = proof.Nodes[1:]
+	if err := BatchVerify(sha256.New(), tree.Root(), opened, short, nbLeaves); err != ErrInvalidProof {
+		t.Fatal("verifying a proof with missing nodes should fail")
+	}
+	long := proof
+	long.Nodes = append(append([][]byte{}, proof.Nodes...), proof.Nodes[0])
+	if err := BatchVerify(sha256.New(), tree.Root(), opened, long, nbLeaves); err != ErrInvalidProof {
+		t.Fatal("verifying a proof with extra nodes should fail")
+	}
+}
+
+func TestNewFromIterator(t *testing.T) {
+	t.Parallel()
+
+	const nbLeaves = 8
+	leaves := randomLeaves(nbLeaves, 4)
+	expected, err := New(sha256.New(), leaves)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the leaves are written in a reused buffer
+	buf := make([]fr.Element, 4)
+	next := 0
+	tree, err := NewFromIterator(sha256.New(), nbLeaves, func(i int) []fr.Element {
+		if i != next {
+			t.Fatal("the leaves should be requested in order")
+		}
+		next++
+		copy(buf, leaves[i])
+		return buf
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(tree.Root(), expected.Root()) {
+		t.Fatal("the roots don't match")
+	}
+}
+
+func TestErrors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := New(sha256.New(), randomLeaves(3, 1)); err != ErrNbLeaves {
+		t.Fatal("expected ErrNbLeaves, got", err)
+	}
+	if _, err := New(sha256.New(), nil); err != ErrNbLeaves {
+		t.Fatal("expected ErrNbLeaves, got", err)
+	}
+	tree, err := New(sha256.New(), randomLeaves(4, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tree.Open(4); err != ErrIndexOutOfRange {
+		t.Fatal("expected ErrIndexOutOfRange, got", err)
+	}
+	if _, err := tree.BatchOpen([]uint64{1, 4}); err != ErrIndexOutOfRange {
+		t.Fatal("expected ErrIndexOutOfRange, got", err)
+	}
+}
+
+func BenchmarkTree(b *testing.B) {
+	const nbLeaves = 1 << 14
+	leaves := randomLeaves(nbLeaves, 2)
+	tree, _ := New(sha256.New(), leaves)
+	indices := make([]uint64, 64)
+	for i := range indices {
+		indices[i] = uint64(i * 251 % nbLeaves)
+	}
+
+	b.Run("New", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = New(sha256.New(), leaves)
+		}
+	})
+	b.Run("BatchOpen", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = tree.BatchOpen(indices)
+		}
+	})
+}
@@ -0,0 +1,131 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package iop
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/fft"
+)
+
+func TestBarycentricWeights(t *testing.T) {
+
+	const size = 16
+	domain := fft.NewDomain(size)
+
+	var z fr.Element
+	z.SetRandom()
+	for _, basis := range []Basis{Lagrange, LagrangeCoset} {
+		w, err := BarycentricWeights(z, basis, domain)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the Lagrange polynomials sum to one
+		var sum fr.Element
+		for i := range w {
+			sum.Add(&sum, &w[i])
+		}
+		if !sum.IsOne() {
+			t.Fatal("the barycentric weights don't sum to one")
+		}
+	}
+
+	// on the domain, the weights are the indicator of the point
+	w, err := BarycentricWeights(domain.RootsOfUnity()[3], Lagrange, domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range w {
+		if (i == 3) != w[i].IsOne() || (i != 3) != w[i].IsZero() {
+			t.Fatal("wrong weights on the domain")
+		}
+	}
+
+	if _, err := BarycentricWeights(z, Canonical, domain); err != ErrMustBeLagrangeOrLagrangeCoset {
+		t.Fatal("expected ErrMustBeLagrangeOrLagrangeCoset, got", err)
+	}
+}
+
+func TestBatchEvaluate(t *testing.T) {
+
+	const size = 16
+	domain := fft.NewDomain(size)
+
+	forms := []Form{canonicalRegular, canonicalBitReverse, lagrangeRegular, lagrangeBitReverse, lagrangeCosetRegular, lagrangeCosetBitReverse}
+	var polys []*Polynomial
+	var expected []fr.Element
+	var z fr.Element
+	z.SetRandom()
+	for _, shift := range []int{0, 2} {
+		for _, form := range forms {
+			p := NewPolynomial(randomVector(size), Form{Basis: Canonical, Layout: Regular}).Shift(shift)
+			expected = append(expected, p.Evaluate(z))
+			switch form.Basis {
+			case Lagrange:
+				p.ToLagrange(domain)
+			case LagrangeCoset:
+				p.ToLagrangeCoset(domain)
+			}
+			if form.Layout == Regular {
+				p.ToRegular()
+			} else {
+				p.ToBitReverse()
+			}
+			polys = append(polys, p)
+		}
+	}
+
+	res, err := BatchEvaluate(polys, z, domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range res {
+		if !res[i].Equal(&expected[i]) {
+			t.Fatalf("wrong evaluation of polynomial %d in form %v", i, polys[i].Form)
+		}
+	}
+
+	if _, err := BatchEvaluate(polys, z, fft.NewDomain(2*size)); err != ErrInconsistentSizeDomain {
+		t.Fatal("expected ErrInconsistentSizeDomain, got", err)
+	}
+}
+
+func BenchmarkBatchEvaluate(b *testing.B) {
+
+	const size, nbPolynomials = 1 << 12, 16
+	domain := fft.NewDomain(size)
+	polys := make([]*Polynomial, nbPolynomials)
+	for i := range polys {
+		polys[i] = NewPolynomial(randomVector(size), Form{Basis: Lagrange, Layout: Regular})
+	}
+	var z fr.Element
+	z.SetRandom()
+
+	b.Run("Evaluate", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			for i := range polys {
+				polys[i].Evaluate(z, domain)
+			}
+		}
+	})
+	b.Run("BatchEvaluate", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			_, _ = BatchEvaluate(polys, z, domain)
+		}
+	})
+}
@@ -0,0 +1,144 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package iop
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/fft"
+)
+
+func TestPermutation(t *testing.T) {
+
+	const size, nbPolynomials = 8, 3
+	domain := fft.NewDomain(size)
+
+	// copy constraints: (0,1)=(1,3)=(2,7), (0,2)=(0,5), and (1,0)=(2,2) merged with
+	// the first cycle by (2,2)=(0,1)
+	p := NewPermutation(nbPolynomials, size)
+	cycles := [][]Wire{
+		{Wire{0, 1}, Wire{1, 3}, Wire{2, 7}},
+		{Wire{0, 2}, Wire{0, 5}},
+		{Wire{1, 0}, Wire{2, 2}},
+		{Wire{2, 2}, Wire{0, 1}},
+	}
+	for _, c := range cycles {
+		if err := p.AddCycle(c...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := p.AddCycle(Wire{0, 1}, Wire{3, 0}); err != ErrWireOutOfRange {
+		t.Fatal("expected ErrWireOutOfRange, got", err)
+	}
+
+	// σ is a permutation whose cycles are the copy constraints
+	sigma := p.Sigma()
+	seen := make([]bool, len(sigma))
+	for _, s := range sigma {
+		if seen[s] {
+			t.Fatal("σ is not a permutation")
+		}
+		seen[s] = true
+	}
+	expectedCycle := []int64{0*size + 1, 1*size + 0, 1*size + 3, 2*size + 2, 2*size + 7}
+	for k, id := range expectedCycle {
+		if sigma[id] != expectedCycle[(k+1)%len(expectedCycle)] {
+			t.Fatal("wrong cycle")
+		}
+	}
+	if sigma[2] != 5 || sigma[5] != 2 || sigma[4] != 4 {
+		t.Fatal("wrong σ")
+	}
+
+	// entries satisfying the copy constraints
+	entries := make([]*Polynomial, nbPolynomials)
+	for j := range entries {
+		entries[j] = NewPolynomial(randomVector(size), Form{Basis: Lagrange, Layout: Regular})
+	}
+	constrained := [][]Wire{
+		{Wire{0, 1}, Wire{1, 3}, Wire{2, 7}, Wire{1, 0}, Wire{2, 2}},
+		{Wire{0, 2}, Wire{0, 5}},
+	}
+	for _, cycle := range constrained {
+		for _, w := range cycle[1:] {
+			entries[w.Polynomial].Coefficients()[w.Index] = entries[cycle[0].Polynomial].Coefficients()[cycle[0].Index]
+		}
+	}
+
+	// the ratio closes: Z(ωⁿ⁻¹) times the last factor is one
+	var beta, gamma fr.Element
+	beta.SetRandom()
+	gamma.SetRandom()
+	ratio, err := p.BuildRatio(entries, beta, gamma, Form{Basis: Lagrange, Layout: Regular}, domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sigmaPolynomials, err := p.SigmaPolynomials(Form{Basis: Lagrange, Layout: Regular}, domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := getSupportIdentityPermutation(nbPolynomials, domain)
+	var a, num, den fr.Element
+	num.Set(&ratio.Coefficients()[size-1])
+	den.SetOne()
+	for j := 0; j < nbPolynomials; j++ {
+		v := entries[j].Coefficients()[size-1]
+		a.Mul(&beta, &id[j*size+size-1]).Add(&a, &v).Add(&a, &gamma)
+		num.Mul(&num, &a)
+		a.Mul(&beta, &sigmaPolynomials[j].Coefficients()[size-1]).Add(&a, &v).Add(&a, &gamma)
+		den.Mul(&den, &a)
+	}
+	if !num.Equal(&den) {
+		t.Fatal("accumulating ratio is not equal to one")
+	}
+
+	// S_σ in canonical form matches the Lagrange form
+	canonical, err := p.SigmaPolynomials(Form{Basis: Canonical, Layout: Regular}, domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for j := range canonical {
+		canonical[j].ToLagrange(domain).ToRegular()
+		if !cmpCoefficents(canonical[j].coefficients, sigmaPolynomials[j].coefficients) {
+			t.Fatal("S_σ in canonical form doesn't match the Lagrange form")
+		}
+	}
+
+	// broken copy constraint
+	entries[2].Coefficients()[7].SetRandom()
+	ratio, err = p.BuildRatio(entries, beta, gamma, Form{Basis: Lagrange, Layout: Regular}, domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	num.Set(&ratio.Coefficients()[size-1])
+	den.SetOne()
+	for j := 0; j < nbPolynomials; j++ {
+		v := entries[j].Coefficients()[size-1]
+		a.Mul(&beta, &id[j*size+size-1]).Add(&a, &v).Add(&a, &gamma)
+		num.Mul(&num, &a)
+		a.Mul(&beta, &sigmaPolynomials[j].Coefficients()[size-1]).Add(&a, &v).Add(&a, &gamma)
+		den.Mul(&den, &a)
+	}
+	if num.Equal(&den) {
+		t.Fatal("the ratio shouldn't close when a copy constraint is broken")
+	}
+
+	if _, err := p.BuildRatio(entries[:2], beta, gamma, Form{Basis: Lagrange, Layout: Regular}, domain); err != ErrNumberPolynomials {
+		t.Fatal("expected ErrNumberPolynomials, got", err)
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package iop
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/fft"
+)
+
+var ErrUnivariateSumcheck = errors.New("the univariate sumcheck relation doesn't hold")
+
+// UnivariateSumcheck is the decomposition f = X⋅G + Sum/n + H⋅(Xⁿ-1) proving that
+// ∑_{x ∈ ⟨ω⟩} f(x) = Sum, where ω generates the roots of unity of size n, as in Aurora
+// (https://eprint.iacr.org/2018/828) and Marlin (https://eprint.iacr.org/2019/1047).
+//
+// Since ∑_{x ∈ ⟨ω⟩} xⁱ is n if n divides i and 0 otherwise, the sum of f on ⟨ω⟩ is n times
+// the constant coefficient of f mod (Xⁿ-1). The soundness relies on deg(G) < n-1, which must
+// be enforced by the commitment scheme of G.
+type UnivariateSumcheck struct {
+	Sum fr.Element
+
+	// G in Canonical Regular form, with n-1 coefficients (one if n = 1, then G = 0)
+	G *Polynomial
+
+	// H quotient of f by Xⁿ-1, in Canonical Regular form
+	H *Polynomial
+}
+
+// ProveUnivariateSumcheck computes the sum of f on the domain, and the decomposition proving it.
+// f must be in Canonical basis, in any layout.
+func ProveUnivariateSumcheck(f *Polynomial, domain *fft.Domain) (UnivariateSumcheck, error) {
+	if f.Basis != Canonical {
+		return UnivariateSumcheck{}, ErrMustBeCanonical
+	}
+	c := f.Clone().ToRegular().Coefficients()
+	n := int(domain.Cardinality)
+
+	// f = R + H⋅(Xⁿ-1) with deg(R) < n, so cᵢ = hᵢ₋ₙ - hᵢ for i ≥ n, and rᵢ = cᵢ + hᵢ for i < n
+	nbH := 1
+	if len(c) > n {
+		nbH = len(c) - n
+	}
+	h := make([]fr.Element, nbH)
+	for i := len(c) - n - 1; i >= 0; i-- {
+		h[i].Set(&c[i+n])
+		if i+n < len(c)-n {
+			h[i].Add(&h[i], &h[i+n])
+		}
+	}
+	r := make([]fr.Element, n)
+	copy(r, c)
+	for i := 0; i < n && i < len(c)-n; i++ {
+		r[i].Add(&r[i], &h[i])
+	}
+
+	// R = X⋅G + r₀ and Sum = n⋅r₀
+	var res UnivariateSumcheck
+	var size fr.Element
+	size.SetUint64(domain.Cardinality)
+	res.Sum.Mul(&r[0], &size)
+	nbG := 1
+	if n > 1 {
+		nbG = n - 1
+	}
+	g := make([]fr.Element, nbG)
+	copy(g, r[1:])
+	res.G = NewPolynomial(&g, Form{Basis: Canonical, Layout: Regular})
+	res.H = NewPolynomial(&h, Form{Basis: Canonical, Layout: Regular})
+
+	return res, nil
+}
+
+// VerifyUnivariateSumcheck checks the decomposition of a univariate sumcheck at a (random) point ζ,
+// from the evaluations f(ζ), G(ζ) and H(ζ):
+//
+//	f(ζ) = ζ⋅G(ζ) + Sum/n + H(ζ)⋅(ζⁿ-1)
+//
+// The degree bound on G is not checked, see UnivariateSumcheck.
+func VerifyUnivariateSumcheck(sum, zeta, fZeta, gZeta, hZeta fr.Element, domain *fft.Domain) error {
+	var expected, t fr.Element
+
+	// H(ζ)⋅(ζⁿ-1)
+	one := fr.One()
+	expected.Exp(zeta, big.NewInt(int64(domain.Cardinality))).Sub(&expected, &one).Mul(&expected, &hZeta)
+
+	// ζ⋅G(ζ) + Sum/n
+	t.Mul(&zeta, &gZeta)
+	expected.Add(&expected, &t)
+	t.Mul(&sum, &domain.CardinalityInv)
+	expected.Add(&expected, &t)
+
+	if !expected.Equal(&fZeta) {
+		return ErrUnivariateSumcheck
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package iop
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/fft"
+)
+
+func TestUnivariateSumcheck(t *testing.T) {
+
+	for _, n := range []uint64{1, 2, 8} {
+		domain := fft.NewDomain(n)
+
+		// f of degree smaller, equal and larger than n
+		for _, size := range []int{1, int(n), 3*int(n) + 5} {
+			coeffs := randomSet(size)
+			f := NewPolynomial(&coeffs, Form{Basis: Canonical, Layout: Regular})
+
+			proof, err := ProveUnivariateSumcheck(f, domain)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// sum of f on ⟨ω⟩
+			var expected, x fr.Element
+			x.SetOne()
+			for i := uint64(0); i < n; i++ {
+				e := f.Evaluate(x)
+				expected.Add(&expected, &e)
+				x.Mul(&x, &domain.Generator)
+			}
+			if !proof.Sum.Equal(&expected) {
+				t.Fatal("wrong sum")
+			}
+			if n > 1 && proof.G.Size() != int(n)-1 {
+				t.Fatal("wrong size of G")
+			}
+
+			var zeta fr.Element
+			zeta.SetRandom()
+			fZeta, gZeta, hZeta := f.Evaluate(zeta), proof.G.Evaluate(zeta), proof.H.Evaluate(zeta)
+			if err := VerifyUnivariateSumcheck(proof.Sum, zeta, fZeta, gZeta, hZeta, domain); err != nil {
+				t.Fatal(err)
+			}
+
+			// wrong sum
+			var wrongSum fr.Element
+			one := fr.One()
+			wrongSum.Add(&proof.Sum, &one)
+			if err := VerifyUnivariateSumcheck(wrongSum, zeta, fZeta, gZeta, hZeta, domain); err != ErrUnivariateSumcheck {
+				t.Fatal("verifying a wrong sum should fail")
+			}
+		}
+	}
+
+	// bit reversed layout
+	domain := fft.NewDomain(4)
+	coeffs := randomSet(16)
+	f := NewPolynomial(&coeffs, Form{Basis: Canonical, Layout: Regular})
+	expected, err := ProveUnivariateSumcheck(f, domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof, err := ProveUnivariateSumcheck(f.Clone().ToBitReverse(), domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proof.Sum.Equal(&expected.Sum) {
+		t.Fatal("the sum should not depend on the layout")
+	}
+
+	// wrong basis
+	f.Basis = Lagrange
+	if _, err := ProveUnivariateSumcheck(f, domain); err != ErrMustBeCanonical {
+		t.Fatal("expected ErrMustBeCanonical, got", err)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package iop
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/fft"
+)
+
+func TestValidate(t *testing.T) {
+
+	const size = 8
+	domain := fft.NewDomain(size)
+
+	p := NewPolynomial(randomVector(size), canonicalRegular)
+	if err := p.Validate(domain); err != nil {
+		t.Fatal(err)
+	}
+	p.ToLagrangeCoset(domain)
+	if err := p.Validate(domain); err != nil {
+		t.Fatal(err)
+	}
+
+	// Lagrange basis on a domain of the wrong size
+	if err := p.Validate(fft.NewDomain(2 * size)); err != ErrInconsistentSizeDomain {
+		t.Fatal("expected ErrInconsistentSizeDomain")
+	}
+
+	// Size > BlindedSize
+	q := p.ShallowClone()
+	q.SetSize(size + 1)
+	if err := q.Validate(domain); err != ErrInconsistentSize {
+		t.Fatal("expected ErrInconsistentSize")
+	}
+
+	// unknown form
+	q = NewPolynomial(randomVector(size), Form{Basis: Lagrange | Canonical, Layout: Regular})
+	if err := q.Validate(domain); err != ErrFormMismatch {
+		t.Fatal("expected ErrFormMismatch")
+	}
+}
+
+func TestEvaluateForm(t *testing.T) {
+
+	const size = 16
+	domain := fft.NewDomain(size)
+	p := NewPolynomial(randomVector(size), canonicalRegular)
+
+	var z fr.Element
+	z.SetRandom()
+	expected := p.Evaluate(z)
+
+	for _, form := range []Form{
+		canonicalBitReverse, lagrangeRegular, lagrangeBitReverse, lagrangeCosetRegular, lagrangeCosetBitReverse,
+	} {
+		q := p.Clone()
+		switch form.Basis {
+		case Lagrange:
+			q.ToLagrange(domain)
+		case LagrangeCoset:
+			q.ToLagrangeCoset(domain)
+		}
+		if form.Layout == Regular {
+			q.ToRegular()
+		} else {
+			q.ToBitReverse()
+		}
+		if q.Form != form {
+			t.Fatal("unexpected form")
+		}
+		if v := q.evaluateForm(domain, z); !v.Equal(&expected) {
+			t.Fatalf("evaluation in form %v doesn't match the canonical one", form)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package iop
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/fft"
+)
+
+var (
+	ErrMustBeLagrangeOrLagrangeCoset = errors.New("the basis must be Lagrange or LagrangeCoset")
+	ErrZeroDenominator               = errors.New("the vanishing polynomial of the set is zero on the domain")
+)
+
+// VanishingPolynomial returns Z_S(X) = ∏_{s ∈ S} (X-s), in Canonical Regular form.
+// The coefficients are computed in O(|S|²).
+func VanishingPolynomial(set []fr.Element) *Polynomial {
+	coeffs := vanishingPolynomialCoefficients(set, len(set)+1)
+	return NewPolynomial(&coeffs, Form{Basis: Canonical, Layout: Regular})
+}
+
+// vanishingPolynomialCoefficients returns the coefficients of ∏_{s ∈ S} (X-s),
+// padded with zeroes to size (size >= len(set)+1).
+func vanishingPolynomialCoefficients(set []fr.Element, size int) []fr.Element {
+	res := make([]fr.Element, size)
+	res[0].SetOne()
+	var t fr.Element
+	for i := range set {
+		// res has degree i, res <- res*(X-set[i])
+		res[i+1] = res[i]
+		for j := i; j > 0; j-- {
+			t.Mul(&res[j], &set[i])
+			res[j].Sub(&res[j-1], &t)
+		}
+		res[0].Mul(&res[0], &set[i]).Neg(&res[0])
+	}
+	return res
+}
+
+// EvaluateVanishingPolynomial returns Z_S(x) = ∏_{s ∈ S} (x-s)
+func EvaluateVanishingPolynomial(set []fr.Element, x fr.Element) fr.Element {
+	var res, t fr.Element
+	res.SetOne()
+	for i := range set {
+		t.Sub(&x, &set[i])
+		res.Mul(&res, &t)
+	}
+	return res
+}
+
+// DomainElements returns the elements ωⁱ of the domain for the given indices, e.g. to
+// build the vanishing polynomial of a subset of the rows of a circuit.
+func DomainElements(domain *fft.Domain, indices []int) []fr.Element {
+	res := make([]fr.Element, len(indices))
+	var e big.Int
+	for i, idx := range indices {
+		e.SetUint64(uint64(idx))
+		res[i].Exp(domain.Generator, &e)
+	}
+	return res
+}
+
+// EvaluateVanishingPolynomialOnDomain returns the evaluations of Z_S = ∏_{s ∈ S} (X-s) on the
+// domain (basis == Lagrange) or on its coset (basis == LagrangeCoset), in Regular layout.
+//
+// If |S| < domain.Cardinality, the coefficients of Z_S are computed and evaluated with an FFT,
+// otherwise the products are evaluated point by point.
+func EvaluateVanishingPolynomialOnDomain(set []fr.Element, domain *fft.Domain, basis Basis) (*Polynomial, error) {
+	if basis != Lagrange && basis != LagrangeCoset {
+		return nil, ErrMustBeLagrangeOrLagrangeCoset
+	}
+	n := int(domain.Cardinality)
+
+	var res []fr.Element
+	if len(set) < n {
+		res = vanishingPolynomialCoefficients(set, n)
+		if basis == LagrangeCoset {
+			domain.FFT(res, fft.DIF, fft.OnCoset())
+		} else {
+			domain.FFT(res, fft.DIF)
+		}
+		fft.BitReverse(res)
+	} else {
+		var shift fr.Element
+		shift.SetOne()
+		if basis == LagrangeCoset {
+			shift.Set(&domain.FrMultiplicativeGen)
+		}
+		res = make([]fr.Element, n)
+		parallel.Execute(n, func(start, end int) {
+			var x fr.Element
+			x.Exp(domain.Generator, big.NewInt(int64(start))).Mul(&x, &shift)
+			for i := start; i < end; i++ {
+				res[i] = EvaluateVanishingPolynomial(set, x)
+				x.Mul(&x, &domain.Generator)
+			}
+		})
+	}
+
+	return NewPolynomial(&res, Form{Basis: basis, Layout: Regular}), nil
+}
+
+// EvaluateInverseVanishingPolynomialOnDomain returns the evaluations of 1/Z_S on the
+// domain (basis == Lagrange) or on its coset (basis == LagrangeCoset), in Regular layout.
+// The inverses are computed with a single batch inversion, and it returns ErrZeroDenominator
+// if an element of S lies on the domain (or coset).
+func EvaluateInverseVanishingPolynomialOnDomain(set []fr.Element, domain *fft.Domain, basis Basis) (*Polynomial, error) {
+	p, err := EvaluateVanishingPolynomialOnDomain(set, domain, basis)
+	if err != nil {
+		return nil, err
+	}
+	evaluations := p.Coefficients()
+	for i := range evaluations {
+		if evaluations[i].IsZero() {
+			return nil, ErrZeroDenominator
+		}
+	}
+	inverses := fr.BatchInvert(evaluations)
+	return NewPolynomial(&inverses, p.Form), nil
+}
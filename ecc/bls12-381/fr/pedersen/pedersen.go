@@ -24,6 +24,7 @@ import (
 	curve "github.com/consensys/gnark-crypto/ecc/bls12-381"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
 	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/random"
 	"io"
 	"math/big"
 )
@@ -32,6 +33,10 @@ import (
 type ProvingKey struct {
 	basis         []curve.G1Affine
 	basisExpSigma []curve.G1Affine
+
+	// optional fixed-base tables, see Precompute
+	basisTable         *curve.MultiExpTableG1
+	basisExpSigmaTable *curve.MultiExpTableG1
 }
 
 type VerifyingKey struct {
@@ -41,7 +46,7 @@ type VerifyingKey struct {
 
 func randomFrSizedBytes() ([]byte, error) {
 	res := make([]byte, fr.Bytes)
-	_, err := rand.Read(res)
+	_, err := random.Read(res)
 	return res, err
 }
 
@@ -53,6 +58,9 @@ func randomOnG2() (curve.G2Affine, error) { // TODO: Add to G2.go?
 	}
 }
 
+// Setup generates the proving keys for each basis, and the verifying key.
+// The secret σ and the point G of the verifying key are sampled from the package-level
+// source of randomness (see random.SetReader).
 func Setup(bases ...[]curve.G1Affine) (pk []ProvingKey, vk VerifyingKey, err error) {
 
 	if vk.G, err = randomOnG2(); err != nil {
@@ -62,7 +70,7 @@ func Setup(bases ...[]curve.G1Affine) (pk []ProvingKey, vk VerifyingKey, err err
 	var modMinusOne big.Int
 	modMinusOne.Sub(fr.Modulus(), big.NewInt(1))
 	var sigma *big.Int
-	if sigma, err = rand.Int(rand.Reader, &modMinusOne); err != nil {
+	if sigma, err = rand.Int(random.Reader(), &modMinusOne); err != nil {
 		return
 	}
 	sigma.Add(sigma, big.NewInt(1))
@@ -95,6 +103,10 @@ func (pk *ProvingKey) ProveKnowledge(values []fr.Element) (pok curve.G1Affine, e
 		NbTasks: 1, // TODO Experiment
 	}
 
+	if pk.basisExpSigmaTable != nil {
+		_, err = pok.MultiExpWithTable(pk.basisExpSigmaTable, values, config)
+		return
+	}
 	_, err = pok.MultiExp(pk.basisExpSigma, values, config)
 	return
 }
@@ -111,11 +123,23 @@ func (pk *ProvingKey) Commit(values []fr.Element) (commitment curve.G1Affine, er
 	config := ecc.MultiExpConfig{
 		NbTasks: 1,
 	}
+	if pk.basisTable != nil {
+		_, err = commitment.MultiExpWithTable(pk.basisTable, values, config)
+		return
+	}
 	_, err = commitment.MultiExp(pk.basis, values, config)
 
 	return
 }
 
+// Precompute builds fixed-base tables of the bases of pk, speeding up the subsequent calls to
+// Commit and ProveKnowledge at the cost of memory (see curve.MultiExpTableG1).
+// The tables are not part of the binary encoding of pk.
+func (pk *ProvingKey) Precompute() {
+	pk.basisTable = curve.NewMultiExpTableG1(pk.basis)
+	pk.basisExpSigmaTable = curve.NewMultiExpTableG1(pk.basisExpSigma)
+}
+
 // BatchProve generates a single proof of knowledge for multiple commitments for faster verification
 func BatchProve(pk []ProvingKey, values [][]fr.Element, fiatshamirSeeds ...[]byte) (pok curve.G1Affine, err error) {
 	if len(pk) != len(values) {
@@ -17,12 +17,17 @@
 package bls12381
 
 import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"runtime"
+	"strings"
+
 	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
 	"github.com/consensys/gnark-crypto/internal/parallel"
-	"math/big"
-	"runtime"
 )
 
 // G1Affine point in affine coordinates
@@ -57,7 +62,16 @@ func (p *G1Affine) setInfinity() *G1Affine {
 }
 
 // ScalarMultiplication computes and returns p = a ⋅ s
+//
+// It runs in variable time: it is ScalarMultiplicationVartime, the fastest path, for public
+// scalars. Secret scalars (keys, nonces) should go throughScalarMultiplicationConstantTime instead.
 func (p *G1Affine) ScalarMultiplication(a *G1Affine, s *big.Int) *G1Affine {
+	return p.ScalarMultiplicationVartime(a, s)
+}
+
+// ScalarMultiplicationVartime computes and returns p = a ⋅ s. Its running time depends on s:
+// it is meant for public scalars, e.g. in verifiers.
+func (p *G1Affine) ScalarMultiplicationVartime(a *G1Affine, s *big.Int) *G1Affine {
 	var _p G1Jac
 	_p.FromAffine(a)
 	_p.mulGLV(&_p, s)
@@ -65,6 +79,21 @@ func (p *G1Affine) ScalarMultiplication(a *G1Affine, s *big.Int) *G1Affine {
 	return p
 }
 
+// ScalarMultiplicationBlinded computes and returns p = a ⋅ s, blinding the scalar with
+// a random multiple of the group order (see ecc.BlindScalar). a must be in the r-torsion subgroup.
+//
+// It is slower than ScalarMultiplication, and meant for secret scalars in environments
+// exposed to side-channel attacks.
+func (p *G1Affine) ScalarMultiplicationBlinded(a *G1Affine, s *big.Int) (*G1Affine, error) {
+	var _p G1Jac
+	_p.FromAffine(a)
+	if _, err := _p.ScalarMultiplicationBlinded(&_p, s); err != nil {
+		return nil, err
+	}
+	p.FromJacobian(&_p)
+	return p, nil
+}
+
 // ScalarMultiplicationAffine computes and returns p = a ⋅ s
 // Takes an affine point and returns a Jacobian point (useful for KZG)
 func (p *G1Jac) ScalarMultiplicationAffine(a *G1Affine, s *big.Int) *G1Jac {
@@ -74,16 +103,19 @@ func (p *G1Jac) ScalarMultiplicationAffine(a *G1Affine, s *big.Int) *G1Jac {
 }
 
 // ScalarMultiplicationBase computes and returns p = g ⋅ s where g is the prime subgroup generator
+//
+// It uses a FixedBaseTableG1 of g, built on the first call (see ScalarMultiplicationFixedBase).
+// The running time depends on s; it is not constant-time.
 func (p *G1Jac) ScalarMultiplicationBase(s *big.Int) *G1Jac {
-	return p.mulGLV(&g1Gen, s)
+	return p.ScalarMultiplicationFixedBase(getG1BaseTable(), s)
 }
 
 // ScalarMultiplicationBase computes and returns p = g ⋅ s where g is the prime subgroup generator
+//
+// It uses a FixedBaseTableG1 of g, built on the first call (see ScalarMultiplicationFixedBase).
+// The running time depends on s; it is not constant-time.
 func (p *G1Affine) ScalarMultiplicationBase(s *big.Int) *G1Affine {
-	var _p G1Jac
-	_p.mulGLV(&g1Gen, s)
-	p.FromJacobian(&_p)
-	return p
+	return p.ScalarMultiplicationFixedBase(getG1BaseTable(), s)
 }
 
 // Add adds two point in affine coordinates.
@@ -157,6 +189,70 @@ func (p *G1Affine) String() string {
 	return "E([" + p.X.String() + "," + p.Y.String() + "])"
 }
 
+// Format implements fmt.Formatter:
+//
+//	%v, %s    p.String()
+//	%+v       p.Dump()
+//	%x, %X    hexadecimal of p.RawBytes(), prefixed by 0x with the # flag
+func (p *G1Affine) Format(s fmt.State, verb rune) {
+	if p == nil {
+		fmt.Fprint(s, "<nil>")
+		return
+	}
+	switch verb {
+	case 'v', 's':
+		if verb == 'v' && s.Flag('+') {
+			fmt.Fprint(s, p.Dump())
+			return
+		}
+		fmt.Fprint(s, p.String())
+	case 'x', 'X':
+		b := p.RawBytes()
+		text := hex.EncodeToString(b[:])
+		if verb == 'X' {
+			text = strings.ToUpper(text)
+		}
+		if s.Flag('#') {
+			text = "0x" + text
+		}
+		fmt.Fprint(s, text)
+	default:
+		fmt.Fprintf(s, "%%!%c(G1Affine=%s)", verb, p.String())
+	}
+}
+
+// Dump returns the coordinates of p in base field elements, one per line, each
+// in the form of fp.Element.Dump, or "O" if p is infinity.
+func (p *G1Affine) Dump() string {
+	if p.IsInfinity() {
+		return "O"
+	}
+	return strings.Join([]string{
+		"X = " + p.X.Dump(),
+		"Y = " + p.Y.Dump(),
+	}, "\n")
+}
+
+// SetText sets p from the hexadecimal of one of its encodings (see SetBytes),
+// with or without 0x prefix, as printed by fmt with %x. The point is checked to
+// be in the correct subgroup. If s can't be parsed, p is left unchanged.
+func (p *G1Affine) SetText(s string) (*G1Affine, error) {
+	buf, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(s), "0x"))
+	if err != nil {
+		return nil, errors.New("can't parse G1Affine: " + err.Error())
+	}
+	var res G1Affine
+	n, err := res.SetBytes(buf)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(buf) {
+		return nil, errors.New("can't parse G1Affine: trailing bytes")
+	}
+	*p = res
+	return p, nil
+}
+
 // IsInfinity checks if the point is infinity
 // in affine, it's encoded as (0,0)
 // (0,0) is never on the curve for j=0 curves
@@ -374,11 +470,34 @@ func (p *G1Jac) DoubleAssign() *G1Jac {
 }
 
 // ScalarMultiplication computes and returns p = a ⋅ s
-// see https://www.iacr.org/archive/crypto2001/21390189.pdf
+//
+// It runs in variable time: it is ScalarMultiplicationVartime, the fastest path, for public
+// scalars. Secret scalars (keys, nonces) should go throughScalarMultiplicationConstantTime instead.
 func (p *G1Jac) ScalarMultiplication(a *G1Jac, s *big.Int) *G1Jac {
+	return p.ScalarMultiplicationVartime(a, s)
+}
+
+// ScalarMultiplicationVartime computes and returns p = a ⋅ s
+// (see https://www.iacr.org/archive/crypto2001/21390189.pdf).
+// Its running time depends on s: it is meant for public scalars, e.g. in verifiers.
+func (p *G1Jac) ScalarMultiplicationVartime(a *G1Jac, s *big.Int) *G1Jac {
 	return p.mulGLV(a, s)
 }
 
+// ScalarMultiplicationBlinded computes and returns p = a ⋅ s, blinding the scalar with
+// a random multiple of the group order (see ecc.BlindScalar). a must be in the r-torsion subgroup.
+//
+// The blinded scalar is not reduced nor split with the endomorphism: it uses
+// the 2-bits windowed exponentiation. It is slower than ScalarMultiplication, and meant for
+// secret scalars in environments exposed to side-channel attacks.
+func (p *G1Jac) ScalarMultiplicationBlinded(a *G1Jac, s *big.Int) (*G1Jac, error) {
+	k, err := ecc.BlindScalar(s, fr.Modulus())
+	if err != nil {
+		return nil, err
+	}
+	return p.mulWindowed(a, k), nil
+}
+
 // String returns canonical representation of the point in affine coordinates
 func (p *G1Jac) String() string {
 	_p := G1Affine{}
@@ -473,6 +592,22 @@ func (p *G1Jac) phi(a *G1Jac) *G1Jac {
 	return p
 }
 
+// Phi sets p to ϕ(a) and returns p, where ϕ: (x,y) → (w x,y) is the GLV endomorphism
+// and w is a third root of unity in 𝔽p.
+//
+// On the prime order subgroup, ϕ acts as the multiplication by λ, a cube root of unity modulo
+// the order r, used by the GLV scalar multiplication.
+func (p *G1Jac) Phi(a *G1Jac) *G1Jac {
+	return p.phi(a)
+}
+
+// Phi sets p to ϕ(a) and returns p, see G1Jac.Phi
+func (p *G1Affine) Phi(a *G1Affine) *G1Affine {
+	p.Y.Set(&a.Y)
+	p.X.Mul(&a.X, &thirdRootOneG1)
+	return p
+}
+
 // mulGLV computes the scalar multiplication using a windowed-GLV method
 // see https://www.iacr.org/archive/crypto2001/21390189.pdf
 func (p *G1Jac) mulGLV(a *G1Jac, s *big.Int) *G1Jac {
@@ -944,7 +1079,8 @@ func (p *g1JacExtended) doubleMixed(q *G1Affine) *g1JacExtended {
 func BatchJacobianToAffineG1(points []G1Jac) []G1Affine {
 	result := make([]G1Affine, len(points))
 	zeroes := make([]bool, len(points))
-	accumulator := fp.One()
+	var accumulator fp.Element
+	accumulator.SetOne()
 
 	// batch invert all points[].Z coordinates with Montgomery batch inversion trick
 	// (stores points[].Z^-1 in result[i].X to avoid allocating a slice of fr.Elements)
@@ -1026,6 +1162,7 @@ func BatchScalarMultiplicationG1(base *G1Affine, scalars []fr.Element) []G1Affin
 		baseTable[i] = baseTable[i-1]
 		baseTable[i].AddMixed(base)
 	}
+
 	// convert our base exp table into affine to use AddMixed
 	baseTableAff := BatchJacobianToAffineG1(baseTable)
 	toReturn := make([]G1Jac, len(scalars))
@@ -1065,9 +1202,9 @@ func BatchScalarMultiplicationG1(base *G1Affine, scalars []fr.Element) []G1Affin
 
 			// set our result point
 			toReturn[i] = p
-
 		}
 	})
+
 	toReturnAff := BatchJacobianToAffineG1(toReturn)
 	return toReturnAff
 }
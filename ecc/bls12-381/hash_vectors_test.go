@@ -33,7 +33,7 @@ func TestG1IsogenyVectors(t *testing.T) {
 	toMont(&ref.X)
 	toMont(&ref.Y)
 
-	g1Isogeny(&p)
+	G1Isogeny(&p)
 
 	if ref != p {
 		t.Fail()
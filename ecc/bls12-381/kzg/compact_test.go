@@ -0,0 +1,121 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/stretchr/testify/require"
+)
+
+// sparseBatchOpeningProof returns a proof with nbValues claimed values, of which one in
+// three is zero
+func sparseBatchOpeningProof(nbValues int) BatchOpeningProof {
+	var proof BatchOpeningProof
+	proof.H.Set(&testSrs.Pk.G1[1])
+	proof.ClaimedValues = make([]fr.Element, nbValues)
+	for i := range proof.ClaimedValues {
+		if i%3 != 0 {
+			proof.ClaimedValues[i].SetRandom()
+		}
+	}
+	return proof
+}
+
+func TestSerializationCompact(t *testing.T) {
+	assert := require.New(t)
+
+	for _, nbValues := range []int{0, 1, 31, 32, 33, 100} {
+		proof := sparseBatchOpeningProof(nbValues)
+
+		var buf, dense bytes.Buffer
+		n, err := proof.WriteCompactTo(&buf)
+		assert.NoError(err)
+		assert.Equal(int64(buf.Len()), n)
+		_, err = proof.WriteTo(&dense)
+		assert.NoError(err)
+		if nbValues >= 32 {
+			assert.Less(buf.Len(), dense.Len(), "the compact encoding should be smaller")
+		}
+
+		var _proof BatchOpeningProof
+		m, err := _proof.ReadFrom(bytes.NewReader(buf.Bytes()))
+		assert.NoError(err)
+		assert.Equal(n, m)
+		assert.Equal(proof.H, _proof.H)
+		assert.Equal(len(proof.ClaimedValues), len(_proof.ClaimedValues))
+		for i := range proof.ClaimedValues {
+			assert.True(proof.ClaimedValues[i].Equal(&_proof.ClaimedValues[i]))
+		}
+	}
+
+	// a proof can't refer to a previous proof
+	proof := sparseBatchOpeningProof(2)
+	var buf bytes.Buffer
+	_, err := proof.WriteCompactTo(&buf)
+	assert.NoError(err)
+	b := buf.Bytes()
+	tagsOffset := len(b) - fr.Bytes - 8
+	b[tagsOffset+7] = tagPrevious<<2 | tagZero
+	b = b[:tagsOffset+8]
+	var _proof BatchOpeningProof
+	_, err = _proof.ReadFrom(bytes.NewReader(b))
+	assert.ErrorIs(err, errInvalidProofEncoding)
+}
+
+func TestSerializationBatchOpeningProofs(t *testing.T) {
+	assert := require.New(t)
+
+	var proofs BatchOpeningProofs
+	proofs.Point.SetRandom()
+	proofs.Proofs = make([]BatchOpeningProof, 4)
+	for i := range proofs.Proofs {
+		proofs.Proofs[i] = sparseBatchOpeningProof(10 + i)
+	}
+	// values shared with the previous proof are not repeated
+	proofs.Proofs[1].ClaimedValues[1] = proofs.Proofs[0].ClaimedValues[1]
+	proofs.Proofs[2].ClaimedValues[2] = proofs.Proofs[1].ClaimedValues[2]
+
+	var buf bytes.Buffer
+	n, err := proofs.WriteTo(&buf)
+	assert.NoError(err)
+	assert.Equal(int64(buf.Len()), n)
+
+	var _proofs BatchOpeningProofs
+	m, err := _proofs.ReadFrom(bytes.NewReader(buf.Bytes()))
+	assert.NoError(err)
+	assert.Equal(n, m)
+	assert.True(proofs.Point.Equal(&_proofs.Point))
+	assert.Equal(len(proofs.Proofs), len(_proofs.Proofs))
+	for i := range proofs.Proofs {
+		assert.Equal(proofs.Proofs[i].H, _proofs.Proofs[i].H)
+		assert.Equal(len(proofs.Proofs[i].ClaimedValues), len(_proofs.Proofs[i].ClaimedValues))
+		for j := range proofs.Proofs[i].ClaimedValues {
+			assert.True(proofs.Proofs[i].ClaimedValues[j].Equal(&_proofs.Proofs[i].ClaimedValues[j]))
+		}
+	}
+
+	// the encoding of a single batch opening proof can't be read as BatchOpeningProofs
+	buf.Reset()
+	_, err = proofs.Proofs[0].WriteCompactTo(&buf)
+	assert.NoError(err)
+	_, err = _proofs.ReadFrom(&buf)
+	assert.ErrorIs(err, ecc.ErrHeaderObject)
+}
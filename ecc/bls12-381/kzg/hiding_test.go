@@ -0,0 +1,111 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+func TestHidingOpening(t *testing.T) {
+	t.Parallel()
+
+	f := randomPolynomial(60)
+	digest, err := Commit(f, testSrs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var point, blinding fr.Element
+	point.SetRandom()
+	blinding.SetRandom()
+
+	proof, err := OpenHiding(f, point, blinding, testSrs.Pk, testSrs.Vk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyHiding(&digest, &proof, point, testSrs.Vk); err != nil {
+		t.Fatal(err)
+	}
+
+	// the value commitment opens to f(point)
+	value := eval(f, point)
+	expected := CommitValue(value, blinding, testSrs.Vk)
+	if !expected.Equal(&proof.ValueCommitment) {
+		t.Fatal("the value commitment doesn't open to f(point)")
+	}
+
+	// a commitment to another value
+	{
+		forged := proof
+		var one fr.Element
+		one.SetOne()
+		value.Add(&value, &one)
+		forged.ValueCommitment = CommitValue(value, blinding, testSrs.Vk)
+		if err := VerifyHiding(&digest, &forged, point, testSrs.Vk); err != ErrVerifyOpeningProof {
+			t.Fatal("verifying a commitment to a wrong value should fail")
+		}
+	}
+
+	// another point
+	{
+		var other fr.Element
+		other.SetRandom()
+		if err := VerifyHiding(&digest, &proof, other, testSrs.Vk); err != ErrVerifyOpeningProof {
+			t.Fatal("verifying at another point should fail")
+		}
+	}
+
+	// another blinding of the quotient, without the matching T
+	{
+		forged := proof
+		base := ValueCommitmentBase()
+		forged.H.Add(&forged.H, &base)
+		if err := VerifyHiding(&digest, &forged, point, testSrs.Vk); err != ErrVerifyOpeningProof {
+			t.Fatal("verifying a tampered quotient should fail")
+		}
+	}
+
+	// two proofs of the same opening differ
+	other, err := OpenHiding(f, point, blinding, testSrs.Pk, testSrs.Vk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other.H.Equal(&proof.H) || other.T.Equal(&proof.T) {
+		t.Fatal("the proofs should be randomized")
+	}
+}
+
+func BenchmarkHidingOpening(b *testing.B) {
+	f := randomPolynomial(1 << 7)
+	digest, _ := Commit(f, testSrs.Pk)
+	var point, blinding fr.Element
+	point.SetRandom()
+	blinding.SetRandom()
+
+	b.Run("OpenHiding", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = OpenHiding(f, point, blinding, testSrs.Pk, testSrs.Vk)
+		}
+	})
+	proof, _ := OpenHiding(f, point, blinding, testSrs.Pk, testSrs.Vk)
+	b.Run("VerifyHiding", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = VerifyHiding(&digest, &proof, point, testSrs.Vk)
+		}
+	})
+}
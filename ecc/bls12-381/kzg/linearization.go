@@ -0,0 +1,111 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// Verifiers of PLONK-like proof systems end with the same two steps:
+//   - the linearization: the commitment to a polynomial which is a linear combination of
+//     committed polynomials, with coefficients the verifier computes from the opened values
+//     (see Linearize),
+//   - the openings of this commitment and of the other digests at a few points, folded into
+//     a single pairing check (see PairingInputs and CheckPairingInputs).
+//
+// The helpers below do both with a single multi-scalar multiplication each, and keep the
+// signs of the pairing check in one place.
+
+// Linearize returns the commitment ∑ᵢ scalars[i]·commitments[i] to the linear combination of
+// the committed polynomials, computed with a single multi-scalar multiplication.
+func Linearize(commitments []Digest, scalars []fr.Element) (Digest, error) {
+	if len(commitments) != len(scalars) {
+		return Digest{}, ErrInvalidNbDigests
+	}
+	if len(commitments) == 0 {
+		return Digest{}, ErrZeroNbDigests
+	}
+	var res Digest
+	if _, err := res.MultiExp(commitments, scalars, ecc.MultiExpConfig{}); err != nil {
+		return Digest{}, err
+	}
+	return res, nil
+}
+
+// PairingInputs returns the G₁ inputs [P, -Q] of the pairing check
+//
+//	e(P, G₂)·e(-Q, [α]G₂) = 1
+//
+// of the opening proofs of digests at points, folded with coefficients λᵢ:
+// P = ∑ᵢλᵢ([fᵢ(α)]G₁ - [fᵢ(zᵢ)]G₁ + zᵢ[Hᵢ(α)]G₁) and Q = ∑ᵢλᵢ[Hᵢ(α)]G₁.
+//
+// The coefficients must be unpredictable to the prover (random, or derived with Fiat Shamir
+// from the proofs), except for a single proof. The inputs can be checked with
+// CheckPairingInputs, or added to a larger pairing check, against G₂ and [α]G₂ respectively.
+func PairingInputs(digests []Digest, proofs []OpeningProof, points, coefficients []fr.Element, vk VerifyingKey) ([2]bls12381.G1Affine, error) {
+	var res [2]bls12381.G1Affine
+	n := len(digests)
+	if len(proofs) != n || len(points) != n || len(coefficients) != n {
+		return res, ErrInvalidNbDigests
+	}
+	if n == 0 {
+		return res, ErrZeroNbDigests
+	}
+
+	// P = ∑ᵢλᵢ[fᵢ(α)]G₁ + ∑ᵢλᵢzᵢ[Hᵢ(α)]G₁ - [∑ᵢλᵢfᵢ(zᵢ)]G₁, in a single multi-scalar multiplication
+	bases := make([]bls12381.G1Affine, 0, 2*n+1)
+	scalars := make([]fr.Element, 2*n+1)
+	bases = append(bases, digests...)
+	for i := range proofs {
+		bases = append(bases, proofs[i].H)
+	}
+	bases = append(bases, vk.G1)
+	var tmp fr.Element
+	for i := 0; i < n; i++ {
+		scalars[i] = coefficients[i]
+		scalars[n+i].Mul(&coefficients[i], &points[i])
+		tmp.Mul(&coefficients[i], &proofs[i].ClaimedValue)
+		scalars[2*n].Sub(&scalars[2*n], &tmp)
+	}
+	config := ecc.MultiExpConfig{}
+	if _, err := res[0].MultiExp(bases, scalars, config); err != nil {
+		return res, err
+	}
+
+	// -Q = -∑ᵢλᵢ[Hᵢ(α)]G₁
+	if _, err := res[1].MultiExp(bases[n:2*n], coefficients, config); err != nil {
+		return res, err
+	}
+	res[1].Neg(&res[1])
+
+	return res, nil
+}
+
+// CheckPairingInputs checks e(inputs[0], G₂)·e(inputs[1], [α]G₂) = 1, with the precomputed
+// lines of vk, where inputs are given by PairingInputs.
+func CheckPairingInputs(inputs [2]bls12381.G1Affine, vk VerifyingKey) error {
+	check, err := bls12381.PairingCheckFixedQ(inputs[:], vk.Lines[:])
+	if err != nil {
+		return err
+	}
+	if !check {
+		return ErrVerifyOpeningProof
+	}
+	return nil
+}
@@ -0,0 +1,411 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command testvectors writes the test setup and the test vectors of the kzg4844 package to
+// its testdata directory, in the formats of c-kzg-4844 (trusted_setup.txt and
+// tests/<operation>/kzg-mainnet/<case>/data.yaml).
+//
+// The setup is built from a public τ, so it is insecure and only meant for tests. The
+// expected outputs are computed from τ and the formulas of the consensus specifications
+// (polynomial-commitments.md), without the kzg4844 package: the commitment to p is
+// [p(τ)]G₁ and the proof of p(z) = y is [(p(τ)-y)/(τ-z)]G₁.
+package main
+
+//go:generate go run main.go
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"os"
+	"path/filepath"
+	"strings"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+const (
+	n             = 4096 // FIELD_ELEMENTS_PER_BLOB
+	nbG2          = 65
+	primitiveRoot = 7
+	testdata      = "../../testdata"
+)
+
+var (
+	tau   fr.Element
+	roots [n]fr.Element // ωᵏ in natural order
+)
+
+func assertNoError(err error) {
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(-1)
+	}
+}
+
+func main() {
+	seed := sha256.Sum256([]byte("gnark-crypto kzg4844 test setup"))
+	tau.SetBytes(seed[:])
+
+	var e big.Int
+	e.Sub(fr.Modulus(), big.NewInt(1)).Div(&e, big.NewInt(n))
+	var omega fr.Element
+	omega.SetUint64(primitiveRoot).Exp(omega, &e)
+	roots[0].SetOne()
+	for k := 1; k < n; k++ {
+		roots[k].Mul(&roots[k-1], &omega)
+	}
+
+	writeSetup()
+	writeVectors()
+}
+
+// writeSetup writes [Lₖ(τ)]G₁ in natural order, then [τⁱ]G₂
+func writeSetup() {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d\n%d\n", n, nbG2)
+	// Lₖ(τ) = ωᵏ(τᴺ-1)/(N(τ-ωᵏ))
+	var tn, nInv, l fr.Element
+	tn.Exp(tau, big.NewInt(n)).Sub(&tn, new(fr.Element).SetOne())
+	nInv.SetUint64(n).Inverse(&nInv)
+	for k := 0; k < n; k++ {
+		l.Sub(&tau, &roots[k]).Inverse(&l).Mul(&l, &roots[k]).Mul(&l, &tn).Mul(&l, &nInv)
+		sb.WriteString(hex.EncodeToString(g1(l)) + "\n")
+	}
+	_, _, _, g2 := bls12381.Generators()
+	var t fr.Element
+	t.SetOne()
+	for i := 0; i < nbG2; i++ {
+		var s big.Int
+		var p bls12381.G2Affine
+		p.ScalarMultiplication(&g2, t.BigInt(&s))
+		b := p.Bytes()
+		sb.WriteString(hex.EncodeToString(b[:]) + "\n")
+		t.Mul(&t, &tau)
+	}
+	assertNoError(os.WriteFile(filepath.Join(testdata, "trusted_setup.txt"), []byte(sb.String()), 0o644))
+}
+
+func bitReverse(i int) int {
+	return int(bits.Reverse64(uint64(i)) >> (64 - 12))
+}
+
+// evaluate returns p(x), where blob holds the evaluations of p at the roots of unity in
+// bit-reversed order: p(x) = (xᴺ-1)/N ∑ₖ p(ωᵏ)ωᵏ/(x-ωᵏ)
+func evaluate(blob *[n]fr.Element, x *fr.Element) fr.Element {
+	for k := range roots {
+		if roots[k].Equal(x) {
+			return blob[bitReverse(k)]
+		}
+	}
+	var res, t, nInv fr.Element
+	for k := range roots {
+		t.Sub(x, &roots[k]).Inverse(&t).Mul(&t, &roots[k]).Mul(&t, &blob[bitReverse(k)])
+		res.Add(&res, &t)
+	}
+	t.Exp(*x, big.NewInt(n)).Sub(&t, new(fr.Element).SetOne())
+	nInv.SetUint64(n).Inverse(&nInv)
+	res.Mul(&res, &t).Mul(&res, &nInv)
+	return res
+}
+
+// g1 returns [s]G₁, compressed
+func g1(s fr.Element) []byte {
+	var b big.Int
+	var p bls12381.G1Affine
+	p.ScalarMultiplicationBase(s.BigInt(&b))
+	res := p.Bytes()
+	return res[:]
+}
+
+// commit returns [p(τ)]G₁
+func commit(blob *[n]fr.Element) []byte {
+	return g1(evaluate(blob, &tau))
+}
+
+// open returns [(p(τ)-p(z))/(τ-z)]G₁ and p(z)
+func open(blob *[n]fr.Element, z fr.Element) ([]byte, fr.Element) {
+	y := evaluate(blob, &z)
+	var q, d fr.Element
+	pt := evaluate(blob, &tau)
+	q.Sub(&pt, &y)
+	d.Sub(&tau, &z).Inverse(&d)
+	q.Mul(&q, &d)
+	return g1(q), y
+}
+
+func encode(blob *[n]fr.Element) []byte {
+	res := make([]byte, 0, n*fr.Bytes)
+	for i := range blob {
+		b := blob[i].Bytes()
+		res = append(res, b[:]...)
+	}
+	return res
+}
+
+// challenge is compute_challenge of the specifications
+func challenge(blob *[n]fr.Element, commitment []byte) fr.Element {
+	h := sha256.New()
+	h.Write([]byte("FSBLOBVERIFY_V1_"))
+	var degree [16]byte
+	binary.BigEndian.PutUint64(degree[8:], n)
+	h.Write(degree[:])
+	h.Write(encode(blob))
+	h.Write(commitment)
+	var x big.Int
+	x.SetBytes(h.Sum(nil)).Mod(&x, fr.Modulus())
+	var res fr.Element
+	res.SetBigInt(&x)
+	return res
+}
+
+// pseudoRandom returns the i-th element of a deterministic sequence
+func pseudoRandom(label string, i int) fr.Element {
+	h := sha256.Sum256([]byte(fmt.Sprintf("%s %d", label, i)))
+	var res fr.Element
+	res.SetBytes(h[:])
+	return res
+}
+
+func writeVectors() {
+	var zero, random, sparse [n]fr.Element
+	for i := range random {
+		random[i] = pseudoRandom("random", i)
+	}
+	for i := 0; i < n; i += 64 {
+		sparse[i] = pseudoRandom("sparse", i)
+	}
+
+	// encodings rejected by the decoders
+	nonCanonical := make([]byte, fr.Bytes)
+	fr.Modulus().FillBytes(nonCanonical)
+	nonCanonicalBlob := encode(&sparse)
+	copy(nonCanonicalBlob[fr.Bytes:], nonCanonical)
+	shortBlob := encode(&sparse)[:n*fr.Bytes-1]
+
+	notInSubgroup := make([]byte, bls12381.SizeOfG1AffineCompressed)
+	notInSubgroup[0] = 0x80 // (0, ±2) is on the curve, but not in G₁
+	notOnCurve := pointNotOnCurve()
+	infinity := make([]byte, bls12381.SizeOfG1AffineCompressed)
+	infinity[0] = 0xc0
+
+	cRandom, cSparse := commit(&random), commit(&sparse)
+	zRandom := pseudoRandom("z", 0)
+	z := zRandom.Bytes()
+	var one fr.Element
+	one.SetOne()
+	inDomain := roots[5]
+
+	// blob_to_kzg_commitment
+	for _, v := range []struct {
+		name   string
+		blob   []byte
+		output []byte
+	}{
+		{"valid_blob_zero", encode(&zero), infinity},
+		{"valid_blob_random", encode(&random), cRandom},
+		{"valid_blob_sparse", encode(&sparse), cSparse},
+		{"invalid_blob_non_canonical", nonCanonicalBlob, nil},
+		{"invalid_blob_short", shortBlob, nil},
+	} {
+		writeVector("blob_to_kzg_commitment", v.name, []field{{"blob", v.blob}}, bytesOutput(v.output))
+	}
+
+	// compute_kzg_proof and verify_kzg_proof
+	for _, v := range []struct {
+		name string
+		blob *[n]fr.Element
+		z    fr.Element
+	}{
+		{"random_z_random", &random, zRandom},
+		{"sparse_z_in_domain", &sparse, inDomain},
+		{"sparse_z_zero", &sparse, fr.Element{}},
+		{"sparse_z_one", &sparse, one},
+		{"zero_z_random", &zero, zRandom},
+	} {
+		proof, y := open(v.blob, v.z)
+		zb, yb := v.z.Bytes(), y.Bytes()
+		writeVector("compute_kzg_proof", "valid_"+v.name,
+			[]field{{"blob", encode(v.blob)}, {"z", zb[:]}},
+			listOutput(proof, yb[:]))
+		writeVector("verify_kzg_proof", "valid_"+v.name,
+			[]field{{"commitment", commit(v.blob)}, {"z", zb[:]}, {"y", yb[:]}, {"proof", proof}},
+			"true")
+	}
+	writeVector("compute_kzg_proof", "invalid_z_non_canonical",
+		[]field{{"blob", encode(&sparse)}, {"z", nonCanonical}}, "null")
+	writeVector("compute_kzg_proof", "invalid_blob_non_canonical",
+		[]field{{"blob", nonCanonicalBlob}, {"z", z[:]}}, "null")
+
+	proof, y := open(&sparse, zRandom)
+	yb := y.Bytes()
+	var wrongY fr.Element
+	wrongY.Add(&y, &one)
+	wrongYb := wrongY.Bytes()
+	otherProof, _ := open(&sparse, one)
+	for _, v := range []struct {
+		name                  string
+		commitment, z, y, prf []byte
+		output                string
+	}{
+		{"incorrect_y", cSparse, z[:], wrongYb[:], proof, "false"},
+		{"incorrect_proof", cSparse, z[:], yb[:], otherProof, "false"},
+		{"incorrect_commitment", cRandom, z[:], yb[:], proof, "false"},
+		{"invalid_commitment_not_in_subgroup", notInSubgroup, z[:], yb[:], proof, "null"},
+		{"invalid_commitment_not_on_curve", notOnCurve, z[:], yb[:], proof, "null"},
+		{"invalid_proof_not_in_subgroup", cSparse, z[:], yb[:], notInSubgroup, "null"},
+		{"invalid_proof_short", cSparse, z[:], yb[:], proof[1:], "null"},
+		{"invalid_z_non_canonical", cSparse, nonCanonical, yb[:], proof, "null"},
+		{"invalid_y_non_canonical", cSparse, z[:], nonCanonical, proof, "null"},
+	} {
+		writeVector("verify_kzg_proof", v.name,
+			[]field{{"commitment", v.commitment}, {"z", v.z}, {"y", v.y}, {"proof", v.prf}},
+			v.output)
+	}
+
+	// compute_blob_kzg_proof and verify_blob_kzg_proof
+	blobProof := func(blob *[n]fr.Element, commitment []byte) []byte {
+		proof, _ := open(blob, challenge(blob, commitment))
+		return proof
+	}
+	pRandom, pSparse := blobProof(&random, cRandom), blobProof(&sparse, cSparse)
+	pZero := blobProof(&zero, infinity)
+	for _, v := range []struct {
+		name       string
+		blob       []byte
+		commitment []byte
+		output     []byte
+	}{
+		{"valid_blob_random", encode(&random), cRandom, pRandom},
+		{"valid_blob_sparse", encode(&sparse), cSparse, pSparse},
+		{"valid_blob_zero", encode(&zero), infinity, pZero},
+		{"invalid_commitment_not_on_curve", encode(&sparse), notOnCurve, nil},
+		{"invalid_commitment_not_in_subgroup", encode(&sparse), notInSubgroup, nil},
+		{"invalid_blob_non_canonical", nonCanonicalBlob, cSparse, nil},
+	} {
+		writeVector("compute_blob_kzg_proof", v.name,
+			[]field{{"blob", v.blob}, {"commitment", v.commitment}}, bytesOutput(v.output))
+	}
+	for _, v := range []struct {
+		name                  string
+		blob, commitment, prf []byte
+		output                string
+	}{
+		{"valid_blob_random", encode(&random), cRandom, pRandom, "true"},
+		{"valid_blob_zero", encode(&zero), infinity, pZero, "true"},
+		{"incorrect_proof", encode(&sparse), cSparse, pZero, "false"},
+		{"incorrect_commitment", encode(&sparse), cRandom, pSparse, "false"},
+		{"invalid_commitment_not_on_curve", encode(&sparse), notOnCurve, pSparse, "null"},
+		{"invalid_proof_not_in_subgroup", encode(&sparse), cSparse, notInSubgroup, "null"},
+		{"invalid_blob_non_canonical", nonCanonicalBlob, cSparse, pSparse, "null"},
+	} {
+		writeVector("verify_blob_kzg_proof", v.name,
+			[]field{{"blob", v.blob}, {"commitment", v.commitment}, {"proof", v.prf}}, v.output)
+	}
+
+	// verify_blob_kzg_proof_batch
+	for _, v := range []struct {
+		name                     string
+		blobs, commitments, prfs [][]byte
+		output                   string
+	}{
+		{"valid_empty", nil, nil, nil, "true"},
+		{"valid_one", [][]byte{encode(&sparse)}, [][]byte{cSparse}, [][]byte{pSparse}, "true"},
+		{"valid_two", [][]byte{encode(&random), encode(&sparse)}, [][]byte{cRandom, cSparse}, [][]byte{pRandom, pSparse}, "true"},
+		{"incorrect_swapped_proofs", [][]byte{encode(&zero), encode(&sparse)}, [][]byte{infinity, cSparse}, [][]byte{pSparse, pZero}, "false"},
+		{"invalid_length_mismatch", [][]byte{encode(&zero), encode(&sparse)}, [][]byte{infinity}, [][]byte{pZero, pSparse}, "null"},
+		{"invalid_proof_not_on_curve", [][]byte{encode(&zero), encode(&sparse)}, [][]byte{infinity, cSparse}, [][]byte{pZero, notOnCurve}, "null"},
+		{"invalid_blob_non_canonical", [][]byte{encode(&zero), nonCanonicalBlob}, [][]byte{infinity, cSparse}, [][]byte{pZero, pSparse}, "null"},
+	} {
+		writeVector("verify_blob_kzg_proof_batch", v.name,
+			[]field{{"blobs", v.blobs}, {"commitments", v.commitments}, {"proofs", v.prfs}}, v.output)
+	}
+}
+
+// pointNotOnCurve returns the compressed encoding of an abscissa x, for which x³+4 isn't a
+// square in 𝔽p
+func pointNotOnCurve() []byte {
+	var x, y2, one, four fp.Element
+	one.SetOne()
+	four.SetUint64(4)
+	for {
+		x.Add(&x, &one)
+		y2.Square(&x).Mul(&y2, &x).Add(&y2, &four)
+		if y2.Legendre() == -1 {
+			break
+		}
+	}
+	b := x.Bytes()
+	b[0] |= 0x80
+	return b[:]
+}
+
+// field is an input of a test vector: a byte string, or a list of byte strings
+type field struct {
+	key   string
+	value interface{}
+}
+
+func bytesOutput(b []byte) string {
+	if b == nil {
+		return "null"
+	}
+	return quote(b)
+}
+
+func listOutput(values ...[]byte) string {
+	var sb strings.Builder
+	for _, v := range values {
+		sb.WriteString("\n- " + quote(v))
+	}
+	return sb.String()
+}
+
+func quote(b []byte) string {
+	return "'0x" + hex.EncodeToString(b) + "'"
+}
+
+// writeVector writes the data.yaml of a test case, in the block style of c-kzg-4844
+func writeVector(operation, name string, input []field, output string) {
+	var sb strings.Builder
+	sb.WriteString("input:\n")
+	for _, f := range input {
+		switch v := f.value.(type) {
+		case []byte:
+			sb.WriteString("  " + f.key + ": " + quote(v) + "\n")
+		case [][]byte:
+			if len(v) == 0 {
+				sb.WriteString("  " + f.key + ": []\n")
+				continue
+			}
+			sb.WriteString("  " + f.key + ":\n")
+			for _, b := range v {
+				sb.WriteString("  - " + quote(b) + "\n")
+			}
+		}
+	}
+	if strings.HasPrefix(output, "\n") {
+		sb.WriteString("output:" + output + "\n")
+	} else {
+		sb.WriteString("output: " + output + "\n")
+	}
+	dir := filepath.Join(testdata, "tests", operation, "kzg-mainnet", name)
+	assertNoError(os.MkdirAll(dir, 0o755))
+	assertNoError(os.WriteFile(filepath.Join(dir, "data.yaml"), []byte(sb.String()), 0o644))
+}
@@ -0,0 +1,476 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kzg4844 implements the KZG polynomial commitments of EIP-4844 (blob transactions)
+// on BLS12-381, following the consensus specifications (polynomial-commitments.md) and
+// the c-kzg-4844 library.
+//
+// A blob holds FieldElementsPerBlob big-endian canonical elements of 𝔽r, which are the
+// evaluations of a polynomial at the roots of unity of order FieldElementsPerBlob, in
+// bit-reversed order. Commitments and proofs are compressed points of G₁, in the encoding
+// of the bls12381 package (which is the ZCash encoding used by Ethereum).
+//
+// All the operations need a Context, built from the trusted setup of the KZG ceremony with
+// LoadTrustedSetup.
+package kzg4844
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+const (
+	// FieldElementsPerBlob is the number of field elements in a blob
+	FieldElementsPerBlob = 4096
+
+	// BytesPerFieldElement is the size of the encoding of a field element
+	BytesPerFieldElement = fr.Bytes
+
+	// BytesPerBlob is the size of a blob
+	BytesPerBlob = FieldElementsPerBlob * BytesPerFieldElement
+
+	// BytesPerCommitment is the size of the encoding of a commitment
+	BytesPerCommitment = bls12381.SizeOfG1AffineCompressed
+
+	// BytesPerProof is the size of the encoding of a proof
+	BytesPerProof = bls12381.SizeOfG1AffineCompressed
+)
+
+// domain separators of the Fiat Shamir challenges
+const (
+	challengeDomain      = "FSBLOBVERIFY_V1_"
+	batchChallengeDomain = "RCKZGBATCH___V1_"
+)
+
+// primitiveRoot generates 𝔽r*, the roots of unity are derived from it
+const primitiveRoot = 7
+
+var (
+	ErrNonCanonicalFieldElement = errors.New("field element is not canonical")
+	ErrInvalidPoint             = errors.New("invalid encoding of a point of G1")
+	ErrInvalidBatchSize         = errors.New("blobs, commitments and proofs don't have the same length")
+	ErrVerifyProof              = errors.New("can't verify opening proof")
+)
+
+// Blob is a blob of EIP-4844: FieldElementsPerBlob field elements, encoded in big-endian
+type Blob [BytesPerBlob]byte
+
+// Commitment is a compressed KZG commitment to the polynomial of a blob
+type Commitment [BytesPerCommitment]byte
+
+// Proof is a compressed KZG opening proof
+type Proof [BytesPerProof]byte
+
+// Scalar is a field element, encoded in big-endian (Bytes32 in the specifications)
+type Scalar [BytesPerFieldElement]byte
+
+// Context holds the trusted setup and the precomputed data used to commit to, open and
+// verify blobs.
+type Context struct {
+	// lagrangeG1 is [Lᵢ(τ)]G₁, in bit-reversed order, where Lᵢ are the Lagrange polynomials
+	// of the roots of unity
+	lagrangeG1 []bls12381.G1Affine
+
+	// g2 is [G₂, [τ]G₂]
+	g2 [2]bls12381.G2Affine
+
+	// lines are the precomputed pairing lines of g2
+	lines [2][2][len(bls12381.LoopCounter) - 1]bls12381.LineEvaluationAff
+
+	// roots are the roots of unity of order FieldElementsPerBlob, in bit-reversed order
+	roots []fr.Element
+
+	g1 bls12381.G1Affine
+}
+
+// NewContext returns a Context from a trusted setup: lagrangeG1 are the FieldElementsPerBlob
+// points [Lᵢ(τ)]G₁ in natural order (as in the setup files), and g2 = [G₂, [τ]G₂].
+//
+// The points are expected to be valid points of the prime order subgroups; the setup of
+// the KZG ceremony is usually loaded with LoadTrustedSetup.
+func NewContext(lagrangeG1 []bls12381.G1Affine, g2 [2]bls12381.G2Affine) (*Context, error) {
+	if len(lagrangeG1) != FieldElementsPerBlob {
+		return nil, ErrInvalidSetup
+	}
+
+	ctx := &Context{
+		lagrangeG1: make([]bls12381.G1Affine, FieldElementsPerBlob),
+		g2:         g2,
+		roots:      make([]fr.Element, FieldElementsPerBlob),
+	}
+	_, _, ctx.g1, _ = bls12381.Generators()
+	ctx.lines[0] = bls12381.PrecomputeLines(g2[0])
+	ctx.lines[1] = bls12381.PrecomputeLines(g2[1])
+
+	// ω = 7^((r-1)/N)
+	var e big.Int
+	e.Sub(fr.Modulus(), big.NewInt(1)).Div(&e, big.NewInt(FieldElementsPerBlob))
+	var omega fr.Element
+	omega.SetUint64(primitiveRoot).Exp(omega, &e)
+
+	const logN = 12
+	var w fr.Element
+	w.SetOne()
+	for i := 0; i < FieldElementsPerBlob; i++ {
+		j := bitReverse(i, logN)
+		ctx.roots[j] = w
+		ctx.lagrangeG1[j] = lagrangeG1[i]
+		w.Mul(&w, &omega)
+	}
+
+	return ctx, nil
+}
+
+// bitReverse returns the reversal of the logN low bits of i
+func bitReverse(i, logN int) int {
+	return int(bits.Reverse64(uint64(i)) >> (64 - logN))
+}
+
+// BlobToCommitment returns the commitment to the polynomial of blob.
+func (ctx *Context) BlobToCommitment(blob *Blob) (Commitment, error) {
+	p, err := blobToPolynomial(blob)
+	if err != nil {
+		return Commitment{}, err
+	}
+	c, err := ctx.commit(p)
+	if err != nil {
+		return Commitment{}, err
+	}
+	return c.Bytes(), nil
+}
+
+// ComputeProof returns a proof of the opening of the polynomial of blob at z, and the
+// value y of the polynomial at z.
+func (ctx *Context) ComputeProof(blob *Blob, z Scalar) (Proof, Scalar, error) {
+	p, err := blobToPolynomial(blob)
+	if err != nil {
+		return Proof{}, Scalar{}, err
+	}
+	zz, err := scalarToElement(z)
+	if err != nil {
+		return Proof{}, Scalar{}, err
+	}
+	proof, y, err := ctx.computeProof(p, zz)
+	if err != nil {
+		return Proof{}, Scalar{}, err
+	}
+	return proof.Bytes(), y.Bytes(), nil
+}
+
+// ComputeBlobProof returns a proof of the opening of the polynomial of blob at the
+// Fiat Shamir challenge derived from blob and commitment, to be checked with VerifyBlobProof.
+//
+// commitment is not checked to be the commitment of blob.
+func (ctx *Context) ComputeBlobProof(blob *Blob, commitment Commitment) (Proof, error) {
+	p, err := blobToPolynomial(blob)
+	if err != nil {
+		return Proof{}, err
+	}
+	if _, err := bytesToPoint(commitment[:]); err != nil {
+		return Proof{}, err
+	}
+	z := computeChallenge(blob, commitment)
+	proof, _, err := ctx.computeProof(p, z)
+	if err != nil {
+		return Proof{}, err
+	}
+	return proof.Bytes(), nil
+}
+
+// VerifyProof verifies that proof shows that the polynomial committed in commitment
+// evaluates to y at z.
+func (ctx *Context) VerifyProof(commitment Commitment, z, y Scalar, proof Proof) error {
+	c, err := bytesToPoint(commitment[:])
+	if err != nil {
+		return err
+	}
+	pi, err := bytesToPoint(proof[:])
+	if err != nil {
+		return err
+	}
+	zz, err := scalarToElement(z)
+	if err != nil {
+		return err
+	}
+	yy, err := scalarToElement(y)
+	if err != nil {
+		return err
+	}
+	return ctx.verifyBatch([]bls12381.G1Affine{c}, []fr.Element{zz}, []fr.Element{yy}, []bls12381.G1Affine{pi}, nil)
+}
+
+// VerifyBlobProof verifies a proof computed by ComputeBlobProof, that is it checks that
+// commitment is a commitment to the polynomial of blob.
+func (ctx *Context) VerifyBlobProof(blob *Blob, commitment Commitment, proof Proof) error {
+	c, pi, z, y, err := ctx.blobOpening(blob, commitment, proof)
+	if err != nil {
+		return err
+	}
+	return ctx.verifyBatch([]bls12381.G1Affine{c}, []fr.Element{z}, []fr.Element{y}, []bls12381.G1Affine{pi}, nil)
+}
+
+// VerifyBlobProofBatch verifies the proofs computed by ComputeBlobProof of several blobs
+// with a single pairing check, using a random linear combination of the openings
+// derived with Fiat Shamir.
+func (ctx *Context) VerifyBlobProofBatch(blobs []Blob, commitments []Commitment, proofs []Proof) error {
+	if len(blobs) != len(commitments) || len(blobs) != len(proofs) {
+		return ErrInvalidBatchSize
+	}
+	if len(blobs) == 0 {
+		return nil
+	}
+
+	n := len(blobs)
+	cs := make([]bls12381.G1Affine, n)
+	pis := make([]bls12381.G1Affine, n)
+	zs := make([]fr.Element, n)
+	ys := make([]fr.Element, n)
+	encoded := make([][]byte, 0, 4*n)
+	for i := range blobs {
+		var err error
+		cs[i], pis[i], zs[i], ys[i], err = ctx.blobOpening(&blobs[i], commitments[i], proofs[i])
+		if err != nil {
+			return err
+		}
+		z, y := zs[i].Bytes(), ys[i].Bytes()
+		encoded = append(encoded, commitments[i][:], z[:], y[:], proofs[i][:])
+	}
+	return ctx.verifyBatch(cs, zs, ys, pis, encoded)
+}
+
+// blobOpening decodes commitment and proof, and returns the opening (z, y) of the
+// polynomial of blob they must satisfy
+func (ctx *Context) blobOpening(blob *Blob, commitment Commitment, proof Proof) (c, pi bls12381.G1Affine, z, y fr.Element, err error) {
+	p, err := blobToPolynomial(blob)
+	if err != nil {
+		return
+	}
+	if c, err = bytesToPoint(commitment[:]); err != nil {
+		return
+	}
+	if pi, err = bytesToPoint(proof[:]); err != nil {
+		return
+	}
+	z = computeChallenge(blob, commitment)
+	y = ctx.evaluate(p, z)
+	return
+}
+
+// verifyBatch checks the openings (commitments[i], zs[i], ys[i], proofs[i]), that is with
+// the powers rⁱ of a challenge r,
+//
+//	e(∑ᵢrⁱ(Cᵢ - [yᵢ]G₁ + zᵢπᵢ), G₂)·e(-∑ᵢrⁱπᵢ, [τ]G₂) == 1
+//
+// r is derived from encoded, the concatenation of the encodings of the openings
+// (commitment ∥ z ∥ y ∥ proof); a single opening is checked with r = 1.
+func (ctx *Context) verifyBatch(commitments []bls12381.G1Affine, zs, ys []fr.Element, proofs []bls12381.G1Affine, encoded [][]byte) error {
+	n := len(commitments)
+
+	rs := make([]fr.Element, n)
+	rs[0].SetOne()
+	if n > 1 {
+		h := sha256.New()
+		h.Write([]byte(batchChallengeDomain))
+		var buf [8]byte
+		binary.BigEndian.PutUint64(buf[:], FieldElementsPerBlob)
+		h.Write(buf[:])
+		binary.BigEndian.PutUint64(buf[:], uint64(n))
+		h.Write(buf[:])
+		for _, b := range encoded {
+			h.Write(b)
+		}
+		var r fr.Element
+		r.SetBytes(h.Sum(nil)) // reduced modulo r
+		for i := 1; i < n; i++ {
+			rs[i].Mul(&rs[i-1], &r)
+		}
+	}
+	// ∑ᵢrⁱCᵢ - [∑ᵢrⁱyᵢ]G₁ + ∑ᵢrⁱzᵢπᵢ
+	bases := make([]bls12381.G1Affine, 0, 2*n+1)
+	scalars := make([]fr.Element, 0, 2*n+1)
+	var sumY, t fr.Element
+	for i := 0; i < n; i++ {
+		bases = append(bases, commitments[i], proofs[i])
+		t.Mul(&rs[i], &zs[i])
+		scalars = append(scalars, rs[i], t)
+		t.Mul(&rs[i], &ys[i])
+		sumY.Add(&sumY, &t)
+	}
+	sumY.Neg(&sumY)
+	bases = append(bases, ctx.g1)
+	scalars = append(scalars, sumY)
+	var lhs, sumProofs bls12381.G1Affine
+	if _, err := lhs.MultiExp(bases, scalars, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+
+	// -∑ᵢrⁱπᵢ
+	if n == 1 {
+		sumProofs.Set(&proofs[0])
+	} else if _, err := sumProofs.MultiExp(proofs, rs, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+	sumProofs.Neg(&sumProofs)
+
+	// the Miller loop overwrites the lines, it gets a copy
+	lines := ctx.lines
+	check, err := bls12381.PairingCheckFixedQ(
+		[]bls12381.G1Affine{lhs, sumProofs},
+		lines[:],
+	)
+	if err != nil {
+		return err
+	}
+	if !check {
+		return ErrVerifyProof
+	}
+	return nil
+}
+
+// commit returns ∑ᵢpᵢ[Lᵢ(τ)]G₁
+func (ctx *Context) commit(p []fr.Element) (bls12381.G1Affine, error) {
+	var res bls12381.G1Affine
+	_, err := res.MultiExp(ctx.lagrangeG1, p, ecc.MultiExpConfig{})
+	return res, err
+}
+
+// computeProof returns the commitment to the quotient (p-p(z))/(X-z), and p(z). p is
+// in Lagrange form on the roots of unity, in bit-reversed order.
+func (ctx *Context) computeProof(p []fr.Element, z fr.Element) (bls12381.G1Affine, fr.Element, error) {
+	y := ctx.evaluate(p, z)
+
+	// qᵢ = (pᵢ-y)/(ωᵢ-z)
+	q := make([]fr.Element, FieldElementsPerBlob)
+	for i := range q {
+		q[i].Sub(&ctx.roots[i], &z)
+	}
+	q = fr.BatchInvert(q) // the inverse of 0 is 0
+	m := -1
+	for i := range q {
+		if ctx.roots[i].Equal(&z) {
+			m = i
+			continue
+		}
+		var t fr.Element
+		t.Sub(&p[i], &y)
+		q[i].Mul(&q[i], &t)
+	}
+
+	// z = ωₘ is in the domain:
+	// qₘ = ∑_{i≠m} (pᵢ-y)ωᵢ/(z(z-ωᵢ))
+	if m != -1 {
+		d := make([]fr.Element, FieldElementsPerBlob)
+		for i := range d {
+			if i == m {
+				continue
+			}
+			d[i].Sub(&z, &ctx.roots[i]).Mul(&d[i], &z)
+		}
+		d = fr.BatchInvert(d)
+		q[m].SetZero()
+		for i := range d {
+			if i == m {
+				continue
+			}
+			var t fr.Element
+			t.Sub(&p[i], &y).Mul(&t, &ctx.roots[i]).Mul(&t, &d[i])
+			q[m].Add(&q[m], &t)
+		}
+	}
+
+	proof, err := ctx.commit(q)
+	return proof, y, err
+}
+
+// evaluate returns p(z), where p is in Lagrange form on the roots of unity (in bit-reversed
+// order), with the barycentric formula
+//
+//	p(z) = (zᴺ-1)/N ∑ᵢpᵢωᵢ/(z-ωᵢ)
+func (ctx *Context) evaluate(p []fr.Element, z fr.Element) fr.Element {
+	d := make([]fr.Element, FieldElementsPerBlob)
+	for i := range d {
+		d[i].Sub(&z, &ctx.roots[i])
+		if d[i].IsZero() {
+			return p[i]
+		}
+	}
+	d = fr.BatchInvert(d)
+
+	var res, t fr.Element
+	for i := range d {
+		t.Mul(&p[i], &ctx.roots[i]).Mul(&t, &d[i])
+		res.Add(&res, &t)
+	}
+
+	var zn, nInv fr.Element
+	zn.Exp(z, big.NewInt(FieldElementsPerBlob))
+	var one fr.Element
+	one.SetOne()
+	zn.Sub(&zn, &one)
+	nInv.SetUint64(FieldElementsPerBlob).Inverse(&nInv)
+	res.Mul(&res, &zn).Mul(&res, &nInv)
+	return res
+}
+
+// computeChallenge returns the Fiat Shamir challenge of the opening of blob
+func computeChallenge(blob *Blob, commitment Commitment) fr.Element {
+	h := sha256.New()
+	h.Write([]byte(challengeDomain))
+	var degree [16]byte
+	binary.BigEndian.PutUint64(degree[8:], FieldElementsPerBlob)
+	h.Write(degree[:])
+	h.Write(blob[:])
+	h.Write(commitment[:])
+	var res fr.Element
+	res.SetBytes(h.Sum(nil)) // reduced modulo r
+	return res
+}
+
+// blobToPolynomial decodes the field elements of blob, which must be canonical
+func blobToPolynomial(blob *Blob) ([]fr.Element, error) {
+	p := make([]fr.Element, FieldElementsPerBlob)
+	for i := range p {
+		var err error
+		p[i], err = fr.BigEndian.Element((*[fr.Bytes]byte)(blob[i*fr.Bytes : (i+1)*fr.Bytes]))
+		if err != nil {
+			return nil, ErrNonCanonicalFieldElement
+		}
+	}
+	return p, nil
+}
+
+// scalarToElement decodes a canonical field element
+func scalarToElement(s Scalar) (fr.Element, error) {
+	e, err := fr.BigEndian.Element((*[fr.Bytes]byte)(&s))
+	if err != nil {
+		return fr.Element{}, ErrNonCanonicalFieldElement
+	}
+	return e, nil
+}
+
+// bytesToPoint decodes a compressed point of G₁, in the prime order subgroup
+func bytesToPoint(b []byte) (bls12381.G1Affine, error) {
+	var p bls12381.G1Affine
+	if _, err := p.SetBytes(b); err != nil {
+		return p, ErrInvalidPoint
+	}
+	return p, nil
+}
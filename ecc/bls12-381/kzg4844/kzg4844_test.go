@@ -0,0 +1,293 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kzg4844
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"testing"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// insecure setup re-used across tests, τ is known. The reference vectors of c-kzg-4844,
+// which need the setup of the KZG ceremony, are run in vectors_test.go.
+var (
+	testTau        fr.Element
+	testLagrangeG1 []bls12381.G1Affine
+	testG2         [2]bls12381.G2Affine
+	testCtx        *Context
+)
+
+func init() {
+	testTau.SetUint64(42)
+
+	// [Lᵢ(τ)]G₁ in natural order, Lᵢ(τ) = ωⁱ(τᴺ-1)/(N(τ-ωⁱ))
+	var e big.Int
+	e.Sub(fr.Modulus(), big.NewInt(1)).Div(&e, big.NewInt(FieldElementsPerBlob))
+	var omega, w, tn, one fr.Element
+	omega.SetUint64(primitiveRoot).Exp(omega, &e)
+	one.SetOne()
+	tn.Exp(testTau, big.NewInt(FieldElementsPerBlob)).Sub(&tn, &one)
+	den := make([]fr.Element, FieldElementsPerBlob)
+	num := make([]fr.Element, FieldElementsPerBlob)
+	w.SetOne()
+	var n fr.Element
+	n.SetUint64(FieldElementsPerBlob)
+	for i := range den {
+		den[i].Sub(&testTau, &w).Mul(&den[i], &n)
+		num[i].Mul(&w, &tn)
+		w.Mul(&w, &omega)
+	}
+	den = fr.BatchInvert(den)
+	for i := range num {
+		num[i].Mul(&num[i], &den[i])
+	}
+	_, _, g1, g2 := bls12381.Generators()
+	testLagrangeG1 = bls12381.BatchScalarMultiplicationG1(&g1, num)
+	testG2[0] = g2
+	var t big.Int
+	testTau.BigInt(&t)
+	testG2[1].ScalarMultiplication(&g2, &t)
+
+	var err error
+	testCtx, err = NewContext(testLagrangeG1, testG2)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func randomBlob(t *testing.T) (*Blob, []fr.Element) {
+	var blob Blob
+	p := make([]fr.Element, FieldElementsPerBlob)
+	for i := range p {
+		if _, err := p[i].SetRandom(); err != nil {
+			t.Fatal(err)
+		}
+		b := p[i].Bytes()
+		copy(blob[i*fr.Bytes:], b[:])
+	}
+	return &blob, p
+}
+
+func TestRootsOfUnity(t *testing.T) {
+	var one, minusOne, w fr.Element
+	one.SetOne()
+	minusOne.Neg(&one)
+
+	if !testCtx.roots[0].IsOne() {
+		t.Fatal("the first root of unity should be 1")
+	}
+	// in bit-reversed order, ω is at index N/2 and -1 at index 1
+	if !testCtx.roots[1].Equal(&minusOne) {
+		t.Fatal("roots[1] should be -1")
+	}
+	omega := testCtx.roots[FieldElementsPerBlob/2]
+	w.Exp(omega, big.NewInt(FieldElementsPerBlob))
+	if !w.IsOne() {
+		t.Fatal("ω should be a root of unity of order N")
+	}
+	w.Exp(omega, big.NewInt(FieldElementsPerBlob/2))
+	if !w.Equal(&minusOne) {
+		t.Fatal("ω should be a primitive root of unity of order N")
+	}
+}
+
+func TestBlobToCommitment(t *testing.T) {
+	blob, p := randomBlob(t)
+	c, err := testCtx.BlobToCommitment(blob)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the commitment is [p(τ)]G₁
+	pTau := testCtx.evaluate(p, testTau)
+	var k big.Int
+	pTau.BigInt(&k)
+	var expected bls12381.G1Affine
+	expected.ScalarMultiplication(&testCtx.g1, &k)
+	if c != Commitment(expected.Bytes()) {
+		t.Fatal("the commitment should be [p(τ)]G₁")
+	}
+
+	// non canonical field element
+	_blob := *blob
+	modulus := fr.Modulus().FillBytes(make([]byte, fr.Bytes))
+	copy(_blob[fr.Bytes:], modulus)
+	if _, err := testCtx.BlobToCommitment(&_blob); err != ErrNonCanonicalFieldElement {
+		t.Fatal("expected ErrNonCanonicalFieldElement, got", err)
+	}
+}
+
+func TestComputeProof(t *testing.T) {
+	blob, p := randomBlob(t)
+	c, err := testCtx.BlobToCommitment(blob)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var random fr.Element
+	random.SetRandom()
+	points := map[string]fr.Element{
+		"random":           random,
+		"in the domain":    testCtx.roots[5],
+		"first root (one)": testCtx.roots[0],
+	}
+	for name, z := range points {
+		zb := Scalar(z.Bytes())
+		proof, y, err := testCtx.ComputeProof(blob, zb)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := testCtx.evaluate(p, z)
+		if y != Scalar(expected.Bytes()) {
+			t.Fatalf("%s: wrong evaluation", name)
+		}
+		if err := testCtx.VerifyProof(c, zb, y, proof); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		// wrong evaluation
+		var wrong fr.Element
+		wrong.SetOne().Add(&wrong, &expected)
+		if err := testCtx.VerifyProof(c, zb, Scalar(wrong.Bytes()), proof); err != ErrVerifyProof {
+			t.Fatalf("%s: expected ErrVerifyProof, got %v", name, err)
+		}
+	}
+
+	// the evaluation in the domain is the entry of the blob
+	if e := testCtx.evaluate(p, testCtx.roots[5]); !e.Equal(&p[5]) {
+		t.Fatal("the evaluation at ωᵢ should be the i-th element of the blob")
+	}
+}
+
+func TestVerifyBlobProofBatch(t *testing.T) {
+	const n = 3
+	blobs := make([]Blob, n)
+	commitments := make([]Commitment, n)
+	proofs := make([]Proof, n)
+	for i := range blobs {
+		blob, _ := randomBlob(t)
+		blobs[i] = *blob
+		var err error
+		if commitments[i], err = testCtx.BlobToCommitment(blob); err != nil {
+			t.Fatal(err)
+		}
+		if proofs[i], err = testCtx.ComputeBlobProof(blob, commitments[i]); err != nil {
+			t.Fatal(err)
+		}
+		if err := testCtx.VerifyBlobProof(blob, commitments[i], proofs[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := testCtx.VerifyBlobProofBatch(blobs, commitments, proofs); err != nil {
+		t.Fatal(err)
+	}
+	if err := testCtx.VerifyBlobProofBatch(nil, nil, nil); err != nil {
+		t.Fatal("an empty batch should verify")
+	}
+	if err := testCtx.VerifyBlobProofBatch(blobs, commitments[1:], proofs); err != ErrInvalidBatchSize {
+		t.Fatal("expected ErrInvalidBatchSize, got", err)
+	}
+
+	// swapped proofs
+	swapped := []Proof{proofs[1], proofs[0], proofs[2]}
+	if err := testCtx.VerifyBlobProofBatch(blobs, commitments, swapped); err != ErrVerifyProof {
+		t.Fatal("expected ErrVerifyProof, got", err)
+	}
+
+	// tampered blob
+	blobs[2][BytesPerBlob-1] ^= 1
+	if err := testCtx.VerifyBlobProof(&blobs[2], commitments[2], proofs[2]); err != ErrVerifyProof {
+		t.Fatal("expected ErrVerifyProof, got", err)
+	}
+	if err := testCtx.VerifyBlobProofBatch(blobs, commitments, proofs); err != ErrVerifyProof {
+		t.Fatal("expected ErrVerifyProof, got", err)
+	}
+
+	// invalid point
+	var invalid Proof
+	invalid[0] = 0xff
+	if err := testCtx.VerifyBlobProof(&blobs[0], commitments[0], invalid); err != ErrInvalidPoint {
+		t.Fatal("expected ErrInvalidPoint, got", err)
+	}
+}
+
+func TestLoadTrustedSetup(t *testing.T) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%d\n%d\n", FieldElementsPerBlob, 3)
+	for i := range testLagrangeG1 {
+		b := testLagrangeG1[i].Bytes()
+		fmt.Fprintln(&buf, hex.EncodeToString(b[:]))
+	}
+	var g2Tau2 bls12381.G2Affine
+	var k big.Int
+	testTau.BigInt(&k)
+	g2Tau2.ScalarMultiplication(&testG2[1], &k)
+	for _, p := range []bls12381.G2Affine{testG2[0], testG2[1], g2Tau2} {
+		b := p.Bytes()
+		fmt.Fprintln(&buf, hex.EncodeToString(b[:]))
+	}
+
+	ctx, err := LoadTrustedSetup(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range ctx.lagrangeG1 {
+		if !ctx.lagrangeG1[i].Equal(&testCtx.lagrangeG1[i]) {
+			t.Fatal("the loaded setup doesn't match")
+		}
+	}
+	if !ctx.g2[1].Equal(&testCtx.g2[1]) {
+		t.Fatal("the loaded setup doesn't match")
+	}
+
+	// truncated setup
+	if _, err := LoadTrustedSetup(bytes.NewReader(buf.Bytes()[:buf.Len()/2])); err != ErrInvalidSetup {
+		t.Fatal("expected ErrInvalidSetup, got", err)
+	}
+}
+
+func BenchmarkKZG4844(b *testing.B) {
+	var blob Blob
+	for i := 0; i < FieldElementsPerBlob; i++ {
+		var e fr.Element
+		e.SetRandom()
+		eb := e.Bytes()
+		copy(blob[i*fr.Bytes:], eb[:])
+	}
+	c, _ := testCtx.BlobToCommitment(&blob)
+	proof, _ := testCtx.ComputeBlobProof(&blob, c)
+
+	b.Run("BlobToCommitment", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = testCtx.BlobToCommitment(&blob)
+		}
+	})
+	b.Run("ComputeBlobProof", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = testCtx.ComputeBlobProof(&blob, c)
+		}
+	})
+	b.Run("VerifyBlobProof", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = testCtx.VerifyBlobProof(&blob, c, proof)
+		}
+	})
+}
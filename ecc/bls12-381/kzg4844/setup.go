@@ -0,0 +1,122 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kzg4844
+
+import (
+	"bufio"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var ErrInvalidSetup = errors.New("invalid trusted setup")
+
+// LoadTrustedSetup reads a trusted setup in the text format of c-kzg-4844 (trusted_setup.txt),
+// and returns the corresponding Context.
+//
+// The format is a list of whitespace separated tokens: the number of points of G₁ (which
+// must be FieldElementsPerBlob), the number of points of G₂, the points [Lᵢ(τ)]G₁ then the
+// points [τⁱ]G₂, all compressed and hex encoded. Any data after the points of G₂ (e.g. the
+// monomial points of G₁ of newer setups) is ignored.
+//
+// The points are subgroup checked.
+func LoadTrustedSetup(r io.Reader) (*Context, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(bufio.ScanWords)
+	next := func() (string, error) {
+		if !scanner.Scan() {
+			if err := scanner.Err(); err != nil {
+				return "", err
+			}
+			return "", ErrInvalidSetup
+		}
+		return scanner.Text(), nil
+	}
+	readInt := func() (int, error) {
+		t, err := next()
+		if err != nil {
+			return 0, err
+		}
+		n, err := strconv.Atoi(t)
+		if err != nil {
+			return 0, ErrInvalidSetup
+		}
+		return n, nil
+	}
+
+	nbG1, err := readInt()
+	if err != nil {
+		return nil, err
+	}
+	nbG2, err := readInt()
+	if err != nil {
+		return nil, err
+	}
+	if nbG1 != FieldElementsPerBlob || nbG2 < 2 {
+		return nil, ErrInvalidSetup
+	}
+
+	g1Hex := make([]string, nbG1)
+	for i := range g1Hex {
+		if g1Hex[i], err = next(); err != nil {
+			return nil, err
+		}
+	}
+	var g2 [2]bls12381.G2Affine
+	for i := 0; i < nbG2; i++ {
+		t, err := next()
+		if err != nil {
+			return nil, err
+		}
+		if i >= len(g2) {
+			continue
+		}
+		b, err := hex.DecodeString(strings.TrimPrefix(t, "0x"))
+		if err != nil || len(b) != bls12381.SizeOfG2AffineCompressed {
+			return nil, ErrInvalidSetup
+		}
+		if _, err := g2[i].SetBytes(b); err != nil {
+			return nil, ErrInvalidSetup
+		}
+	}
+
+	// decompress the points of G₁ in parallel
+	g1 := make([]bls12381.G1Affine, nbG1)
+	var nbErrs uint64
+	parallel.Execute(nbG1, func(start, end int) {
+		for i := start; i < end; i++ {
+			b, err := hex.DecodeString(strings.TrimPrefix(g1Hex[i], "0x"))
+			if err != nil || len(b) != bls12381.SizeOfG1AffineCompressed {
+				atomic.AddUint64(&nbErrs, 1)
+				return
+			}
+			if _, err := g1[i].SetBytes(b); err != nil {
+				atomic.AddUint64(&nbErrs, 1)
+				return
+			}
+		}
+	})
+	if nbErrs != 0 {
+		return nil, ErrInvalidSetup
+	}
+
+	return NewContext(g1, g2)
+}
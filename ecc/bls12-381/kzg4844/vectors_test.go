@@ -0,0 +1,369 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kzg4844
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+// The test vectors are in the formats of c-kzg-4844, with a test setup built from a public τ
+// (see internal/testvectors, which computes the expected outputs from τ and the formulas of
+// the specifications):
+//
+//	testdata/trusted_setup.txt
+//	testdata/tests/<operation>/kzg-mainnet/<case>/data.yaml
+//
+// Replacing testdata with trusted_setup.txt and the tests directory of c-kzg-4844 runs
+// its reference vectors, computed with the setup of the KZG ceremony.
+const (
+	vectorsSetup = "testdata/trusted_setup.txt"
+	vectorsDir   = "testdata/tests"
+)
+
+var (
+	vectorsOnce sync.Once
+	vectorsCtx  *Context
+	vectorsErr  error
+)
+
+// vectorsContext loads the setup of the test vectors
+func vectorsContext(t *testing.T) *Context {
+	t.Helper()
+	vectorsOnce.Do(func() {
+		f, err := os.Open(vectorsSetup)
+		if err != nil {
+			vectorsErr = err
+			return
+		}
+		defer f.Close()
+		vectorsCtx, vectorsErr = LoadTrustedSetup(f)
+	})
+	if vectorsErr != nil {
+		t.Fatal(vectorsErr)
+	}
+	return vectorsCtx
+}
+
+// kzgVector is a test case of c-kzg-4844. Single values are stored as lists of one
+// element; a nil Output stands for "output: null", that is an error is expected.
+type kzgVector struct {
+	Input  map[string][]string
+	Output []string
+}
+
+// readVectors parses the data.yaml files of an operation of c-kzg-4844
+func readVectors(t *testing.T, operation string) map[string]kzgVector {
+	t.Helper()
+	files, err := filepath.Glob(filepath.Join(vectorsDir, operation, "*", "*", "data.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no test vectors of " + operation + " in " + vectorsDir)
+	}
+	res := make(map[string]kzgVector, len(files))
+	for _, file := range files {
+		var data struct {
+			Input  map[string]interface{} `yaml:"input"`
+			Output interface{}            `yaml:"output"`
+		}
+		f, err := os.Open(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = yaml.NewDecoder(f).Decode(&data)
+		f.Close()
+		if err != nil {
+			t.Fatal(file, err)
+		}
+		v := kzgVector{Input: make(map[string][]string, len(data.Input))}
+		for k, value := range data.Input {
+			v.Input[k] = yamlStrings(value)
+		}
+		if data.Output != nil {
+			v.Output = yamlStrings(data.Output)
+		}
+		res[filepath.Base(filepath.Dir(file))] = v
+	}
+	return res
+}
+
+// yamlStrings returns a scalar as a list of one string, or the strings of a list
+func yamlStrings(value interface{}) []string {
+	list, ok := value.([]interface{})
+	if !ok {
+		return []string{fmt.Sprint(value)}
+	}
+	res := make([]string, len(list))
+	for i := range list {
+		res[i] = fmt.Sprint(list[i])
+	}
+	return res
+}
+
+// decodeHex decodes a 0x prefixed hex string of n bytes; ok is false if s isn't one
+func decodeHex(s string, n int) (b []byte, ok bool) {
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil || len(b) != n {
+		return nil, false
+	}
+	return b, true
+}
+
+func decodeBlob(s string) (*Blob, bool) {
+	b, ok := decodeHex(s, BytesPerBlob)
+	if !ok {
+		return nil, false
+	}
+	var blob Blob
+	copy(blob[:], b)
+	return &blob, true
+}
+
+func decodeScalar(s string) (Scalar, bool) {
+	var res Scalar
+	b, ok := decodeHex(s, BytesPerFieldElement)
+	copy(res[:], b)
+	return res, ok
+}
+
+func decodeCommitment(s string) (Commitment, bool) {
+	var res Commitment
+	b, ok := decodeHex(s, BytesPerCommitment)
+	copy(res[:], b)
+	return res, ok
+}
+
+func decodeProof(s string) (Proof, bool) {
+	var res Proof
+	b, ok := decodeHex(s, BytesPerProof)
+	copy(res[:], b)
+	return res, ok
+}
+
+func encodeHex(b []byte) string {
+	return "0x" + hex.EncodeToString(b)
+}
+
+// checkVerification checks the result of a verification against the expected output:
+// true, false (ErrVerifyProof) or null (any other error)
+func checkVerification(t *testing.T, name string, v kzgVector, err error) {
+	t.Helper()
+	switch {
+	case v.Output == nil:
+		if err == nil || errors.Is(err, ErrVerifyProof) {
+			t.Fatalf("%s: expected an invalid input, got %v", name, err)
+		}
+	case v.Output[0] == "true":
+		if err != nil {
+			t.Fatalf("%s: expected a valid proof, got %v", name, err)
+		}
+	case v.Output[0] == "false":
+		if !errors.Is(err, ErrVerifyProof) {
+			t.Fatalf("%s: expected an invalid proof, got %v", name, err)
+		}
+	default:
+		t.Fatalf("%s: unexpected output %v", name, v.Output)
+	}
+}
+
+func TestVectorsBlobToCommitment(t *testing.T) {
+	ctx := vectorsContext(t)
+	for name, v := range readVectors(t, "blob_to_kzg_commitment") {
+		blob, ok := decodeBlob(v.Input["blob"][0])
+		if !ok {
+			if v.Output != nil {
+				t.Fatalf("%s: invalid blob with an output", name)
+			}
+			continue
+		}
+		c, err := ctx.BlobToCommitment(blob)
+		if v.Output == nil {
+			if err == nil {
+				t.Fatalf("%s: expected an error", name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(name, err)
+		}
+		if encodeHex(c[:]) != v.Output[0] {
+			t.Fatalf("%s: commitment mismatch", name)
+		}
+	}
+}
+
+func TestVectorsComputeProof(t *testing.T) {
+	ctx := vectorsContext(t)
+	for name, v := range readVectors(t, "compute_kzg_proof") {
+		blob, okBlob := decodeBlob(v.Input["blob"][0])
+		z, okZ := decodeScalar(v.Input["z"][0])
+		if !okBlob || !okZ {
+			if v.Output != nil {
+				t.Fatalf("%s: invalid input with an output", name)
+			}
+			continue
+		}
+		proof, y, err := ctx.ComputeProof(blob, z)
+		if v.Output == nil {
+			if err == nil {
+				t.Fatalf("%s: expected an error", name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(name, err)
+		}
+		if encodeHex(proof[:]) != v.Output[0] || encodeHex(y[:]) != v.Output[1] {
+			t.Fatalf("%s: proof or evaluation mismatch", name)
+		}
+	}
+}
+
+func TestVectorsComputeBlobProof(t *testing.T) {
+	ctx := vectorsContext(t)
+	for name, v := range readVectors(t, "compute_blob_kzg_proof") {
+		blob, okBlob := decodeBlob(v.Input["blob"][0])
+		c, okC := decodeCommitment(v.Input["commitment"][0])
+		if !okBlob || !okC {
+			if v.Output != nil {
+				t.Fatalf("%s: invalid input with an output", name)
+			}
+			continue
+		}
+		proof, err := ctx.ComputeBlobProof(blob, c)
+		if v.Output == nil {
+			if err == nil {
+				t.Fatalf("%s: expected an error", name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(name, err)
+		}
+		if encodeHex(proof[:]) != v.Output[0] {
+			t.Fatalf("%s: proof mismatch", name)
+		}
+	}
+}
+
+func TestVectorsVerifyProof(t *testing.T) {
+	ctx := vectorsContext(t)
+	for name, v := range readVectors(t, "verify_kzg_proof") {
+		c, okC := decodeCommitment(v.Input["commitment"][0])
+		z, okZ := decodeScalar(v.Input["z"][0])
+		y, okY := decodeScalar(v.Input["y"][0])
+		proof, okP := decodeProof(v.Input["proof"][0])
+		if !okC || !okZ || !okY || !okP {
+			if v.Output != nil {
+				t.Fatalf("%s: invalid input with an output", name)
+			}
+			continue
+		}
+		checkVerification(t, name, v, ctx.VerifyProof(c, z, y, proof))
+	}
+}
+
+func TestVectorsVerifyBlobProof(t *testing.T) {
+	ctx := vectorsContext(t)
+	for name, v := range readVectors(t, "verify_blob_kzg_proof") {
+		blob, okBlob := decodeBlob(v.Input["blob"][0])
+		c, okC := decodeCommitment(v.Input["commitment"][0])
+		proof, okP := decodeProof(v.Input["proof"][0])
+		if !okBlob || !okC || !okP {
+			if v.Output != nil {
+				t.Fatalf("%s: invalid input with an output", name)
+			}
+			continue
+		}
+		checkVerification(t, name, v, ctx.VerifyBlobProof(blob, c, proof))
+	}
+}
+
+func TestVectorsVerifyBlobProofBatch(t *testing.T) {
+	ctx := vectorsContext(t)
+	for name, v := range readVectors(t, "verify_blob_kzg_proof_batch") {
+		blobs := make([]Blob, 0, len(v.Input["blobs"]))
+		commitments := make([]Commitment, 0, len(v.Input["commitments"]))
+		proofs := make([]Proof, 0, len(v.Input["proofs"]))
+		ok := true
+		for _, s := range v.Input["blobs"] {
+			blob, okBlob := decodeBlob(s)
+			if ok = ok && okBlob; ok {
+				blobs = append(blobs, *blob)
+			}
+		}
+		for _, s := range v.Input["commitments"] {
+			c, okC := decodeCommitment(s)
+			ok = ok && okC
+			commitments = append(commitments, c)
+		}
+		for _, s := range v.Input["proofs"] {
+			p, okP := decodeProof(s)
+			ok = ok && okP
+			proofs = append(proofs, p)
+		}
+		if !ok {
+			if v.Output != nil {
+				t.Fatalf("%s: invalid input with an output", name)
+			}
+			continue
+		}
+		checkVerification(t, name, v, ctx.VerifyBlobProofBatch(blobs, commitments, proofs))
+	}
+}
+
+// TestVectorsSetupIndependent checks the outputs of c-kzg-4844 that don't depend on the
+// setup: the commitment to the zero blob and the openings of the zero polynomial are the
+// point at infinity, and the proofs of its openings verify with any setup.
+func TestVectorsSetupIndependent(t *testing.T) {
+	infinity := make([]byte, BytesPerCommitment)
+	infinity[0] = 0xc0
+
+	var blob Blob
+	c, err := testCtx.BlobToCommitment(&blob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(c[:], infinity) {
+		t.Fatal("the commitment to the zero blob should be the point at infinity")
+	}
+
+	var z Scalar
+	z[BytesPerFieldElement-1] = 5
+	proof, y, err := testCtx.ComputeProof(&blob, z)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(proof[:], infinity) || y != (Scalar{}) {
+		t.Fatal("the opening of the zero polynomial should be (∞, 0)")
+	}
+	if err := testCtx.VerifyProof(c, z, y, proof); err != nil {
+		t.Fatal(err)
+	}
+	if err := testCtx.VerifyBlobProofBatch([]Blob{blob}, []Commitment{c}, []Proof{proof}); err != nil {
+		t.Fatal(err)
+	}
+}
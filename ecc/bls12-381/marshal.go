@@ -21,6 +21,8 @@ import (
 	"errors"
 	"io"
 	"reflect"
+	"runtime"
+	"sync"
 	"sync/atomic"
 
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
@@ -63,15 +65,21 @@ type Encoder struct {
 
 // Decoder reads bls12-381 object values from an inbound stream
 type Decoder struct {
-	r             io.Reader
-	n             int64 // read bytes
-	subGroupCheck bool  // default to true
+	r                   io.Reader
+	n                   int64 // read bytes
+	subGroupCheck       bool  // default to true
+	batchSubGroupChecks bool  // defer subgroup checks to CheckSubGroups
+	strict              bool  // reject non-canonical encodings, default to true
+
+	// points decoded with batchSubGroupChecks set, waiting for their subgroup checks
+	pendingG1 [][]G1Affine
+	pendingG2 [][]G2Affine
 }
 
 // NewDecoder returns a binary decoder supporting curve bls12-381 objects in both
 // compressed and uncompressed (raw) forms
 func NewDecoder(r io.Reader, options ...func(*Decoder)) *Decoder {
-	d := &Decoder{r: r, subGroupCheck: true}
+	d := &Decoder{r: r, subGroupCheck: true, strict: true}
 
 	for _, o := range options {
 		o(d)
@@ -141,6 +149,10 @@ func (dec *Decoder) Decode(v interface{}) (err error) {
 		if err != nil {
 			return
 		}
+		if !dec.strict {
+			t.SetBytes(buf[:fr.Bytes])
+			return
+		}
 		err = t.SetBytesCanonical(buf[:fr.Bytes])
 		return
 	case *fp.Element:
@@ -149,13 +161,43 @@ func (dec *Decoder) Decode(v interface{}) (err error) {
 		if err != nil {
 			return
 		}
+		if !dec.strict {
+			t.SetBytes(buf[:fp.Bytes])
+			return
+		}
 		err = t.SetBytesCanonical(buf[:fp.Bytes])
 		return
 	case *[]fr.Element:
+		if !dec.strict {
+			// fr.Vector rejects non-canonical elements, decode them one by one
+			if sliceLen, err = dec.readUint32(); err != nil {
+				return
+			}
+			*t = make([]fr.Element, sliceLen)
+			for i := range *t {
+				if err = dec.Decode(&(*t)[i]); err != nil {
+					return
+				}
+			}
+			return
+		}
 		read64, err = (*fr.Vector)(t).ReadFrom(dec.r)
 		dec.n += read64
 		return
 	case *[]fp.Element:
+		if !dec.strict {
+			// fp.Vector rejects non-canonical elements, decode them one by one
+			if sliceLen, err = dec.readUint32(); err != nil {
+				return
+			}
+			*t = make([]fp.Element, sliceLen)
+			for i := range *t {
+				if err = dec.Decode(&(*t)[i]); err != nil {
+					return
+				}
+			}
+			return
+		}
 		read64, err = (*fp.Vector)(t).ReadFrom(dec.r)
 		dec.n += read64
 		return
@@ -167,8 +209,9 @@ func (dec *Decoder) Decode(v interface{}) (err error) {
 			*t = make([][]fr.Element, sliceLen)
 		}
 		for i := range *t {
-			read64, err = (*fr.Vector)(&(*t)[i]).ReadFrom(dec.r)
-			dec.n += read64
+			if err = dec.Decode(&(*t)[i]); err != nil {
+				return
+			}
 		}
 		return
 	case *G1Affine:
@@ -196,7 +239,10 @@ func (dec *Decoder) Decode(v interface{}) (err error) {
 				return
 			}
 		}
-		_, err = t.setBytes(buf[:nbBytes], dec.subGroupCheck)
+		_, err = t.setBytes(buf[:nbBytes], dec.subGroupCheck && !dec.batchSubGroupChecks, dec.strict)
+		if err == nil && dec.subGroupCheck && dec.batchSubGroupChecks {
+			dec.pendingG1 = append(dec.pendingG1, []G1Affine{*t})
+		}
 		return
 	case *G2Affine:
 		// we start by reading compressed point size, if metadata tells us it is uncompressed, we read more.
@@ -223,7 +269,10 @@ func (dec *Decoder) Decode(v interface{}) (err error) {
 				return
 			}
 		}
-		_, err = t.setBytes(buf[:nbBytes], dec.subGroupCheck)
+		_, err = t.setBytes(buf[:nbBytes], dec.subGroupCheck && !dec.batchSubGroupChecks, dec.strict)
+		if err == nil && dec.subGroupCheck && dec.batchSubGroupChecks {
+			dec.pendingG2 = append(dec.pendingG2, []G2Affine{*t})
+		}
 		return
 	case *[]G1Affine:
 		sliceLen, err = dec.readUint32()
@@ -233,128 +282,16 @@ func (dec *Decoder) Decode(v interface{}) (err error) {
 		if len(*t) != int(sliceLen) || *t == nil {
 			*t = make([]G1Affine, sliceLen)
 		}
-		compressed := make([]bool, sliceLen)
-		for i := 0; i < len(*t); i++ {
-
-			// we start by reading compressed point size, if metadata tells us it is uncompressed, we read more.
-			read, err = io.ReadFull(dec.r, buf[:SizeOfG1AffineCompressed])
-			dec.n += int64(read)
-			if err != nil {
-				return
-			}
-			nbBytes := SizeOfG1AffineCompressed
-
-			// 111, 011, 001  --> invalid mask
-			if isMaskInvalid(buf[0]) {
-				err = ErrInvalidEncoding
-				return
-			}
-
-			// most significant byte contains metadata
-			if !isCompressed(buf[0]) {
-				nbBytes = SizeOfG1AffineUncompressed
-				// we read more.
-				read, err = io.ReadFull(dec.r, buf[SizeOfG1AffineCompressed:SizeOfG1AffineUncompressed])
-				dec.n += int64(read)
-				if err != nil {
-					return
-				}
-				_, err = (*t)[i].setBytes(buf[:nbBytes], false)
-				if err != nil {
-					return
-				}
-			} else {
-				var r bool
-				if r, err = (*t)[i].unsafeSetCompressedBytes(buf[:nbBytes]); err != nil {
-					return
-				}
-				compressed[i] = !r
-			}
-		}
-		var nbErrs uint64
-		parallel.Execute(len(compressed), func(start, end int) {
-			for i := start; i < end; i++ {
-				if compressed[i] {
-					if err := (*t)[i].unsafeComputeY(dec.subGroupCheck); err != nil {
-						atomic.AddUint64(&nbErrs, 1)
-					}
-				} else if dec.subGroupCheck {
-					if !(*t)[i].IsInSubGroup() {
-						atomic.AddUint64(&nbErrs, 1)
-					}
-				}
-			}
-		})
-		if nbErrs != 0 {
-			return errors.New("point decompression failed")
-		}
-
-		return nil
+		return dec.decodeSliceG1Affine(*t)
 	case *[]G2Affine:
 		sliceLen, err = dec.readUint32()
 		if err != nil {
 			return
 		}
-		if len(*t) != int(sliceLen) {
+		if len(*t) != int(sliceLen) || *t == nil {
 			*t = make([]G2Affine, sliceLen)
 		}
-		compressed := make([]bool, sliceLen)
-		for i := 0; i < len(*t); i++ {
-
-			// we start by reading compressed point size, if metadata tells us it is uncompressed, we read more.
-			read, err = io.ReadFull(dec.r, buf[:SizeOfG2AffineCompressed])
-			dec.n += int64(read)
-			if err != nil {
-				return
-			}
-			nbBytes := SizeOfG2AffineCompressed
-
-			// 111, 011, 001  --> invalid mask
-			if isMaskInvalid(buf[0]) {
-				err = ErrInvalidEncoding
-				return
-			}
-
-			// most significant byte contains metadata
-			if !isCompressed(buf[0]) {
-				nbBytes = SizeOfG2AffineUncompressed
-				// we read more.
-				read, err = io.ReadFull(dec.r, buf[SizeOfG2AffineCompressed:SizeOfG2AffineUncompressed])
-				dec.n += int64(read)
-				if err != nil {
-					return
-				}
-				_, err = (*t)[i].setBytes(buf[:nbBytes], false)
-				if err != nil {
-					return
-				}
-			} else {
-				var r bool
-				if r, err = (*t)[i].unsafeSetCompressedBytes(buf[:nbBytes]); err != nil {
-					return
-				}
-				compressed[i] = !r
-			}
-		}
-		var nbErrs uint64
-		parallel.Execute(len(compressed), func(start, end int) {
-			for i := start; i < end; i++ {
-				if compressed[i] {
-					if err := (*t)[i].unsafeComputeY(dec.subGroupCheck); err != nil {
-						atomic.AddUint64(&nbErrs, 1)
-					}
-				} else if dec.subGroupCheck {
-					if !(*t)[i].IsInSubGroup() {
-						atomic.AddUint64(&nbErrs, 1)
-					}
-				}
-			}
-		})
-		if nbErrs != 0 {
-			return errors.New("point decompression failed")
-		}
-
-		return nil
+		return dec.decodeSliceG2Affine(*t)
 	default:
 		n := binary.Size(t)
 		if n == -1 {
@@ -455,6 +392,235 @@ func NoSubgroupChecks() func(*Decoder) {
 	}
 }
 
+// NonCanonicalEncodings returns an option to use in NewDecoder(...) which allows non-canonical
+// encodings: field elements (and point coordinates) greater than the modulus are reduced, and
+// the unused bits of infinity points are ignored.
+// By default, the decoder rejects such encodings; this option exists to read legacy data
+// and should not be used on untrusted inputs, as it makes the encoding malleable.
+func NonCanonicalEncodings() func(*Decoder) {
+	return func(dec *Decoder) {
+		dec.strict = false
+	}
+}
+
+// BatchSubgroupChecks returns an option to use in NewDecoder(...) which defers the subgroup checks
+// on the points the decoder will read. The points are still decoded (and decompressed) but the
+// checks are only performed, in parallel, when CheckSubGroups is called.
+//
+// This is useful when decoding large objects made of several points or slices of points (e.g. a SRS):
+// the checks are batched in a single parallel pass instead of being run for each call to Decode.
+// Decoded points must not be used before CheckSubGroups returns nil.
+func BatchSubgroupChecks() func(*Decoder) {
+	return func(dec *Decoder) {
+		dec.batchSubGroupChecks = true
+	}
+}
+
+// CheckSubGroups runs the subgroup checks deferred by the BatchSubgroupChecks option
+// on all the points decoded so far, and returns an error if one of them is not in the
+// correct subgroup.
+//
+// It is a no-op if the option is not set or if NoSubgroupChecks is set.
+func (dec *Decoder) CheckSubGroups() error {
+	var nbErrs uint64
+	for _, points := range dec.pendingG1 {
+		points := points
+		parallel.Execute(len(points), func(start, end int) {
+			for i := start; i < end; i++ {
+				if !points[i].IsInSubGroup() {
+					atomic.AddUint64(&nbErrs, 1)
+				}
+			}
+		})
+	}
+	for _, points := range dec.pendingG2 {
+		points := points
+		parallel.Execute(len(points), func(start, end int) {
+			for i := start; i < end; i++ {
+				if !points[i].IsInSubGroup() {
+					atomic.AddUint64(&nbErrs, 1)
+				}
+			}
+		})
+	}
+	dec.pendingG1 = nil
+	dec.pendingG2 = nil
+	if nbErrs != 0 {
+		return errors.New("invalid point: subgroup check failed")
+	}
+	return nil
+}
+
+// decodeSliceG1Affine reads len(points) encoded points from the stream.
+//
+// The bytes are read sequentially, in chunks; each chunk is then decoded (decompression,
+// subgroup checks) by a pool of workers while the next chunk is being read.
+func (dec *Decoder) decodeSliceG1Affine(points []G1Affine) error {
+	const chunkSize = 1 << 10
+	subGroupCheck := dec.subGroupCheck && !dec.batchSubGroupChecks
+	strict := dec.strict
+
+	type chunk struct {
+		start   int                   // index of the first point of the chunk
+		buf     []byte                // encoded points, contiguous
+		offsets [chunkSize + 1]uint32 // offsets[i] is the position of point i in buf
+		nb      int                   // number of points in the chunk
+	}
+
+	nbWorkers := runtime.NumCPU()
+	if nbChunks := (len(points) + chunkSize - 1) / chunkSize; nbChunks < nbWorkers {
+		nbWorkers = nbChunks
+	}
+
+	var nbErrs uint64
+	var wg sync.WaitGroup
+	chChunks := make(chan *chunk, nbWorkers)
+	for w := 0; w < nbWorkers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range chChunks {
+				for i := 0; i < c.nb; i++ {
+					if _, err := points[c.start+i].setBytes(c.buf[c.offsets[i]:c.offsets[i+1]], subGroupCheck, strict); err != nil {
+						atomic.AddUint64(&nbErrs, 1)
+					}
+				}
+			}
+		}()
+	}
+
+	var err error
+	for start := 0; start < len(points) && err == nil; start += chunkSize {
+		c := &chunk{
+			start: start,
+			buf:   make([]byte, 0, chunkSize*SizeOfG1AffineUncompressed),
+		}
+		for i := start; i < len(points) && c.nb < chunkSize; i++ {
+			if err = dec.readPoint(&c.buf, SizeOfG1AffineCompressed, SizeOfG1AffineUncompressed); err != nil {
+				break
+			}
+			c.nb++
+			c.offsets[c.nb] = uint32(len(c.buf))
+		}
+		if err == nil {
+			chChunks <- c
+		}
+	}
+	close(chChunks)
+	wg.Wait()
+
+	if err != nil {
+		return err
+	}
+	if nbErrs != 0 {
+		return errors.New("point decompression failed")
+	}
+	if dec.subGroupCheck && dec.batchSubGroupChecks {
+		dec.pendingG1 = append(dec.pendingG1, points)
+	}
+	return nil
+}
+
+// decodeSliceG2Affine reads len(points) encoded points from the stream.
+//
+// The bytes are read sequentially, in chunks; each chunk is then decoded (decompression,
+// subgroup checks) by a pool of workers while the next chunk is being read.
+func (dec *Decoder) decodeSliceG2Affine(points []G2Affine) error {
+	const chunkSize = 1 << 10
+	subGroupCheck := dec.subGroupCheck && !dec.batchSubGroupChecks
+	strict := dec.strict
+
+	type chunk struct {
+		start   int                   // index of the first point of the chunk
+		buf     []byte                // encoded points, contiguous
+		offsets [chunkSize + 1]uint32 // offsets[i] is the position of point i in buf
+		nb      int                   // number of points in the chunk
+	}
+
+	nbWorkers := runtime.NumCPU()
+	if nbChunks := (len(points) + chunkSize - 1) / chunkSize; nbChunks < nbWorkers {
+		nbWorkers = nbChunks
+	}
+
+	var nbErrs uint64
+	var wg sync.WaitGroup
+	chChunks := make(chan *chunk, nbWorkers)
+	for w := 0; w < nbWorkers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range chChunks {
+				for i := 0; i < c.nb; i++ {
+					if _, err := points[c.start+i].setBytes(c.buf[c.offsets[i]:c.offsets[i+1]], subGroupCheck, strict); err != nil {
+						atomic.AddUint64(&nbErrs, 1)
+					}
+				}
+			}
+		}()
+	}
+
+	var err error
+	for start := 0; start < len(points) && err == nil; start += chunkSize {
+		c := &chunk{
+			start: start,
+			buf:   make([]byte, 0, chunkSize*SizeOfG2AffineUncompressed),
+		}
+		for i := start; i < len(points) && c.nb < chunkSize; i++ {
+			if err = dec.readPoint(&c.buf, SizeOfG2AffineCompressed, SizeOfG2AffineUncompressed); err != nil {
+				break
+			}
+			c.nb++
+			c.offsets[c.nb] = uint32(len(c.buf))
+		}
+		if err == nil {
+			chChunks <- c
+		}
+	}
+	close(chChunks)
+	wg.Wait()
+
+	if err != nil {
+		return err
+	}
+	if nbErrs != 0 {
+		return errors.New("point decompression failed")
+	}
+	if dec.subGroupCheck && dec.batchSubGroupChecks {
+		dec.pendingG2 = append(dec.pendingG2, points)
+	}
+	return nil
+}
+
+// readPoint reads an encoded point from the stream and appends it to buf.
+// It first reads the compressed size, and reads more if the metadata says
+// the point is uncompressed.
+func (dec *Decoder) readPoint(buf *[]byte, sizeCompressed, sizeUncompressed int) error {
+	offset := len(*buf)
+	*buf = (*buf)[:offset+sizeCompressed]
+	read, err := io.ReadFull(dec.r, (*buf)[offset:])
+	dec.n += int64(read)
+	if err != nil {
+		return err
+	}
+
+	// 111, 011, 001  --> invalid mask
+	if isMaskInvalid((*buf)[offset]) {
+		return ErrInvalidEncoding
+	}
+
+	// most significant byte contains metadata
+	if !isCompressed((*buf)[offset]) {
+		// we read more.
+		*buf = (*buf)[:offset+sizeUncompressed]
+		read, err = io.ReadFull(dec.r, (*buf)[offset+sizeCompressed:])
+		dec.n += int64(read)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // isZeroed checks that the provided bytes are at 0
 func isZeroed(firstByte byte, buf []byte) bool {
 	if firstByte != 0 {
@@ -468,6 +634,16 @@ func isZeroed(firstByte byte, buf []byte) bool {
 	return true
 }
 
+// setFpBytes sets z from the big endian bytes in buf; if strict is set, it returns an
+// error if buf doesn't encode an element smaller than the modulus.
+func setFpBytes(z *fp.Element, buf []byte, strict bool) error {
+	if strict {
+		return z.SetBytesCanonical(buf)
+	}
+	z.SetBytes(buf)
+	return nil
+}
+
 func (enc *Encoder) encode(v interface{}) (err error) {
 	rv := reflect.ValueOf(v)
 	if v == nil || (rv.Kind() == reflect.Ptr && rv.IsNil()) {
@@ -834,10 +1010,13 @@ func (p *G1Affine) RawBytes() (res [SizeOfG1AffineUncompressed]byte) {
 //
 // this check if the resulting point is on the curve and in the correct subgroup
 func (p *G1Affine) SetBytes(buf []byte) (int, error) {
-	return p.setBytes(buf, true)
+	return p.setBytes(buf, true, true)
 }
 
-func (p *G1Affine) setBytes(buf []byte, subGroupCheck bool) (int, error) {
+// setBytes is SetBytes with optional subgroup check; if strict is false, coordinates
+// greater than the field modulus are reduced and the unused bits of an infinity
+// encoding are ignored, instead of returning an error.
+func (p *G1Affine) setBytes(buf []byte, subGroupCheck, strict bool) (int, error) {
 	if len(buf) < SizeOfG1AffineCompressed {
 		return 0, io.ErrShortBuffer
 	}
@@ -859,7 +1038,7 @@ func (p *G1Affine) setBytes(buf []byte, subGroupCheck bool) (int, error) {
 
 	// infinity encoded, we still check that the buffer is full of zeroes.
 	if mData == mCompressedInfinity {
-		if !isZeroed(buf[0] & ^mMask, buf[1:SizeOfG1AffineCompressed]) {
+		if strict && !isZeroed(buf[0] & ^mMask, buf[1:SizeOfG1AffineCompressed]) {
 			return 0, ErrInvalidInfinityEncoding
 		}
 		p.X.SetZero()
@@ -867,7 +1046,7 @@ func (p *G1Affine) setBytes(buf []byte, subGroupCheck bool) (int, error) {
 		return SizeOfG1AffineCompressed, nil
 	}
 	if mData == mUncompressedInfinity {
-		if !isZeroed(buf[0] & ^mMask, buf[1:SizeOfG1AffineUncompressed]) {
+		if strict && !isZeroed(buf[0] & ^mMask, buf[1:SizeOfG1AffineUncompressed]) {
 			return 0, ErrInvalidInfinityEncoding
 		}
 		p.X.SetZero()
@@ -878,10 +1057,10 @@ func (p *G1Affine) setBytes(buf []byte, subGroupCheck bool) (int, error) {
 	// uncompressed point
 	if mData == mUncompressed {
 		// read X and Y coordinates
-		if err := p.X.SetBytesCanonical(buf[:fp.Bytes]); err != nil {
+		if err := setFpBytes(&p.X, buf[:fp.Bytes], strict); err != nil {
 			return 0, err
 		}
-		if err := p.Y.SetBytesCanonical(buf[fp.Bytes : fp.Bytes*2]); err != nil {
+		if err := setFpBytes(&p.Y, buf[fp.Bytes:fp.Bytes*2], strict); err != nil {
 			return 0, err
 		}
 
@@ -903,7 +1082,7 @@ func (p *G1Affine) setBytes(buf []byte, subGroupCheck bool) (int, error) {
 	bufX[0] &= ^mMask
 
 	// read X coordinate
-	if err := p.X.SetBytesCanonical(bufX[:fp.Bytes]); err != nil {
+	if err := setFpBytes(&p.X, bufX[:fp.Bytes], strict); err != nil {
 		return 0, err
 	}
 
@@ -937,79 +1116,6 @@ func (p *G1Affine) setBytes(buf []byte, subGroupCheck bool) (int, error) {
 	return SizeOfG1AffineCompressed, nil
 }
 
-// unsafeComputeY called by Decoder when processing slices of compressed point in parallel (step 2)
-// it computes the Y coordinate from the already set X coordinate and is compute intensive
-func (p *G1Affine) unsafeComputeY(subGroupCheck bool) error {
-	// stored in unsafeSetCompressedBytes
-
-	mData := byte(p.Y[0])
-
-	// we have a compressed coordinate, we need to solve the curve equation to compute Y
-	var YSquared, Y fp.Element
-
-	YSquared.Square(&p.X).Mul(&YSquared, &p.X)
-	YSquared.Add(&YSquared, &bCurveCoeff)
-	if Y.Sqrt(&YSquared) == nil {
-		return errors.New("invalid compressed coordinate: square root doesn't exist")
-	}
-
-	if Y.LexicographicallyLargest() {
-		// Y ">" -Y
-		if mData == mCompressedSmallest {
-			Y.Neg(&Y)
-		}
-	} else {
-		// Y "<=" -Y
-		if mData == mCompressedLargest {
-			Y.Neg(&Y)
-		}
-	}
-
-	p.Y = Y
-
-	// subgroup check
-	if subGroupCheck && !p.IsInSubGroup() {
-		return errors.New("invalid point: subgroup check failed")
-	}
-
-	return nil
-}
-
-// unsafeSetCompressedBytes is called by Decoder when processing slices of compressed point in parallel (step 1)
-// assumes buf[:8] mask is set to compressed
-// returns true if point is infinity and need no further processing
-// it sets X coordinate and uses Y for scratch space to store decompression metadata
-func (p *G1Affine) unsafeSetCompressedBytes(buf []byte) (isInfinity bool, err error) {
-
-	// read the most significant byte
-	mData := buf[0] & mMask
-
-	if mData == mCompressedInfinity {
-		isInfinity = true
-		if !isZeroed(buf[0] & ^mMask, buf[1:SizeOfG1AffineCompressed]) {
-			return isInfinity, ErrInvalidInfinityEncoding
-		}
-		p.X.SetZero()
-		p.Y.SetZero()
-		return isInfinity, nil
-	}
-
-	// we need to copy the input buffer (to keep this method thread safe)
-	var bufX [fp.Bytes]byte
-	copy(bufX[:fp.Bytes], buf[:fp.Bytes])
-	bufX[0] &= ^mMask
-
-	// read X coordinate
-	if err := p.X.SetBytesCanonical(bufX[:fp.Bytes]); err != nil {
-		return false, err
-	}
-	// store mData in p.Y[0]
-	p.Y[0] = uint64(mData)
-
-	// recomputing Y will be done asynchronously
-	return isInfinity, nil
-}
-
 // SizeOfG2AffineCompressed represents the size in bytes that a G2Affine need in binary form, compressed
 const SizeOfG2AffineCompressed = 48 * 2
 
@@ -1101,10 +1207,13 @@ func (p *G2Affine) RawBytes() (res [SizeOfG2AffineUncompressed]byte) {
 //
 // this check if the resulting point is on the curve and in the correct subgroup
 func (p *G2Affine) SetBytes(buf []byte) (int, error) {
-	return p.setBytes(buf, true)
+	return p.setBytes(buf, true, true)
 }
 
-func (p *G2Affine) setBytes(buf []byte, subGroupCheck bool) (int, error) {
+// setBytes is SetBytes with optional subgroup check; if strict is false, coordinates
+// greater than the field modulus are reduced and the unused bits of an infinity
+// encoding are ignored, instead of returning an error.
+func (p *G2Affine) setBytes(buf []byte, subGroupCheck, strict bool) (int, error) {
 	if len(buf) < SizeOfG2AffineCompressed {
 		return 0, io.ErrShortBuffer
 	}
@@ -1126,7 +1235,7 @@ func (p *G2Affine) setBytes(buf []byte, subGroupCheck bool) (int, error) {
 
 	// infinity encoded, we still check that the buffer is full of zeroes.
 	if mData == mCompressedInfinity {
-		if !isZeroed(buf[0] & ^mMask, buf[1:SizeOfG2AffineCompressed]) {
+		if strict && !isZeroed(buf[0] & ^mMask, buf[1:SizeOfG2AffineCompressed]) {
 			return 0, ErrInvalidInfinityEncoding
 		}
 		p.X.SetZero()
@@ -1134,7 +1243,7 @@ func (p *G2Affine) setBytes(buf []byte, subGroupCheck bool) (int, error) {
 		return SizeOfG2AffineCompressed, nil
 	}
 	if mData == mUncompressedInfinity {
-		if !isZeroed(buf[0] & ^mMask, buf[1:SizeOfG2AffineUncompressed]) {
+		if strict && !isZeroed(buf[0] & ^mMask, buf[1:SizeOfG2AffineUncompressed]) {
 			return 0, ErrInvalidInfinityEncoding
 		}
 		p.X.SetZero()
@@ -1146,17 +1255,17 @@ func (p *G2Affine) setBytes(buf []byte, subGroupCheck bool) (int, error) {
 	if mData == mUncompressed {
 		// read X and Y coordinates
 		// p.X.A1 | p.X.A0
-		if err := p.X.A1.SetBytesCanonical(buf[:fp.Bytes]); err != nil {
+		if err := setFpBytes(&p.X.A1, buf[:fp.Bytes], strict); err != nil {
 			return 0, err
 		}
-		if err := p.X.A0.SetBytesCanonical(buf[fp.Bytes : fp.Bytes*2]); err != nil {
+		if err := setFpBytes(&p.X.A0, buf[fp.Bytes:fp.Bytes*2], strict); err != nil {
 			return 0, err
 		}
 		// p.Y.A1 | p.Y.A0
-		if err := p.Y.A1.SetBytesCanonical(buf[fp.Bytes*2 : fp.Bytes*3]); err != nil {
+		if err := setFpBytes(&p.Y.A1, buf[fp.Bytes*2:fp.Bytes*3], strict); err != nil {
 			return 0, err
 		}
-		if err := p.Y.A0.SetBytesCanonical(buf[fp.Bytes*3 : fp.Bytes*4]); err != nil {
+		if err := setFpBytes(&p.Y.A0, buf[fp.Bytes*3:fp.Bytes*4], strict); err != nil {
 			return 0, err
 		}
 
@@ -1179,10 +1288,10 @@ func (p *G2Affine) setBytes(buf []byte, subGroupCheck bool) (int, error) {
 
 	// read X coordinate
 	// p.X.A1 | p.X.A0
-	if err := p.X.A1.SetBytesCanonical(bufX[:fp.Bytes]); err != nil {
+	if err := setFpBytes(&p.X.A1, bufX[:fp.Bytes], strict); err != nil {
 		return 0, err
 	}
-	if err := p.X.A0.SetBytesCanonical(buf[fp.Bytes : fp.Bytes*2]); err != nil {
+	if err := setFpBytes(&p.X.A0, buf[fp.Bytes:fp.Bytes*2], strict); err != nil {
 		return 0, err
 	}
 
@@ -1216,82 +1325,3 @@ func (p *G2Affine) setBytes(buf []byte, subGroupCheck bool) (int, error) {
 
 	return SizeOfG2AffineCompressed, nil
 }
-
-// unsafeComputeY called by Decoder when processing slices of compressed point in parallel (step 2)
-// it computes the Y coordinate from the already set X coordinate and is compute intensive
-func (p *G2Affine) unsafeComputeY(subGroupCheck bool) error {
-	// stored in unsafeSetCompressedBytes
-
-	mData := byte(p.Y.A0[0])
-
-	// we have a compressed coordinate, we need to solve the curve equation to compute Y
-	var YSquared, Y fptower.E2
-
-	YSquared.Square(&p.X).Mul(&YSquared, &p.X)
-	YSquared.Add(&YSquared, &bTwistCurveCoeff)
-	if YSquared.Legendre() == -1 {
-		return errors.New("invalid compressed coordinate: square root doesn't exist")
-	}
-	Y.Sqrt(&YSquared)
-
-	if Y.LexicographicallyLargest() {
-		// Y ">" -Y
-		if mData == mCompressedSmallest {
-			Y.Neg(&Y)
-		}
-	} else {
-		// Y "<=" -Y
-		if mData == mCompressedLargest {
-			Y.Neg(&Y)
-		}
-	}
-
-	p.Y = Y
-
-	// subgroup check
-	if subGroupCheck && !p.IsInSubGroup() {
-		return errors.New("invalid point: subgroup check failed")
-	}
-
-	return nil
-}
-
-// unsafeSetCompressedBytes is called by Decoder when processing slices of compressed point in parallel (step 1)
-// assumes buf[:8] mask is set to compressed
-// returns true if point is infinity and need no further processing
-// it sets X coordinate and uses Y for scratch space to store decompression metadata
-func (p *G2Affine) unsafeSetCompressedBytes(buf []byte) (isInfinity bool, err error) {
-
-	// read the most significant byte
-	mData := buf[0] & mMask
-
-	if mData == mCompressedInfinity {
-		isInfinity = true
-		if !isZeroed(buf[0] & ^mMask, buf[1:SizeOfG2AffineCompressed]) {
-			return isInfinity, ErrInvalidInfinityEncoding
-		}
-		p.X.SetZero()
-		p.Y.SetZero()
-		return isInfinity, nil
-	}
-
-	// we need to copy the input buffer (to keep this method thread safe)
-	var bufX [fp.Bytes]byte
-	copy(bufX[:fp.Bytes], buf[:fp.Bytes])
-	bufX[0] &= ^mMask
-
-	// read X coordinate
-	// p.X.A1 | p.X.A0
-	if err := p.X.A1.SetBytesCanonical(bufX[:fp.Bytes]); err != nil {
-		return false, err
-	}
-	if err := p.X.A0.SetBytesCanonical(buf[fp.Bytes : fp.Bytes*2]); err != nil {
-		return false, err
-	}
-
-	// store mData in p.Y.A0[0]
-	p.Y.A0[0] = uint64(mData)
-
-	// recomputing Y will be done asynchronously
-	return isInfinity, nil
-}
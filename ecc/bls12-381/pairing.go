@@ -17,6 +17,8 @@ package bls12381
 import (
 	"errors"
 
+	"github.com/consensys/gnark-crypto/ecc"
+
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/internal/fptower"
 )
@@ -35,6 +37,8 @@ type lineEvaluation struct {
 //
 // This function doesn't check that the inputs are in the correct subgroup. See IsInSubGroup.
 func Pair(P []G1Affine, Q []G2Affine) (GT, error) {
+	defer ecc.StartRegion(ecc.BLS12_381, ecc.OpPairing, len(P)).End()
+
 	f, err := MillerLoop(P, Q)
 	if err != nil {
 		return GT{}, err
@@ -361,6 +365,8 @@ type LineEvaluationAff struct {
 //
 // This function doesn't check that the inputs are in the correct subgroup. See IsInSubGroup.
 func PairFixedQ(P []G1Affine, lines [][2][len(LoopCounter) - 1]LineEvaluationAff) (GT, error) {
+	defer ecc.StartRegion(ecc.BLS12_381, ecc.OpPairing, len(P)).End()
+
 	f, err := MillerLoopFixedQ(P, lines)
 	if err != nil {
 		return GT{}, err
@@ -0,0 +1,289 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package twistededwards
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// Bowe–Hopwood Pedersen hash
+//
+// This is the Pedersen hash of Zcash Sapling (https://zips.z.cash/protocol/protocol.pdf, 5.4.1.7)
+// on the companion curve. The message bits are padded with zeros to a multiple of 3 and split
+// in segments of c 3-bit chunks mⱼ = (s₀, s₁, s₂), each encoded as the signed digit
+// enc(mⱼ) = (1-2s₂)(1+s₀+2s₁) ∈ {±1, ±2, ±3, ±4}. The hash is
+//
+//	∑ᵢ [⟨Mᵢ⟩]Gᵢ, where ⟨Mᵢ⟩ = ∑ⱼ enc(mⱼ)·2⁴ʲ
+//
+// and Gᵢ are independent generators of the prime subgroup. c is the largest number of chunks
+// such that the |⟨Mᵢ⟩| stay below half the order of the subgroup, which makes the encoding of
+// each segment injective.
+//
+// The generators are derived from a domain separation tag (see NewPedersenHasher) with the hash
+// to field of RFC 9380, so they are not the generators of Sapling. Each segment has a table of
+// the multiples [k·2⁴ʲ]Gᵢ, k ∈ {1, 2, 3, 4}: a chunk costs a single mixed addition.
+
+var errPedersenDomain = errors.New("the domain separation tag must be between 1 and 255 bytes")
+
+// PedersenHasher holds the generators of a Bowe–Hopwood Pedersen hash and their tables. The
+// tables of the segments are built the first time a message is long enough to need them.
+// A PedersenHasher can be shared by concurrent PedersenDigest.
+type PedersenHasher struct {
+	domain []byte
+
+	lock   sync.RWMutex
+	tables [][]PointAffine // tables[i][4j+k-1] = [k·2⁴ʲ]Gᵢ
+}
+
+// NewPedersenHasher returns the Pedersen hasher whose generators are derived from the domain
+// separation tag domain.
+func NewPedersenHasher(domain []byte) (*PedersenHasher, error) {
+	if len(domain) == 0 || len(domain) > 255 {
+		return nil, errPedersenDomain
+	}
+	h := &PedersenHasher{domain: make([]byte, len(domain))}
+	copy(h.domain, domain)
+	return h, nil
+}
+
+// pedersenChunksPerSegment returns the number c of chunks per segment: the largest c such that
+// 4·∑_{j<c}2⁴ʲ ≤ (order-1)/2.
+func pedersenChunksPerSegment() int {
+	initOnce.Do(initCurveParams)
+	var bound, sum, pow big.Int
+	bound.Sub(&curveParams.Order, big.NewInt(1)).Rsh(&bound, 1)
+	pow.SetUint64(4)
+	c := 0
+	for {
+		sum.Add(&sum, &pow)
+		if sum.Cmp(&bound) > 0 {
+			return c
+		}
+		c++
+		pow.Lsh(&pow, 4)
+	}
+}
+
+// Generator returns the generator of the i-th segment of the messages
+func (h *PedersenHasher) Generator(i int) PointAffine {
+	return h.table(i)[0]
+}
+
+// table returns the table of the i-th segment, and builds the missing ones
+func (h *PedersenHasher) table(i int) []PointAffine {
+	h.lock.RLock()
+	if i < len(h.tables) {
+		t := h.tables[i]
+		h.lock.RUnlock()
+		return t
+	}
+	h.lock.RUnlock()
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	c := pedersenChunksPerSegment()
+	for len(h.tables) <= i {
+		g := h.generator(len(h.tables))
+		t := make([]PointAffine, 4*c)
+
+		// [2⁴ʲ]G, [2·2⁴ʲ]G, [3·2⁴ʲ]G, [4·2⁴ʲ]G
+		var p, tmp PointExtended
+		p.FromAffine(&g)
+		multiples := make([]PointExtended, 4*c)
+		for j := 0; j < c; j++ {
+			multiples[4*j].Set(&p)
+			multiples[4*j+1].Double(&p)
+			multiples[4*j+2].Add(&multiples[4*j+1], &p)
+			multiples[4*j+3].Double(&multiples[4*j+1])
+			tmp.Double(&multiples[4*j+3])
+			p.Double(&tmp)
+		}
+		copy(t, BatchExtendedToAffine(multiples))
+		h.tables = append(h.tables, t)
+	}
+	return h.tables[i]
+}
+
+// generator derives the generator of the i-th segment: the first point of the curve whose y
+// coordinate is fr.Hash(i ‖ counter, domain), with an even x, multiplied by the cofactor.
+func (h *PedersenHasher) generator(i int) PointAffine {
+	initOnce.Do(initCurveParams)
+	var msg [8]byte
+	binary.BigEndian.PutUint32(msg[:4], uint32(i))
+	for counter := uint32(0); ; counter++ {
+		binary.BigEndian.PutUint32(msg[4:], counter)
+		y, err := fr.Hash(msg[:], h.domain, 1)
+		if err != nil {
+			// the domain was checked in NewPedersenHasher
+			panic(err)
+		}
+
+		// x² = (1-y²)/(a-dy²)
+		var p PointAffine
+		var num, den fr.Element
+		p.Y = y[0]
+		num.Square(&p.Y)
+		den.Mul(&num, &curveParams.D)
+		num.Sub(new(fr.Element).SetOne(), &num)
+		den.Sub(&curveParams.A, &den)
+		if den.IsZero() {
+			continue
+		}
+		p.X.Div(&num, &den)
+		if p.X.Sqrt(&p.X) == nil {
+			continue
+		}
+		if p.X.LexicographicallyLargest() {
+			p.X.Neg(&p.X)
+		}
+
+		p.ClearCofactor(&p)
+		if !p.IsZero() {
+			return p
+		}
+	}
+}
+
+// Hash returns the hash of the bits of msg, least significant bit of each byte first
+// (see PedersenDigest.Write).
+func (h *PedersenHasher) Hash(msg []byte) PointAffine {
+	d := h.NewDigest()
+	_, _ = d.Write(msg)
+	return d.SumPoint()
+}
+
+// HashBits returns the hash of the message bits
+func (h *PedersenHasher) HashBits(bits []bool) PointAffine {
+	d := h.NewDigest()
+	d.WriteBits(bits)
+	return d.SumPoint()
+}
+
+// PedersenDigest computes the hash of a message written in several parts. Its Sum is the
+// x coordinate of the hash, as Sapling's extraction of the u coordinate.
+//
+// implements hash.Hash
+type PedersenDigest struct {
+	hasher *PedersenHasher
+	c      int
+
+	acc       PointExtended // sum of the chunks of the previous segments and of the current one
+	segment   int           // index of the current segment
+	chunk     int           // index of the next chunk in the current segment
+	pending   [3]bool       // bits of the next chunk
+	nbPending int
+}
+
+// NewDigest returns an empty PedersenDigest for h
+func (h *PedersenHasher) NewDigest() *PedersenDigest {
+	d := &PedersenDigest{hasher: h, c: pedersenChunksPerSegment()}
+	d.Reset()
+	return d
+}
+
+// Reset resets the digest to the hash of the empty message
+func (d *PedersenDigest) Reset() {
+	d.acc.setInfinity()
+	d.segment, d.chunk, d.nbPending = 0, 0, 0
+}
+
+// WriteBits adds bits to the message
+func (d *PedersenDigest) WriteBits(bits []bool) {
+	for _, b := range bits {
+		d.pending[d.nbPending] = b
+		d.nbPending++
+		if d.nbPending == 3 {
+			d.addChunk()
+		}
+	}
+}
+
+// Write adds the bits of p to the message, least significant bit of each byte first. It never
+// returns an error.
+func (d *PedersenDigest) Write(p []byte) (int, error) {
+	var bits [8]bool
+	for _, b := range p {
+		for k := range bits {
+			bits[k] = (b>>k)&1 == 1
+		}
+		d.WriteBits(bits[:])
+	}
+	return len(p), nil
+}
+
+// addChunk adds the pending chunk to the accumulator
+func (d *PedersenDigest) addChunk() {
+	k := 1
+	if d.pending[0] {
+		k++
+	}
+	if d.pending[1] {
+		k += 2
+	}
+	t := &d.hasher.table(d.segment)[4*d.chunk+k-1]
+	if d.pending[2] {
+		var neg PointAffine
+		neg.Neg(t)
+		d.acc.MixedAdd(&d.acc, &neg)
+	} else {
+		d.acc.MixedAdd(&d.acc, t)
+	}
+
+	d.nbPending = 0
+	d.chunk++
+	if d.chunk == d.c {
+		d.segment++
+		d.chunk = 0
+	}
+}
+
+// SumPoint returns the hash of the message written so far, padded with zeros to a multiple
+// of 3 bits. It doesn't change the state of the digest.
+func (d *PedersenDigest) SumPoint() PointAffine {
+	_d := *d
+	if _d.nbPending != 0 {
+		for k := _d.nbPending; k < 3; k++ {
+			_d.pending[k] = false
+		}
+		_d.addChunk()
+	}
+	var res PointAffine
+	res.FromExtended(&_d.acc)
+	return res
+}
+
+// Sum appends the x coordinate of the hash (see SumPoint) to b, in big endian, and returns it.
+func (d *PedersenDigest) Sum(b []byte) []byte {
+	p := d.SumPoint()
+	x := p.X.Bytes()
+	return append(b, x[:]...)
+}
+
+// Size returns the number of bytes Sum will return
+func (d *PedersenDigest) Size() int {
+	return fr.Bytes
+}
+
+// BlockSize returns 1: messages can have any number of bytes
+func (d *PedersenDigest) BlockSize() int {
+	return 1
+}
@@ -22,6 +22,7 @@ import (
 	"math/big"
 	"math/bits"
 
+	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
 )
 
@@ -179,6 +180,30 @@ func (p *PointAffine) IsOnCurve() bool {
 	return lhs.Equal(&rhs)
 }
 
+// IsInSubGroup returns true if p is on the twisted Edwards curve and in the prime subgroup
+func (p *PointAffine) IsInSubGroup() bool {
+	initOnce.Do(initCurveParams)
+	if !p.IsOnCurve() {
+		return false
+	}
+	var pExtended, res PointExtended
+	pExtended.FromAffine(p)
+	res.mulDoubleAndAdd(&pExtended, &curveParams.Order)
+	return res.IsZero()
+}
+
+// ClearCofactor sets p to [h]p1, where h is the cofactor of the curve, and returns it
+func (p *PointAffine) ClearCofactor(p1 *PointAffine) *PointAffine {
+	initOnce.Do(initCurveParams)
+	var h big.Int
+	curveParams.Cofactor.BigInt(&h)
+	var p1Extended, resExtended PointExtended
+	p1Extended.FromAffine(p1)
+	resExtended.mulDoubleAndAdd(&p1Extended, &h)
+	p.FromExtended(&resExtended)
+	return p
+}
+
 // Neg sets p to -p1 and returns it
 func (p *PointAffine) Neg(p1 *PointAffine) *PointAffine {
 	p.X.Neg(&p1.X)
@@ -266,6 +291,21 @@ func (p *PointAffine) ScalarMultiplication(p1 *PointAffine, scalar *big.Int) *Po
 	return p
 }
 
+// ScalarMultiplicationBlinded computes and returns p = [scalar]p1, blinding the scalar with a
+// random multiple of the order of the subgroup (see ecc.BlindScalar). p1 must be in the subgroup.
+//
+// It is slower than ScalarMultiplication, and meant for secret scalars in environments
+// exposed to side-channel attacks.
+func (p *PointAffine) ScalarMultiplicationBlinded(p1 *PointAffine, scalar *big.Int) (*PointAffine, error) {
+	var p1Extended, resExtended PointExtended
+	p1Extended.FromAffine(p1)
+	if _, err := resExtended.ScalarMultiplicationBlinded(&p1Extended, scalar); err != nil {
+		return nil, err
+	}
+	p.FromExtended(&resExtended)
+	return p, nil
+}
+
 // setInfinity sets p to O (0:1)
 func (p *PointAffine) setInfinity() *PointAffine {
 	p.X.SetZero()
@@ -628,6 +668,36 @@ func (p *PointExtended) setInfinity() *PointExtended {
 	return p
 }
 
+// mulDoubleAndAdd sets p to [s]p1 for a non-negative s, and returns it.
+// Unlike ScalarMultiplication, s is not reduced modulo the order of the subgroup,
+// so that it can be used on points outside of it.
+func (p *PointExtended) mulDoubleAndAdd(p1 *PointExtended, s *big.Int) *PointExtended {
+	var res PointExtended
+	res.setInfinity()
+	for i := s.BitLen() - 1; i >= 0; i-- {
+		res.Double(&res)
+		if s.Bit(i) == 1 {
+			res.Add(&res, p1)
+		}
+	}
+	return p.Set(&res)
+}
+
+// ScalarMultiplicationBlinded computes and returns p = [scalar]p1, blinding the scalar with a
+// random multiple of the order of the subgroup (see ecc.BlindScalar). p1 must be in the subgroup.
+//
+// The blinded scalar is not reduced: it uses
+// a double-and-add. It is slower than ScalarMultiplication, and meant for secret scalars in
+// environments exposed to side-channel attacks.
+func (p *PointExtended) ScalarMultiplicationBlinded(p1 *PointExtended, scalar *big.Int) (*PointExtended, error) {
+	initOnce.Do(initCurveParams)
+	k, err := ecc.BlindScalar(scalar, &curveParams.Order)
+	if err != nil {
+		return nil, err
+	}
+	return p.mulDoubleAndAdd(p1, k), nil
+}
+
 // ScalarMultiplication scalar multiplication of a point
 // p1 in extended coordinates with a scalar in big.Int
 func (p *PointExtended) ScalarMultiplication(p1 *PointExtended, scalar *big.Int) *PointExtended {
@@ -0,0 +1,71 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bls12381
+
+import (
+	"errors"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+)
+
+var (
+	ErrNotOnCurve    = errors.New("the abscissa isn't the one of a point of the curve")
+	ErrNotInSubGroup = errors.New("the point isn't in the prime order subgroup")
+)
+
+// The compressed encodings (Bytes) only hold the abscissa of a point, and a flag telling which
+// of its two ordinates ±y it is. SetX recovers the point from this x-only form, for protocols
+// which transmit the abscissa and the flag separately, e.g. in their own encoding.
+
+// SetX sets p to the point of G1 of abscissa x, whose ordinate is the lexicographically
+// largest of its two possible values if largest is set, the smallest otherwise (the flag of
+// the compressed encoding). It returns an error if x isn't the abscissa of a point of G1.
+func (p *G1Affine) SetX(x *fp.Element, largest bool) error {
+	var y, y2 fp.Element
+	y2.Square(x).Mul(&y2, x).Add(&y2, &bCurveCoeff)
+	if y.Sqrt(&y2) == nil {
+		return ErrNotOnCurve
+	}
+	if y.LexicographicallyLargest() != largest {
+		y.Neg(&y)
+	}
+	q := G1Affine{X: *x, Y: y}
+	if !q.IsInSubGroup() {
+		return ErrNotInSubGroup
+	}
+	*p = q
+	return nil
+}
+
+// SetX sets p to the point of G2 of abscissa x, whose ordinate is the lexicographically
+// largest of its two possible values if largest is set, the smallest otherwise (the flag of
+// the compressed encoding). It returns an error if x isn't the abscissa of a point of G2.
+func (p *G2Affine) SetX(x *E2, largest bool) error {
+	var y, y2 E2
+	y2.Square(x).Mul(&y2, x).Add(&y2, &bTwistCurveCoeff)
+	if y2.Legendre() == -1 {
+		return ErrNotOnCurve
+	}
+	y.Sqrt(&y2)
+	if y.LexicographicallyLargest() != largest {
+		y.Neg(&y)
+	}
+	q := G2Affine{X: *x, Y: y}
+	if !q.IsInSubGroup() {
+		return ErrNotInSubGroup
+	}
+	*p = q
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bls12381
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+)
+
+func TestSetX(t *testing.T) {
+	var s big.Int
+	s.SetUint64(123456789)
+
+	var p1 G1Affine
+	p1.ScalarMultiplicationBase(&s)
+	for _, p := range []G1Affine{p1, *new(G1Affine).Neg(&p1)} {
+		var q G1Affine
+		if err := q.SetX(&p.X, p.Y.LexicographicallyLargest()); err != nil {
+			t.Fatal(err)
+		}
+		if !q.Equal(&p) {
+			t.Fatal("G1: the recovered point differs")
+		}
+	}
+
+	var p2 G2Affine
+	p2.ScalarMultiplicationBase(&s)
+	for _, p := range []G2Affine{p2, *new(G2Affine).Neg(&p2)} {
+		var q G2Affine
+		if err := q.SetX(&p.X, p.Y.LexicographicallyLargest()); err != nil {
+			t.Fatal(err)
+		}
+		if !q.Equal(&p) {
+			t.Fatal("G2: the recovered point differs")
+		}
+	}
+
+	// (0, ±2) is on the curve, but not in G1
+	var x fp.Element
+	var q G1Affine
+	if err := q.SetX(&x, false); err != ErrNotInSubGroup {
+		t.Fatal("expected ErrNotInSubGroup, got", err)
+	}
+
+	// x³ + 4 isn't a square for some x
+	var one, y2 fp.Element
+	one.SetOne()
+	for {
+		x.Add(&x, &one)
+		y2.Square(&x).Mul(&y2, &x).Add(&y2, &bCurveCoeff)
+		if y2.Legendre() == -1 {
+			break
+		}
+	}
+	if err := q.SetX(&x, false); err != ErrNotOnCurve {
+		t.Fatal("expected ErrNotOnCurve, got", err)
+	}
+}
@@ -26,7 +26,7 @@
 //
 // # Warning
 //
-// This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+// This code has not been audited and is provided as-is. In particular, only G1Affine.ScalarMultiplicationConstantTime (used by the ECDSA signing and key generation) is meant to run in constant time; the other operations, ScalarMultiplication included, run in variable time.
 package bls24315
 
 import (
@@ -0,0 +1,378 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls24315
+
+import (
+	"errors"
+	"io"
+	"math/big"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// fixedBaseC is the window size of the fixed-base tables.
+// The last window of the signed digit decomposition must not be larger than the others (lastC(fixedBaseC) <= fixedBaseC).
+const fixedBaseC = 8
+
+// FixedBaseTableG1 holds precomputed multiples of a fixed base (e.g. the generator,
+// or the bases of Pedersen commitments) to speed up the scalar multiplications by this base.
+//
+// For each c-bit window j of the scalars, the table stores [d*2^{c*j}]base for the 2^{c-1} (signed)
+// digits d; a scalar multiplication is then a sum of one precomputed point per window, without any
+// doubling. Tables can be written to disk (WriteTo) and loaded back (ReadFrom).
+type FixedBaseTableG1 struct {
+	points []G1Affine // points[j*2^{c-1}+d-1] = [d*2^{c*j}]base
+}
+
+// NewFixedBaseTableG1 precomputes a FixedBaseTableG1 for base
+func NewFixedBaseTableG1(base *G1Affine) *FixedBaseTableG1 {
+	nbChunks := int(computeNbChunks(fixedBaseC))
+	nbDigits := 1 << (fixedBaseC - 1)
+
+	// [2^{c*j}]base for each window j
+	var p G1Jac
+	p.FromAffine(base)
+	windows := make([]G1Jac, nbChunks)
+	for j := range windows {
+		if j != 0 {
+			for k := 0; k < fixedBaseC; k++ {
+				p.DoubleAssign()
+			}
+		}
+		windows[j].Set(&p)
+	}
+
+	multiples := make([]G1Jac, nbChunks*nbDigits)
+	parallel.Execute(nbChunks, func(start, end int) {
+		for j := start; j < end; j++ {
+			w := multiples[j*nbDigits : (j+1)*nbDigits]
+			w[0].Set(&windows[j])
+			for d := 1; d < nbDigits; d++ {
+				w[d].Set(&w[d-1]).AddAssign(&windows[j])
+			}
+		}
+	})
+
+	return &FixedBaseTableG1{points: BatchJacobianToAffineG1(multiples)}
+}
+
+// Base returns the base the table was built from
+func (t *FixedBaseTableG1) Base() G1Affine {
+	return t.points[0]
+}
+
+var g1BaseTable struct {
+	once  sync.Once
+	table *FixedBaseTableG1
+}
+
+// getG1BaseTable returns the FixedBaseTableG1 of the generator of the prime
+// subgroup, built on the first call. It is used by ScalarMultiplicationBase.
+func getG1BaseTable() *FixedBaseTableG1 {
+	g1BaseTable.once.Do(func() {
+		g1BaseTable.table = NewFixedBaseTableG1(&g1GenAff)
+	})
+	return g1BaseTable.table
+}
+
+// ScalarMultiplicationFixedBase computes and returns p = [s]base, where base is the base of table.
+//
+// s is reduced modulo r, the base must be in the r-torsion subgroup.
+// The running time depends on s; it is not constant-time.
+func (p *G1Affine) ScalarMultiplicationFixedBase(table *FixedBaseTableG1, s *big.Int) *G1Affine {
+	var _p G1Jac
+	_p.ScalarMultiplicationFixedBase(table, s)
+	p.FromJacobian(&_p)
+	return p
+}
+
+// ScalarMultiplicationFixedBase computes and returns p = [s]base, where base is the base of table.
+//
+// s is reduced modulo r, the base must be in the r-torsion subgroup.
+// The running time depends on s; it is not constant-time.
+func (p *G1Jac) ScalarMultiplicationFixedBase(table *FixedBaseTableG1, s *big.Int) *G1Jac {
+	var e [1]fr.Element
+	e[0].SetBigInt(s)
+	digits, _ := partitionScalars(e[:], fixedBaseC, 1)
+
+	const nbDigits = 1 << (fixedBaseC - 1)
+	var acc g1JacExtended
+	acc.setInfinity()
+	for j, digit := range digits {
+		if digit == 0 {
+			continue
+		}
+		// digit&1 == 0 -> digit>>1 is the (positive) digit
+		// digit&1 == 1 -> -(digit>>1)-1 is the (negative) digit
+		if digit&1 == 0 {
+			acc.addMixed(&table.points[j*nbDigits+int(digit>>1)-1])
+		} else {
+			acc.subMixed(&table.points[j*nbDigits+int(digit>>1)])
+		}
+	}
+	return p.fromJacExtended(&acc)
+}
+
+// WriteTo writes the binary encoding of the table to w
+func (t *FixedBaseTableG1) WriteTo(w io.Writer) (int64, error) {
+	return t.writeTo(w, false)
+}
+
+// WriteRawTo writes the binary encoding of the table to w, without point compression.
+// The encoding is twice as large, but much faster to decode.
+func (t *FixedBaseTableG1) WriteRawTo(w io.Writer) (int64, error) {
+	return t.writeTo(w, true)
+}
+
+func (t *FixedBaseTableG1) writeTo(w io.Writer, raw bool) (int64, error) {
+	var flags ecc.HeaderFlag
+	var options []func(*Encoder)
+	if raw {
+		flags |= ecc.FlagRawEncoding
+		options = append(options, RawEncoding())
+	}
+	h := ecc.NewHeader(ecc.BLS24_315, ecc.ObjectFixedBaseTableG1, flags)
+	hn, err := h.WriteTo(w)
+	if err != nil {
+		return hn, err
+	}
+
+	enc := NewEncoder(w, options...)
+	toEncode := []interface{}{
+		uint64(fixedBaseC),
+		t.points,
+	}
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return hn + enc.BytesWritten(), err
+		}
+	}
+	return hn + enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes a table from r. The subgroup checks of the points are batched in a
+// single pass once the table is read.
+//
+// Note that this doesn't check that the points are multiples of the base.
+func (t *FixedBaseTableG1) ReadFrom(r io.Reader) (int64, error) {
+	return t.readFrom(r, BatchSubgroupChecks())
+}
+
+// UnsafeReadFrom decodes a table from r without checking that the points are
+// in the correct subgroup.
+func (t *FixedBaseTableG1) UnsafeReadFrom(r io.Reader) (int64, error) {
+	return t.readFrom(r, NoSubgroupChecks())
+}
+
+func (t *FixedBaseTableG1) readFrom(r io.Reader, options ...func(*Decoder)) (int64, error) {
+	h, r, hn, err := ecc.ReadHeader(r)
+	if err != nil {
+		return hn, err
+	}
+	if err := h.Check(ecc.BLS24_315, ecc.ObjectFixedBaseTableG1); err != nil {
+		return hn, err
+	}
+
+	dec := NewDecoder(r, options...)
+	var c uint64
+	for _, v := range []interface{}{&c, &t.points} {
+		if err := dec.Decode(v); err != nil {
+			return hn + dec.BytesRead(), err
+		}
+	}
+	if c != fixedBaseC || uint64(len(t.points)) != computeNbChunks(fixedBaseC)<<(fixedBaseC-1) {
+		return hn + dec.BytesRead(), errors.New("invalid table encoding")
+	}
+
+	return hn + dec.BytesRead(), dec.CheckSubGroups()
+}
+
+// FixedBaseTableG2 holds precomputed multiples of a fixed base (e.g. the generator,
+// or the bases of Pedersen commitments) to speed up the scalar multiplications by this base.
+//
+// For each c-bit window j of the scalars, the table stores [d*2^{c*j}]base for the 2^{c-1} (signed)
+// digits d; a scalar multiplication is then a sum of one precomputed point per window, without any
+// doubling. Tables can be written to disk (WriteTo) and loaded back (ReadFrom).
+type FixedBaseTableG2 struct {
+	points []G2Affine // points[j*2^{c-1}+d-1] = [d*2^{c*j}]base
+}
+
+// NewFixedBaseTableG2 precomputes a FixedBaseTableG2 for base
+func NewFixedBaseTableG2(base *G2Affine) *FixedBaseTableG2 {
+	nbChunks := int(computeNbChunks(fixedBaseC))
+	nbDigits := 1 << (fixedBaseC - 1)
+
+	// [2^{c*j}]base for each window j
+	var p G2Jac
+	p.FromAffine(base)
+	windows := make([]G2Jac, nbChunks)
+	for j := range windows {
+		if j != 0 {
+			for k := 0; k < fixedBaseC; k++ {
+				p.DoubleAssign()
+			}
+		}
+		windows[j].Set(&p)
+	}
+
+	multiples := make([]G2Jac, nbChunks*nbDigits)
+	parallel.Execute(nbChunks, func(start, end int) {
+		for j := start; j < end; j++ {
+			w := multiples[j*nbDigits : (j+1)*nbDigits]
+			w[0].Set(&windows[j])
+			for d := 1; d < nbDigits; d++ {
+				w[d].Set(&w[d-1]).AddAssign(&windows[j])
+			}
+		}
+	})
+
+	return &FixedBaseTableG2{points: BatchJacobianToAffineG2(multiples)}
+}
+
+// Base returns the base the table was built from
+func (t *FixedBaseTableG2) Base() G2Affine {
+	return t.points[0]
+}
+
+var g2BaseTable struct {
+	once  sync.Once
+	table *FixedBaseTableG2
+}
+
+// getG2BaseTable returns the FixedBaseTableG2 of the generator of the prime
+// subgroup, built on the first call. It is used by ScalarMultiplicationBase.
+func getG2BaseTable() *FixedBaseTableG2 {
+	g2BaseTable.once.Do(func() {
+		g2BaseTable.table = NewFixedBaseTableG2(&g2GenAff)
+	})
+	return g2BaseTable.table
+}
+
+// ScalarMultiplicationFixedBase computes and returns p = [s]base, where base is the base of table.
+//
+// s is reduced modulo r, the base must be in the r-torsion subgroup.
+// The running time depends on s; it is not constant-time.
+func (p *G2Affine) ScalarMultiplicationFixedBase(table *FixedBaseTableG2, s *big.Int) *G2Affine {
+	var _p G2Jac
+	_p.ScalarMultiplicationFixedBase(table, s)
+	p.FromJacobian(&_p)
+	return p
+}
+
+// ScalarMultiplicationFixedBase computes and returns p = [s]base, where base is the base of table.
+//
+// s is reduced modulo r, the base must be in the r-torsion subgroup.
+// The running time depends on s; it is not constant-time.
+func (p *G2Jac) ScalarMultiplicationFixedBase(table *FixedBaseTableG2, s *big.Int) *G2Jac {
+	var e [1]fr.Element
+	e[0].SetBigInt(s)
+	digits, _ := partitionScalars(e[:], fixedBaseC, 1)
+
+	const nbDigits = 1 << (fixedBaseC - 1)
+	var acc g2JacExtended
+	acc.setInfinity()
+	for j, digit := range digits {
+		if digit == 0 {
+			continue
+		}
+		// digit&1 == 0 -> digit>>1 is the (positive) digit
+		// digit&1 == 1 -> -(digit>>1)-1 is the (negative) digit
+		if digit&1 == 0 {
+			acc.addMixed(&table.points[j*nbDigits+int(digit>>1)-1])
+		} else {
+			acc.subMixed(&table.points[j*nbDigits+int(digit>>1)])
+		}
+	}
+	return p.fromJacExtended(&acc)
+}
+
+// WriteTo writes the binary encoding of the table to w
+func (t *FixedBaseTableG2) WriteTo(w io.Writer) (int64, error) {
+	return t.writeTo(w, false)
+}
+
+// WriteRawTo writes the binary encoding of the table to w, without point compression.
+// The encoding is twice as large, but much faster to decode.
+func (t *FixedBaseTableG2) WriteRawTo(w io.Writer) (int64, error) {
+	return t.writeTo(w, true)
+}
+
+func (t *FixedBaseTableG2) writeTo(w io.Writer, raw bool) (int64, error) {
+	var flags ecc.HeaderFlag
+	var options []func(*Encoder)
+	if raw {
+		flags |= ecc.FlagRawEncoding
+		options = append(options, RawEncoding())
+	}
+	h := ecc.NewHeader(ecc.BLS24_315, ecc.ObjectFixedBaseTableG2, flags)
+	hn, err := h.WriteTo(w)
+	if err != nil {
+		return hn, err
+	}
+
+	enc := NewEncoder(w, options...)
+	toEncode := []interface{}{
+		uint64(fixedBaseC),
+		t.points,
+	}
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return hn + enc.BytesWritten(), err
+		}
+	}
+	return hn + enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes a table from r. The subgroup checks of the points are batched in a
+// single pass once the table is read.
+//
+// Note that this doesn't check that the points are multiples of the base.
+func (t *FixedBaseTableG2) ReadFrom(r io.Reader) (int64, error) {
+	return t.readFrom(r, BatchSubgroupChecks())
+}
+
+// UnsafeReadFrom decodes a table from r without checking that the points are
+// in the correct subgroup.
+func (t *FixedBaseTableG2) UnsafeReadFrom(r io.Reader) (int64, error) {
+	return t.readFrom(r, NoSubgroupChecks())
+}
+
+func (t *FixedBaseTableG2) readFrom(r io.Reader, options ...func(*Decoder)) (int64, error) {
+	h, r, hn, err := ecc.ReadHeader(r)
+	if err != nil {
+		return hn, err
+	}
+	if err := h.Check(ecc.BLS24_315, ecc.ObjectFixedBaseTableG2); err != nil {
+		return hn, err
+	}
+
+	dec := NewDecoder(r, options...)
+	var c uint64
+	for _, v := range []interface{}{&c, &t.points} {
+		if err := dec.Decode(v); err != nil {
+			return hn + dec.BytesRead(), err
+		}
+	}
+	if c != fixedBaseC || uint64(len(t.points)) != computeNbChunks(fixedBaseC)<<(fixedBaseC-1) {
+		return hn + dec.BytesRead(), errors.New("invalid table encoding")
+	}
+
+	return hn + dec.BytesRead(), dec.CheckSubGroups()
+}
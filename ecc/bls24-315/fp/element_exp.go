@@ -975,3 +975,533 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	return z
 }
+
+// expByInverseExp is equivalent to z.Exp(x, 4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff402fffff)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByInverseExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_11       = 1 + _10
+	//	_100      = 1 + _11
+	//	_101      = 1 + _100
+	//	_111      = _10 + _101
+	//	_1011     = _100 + _111
+	//	_1101     = _10 + _1011
+	//	_1111     = _10 + _1101
+	//	_10001    = _10 + _1111
+	//	_10011    = _10 + _10001
+	//	_10101    = _10 + _10011
+	//	_10111    = _10 + _10101
+	//	_11011    = _100 + _10111
+	//	_11101    = _10 + _11011
+	//	_111010   = 2*_11101
+	//	_111111   = _101 + _111010
+	//	_1001100  = _1101 + _111111
+	//	_1111110  = 2*_111111
+	//	_1111111  = 1 + _1111110
+	//	_10011000 = 2*_1001100
+	//	_11111110 = 2*_1111111
+	//	_11111111 = 1 + _11111110
+	//	i46       = ((_10011000 << 6 + _10001) << 4 + _1101) << 12
+	//	i55       = 2*((_10101 + i46) << 5 + _10101) + 1
+	//	i76       = ((i55 << 9 + _11011) << 5 + _1011) << 5
+	//	i95       = ((_101 + i76) << 8 + _1101) << 8 + _111111
+	//	i118      = ((i95 << 6 + _11101) << 7 + _10011) << 8
+	//	i132      = ((_10111 + i118) << 4 + _1101) << 7 + _10111
+	//	i149      = ((i132 << 2 + _11) << 8 + _10111) << 5
+	//	i164      = ((_10101 + i149) << 7 + _111111) << 5 + _1111
+	//	i190      = ((i164 << 3 + _11) << 10 + _11101) << 11
+	//	i205      = ((_1101 + i190) << 4 + _101) << 8 + _10011
+	//	i223      = ((i205 << 4 + _1101) << 8 + _1101) << 4
+	//	i238      = ((_111 + i223) << 7 + 1) << 5 + 1
+	//	i259      = ((i238 << 8 + _1111) << 4 + _111) << 7
+	//	i272      = ((_10111 + i259) << 5 + _1101) << 5 + _101
+	//	i289      = ((i272 << 7 + _10101) << 5 + _11101) << 3
+	//	i308      = ((_111 + i289) << 5 + _101) << 11 + _10011
+	//	i330      = ((i308 << 8 + _1111111) << 2 + 1) << 10
+	//	i344      = ((1 + i330) << 9 + _11111111) << 2 + 1
+	//	i372      = ((i344 << 9 + 1) << 9 + _11111111) << 8
+	//	return      (_11111111 + i372) << 4 + _1111
+	//
+	// Operations: 312 squares 66 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14 Element
+	// Step 1: t0 = x^0x2
+	t0.Square(&x)
+
+	// Step 2: t9 = x^0x3
+	t9.Mul(&x, t0)
+
+	// Step 3: t1 = x^0x4
+	t1.Mul(&x, t9)
+
+	// Step 4: t3 = x^0x5
+	t3.Mul(&x, t1)
+
+	// Step 5: t4 = x^0x7
+	t4.Mul(t0, t3)
+
+	// Step 6: t11 = x^0xb
+	t11.Mul(t1, t4)
+
+	// Step 7: t7 = x^0xd
+	t7.Mul(t0, t11)
+
+	// Step 8: z = x^0xf
+	z.Mul(t0, t7)
+
+	// Step 9: t13 = x^0x11
+	t13.Mul(t0, z)
+
+	// Step 10: t2 = x^0x13
+	t2.Mul(t0, t13)
+
+	// Step 11: t6 = x^0x15
+	t6.Mul(t0, t2)
+
+	// Step 12: t8 = x^0x17
+	t8.Mul(t0, t6)
+
+	// Step 13: t12 = x^0x1b
+	t12.Mul(t1, t8)
+
+	// Step 14: t5 = x^0x1d
+	t5.Mul(t0, t12)
+
+	// Step 15: t0 = x^0x3a
+	t0.Square(t5)
+
+	// Step 16: t10 = x^0x3f
+	t10.Mul(t3, t0)
+
+	// Step 17: t0 = x^0x4c
+	t0.Mul(t7, t10)
+
+	// Step 18: t1 = x^0x7e
+	t1.Square(t10)
+
+	// Step 19: t1 = x^0x7f
+	t1.Mul(&x, t1)
+
+	// Step 20: t14 = x^0x98
+	t14.Square(t0)
+
+	// Step 21: t0 = x^0xfe
+	t0.Square(t1)
+
+	// Step 22: t0 = x^0xff
+	t0.Mul(&x, t0)
+
+	// Step 28: t14 = x^0x2600
+	for s := 0; s < 6; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 29: t13 = x^0x2611
+	t13.Mul(t13, t14)
+
+	// Step 33: t13 = x^0x26110
+	for s := 0; s < 4; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 34: t13 = x^0x2611d
+	t13.Mul(t7, t13)
+
+	// Step 46: t13 = x^0x2611d000
+	for s := 0; s < 12; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 47: t13 = x^0x2611d015
+	t13.Mul(t6, t13)
+
+	// Step 52: t13 = x^0x4c23a02a0
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 53: t13 = x^0x4c23a02b5
+	t13.Mul(t6, t13)
+
+	// Step 54: t13 = x^0x98474056a
+	t13.Square(t13)
+
+	// Step 55: t13 = x^0x98474056b
+	t13.Mul(&x, t13)
+
+	// Step 64: t13 = x^0x1308e80ad600
+	for s := 0; s < 9; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 65: t12 = x^0x1308e80ad61b
+	t12.Mul(t12, t13)
+
+	// Step 70: t12 = x^0x2611d015ac360
+	for s := 0; s < 5; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 71: t11 = x^0x2611d015ac36b
+	t11.Mul(t11, t12)
+
+	// Step 76: t11 = x^0x4c23a02b586d60
+	for s := 0; s < 5; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 77: t11 = x^0x4c23a02b586d65
+	t11.Mul(t3, t11)
+
+	// Step 85: t11 = x^0x4c23a02b586d6500
+	for s := 0; s < 8; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 86: t11 = x^0x4c23a02b586d650d
+	t11.Mul(t7, t11)
+
+	// Step 94: t11 = x^0x4c23a02b586d650d00
+	for s := 0; s < 8; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 95: t11 = x^0x4c23a02b586d650d3f
+	t11.Mul(t10, t11)
+
+	// Step 101: t11 = x^0x1308e80ad61b59434fc0
+	for s := 0; s < 6; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 102: t11 = x^0x1308e80ad61b59434fdd
+	t11.Mul(t5, t11)
+
+	// Step 109: t11 = x^0x98474056b0daca1a7ee80
+	for s := 0; s < 7; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 110: t11 = x^0x98474056b0daca1a7ee93
+	t11.Mul(t2, t11)
+
+	// Step 118: t11 = x^0x98474056b0daca1a7ee9300
+	for s := 0; s < 8; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 119: t11 = x^0x98474056b0daca1a7ee9317
+	t11.Mul(t8, t11)
+
+	// Step 123: t11 = x^0x98474056b0daca1a7ee93170
+	for s := 0; s < 4; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 124: t11 = x^0x98474056b0daca1a7ee9317d
+	t11.Mul(t7, t11)
+
+	// Step 131: t11 = x^0x4c23a02b586d650d3f7498be80
+	for s := 0; s < 7; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 132: t11 = x^0x4c23a02b586d650d3f7498be97
+	t11.Mul(t8, t11)
+
+	// Step 134: t11 = x^0x1308e80ad61b59434fdd262fa5c
+	for s := 0; s < 2; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 135: t11 = x^0x1308e80ad61b59434fdd262fa5f
+	t11.Mul(t9, t11)
+
+	// Step 143: t11 = x^0x1308e80ad61b59434fdd262fa5f00
+	for s := 0; s < 8; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 144: t11 = x^0x1308e80ad61b59434fdd262fa5f17
+	t11.Mul(t8, t11)
+
+	// Step 149: t11 = x^0x2611d015ac36b2869fba4c5f4be2e0
+	for s := 0; s < 5; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 150: t11 = x^0x2611d015ac36b2869fba4c5f4be2f5
+	t11.Mul(t6, t11)
+
+	// Step 157: t11 = x^0x1308e80ad61b59434fdd262fa5f17a80
+	for s := 0; s < 7; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 158: t10 = x^0x1308e80ad61b59434fdd262fa5f17abf
+	t10.Mul(t10, t11)
+
+	// Step 163: t10 = x^0x2611d015ac36b2869fba4c5f4be2f57e0
+	for s := 0; s < 5; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 164: t10 = x^0x2611d015ac36b2869fba4c5f4be2f57ef
+	t10.Mul(z, t10)
+
+	// Step 167: t10 = x^0x1308e80ad61b59434fdd262fa5f17abf78
+	for s := 0; s < 3; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 168: t9 = x^0x1308e80ad61b59434fdd262fa5f17abf7b
+	t9.Mul(t9, t10)
+
+	// Step 178: t9 = x^0x4c23a02b586d650d3f7498be97c5eafdec00
+	for s := 0; s < 10; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 179: t9 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d
+	t9.Mul(t5, t9)
+
+	// Step 190: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e800
+	for s := 0; s < 11; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 191: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d
+	t9.Mul(t7, t9)
+
+	// Step 195: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d0
+	for s := 0; s < 4; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 196: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d5
+	t9.Mul(t3, t9)
+
+	// Step 204: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d500
+	for s := 0; s < 8; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 205: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513
+	t9.Mul(t2, t9)
+
+	// Step 209: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d5130
+	for s := 0; s < 4; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 210: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d
+	t9.Mul(t7, t9)
+
+	// Step 218: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d00
+	for s := 0; s < 8; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 219: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d
+	t9.Mul(t7, t9)
+
+	// Step 223: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d0
+	for s := 0; s < 4; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 224: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d7
+	t9.Mul(t4, t9)
+
+	// Step 231: t9 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b80
+	for s := 0; s < 7; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 232: t9 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81
+	t9.Mul(&x, t9)
+
+	// Step 237: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d7020
+	for s := 0; s < 5; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 238: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d7021
+	t9.Mul(&x, t9)
+
+	// Step 246: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d702100
+	for s := 0; s < 8; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 247: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f
+	t9.Mul(z, t9)
+
+	// Step 251: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f0
+	for s := 0; s < 4; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 252: t9 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f7
+	t9.Mul(t4, t9)
+
+	// Step 259: t9 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b80
+	for s := 0; s < 7; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 260: t8 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b97
+	t8.Mul(t8, t9)
+
+	// Step 265: t8 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72e0
+	for s := 0; s < 5; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 266: t7 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed
+	t7.Mul(t7, t8)
+
+	// Step 271: t7 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da0
+	for s := 0; s < 5; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 272: t7 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da5
+	t7.Mul(t3, t7)
+
+	// Step 279: t7 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed280
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 280: t6 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295
+	t6.Mul(t6, t7)
+
+	// Step 285: t6 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52a0
+	for s := 0; s < 5; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 286: t5 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bd
+	t5.Mul(t5, t6)
+
+	// Step 289: t5 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295e8
+	for s := 0; s < 3; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 290: t4 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef
+	t4.Mul(t4, t5)
+
+	// Step 295: t4 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde0
+	for s := 0; s < 5; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 296: t3 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5
+	t3.Mul(t3, t4)
+
+	// Step 307: t3 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef2800
+	for s := 0; s < 11; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 308: t2 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef2813
+	t2.Mul(t2, t3)
+
+	// Step 316: t2 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef281300
+	for s := 0; s < 8; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 317: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f
+	t1.Mul(t1, t2)
+
+	// Step 319: t1 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c0843dcbb4a57bca04dfc
+	for s := 0; s < 2; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 320: t1 = x^0x98474056b0daca1a7ee9317d2f8bd5fbd83a03544f435c0843dcbb4a57bca04dfd
+	t1.Mul(&x, t1)
+
+	// Step 330: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f400
+	for s := 0; s < 10; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 331: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f401
+	t1.Mul(&x, t1)
+
+	// Step 340: t1 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe80200
+	for s := 0; s < 9; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 341: t1 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff
+	t1.Mul(t0, t1)
+
+	// Step 343: t1 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b97694af79409bfa00bfc
+	for s := 0; s < 2; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 344: t1 = x^0x1308e80ad61b59434fdd262fa5f17abf7b07406a89e86b81087b97694af79409bfa00bfd
+	t1.Mul(&x, t1)
+
+	// Step 353: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f4017fa00
+	for s := 0; s < 9; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 354: t1 = x^0x2611d015ac36b2869fba4c5f4be2f57ef60e80d513d0d70210f72ed295ef28137f4017fa01
+	t1.Mul(&x, t1)
+
+	// Step 363: t1 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff40200
+	for s := 0; s < 9; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 364: t1 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff402ff
+	t1.Mul(t0, t1)
+
+	// Step 372: t1 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff402ff00
+	for s := 0; s < 8; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 373: t0 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff402ffff
+	t0.Mul(t0, t1)
+
+	// Step 377: t0 = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff402ffff0
+	for s := 0; s < 4; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 378: z = x^0x4c23a02b586d650d3f7498be97c5eafdec1d01aa27a1ae0421ee5da52bde5026fe802ff402fffff
+	z.Mul(z, t0)
+
+	return z
+}
@@ -17,6 +17,7 @@
 package fp
 
 import (
+	"bytes"
 	"crypto/rand"
 	"encoding/json"
 	"fmt"
@@ -110,6 +111,17 @@ func BenchmarkElementInverse(b *testing.B) {
 
 }
 
+func BenchmarkElementInverseConstantTime(b *testing.B) {
+	var x Element
+	x.SetRandom()
+	benchResElement.SetRandom()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		benchResElement.InverseConstantTime(&x)
+	}
+}
+
 func BenchmarkElementButterfly(b *testing.B) {
 	var x Element
 	x.SetRandom()
@@ -264,6 +276,36 @@ func TestElementCmp(t *testing.T) {
 		t.Fatal("x < y")
 	}
 }
+
+func TestElementSetRandomFrom(t *testing.T) {
+	// the same stream of randomness gives the same elements
+	// (with enough bytes for the rejection sampling)
+	seed := make([]byte, 1<<14)
+	if _, err := rand.Read(seed); err != nil {
+		t.Fatal(err)
+	}
+	var x, y Element
+	r1, r2 := bytes.NewReader(seed), bytes.NewReader(seed)
+	for i := 0; i < 10; i++ {
+		if _, err := x.SetRandomFrom(r1); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := y.SetRandomFrom(r2); err != nil {
+			t.Fatal(err)
+		}
+		if !x.Equal(&y) {
+			t.Fatal("SetRandomFrom isn't deterministic")
+		}
+		if !x.smallerThanModulus() {
+			t.Fatal("SetRandomFrom returned a value larger than the modulus")
+		}
+	}
+
+	// errors of the reader are returned
+	if _, err := x.SetRandomFrom(bytes.NewReader(nil)); err == nil {
+		t.Fatal("expected an error, the reader is empty")
+	}
+}
 func TestElementIsRandom(t *testing.T) {
 	for i := 0; i < 50; i++ {
 		var x, y Element
@@ -502,6 +544,33 @@ func TestElementInverseExp(t *testing.T) {
 
 }
 
+func TestElementInverseConstantTime(t *testing.T) {
+	invCTMatchInv := func(a testPairElement) bool {
+		var b Element
+		b.InverseConstantTime(&a.element)
+		a.element.Inverse(&a.element)
+
+		return a.element.Equal(&b)
+	}
+
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+	properties := gopter.NewProperties(parameters)
+	genA := gen()
+	properties.Property("InverseConstantTime == Inverse", prop.ForAll(invCTMatchInv, genA))
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	parameters.MinSuccessfulTests = 1
+	properties = gopter.NewProperties(parameters)
+	properties.Property("InverseConstantTime(0) == 0", prop.ForAll(invCTMatchInv, ggen.OneConstOf(testPairElement{})))
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
 func mulByConstant(z *Element, c uint8) {
 	var y Element
 	y.SetUint64(uint64(c))
@@ -21,23 +21,45 @@ import (
 	"runtime"
 
 	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
 )
 
 // BitReverse applies the bit-reversal permutation to v.
 // len(v) must be a power of 2
-func BitReverse(v []fr.Element) {
+//
+// Large inputs are permuted in parallel; nbTasks (default runtime.NumCPU()) sets the maximum
+// number of tasks.
+func BitReverse(v []fr.Element, nbTasks ...int) {
 	n := uint64(len(v))
 	if bits.OnesCount64(n) != 1 {
 		panic("len(a) must be a power of 2")
 	}
 
-	if runtime.GOARCH == "arm64" {
-		bitReverseNaive(v)
+	tasks := runtime.NumCPU()
+	if len(nbTasks) == 1 && nbTasks[0] > 0 {
+		tasks = nbTasks[0]
+	}
+	if tasks == 1 || n < minSizeParallelBitReverse {
+		if runtime.GOARCH == "arm64" {
+			bitReverseNaive(v)
+		} else {
+			bitReverseCobra(v)
+		}
+		return
+	}
+
+	if runtime.GOARCH == "arm64" || n < 1<<21 {
+		bitReverseNaiveParallel(v, tasks)
 	} else {
-		bitReverseCobra(v)
+		bitReverseCobraParallel(v, tasks)
 	}
 }
 
+// minSizeParallelBitReverse is the size under which the bit-reversal permutation
+// is not worth parallelizing.
+const minSizeParallelBitReverse = 1 << 14
+
 // bitReverseNaive applies the bit-reversal permutation to v.
 // len(v) must be a power of 2
 func bitReverseNaive(v []fr.Element) {
@@ -52,6 +74,25 @@ func bitReverseNaive(v []fr.Element) {
 	}
 }
 
+// bitReverseNaiveParallel applies the bit-reversal permutation to v, with up to nbTasks tasks.
+// len(v) must be a power of 2
+//
+// Each pair (i, iRev) is swapped by the task owning min(i, iRev), so the tasks write to
+// disjoint elements.
+func bitReverseNaiveParallel(v []fr.Element, nbTasks int) {
+	n := uint64(len(v))
+	nn := uint64(64 - bits.TrailingZeros64(n))
+
+	parallel.Execute(len(v), func(start, end int) {
+		for i := uint64(start); i < uint64(end); i++ {
+			iRev := bits.Reverse64(i) >> nn
+			if iRev > i {
+				v[i], v[iRev] = v[iRev], v[i]
+			}
+		}
+	}, nbTasks)
+}
+
 // bitReverseCobraInPlace applies the bit-reversal permutation to v.
 // len(v) must be a power of 2
 // This is derived from:
@@ -73,7 +114,6 @@ func bitReverseCobraInPlace(v []fr.Element) {
 	logTileSize := deriveLogTileSize(logN)
 	logBLen := logN - 2*logTileSize
 	bLen := uint64(1) << logBLen
-	bShift := logBLen + logTileSize
 	tileSize := uint64(1) << logTileSize
 
 	// rough idea;
@@ -100,40 +140,76 @@ func bitReverseCobraInPlace(v []fr.Element) {
 	// see https://csaws.cs.technion.ac.il/~itai/Courses/Cache/bit.pdf
 	// for a detailed explanation of the algorithm.
 	for b := uint64(0); b < bLen; b++ {
+		bitReverseCobraBlock(v, t, b, logBLen, logTileSize)
+	}
+}
 
-		for a := uint64(0); a < tileSize; a++ {
-			aRev := (bits.Reverse64(a) >> (64 - logTileSize)) << logTileSize
-			for c := uint64(0); c < tileSize; c++ {
-				idx := (a << bShift) | (b << logTileSize) | c
-				t[aRev|c] = v[idx]
+// bitReverseCobraParallel applies the bit-reversal permutation to v, with up to nbTasks tasks.
+// len(v) must be a power of 2
+//
+// The swaps of the block b of bitReverseCobraInPlace (middle bits of the indices equal to b) are
+// with the elements of the block bRev; the tasks process the pairs of blocks {b, bRev}, which are
+// disjoint, each with its own t buffer.
+func bitReverseCobraParallel(v []fr.Element, nbTasks int) {
+	logN := uint64(bits.Len64(uint64(len(v))) - 1)
+	logTileSize := deriveLogTileSize(logN)
+	logBLen := logN - 2*logTileSize
+	bLen := uint64(1) << logBLen
+	tileSize := uint64(1) << logTileSize
+
+	parallel.Execute(int(bLen), func(start, end int) {
+		t := make([]fr.Element, tileSize*tileSize)
+		for b := uint64(start); b < uint64(end); b++ {
+			bRev := bits.Reverse64(b) >> (64 - logBLen)
+			if bRev < b {
+				continue
+			}
+			bitReverseCobraBlock(v, t, b, logBLen, logTileSize)
+			if bRev != b {
+				bitReverseCobraBlock(v, t, bRev, logBLen, logTileSize)
 			}
 		}
+	}, nbTasks)
+}
 
-		bRev := (bits.Reverse64(b) >> (64 - logBLen)) << logTileSize
+// bitReverseCobraBlock performs the swaps of the block b of bitReverseCobraInPlace,
+// using t (of size tileSize²) as a buffer.
+func bitReverseCobraBlock(v, t []fr.Element, b, logBLen, logTileSize uint64) {
+	bShift := logBLen + logTileSize
+	tileSize := uint64(1) << logTileSize
 
+	for a := uint64(0); a < tileSize; a++ {
+		aRev := (bits.Reverse64(a) >> (64 - logTileSize)) << logTileSize
 		for c := uint64(0); c < tileSize; c++ {
-			cRev := ((bits.Reverse64(c) >> (64 - logTileSize)) << bShift) | bRev
-			for aRev := uint64(0); aRev < tileSize; aRev++ {
-				a := bits.Reverse64(aRev) >> (64 - logTileSize)
-				idx := (a << bShift) | (b << logTileSize) | c
-				idxRev := cRev | aRev
-				if idx < idxRev {
-					tIdx := (aRev << logTileSize) | c
-					v[idxRev], t[tIdx] = t[tIdx], v[idxRev]
-				}
+			idx := (a << bShift) | (b << logTileSize) | c
+			t[aRev|c] = v[idx]
+		}
+	}
+
+	bRev := (bits.Reverse64(b) >> (64 - logBLen)) << logTileSize
+
+	for c := uint64(0); c < tileSize; c++ {
+		cRev := ((bits.Reverse64(c) >> (64 - logTileSize)) << bShift) | bRev
+		for aRev := uint64(0); aRev < tileSize; aRev++ {
+			a := bits.Reverse64(aRev) >> (64 - logTileSize)
+			idx := (a << bShift) | (b << logTileSize) | c
+			idxRev := cRev | aRev
+			if idx < idxRev {
+				tIdx := (aRev << logTileSize) | c
+				v[idxRev], t[tIdx] = t[tIdx], v[idxRev]
 			}
 		}
+	}
 
-		for a := uint64(0); a < tileSize; a++ {
-			aRev := bits.Reverse64(a) >> (64 - logTileSize)
-			for c := uint64(0); c < tileSize; c++ {
-				cRev := (bits.Reverse64(c) >> (64 - logTileSize)) << bShift
-				idx := (a << bShift) | (b << logTileSize) | c
-				idxRev := cRev | bRev | aRev
-				if idx < idxRev {
-					tIdx := (aRev << logTileSize) | c
-					v[idx], t[tIdx] = t[tIdx], v[idx]
-				}
+	for a := uint64(0); a < tileSize; a++ {
+		aRev := bits.Reverse64(a) >> (64 - logTileSize)
+		for c := uint64(0); c < tileSize; c++ {
+			cRev := (bits.Reverse64(c) >> (64 - logTileSize)) << bShift
+			idx := (a << bShift) | (b << logTileSize) | c
+			idxRev := cRev | bRev | aRev
+			if idx < idxRev {
+				tIdx := (aRev << logTileSize) | c
+				v[idx], t[tIdx] = t[tIdx], v[idx]
 			}
 		}
 	}
@@ -62,6 +62,27 @@ type Domain struct {
 
 	// cosetTable[i][j] = domain.Generator(i-th)SqrtInv ^ j
 	cosetTableInv []fr.Element
+
+	// tables computed on demand, see RootsOfUnity, RootsOfUnityInv, ShiftPowers and ShiftPowersInv
+	tables *domainTables
+}
+
+// domainTables holds the tables of a domain that are computed on the first use
+type domainTables struct {
+	roots, rootsInv, shift, shiftInv lazyTable
+}
+
+type lazyTable struct {
+	once  sync.Once
+	table []fr.Element
+}
+
+// get returns the table, computing it with build on the first call
+func (t *lazyTable) get(build func() []fr.Element) []fr.Element {
+	t.once.Do(func() {
+		t.table = build()
+	})
+	return t.table
 }
 
 // NewDomain returns a subgroup with a power of 2 cardinality
@@ -69,7 +90,7 @@ type Domain struct {
 // shift: when specified, it's the element by which the set of root of unity is shifted.
 func NewDomain(m uint64, opts ...DomainOption) *Domain {
 	opt := domainOptions(opts...)
-	domain := &Domain{}
+	domain := &Domain{tables: new(domainTables)}
 	x := ecc.NextPowerOfTwo(m)
 	domain.Cardinality = uint64(x)
 
@@ -82,9 +103,16 @@ func NewDomain(m uint64, opts ...DomainOption) *Domain {
 	}
 	domain.FrMultiplicativeGenInv.Inverse(&domain.FrMultiplicativeGen)
 
-	var err error
-	domain.Generator, err = Generator(m)
-	if err != nil {
+	if opt.generator != nil {
+		domain.Generator.Set(opt.generator)
+	} else {
+		var err error
+		domain.Generator, err = Generator(m)
+		if err != nil {
+			panic(err)
+		}
+	}
+	if err := domain.checkGenerators(); err != nil {
 		panic(err)
 	}
 	domain.GeneratorInv.Inverse(&domain.Generator)
@@ -99,6 +127,34 @@ func NewDomain(m uint64, opts ...DomainOption) *Domain {
 	return domain
 }
 
+var (
+	ErrNotPrimitiveRoot = errors.New("the generator of the domain is not a primitive root of unity of order the cardinality")
+	ErrInvalidShift     = errors.New("the coset of the shift is not disjoint from the domain")
+)
+
+// checkGenerators checks that d.Generator is a primitive root of unity of order d.Cardinality,
+// and that d.FrMultiplicativeGen is not zero and not in <d.Generator>, so that the coset
+// FrMultiplicativeGen*<Generator> is disjoint from the domain.
+func (d *Domain) checkGenerators() error {
+	n := big.NewInt(int64(d.Cardinality))
+	var t fr.Element
+	if t.Exp(d.Generator, n); !t.IsOne() {
+		return ErrNotPrimitiveRoot
+	}
+	if d.Cardinality > 1 {
+		if t.Exp(d.Generator, n.Rsh(n, 1)); t.IsOne() {
+			return ErrNotPrimitiveRoot
+		}
+	}
+	if d.FrMultiplicativeGen.IsZero() {
+		return ErrInvalidShift
+	}
+	if t.Exp(d.FrMultiplicativeGen, big.NewInt(int64(d.Cardinality))); t.IsOne() {
+		return ErrInvalidShift
+	}
+	return nil
+}
+
 // Generator returns a generator for Z/2^(log(m))Z
 // or an error if m is too big (required root of unity doesn't exist)
 func Generator(m uint64) (fr.Element, error) {
@@ -141,6 +197,63 @@ func (d *Domain) CosetTableInv() ([]fr.Element, error) {
 	return d.cosetTableInv, nil
 }
 
+// RootsOfUnity returns the elements <1,ω,..,ω^(n-1)> of the domain, where ω is d.Generator.
+//
+// The table is computed on the first call and cached on the domain, regardless of the
+// WithoutPrecompute option; it is shared by all the callers and must not be modified.
+func (d *Domain) RootsOfUnity() []fr.Element {
+	return d.expTable(d.Generator, func(t *domainTables) *lazyTable { return &t.roots })
+}
+
+// RootsOfUnityInv returns the inverses <1,ω⁻¹,..,ω^-(n-1)> of the elements of the domain,
+// where ω is d.Generator.
+//
+// The table is computed on the first call and cached on the domain, regardless of the
+// WithoutPrecompute option; it is shared by all the callers and must not be modified.
+func (d *Domain) RootsOfUnityInv() []fr.Element {
+	return d.expTable(d.GeneratorInv, func(t *domainTables) *lazyTable { return &t.rootsInv })
+}
+
+// ShiftPowers returns the n first powers [1,u,..,u^(n-1)] of the shift u = d.FrMultiplicativeGen,
+// n being the cardinality of the domain: uⁱ*<ω> is the i-th coset of the domain, and the FFTs on
+// the coset u*<ω> scale the i-th coefficient by uⁱ.
+//
+// The table is the coset table when the domain is precomputed, otherwise it is computed on
+// the first call and cached on the domain; it is shared by all the callers and must not be modified.
+func (d *Domain) ShiftPowers() []fr.Element {
+	if d.cosetTable != nil {
+		return d.cosetTable
+	}
+	return d.expTable(d.FrMultiplicativeGen, func(t *domainTables) *lazyTable { return &t.shift })
+}
+
+// ShiftPowersInv returns the n first powers [1,u⁻¹,..,u^-(n-1)] of the inverse of the shift
+// u = d.FrMultiplicativeGen, n being the cardinality of the domain.
+//
+// The table is the inverse coset table when the domain is precomputed, otherwise it is computed on
+// the first call and cached on the domain; it is shared by all the callers and must not be modified.
+func (d *Domain) ShiftPowersInv() []fr.Element {
+	if d.cosetTableInv != nil {
+		return d.cosetTableInv
+	}
+	return d.expTable(d.FrMultiplicativeGenInv, func(t *domainTables) *lazyTable { return &t.shiftInv })
+}
+
+// expTable returns the n first powers of w, cached in the table selected by table
+// when the domain was built by NewDomain or ReadFrom.
+func (d *Domain) expTable(w fr.Element, table func(*domainTables) *lazyTable) []fr.Element {
+	build := func() []fr.Element {
+		res := make([]fr.Element, d.Cardinality)
+		BuildExpTable(w, res)
+		return res
+	}
+	if d.tables == nil {
+		// nowhere to cache the table
+		return build()
+	}
+	return table(d.tables).get(build)
+}
+
 func (d *Domain) preComputeTwiddles() {
 
 	// nb fft stages
@@ -250,37 +363,60 @@ func precomputeExpTableChunk(w fr.Element, power uint64, table []fr.Element) {
 
 // WriteTo writes a binary representation of the domain (without the precomputed twiddle factors)
 // to the provided writer
+//
+// The encoding starts with an ecc.Header identifying the curve and the object type.
 func (d *Domain) WriteTo(w io.Writer) (int64, error) {
 
+	h := ecc.NewHeader(ecc.BLS24_315, ecc.ObjectDomain, 0)
+	hn, err := h.WriteTo(w)
+	if err != nil {
+		return hn, err
+	}
+
 	enc := curve.NewEncoder(w)
 
 	toEncode := []interface{}{d.Cardinality, &d.CardinalityInv, &d.Generator, &d.GeneratorInv, &d.FrMultiplicativeGen, &d.FrMultiplicativeGenInv, &d.withPrecompute}
 
 	for _, v := range toEncode {
 		if err := enc.Encode(v); err != nil {
-			return enc.BytesWritten(), err
+			return hn + enc.BytesWritten(), err
 		}
 	}
 
-	return enc.BytesWritten(), nil
+	return hn + enc.BytesWritten(), nil
 }
 
 // ReadFrom attempts to decode a domain from Reader
+//
+// Domains serialized without an ecc.Header (legacy encoding) are accepted.
 func (d *Domain) ReadFrom(r io.Reader) (int64, error) {
 
+	h, r, hn, err := ecc.ReadHeader(r)
+	if err != nil {
+		return hn, err
+	}
+	if err := h.Check(ecc.BLS24_315, ecc.ObjectDomain); err != nil {
+		return hn, err
+	}
+
 	dec := curve.NewDecoder(r)
 
+	d.tables = new(domainTables)
 	toDecode := []interface{}{&d.Cardinality, &d.CardinalityInv, &d.Generator, &d.GeneratorInv, &d.FrMultiplicativeGen, &d.FrMultiplicativeGenInv, &d.withPrecompute}
 
 	for _, v := range toDecode {
 		if err := dec.Decode(v); err != nil {
-			return dec.BytesRead(), err
+			return hn + dec.BytesRead(), err
 		}
 	}
 
+	if err := d.checkGenerators(); err != nil {
+		return hn + dec.BytesRead(), err
+	}
+
 	if d.withPrecompute {
 		d.preComputeTwiddles()
 	}
 
-	return dec.BytesRead(), nil
+	return hn + dec.BytesRead(), nil
 }
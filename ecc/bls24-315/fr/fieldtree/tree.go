@@ -0,0 +1,275 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fieldtree
+
+import (
+	"bytes"
+	"errors"
+	"hash"
+	"math/bits"
+	"sort"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+)
+
+var (
+	ErrNbLeaves        = errors.New("the number of leaves must be a positive power of two")
+	ErrIndexOutOfRange = errors.New("the index of the leaf is out of range")
+	ErrInvalidProof    = errors.New("the Merkle proof is invalid")
+)
+
+// Tree is a Merkle tree whose leaves are vectors of field elements. It stores the hashes of all
+// its nodes, but not the leaves.
+type Tree struct {
+	h hash.Hash
+
+	// levels[0] are the hashes of the leaves, levels[len(levels)-1] = [root]
+	levels [][][]byte
+}
+
+// Proof is the Merkle path of a leaf
+type Proof struct {
+	// Index of the leaf
+	Index uint64
+
+	// Path hashes of the siblings of the nodes on the path from the leaf to the root, starting
+	// with the sibling of the leaf
+	Path [][]byte
+}
+
+// BatchProof is the Merkle path of several leaves, where the nodes shared by several paths, or
+// computable from the opened leaves, appear only once
+type BatchProof struct {
+	// Indices of the leaves
+	Indices []uint64
+
+	// Nodes hashes of the nodes needed to compute the root, which can't be computed from the
+	// opened leaves; level by level starting from the leaves, and by increasing position in a level
+	Nodes [][]byte
+}
+
+// New returns the Merkle tree of the given leaves, whose number must be a power of two.
+func New(h hash.Hash, leaves [][]fr.Element) (*Tree, error) {
+	return NewFromIterator(h, len(leaves), func(i int) []fr.Element { return leaves[i] })
+}
+
+// NewFromIterator returns the Merkle tree of nbLeaves leaves, a power of two, where leaf(i)
+// returns the i-th leaf. leaf is called once per leaf, by increasing index, and the returned
+// slice is not retained, so that it can be reused from one call to the next.
+func NewFromIterator(h hash.Hash, nbLeaves int, leaf func(i int) []fr.Element) (*Tree, error) {
+	if nbLeaves <= 0 || nbLeaves&(nbLeaves-1) != 0 {
+		return nil, ErrNbLeaves
+	}
+
+	level := make([][]byte, nbLeaves)
+	var buf []byte
+	for i := range level {
+		buf = appendLeaf(buf[:0], leaf(i))
+		level[i] = sum(h, buf)
+	}
+
+	t := &Tree{h: h, levels: [][][]byte{level}}
+	for len(level) > 1 {
+		next := make([][]byte, len(level)/2)
+		for i := range next {
+			next[i] = sum(h, level[2*i], level[2*i+1])
+		}
+		t.levels = append(t.levels, next)
+		level = next
+	}
+	return t, nil
+}
+
+// Root returns the Merkle root of the tree
+func (t *Tree) Root() []byte {
+	return t.levels[len(t.levels)-1][0]
+}
+
+// NbLeaves returns the number of leaves of the tree
+func (t *Tree) NbLeaves() uint64 {
+	return uint64(len(t.levels[0]))
+}
+
+// Open returns the Merkle path of the leaf at index
+func (t *Tree) Open(index uint64) (Proof, error) {
+	if index >= t.NbLeaves() {
+		return Proof{}, ErrIndexOutOfRange
+	}
+	res := Proof{Index: index, Path: make([][]byte, len(t.levels)-1)}
+	for l := range res.Path {
+		res.Path[l] = t.levels[l][index^1]
+		index >>= 1
+	}
+	return res, nil
+}
+
+// BatchOpen returns the Merkle paths of the leaves at indices, which can be in any order and
+// contain duplicates.
+func (t *Tree) BatchOpen(indices []uint64) (BatchProof, error) {
+	positions := make([]uint64, len(indices))
+	for i, index := range indices {
+		if index >= t.NbLeaves() {
+			return BatchProof{}, ErrIndexOutOfRange
+		}
+		positions[i] = index
+	}
+	positions = sortUnique(positions)
+
+	res := BatchProof{Indices: make([]uint64, len(indices))}
+	copy(res.Indices, indices)
+	for l := 0; l < len(t.levels)-1; l++ {
+		parents := positions[:0]
+		for i := 0; i < len(positions); i++ {
+			p := positions[i]
+			if p&1 == 0 && i+1 < len(positions) && positions[i+1] == p+1 {
+				// both children are known
+				i++
+			} else {
+				res.Nodes = append(res.Nodes, t.levels[l][p^1])
+			}
+			parents = append(parents, p>>1)
+		}
+		positions = parents
+	}
+	return res, nil
+}
+
+// Verify checks that leaf is the leaf at proof.Index of the Merkle tree of nbLeaves leaves
+// whose root is root.
+func Verify(h hash.Hash, root []byte, leaf []fr.Element, proof Proof, nbLeaves uint64) error {
+	if nbLeaves == 0 || nbLeaves&(nbLeaves-1) != 0 || proof.Index >= nbLeaves ||
+		len(proof.Path) != bits.TrailingZeros64(nbLeaves) {
+		return ErrInvalidProof
+	}
+
+	node := sum(h, appendLeaf(nil, leaf))
+	index := proof.Index
+	for _, sibling := range proof.Path {
+		if index&1 == 0 {
+			node = sum(h, node, sibling)
+		} else {
+			node = sum(h, sibling, node)
+		}
+		index >>= 1
+	}
+	if !bytes.Equal(node, root) {
+		return ErrInvalidProof
+	}
+	return nil
+}
+
+// BatchVerify checks that leaves[i] is the leaf at proof.Indices[i] of the Merkle tree of
+// nbLeaves leaves whose root is root, for all i.
+func BatchVerify(h hash.Hash, root []byte, leaves [][]fr.Element, proof BatchProof, nbLeaves uint64) error {
+	if nbLeaves == 0 || nbLeaves&(nbLeaves-1) != 0 || len(leaves) == 0 || len(leaves) != len(proof.Indices) {
+		return ErrInvalidProof
+	}
+
+	// hashes of the opened leaves, by increasing position
+	type node struct {
+		position uint64
+		hash     []byte
+	}
+	known := make([]node, len(leaves))
+	for i := range leaves {
+		if proof.Indices[i] >= nbLeaves {
+			return ErrInvalidProof
+		}
+		known[i] = node{position: proof.Indices[i], hash: sum(h, appendLeaf(nil, leaves[i]))}
+	}
+	sort.Slice(known, func(i, j int) bool { return known[i].position < known[j].position })
+	unique := known[:1]
+	for _, n := range known[1:] {
+		last := &unique[len(unique)-1]
+		if n.position != last.position {
+			unique = append(unique, n)
+		} else if !bytes.Equal(n.hash, last.hash) {
+			// the same leaf is opened to two different values
+			return ErrInvalidProof
+		}
+	}
+	known = unique
+
+	nodes := proof.Nodes
+	for l := bits.TrailingZeros64(nbLeaves); l > 0; l-- {
+		parents := known[:0]
+		for i := 0; i < len(known); i++ {
+			p := known[i]
+			var left, right []byte
+			if p.position&1 == 0 && i+1 < len(known) && known[i+1].position == p.position+1 {
+				left, right = p.hash, known[i+1].hash
+				i++
+			} else {
+				if len(nodes) == 0 {
+					return ErrInvalidProof
+				}
+				if p.position&1 == 0 {
+					left, right = p.hash, nodes[0]
+				} else {
+					left, right = nodes[0], p.hash
+				}
+				nodes = nodes[1:]
+			}
+			parents = append(parents, node{position: p.position >> 1, hash: sum(h, left, right)})
+		}
+		known = parents
+	}
+
+	if len(nodes) != 0 || !bytes.Equal(known[0].hash, root) {
+		return ErrInvalidProof
+	}
+	return nil
+}
+
+// LeafBytes returns the data hashed for a leaf: the concatenation of the big endian encodings
+// of its elements
+func LeafBytes(leaf []fr.Element) []byte {
+	return appendLeaf(make([]byte, 0, len(leaf)*fr.Bytes), leaf)
+}
+
+// appendLeaf appends LeafBytes(leaf) to buf
+func appendLeaf(buf []byte, leaf []fr.Element) []byte {
+	for i := range leaf {
+		b := leaf[i].Bytes()
+		buf = append(buf, b[:]...)
+	}
+	return buf
+}
+
+// sum returns the hash of the concatenation of data
+func sum(h hash.Hash, data ...[]byte) []byte {
+	h.Reset()
+	for _, d := range data {
+		// the Hash interface specifies that Write never returns an error
+		if _, err := h.Write(d); err != nil {
+			panic(err)
+		}
+	}
+	return h.Sum(nil)
+}
+
+// sortUnique sorts v in place and removes the duplicates
+func sortUnique(v []uint64) []uint64 {
+	sort.Slice(v, func(i, j int) bool { return v[i] < v[j] })
+	res := v[:0]
+	for i := range v {
+		if i == 0 || v[i] != v[i-1] {
+			res = append(res, v[i])
+		}
+	}
+	return res
+}
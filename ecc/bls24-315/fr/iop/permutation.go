@@ -0,0 +1,146 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package iop
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr/fft"
+)
+
+var ErrWireOutOfRange = errors.New("the wire is out of the range of the permutation")
+
+// Wire identifies the evaluation at ωᴵⁿᵈᵉˣ of the Polynomial-th polynomial of a
+// Permutation.
+type Wire struct {
+	Polynomial, Index int
+}
+
+// Permutation σ acting on the evaluations of nbPolynomials polynomials of the same size n,
+// built from copy constraints: the wires that must hold the same value are gathered in a
+// cycle of σ.
+//
+// The wire (j, i) is numbered j*n+i, as in BuildRatioCopyConstraint: σ(j*n+i) = k*n+l means
+// that σ maps the evaluation at ωⁱ of the j-th polynomial to the evaluation at ωˡ of the
+// k-th polynomial.
+type Permutation struct {
+	nbPolynomials, size int
+
+	// parent is the union-find forest of the copy constraints
+	parent []int
+}
+
+// NewPermutation returns the identity permutation on the evaluations of nbPolynomials
+// polynomials of the given size.
+func NewPermutation(nbPolynomials, size int) *Permutation {
+	p := &Permutation{
+		nbPolynomials: nbPolynomials,
+		size:          size,
+		parent:        make([]int, nbPolynomials*size),
+	}
+	for i := range p.parent {
+		p.parent[i] = i
+	}
+	return p
+}
+
+// AddCycle constrains wires to hold the same value. The constraints are transitive:
+// wires sharing a wire with a previous cycle are merged with it.
+func (p *Permutation) AddCycle(wires ...Wire) error {
+	ids := make([]int, len(wires))
+	for i, w := range wires {
+		if w.Polynomial < 0 || w.Polynomial >= p.nbPolynomials || w.Index < 0 || w.Index >= p.size {
+			return ErrWireOutOfRange
+		}
+		ids[i] = w.Polynomial*p.size + w.Index
+	}
+	for i := 1; i < len(ids); i++ {
+		a, b := p.find(ids[0]), p.find(ids[i])
+		if a != b {
+			p.parent[b] = a
+		}
+	}
+	return nil
+}
+
+// find returns the representative of the cycle of the wire id
+func (p *Permutation) find(id int) int {
+	for p.parent[id] != id {
+		p.parent[id] = p.parent[p.parent[id]]
+		id = p.parent[id]
+	}
+	return id
+}
+
+// Sigma returns σ, in the format expected by BuildRatioCopyConstraint. Each cycle
+// goes through its wires by increasing number.
+func (p *Permutation) Sigma() []int64 {
+	cycles := make(map[int][]int)
+	for id := range p.parent {
+		r := p.find(id)
+		cycles[r] = append(cycles[r], id)
+	}
+
+	res := make([]int64, len(p.parent))
+	for _, c := range cycles {
+		// ids are appended in increasing order, keep it explicit
+		sort.Ints(c)
+		for k, id := range c {
+			res[id] = int64(c[(k+1)%len(c)])
+		}
+	}
+	return res
+}
+
+// SigmaPolynomials returns the polynomials S_σⱼ encoding σ over the support of the
+// identity permutation [1,..,ωⁿ⁻¹, g,..,g*ωⁿ⁻¹, ..] (see BuildRatioCopyConstraint), that is
+// S_σⱼ(ωⁱ) = ID(σ(j*n+i)), in expectedForm.
+func (p *Permutation) SigmaPolynomials(expectedForm Form, domain *fft.Domain) ([]*Polynomial, error) {
+	domain, err := buildDomain(p.size, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	sigma := p.Sigma()
+	id := getSupportIdentityPermutation(p.nbPolynomials, domain)
+	res := make([]*Polynomial, p.nbPolynomials)
+	for j := range res {
+		coeffs := make([]fr.Element, p.size)
+		for i := range coeffs {
+			coeffs[i] = id[sigma[j*p.size+i]]
+		}
+		res[j] = NewPolynomial(&coeffs, expectedForm)
+		putInExpectedFormFromLagrangeRegular(res[j], domain, expectedForm)
+	}
+	return res, nil
+}
+
+// BuildRatio builds the accumulating ratio of the copy constraints of p on entries,
+// see BuildRatioCopyConstraint.
+func (p *Permutation) BuildRatio(entries []*Polynomial, beta, gamma fr.Element, expectedForm Form, domain *fft.Domain) (*Polynomial, error) {
+	if len(entries) != p.nbPolynomials {
+		return nil, ErrNumberPolynomials
+	}
+	for i := range entries {
+		if entries[i].coefficients.Len() != p.size {
+			return nil, ErrInconsistentSize
+		}
+	}
+	return BuildRatioCopyConstraint(entries, p.Sigma(), beta, gamma, expectedForm, domain)
+}
@@ -157,33 +157,59 @@ func (p *Polynomial) Blind(blindingOrder int) *Polynomial {
 		(*p.coefficients)[i+p.size].Add(&(*p.coefficients)[i+p.size], &r)
 	}
 	p.blindedSize = newSize
+	p.representations = nil
 
 	return p
 }
 
-// Evaluate evaluates p at x.
-// The code panics if the function is not in canonical form.
-func (p *Polynomial) Evaluate(x fr.Element) fr.Element {
+// Evaluate evaluates p at x, whatever its form.
+//
+// In canonical basis, p is evaluated with Horner's method. In Lagrange (resp. LagrangeCoset)
+// basis, p is evaluated with the barycentric formula, without converting it to canonical basis.
+// The optional domain d is the domain on which p is evaluated (with the same cardinality as the
+// number of coefficients of p); its shift FrMultiplicativeGen defines the coset of the LagrangeCoset
+// basis. When omitted, the domain of default shift is used.
+func (p *Polynomial) Evaluate(x fr.Element, d ...*fft.Domain) fr.Element {
 
-	if p.shift == 0 {
+	x = p.shiftPoint(x)
+
+	if p.Basis == Canonical {
 		return p.polynomial.evaluate(x)
 	}
 
+	var domain *fft.Domain
+	if len(d) == 1 && d[0] != nil {
+		domain = d[0]
+	} else {
+		domain = fft.NewDomain(uint64(p.coefficients.Len()), fft.WithoutPrecompute())
+	}
+	if domain.Cardinality != uint64(p.coefficients.Len()) {
+		panic("the cardinality of the domain must match the number of coefficients")
+	}
+	if p.Basis == LagrangeCoset {
+		x.Mul(&x, &domain.FrMultiplicativeGenInv)
+	}
+	return p.polynomial.evaluateLagrange(x, domain)
+}
+
+// shiftPoint returns ωˢ*x, where ω is the generator of the roots of unity of size p.size
+// and s is the shift of p, so that p(x) is the evaluation of the underlying polynomial at ωˢ*x.
+func (p *Polynomial) shiftPoint(x fr.Element) fr.Element {
+	if p.shift == 0 {
+		return x
+	}
+	gen, err := fft.Generator(uint64(p.size))
+	if err != nil {
+		panic(err)
+	}
 	var g fr.Element
-	if p.shift <= 5 {
-		gen, err := fft.Generator(uint64(p.size))
-		if err != nil {
-			panic(err)
-		}
+	if p.shift > 0 && p.shift <= 5 {
 		g = smallExp(gen, p.shift)
-		x.Mul(&x, &g)
-		return p.polynomial.evaluate(x)
+	} else {
+		g.Exp(gen, big.NewInt(int64(p.shift)))
 	}
-
-	bs := big.NewInt(int64(p.shift))
-	g = *g.Exp(g, bs)
 	x.Mul(&x, &g)
-	return p.polynomial.evaluate(x)
+	return x
 }
 
 // Clone returns a deep copy of p. The underlying polynomial is cloned;
@@ -222,6 +248,11 @@ func (p *Polynomial) GetCoeff(i int) fr.Element {
 type polynomial struct {
 	coefficients *fr.Vector
 	Form
+
+	// representations caches other representations of the polynomial, in Regular layout,
+	// see KeepRepresentations
+	representations     map[representationKey]*Polynomial
+	keepRepresentations bool
 }
 
 // Coefficients returns a slice on the underlying data structure.
@@ -275,24 +306,32 @@ func (p *polynomial) evaluate(x fr.Element) fr.Element {
 
 }
 
+// evaluateLagrange evaluates at x the polynomial whose evaluations on <ω>
+// (the roots of unity of domain) are the coefficients of p, see BarycentricWeights.
+func (p *polynomial) evaluateLagrange(x fr.Element, domain *fft.Domain) fr.Element {
+	return p.innerProduct(lagrangeWeights(x, domain))
+}
+
 // ToRegular changes the layout of p to Regular.
 // Leaves p unchanged if p's layout was already Regular.
-func (p *Polynomial) ToRegular() *Polynomial {
+// nbTasks bounds the number of tasks of the permutation, see fft.BitReverse.
+func (p *Polynomial) ToRegular(nbTasks ...int) *Polynomial {
 	if p.Layout == Regular {
 		return p
 	}
-	fft.BitReverse((*p.coefficients))
+	fft.BitReverse((*p.coefficients), nbTasks...)
 	p.Layout = Regular
 	return p
 }
 
 // ToBitReverse changes the layout of p to BitReverse.
 // Leaves p unchanged if p's layout was already BitReverse.
-func (p *Polynomial) ToBitReverse() *Polynomial {
+// nbTasks bounds the number of tasks of the permutation, see fft.BitReverse.
+func (p *Polynomial) ToBitReverse(nbTasks ...int) *Polynomial {
 	if p.Layout == BitReverse {
 		return p
 	}
-	fft.BitReverse((*p.coefficients))
+	fft.BitReverse((*p.coefficients), nbTasks...)
 	p.Layout = BitReverse
 	return p
 }
@@ -300,6 +339,11 @@ func (p *Polynomial) ToBitReverse() *Polynomial {
 // ToLagrange converts p to Lagrange form.
 // Leaves p unchanged if p was already in Lagrange form.
 func (p *Polynomial) ToLagrange(d *fft.Domain, nbTasks ...int) *Polynomial {
+	z, v := debugCheckpoint(p, d)
+	if p.convertFromCache(d, Lagrange) {
+		debugCheck(p, d, z, v)
+		return p
+	}
 	id := p.Form
 	p.grow(int(d.Cardinality))
 
@@ -329,12 +373,18 @@ func (p *Polynomial) ToLagrange(d *fft.Domain, nbTasks ...int) *Polynomial {
 		panic("unknown ID")
 	}
 	p.Basis = Lagrange
+	debugCheck(p, d, z, v)
 	return p
 }
 
 // ToCanonical converts p to canonical form.
 // Leaves p unchanged if p was already in Canonical form.
 func (p *Polynomial) ToCanonical(d *fft.Domain, nbTasks ...int) *Polynomial {
+	z, v := debugCheckpoint(p, d)
+	if p.convertFromCache(d, Canonical) {
+		debugCheck(p, d, z, v)
+		return p
+	}
 	id := p.Form
 	p.grow(int(d.Cardinality))
 	n := runtime.NumCPU()
@@ -360,6 +410,7 @@ func (p *Polynomial) ToCanonical(d *fft.Domain, nbTasks ...int) *Polynomial {
 		panic("unknown ID")
 	}
 	p.Basis = Canonical
+	debugCheck(p, d, z, v)
 	return p
 }
 
@@ -372,6 +423,11 @@ func (p *polynomial) grow(newSize int) {
 
 // ToLagrangeCoset Sets p to q, in LagrangeCoset form and returns it.
 func (p *Polynomial) ToLagrangeCoset(d *fft.Domain) *Polynomial {
+	z, v := debugCheckpoint(p, d)
+	if p.convertFromCache(d, LagrangeCoset) {
+		debugCheck(p, d, z, v)
+		return p
+	}
 	id := p.Form
 	p.grow(int(d.Cardinality))
 	switch id {
@@ -396,6 +452,7 @@ func (p *Polynomial) ToLagrangeCoset(d *fft.Domain) *Polynomial {
 	}
 
 	p.Basis = LagrangeCoset
+	debugCheck(p, d, z, v)
 	return p
 }
 
@@ -454,6 +511,7 @@ func (p *Polynomial) ReadFrom(r io.Reader) (int64, error) {
 
 	p.Basis = Basis(data[0])
 	p.Layout = Layout(data[1])
+	p.representations = nil
 	p.shift = int(data[2])
 	p.size = int(data[3])
 	p.blindedSize = int(data[4])
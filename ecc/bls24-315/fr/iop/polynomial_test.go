@@ -727,3 +727,54 @@ func TestPutInLagrangeCosetForm(t *testing.T) {
 	}
 
 }
+
+func TestEvaluationAnyForm(t *testing.T) {
+
+	const size = 16
+	d := fft.NewDomain(size)
+	dBig := fft.NewDomain(4 * size)
+	c := randomVector(size)
+	wp := NewPolynomial(c, Form{Basis: Canonical, Layout: Regular})
+
+	var x fr.Element
+	x.SetRandom()
+	points := []fr.Element{x, d.Generator, d.FrMultiplicativeGen}
+
+	for _, shift := range []int{0, 3, 7, -2} {
+		for _, point := range points {
+			ref := wp.ShallowClone().Shift(shift)
+			expected := ref.Evaluate(point)
+
+			// Lagrange and LagrangeCoset in both layouts, on d and on a larger domain
+			for _, form := range []Form{lagrangeRegular, lagrangeBitReverse, lagrangeCosetRegular, lagrangeCosetBitReverse, canonicalBitReverse} {
+				for _, domain := range []*fft.Domain{d, dBig} {
+					q := wp.Clone().Shift(shift)
+					switch form.Basis {
+					case Lagrange:
+						if domain != d {
+							continue
+						}
+						q.ToLagrange(domain)
+					case LagrangeCoset:
+						q.ToLagrangeCoset(domain)
+					}
+					if form.Layout == Regular {
+						q.ToRegular()
+					} else {
+						q.ToBitReverse()
+					}
+
+					v := q.Evaluate(point, domain)
+					if !v.Equal(&expected) {
+						t.Fatalf("wrong evaluation in form %v (shift %d, domain size %d)", form, shift, domain.Cardinality)
+					}
+					// default domain
+					v = q.Evaluate(point)
+					if !v.Equal(&expected) {
+						t.Fatalf("wrong evaluation in form %v with the default domain (shift %d)", form, shift)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package iop
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr/fft"
+)
+
+func randomSet(n int) []fr.Element {
+	res := make([]fr.Element, n)
+	for i := range res {
+		res[i].SetRandom()
+	}
+	return res
+}
+
+func TestVanishingPolynomial(t *testing.T) {
+
+	set := randomSet(7)
+	z := VanishingPolynomial(set)
+
+	if z.Size() != len(set)+1 || z.Form != (Form{Basis: Canonical, Layout: Regular}) {
+		t.Fatal("wrong size or form")
+	}
+
+	// Z_S vanishes on S
+	for i := range set {
+		e := z.Evaluate(set[i])
+		if !e.IsZero() {
+			t.Fatal("Z_S(s) != 0")
+		}
+	}
+
+	// Z_S matches the product form
+	var x fr.Element
+	x.SetRandom()
+	e := z.Evaluate(x)
+	expected := EvaluateVanishingPolynomial(set, x)
+	if !e.Equal(&expected) {
+		t.Fatal("coefficients and product form of Z_S don't match")
+	}
+
+	// empty set
+	one := fr.One()
+	e = VanishingPolynomial(nil).Evaluate(x)
+	if !e.Equal(&one) {
+		t.Fatal("Z_∅ should be 1")
+	}
+}
+
+func TestEvaluateVanishingPolynomialOnDomain(t *testing.T) {
+
+	const size = 8
+	domain := fft.NewDomain(size)
+
+	// both the FFT (|S| < size) and the point by point evaluations
+	for _, n := range []int{3, size, 11} {
+		set := randomSet(n)
+		for _, basis := range []Basis{Lagrange, LagrangeCoset} {
+			p, err := EvaluateVanishingPolynomialOnDomain(set, domain, basis)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if p.Form != (Form{Basis: basis, Layout: Regular}) {
+				t.Fatal("wrong form")
+			}
+			inv, err := EvaluateInverseVanishingPolynomialOnDomain(set, domain, basis)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var x fr.Element
+			x.SetOne()
+			if basis == LagrangeCoset {
+				x.Set(&domain.FrMultiplicativeGen)
+			}
+			for i := 0; i < size; i++ {
+				expected := EvaluateVanishingPolynomial(set, x)
+				if got := p.GetCoeff(i); !got.Equal(&expected) {
+					t.Fatalf("wrong evaluation of Z_S (|S|=%d) at index %d", n, i)
+				}
+				var one fr.Element
+				c := inv.GetCoeff(i)
+				one.Mul(&c, &expected)
+				if !one.IsOne() {
+					t.Fatalf("wrong evaluation of 1/Z_S (|S|=%d) at index %d", n, i)
+				}
+				x.Mul(&x, &domain.Generator)
+			}
+		}
+	}
+
+	if _, err := EvaluateVanishingPolynomialOnDomain(randomSet(2), domain, Canonical); err != ErrMustBeLagrangeOrLagrangeCoset {
+		t.Fatal("expected ErrMustBeLagrangeOrLagrangeCoset")
+	}
+}
+
+func TestVanishingPolynomialDomainSubset(t *testing.T) {
+
+	const size = 16
+	domain := fft.NewDomain(size)
+	indices := []int{1, 4, 5, 11}
+	set := DomainElements(domain, indices)
+
+	p, err := EvaluateVanishingPolynomialOnDomain(set, domain, Lagrange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	isInSubset := make([]bool, size)
+	for _, i := range indices {
+		isInSubset[i] = true
+	}
+	for i := 0; i < size; i++ {
+		if c := p.GetCoeff(i); c.IsZero() != isInSubset[i] {
+			t.Fatalf("Z_S should vanish exactly on the subset (index %d)", i)
+		}
+	}
+
+	if _, err := EvaluateInverseVanishingPolynomialOnDomain(set, domain, Lagrange); err != ErrZeroDenominator {
+		t.Fatal("expected ErrZeroDenominator")
+	}
+	if _, err := EvaluateInverseVanishingPolynomialOnDomain(set, domain, LagrangeCoset); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,386 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ligero
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash"
+	"math/bits"
+	"sync/atomic"
+
+	"github.com/consensys/gnark-crypto/accumulator/merkletree"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr/fft"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr/polynomial"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrInvalidParameters    = errors.New("the rate must be a power of two ≥ 2, and the number of queries positive")
+	ErrInvalidNbEvaluations = errors.New("the number of evaluations doesn't match the parameters")
+	ErrInvalidPoint         = errors.New("the number of coordinates of the point doesn't match the parameters")
+	ErrInvalidProof         = errors.New("the opening proof doesn't have the expected size")
+	ErrMerklePath           = errors.New("merkle path proof is wrong")
+	ErrProximityTest        = errors.New("a queried column doesn't match the random combination of the rows")
+	ErrEvaluationTest       = errors.New("a queried column doesn't match the evaluation")
+)
+
+// Digest commitment of a polynomial: the root of the Merkle tree of the columns
+// of the encoded matrix
+type Digest []byte
+
+// Params public parameters of the scheme, for multilinear polynomials in a given number
+// of variables
+type Params struct {
+	// NbRows, NbColumns dimensions of the matrix of the 2ⁿ evaluations of the polynomial:
+	// the evaluation at i is in row i / NbColumns and column i % NbColumns
+	NbRows, NbColumns int
+
+	// Rate inverse of the rate of the Reed-Solomon code: a row of NbColumns evaluations
+	// (read as the coefficients of a polynomial) is encoded as Rate·NbColumns evaluations
+	Rate int
+
+	// NbQueries number of columns of the encoded matrix opened by a proof. Each query catches
+	// an encoded matrix far from the code with probability at least a constant fraction of the
+	// distance 1-1/Rate of the code: the number of queries sets the soundness of the scheme.
+	NbQueries int
+
+	// NewHash returns new instances of the hash function of the columns and the Merkle tree
+	NewHash func() hash.Hash
+
+	nbVars int
+	domain *fft.Domain
+}
+
+// ProverState is the data of a commitment the prover needs to open it
+type ProverState struct {
+	digest      Digest
+	evaluations []fr.Element
+	encoded     []fr.Element // encoded rows, one after the other
+	leaves      [][]byte     // hashes of the columns of the encoded matrix
+}
+
+// OpeningProof proof that a committed polynomial evaluates to ClaimedValue at a point
+type OpeningProof struct {
+	// Combination ∑ᵣγʳMᵣ of the rows Mᵣ of the matrix, for a challenge γ
+	Combination []fr.Element
+
+	// Evaluation ∑ᵣeq(z₀, r)Mᵣ, where z₀ are the first coordinates of the point (the variables
+	// of the row index), so that the claimed value is ∑ₖeq(z₁, k)Evaluationₖ
+	Evaluation []fr.Element
+
+	// Columns queried columns of the encoded matrix
+	Columns [][]fr.Element
+
+	// MerkleProofs Merkle paths of the queried columns; the first entry of a path is the
+	// hash of the column
+	MerkleProofs [][][]byte
+
+	// ClaimedValue purported value of the polynomial at the point
+	ClaimedValue fr.Element
+}
+
+// NewParams returns the parameters to commit to multilinear polynomials in nbVars variables.
+// The matrix of the evaluations has 2^⌊nbVars/2⌋ rows and 2^⌈nbVars/2⌉ columns.
+//
+// * rate inverse of the rate of the Reed-Solomon code, a power of two ≥ 2
+// * nbQueries number of columns opened by a proof
+// * newHash returns new instances of the hash function used to commit to the columns
+func NewParams(nbVars, rate, nbQueries int, newHash func() hash.Hash) (*Params, error) {
+	if nbVars < 0 || rate < 2 || rate&(rate-1) != 0 || nbQueries <= 0 {
+		return nil, ErrInvalidParameters
+	}
+	res := &Params{
+		NbRows:    1 << (nbVars / 2),
+		NbColumns: 1 << (nbVars - nbVars/2),
+		Rate:      rate,
+		NbQueries: nbQueries,
+		NewHash:   newHash,
+		nbVars:    nbVars,
+	}
+	res.domain = fft.NewDomain(uint64(res.NbColumns * rate))
+	return res, nil
+}
+
+// Commit commits to the multilinear polynomial given by its evaluations on the hypercube,
+// and returns the state needed to open the commitment.
+func (p *Params) Commit(evaluations []fr.Element) (Digest, *ProverState, error) {
+	if len(evaluations) != p.NbRows*p.NbColumns {
+		return nil, nil, ErrInvalidNbEvaluations
+	}
+	width := p.Rate * p.NbColumns
+
+	// encode the rows
+	encoded := make([]fr.Element, p.NbRows*width)
+	parallel.Execute(p.NbRows, func(start, end int) {
+		for r := start; r < end; r++ {
+			copy(encoded[r*width:], evaluations[r*p.NbColumns:(r+1)*p.NbColumns])
+			p.encode(encoded[r*width:(r+1)*width], fft.WithNbTasks(1))
+		}
+	})
+
+	// hash the columns
+	leaves := make([][]byte, width)
+	parallel.Execute(width, func(start, end int) {
+		h := p.NewHash()
+		column := make([]fr.Element, p.NbRows)
+		for c := start; c < end; c++ {
+			for r := range column {
+				column[r] = encoded[r*width+c]
+			}
+			leaves[c] = hashColumn(h, column)
+		}
+	})
+
+	tree := merkletree.New(p.NewHash())
+	for _, leaf := range leaves {
+		tree.Push(leaf)
+	}
+	digest := Digest(tree.Root())
+
+	return digest, &ProverState{
+		digest:      digest,
+		evaluations: evaluations,
+		encoded:     encoded,
+		leaves:      leaves,
+	}, nil
+}
+
+// Open computes an opening proof of the committed polynomial at point. hf is the hash function
+// of the Fiat-Shamir transcript.
+func (p *Params) Open(state *ProverState, point []fr.Element, hf hash.Hash) (OpeningProof, error) {
+	if len(point) != p.nbVars {
+		return OpeningProof{}, ErrInvalidPoint
+	}
+	nbRowVars := bits.TrailingZeros(uint(p.NbRows))
+
+	fs := fiatshamir.NewTranscript(hf, "gamma", "queries")
+	gamma, err := deriveGamma(fs, state.digest, point)
+	if err != nil {
+		return OpeningProof{}, err
+	}
+
+	var res OpeningProof
+	res.Combination = p.combineRows(state.evaluations, powers(gamma, p.NbRows))
+	res.Evaluation = p.combineRows(state.evaluations, polynomial.EqVector(point[:nbRowVars]))
+	res.ClaimedValue = innerProduct(res.Evaluation, polynomial.EqVector(point[nbRowVars:]))
+
+	positions, err := p.deriveQueries(fs, &res)
+	if err != nil {
+		return OpeningProof{}, err
+	}
+
+	// open the queried columns
+	width := p.Rate * p.NbColumns
+	res.Columns = make([][]fr.Element, len(positions))
+	res.MerkleProofs = make([][][]byte, len(positions))
+	var nbErrs uint64
+	parallel.Execute(len(positions), func(start, end int) {
+		for i := start; i < end; i++ {
+			res.Columns[i] = make([]fr.Element, p.NbRows)
+			for r := range res.Columns[i] {
+				res.Columns[i][r] = state.encoded[r*width+positions[i]]
+			}
+			tree := merkletree.New(p.NewHash())
+			if err := tree.SetIndex(uint64(positions[i])); err != nil {
+				atomic.AddUint64(&nbErrs, 1)
+				return
+			}
+			for _, leaf := range state.leaves {
+				tree.Push(leaf)
+			}
+			_, res.MerkleProofs[i], _, _ = tree.Prove()
+		}
+	})
+	if nbErrs != 0 {
+		return OpeningProof{}, ErrMerklePath
+	}
+
+	return res, nil
+}
+
+// Verify verifies an opening proof of the polynomial committed in digest, at point. hf is the
+// hash function of the Fiat-Shamir transcript.
+func (p *Params) Verify(digest Digest, proof *OpeningProof, point []fr.Element, hf hash.Hash) error {
+	if len(point) != p.nbVars {
+		return ErrInvalidPoint
+	}
+	if len(proof.Combination) != p.NbColumns || len(proof.Evaluation) != p.NbColumns ||
+		len(proof.Columns) != p.NbQueries || len(proof.MerkleProofs) != p.NbQueries {
+		return ErrInvalidProof
+	}
+	for i := range proof.Columns {
+		if len(proof.Columns[i]) != p.NbRows || len(proof.MerkleProofs[i]) == 0 {
+			return ErrInvalidProof
+		}
+	}
+	nbRowVars := bits.TrailingZeros(uint(p.NbRows))
+
+	fs := fiatshamir.NewTranscript(hf, "gamma", "queries")
+	gamma, err := deriveGamma(fs, digest, point)
+	if err != nil {
+		return err
+	}
+
+	// the claimed value is the evaluation of the combination of the rows at the column variables
+	claimedValue := innerProduct(proof.Evaluation, polynomial.EqVector(point[nbRowVars:]))
+	if !claimedValue.Equal(&proof.ClaimedValue) {
+		return ErrEvaluationTest
+	}
+
+	positions, err := p.deriveQueries(fs, proof)
+	if err != nil {
+		return err
+	}
+
+	// the encodings of the combinations must match the combinations of the queried columns
+	width := p.Rate * p.NbColumns
+	encodedCombination := make([]fr.Element, width)
+	copy(encodedCombination, proof.Combination)
+	p.encode(encodedCombination)
+	encodedEvaluation := make([]fr.Element, width)
+	copy(encodedEvaluation, proof.Evaluation)
+	p.encode(encodedEvaluation)
+
+	gammas := powers(gamma, p.NbRows)
+	eqRows := polynomial.EqVector(point[:nbRowVars])
+	h := p.NewHash()
+	for i, pos := range positions {
+		if !bytes.Equal(hashColumn(h, proof.Columns[i]), proof.MerkleProofs[i][0]) ||
+			!merkletree.VerifyProof(h, digest, proof.MerkleProofs[i], uint64(pos), uint64(width)) {
+			return ErrMerklePath
+		}
+		if v := innerProduct(proof.Columns[i], gammas); !v.Equal(&encodedCombination[pos]) {
+			return ErrProximityTest
+		}
+		if v := innerProduct(proof.Columns[i], eqRows); !v.Equal(&encodedEvaluation[pos]) {
+			return ErrEvaluationTest
+		}
+	}
+
+	return nil
+}
+
+// encode encodes in place the first NbColumns entries of a, of size Rate·NbColumns, read as
+// the coefficients of a polynomial, to its evaluations on the domain
+func (p *Params) encode(a []fr.Element, opts ...fft.Option) {
+	for i := p.NbColumns; i < len(a); i++ {
+		a[i].SetZero()
+	}
+	p.domain.FFT(a, fft.DIF, opts...)
+	fft.BitReverse(a)
+}
+
+// combineRows returns ∑ᵣcoeffsᵣMᵣ, where Mᵣ are the rows of the matrix of the evaluations
+func (p *Params) combineRows(evaluations, coeffs []fr.Element) []fr.Element {
+	res := make([]fr.Element, p.NbColumns)
+	parallel.Execute(p.NbColumns, func(start, end int) {
+		var t fr.Element
+		for r := range coeffs {
+			row := evaluations[r*p.NbColumns : (r+1)*p.NbColumns]
+			for c := start; c < end; c++ {
+				t.Mul(&row[c], &coeffs[r])
+				res[c].Add(&res[c], &t)
+			}
+		}
+	})
+	return res
+}
+
+// deriveQueries derives the positions of the queried columns, bound to the combinations of
+// the rows. The positions are expanded from a single challenge with NewHash.
+func (p *Params) deriveQueries(fs *fiatshamir.Transcript, proof *OpeningProof) ([]int, error) {
+	for _, v := range [][]fr.Element{proof.Combination, proof.Evaluation} {
+		for i := range v {
+			b := v[i].Bytes()
+			if err := fs.Bind("queries", b[:]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	seed, err := fs.ComputeChallenge("queries")
+	if err != nil {
+		return nil, err
+	}
+
+	// the width of the encoded matrix is a power of two, so the positions are uniform
+	width := uint64(p.Rate * p.NbColumns)
+	res := make([]int, p.NbQueries)
+	h := p.NewHash()
+	var counter [8]byte
+	for i := range res {
+		h.Reset()
+		h.Write(seed)
+		binary.BigEndian.PutUint64(counter[:], uint64(i))
+		h.Write(counter[:])
+		res[i] = int(binary.BigEndian.Uint64(h.Sum(nil)) % width)
+	}
+	return res, nil
+}
+
+// deriveGamma derives the challenge of the proximity test, bound to the commitment and the point
+func deriveGamma(fs *fiatshamir.Transcript, digest Digest, point []fr.Element) (fr.Element, error) {
+	if err := fs.Bind("gamma", digest); err != nil {
+		return fr.Element{}, err
+	}
+	for i := range point {
+		b := point[i].Bytes()
+		if err := fs.Bind("gamma", b[:]); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	b, err := fs.ComputeChallenge("gamma")
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var res fr.Element
+	res.SetBytes(b)
+	return res, nil
+}
+
+// hashColumn returns the hash of the column, the leaf of the Merkle tree
+func hashColumn(h hash.Hash, column []fr.Element) []byte {
+	h.Reset()
+	for i := range column {
+		b := column[i].Bytes()
+		h.Write(b[:])
+	}
+	return h.Sum(nil)
+}
+
+// powers returns 1, x, …, xⁿ⁻¹
+func powers(x fr.Element, n int) []fr.Element {
+	res := make([]fr.Element, n)
+	res[0].SetOne()
+	for i := 1; i < n; i++ {
+		res[i].Mul(&res[i-1], &x)
+	}
+	return res
+}
+
+// innerProduct returns <a, b>
+func innerProduct(a, b []fr.Element) fr.Element {
+	var res, tmp fr.Element
+	for i := range a {
+		tmp.Mul(&a[i], &b[i])
+		res.Add(&res, &tmp)
+	}
+	return res
+}
@@ -0,0 +1,31 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package whir provides the WHIR polynomial commitment scheme [ACFY24] for multilinear
+// polynomials, a hash-based alternative to FRI and STIR with a cheaper verifier.
+//
+// A multilinear polynomial f in n variables, with coefficients cᵢ in the monomial basis, is
+// committed to as the Reed-Solomon codeword of the univariate polynomial ∑ᵢcᵢXⁱ, whose leaves
+// are Merkle-hashed. An opening proof runs a sumcheck on the evaluation claim; every few
+// variables, the prover commits to the folded polynomial on a domain half the size, and the
+// verifier checks the folding at random points of the previous codeword, whose evaluations are
+// added to the claim. The last folded polynomial is sent in the clear.
+//
+// This implementation works in the unique decoding regime, where the out-of-domain samples of
+// [ACFY24] are not needed. It is not hiding.
+//
+// [ACFY24]: https://eprint.iacr.org/2024/1586.pdf
+package whir
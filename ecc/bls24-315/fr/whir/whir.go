@@ -0,0 +1,701 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package whir
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/accumulator/merkletree"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr/fft"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr/polynomial"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrInvalidParameters    = errors.New("the rate must be a power of two ≥ 2, the folding factor and the number of queries positive")
+	ErrInvalidNbEvaluations = errors.New("the number of evaluations doesn't match the parameters")
+	ErrInvalidPoint         = errors.New("the number of coordinates of the point doesn't match the parameters")
+	ErrInvalidProof         = errors.New("the opening proof doesn't have the expected size")
+	ErrMerklePath           = errors.New("merkle path proof is wrong")
+	ErrSumcheck             = errors.New("a round of the sumcheck failed")
+	ErrFolding              = errors.New("a queried codeword doesn't match the final polynomial")
+	ErrFinalClaim           = errors.New("the final polynomial doesn't satisfy the claim")
+)
+
+// Digest commitment of a polynomial: the root of the Merkle tree of its codeword
+type Digest []byte
+
+// Params public parameters of the scheme, for multilinear polynomials in a given number
+// of variables
+type Params struct {
+	// NbVariables number of variables of the committed polynomials
+	NbVariables int
+
+	// Rate inverse of the rate of the Reed-Solomon code of the committed polynomials: the
+	// codewords have Rate·2ⁿ entries. The rate improves in each round.
+	Rate int
+
+	// FoldingFactor number of variables folded in each round
+	FoldingFactor int
+
+	// NbQueries number of entries of the codeword opened in each round; in the unique decoding
+	// regime, each query catches a function far from the code with probability at least
+	// (1-1/Rate)/2.
+	NbQueries int
+
+	// NewHash returns new instances of the hash function of the Merkle trees
+	NewHash func() hash.Hash
+
+	rounds []roundParams
+}
+
+// roundParams parameters of a round, which folds nbFolded of the nbVars variables of a
+// polynomial committed to on domain
+type roundParams struct {
+	nbVars, nbFolded int
+	domain           *fft.Domain
+}
+
+// ProverState is the data of a commitment the prover needs to open it
+type ProverState struct {
+	digest       Digest
+	evaluations  []fr.Element
+	coefficients []fr.Element
+	codeword     []fr.Element
+	leaves       [][]byte
+}
+
+// Round messages of the prover in a round of an opening proof
+type Round struct {
+	// SumcheckPolynomials coefficients of the degree 2 polynomials of the sumcheck, one for each
+	// folded variable
+	SumcheckPolynomials [][3]fr.Element
+
+	// Commitment Merkle root of the codeword of the folded polynomial (nil in the last round)
+	Commitment []byte
+
+	// Fibers queried entries of the codeword of the round: a fiber holds the evaluations at the
+	// 2ᵏ points x such that x^{2ᵏ} is the query, where k is the number of folded variables
+	Fibers [][]fr.Element
+
+	// MerkleProofs Merkle paths of the fibers
+	MerkleProofs [][][]byte
+}
+
+// OpeningProof proof that a committed polynomial evaluates to ClaimedValue at a point
+type OpeningProof struct {
+	Rounds []Round
+
+	// FinalPolynomial coefficients, in the monomial basis, of the polynomial folded in all
+	// the rounds
+	FinalPolynomial []fr.Element
+
+	// ClaimedValue purported value of the polynomial at the point
+	ClaimedValue fr.Element
+}
+
+// NewParams returns the parameters to commit to multilinear polynomials in nbVars variables.
+//
+// * rate inverse of the rate of the Reed-Solomon code, a power of two ≥ 2
+// * foldingFactor number of variables folded in each round; the rounds stop, and the folded
+// polynomial is sent in the clear, once it has at most foldingFactor variables
+// * nbQueries number of entries of the codeword opened in each round
+// * newHash returns new instances of the hash function of the Merkle trees
+func NewParams(nbVars, rate, foldingFactor, nbQueries int, newHash func() hash.Hash) (*Params, error) {
+	if nbVars < 0 || rate < 2 || rate&(rate-1) != 0 || foldingFactor <= 0 || nbQueries <= 0 {
+		return nil, ErrInvalidParameters
+	}
+	res := &Params{
+		NbVariables:   nbVars,
+		Rate:          rate,
+		FoldingFactor: foldingFactor,
+		NbQueries:     nbQueries,
+		NewHash:       newHash,
+	}
+
+	// the codeword of each round is on a domain half the size of the previous one
+	m, size := nbVars, uint64(rate)<<nbVars
+	for {
+		k := foldingFactor
+		if m < k {
+			k = m
+		}
+		res.rounds = append(res.rounds, roundParams{nbVars: m, nbFolded: k, domain: fft.NewDomain(size)})
+		m -= k
+		size /= 2
+		if m <= foldingFactor {
+			break
+		}
+	}
+	return res, nil
+}
+
+// Commit commits to the multilinear polynomial given by its evaluations on the hypercube,
+// and returns the state needed to open the commitment.
+func (p *Params) Commit(evaluations []fr.Element) (Digest, *ProverState, error) {
+	if len(evaluations) != 1<<p.NbVariables {
+		return nil, nil, ErrInvalidNbEvaluations
+	}
+	coefficients := make([]fr.Element, len(evaluations))
+	copy(coefficients, evaluations)
+	toMonomial(coefficients)
+
+	codeword, leaves, root := p.rounds[0].encode(coefficients, p.NewHash())
+	return root, &ProverState{
+		digest:       root,
+		evaluations:  evaluations,
+		coefficients: coefficients,
+		codeword:     codeword,
+		leaves:       leaves,
+	}, nil
+}
+
+// Open computes an opening proof of the committed polynomial at point. hf is the hash function
+// of the Fiat-Shamir transcript.
+func (p *Params) Open(state *ProverState, point []fr.Element, hf hash.Hash) (OpeningProof, error) {
+	if len(point) != p.NbVariables {
+		return OpeningProof{}, ErrInvalidPoint
+	}
+
+	// the claim ∑_b f(b)w(b) = σ, with w = eq(point, ⋅), on the tables of f and w
+	f := make([]fr.Element, len(state.evaluations))
+	copy(f, state.evaluations)
+	c := make([]fr.Element, len(state.coefficients))
+	copy(c, state.coefficients)
+	w := polynomial.EqVector(point)
+
+	var res OpeningProof
+	res.ClaimedValue = innerProduct(f, w)
+
+	fs, err := p.newTranscript(hf, state.digest, point, &res.ClaimedValue)
+	if err != nil {
+		return OpeningProof{}, err
+	}
+
+	codeword, leaves := state.codeword, state.leaves
+	res.Rounds = make([]Round, len(p.rounds))
+	for i := range p.rounds {
+		r := &p.rounds[i]
+		round := &res.Rounds[i]
+
+		// sumcheck on the folded variables
+		round.SumcheckPolynomials = make([][3]fr.Element, r.nbFolded)
+		for j := range round.SumcheckPolynomials {
+			round.SumcheckPolynomials[j] = sumcheckPolynomial(f, w)
+			alpha, err := deriveChallenge(fs, fmt.Sprintf("alpha_%d_%d", i, j), marshal(round.SumcheckPolynomials[j][:]...)...)
+			if err != nil {
+				return OpeningProof{}, err
+			}
+			f, w, c = fold(f, alpha), fold(w, alpha), foldCoefficients(c, alpha)
+		}
+
+		// commit to the folded polynomial, or send it in the clear in the last round
+		var next *roundParams
+		var nextCodeword []fr.Element
+		var nextLeaves [][]byte
+		var queriesData [][]byte
+		if i == len(p.rounds)-1 {
+			res.FinalPolynomial = c
+			queriesData = marshal(c...)
+		} else {
+			next = &p.rounds[i+1]
+			nextCodeword, nextLeaves, round.Commitment = next.encode(c, p.NewHash())
+			queriesData = [][]byte{round.Commitment}
+		}
+
+		// open the queried fibers of the codeword
+		positions, err := p.deriveQueries(fs, i, queriesData)
+		if err != nil {
+			return OpeningProof{}, err
+		}
+		round.Fibers, round.MerkleProofs = r.open(codeword, leaves, positions, p.NewHash)
+
+		if next != nil {
+			// the folded polynomial g evaluates to g(zⱼ) at the queries zⱼ, which the verifier
+			// checks against the fibers: add γʲ⁺¹eq(pow(zⱼ), ⋅) to w
+			gamma, err := deriveChallenge(fs, fmt.Sprintf("gamma_%d", i))
+			if err != nil {
+				return OpeningProof{}, err
+			}
+			gammaPow := gamma
+			for _, y := range positions {
+				eq := polynomial.EqVector(powVector(r.queryPoint(y), next.nbVars))
+				coeff := gammaPow
+				parallel.Execute(len(w), func(start, end int) {
+					var t fr.Element
+					for l := start; l < end; l++ {
+						t.Mul(&eq[l], &coeff)
+						w[l].Add(&w[l], &t)
+					}
+				})
+				gammaPow.Mul(&gammaPow, &gamma)
+			}
+		}
+		codeword, leaves = nextCodeword, nextLeaves
+	}
+
+	return res, nil
+}
+
+// weightTerm a term s⋅eq(point, ⋅) of the weight polynomial of the claim
+type weightTerm struct {
+	scalar fr.Element
+	point  []fr.Element
+}
+
+// Verify verifies an opening proof of the polynomial committed in digest, at point. hf is the
+// hash function of the Fiat-Shamir transcript.
+func (p *Params) Verify(digest Digest, proof *OpeningProof, point []fr.Element, hf hash.Hash) error {
+	if len(point) != p.NbVariables {
+		return ErrInvalidPoint
+	}
+	if err := p.checkProofSize(proof); err != nil {
+		return err
+	}
+
+	fs, err := p.newTranscript(hf, digest, point, &proof.ClaimedValue)
+	if err != nil {
+		return err
+	}
+
+	// the claim is ∑_b f(b)w(b) = σ, with w = ∑ₜsₜeq(pₜ, ⋅)
+	sigma := proof.ClaimedValue
+	terms := make([]weightTerm, 1)
+	terms[0].scalar.SetOne()
+	terms[0].point = point
+	root := []byte(digest)
+	for i := range p.rounds {
+		r := &p.rounds[i]
+		round := &proof.Rounds[i]
+
+		// sumcheck on the folded variables
+		alphas := make([]fr.Element, r.nbFolded)
+		for j, h := range round.SumcheckPolynomials {
+			// h(0) + h(1) = σ
+			var sum fr.Element
+			sum.Double(&h[0]).Add(&sum, &h[1]).Add(&sum, &h[2])
+			if !sum.Equal(&sigma) {
+				return ErrSumcheck
+			}
+			alpha, err := deriveChallenge(fs, fmt.Sprintf("alpha_%d_%d", i, j), marshal(h[:]...)...)
+			if err != nil {
+				return err
+			}
+			// σ = h(α)
+			sigma.Mul(&h[2], &alpha).Add(&sigma, &h[1]).Mul(&sigma, &alpha).Add(&sigma, &h[0])
+			// eq(p, (X, α)) = eq(p', X)⋅eq(pₙ₋₁, α)
+			for t := range terms {
+				last := len(terms[t].point) - 1
+				e := eq1(&terms[t].point[last], &alpha)
+				terms[t].scalar.Mul(&terms[t].scalar, &e)
+				terms[t].point = terms[t].point[:last]
+			}
+			alphas[j] = alpha
+		}
+
+		last := i == len(p.rounds)-1
+		var queriesData [][]byte
+		if last {
+			queriesData = marshal(proof.FinalPolynomial...)
+		} else {
+			queriesData = [][]byte{round.Commitment}
+		}
+		positions, err := p.deriveQueries(fs, i, queriesData)
+		if err != nil {
+			return err
+		}
+
+		// fold the queried fibers
+		h := p.NewHash()
+		nbLeaves := r.domain.Cardinality >> r.nbFolded
+		folded := make([]fr.Element, len(positions))
+		for j, y := range positions {
+			if !bytes.Equal(fiberLeaf(round.Fibers[j]), round.MerkleProofs[j][0]) ||
+				!merkletree.VerifyProof(h, root, round.MerkleProofs[j], uint64(y), nbLeaves) {
+				return ErrMerklePath
+			}
+			folded[j] = r.foldFiber(round.Fibers[j], y, alphas)
+		}
+
+		if last {
+			for j, y := range positions {
+				z := r.queryPoint(y)
+				if v := horner(proof.FinalPolynomial, &z); !v.Equal(&folded[j]) {
+					return ErrFolding
+				}
+			}
+			break
+		}
+
+		// add the evaluations of the folded polynomial at the queries to the claim
+		gamma, err := deriveChallenge(fs, fmt.Sprintf("gamma_%d", i))
+		if err != nil {
+			return err
+		}
+		gammaPow := gamma
+		for j, y := range positions {
+			terms = append(terms, weightTerm{scalar: gammaPow, point: powVector(r.queryPoint(y), p.rounds[i+1].nbVars)})
+			var t fr.Element
+			t.Mul(&gammaPow, &folded[j])
+			sigma.Add(&sigma, &t)
+			gammaPow.Mul(&gammaPow, &gamma)
+		}
+		root = round.Commitment
+	}
+
+	// σ = ∑_b g(b)w(b) = ∑ₜsₜg(pₜ), for the final polynomial g
+	var expected fr.Element
+	for t := range terms {
+		v := evalMonomial(proof.FinalPolynomial, terms[t].point)
+		v.Mul(&v, &terms[t].scalar)
+		expected.Add(&expected, &v)
+	}
+	if !expected.Equal(&sigma) {
+		return ErrFinalClaim
+	}
+	return nil
+}
+
+// checkProofSize checks that the proof has the shape given by the parameters
+func (p *Params) checkProofSize(proof *OpeningProof) error {
+	if len(proof.Rounds) != len(p.rounds) {
+		return ErrInvalidProof
+	}
+	for i := range p.rounds {
+		r := &p.rounds[i]
+		round := &proof.Rounds[i]
+		if len(round.SumcheckPolynomials) != r.nbFolded || len(round.Fibers) != p.NbQueries ||
+			len(round.MerkleProofs) != p.NbQueries || (i != len(p.rounds)-1 && len(round.Commitment) == 0) {
+			return ErrInvalidProof
+		}
+		for j := range round.Fibers {
+			if len(round.Fibers[j]) != 1<<r.nbFolded || len(round.MerkleProofs[j]) == 0 {
+				return ErrInvalidProof
+			}
+		}
+	}
+	last := &p.rounds[len(p.rounds)-1]
+	if len(proof.FinalPolynomial) != 1<<(last.nbVars-last.nbFolded) {
+		return ErrInvalidProof
+	}
+	return nil
+}
+
+// newTranscript returns the Fiat-Shamir transcript of an opening proof, whose first challenge
+// is bound to the commitment, the point and the claimed value
+func (p *Params) newTranscript(hf hash.Hash, digest Digest, point []fr.Element, claimedValue *fr.Element) (*fiatshamir.Transcript, error) {
+	var ids []string
+	for i := range p.rounds {
+		for j := 0; j < p.rounds[i].nbFolded; j++ {
+			ids = append(ids, fmt.Sprintf("alpha_%d_%d", i, j))
+		}
+		ids = append(ids, fmt.Sprintf("queries_%d", i))
+		if i != len(p.rounds)-1 {
+			ids = append(ids, fmt.Sprintf("gamma_%d", i))
+		}
+	}
+	fs := fiatshamir.NewTranscript(hf, ids...)
+	data := append([][]byte{digest}, marshal(point...)...)
+	data = append(data, claimedValue.Marshal())
+	for _, b := range data {
+		if err := fs.Bind(ids[0], b); err != nil {
+			return nil, err
+		}
+	}
+	return fs, nil
+}
+
+// deriveQueries derives the positions of the queried fibers of round i, bound to data
+func (p *Params) deriveQueries(fs *fiatshamir.Transcript, i int, data [][]byte) ([]int, error) {
+	name := fmt.Sprintf("queries_%d", i)
+	for _, b := range data {
+		if err := fs.Bind(name, b); err != nil {
+			return nil, err
+		}
+	}
+	seed, err := fs.ComputeChallenge(name)
+	if err != nil {
+		return nil, err
+	}
+
+	// the number of fibers is a power of two, so the positions are uniform
+	r := &p.rounds[i]
+	nbLeaves := r.domain.Cardinality >> r.nbFolded
+	res := make([]int, p.NbQueries)
+	h := p.NewHash()
+	var counter [8]byte
+	for j := range res {
+		h.Reset()
+		h.Write(seed)
+		binary.BigEndian.PutUint64(counter[:], uint64(j))
+		h.Write(counter[:])
+		res[j] = int(binary.BigEndian.Uint64(h.Sum(nil)) % nbLeaves)
+	}
+	return res, nil
+}
+
+// encode returns the codeword of the polynomial with the given coefficients on the domain of the
+// round, the leaves of its Merkle tree and its root. The leaf y holds the fiber of ω^{2ᵏy}, the
+// evaluations at ω^{y + j⋅N/2ᵏ} for j < 2ᵏ, where N is the size of the domain.
+func (r *roundParams) encode(coefficients []fr.Element, h hash.Hash) ([]fr.Element, [][]byte, []byte) {
+	n := int(r.domain.Cardinality)
+	codeword := make([]fr.Element, n)
+	copy(codeword, coefficients)
+	r.domain.FFT(codeword, fft.DIF)
+	fft.BitReverse(codeword)
+
+	fiberSize := 1 << r.nbFolded
+	leaves := make([][]byte, n/fiberSize)
+	parallel.Execute(len(leaves), func(start, end int) {
+		fiber := make([]fr.Element, fiberSize)
+		for y := start; y < end; y++ {
+			for j := range fiber {
+				fiber[j] = codeword[y+j*len(leaves)]
+			}
+			leaves[y] = fiberLeaf(fiber)
+		}
+	})
+
+	tree := merkletree.New(h)
+	for _, leaf := range leaves {
+		tree.Push(leaf)
+	}
+	return codeword, leaves, tree.Root()
+}
+
+// open returns the queried fibers of the codeword, and their Merkle paths
+func (r *roundParams) open(codeword []fr.Element, leaves [][]byte, positions []int, newHash func() hash.Hash) ([][]fr.Element, [][][]byte) {
+	fibers := make([][]fr.Element, len(positions))
+	proofs := make([][][]byte, len(positions))
+	parallel.Execute(len(positions), func(start, end int) {
+		for i := start; i < end; i++ {
+			fibers[i] = make([]fr.Element, 1<<r.nbFolded)
+			for j := range fibers[i] {
+				fibers[i][j] = codeword[positions[i]+j*len(leaves)]
+			}
+			// the tree is empty, so SetIndex can't fail
+			tree := merkletree.New(newHash())
+			_ = tree.SetIndex(uint64(positions[i]))
+			for _, leaf := range leaves {
+				tree.Push(leaf)
+			}
+			_, proofs[i], _, _ = tree.Prove()
+		}
+	})
+	return fibers, proofs
+}
+
+// queryPoint returns the point ω^{2ᵏy} queried at the fiber y
+func (r *roundParams) queryPoint(y int) fr.Element {
+	var res fr.Element
+	res.Exp(r.domain.Generator, big.NewInt(int64(y)<<r.nbFolded))
+	return res
+}
+
+// foldFiber returns the evaluation at ω^{2ᵏy} of the polynomial folded with alphas, from the
+// evaluations at the points of the fiber: with f(X) = fₑ(X²) + X⋅fₒ(X²), each folding step
+// computes fₑ(x²) + α⋅fₒ(x²) = (f(x)+f(-x))/2 + α⋅(f(x)-f(-x))/(2x).
+func (r *roundParams) foldFiber(fiber []fr.Element, y int, alphas []fr.Element) fr.Element {
+	values := make([]fr.Element, len(fiber))
+	copy(values, fiber)
+
+	// points[j] = ω^{y + j⋅N/2ᵏ}
+	points := make([]fr.Element, len(fiber))
+	var step fr.Element
+	points[0].Exp(r.domain.Generator, big.NewInt(int64(y)))
+	step.Exp(r.domain.Generator, big.NewInt(int64(r.domain.Cardinality>>r.nbFolded)))
+	for j := 1; j < len(points); j++ {
+		points[j].Mul(&points[j-1], &step)
+	}
+
+	var twoInv fr.Element
+	twoInv.SetUint64(2).Inverse(&twoInv)
+	for _, alpha := range alphas {
+		half := len(values) / 2
+		// 1/(2x), with x and -x = points[j+half]
+		inv := make([]fr.Element, half)
+		for j := range inv {
+			inv[j].Double(&points[j])
+		}
+		inv = fr.BatchInvert(inv)
+		for j := 0; j < half; j++ {
+			var even, odd fr.Element
+			even.Add(&values[j], &values[j+half]).Mul(&even, &twoInv)
+			odd.Sub(&values[j], &values[j+half]).Mul(&odd, &inv[j]).Mul(&odd, &alpha)
+			values[j].Add(&even, &odd)
+			points[j].Square(&points[j])
+		}
+		values, points = values[:half], points[:half]
+	}
+	return values[0]
+}
+
+// sumcheckPolynomial returns the coefficients of h(X) = ∑_b f(b, X)w(b, X), where X is the
+// variable of the least significant bit of the index
+func sumcheckPolynomial(f, w []fr.Element) [3]fr.Element {
+	var res [3]fr.Element
+	for i := 0; i < len(f)/2; i++ {
+		var df, dw, t fr.Element
+		df.Sub(&f[2*i+1], &f[2*i])
+		dw.Sub(&w[2*i+1], &w[2*i])
+		t.Mul(&f[2*i], &w[2*i])
+		res[0].Add(&res[0], &t)
+		t.Mul(&f[2*i], &dw)
+		res[1].Add(&res[1], &t)
+		t.Mul(&df, &w[2*i])
+		res[1].Add(&res[1], &t)
+		t.Mul(&df, &dw)
+		res[2].Add(&res[2], &t)
+	}
+	return res
+}
+
+// fold sets the variable of the least significant bit of the index of the table v to alpha,
+// in place, and returns the folded table
+func fold(v []fr.Element, alpha fr.Element) []fr.Element {
+	half := len(v) / 2
+	for i := 0; i < half; i++ {
+		var t fr.Element
+		t.Sub(&v[2*i+1], &v[2*i]).Mul(&t, &alpha)
+		v[i].Add(&v[2*i], &t)
+	}
+	return v[:half]
+}
+
+// foldCoefficients sets the variable of the least significant bit of the index to alpha, in
+// place, in the multilinear polynomial with the given coefficients in the monomial basis, and
+// returns the coefficients of the folded polynomial
+func foldCoefficients(c []fr.Element, alpha fr.Element) []fr.Element {
+	half := len(c) / 2
+	for i := 0; i < half; i++ {
+		var t fr.Element
+		t.Mul(&c[2*i+1], &alpha)
+		c[i].Add(&c[2*i], &t)
+	}
+	return c[:half]
+}
+
+// toMonomial converts in place the evaluations of a multilinear polynomial on the hypercube to
+// its coefficients in the monomial basis (Möbius transform)
+func toMonomial(v []fr.Element) {
+	for b := 1; b < len(v); b <<= 1 {
+		for i := range v {
+			if i&b != 0 {
+				v[i].Sub(&v[i], &v[i^b])
+			}
+		}
+	}
+}
+
+// evalMonomial evaluates at point the multilinear polynomial with the given coefficients in the
+// monomial basis, where the first variable is the most significant bit of the index
+func evalMonomial(coefficients []fr.Element, point []fr.Element) fr.Element {
+	v := make([]fr.Element, len(coefficients))
+	copy(v, coefficients)
+	for j := len(point) - 1; j >= 0; j-- {
+		half := len(v) / 2
+		for i := 0; i < half; i++ {
+			var t fr.Element
+			t.Mul(&v[2*i+1], &point[j])
+			v[i].Add(&v[2*i], &t)
+		}
+		v = v[:half]
+	}
+	return v[0]
+}
+
+// horner returns ∑ᵢcoefficientsᵢzⁱ
+func horner(coefficients []fr.Element, z *fr.Element) fr.Element {
+	var res fr.Element
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		res.Mul(&res, z).Add(&res, &coefficients[i])
+	}
+	return res
+}
+
+// powVector returns (z^{2ⁿ⁻¹}, …, z², z), so that the multilinear polynomial with coefficients
+// cᵢ evaluates to ∑ᵢcᵢzⁱ at this point
+func powVector(z fr.Element, n int) []fr.Element {
+	res := make([]fr.Element, n)
+	for j := n - 1; j >= 0; j-- {
+		res[j] = z
+		z.Square(&z)
+	}
+	return res
+}
+
+// eq1 returns eq(a, b) = ab + (1-a)(1-b)
+func eq1(a, b *fr.Element) fr.Element {
+	var res, t fr.Element
+	res.Mul(a, b).Double(&res)
+	t.Add(a, b)
+	res.Sub(&res, &t)
+	t.SetOne()
+	return *res.Add(&res, &t)
+}
+
+// innerProduct returns <a, b>
+func innerProduct(a, b []fr.Element) fr.Element {
+	var res, tmp fr.Element
+	for i := range a {
+		tmp.Mul(&a[i], &b[i])
+		res.Add(&res, &tmp)
+	}
+	return res
+}
+
+// deriveChallenge binds data to the challenge name and computes it
+func deriveChallenge(fs *fiatshamir.Transcript, name string, data ...[]byte) (fr.Element, error) {
+	for i := range data {
+		if err := fs.Bind(name, data[i]); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	b, err := fs.ComputeChallenge(name)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var res fr.Element
+	res.SetBytes(b)
+	return res, nil
+}
+
+// fiberLeaf returns the leaf of the Merkle tree storing the fiber
+func fiberLeaf(fiber []fr.Element) []byte {
+	res := make([]byte, 0, len(fiber)*fr.Bytes)
+	for i := range fiber {
+		b := fiber[i].Bytes()
+		res = append(res, b[:]...)
+	}
+	return res
+}
+
+// marshal returns the encodings of v
+func marshal(v ...fr.Element) [][]byte {
+	res := make([][]byte, len(v))
+	for i := range v {
+		res[i] = v[i].Marshal()
+	}
+	return res
+}
@@ -0,0 +1,271 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls24315
+
+import (
+	"errors"
+	"math/big"
+	"runtime"
+	"sync/atomic"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// Exponentiations in GT
+//
+// Single exponentiations are methods of GT:
+//   - GT.ExpGLV splits the exponent with the Frobenius endomorphism (2-dimensional GLS decomposition),
+//   - GT.CyclotomicExp uses the cheaper squarings of the cyclotomic subgroup,
+//   - GT.Exp works for any element of the extension field.
+//
+// MultiExpGT and BatchExpGT below are the batched counterparts, e.g. for inner-pairing-product
+// arguments. All of them expect elements of GT (the output of a pairing), where the inverse is
+// the (free) conjugate.
+
+var errGTLength = errors.New("len(bases) != len(scalars)")
+
+// MultiExpGT returns ∏ basesᵢ^scalarsᵢ.
+//
+// It uses the bucket method with signed digits (the inverse of an element of GT is its conjugate),
+// and the cyclotomic squarings between the windows. The chunks of the scalars are processed in
+// parallel; config.NbTasks, config.ScalarBits and config.ScalarsCanonical are honoured as in G1Jac.MultiExp,
+// config.DeduplicateScalars is ignored.
+//
+// The bases must be in GT.
+func MultiExpGT(bases []GT, scalars []fr.Element, config ecc.MultiExpConfig) (GT, error) {
+	var res GT
+	res.SetOne()
+
+	if len(bases) != len(scalars) {
+		return res, errGTLength
+	}
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU()
+	} else if config.NbTasks > 1024 {
+		return res, errors.New("invalid config: config.NbTasks > 1024")
+	}
+	if config.ScalarBits < 0 {
+		return res, errors.New("invalid config: config.ScalarBits < 0")
+	}
+	if config.ScalarBits >= fr.Bits {
+		config.ScalarBits = 0
+	}
+	if config.ScalarBits > 0 {
+		if err := checkScalarBits(scalars, config.ScalarBits, config.NbTasks, config.ScalarsCanonical); err != nil {
+			return res, err
+		}
+	}
+	if len(bases) == 0 {
+		return res, nil
+	}
+
+	c := bestCGT(len(bases), config.ScalarBits)
+	var nbChunks uint64
+	nbBuckets := 1 << (c - 1)
+	if config.ScalarBits > 0 {
+		nbChunks = computeNbChunksBounded(c, uint64(config.ScalarBits))
+	} else {
+		nbChunks = computeNbChunks(c)
+		if lc := lastC(c); lc > c {
+			// the last window may hold a larger digit
+			nbBuckets = 1 << (lc - 1)
+		}
+	}
+
+	digits, _ := partitionScalarsN(scalars, c, nbChunks, config.NbTasks, config.ScalarsCanonical)
+
+	// for each chunk, ∏ basesᵢ^digitᵢ
+	chunks := make([]GT, nbChunks)
+	parallel.Execute(int(nbChunks), func(start, end int) {
+		buckets := make([]GT, nbBuckets)
+		used := make([]bool, nbBuckets)
+		var tmp GT
+		for chunk := start; chunk < end; chunk++ {
+			for i := range used {
+				used[i] = false
+			}
+			chunkDigits := digits[chunk*len(bases) : (chunk+1)*len(bases)]
+			for i, digit := range chunkDigits {
+				if digit == 0 {
+					continue
+				}
+				// digit&1 == 0 -> digit>>1 is the (positive) digit
+				// digit&1 == 1 -> -(digit>>1)-1 is the (negative) digit
+				if digit&1 == 0 {
+					b := int(digit>>1) - 1
+					if used[b] {
+						buckets[b].Mul(&buckets[b], &bases[i])
+					} else {
+						buckets[b].Set(&bases[i])
+						used[b] = true
+					}
+				} else {
+					b := int(digit >> 1)
+					tmp.Conjugate(&bases[i])
+					if used[b] {
+						buckets[b].Mul(&buckets[b], &tmp)
+					} else {
+						buckets[b].Set(&tmp)
+						used[b] = true
+					}
+				}
+			}
+
+			// ∏ bucketₖ^(k+1) with a running product
+			var runningProduct GT
+			runningProduct.SetOne()
+			chunks[chunk].SetOne()
+			for k := nbBuckets - 1; k >= 0; k-- {
+				if used[k] {
+					runningProduct.Mul(&runningProduct, &buckets[k])
+				}
+				chunks[chunk].Mul(&chunks[chunk], &runningProduct)
+			}
+		}
+	}, config.NbTasks)
+
+	// ∏ chunkⱼ^(2^(c*j))
+	res.Set(&chunks[nbChunks-1])
+	for j := int(nbChunks) - 2; j >= 0; j-- {
+		for l := uint64(0); l < c; l++ {
+			res.CyclotomicSquare(&res)
+		}
+		res.Mul(&res, &chunks[j])
+	}
+
+	return res, nil
+}
+
+// bestCGT returns the window size minimizing the number of multiplications in GT of
+// MultiExpGT, that is (number of windows) * (number of bases + 2 * number of buckets).
+// The (signed) digits must fit on the 15 bits of the encoding of partitionScalars.
+func bestCGT(nbBases, scalarBits int) uint64 {
+	nbBits := uint64(fr.Bits)
+	if scalarBits > 0 {
+		nbBits = uint64(scalarBits) + 1
+	}
+	best, bestCost := uint64(2), uint64(0)
+	for c := uint64(2); c <= 15; c++ {
+		if scalarBits == 0 && lastC(c) > 15 {
+			continue
+		}
+		nbChunks := (nbBits + c - 1) / c
+		cost := nbChunks * (uint64(nbBases) + (1 << c))
+		if bestCost == 0 || cost < bestCost {
+			best, bestCost = c, cost
+		}
+	}
+	return best
+}
+
+// BatchExpGT returns basesᵢ^scalarsᵢ for each i, using GT.ExpGLV.
+//
+// The bases must be in GT.
+func BatchExpGT(bases []GT, scalars []fr.Element) ([]GT, error) {
+	if len(bases) != len(scalars) {
+		return nil, errGTLength
+	}
+	res := make([]GT, len(bases))
+	parallel.Execute(len(bases), func(start, end int) {
+		var k big.Int
+		for i := start; i < end; i++ {
+			scalars[i].BigInt(&k)
+			res[i].ExpGLV(bases[i], &k)
+		}
+	})
+	return res, nil
+}
+
+// FrobeniusGT returns z^(pᵏ), the k-th power of the Frobenius map π: z → zᵖ of the degree 24
+// extension of 𝔽p that contains GT. k is reduced modulo 24.
+//
+// On GT, π acts as the exponentiation by p mod r; it is also a method of GT
+// (GT.Frobenius, GT.FrobeniusSquare, GT.FrobeniusQuad).
+func FrobeniusGT(z *GT, k int) GT {
+	const embeddingDegree = 24
+	k %= embeddingDegree
+	if k < 0 {
+		k += embeddingDegree
+	}
+	var res GT
+	res.Set(z)
+	for ; k >= 4; k -= 4 {
+		res.FrobeniusQuad(&res)
+	}
+	for ; k >= 2; k -= 2 {
+		res.FrobeniusSquare(&res)
+	}
+	for ; k > 0; k-- {
+		res.Frobenius(&res)
+	}
+	return res
+}
+
+// BatchIsInSubGroupGT reports whether all the elements are in GT, e.g. pairing outputs received
+// from other parties, which must be validated before they are combined. The subgroup checks
+// run in parallel. Unlike GT.IsInSubGroup, 0 is rejected.
+func BatchIsInSubGroupGT(elements []GT) bool {
+	var nbErrs uint64
+	parallel.Execute(len(elements), func(start, end int) {
+		for i := start; i < end; i++ {
+			if elements[i].IsZero() || !elements[i].IsInSubGroup() {
+				atomic.AddUint64(&nbErrs, 1)
+				return
+			}
+		}
+	})
+	return nbErrs == 0
+}
+
+// BatchEqualGT reports whether a and b have the same length and aᵢ = bᵢ for each i.
+func BatchEqualGT(a, b []GT) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(&b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// The canonical encoding of an element of GT is GT.Bytes: the SizeOfGT bytes of the big endian
+// regular form coordinates. Protocols using pairing outputs as shared secrets or challenges
+// should go through HashGTToField or BindGT below rather than serializing the coordinates
+// themselves, so that they all agree on the ordering.
+
+// HashGTToField hashes the canonical encoding of z into fr, with the hash to field of
+// RFC 9380 (expand_message_xmd with SHA-256) and the domain separation tag dst.
+func HashGTToField(z *GT, dst []byte) (fr.Element, error) {
+	b := z.Bytes()
+	res, err := fr.Hash(b[:], dst, 1)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	return res[0], nil
+}
+
+// BindGT binds the challenge challengeID of t to the canonical encoding of z. The encoding
+// has a fixed size, so it isn't prefixed with its length.
+func BindGT(t *fiatshamir.Transcript, challengeID string, z *GT) error {
+	b := z.Bytes()
+	return t.Bind(challengeID, b[:])
+}
@@ -0,0 +1,150 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+)
+
+// aggregatedProofsTestCase returns n opening proofs, computed separately
+func aggregatedProofsTestCase(t *testing.T, n int) ([]Digest, []fr.Element, []fr.Element, []OpeningProof) {
+	digests := make([]Digest, n)
+	points := make([]fr.Element, n)
+	claimedValues := make([]fr.Element, n)
+	proofs := make([]OpeningProof, n)
+	for i := 0; i < n; i++ {
+		f := randomPolynomial(10 + i)
+		var err error
+		if digests[i], err = Commit(f, testSrs.Pk); err != nil {
+			t.Fatal(err)
+		}
+		points[i].SetRandom()
+		if proofs[i], err = Open(f, points[i], testSrs.Pk); err != nil {
+			t.Fatal(err)
+		}
+		claimedValues[i] = proofs[i].ClaimedValue
+	}
+	return digests, points, claimedValues, proofs
+}
+
+func TestAggregateProofs(t *testing.T) {
+
+	const n = 5 // not a power of two
+	key, err := NewAggregationKey(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	digests, points, claimedValues, proofs := aggregatedProofsTestCase(t, n)
+	hf := sha256.New()
+
+	proof, err := AggregateProofs(key, digests, points, proofs, hf, []byte("data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(proof.LT) != 3 {
+		t.Fatal("the proof should have log₂(8) rounds")
+	}
+	if err := VerifyAggregatedProofs(key, digests, points, claimedValues, &proof, hf, testSrs.Vk, []byte("data")); err != nil {
+		t.Fatal(err)
+	}
+
+	// serialization round trip
+	var buf bytes.Buffer
+	written, err := proof.WriteTo(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var _proof AggregatedProofs
+	read, err := _proof.ReadFrom(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if written != read {
+		t.Fatal("bytes read don't match bytes written")
+	}
+	if err := VerifyAggregatedProofs(key, digests, points, claimedValues, &_proof, hf, testSrs.Vk, []byte("data")); err != nil {
+		t.Fatal(err)
+	}
+
+	// wrong claimed value
+	claimedValues[2].SetRandom()
+	if err := VerifyAggregatedProofs(key, digests, points, claimedValues, &proof, hf, testSrs.Vk, []byte("data")); err == nil {
+		t.Fatal("verifying a wrong claimed value should fail")
+	}
+	claimedValues[2] = proofs[2].ClaimedValue
+
+	// wrong transcript data
+	if err := VerifyAggregatedProofs(key, digests, points, claimedValues, &proof, hf, testSrs.Vk, []byte("other")); err == nil {
+		t.Fatal("verifying with other transcript data should fail")
+	}
+
+	// tampered folded proof
+	tampered := proof
+	tampered.H.Add(&tampered.H, &testSrs.Vk.G1)
+	if err := VerifyAggregatedProofs(key, digests, points, claimedValues, &tampered, hf, testSrs.Vk, []byte("data")); err == nil {
+		t.Fatal("verifying a tampered proof should fail")
+	}
+}
+
+func TestAggregateProofsInvalidProof(t *testing.T) {
+
+	const n = 4
+	key, err := NewAggregationKey(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	digests, points, claimedValues, proofs := aggregatedProofsTestCase(t, n)
+	hf := sha256.New()
+
+	// one of the aggregated proofs is wrong
+	proofs[1].H.Add(&proofs[1].H, &testSrs.Vk.G1)
+	proof, err := AggregateProofs(key, digests, points, proofs, hf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyAggregatedProofs(key, digests, points, claimedValues, &proof, hf, testSrs.Vk); err == nil {
+		t.Fatal("the aggregation of a wrong proof should not verify")
+	}
+
+	// the key is too small
+	if _, err := AggregateProofs(AggregationKey{V: key.V[:2]}, digests, points, proofs, hf); err != ErrAggregationKeySize {
+		t.Fatal("expected ErrAggregationKeySize, got", err)
+	}
+}
+
+func TestAggregationKey(t *testing.T) {
+	small, err := NewAggregationKey(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	large, err := NewAggregationKey(16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(small.V) != 4 || len(large.V) != 16 {
+		t.Fatal("the key should have the next power of two points")
+	}
+	for i := range small.V {
+		if !small.V[i].Equal(&large.V[i]) {
+			t.Fatal("the keys of different sizes should share their points")
+		}
+	}
+}
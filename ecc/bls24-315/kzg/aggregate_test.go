@@ -0,0 +1,161 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+)
+
+func TestAggregateOpen(t *testing.T) {
+
+	const nbPolynomials = 12
+
+	// polynomials of different sizes, opened at points some of which coincide
+	f := make([][]fr.Element, nbPolynomials)
+	digests := make([]Digest, nbPolynomials)
+	points := make([]fr.Element, nbPolynomials)
+	for i := range f {
+		f[i] = randomPolynomial(20 + 10*i)
+		var err error
+		digests[i], err = Commit(f[i], testSrs.Pk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i%3 == 0 {
+			points[i].SetRandom()
+		} else {
+			points[i] = points[i-1]
+		}
+	}
+	hf := sha256.New()
+
+	proof, err := AggregateOpen(f, digests, points, hf, testSrs.Pk, []byte("data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range f {
+		expected := eval(f[i], points[i])
+		if !proof.ClaimedValues[i].Equal(&expected) {
+			t.Fatal("inconsistent claimed values")
+		}
+	}
+
+	if err := VerifyAggregated(digests, &proof, points, hf, testSrs.Vk, []byte("data")); err != nil {
+		t.Fatal(err)
+	}
+
+	// serialization round trip
+	var buf bytes.Buffer
+	n, err := proof.WriteTo(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var _proof AggregatedOpeningProof
+	m, err := _proof.ReadFrom(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != m {
+		t.Fatal("bytes read don't match bytes written")
+	}
+	if err := VerifyAggregated(digests, &_proof, points, hf, testSrs.Vk, []byte("data")); err != nil {
+		t.Fatal(err)
+	}
+
+	// a different transcript fails
+	if err := VerifyAggregated(digests, &proof, points, hf, testSrs.Vk); err == nil {
+		t.Fatal("verifying with a different transcript should have failed")
+	}
+
+	{
+		// wrong claimed value
+		_proof := proof
+		_proof.ClaimedValues = make([]fr.Element, nbPolynomials)
+		copy(_proof.ClaimedValues, proof.ClaimedValues)
+		_proof.ClaimedValues[5].Double(&_proof.ClaimedValues[5])
+		if err := VerifyAggregated(digests, &_proof, points, hf, testSrs.Vk, []byte("data")); err == nil {
+			t.Fatal("verifying a wrong claimed value should have failed")
+		}
+	}
+	{
+		// wrong point
+		_points := make([]fr.Element, nbPolynomials)
+		copy(_points, points)
+		_points[1].SetRandom()
+		if err := VerifyAggregated(digests, &proof, _points, hf, testSrs.Vk, []byte("data")); err == nil {
+			t.Fatal("verifying at a wrong point should have failed")
+		}
+	}
+	{
+		// quotients set to zero
+		_proof := proof
+		_proof.W.X.SetZero()
+		_proof.W.Y.SetZero()
+		_proof.WPrime.X.SetZero()
+		_proof.WPrime.Y.SetZero()
+		if err := VerifyAggregated(digests, &_proof, points, hf, testSrs.Vk, []byte("data")); err == nil {
+			t.Fatal("verifying a proof with zero quotients should have failed")
+		}
+	}
+
+	// invalid sizes
+	if _, err := AggregateOpen(f, digests, points[1:], hf, testSrs.Pk); err != ErrInvalidNbPoints {
+		t.Fatal("expected ErrInvalidNbPoints, got", err)
+	}
+	if err := VerifyAggregated(digests[1:], &proof, points, hf, testSrs.Vk); err != ErrInvalidNbDigests {
+		t.Fatal("expected ErrInvalidNbDigests, got", err)
+	}
+	if _, err := AggregateOpen(nil, nil, nil, hf, testSrs.Pk); err != ErrZeroNbDigests {
+		t.Fatal("expected ErrZeroNbDigests, got", err)
+	}
+}
+
+func BenchmarkAggregateOpen(b *testing.B) {
+	const nbPolynomials = 64
+	srs, err := NewSRSInsecure(ecc.NextPowerOfTwo(1<<10), testSeed)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	f := make([][]fr.Element, nbPolynomials)
+	digests := make([]Digest, nbPolynomials)
+	points := make([]fr.Element, nbPolynomials)
+	for i := range f {
+		f[i] = randomPolynomial(1 << 10)
+		digests[i], _ = Commit(f[i], srs.Pk)
+		points[i].SetRandom()
+	}
+	hf := sha256.New()
+
+	b.Run("AggregateOpen", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = AggregateOpen(f, digests, points, hf, srs.Pk)
+		}
+	})
+
+	proof, _ := AggregateOpen(f, digests, points, hf, srs.Pk)
+	b.Run("VerifyAggregated", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = VerifyAggregated(digests, &proof, points, hf, srs.Vk)
+		}
+	})
+}
@@ -0,0 +1,191 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fflonk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/kzg"
+)
+
+// testSrs has enough G₂ powers for up to 7 combined polynomials
+var testSrs *kzg.SRS
+
+func init() {
+	var err error
+	testSrs, err = kzg.NewSRSInsecure(256, []byte("gnark-crypto fflonk tests"), kzg.WithG2Powers(8))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// randomPolynomials returns polynomials of the given sizes, with random coefficients
+func randomPolynomials(sizes ...int) [][]fr.Element {
+	res := make([][]fr.Element, len(sizes))
+	for i := range res {
+		res[i] = make([]fr.Element, sizes[i])
+		for j := range res[i] {
+			res[i][j].SetRandom()
+		}
+	}
+	return res
+}
+
+func TestCombine(t *testing.T) {
+	t.Parallel()
+
+	f := randomPolynomials(5, 3, 4)
+	g := Combine(f)
+
+	// g(x) = ∑ⱼ fⱼ(x³)xʲ
+	var x, x3, expected, xj, tmp fr.Element
+	x.SetRandom()
+	x3.Square(&x).Mul(&x3, &x)
+	xj.SetOne()
+	for j := range f {
+		tmp = eval(f[j], x3)
+		tmp.Mul(&tmp, &xj)
+		expected.Add(&expected, &tmp)
+		xj.Mul(&xj, &x)
+	}
+	if got := eval(g, x); !got.Equal(&expected) {
+		t.Fatal("wrong combination")
+	}
+}
+
+func TestOpen(t *testing.T) {
+	t.Parallel()
+
+	testCases := [][]int{
+		{20},
+		{10, 7},
+		{30, 1, 12, 30, 5},
+		{1, 1, 1},
+	}
+	for _, sizes := range testCases {
+		f := randomPolynomials(sizes...)
+		digest, err := Commit(f, testSrs.Pk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		vk, err := NewVerifyingKey(testSrs, len(f))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var point fr.Element
+		point.SetRandom()
+		proof, err := Open(f, point, testSrs.Pk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for j := range f {
+			if expected := eval(f[j], point); !expected.Equal(&proof.ClaimedValues[j]) {
+				t.Fatalf("%v: wrong claimed value %d", sizes, j)
+			}
+		}
+		if err := Verify(&digest, &proof, point, vk); err != nil {
+			t.Fatalf("%v: %v", sizes, err)
+		}
+
+		// wrong claimed value
+		proof.ClaimedValues[len(f)-1].Double(&proof.ClaimedValues[len(f)-1])
+		if err := Verify(&digest, &proof, point, vk); !errors.Is(err, ErrVerifyOpeningProof) {
+			t.Fatalf("%v: expected ErrVerifyOpeningProof, got %v", sizes, err)
+		}
+		proof.ClaimedValues[len(f)-1] = eval(f[len(f)-1], point)
+
+		// wrong point, unless the polynomials are constants
+		if len(Combine(f)) > len(f) {
+			var other fr.Element
+			other.SetRandom()
+			if err := Verify(&digest, &proof, other, vk); !errors.Is(err, ErrVerifyOpeningProof) {
+				t.Fatalf("%v: expected ErrVerifyOpeningProof, got %v", sizes, err)
+			}
+		}
+
+		// verifying key for another number of polynomials
+		otherVk, err := NewVerifyingKey(testSrs, len(f)+1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := Verify(&digest, &proof, point, otherVk); !errors.Is(err, ErrNbClaimedValues) {
+			t.Fatalf("%v: expected ErrNbClaimedValues, got %v", sizes, err)
+		}
+	}
+}
+
+func TestOpenSinglePolynomial(t *testing.T) {
+	t.Parallel()
+
+	// with a single polynomial, fflonk is a regular KZG opening
+	f := randomPolynomials(40)
+	var point fr.Element
+	point.SetRandom()
+
+	proof, err := Open(f, point, testSrs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := kzg.Open(f[0], point, testSrs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proof.H.Equal(&expected.H) || !proof.ClaimedValues[0].Equal(&expected.ClaimedValue) {
+		t.Fatal("proof differs from the kzg opening proof")
+	}
+}
+
+func TestNewVerifyingKey(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewVerifyingKey(testSrs, 0); !errors.Is(err, ErrNbPolynomials) {
+		t.Fatalf("expected ErrNbPolynomials, got %v", err)
+	}
+	if _, err := NewVerifyingKey(testSrs, len(testSrs.Pk.G2)); !errors.Is(err, ErrMissingG2Powers) {
+		t.Fatalf("expected ErrMissingG2Powers, got %v", err)
+	}
+	srs, err := kzg.NewSRSInsecure(16, []byte("gnark-crypto fflonk tests"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewVerifyingKey(srs, 2); !errors.Is(err, ErrMissingG2Powers) {
+		t.Fatalf("expected ErrMissingG2Powers, got %v", err)
+	}
+}
+
+func BenchmarkOpen(b *testing.B) {
+	const nbPolynomials, size = 4, 1 << 12
+	srs, err := kzg.NewSRSInsecure(nbPolynomials*size, []byte("gnark-crypto fflonk tests"), kzg.WithG2Powers(nbPolynomials+1))
+	if err != nil {
+		b.Fatal(err)
+	}
+	sizes := make([]int, nbPolynomials)
+	for i := range sizes {
+		sizes[i] = size
+	}
+	f := randomPolynomials(sizes...)
+	var point fr.Element
+	point.SetRandom()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = Open(f, point, srs.Pk)
+	}
+}
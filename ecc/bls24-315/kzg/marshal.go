@@ -17,18 +17,82 @@
 package kzg
 
 import (
-	"github.com/consensys/gnark-crypto/ecc/bls24-315"
+	"encoding/json"
+	"errors"
 	"io"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/internal/marshal"
 )
 
+// readHeader reads the header of an object of type object on bls24-315, if any, and returns
+// the reader from which the rest of the object must be read.
+//
+// Objects serialized without a header (legacy encoding) are accepted.
+func readHeader(r io.Reader, object ecc.ObjectType) (io.Reader, int64, error) {
+	r, _, n, err := readHeaderFlags(r, object)
+	return r, n, err
+}
+
+// readHeaderFlags is readHeader, which also returns the flags of the header (none for
+// the legacy encoding).
+func readHeaderFlags(r io.Reader, object ecc.ObjectType) (io.Reader, ecc.HeaderFlag, int64, error) {
+	h, r, n, err := ecc.ReadHeader(r)
+	if err != nil {
+		return r, 0, n, err
+	}
+	if err := h.Check(ecc.BLS24_315, object); err != nil {
+		return r, 0, n, err
+	}
+	if h == nil {
+		return r, 0, n, nil
+	}
+	return r, h.Flags, n, nil
+}
+
+// writeWithHeader writes the header identifying an object of type object on bls24-315,
+// followed by the object encoding (with or without point compression).
+func writeWithHeader(w io.Writer, object ecc.ObjectType, writeTo func(io.Writer, ...func(*bls24315.Encoder)) (int64, error), raw bool) (int64, error) {
+	return writeWithFlags(w, object, 0, writeTo, raw)
+}
+
+// writeWithFlags is writeWithHeader, with additional flags set in the header.
+func writeWithFlags(w io.Writer, object ecc.ObjectType, flags ecc.HeaderFlag, writeTo func(io.Writer, ...func(*bls24315.Encoder)) (int64, error), raw bool) (int64, error) {
+	var options []func(*bls24315.Encoder)
+	if raw {
+		flags |= ecc.FlagRawEncoding
+		options = append(options, bls24315.RawEncoding())
+	}
+	h := ecc.NewHeader(ecc.BLS24_315, object, flags)
+	hn, err := h.WriteTo(w)
+	if err != nil {
+		return hn, err
+	}
+	n, err := writeTo(w, options...)
+	return hn + n, err
+}
+
 // WriteTo writes binary encoding of the ProvingKey
+//
+// The optional powers pk.G2 are encoded after pk.G1, and signaled by ecc.FlagExtendedG2
+// in the header; a ProvingKey without them has the same encoding as before they existed.
 func (pk *ProvingKey) WriteTo(w io.Writer) (int64, error) {
-	return pk.writeTo(w)
+	return writeWithFlags(w, ecc.ObjectProvingKey, pk.flags(), pk.writeTo, false)
 }
 
 // WriteRawTo writes binary encoding of ProvingKey to w without point compression
 func (pk *ProvingKey) WriteRawTo(w io.Writer) (int64, error) {
-	return pk.writeTo(w, bls24315.RawEncoding())
+	return writeWithFlags(w, ecc.ObjectProvingKey, pk.flags(), pk.writeTo, true)
+}
+
+// flags returns the header flags describing the encoding of pk
+func (pk *ProvingKey) flags() ecc.HeaderFlag {
+	if len(pk.G2) != 0 {
+		return ecc.FlagExtendedG2
+	}
+	return 0
 }
 
 func (pk *ProvingKey) writeTo(w io.Writer, options ...func(*bls24315.Encoder)) (int64, error) {
@@ -37,17 +101,36 @@ func (pk *ProvingKey) writeTo(w io.Writer, options ...func(*bls24315.Encoder)) (
 	if err := enc.Encode(pk.G1); err != nil {
 		return enc.BytesWritten(), err
 	}
+	if len(pk.G2) != 0 {
+		if err := enc.Encode(pk.G2); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
 	return enc.BytesWritten(), nil
 }
 
+// decode decodes the ProvingKey encoded with the given header flags
+func (pk *ProvingKey) decode(dec *bls24315.Decoder, flags ecc.HeaderFlag) error {
+	if err := dec.Decode(&pk.G1); err != nil {
+		return err
+	}
+	pk.G2 = nil
+	if flags&ecc.FlagExtendedG2 != 0 {
+		if err := dec.Decode(&pk.G2); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // WriteRawTo writes binary encoding of VerifyingKey to w without point compression
 func (vk *VerifyingKey) WriteRawTo(w io.Writer) (int64, error) {
-	return vk.writeTo(w, bls24315.RawEncoding())
+	return writeWithHeader(w, ecc.ObjectVerifyingKey, vk.writeTo, true)
 }
 
 // WriteTo writes binary encoding of the VerifyingKey
 func (vk *VerifyingKey) WriteTo(w io.Writer) (int64, error) {
-	return vk.writeTo(w)
+	return writeWithHeader(w, ecc.ObjectVerifyingKey, vk.writeTo, false)
 }
 
 func (vk *VerifyingKey) writeTo(w io.Writer, options ...func(*bls24315.Encoder)) (int64, error) {
@@ -78,53 +161,66 @@ func (vk *VerifyingKey) writeTo(w io.Writer, options ...func(*bls24315.Encoder))
 
 // WriteTo writes binary encoding of the entire SRS
 func (srs *SRS) WriteTo(w io.Writer) (int64, error) {
-	// encode the SRS
-	var pn, vn int64
-	var err error
-	if pn, err = srs.Pk.WriteTo(w); err != nil {
-		return pn, err
-	}
-	vn, err = srs.Vk.WriteTo(w)
-	return pn + vn, err
+	return writeWithFlags(w, ecc.ObjectSRS, srs.Pk.flags(), srs.writeTo, false)
 }
 
 // WriteRawTo writes binary encoding of the entire SRS without point compression
 func (srs *SRS) WriteRawTo(w io.Writer) (int64, error) {
+	return writeWithFlags(w, ecc.ObjectSRS, srs.Pk.flags(), srs.writeTo, true)
+}
+
+func (srs *SRS) writeTo(w io.Writer, options ...func(*bls24315.Encoder)) (int64, error) {
 	// encode the SRS
 	var pn, vn int64
 	var err error
-	if pn, err = srs.Pk.WriteRawTo(w); err != nil {
+	if pn, err = srs.Pk.writeTo(w, options...); err != nil {
 		return pn, err
 	}
-	vn, err = srs.Vk.WriteRawTo(w)
+	vn, err = srs.Vk.writeTo(w, options...)
 	return pn + vn, err
 }
 
 // ReadFrom decodes ProvingKey data from reader.
 func (pk *ProvingKey) ReadFrom(r io.Reader) (int64, error) {
-	// decode the ProvingKey
-	dec := bls24315.NewDecoder(r)
-	if err := dec.Decode(&pk.G1); err != nil {
-		return dec.BytesRead(), err
-	}
-	return dec.BytesRead(), nil
+	return pk.readFrom(r)
 }
 
 // UnsafeReadFrom decodes ProvingKey data from reader without checking
 // that point are in the correct subgroup.
 func (pk *ProvingKey) UnsafeReadFrom(r io.Reader) (int64, error) {
+	return pk.readFrom(r, bls24315.NoSubgroupChecks())
+}
+
+func (pk *ProvingKey) readFrom(r io.Reader, options ...func(*bls24315.Decoder)) (int64, error) {
+	r, flags, hn, err := readHeaderFlags(r, ecc.ObjectProvingKey)
+	if err != nil {
+		return hn, err
+	}
+
 	// decode the ProvingKey
-	dec := bls24315.NewDecoder(r, bls24315.NoSubgroupChecks())
-	if err := dec.Decode(&pk.G1); err != nil {
-		return dec.BytesRead(), err
+	dec := bls24315.NewDecoder(r, options...)
+	if err := pk.decode(dec, flags); err != nil {
+		return hn + dec.BytesRead(), err
 	}
-	return dec.BytesRead(), nil
+	return hn + dec.BytesRead(), nil
 }
 
 // ReadFrom decodes VerifyingKey data from reader.
 func (vk *VerifyingKey) ReadFrom(r io.Reader) (int64, error) {
-	// decode the VerifyingKey
+	r, hn, err := readHeader(r, ecc.ObjectVerifyingKey)
+	if err != nil {
+		return hn, err
+	}
+
 	dec := bls24315.NewDecoder(r)
+	if err := vk.decode(dec); err != nil {
+		return hn + dec.BytesRead(), err
+	}
+	return hn + dec.BytesRead(), nil
+}
+
+func (vk *VerifyingKey) decode(dec *bls24315.Decoder) error {
+	// decode the VerifyingKey
 	nLines := 32
 	toDecode := make([]interface{}, 0, 4*nLines+3)
 	toDecode = append(toDecode, &vk.G2[0])
@@ -141,40 +237,121 @@ func (vk *VerifyingKey) ReadFrom(r io.Reader) (int64, error) {
 
 	for _, v := range toDecode {
 		if err := dec.Decode(v); err != nil {
-			return dec.BytesRead(), err
+			return err
 		}
 	}
 
-	return dec.BytesRead(), nil
+	return nil
 }
 
 // ReadFrom decodes SRS data from reader.
+//
+// The points are decoded in parallel, and the subgroup checks of the ProvingKey
+// and VerifyingKey points are batched in a single pass once the SRS is read.
 func (srs *SRS) ReadFrom(r io.Reader) (int64, error) {
-	// decode the VerifyingKey
-	var pn, vn int64
-	var err error
-	if pn, err = srs.Pk.ReadFrom(r); err != nil {
-		return pn, err
+	return srs.readFrom(r)
+}
+
+// LenientReadFrom decodes SRS data from reader, accepting non-canonical encodings
+// (see bls24315.NonCanonicalEncodings). Points are still checked to be in
+// the correct subgroup.
+func (srs *SRS) LenientReadFrom(r io.Reader) (int64, error) {
+	return srs.readFrom(r, bls24315.NonCanonicalEncodings())
+}
+
+func (srs *SRS) readFrom(r io.Reader, options ...func(*bls24315.Decoder)) (int64, error) {
+	r, flags, hn, err := readHeaderFlags(r, ecc.ObjectSRS)
+	if err != nil {
+		return hn, err
 	}
-	vn, err = srs.Vk.ReadFrom(r)
-	return pn + vn, err
+
+	options = append(options, bls24315.BatchSubgroupChecks())
+	dec := bls24315.NewDecoder(r, options...)
+	if err := srs.Pk.decode(dec, flags); err != nil {
+		return hn + dec.BytesRead(), err
+	}
+	if err := srs.Vk.decode(dec); err != nil {
+		return hn + dec.BytesRead(), err
+	}
+	if err := dec.CheckSubGroups(); err != nil {
+		return hn + dec.BytesRead(), err
+	}
+	return hn + dec.BytesRead(), srs.checkG2Powers()
 }
 
 // UnsafeReadFrom decodes SRS data from reader without sub group checks
 func (srs *SRS) UnsafeReadFrom(r io.Reader) (int64, error) {
-	// decode the VerifyingKey
-	var pn, vn int64
-	var err error
-	if pn, err = srs.Pk.UnsafeReadFrom(r); err != nil {
-		return pn, err
+	r, flags, hn, err := readHeaderFlags(r, ecc.ObjectSRS)
+	if err != nil {
+		return hn, err
 	}
-	vn, err = srs.Vk.ReadFrom(r)
-	return pn + vn, err
+
+	// the ProvingKey is decoded without subgroup checks, the VerifyingKey is checked
+	pDec := bls24315.NewDecoder(r, bls24315.NoSubgroupChecks())
+	if err := srs.Pk.decode(pDec, flags); err != nil {
+		return hn + pDec.BytesRead(), err
+	}
+	vDec := bls24315.NewDecoder(r)
+	err = srs.Vk.decode(vDec)
+	return hn + pDec.BytesRead() + vDec.BytesRead(), err
+}
+
+// WriteTo writes binary encoding of a SRSSlice
+func (slice *SRSSlice) WriteTo(w io.Writer) (int64, error) {
+	return writeWithHeader(w, ecc.ObjectSRSSlice, slice.writeTo, false)
+}
+
+// WriteRawTo writes binary encoding of a SRSSlice without point compression
+func (slice *SRSSlice) WriteRawTo(w io.Writer) (int64, error) {
+	return writeWithHeader(w, ecc.ObjectSRSSlice, slice.writeTo, true)
+}
+
+func (slice *SRSSlice) writeTo(w io.Writer, options ...func(*bls24315.Encoder)) (int64, error) {
+	enc := bls24315.NewEncoder(w, options...)
+	toEncode := []interface{}{
+		slice.From,
+		&slice.Proof,
+		slice.G1,
+	}
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes SRSSlice data from reader.
+//
+// The points are subgroup checked; the slice must still be checked against the
+// VerifyingKey with Verify.
+func (slice *SRSSlice) ReadFrom(r io.Reader) (int64, error) {
+	r, hn, err := readHeader(r, ecc.ObjectSRSSlice)
+	if err != nil {
+		return hn, err
+	}
+
+	dec := bls24315.NewDecoder(r, bls24315.BatchSubgroupChecks())
+	toDecode := []interface{}{
+		&slice.From,
+		&slice.Proof,
+		&slice.G1,
+	}
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return hn + dec.BytesRead(), err
+		}
+	}
+	return hn + dec.BytesRead(), dec.CheckSubGroups()
 }
 
 // WriteTo writes binary encoding of a OpeningProof
 func (proof *OpeningProof) WriteTo(w io.Writer) (int64, error) {
-	enc := bls24315.NewEncoder(w)
+	return writeWithHeader(w, ecc.ObjectOpeningProof, proof.writeTo, false)
+}
+
+func (proof *OpeningProof) writeTo(w io.Writer, options ...func(*bls24315.Encoder)) (int64, error) {
+	enc := bls24315.NewEncoder(w, options...)
 
 	toEncode := []interface{}{
 		&proof.H,
@@ -192,7 +369,22 @@ func (proof *OpeningProof) WriteTo(w io.Writer) (int64, error) {
 
 // ReadFrom decodes OpeningProof data from reader.
 func (proof *OpeningProof) ReadFrom(r io.Reader) (int64, error) {
-	dec := bls24315.NewDecoder(r)
+	return proof.readFrom(r)
+}
+
+// LenientReadFrom decodes OpeningProof data from reader, accepting non-canonical encodings
+// (see bls24315.NonCanonicalEncodings).
+func (proof *OpeningProof) LenientReadFrom(r io.Reader) (int64, error) {
+	return proof.readFrom(r, bls24315.NonCanonicalEncodings())
+}
+
+func (proof *OpeningProof) readFrom(r io.Reader, options ...func(*bls24315.Decoder)) (int64, error) {
+	r, hn, err := readHeader(r, ecc.ObjectOpeningProof)
+	if err != nil {
+		return hn, err
+	}
+
+	dec := bls24315.NewDecoder(r, options...)
 
 	toDecode := []interface{}{
 		&proof.H,
@@ -201,16 +393,20 @@ func (proof *OpeningProof) ReadFrom(r io.Reader) (int64, error) {
 
 	for _, v := range toDecode {
 		if err := dec.Decode(v); err != nil {
-			return dec.BytesRead(), err
+			return hn + dec.BytesRead(), err
 		}
 	}
 
-	return dec.BytesRead(), nil
+	return hn + dec.BytesRead(), nil
 }
 
 // WriteTo writes binary encoding of a BatchOpeningProof
 func (proof *BatchOpeningProof) WriteTo(w io.Writer) (int64, error) {
-	enc := bls24315.NewEncoder(w)
+	return writeWithHeader(w, ecc.ObjectBatchOpeningProof, proof.writeTo, false)
+}
+
+func (proof *BatchOpeningProof) writeTo(w io.Writer, options ...func(*bls24315.Encoder)) (int64, error) {
+	enc := bls24315.NewEncoder(w, options...)
 
 	toEncode := []interface{}{
 		&proof.H,
@@ -226,9 +422,28 @@ func (proof *BatchOpeningProof) WriteTo(w io.Writer) (int64, error) {
 	return enc.BytesWritten(), nil
 }
 
-// ReadFrom decodes BatchOpeningProof data from reader.
+// ReadFrom decodes BatchOpeningProof data from reader, written by WriteTo or WriteCompactTo.
 func (proof *BatchOpeningProof) ReadFrom(r io.Reader) (int64, error) {
-	dec := bls24315.NewDecoder(r)
+	return proof.readFrom(r)
+}
+
+// LenientReadFrom decodes BatchOpeningProof data from reader, accepting non-canonical encodings
+// (see bls24315.NonCanonicalEncodings).
+func (proof *BatchOpeningProof) LenientReadFrom(r io.Reader) (int64, error) {
+	return proof.readFrom(r, bls24315.NonCanonicalEncodings())
+}
+
+func (proof *BatchOpeningProof) readFrom(r io.Reader, options ...func(*bls24315.Decoder)) (int64, error) {
+	r, flags, hn, err := readHeaderFlags(r, ecc.ObjectBatchOpeningProof)
+	if err != nil {
+		return hn, err
+	}
+
+	dec := bls24315.NewDecoder(r, options...)
+	if flags&ecc.FlagCompactEncoding != 0 {
+		err := proof.decodeCompact(dec, nil)
+		return hn + dec.BytesRead(), err
+	}
 	toDecode := []interface{}{
 		&proof.H,
 		&proof.ClaimedValues,
@@ -236,9 +451,386 @@ func (proof *BatchOpeningProof) ReadFrom(r io.Reader) (int64, error) {
 
 	for _, v := range toDecode {
 		if err := dec.Decode(v); err != nil {
-			return dec.BytesRead(), err
+			return hn + dec.BytesRead(), err
+		}
+	}
+
+	return hn + dec.BytesRead(), nil
+}
+
+// WriteTo writes binary encoding of an AggregatedOpeningProof
+func (proof *AggregatedOpeningProof) WriteTo(w io.Writer) (int64, error) {
+	return writeWithHeader(w, ecc.ObjectAggregatedOpeningProof, proof.writeTo, false)
+}
+
+func (proof *AggregatedOpeningProof) writeTo(w io.Writer, options ...func(*bls24315.Encoder)) (int64, error) {
+	enc := bls24315.NewEncoder(w, options...)
+
+	toEncode := []interface{}{
+		&proof.W,
+		&proof.WPrime,
+		proof.ClaimedValues,
+	}
+
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes AggregatedOpeningProof data from reader.
+func (proof *AggregatedOpeningProof) ReadFrom(r io.Reader) (int64, error) {
+	return proof.readFrom(r)
+}
+
+// LenientReadFrom decodes AggregatedOpeningProof data from reader, accepting non-canonical encodings
+// (see bls24315.NonCanonicalEncodings).
+func (proof *AggregatedOpeningProof) LenientReadFrom(r io.Reader) (int64, error) {
+	return proof.readFrom(r, bls24315.NonCanonicalEncodings())
+}
+
+func (proof *AggregatedOpeningProof) readFrom(r io.Reader, options ...func(*bls24315.Decoder)) (int64, error) {
+	r, hn, err := readHeader(r, ecc.ObjectAggregatedOpeningProof)
+	if err != nil {
+		return hn, err
+	}
+
+	dec := bls24315.NewDecoder(r, options...)
+	toDecode := []interface{}{
+		&proof.W,
+		&proof.WPrime,
+		&proof.ClaimedValues,
+	}
+
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return hn + dec.BytesRead(), err
+		}
+	}
+
+	return hn + dec.BytesRead(), nil
+}
+
+// WriteTo writes binary encoding of an AggregatedProofs: the points of G₁, then the elements
+// of GT (T, LT, RT), encoded with GT.Bytes
+func (proof *AggregatedProofs) WriteTo(w io.Writer) (int64, error) {
+	return writeWithHeader(w, ecc.ObjectAggregatedProofs, proof.writeTo, false)
+}
+
+func (proof *AggregatedProofs) writeTo(w io.Writer, options ...func(*bls24315.Encoder)) (int64, error) {
+	if len(proof.LT) != len(proof.LA) || len(proof.RT) != len(proof.LA) {
+		return 0, ErrVerifyAggregatedProofs
+	}
+	enc := bls24315.NewEncoder(w, options...)
+
+	toEncode := []interface{}{
+		&proof.A,
+		&proof.B,
+		&proof.H,
+		proof.LA,
+		proof.RA,
+		proof.LB,
+		proof.RB,
+	}
+
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+
+	n := enc.BytesWritten()
+	for _, z := range proof.elementsGT() {
+		b := z.Bytes()
+		m, err := w.Write(b[:])
+		n += int64(m)
+		if err != nil {
+			return n, err
 		}
 	}
 
-	return dec.BytesRead(), nil
+	return n, nil
+}
+
+// elementsGT returns T, the LT and the RT, in the order of the encoding
+func (proof *AggregatedProofs) elementsGT() []*bls24315.GT {
+	res := make([]*bls24315.GT, 0, 2*len(proof.LT)+1)
+	res = append(res, &proof.T)
+	for i := range proof.LT {
+		res = append(res, &proof.LT[i])
+	}
+	for i := range proof.RT {
+		res = append(res, &proof.RT[i])
+	}
+	return res
+}
+
+// ReadFrom decodes AggregatedProofs data from reader.
+func (proof *AggregatedProofs) ReadFrom(r io.Reader) (int64, error) {
+	r, hn, err := readHeader(r, ecc.ObjectAggregatedProofs)
+	if err != nil {
+		return hn, err
+	}
+
+	dec := bls24315.NewDecoder(r)
+	toDecode := []interface{}{
+		&proof.A,
+		&proof.B,
+		&proof.H,
+		&proof.LA,
+		&proof.RA,
+		&proof.LB,
+		&proof.RB,
+	}
+
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return hn + dec.BytesRead(), err
+		}
+	}
+
+	n := hn + dec.BytesRead()
+	nbRounds := len(proof.LA)
+	if len(proof.RA) != nbRounds || len(proof.LB) != nbRounds || len(proof.RB) != nbRounds {
+		return n, ErrVerifyAggregatedProofs
+	}
+	proof.LT = make([]bls24315.GT, nbRounds)
+	proof.RT = make([]bls24315.GT, nbRounds)
+	var buf [bls24315.SizeOfGT]byte
+	for _, z := range proof.elementsGT() {
+		m, err := io.ReadFull(r, buf[:])
+		n += int64(m)
+		if err != nil {
+			return n, err
+		}
+		if err := z.SetBytes(buf[:]); err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
+
+// openingProofJSON is the JSON representation of OpeningProof and BatchOpeningProof
+type openingProofJSON struct {
+	H             string   `json:"h"`
+	ClaimedValue  string   `json:"claimedValue,omitempty"`
+	ClaimedValues []string `json:"claimedValues,omitempty"`
+}
+
+var errInvalidProofEncoding = errors.New("invalid proof encoding")
+
+// MarshalJSON implements json.Marshaler.
+// H and the claimed value are encoded as 0x-prefixed hex strings of their
+// (compressed) binary representation.
+func (proof *OpeningProof) MarshalJSON() ([]byte, error) {
+	h := proof.H.Bytes()
+	v := proof.ClaimedValue.Bytes()
+	return json.Marshal(openingProofJSON{
+		H:            marshal.HexString(h[:]),
+		ClaimedValue: marshal.HexString(v[:]),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (proof *OpeningProof) UnmarshalJSON(data []byte) error {
+	var p openingProofJSON
+	if err := json.Unmarshal(data, &p); err != nil {
+		return err
+	}
+	if err := setPointHex(&proof.H, p.H); err != nil {
+		return err
+	}
+	return setElementHex(&proof.ClaimedValue, p.ClaimedValue)
+}
+
+// MarshalJSON implements json.Marshaler.
+// H and the claimed values are encoded as 0x-prefixed hex strings of their
+// (compressed) binary representation.
+func (proof *BatchOpeningProof) MarshalJSON() ([]byte, error) {
+	h := proof.H.Bytes()
+	p := openingProofJSON{
+		H:             marshal.HexString(h[:]),
+		ClaimedValues: make([]string, len(proof.ClaimedValues)),
+	}
+	for i := range proof.ClaimedValues {
+		v := proof.ClaimedValues[i].Bytes()
+		p.ClaimedValues[i] = marshal.HexString(v[:])
+	}
+	return json.Marshal(p)
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (proof *BatchOpeningProof) UnmarshalJSON(data []byte) error {
+	var p openingProofJSON
+	if err := json.Unmarshal(data, &p); err != nil {
+		return err
+	}
+	if err := setPointHex(&proof.H, p.H); err != nil {
+		return err
+	}
+	proof.ClaimedValues = make([]fr.Element, len(p.ClaimedValues))
+	for i := range p.ClaimedValues {
+		if err := setElementHex(&proof.ClaimedValues[i], p.ClaimedValues[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalCBOR returns the CBOR encoding of the proof, a map with keys
+// "h" and "claimedValue" whose values are the binary representations
+// of H and of the claimed value, as byte strings.
+func (proof *OpeningProof) MarshalCBOR() ([]byte, error) {
+	h := proof.H.Bytes()
+	v := proof.ClaimedValue.Bytes()
+	var e marshal.CBOREncoder
+	e.WriteMapHeader(2)
+	e.WriteText("h")
+	e.WriteBytes(h[:])
+	e.WriteText("claimedValue")
+	e.WriteBytes(v[:])
+	return e.Bytes(), nil
+}
+
+// UnmarshalCBOR decodes a proof encoded with MarshalCBOR
+func (proof *OpeningProof) UnmarshalCBOR(data []byte) error {
+	d := marshal.NewCBORDecoder(data)
+	n, err := d.ReadMapHeader()
+	if err != nil {
+		return err
+	}
+	if n != 2 {
+		return errInvalidProofEncoding
+	}
+	var hasH, hasValue bool
+	for i := 0; i < n; i++ {
+		key, err := d.ReadText()
+		if err != nil {
+			return err
+		}
+		b, err := d.ReadBytes()
+		if err != nil {
+			return err
+		}
+		switch key {
+		case "h":
+			err = setPoint(&proof.H, b)
+			hasH = true
+		case "claimedValue":
+			err = proof.ClaimedValue.SetBytesCanonical(b)
+			hasValue = true
+		default:
+			err = errInvalidProofEncoding
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if !hasH || !hasValue {
+		return errInvalidProofEncoding
+	}
+	return d.Done()
+}
+
+// MarshalCBOR returns the CBOR encoding of the proof, a map with keys
+// "h" and "claimedValues" whose values are the binary representation
+// of H as a byte string, and an array of the binary representations
+// of the claimed values as byte strings.
+func (proof *BatchOpeningProof) MarshalCBOR() ([]byte, error) {
+	h := proof.H.Bytes()
+	var e marshal.CBOREncoder
+	e.WriteMapHeader(2)
+	e.WriteText("h")
+	e.WriteBytes(h[:])
+	e.WriteText("claimedValues")
+	e.WriteArrayHeader(len(proof.ClaimedValues))
+	for i := range proof.ClaimedValues {
+		v := proof.ClaimedValues[i].Bytes()
+		e.WriteBytes(v[:])
+	}
+	return e.Bytes(), nil
+}
+
+// UnmarshalCBOR decodes a proof encoded with MarshalCBOR
+func (proof *BatchOpeningProof) UnmarshalCBOR(data []byte) error {
+	d := marshal.NewCBORDecoder(data)
+	n, err := d.ReadMapHeader()
+	if err != nil {
+		return err
+	}
+	if n != 2 {
+		return errInvalidProofEncoding
+	}
+	var hasH, hasValues bool
+	for i := 0; i < n; i++ {
+		key, err := d.ReadText()
+		if err != nil {
+			return err
+		}
+		switch key {
+		case "h":
+			b, err := d.ReadBytes()
+			if err != nil {
+				return err
+			}
+			if err := setPoint(&proof.H, b); err != nil {
+				return err
+			}
+			hasH = true
+		case "claimedValues":
+			nbValues, err := d.ReadArrayHeader()
+			if err != nil {
+				return err
+			}
+			proof.ClaimedValues = make([]fr.Element, nbValues)
+			for j := range proof.ClaimedValues {
+				b, err := d.ReadBytes()
+				if err != nil {
+					return err
+				}
+				if err := proof.ClaimedValues[j].SetBytesCanonical(b); err != nil {
+					return err
+				}
+			}
+			hasValues = true
+		default:
+			return errInvalidProofEncoding
+		}
+	}
+	if !hasH || !hasValues {
+		return errInvalidProofEncoding
+	}
+	return d.Done()
+}
+
+// setPoint sets p from its binary representation, which must span the entire buffer
+func setPoint(p *bls24315.G1Affine, b []byte) error {
+	n, err := p.SetBytes(b)
+	if err != nil {
+		return err
+	}
+	if n != len(b) {
+		return errInvalidProofEncoding
+	}
+	return nil
+}
+
+func setPointHex(p *bls24315.G1Affine, s string) error {
+	b, err := marshal.ParseHexString(s)
+	if err != nil {
+		return err
+	}
+	return setPoint(p, b)
+}
+
+func setElementHex(e *fr.Element, s string) error {
+	b, err := marshal.ParseHexString(s)
+	if err != nil {
+		return err
+	}
+	return e.SetBytesCanonical(b)
 }
@@ -162,6 +162,106 @@ func TestEncoder(t *testing.T) {
 
 }
 
+func TestDecoderBatchSubgroupChecks(t *testing.T) {
+	t.Parallel()
+
+	// enough points to span several decoding chunks
+	const n = 2500
+	inG1 := make([]G1Affine, n)
+	inG2 := make([]G2Affine, 3)
+	var s big.Int
+	for i := 0; i < n; i++ {
+		s.SetUint64(uint64(i))
+		inG1[i].ScalarMultiplication(&g1GenAff, &s)
+	}
+	inG2[1] = g2GenAff
+
+	for _, raw := range []bool{false, true} {
+		var buf bytes.Buffer
+		var enc *Encoder
+		if raw {
+			enc = NewEncoder(&buf, RawEncoding())
+		} else {
+			enc = NewEncoder(&buf)
+		}
+		for _, v := range []interface{}{inG1, &inG1[1], inG2} {
+			if err := enc.Encode(v); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		dec := NewDecoder(&buf, BatchSubgroupChecks())
+		var outG1 []G1Affine
+		var outP G1Affine
+		var outG2 []G2Affine
+		for _, v := range []interface{}{&outG1, &outP, &outG2} {
+			if err := dec.Decode(v); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := dec.CheckSubGroups(); err != nil {
+			t.Fatal(err)
+		}
+		if dec.BytesRead() != enc.BytesWritten() {
+			t.Fatal("bytes read don't match bytes written")
+		}
+		if len(outG1) != n || len(outG2) != len(inG2) || !outP.Equal(&inG1[1]) {
+			t.Fatal("decode(encode(slice(points))) failed")
+		}
+		for i := range inG1 {
+			if !inG1[i].Equal(&outG1[i]) {
+				t.Fatal("decode(encode(slice(points))) failed")
+			}
+		}
+		for i := range inG2 {
+			if !inG2[i].Equal(&outG2[i]) {
+				t.Fatal("decode(encode(slice(points))) failed")
+			}
+		}
+	}
+}
+
+func TestDecoderNonCanonical(t *testing.T) {
+	t.Parallel()
+
+	// modulus + 1 is a non-canonical encoding of 1
+	var e [fr.Bytes]byte
+	new(big.Int).Add(fr.Modulus(), big.NewInt(1)).FillBytes(e[:])
+	var one fr.Element
+	one.SetOne()
+
+	// compressed infinity with a non-zero unused bit
+	var inf G1Affine
+	bInf := inf.Bytes()
+	bInf[len(bInf)-1] = 1
+
+	encodings := []struct {
+		name  string
+		buf   []byte
+		v     interface{}
+		check func(interface{}) bool
+	}{
+		{"fr.Element", e[:], new(fr.Element), func(v interface{}) bool { return v.(*fr.Element).Equal(&one) }},
+		{"[]fr.Element", append([]byte{0, 0, 0, 1}, e[:]...), new([]fr.Element), func(v interface{}) bool {
+			return len(*v.(*[]fr.Element)) == 1 && (*v.(*[]fr.Element))[0].Equal(&one)
+		}},
+		{"G1Affine infinity", bInf[:], new(G1Affine), func(v interface{}) bool { return v.(*G1Affine).IsInfinity() }},
+	}
+
+	for _, tc := range encodings {
+		if err := NewDecoder(bytes.NewReader(tc.buf)).Decode(tc.v); err == nil {
+			t.Fatalf("%s: non-canonical encoding should be rejected by default", tc.name)
+		}
+		dec := NewDecoder(bytes.NewReader(tc.buf), NonCanonicalEncodings())
+		if err := dec.Decode(tc.v); err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if !tc.check(tc.v) || dec.BytesRead() != int64(len(tc.buf)) {
+			t.Fatalf("%s: lenient decoding failed", tc.name)
+		}
+	}
+}
+
 func TestIsCompressed(t *testing.T) {
 	t.Parallel()
 	var g1Inf, g1 G1Affine
@@ -23,6 +23,7 @@ import (
 	"github.com/consensys/gnark-crypto/internal/parallel"
 	"math"
 	"runtime"
+	"sync/atomic"
 )
 
 // MultiExp implements section 4 of https://eprint.iacr.org/2012/549.pdf
@@ -68,6 +69,8 @@ func (p *G1Jac) MultiExp(points []G1Affine, scalars []fr.Element, config ecc.Mul
 	// step 3
 	// reduce the buckets weighed sums into our result (msmReduceChunk)
 
+	defer ecc.StartRegion(ecc.BLS24_315, ecc.OpMultiExpG1, len(points)).End()
+
 	// ensure len(points) == len(scalars)
 	nbPoints := len(points)
 	if nbPoints != len(scalars) {
@@ -81,6 +84,86 @@ func (p *G1Jac) MultiExp(points []G1Affine, scalars []fr.Element, config ecc.Mul
 		return nil, errors.New("invalid config: config.NbTasks > 1024")
 	}
 
+	// if the scalars are known to be small, we check they are and use fewer (and smaller) windows
+	if config.ScalarBits < 0 {
+		return nil, errors.New("invalid config: config.ScalarBits < 0")
+	}
+	if config.ScalarBits >= fr.Bits {
+		config.ScalarBits = 0
+	}
+	if config.ScalarBits > 0 {
+		if err := checkScalarBits(scalars, config.ScalarBits, config.NbTasks, config.ScalarsCanonical); err != nil {
+			return nil, err
+		}
+	}
+
+	if config.DeduplicateScalars {
+		points, scalars = deduplicateScalarsG1(points, scalars, config.NbTasks)
+	}
+
+	return p.multiExp(points, scalars, config), nil
+}
+
+// deduplicateScalarsG1 sums the points sharing the same scalar, and returns the
+// (shorter) lists of aggregated points and distinct non-zero scalars.
+func deduplicateScalarsG1(points []G1Affine, scalars []fr.Element, nbTasks int) ([]G1Affine, []fr.Element) {
+	// group the points by scalar
+	groups := make(map[fr.Element]int)
+	var uniqueScalars []fr.Element
+	var indexes [][]int
+	for i := range scalars {
+		if scalars[i].IsZero() {
+			continue
+		}
+		g, ok := groups[scalars[i]]
+		if !ok {
+			g = len(uniqueScalars)
+			groups[scalars[i]] = g
+			uniqueScalars = append(uniqueScalars, scalars[i])
+			indexes = append(indexes, nil)
+		}
+		indexes[g] = append(indexes[g], i)
+	}
+
+	// sum the points of each group
+	if nbTasks > runtime.NumCPU() {
+		nbTasks = runtime.NumCPU()
+	}
+	aggregated := make([]G1Affine, len(uniqueScalars))
+	parallel.Execute(len(uniqueScalars), func(start, end int) {
+		for g := start; g < end; g++ {
+			if len(indexes[g]) == 1 {
+				aggregated[g] = points[indexes[g][0]]
+				continue
+			}
+			var acc G1Jac
+			acc.FromAffine(&points[indexes[g][0]])
+			for _, i := range indexes[g][1:] {
+				acc.AddMixed(&points[i])
+			}
+			aggregated[g].FromJacobian(&acc)
+		}
+	}, nbTasks)
+
+	return aggregated, uniqueScalars
+}
+
+// multiExp is MultiExp for a validated config
+func (p *G1Jac) multiExp(points []G1Affine, scalars []fr.Element, config ecc.MultiExpConfig) *G1Jac {
+	nbPoints := len(points)
+
+	// number of bits of the scalars
+	nbBits := fr.Bits
+	if config.ScalarBits > 0 {
+		nbBits = config.ScalarBits
+	}
+	nbChunksFor := func(c uint64) int {
+		if config.ScalarBits > 0 {
+			return int(computeNbChunksBounded(c, uint64(nbBits)))
+		}
+		return int(computeNbChunks(c))
+	}
+
 	// here, we compute the best C for nbPoints
 	// we split recursively until nbChunks(c) >= nbTasks,
 	bestC := func(nbPoints int) uint64 {
@@ -93,7 +176,7 @@ func (p *G1Jac) MultiExp(points []G1Affine, scalars []fr.Element, config ecc.Mul
 		// for example, on a MBP 2016, for G2 MultiExp > 8M points, hand picking c gives better results
 		min := math.MaxFloat64
 		for _, c := range implementedCs {
-			cc := (fr.Bits + 1) * (nbPoints + (1 << c))
+			cc := (nbBits + 1) * (nbPoints + (1 << c))
 			cost := float64(cc) / float64(c)
 			if cost < min {
 				min = cost
@@ -104,7 +187,7 @@ func (p *G1Jac) MultiExp(points []G1Affine, scalars []fr.Element, config ecc.Mul
 	}
 
 	C := bestC(nbPoints)
-	nbChunks := int(computeNbChunks(C))
+	nbChunks := nbChunksFor(C)
 
 	// should we recursively split the msm in half? (see below)
 	// we want to minimize the execution time of the algorithm;
@@ -132,7 +215,7 @@ func (p *G1Jac) MultiExp(points []G1Affine, scalars []fr.Element, config ecc.Mul
 	costPreSplit := costFunction(nbChunks, config.NbTasks, costPerTask(C, nbPoints))
 
 	cPostSplit := bestC(nbPoints / 2)
-	nbChunksPostSplit := int(computeNbChunks(cPostSplit))
+	nbChunksPostSplit := nbChunksFor(cPostSplit)
 	costPostSplit := costFunction(nbChunksPostSplit*2, config.NbTasks, costPerTask(cPostSplit, nbPoints/2))
 
 	// if the cost of the split msm is lower than the cost of the non split msm, we split
@@ -141,26 +224,29 @@ func (p *G1Jac) MultiExp(points []G1Affine, scalars []fr.Element, config ecc.Mul
 		var _p G1Jac
 		chDone := make(chan struct{}, 1)
 		go func() {
-			_p.MultiExp(points[:nbPoints/2], scalars[:nbPoints/2], config)
+			_p.multiExp(points[:nbPoints/2], scalars[:nbPoints/2], config)
 			close(chDone)
 		}()
-		p.MultiExp(points[nbPoints/2:], scalars[nbPoints/2:], config)
+		p.multiExp(points[nbPoints/2:], scalars[nbPoints/2:], config)
 		<-chDone
 		p.AddAssign(&_p)
-		return p, nil
+		return p
 	}
 
 	// if we don't split, we use the best C we found
-	_innerMsmG1(p, C, points, scalars, config)
-
-	return p, nil
+	return _innerMsmG1(p, C, points, scalars, config)
 }
 
 func _innerMsmG1(p *G1Jac, c uint64, points []G1Affine, scalars []fr.Element, config ecc.MultiExpConfig) *G1Jac {
-	// partition the scalars
-	digits, chunkStats := partitionScalars(scalars, c, config.NbTasks)
+	// number of chunks, and window size of the last chunk
+	nbChunks, cLast := computeNbChunks(c), lastC(c)
+	if config.ScalarBits > 0 && config.ScalarBits < fr.Bits {
+		// small scalars: the higher windows are empty, and the last one is a regular c-bit window
+		nbChunks, cLast = computeNbChunksBounded(c, uint64(config.ScalarBits)), c
+	}
 
-	nbChunks := computeNbChunks(c)
+	// partition the scalars
+	digits, chunkStats := partitionScalarsN(scalars, c, nbChunks, config.NbTasks, config.ScalarsCanonical)
 
 	// for each chunk, spawn one go routine that'll loop through all the scalars in the
 	// corresponding bit-window
@@ -191,7 +277,7 @@ func _innerMsmG1(p *G1Jac, c uint64, points []G1Affine, scalars []fr.Element, co
 	for j := int(nbChunks - 1); j >= 0; j-- {
 		processChunk := getChunkProcessorG1(c, chunkStats[j])
 		if j == int(nbChunks-1) {
-			processChunk = getChunkProcessorG1(lastC(c), chunkStats[j])
+			processChunk = getChunkProcessorG1(cLast, chunkStats[j])
 		}
 		if chunkStats[j].weight >= 115 {
 			// we split this in more go routines since this chunk has more work to do than the others.
@@ -366,6 +452,8 @@ func (p *G2Jac) MultiExp(points []G2Affine, scalars []fr.Element, config ecc.Mul
 	// step 3
 	// reduce the buckets weighed sums into our result (msmReduceChunk)
 
+	defer ecc.StartRegion(ecc.BLS24_315, ecc.OpMultiExpG2, len(points)).End()
+
 	// ensure len(points) == len(scalars)
 	nbPoints := len(points)
 	if nbPoints != len(scalars) {
@@ -379,6 +467,86 @@ func (p *G2Jac) MultiExp(points []G2Affine, scalars []fr.Element, config ecc.Mul
 		return nil, errors.New("invalid config: config.NbTasks > 1024")
 	}
 
+	// if the scalars are known to be small, we check they are and use fewer (and smaller) windows
+	if config.ScalarBits < 0 {
+		return nil, errors.New("invalid config: config.ScalarBits < 0")
+	}
+	if config.ScalarBits >= fr.Bits {
+		config.ScalarBits = 0
+	}
+	if config.ScalarBits > 0 {
+		if err := checkScalarBits(scalars, config.ScalarBits, config.NbTasks, config.ScalarsCanonical); err != nil {
+			return nil, err
+		}
+	}
+
+	if config.DeduplicateScalars {
+		points, scalars = deduplicateScalarsG2(points, scalars, config.NbTasks)
+	}
+
+	return p.multiExp(points, scalars, config), nil
+}
+
+// deduplicateScalarsG2 sums the points sharing the same scalar, and returns the
+// (shorter) lists of aggregated points and distinct non-zero scalars.
+func deduplicateScalarsG2(points []G2Affine, scalars []fr.Element, nbTasks int) ([]G2Affine, []fr.Element) {
+	// group the points by scalar
+	groups := make(map[fr.Element]int)
+	var uniqueScalars []fr.Element
+	var indexes [][]int
+	for i := range scalars {
+		if scalars[i].IsZero() {
+			continue
+		}
+		g, ok := groups[scalars[i]]
+		if !ok {
+			g = len(uniqueScalars)
+			groups[scalars[i]] = g
+			uniqueScalars = append(uniqueScalars, scalars[i])
+			indexes = append(indexes, nil)
+		}
+		indexes[g] = append(indexes[g], i)
+	}
+
+	// sum the points of each group
+	if nbTasks > runtime.NumCPU() {
+		nbTasks = runtime.NumCPU()
+	}
+	aggregated := make([]G2Affine, len(uniqueScalars))
+	parallel.Execute(len(uniqueScalars), func(start, end int) {
+		for g := start; g < end; g++ {
+			if len(indexes[g]) == 1 {
+				aggregated[g] = points[indexes[g][0]]
+				continue
+			}
+			var acc G2Jac
+			acc.FromAffine(&points[indexes[g][0]])
+			for _, i := range indexes[g][1:] {
+				acc.AddMixed(&points[i])
+			}
+			aggregated[g].FromJacobian(&acc)
+		}
+	}, nbTasks)
+
+	return aggregated, uniqueScalars
+}
+
+// multiExp is MultiExp for a validated config
+func (p *G2Jac) multiExp(points []G2Affine, scalars []fr.Element, config ecc.MultiExpConfig) *G2Jac {
+	nbPoints := len(points)
+
+	// number of bits of the scalars
+	nbBits := fr.Bits
+	if config.ScalarBits > 0 {
+		nbBits = config.ScalarBits
+	}
+	nbChunksFor := func(c uint64) int {
+		if config.ScalarBits > 0 {
+			return int(computeNbChunksBounded(c, uint64(nbBits)))
+		}
+		return int(computeNbChunks(c))
+	}
+
 	// here, we compute the best C for nbPoints
 	// we split recursively until nbChunks(c) >= nbTasks,
 	bestC := func(nbPoints int) uint64 {
@@ -391,7 +559,7 @@ func (p *G2Jac) MultiExp(points []G2Affine, scalars []fr.Element, config ecc.Mul
 		// for example, on a MBP 2016, for G2 MultiExp > 8M points, hand picking c gives better results
 		min := math.MaxFloat64
 		for _, c := range implementedCs {
-			cc := (fr.Bits + 1) * (nbPoints + (1 << c))
+			cc := (nbBits + 1) * (nbPoints + (1 << c))
 			cost := float64(cc) / float64(c)
 			if cost < min {
 				min = cost
@@ -402,7 +570,7 @@ func (p *G2Jac) MultiExp(points []G2Affine, scalars []fr.Element, config ecc.Mul
 	}
 
 	C := bestC(nbPoints)
-	nbChunks := int(computeNbChunks(C))
+	nbChunks := nbChunksFor(C)
 
 	// should we recursively split the msm in half? (see below)
 	// we want to minimize the execution time of the algorithm;
@@ -430,7 +598,7 @@ func (p *G2Jac) MultiExp(points []G2Affine, scalars []fr.Element, config ecc.Mul
 	costPreSplit := costFunction(nbChunks, config.NbTasks, costPerTask(C, nbPoints))
 
 	cPostSplit := bestC(nbPoints / 2)
-	nbChunksPostSplit := int(computeNbChunks(cPostSplit))
+	nbChunksPostSplit := nbChunksFor(cPostSplit)
 	costPostSplit := costFunction(nbChunksPostSplit*2, config.NbTasks, costPerTask(cPostSplit, nbPoints/2))
 
 	// if the cost of the split msm is lower than the cost of the non split msm, we split
@@ -439,26 +607,29 @@ func (p *G2Jac) MultiExp(points []G2Affine, scalars []fr.Element, config ecc.Mul
 		var _p G2Jac
 		chDone := make(chan struct{}, 1)
 		go func() {
-			_p.MultiExp(points[:nbPoints/2], scalars[:nbPoints/2], config)
+			_p.multiExp(points[:nbPoints/2], scalars[:nbPoints/2], config)
 			close(chDone)
 		}()
-		p.MultiExp(points[nbPoints/2:], scalars[nbPoints/2:], config)
+		p.multiExp(points[nbPoints/2:], scalars[nbPoints/2:], config)
 		<-chDone
 		p.AddAssign(&_p)
-		return p, nil
+		return p
 	}
 
 	// if we don't split, we use the best C we found
-	_innerMsmG2(p, C, points, scalars, config)
-
-	return p, nil
+	return _innerMsmG2(p, C, points, scalars, config)
 }
 
 func _innerMsmG2(p *G2Jac, c uint64, points []G2Affine, scalars []fr.Element, config ecc.MultiExpConfig) *G2Jac {
-	// partition the scalars
-	digits, chunkStats := partitionScalars(scalars, c, config.NbTasks)
+	// number of chunks, and window size of the last chunk
+	nbChunks, cLast := computeNbChunks(c), lastC(c)
+	if config.ScalarBits > 0 && config.ScalarBits < fr.Bits {
+		// small scalars: the higher windows are empty, and the last one is a regular c-bit window
+		nbChunks, cLast = computeNbChunksBounded(c, uint64(config.ScalarBits)), c
+	}
 
-	nbChunks := computeNbChunks(c)
+	// partition the scalars
+	digits, chunkStats := partitionScalarsN(scalars, c, nbChunks, config.NbTasks, config.ScalarsCanonical)
 
 	// for each chunk, spawn one go routine that'll loop through all the scalars in the
 	// corresponding bit-window
@@ -489,7 +660,7 @@ func _innerMsmG2(p *G2Jac, c uint64, points []G2Affine, scalars []fr.Element, co
 	for j := int(nbChunks - 1); j >= 0; j-- {
 		processChunk := getChunkProcessorG2(c, chunkStats[j])
 		if j == int(nbChunks-1) {
-			processChunk = getChunkProcessorG2(lastC(c), chunkStats[j])
+			processChunk = getChunkProcessorG2(cLast, chunkStats[j])
 		}
 		if chunkStats[j].weight >= 115 {
 			// we split this in more go routines since this chunk has more work to do than the others.
@@ -649,6 +820,42 @@ func lastC(c uint64) uint64 {
 	return c + 1 - nbAvailableBits
 }
 
+// return the number of chunks for scalars of at most nbBits bits (nbBits < fr.Bits);
+// the last chunk is a regular c-bit window which accommodates the potential carry
+// from the NAF decomposition
+func computeNbChunksBounded(c, nbBits uint64) uint64 {
+	return nbBits/c + 1
+}
+
+// checkScalarBits returns an error if one of the scalars doesn't fit on nbBits bits;
+// canonical is set if the scalars are in regular form (see ecc.MultiExpConfig).
+func checkScalarBits(scalars []fr.Element, nbBits int, nbTasks int, canonical bool) error {
+	if nbTasks > runtime.NumCPU() {
+		nbTasks = runtime.NumCPU()
+	}
+	word, shift := nbBits/64, uint(nbBits%64)
+	var nbErrs uint64
+	parallel.Execute(len(scalars), func(start, end int) {
+		for i := start; i < end; i++ {
+			scalar := scalarBits(&scalars[i], canonical)
+			if scalar[word]>>shift != 0 {
+				atomic.AddUint64(&nbErrs, 1)
+				return
+			}
+			for j := word + 1; j < fr.Limbs; j++ {
+				if scalar[j] != 0 {
+					atomic.AddUint64(&nbErrs, 1)
+					return
+				}
+			}
+		}
+	}, nbTasks)
+	if nbErrs != 0 {
+		return errors.New("invalid scalars: scalar larger than config.ScalarBits")
+	}
+	return nil
+}
+
 type chunkStat struct {
 	// relative weight of work compared to other chunks. 100.0 -> nominal weight.
 	weight float32
@@ -664,14 +871,27 @@ type chunkStat struct {
 // negative digits can be processed in a later step as adding -G into the bucket instead of G
 // (computing -G is cheap, and this saves us half of the buckets in the MultiExp or BatchScalarMultiplication)
 func partitionScalars(scalars []fr.Element, c uint64, nbTasks int) ([]uint16, []chunkStat) {
+	return partitionScalarsN(scalars, c, computeNbChunks(c), nbTasks, false)
+}
+
+// scalarBits returns the limbs of the regular form of s; if canonical is set, s is
+// already in regular form (see ecc.MultiExpConfig) and isn't converted.
+func scalarBits(s *fr.Element, canonical bool) [fr.Limbs]uint64 {
+	if canonical {
+		return [fr.Limbs]uint64(*s)
+	}
+	return s.Bits()
+}
+
+// partitionScalarsN is partitionScalars where only the first nbChunks c-bit windows
+// of the scalars are considered (the scalars must fit on them); canonical is set if
+// the scalars are in regular form (see ecc.MultiExpConfig).
+func partitionScalarsN(scalars []fr.Element, c, nbChunks uint64, nbTasks int, canonical bool) ([]uint16, []chunkStat) {
 	// no benefit here to have more tasks than CPUs
 	if nbTasks > runtime.NumCPU() {
 		nbTasks = runtime.NumCPU()
 	}
 
-	// number of c-bit radixes in a scalar
-	nbChunks := computeNbChunks(c)
-
 	digits := make([]uint16, len(scalars)*int(nbChunks))
 
 	mask := uint64((1 << c) - 1) // low c bits are 1
@@ -701,7 +921,7 @@ func partitionScalars(scalars []fr.Element, c uint64, nbTasks int) ([]uint16, []
 				// everything is 0, no need to process this scalar
 				continue
 			}
-			scalar := scalars[i].Bits()
+			scalar := scalarBits(&scalars[i], canonical)
 
 			var carry int
 
@@ -0,0 +1,271 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls24315
+
+import (
+	"bytes"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+)
+
+func TestDistributedMultiExpG1(t *testing.T) {
+	t.Parallel()
+
+	const n = 53
+	bases := make([]G1Affine, n)
+	scalars := make([]fr.Element, n)
+	var s big.Int
+	for i := range bases {
+		s.SetUint64(uint64(i + 1))
+		bases[i].ScalarMultiplication(&g1GenAff, &s)
+		scalars[i].SetRandom()
+	}
+	var expected G1Affine
+	if _, err := expected.MultiExp(bases, scalars, ecc.MultiExpConfig{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// more shards than points: some shards are empty
+	for _, nbShards := range []int{1, 4, n + 2} {
+		shards, err := ecc.PartitionMultiExp(n, nbShards)
+		if err != nil {
+			t.Fatal(err)
+		}
+		partials := make([]PartialMultiExpG1, len(shards))
+		for i, shard := range shards {
+			partial, err := NewPartialMultiExpG1(shard, bases[shard.Start:shard.End], scalars[shard.Start:shard.End], ecc.MultiExpConfig{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// the partial results are sent to the combiner; they arrive in reverse order
+			var buf bytes.Buffer
+			if _, err := partial.WriteTo(&buf); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := partials[len(shards)-1-i].ReadFrom(&buf); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		res, err := CombinePartialMultiExpG1(n, partials)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !res.Equal(&expected) {
+			t.Fatalf("%d shards: combined result doesn't match MultiExp", nbShards)
+		}
+
+		// missing or duplicated shards (partials[len(partials)-1] is the first, non-empty, shard)
+		if _, err := CombinePartialMultiExpG1(n, partials[1:]); err == nil && partials[0].Shard.Len() > 0 {
+			t.Fatal("expected an error for a missing shard")
+		}
+		if _, err := CombinePartialMultiExpG1(n, append(partials, partials[len(partials)-1])); err == nil {
+			t.Fatal("expected an error for a duplicated shard")
+		}
+	}
+
+	shard := ecc.MultiExpShard{Start: 2, End: 5}
+	if _, err := NewPartialMultiExpG1(shard, bases[:3], scalars[:2], ecc.MultiExpConfig{}); err == nil {
+		t.Fatal("expected an error for inputs not matching the shard")
+	}
+}
+
+func TestMultiExpSessionG1(t *testing.T) {
+	t.Parallel()
+
+	const n = 53
+	bases := make([]G1Affine, n)
+	scalars := make([]fr.Element, n)
+	var s big.Int
+	for i := range bases {
+		s.SetUint64(uint64(i + 1))
+		bases[i].ScalarMultiplication(&g1GenAff, &s)
+		scalars[i].SetRandom()
+	}
+	var expected G1Jac
+	if _, err := expected.MultiExp(bases, scalars, ecc.MultiExpConfig{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the chunks are added concurrently, as they arrive; one of them is empty
+	session := NewMultiExpSessionG1(ecc.MultiExpConfig{})
+	chunks := []int{0, 10, 10, 30, 53}
+	var wg sync.WaitGroup
+	for i := 1; i < len(chunks); i++ {
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			partial, err := session.Add(bases[start:end], scalars[start:end])
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			var expected G1Jac
+			if start != end {
+				if _, err := expected.MultiExp(bases[start:end], scalars[start:end], ecc.MultiExpConfig{}); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+			if !partial.Equal(&expected) {
+				t.Errorf("wrong partial sum for [%d, %d)", start, end)
+			}
+		}(chunks[i-1], chunks[i])
+	}
+	wg.Wait()
+
+	if session.Len() != n || len(session.Partials()) != len(chunks)-1 {
+		t.Fatal("wrong number of chunks")
+	}
+	if res := session.Result(); !res.Equal(&expected) {
+		t.Fatal("session result doesn't match MultiExp")
+	}
+	if res := CombineMultiExpG1(session.Partials()); !res.Equal(&expected) {
+		t.Fatal("combined result doesn't match MultiExp")
+	}
+
+	if _, err := session.Add(bases[:2], scalars[:3]); err == nil {
+		t.Fatal("expected an error for a chunk with more scalars than bases")
+	}
+}
+
+func TestDistributedMultiExpG2(t *testing.T) {
+	t.Parallel()
+
+	const n = 53
+	bases := make([]G2Affine, n)
+	scalars := make([]fr.Element, n)
+	var s big.Int
+	for i := range bases {
+		s.SetUint64(uint64(i + 1))
+		bases[i].ScalarMultiplication(&g2GenAff, &s)
+		scalars[i].SetRandom()
+	}
+	var expected G2Affine
+	if _, err := expected.MultiExp(bases, scalars, ecc.MultiExpConfig{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// more shards than points: some shards are empty
+	for _, nbShards := range []int{1, 4, n + 2} {
+		shards, err := ecc.PartitionMultiExp(n, nbShards)
+		if err != nil {
+			t.Fatal(err)
+		}
+		partials := make([]PartialMultiExpG2, len(shards))
+		for i, shard := range shards {
+			partial, err := NewPartialMultiExpG2(shard, bases[shard.Start:shard.End], scalars[shard.Start:shard.End], ecc.MultiExpConfig{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// the partial results are sent to the combiner; they arrive in reverse order
+			var buf bytes.Buffer
+			if _, err := partial.WriteTo(&buf); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := partials[len(shards)-1-i].ReadFrom(&buf); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		res, err := CombinePartialMultiExpG2(n, partials)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !res.Equal(&expected) {
+			t.Fatalf("%d shards: combined result doesn't match MultiExp", nbShards)
+		}
+
+		// missing or duplicated shards (partials[len(partials)-1] is the first, non-empty, shard)
+		if _, err := CombinePartialMultiExpG2(n, partials[1:]); err == nil && partials[0].Shard.Len() > 0 {
+			t.Fatal("expected an error for a missing shard")
+		}
+		if _, err := CombinePartialMultiExpG2(n, append(partials, partials[len(partials)-1])); err == nil {
+			t.Fatal("expected an error for a duplicated shard")
+		}
+	}
+
+	shard := ecc.MultiExpShard{Start: 2, End: 5}
+	if _, err := NewPartialMultiExpG2(shard, bases[:3], scalars[:2], ecc.MultiExpConfig{}); err == nil {
+		t.Fatal("expected an error for inputs not matching the shard")
+	}
+}
+
+func TestMultiExpSessionG2(t *testing.T) {
+	t.Parallel()
+
+	const n = 53
+	bases := make([]G2Affine, n)
+	scalars := make([]fr.Element, n)
+	var s big.Int
+	for i := range bases {
+		s.SetUint64(uint64(i + 1))
+		bases[i].ScalarMultiplication(&g2GenAff, &s)
+		scalars[i].SetRandom()
+	}
+	var expected G2Jac
+	if _, err := expected.MultiExp(bases, scalars, ecc.MultiExpConfig{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the chunks are added concurrently, as they arrive; one of them is empty
+	session := NewMultiExpSessionG2(ecc.MultiExpConfig{})
+	chunks := []int{0, 10, 10, 30, 53}
+	var wg sync.WaitGroup
+	for i := 1; i < len(chunks); i++ {
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			partial, err := session.Add(bases[start:end], scalars[start:end])
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			var expected G2Jac
+			if start != end {
+				if _, err := expected.MultiExp(bases[start:end], scalars[start:end], ecc.MultiExpConfig{}); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+			if !partial.Equal(&expected) {
+				t.Errorf("wrong partial sum for [%d, %d)", start, end)
+			}
+		}(chunks[i-1], chunks[i])
+	}
+	wg.Wait()
+
+	if session.Len() != n || len(session.Partials()) != len(chunks)-1 {
+		t.Fatal("wrong number of chunks")
+	}
+	if res := session.Result(); !res.Equal(&expected) {
+		t.Fatal("session result doesn't match MultiExp")
+	}
+	if res := CombineMultiExpG2(session.Partials()); !res.Equal(&expected) {
+		t.Fatal("combined result doesn't match MultiExp")
+	}
+
+	if _, err := session.Add(bases[:2], scalars[:3]); err == nil {
+		t.Fatal("expected an error for a chunk with more scalars than bases")
+	}
+}
@@ -0,0 +1,530 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls24315
+
+import (
+	"errors"
+	"io"
+	"math"
+	"runtime"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// MultiExpTableG1 holds precomputed multiples of a fixed slice of bases (e.g. the
+// points of a SRS) to speed up the multi-exponentiations with these bases.
+//
+// For a window size c, the table stores [2^{c*j}]bases[i] for each c-bit window j of the scalars;
+// a multi-exponentiation is then a single bucket accumulation, without the doublings between
+// windows. Building the table is costly for large slices of bases; it can be written to disk
+// (WriteTo) and loaded back (ReadFrom) instead of being recomputed.
+type MultiExpTableG1 struct {
+	c       uint64
+	nbBases int
+	points  []G1Affine // points[j*nbBases+i] = [2^{c*j}]bases[i]
+}
+
+// NewMultiExpTableG1 precomputes a MultiExpTableG1 for the given bases
+func NewMultiExpTableG1(bases []G1Affine) *MultiExpTableG1 {
+	n := len(bases)
+	c := bestTableCG1(n)
+	nbChunks := int(computeNbChunks(c))
+
+	t := &MultiExpTableG1{
+		c:       c,
+		nbBases: n,
+		points:  make([]G1Affine, n*nbChunks),
+	}
+
+	parallel.Execute(n, func(start, end int) {
+		// multiples of bases[start:end], window by window, converted to affine in a batch
+		multiples := make([]G1Jac, (end-start)*nbChunks)
+		var p G1Jac
+		for i := start; i < end; i++ {
+			p.FromAffine(&bases[i])
+			for j := 0; j < nbChunks; j++ {
+				if j != 0 {
+					for k := uint64(0); k < c; k++ {
+						p.DoubleAssign()
+					}
+				}
+				multiples[j*(end-start)+i-start] = p
+			}
+		}
+		multiplesAff := BatchJacobianToAffineG1(multiples)
+		for j := 0; j < nbChunks; j++ {
+			copy(t.points[j*n+start:j*n+end], multiplesAff[j*(end-start):(j+1)*(end-start)])
+		}
+	})
+
+	return t
+}
+
+// NbBases returns the number of bases of the table
+func (t *MultiExpTableG1) NbBases() int {
+	return t.nbBases
+}
+
+// Bases returns the bases the table was built from
+func (t *MultiExpTableG1) Bases() []G1Affine {
+	return t.points[:t.nbBases]
+}
+
+// MultiExpWithTable computes the multi-exponentiation of the bases of table by scalars.
+//
+// Only config.NbTasks and config.ScalarsCanonical are used. This call returns an error if len(scalars) != table.NbBases().
+func (p *G1Affine) MultiExpWithTable(table *MultiExpTableG1, scalars []fr.Element, config ecc.MultiExpConfig) (*G1Affine, error) {
+	var _p G1Jac
+	if _, err := _p.MultiExpWithTable(table, scalars, config); err != nil {
+		return nil, err
+	}
+	p.FromJacobian(&_p)
+	return p, nil
+}
+
+// MultiExpWithTable computes the multi-exponentiation of the bases of table by scalars.
+//
+// Only config.NbTasks and config.ScalarsCanonical are used. This call returns an error if len(scalars) != table.NbBases().
+func (p *G1Jac) MultiExpWithTable(table *MultiExpTableG1, scalars []fr.Element, config ecc.MultiExpConfig) (*G1Jac, error) {
+	if len(scalars) != table.nbBases {
+		return nil, errors.New("len(scalars) != table.NbBases()")
+	}
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU()
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	// the digits are laid out window by window, as the points of the table:
+	// digits[j*nbBases+i] is the j-th digit of scalars[i], to be multiplied by [2^{c*j}]bases[i]
+	digits, _ := partitionScalarsN(scalars, table.c, computeNbChunks(table.c), config.NbTasks, config.ScalarsCanonical)
+
+	nbTasks := config.NbTasks
+	if nbTasks > runtime.NumCPU() {
+		nbTasks = runtime.NumCPU()
+	}
+	if nbTasks > len(digits) {
+		nbTasks = len(digits)
+	}
+	var total g1JacExtended
+	total.setInfinity()
+	if nbTasks == 0 {
+		return p.unsafeFromJacExtended(&total), nil
+	}
+
+	nbBuckets := 1 << (table.c - 1)
+	if nbBuckets > len(digits) {
+		nbBuckets = len(digits)
+	}
+	processChunk := getChunkProcessorG1(table.c, chunkStat{nbBucketFilled: nbBuckets})
+
+	chRes := make(chan g1JacExtended, nbTasks)
+	for k := 0; k < nbTasks; k++ {
+		start := k * len(digits) / nbTasks
+		end := (k + 1) * len(digits) / nbTasks
+		go processChunk(0, chRes, table.c, table.points[start:end], digits[start:end], nil)
+	}
+	for k := 0; k < nbTasks; k++ {
+		r := <-chRes
+		total.add(&r)
+	}
+
+	return p.unsafeFromJacExtended(&total), nil
+}
+
+// bestTableCG1 returns the window size minimizing the cost of a multi-exponentiation
+// with a MultiExpTableG1 of n bases. Since all the windows share the same buckets,
+// the last window must not be larger than the others.
+func bestTableCG1(n int) uint64 {
+	var C uint64
+	min := math.MaxFloat64
+	for _, c := range []uint64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16} {
+		if !isValidTableCG1(c) {
+			continue
+		}
+		// cost = nbChunks * n additions + 2^{c} for the bucket reduction
+		cost := float64(int(computeNbChunks(c))*n + (1 << c))
+		if cost < min {
+			min = cost
+			C = c
+		}
+	}
+	return C
+}
+
+// isValidTableCG1 returns true if c is a window size usable by a MultiExpTableG1
+func isValidTableCG1(c uint64) bool {
+	switch c {
+	case 4:
+		return lastC(c) <= c
+	case 5:
+		return lastC(c) <= c
+	case 6:
+		return lastC(c) <= c
+	case 7:
+		return lastC(c) <= c
+	case 8:
+		return lastC(c) <= c
+	case 9:
+		return lastC(c) <= c
+	case 10:
+		return lastC(c) <= c
+	case 11:
+		return lastC(c) <= c
+	case 12:
+		return lastC(c) <= c
+	case 13:
+		return lastC(c) <= c
+	case 14:
+		return lastC(c) <= c
+	case 15:
+		return lastC(c) <= c
+	case 16:
+		return lastC(c) <= c
+	default:
+		return false
+	}
+}
+
+// WriteTo writes the binary encoding of the table to w
+func (t *MultiExpTableG1) WriteTo(w io.Writer) (int64, error) {
+	return t.writeTo(w, false)
+}
+
+// WriteRawTo writes the binary encoding of the table to w, without point compression.
+// The encoding is twice as large, but much faster to decode.
+func (t *MultiExpTableG1) WriteRawTo(w io.Writer) (int64, error) {
+	return t.writeTo(w, true)
+}
+
+func (t *MultiExpTableG1) writeTo(w io.Writer, raw bool) (int64, error) {
+	var flags ecc.HeaderFlag
+	var options []func(*Encoder)
+	if raw {
+		flags |= ecc.FlagRawEncoding
+		options = append(options, RawEncoding())
+	}
+	h := ecc.NewHeader(ecc.BLS24_315, ecc.ObjectMultiExpTableG1, flags)
+	hn, err := h.WriteTo(w)
+	if err != nil {
+		return hn, err
+	}
+
+	enc := NewEncoder(w, options...)
+	toEncode := []interface{}{
+		t.c,
+		uint64(t.nbBases),
+		t.points,
+	}
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return hn + enc.BytesWritten(), err
+		}
+	}
+	return hn + enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes a table from r. The subgroup checks of the points are batched in a
+// single pass once the table is read.
+//
+// Note that this doesn't check that the points are multiples of the bases.
+func (t *MultiExpTableG1) ReadFrom(r io.Reader) (int64, error) {
+	return t.readFrom(r, BatchSubgroupChecks())
+}
+
+// UnsafeReadFrom decodes a table from r without checking that the points are
+// in the correct subgroup.
+func (t *MultiExpTableG1) UnsafeReadFrom(r io.Reader) (int64, error) {
+	return t.readFrom(r, NoSubgroupChecks())
+}
+
+func (t *MultiExpTableG1) readFrom(r io.Reader, options ...func(*Decoder)) (int64, error) {
+	h, r, hn, err := ecc.ReadHeader(r)
+	if err != nil {
+		return hn, err
+	}
+	if err := h.Check(ecc.BLS24_315, ecc.ObjectMultiExpTableG1); err != nil {
+		return hn, err
+	}
+
+	dec := NewDecoder(r, options...)
+	var c, nbBases uint64
+	for _, v := range []interface{}{&c, &nbBases, &t.points} {
+		if err := dec.Decode(v); err != nil {
+			return hn + dec.BytesRead(), err
+		}
+	}
+	if !isValidTableCG1(c) || nbBases > uint64(len(t.points)) || uint64(len(t.points)) != nbBases*computeNbChunks(c) {
+		return hn + dec.BytesRead(), errors.New("invalid table encoding")
+	}
+	t.c, t.nbBases = c, int(nbBases)
+
+	return hn + dec.BytesRead(), dec.CheckSubGroups()
+}
+
+// MultiExpTableG2 holds precomputed multiples of a fixed slice of bases (e.g. the
+// points of a SRS) to speed up the multi-exponentiations with these bases.
+//
+// For a window size c, the table stores [2^{c*j}]bases[i] for each c-bit window j of the scalars;
+// a multi-exponentiation is then a single bucket accumulation, without the doublings between
+// windows. Building the table is costly for large slices of bases; it can be written to disk
+// (WriteTo) and loaded back (ReadFrom) instead of being recomputed.
+type MultiExpTableG2 struct {
+	c       uint64
+	nbBases int
+	points  []G2Affine // points[j*nbBases+i] = [2^{c*j}]bases[i]
+}
+
+// NewMultiExpTableG2 precomputes a MultiExpTableG2 for the given bases
+func NewMultiExpTableG2(bases []G2Affine) *MultiExpTableG2 {
+	n := len(bases)
+	c := bestTableCG2(n)
+	nbChunks := int(computeNbChunks(c))
+
+	t := &MultiExpTableG2{
+		c:       c,
+		nbBases: n,
+		points:  make([]G2Affine, n*nbChunks),
+	}
+
+	parallel.Execute(n, func(start, end int) {
+		// multiples of bases[start:end], window by window, converted to affine in a batch
+		multiples := make([]G2Jac, (end-start)*nbChunks)
+		var p G2Jac
+		for i := start; i < end; i++ {
+			p.FromAffine(&bases[i])
+			for j := 0; j < nbChunks; j++ {
+				if j != 0 {
+					for k := uint64(0); k < c; k++ {
+						p.DoubleAssign()
+					}
+				}
+				multiples[j*(end-start)+i-start] = p
+			}
+		}
+		multiplesAff := BatchJacobianToAffineG2(multiples)
+		for j := 0; j < nbChunks; j++ {
+			copy(t.points[j*n+start:j*n+end], multiplesAff[j*(end-start):(j+1)*(end-start)])
+		}
+	})
+
+	return t
+}
+
+// NbBases returns the number of bases of the table
+func (t *MultiExpTableG2) NbBases() int {
+	return t.nbBases
+}
+
+// Bases returns the bases the table was built from
+func (t *MultiExpTableG2) Bases() []G2Affine {
+	return t.points[:t.nbBases]
+}
+
+// MultiExpWithTable computes the multi-exponentiation of the bases of table by scalars.
+//
+// Only config.NbTasks and config.ScalarsCanonical are used. This call returns an error if len(scalars) != table.NbBases().
+func (p *G2Affine) MultiExpWithTable(table *MultiExpTableG2, scalars []fr.Element, config ecc.MultiExpConfig) (*G2Affine, error) {
+	var _p G2Jac
+	if _, err := _p.MultiExpWithTable(table, scalars, config); err != nil {
+		return nil, err
+	}
+	p.FromJacobian(&_p)
+	return p, nil
+}
+
+// MultiExpWithTable computes the multi-exponentiation of the bases of table by scalars.
+//
+// Only config.NbTasks and config.ScalarsCanonical are used. This call returns an error if len(scalars) != table.NbBases().
+func (p *G2Jac) MultiExpWithTable(table *MultiExpTableG2, scalars []fr.Element, config ecc.MultiExpConfig) (*G2Jac, error) {
+	if len(scalars) != table.nbBases {
+		return nil, errors.New("len(scalars) != table.NbBases()")
+	}
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU()
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	// the digits are laid out window by window, as the points of the table:
+	// digits[j*nbBases+i] is the j-th digit of scalars[i], to be multiplied by [2^{c*j}]bases[i]
+	digits, _ := partitionScalarsN(scalars, table.c, computeNbChunks(table.c), config.NbTasks, config.ScalarsCanonical)
+
+	nbTasks := config.NbTasks
+	if nbTasks > runtime.NumCPU() {
+		nbTasks = runtime.NumCPU()
+	}
+	if nbTasks > len(digits) {
+		nbTasks = len(digits)
+	}
+	var total g2JacExtended
+	total.setInfinity()
+	if nbTasks == 0 {
+		return p.unsafeFromJacExtended(&total), nil
+	}
+
+	nbBuckets := 1 << (table.c - 1)
+	if nbBuckets > len(digits) {
+		nbBuckets = len(digits)
+	}
+	processChunk := getChunkProcessorG2(table.c, chunkStat{nbBucketFilled: nbBuckets})
+
+	chRes := make(chan g2JacExtended, nbTasks)
+	for k := 0; k < nbTasks; k++ {
+		start := k * len(digits) / nbTasks
+		end := (k + 1) * len(digits) / nbTasks
+		go processChunk(0, chRes, table.c, table.points[start:end], digits[start:end], nil)
+	}
+	for k := 0; k < nbTasks; k++ {
+		r := <-chRes
+		total.add(&r)
+	}
+
+	return p.unsafeFromJacExtended(&total), nil
+}
+
+// bestTableCG2 returns the window size minimizing the cost of a multi-exponentiation
+// with a MultiExpTableG2 of n bases. Since all the windows share the same buckets,
+// the last window must not be larger than the others.
+func bestTableCG2(n int) uint64 {
+	var C uint64
+	min := math.MaxFloat64
+	for _, c := range []uint64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16} {
+		if !isValidTableCG2(c) {
+			continue
+		}
+		// cost = nbChunks * n additions + 2^{c} for the bucket reduction
+		cost := float64(int(computeNbChunks(c))*n + (1 << c))
+		if cost < min {
+			min = cost
+			C = c
+		}
+	}
+	return C
+}
+
+// isValidTableCG2 returns true if c is a window size usable by a MultiExpTableG2
+func isValidTableCG2(c uint64) bool {
+	switch c {
+	case 4:
+		return lastC(c) <= c
+	case 5:
+		return lastC(c) <= c
+	case 6:
+		return lastC(c) <= c
+	case 7:
+		return lastC(c) <= c
+	case 8:
+		return lastC(c) <= c
+	case 9:
+		return lastC(c) <= c
+	case 10:
+		return lastC(c) <= c
+	case 11:
+		return lastC(c) <= c
+	case 12:
+		return lastC(c) <= c
+	case 13:
+		return lastC(c) <= c
+	case 14:
+		return lastC(c) <= c
+	case 15:
+		return lastC(c) <= c
+	case 16:
+		return lastC(c) <= c
+	default:
+		return false
+	}
+}
+
+// WriteTo writes the binary encoding of the table to w
+func (t *MultiExpTableG2) WriteTo(w io.Writer) (int64, error) {
+	return t.writeTo(w, false)
+}
+
+// WriteRawTo writes the binary encoding of the table to w, without point compression.
+// The encoding is twice as large, but much faster to decode.
+func (t *MultiExpTableG2) WriteRawTo(w io.Writer) (int64, error) {
+	return t.writeTo(w, true)
+}
+
+func (t *MultiExpTableG2) writeTo(w io.Writer, raw bool) (int64, error) {
+	var flags ecc.HeaderFlag
+	var options []func(*Encoder)
+	if raw {
+		flags |= ecc.FlagRawEncoding
+		options = append(options, RawEncoding())
+	}
+	h := ecc.NewHeader(ecc.BLS24_315, ecc.ObjectMultiExpTableG2, flags)
+	hn, err := h.WriteTo(w)
+	if err != nil {
+		return hn, err
+	}
+
+	enc := NewEncoder(w, options...)
+	toEncode := []interface{}{
+		t.c,
+		uint64(t.nbBases),
+		t.points,
+	}
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return hn + enc.BytesWritten(), err
+		}
+	}
+	return hn + enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes a table from r. The subgroup checks of the points are batched in a
+// single pass once the table is read.
+//
+// Note that this doesn't check that the points are multiples of the bases.
+func (t *MultiExpTableG2) ReadFrom(r io.Reader) (int64, error) {
+	return t.readFrom(r, BatchSubgroupChecks())
+}
+
+// UnsafeReadFrom decodes a table from r without checking that the points are
+// in the correct subgroup.
+func (t *MultiExpTableG2) UnsafeReadFrom(r io.Reader) (int64, error) {
+	return t.readFrom(r, NoSubgroupChecks())
+}
+
+func (t *MultiExpTableG2) readFrom(r io.Reader, options ...func(*Decoder)) (int64, error) {
+	h, r, hn, err := ecc.ReadHeader(r)
+	if err != nil {
+		return hn, err
+	}
+	if err := h.Check(ecc.BLS24_315, ecc.ObjectMultiExpTableG2); err != nil {
+		return hn, err
+	}
+
+	dec := NewDecoder(r, options...)
+	var c, nbBases uint64
+	for _, v := range []interface{}{&c, &nbBases, &t.points} {
+		if err := dec.Decode(v); err != nil {
+			return hn + dec.BytesRead(), err
+		}
+	}
+	if !isValidTableCG2(c) || nbBases > uint64(len(t.points)) || uint64(len(t.points)) != nbBases*computeNbChunks(c) {
+		return hn + dec.BytesRead(), errors.New("invalid table encoding")
+	}
+	t.c, t.nbBases = c, int(nbBases)
+
+	return hn + dec.BytesRead(), dec.CheckSubGroups()
+}
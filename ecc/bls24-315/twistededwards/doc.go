@@ -15,4 +15,7 @@
 // Code generated by consensys/gnark-crypto DO NOT EDIT
 
 // Package twistededwards provides bls24-315's twisted edwards "companion curve" defined on fr.
+//
+// PedersenHasher implements the Bowe–Hopwood variant of the Pedersen hash (as in Zcash Sapling)
+// on this curve.
 package twistededwards
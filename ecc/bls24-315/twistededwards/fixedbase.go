@@ -0,0 +1,265 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package twistededwards
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+	"sync"
+	"sync/atomic"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// fixedBaseC is the window size of the fixed-base tables; with 8-bit windows,
+// the digits of a scalar are read from its bytes.
+const fixedBaseC = 8
+
+var errInvalidTable = errors.New("invalid fixed-base table encoding")
+
+// FixedBaseTable holds precomputed multiples of a fixed base (e.g. the generator of the curve,
+// used for EdDSA key generation and signing) to speed up the scalar multiplications by this base.
+//
+// For each 8-bit window j of the scalars, the table stores [d*2^{8*j}]base for the 2^7 (signed)
+// digits d; a scalar multiplication is then a sum of one precomputed point per window, without any
+// doubling. Tables can be written to disk (WriteTo) and loaded back (ReadFrom).
+type FixedBaseTable struct {
+	points []PointAffine // points[j*2^7+d-1] = [d*2^{8*j}]base
+}
+
+// nbFixedBaseWindows returns the number of windows of the signed digit decomposition of the
+// scalars modulo the order of the curve, including a window for the last carry.
+func nbFixedBaseWindows() int {
+	c := GetEdwardsCurve()
+	return c.Order.BitLen()/fixedBaseC + 1
+}
+
+// NewFixedBaseTable precomputes a FixedBaseTable for base
+func NewFixedBaseTable(base *PointAffine) *FixedBaseTable {
+	const nbDigits = 1 << (fixedBaseC - 1)
+	nbWindows := nbFixedBaseWindows()
+
+	// [2^{8*j}]base for each window j
+	var p PointExtended
+	p.FromAffine(base)
+	windows := make([]PointExtended, nbWindows)
+	for j := range windows {
+		if j != 0 {
+			for k := 0; k < fixedBaseC; k++ {
+				p.Double(&p)
+			}
+		}
+		windows[j].Set(&p)
+	}
+
+	multiples := make([]PointExtended, nbWindows*nbDigits)
+	parallel.Execute(nbWindows, func(start, end int) {
+		for j := start; j < end; j++ {
+			w := multiples[j*nbDigits : (j+1)*nbDigits]
+			w[0].Set(&windows[j])
+			for d := 1; d < nbDigits; d++ {
+				w[d].Add(&w[d-1], &windows[j])
+			}
+		}
+	})
+
+	return &FixedBaseTable{points: BatchExtendedToAffine(multiples)}
+}
+
+// Base returns the base the table was built from
+func (t *FixedBaseTable) Base() PointAffine {
+	return t.points[0]
+}
+
+// ScalarMultiplicationFixedBase computes and returns p = [s]base, where base is the base of table.
+//
+// s is reduced modulo the order of the curve, the base must be in the prime subgroup.
+// The running time depends on s; it is not constant-time.
+func (p *PointAffine) ScalarMultiplicationFixedBase(table *FixedBaseTable, s *big.Int) *PointAffine {
+	var _p PointExtended
+	_p.ScalarMultiplicationFixedBase(table, s)
+	p.FromExtended(&_p)
+	return p
+}
+
+// ScalarMultiplicationFixedBase computes and returns p = [s]base, where base is the base of table.
+//
+// s is reduced modulo the order of the curve, the base must be in the prime subgroup.
+// The running time depends on s; it is not constant-time.
+func (p *PointExtended) ScalarMultiplicationFixedBase(table *FixedBaseTable, s *big.Int) *PointExtended {
+	const nbDigits = 1 << (fixedBaseC - 1)
+	nbWindows := len(table.points) / nbDigits
+
+	c := GetEdwardsCurve()
+	var k big.Int
+	k.Mod(s, &c.Order)
+	// little-endian bytes of k, one per window
+	b := k.FillBytes(make([]byte, nbWindows))
+
+	var res PointExtended
+	var neg PointAffine
+	res.setInfinity()
+	carry := 0
+	for j := 0; j < nbWindows; j++ {
+		digit := int(b[nbWindows-1-j]) + carry
+		carry = 0
+		if digit > nbDigits {
+			digit -= 1 << fixedBaseC
+			carry = 1
+		}
+		switch {
+		case digit > 0:
+			res.MixedAdd(&res, &table.points[j*nbDigits+digit-1])
+		case digit < 0:
+			neg.Neg(&table.points[j*nbDigits-digit-1])
+			res.MixedAdd(&res, &neg)
+		}
+	}
+
+	p.Set(&res)
+	return p
+}
+
+var baseTable struct {
+	once  sync.Once
+	table *FixedBaseTable
+}
+
+// ScalarMultiplicationBase computes and returns p = [s]Base, where Base is the generator of
+// the prime subgroup (see GetEdwardsCurve).
+//
+// It uses a FixedBaseTable of Base, built on the first call.
+// The running time depends on s; it is not constant-time.
+func (p *PointAffine) ScalarMultiplicationBase(s *big.Int) *PointAffine {
+	return p.ScalarMultiplicationFixedBase(getBaseTable(), s)
+}
+
+func getBaseTable() *FixedBaseTable {
+	baseTable.once.Do(func() {
+		c := GetEdwardsCurve()
+		baseTable.table = NewFixedBaseTable(&c.Base)
+	})
+	return baseTable.table
+}
+
+// BatchScalarMultiplicationFixedBase computes and returns [scalars[i]]base for all i, where
+// base is the base of table.
+//
+// The multiplications run in parallel, in extended coordinates, and the results are
+// converted to affine coordinates with a single field inversion.
+// The running time depends on the scalars; it is not constant-time.
+func BatchScalarMultiplicationFixedBase(table *FixedBaseTable, scalars []big.Int) []PointAffine {
+	res := make([]PointExtended, len(scalars))
+	parallel.Execute(len(scalars), func(start, end int) {
+		for i := start; i < end; i++ {
+			res[i].ScalarMultiplicationFixedBase(table, &scalars[i])
+		}
+	})
+	return BatchExtendedToAffine(res)
+}
+
+// BatchScalarMultiplicationBase computes and returns [scalars[i]]Base for all i, where Base
+// is the generator of the prime subgroup (see GetEdwardsCurve).
+//
+// See BatchScalarMultiplicationFixedBase.
+func BatchScalarMultiplicationBase(scalars []big.Int) []PointAffine {
+	return BatchScalarMultiplicationFixedBase(getBaseTable(), scalars)
+}
+
+// WriteTo writes the binary encoding of the table to w, with compressed points.
+//
+// The encoding starts with an ecc.Header whose curve is the curve the twisted Edwards
+// curve is defined on.
+func (t *FixedBaseTable) WriteTo(w io.Writer) (int64, error) {
+	h := ecc.NewHeader(ecc.BLS24_315, ecc.ObjectFixedBaseTableEdwards, 0)
+	hn, err := h.WriteTo(w)
+	if err != nil {
+		return hn, err
+	}
+
+	buf := make([]byte, 8+len(t.points)*sizePointCompressed)
+	binary.BigEndian.PutUint32(buf[:4], fixedBaseC)
+	binary.BigEndian.PutUint32(buf[4:8], uint32(len(t.points)))
+	for i := range t.points {
+		b := t.points[i].Bytes()
+		copy(buf[8+i*sizePointCompressed:], b[:])
+	}
+	n, err := w.Write(buf)
+	return hn + int64(n), err
+}
+
+// ReadFrom decodes a table from r, and checks that the points are in the prime subgroup.
+//
+// Note that this doesn't check that the points are multiples of the base.
+func (t *FixedBaseTable) ReadFrom(r io.Reader) (int64, error) {
+	return t.readFrom(r, true)
+}
+
+// UnsafeReadFrom decodes a table from r without checking that the points are
+// in the prime subgroup.
+func (t *FixedBaseTable) UnsafeReadFrom(r io.Reader) (int64, error) {
+	return t.readFrom(r, false)
+}
+
+func (t *FixedBaseTable) readFrom(r io.Reader, subGroupCheck bool) (int64, error) {
+	h, r, hn, err := ecc.ReadHeader(r)
+	if err != nil {
+		return hn, err
+	}
+	if err := h.Check(ecc.BLS24_315, ecc.ObjectFixedBaseTableEdwards); err != nil {
+		return hn, err
+	}
+
+	var buf [8]byte
+	n, err := io.ReadFull(r, buf[:])
+	read := hn + int64(n)
+	if err != nil {
+		return read, err
+	}
+	nbPoints := nbFixedBaseWindows() << (fixedBaseC - 1)
+	if binary.BigEndian.Uint32(buf[:4]) != fixedBaseC || binary.BigEndian.Uint32(buf[4:8]) != uint32(nbPoints) {
+		return read, errInvalidTable
+	}
+
+	data := make([]byte, nbPoints*sizePointCompressed)
+	n, err = io.ReadFull(r, data)
+	read += int64(n)
+	if err != nil {
+		return read, err
+	}
+
+	points := make([]PointAffine, nbPoints)
+	var nbErrs uint64
+	parallel.Execute(nbPoints, func(start, end int) {
+		for i := start; i < end; i++ {
+			if _, err := points[i].SetBytes(data[i*sizePointCompressed:]); err != nil || !points[i].IsOnCurve() ||
+				(subGroupCheck && !points[i].IsInSubGroup()) {
+				atomic.AddUint64(&nbErrs, 1)
+				return
+			}
+		}
+	})
+	if nbErrs != 0 {
+		return read, errInvalidTable
+	}
+	t.points = points
+
+	return read, nil
+}
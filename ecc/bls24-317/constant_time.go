@@ -0,0 +1,185 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls24317
+
+import (
+	"crypto/subtle"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fp"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+)
+
+// g1Homogeneous is a point in homogeneous projective coordinates (x=X/Z, y=Y/Z), used by the
+// complete formulas of the constant-time scalar multiplication. The point at infinity is (0:1:0).
+type g1Homogeneous struct {
+	X, Y, Z fp.Element
+}
+
+// setInfinity sets p to O
+func (p *g1Homogeneous) setInfinity() *g1Homogeneous {
+	p.X.SetZero()
+	p.Y.SetOne()
+	p.Z.SetZero()
+	return p
+}
+
+// fromAffine sets p = Q, p in homogeneous projective, Q in affine
+func (p *g1Homogeneous) fromAffine(Q *G1Affine) *g1Homogeneous {
+	if Q.IsInfinity() {
+		return p.setInfinity()
+	}
+	p.X.Set(&Q.X)
+	p.Y.Set(&Q.Y)
+	p.Z.SetOne()
+	return p
+}
+
+// selectIf sets p to q if c ≠ 0, in constant time
+func (p *g1Homogeneous) selectIf(c int, q *g1Homogeneous) *g1Homogeneous {
+	p.X.Select(c, &p.X, &q.X)
+	p.Y.Select(c, &p.Y, &q.Y)
+	p.Z.Select(c, &p.Z, &q.Z)
+	return p
+}
+
+// add sets p = q + r, with the complete addition formulas for a = 0 of Renes, Costello and
+// Batina (https://eprint.iacr.org/2015/1060.pdf, algorithm 7), where b3 = 3b. They have no
+// exceptional case for points of odd order: q and r can be equal, opposite or at infinity.
+func (p *g1Homogeneous) add(q, r *g1Homogeneous, b3 *fp.Element) *g1Homogeneous {
+	var t0, t1, t2, t3, t4, X3, Y3, Z3 fp.Element
+
+	t0.Mul(&q.X, &r.X)
+	t1.Mul(&q.Y, &r.Y)
+	t2.Mul(&q.Z, &r.Z)
+	t3.Add(&q.X, &q.Y)
+	t4.Add(&r.X, &r.Y)
+	t3.Mul(&t3, &t4)
+	t4.Add(&t0, &t1)
+	t3.Sub(&t3, &t4)
+	t4.Add(&q.Y, &q.Z)
+	X3.Add(&r.Y, &r.Z)
+	t4.Mul(&t4, &X3)
+	X3.Add(&t1, &t2)
+	t4.Sub(&t4, &X3)
+	X3.Add(&q.X, &q.Z)
+	Y3.Add(&r.X, &r.Z)
+	X3.Mul(&X3, &Y3)
+	Y3.Add(&t0, &t2)
+	Y3.Sub(&X3, &Y3)
+	X3.Double(&t0)
+	t0.Add(&X3, &t0)
+	t2.Mul(b3, &t2)
+	Z3.Add(&t1, &t2)
+	t1.Sub(&t1, &t2)
+	Y3.Mul(b3, &Y3)
+	X3.Mul(&t4, &Y3)
+	t2.Mul(&t3, &t1)
+	X3.Sub(&t2, &X3)
+	Y3.Mul(&Y3, &t0)
+	t1.Mul(&t1, &Z3)
+	Y3.Add(&t1, &Y3)
+	t0.Mul(&t0, &t3)
+	Z3.Mul(&Z3, &t4)
+	Z3.Add(&Z3, &t0)
+
+	p.X, p.Y, p.Z = X3, Y3, Z3
+	return p
+}
+
+// double sets p = [2]q, with the complete doubling formulas for a = 0 of Renes, Costello and
+// Batina (https://eprint.iacr.org/2015/1060.pdf, algorithm 9), where b3 = 3b.
+func (p *g1Homogeneous) double(q *g1Homogeneous, b3 *fp.Element) *g1Homogeneous {
+	var t0, t1, t2, X3, Y3, Z3 fp.Element
+
+	t0.Square(&q.Y)
+	Z3.Double(&t0)
+	Z3.Double(&Z3)
+	Z3.Double(&Z3)
+	t1.Mul(&q.Y, &q.Z)
+	t2.Square(&q.Z)
+	t2.Mul(b3, &t2)
+	X3.Mul(&t2, &Z3)
+	Y3.Add(&t0, &t2)
+	Z3.Mul(&t1, &Z3)
+	t1.Double(&t2)
+	t2.Add(&t1, &t2)
+	t0.Sub(&t0, &t2)
+	Y3.Mul(&t0, &Y3)
+	Y3.Add(&X3, &Y3)
+	t1.Mul(&q.X, &q.Y)
+	X3.Mul(&t0, &t1)
+	X3.Double(&X3)
+
+	p.X, p.Y, p.Z = X3, Y3, Z3
+	return p
+}
+
+// ScalarMultiplicationConstantTime computes and returns p = a ⋅ s, where s is reduced modulo r.
+// a must be in the r-torsion subgroup.
+//
+// Unlike ScalarMultiplication, the sequence of field operations and the memory accesses don't
+// depend on the value of s: it uses a fixed 4-bit window, constant-time lookups in the table of
+// the multiples of a and complete addition formulas. It is meant for secret scalars (e.g. signing
+// keys and nonces).
+func (p *G1Jac) ScalarMultiplicationConstantTime(a *G1Jac, s *big.Int) *G1Jac {
+	var _a G1Affine
+	_a.FromJacobian(a)
+	_a.ScalarMultiplicationConstantTime(&_a, s)
+	return p.FromAffine(&_a)
+}
+
+// ScalarMultiplicationConstantTime computes and returns p = a ⋅ s, see G1Jac.ScalarMultiplicationConstantTime
+func (p *G1Affine) ScalarMultiplicationConstantTime(a *G1Affine, s *big.Int) *G1Affine {
+	var b3 fp.Element
+	b3.Double(&bCurveCoeff).Add(&b3, &bCurveCoeff)
+
+	// table[i] = [i]a
+	var table [16]g1Homogeneous
+	table[0].setInfinity()
+	table[1].fromAffine(a)
+	for i := 2; i < 16; i += 2 {
+		table[i].double(&table[i/2], &b3)
+		table[i+1].add(&table[i], &table[1], &b3)
+	}
+
+	var e fr.Element
+	e.SetBigInt(s)
+	b := e.Bytes()
+
+	var res, t g1Homogeneous
+	res.setInfinity()
+	for i := 0; i < 2*fr.Bytes; i++ {
+		// 4-bit windows, from the most significant one
+		w := b[i/2] >> (4 * (1 - i%2)) & 0xf
+		if i != 0 {
+			res.double(&res, &b3).double(&res, &b3).double(&res, &b3).double(&res, &b3)
+		}
+		t.setInfinity()
+		for j := 1; j < 16; j++ {
+			t.selectIf(subtle.ConstantTimeByteEq(w, uint8(j)), &table[j])
+		}
+		res.add(&res, &t, &b3)
+	}
+
+	// back to affine coordinates: (X/Z, Y/Z), and (0, 0) for the point at infinity
+	var zInv fp.Element
+	zInv.InverseConstantTime(&res.Z)
+	p.X.Mul(&res.X, &zInv)
+	p.Y.Mul(&res.Y, &zInv)
+	return p
+}
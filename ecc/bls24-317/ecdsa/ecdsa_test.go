@@ -17,6 +17,7 @@
 package ecdsa
 
 import (
+	"bytes"
 	"crypto/rand"
 	"crypto/sha256"
 	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
@@ -62,6 +63,25 @@ func TestECDSA(t *testing.T) {
 		},
 	))
 
+	properties.Property("[BLS24-317] test the signing with scalar blinding and verification", prop.ForAll(
+		func() bool {
+
+			privKey, _ := GenerateKey(rand.Reader)
+			privKey.SetScalarBlinding(true)
+			publicKey := privKey.PublicKey
+
+			msg := []byte("testing ECDSA")
+			hFunc := sha256.New()
+			sig, err := privKey.Sign(msg, hFunc)
+			if err != nil {
+				return false
+			}
+			flag, _ := publicKey.Verify(sig, msg, hFunc)
+
+			return flag
+		},
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
@@ -112,6 +132,51 @@ func TestNonMalleability(t *testing.T) {
 
 }
 
+func TestSetRandomness(t *testing.T) {
+	t.Parallel()
+	privKey, err := GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := []byte("testing ECDSA")
+	hFunc := sha256.New()
+
+	// the same entropy gives the same signature
+	seed := make([]byte, 32)
+	privKey.SetRandomness(bytes.NewReader(seed))
+	sig1, err := privKey.Sign(msg, hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privKey.SetRandomness(bytes.NewReader(seed))
+	sig2, err := privKey.Sign(msg, hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(sig1, sig2) {
+		t.Fatal("signatures with the same entropy should be equal")
+	}
+	if ok, err := privKey.PublicKey.Verify(sig1, msg, hFunc); err != nil || !ok {
+		t.Fatal("signature with a custom source of randomness doesn't verify")
+	}
+
+	// errors of the source of randomness are returned
+	privKey.SetRandomness(bytes.NewReader(nil))
+	if _, err := privKey.Sign(msg, hFunc); err == nil {
+		t.Fatal("expected an error, the source of randomness is empty")
+	}
+
+	// back to the package-level source of randomness
+	privKey.SetRandomness(nil)
+	sig3, err := privKey.Sign(msg, hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(sig1, sig3) {
+		t.Fatal("signatures with different entropy should differ")
+	}
+}
+
 func TestNoZeros(t *testing.T) {
 	t.Run("R=0", func(t *testing.T) {
 		// R is 0
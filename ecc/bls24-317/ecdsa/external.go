@@ -0,0 +1,164 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecdsa
+
+import (
+	"crypto"
+	stdecdsa "crypto/ecdsa"
+	"encoding/asn1"
+	"errors"
+	"hash"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fp"
+	"github.com/consensys/gnark-crypto/random"
+	"github.com/consensys/gnark-crypto/signature"
+)
+
+var (
+	errExternalPublicKey = errors.New("unsupported public key type for the external signer")
+	errExternalSignature = errors.New("external signer returned an invalid signature")
+	errExternalKey       = errors.New("the key of an external signer can't be exported")
+)
+
+// ExternalSigner signs with a private key held outside of the process, for instance
+// in an HSM (PKCS#11) or a cloud KMS, so that it is never materialized in memory.
+// The external key is accessed through the crypto.Signer interface.
+//
+// implements signature.Signer
+type ExternalSigner struct {
+	handle    crypto.Signer
+	opts      crypto.SignerOpts
+	publicKey PublicKey
+	rand      io.Reader // nil for random.Reader()
+}
+
+// NewExternalSigner returns a signer delegating the operations involving the secret
+// scalar to handle.
+//
+// handle.Public() must return a *PublicKey or a *crypto/ecdsa.PublicKey with the
+// coordinates of a point of the curve. handle.Sign receives the digest of the message
+// (or the message itself if it is pre-hashed) and opts, and must return the signature
+// (r, s) either ASN.1 DER encoded, as crypto/ecdsa and most KMS do, or as the raw
+// concatenation r || s of PKCS#11. opts should name the hash function used on the
+// messages, as some signers refuse crypto.Hash(0), the default if opts is nil.
+func NewExternalSigner(handle crypto.Signer, opts crypto.SignerOpts) (*ExternalSigner, error) {
+	if opts == nil {
+		opts = crypto.Hash(0)
+	}
+	signer := &ExternalSigner{handle: handle, opts: opts}
+
+	switch pub := handle.Public().(type) {
+	case *PublicKey:
+		signer.publicKey.A.Set(&pub.A)
+	case *stdecdsa.PublicKey:
+		if pub.X == nil || pub.Y == nil || pub.X.Sign() < 0 || pub.Y.Sign() < 0 ||
+			pub.X.Cmp(fp.Modulus()) >= 0 || pub.Y.Cmp(fp.Modulus()) >= 0 {
+			return nil, errExternalPublicKey
+		}
+		signer.publicKey.A.X.SetBigInt(pub.X)
+		signer.publicKey.A.Y.SetBigInt(pub.Y)
+	default:
+		return nil, errExternalPublicKey
+	}
+	if signer.publicKey.A.IsInfinity() || !signer.publicKey.A.IsOnCurve() || !signer.publicKey.A.IsInSubGroup() {
+		return nil, errExternalPublicKey
+	}
+	return signer, nil
+}
+
+// SetRandomness sets the source of randomness passed to the external signer.
+// It defaults to the package-level source of randomness (see random.SetReader).
+func (signer *ExternalSigner) SetRandomness(r io.Reader) {
+	signer.rand = r
+}
+
+// Public returns the public key of the external key.
+func (signer *ExternalSigner) Public() signature.PublicKey {
+	var pub PublicKey
+	pub.A.Set(&signer.publicKey.A)
+	return &pub
+}
+
+// Sign performs the ECDSA signature with the external key. If hFunc is nil, the
+// message is considered pre-hashed.
+//
+// The signature is checked against the public key before being returned, so that
+// a faulty or misconfigured external signer is detected.
+func (signer *ExternalSigner) Sign(message []byte, hFunc hash.Hash) ([]byte, error) {
+	digest := message
+	if hFunc != nil {
+		hFunc.Reset()
+		if _, err := hFunc.Write(message); err != nil {
+			return nil, err
+		}
+		digest = hFunc.Sum(nil)
+	}
+
+	rnd := signer.rand
+	if rnd == nil {
+		rnd = random.Reader()
+	}
+	sigBin, err := signer.handle.Sign(rnd, digest, signer.opts)
+	if err != nil {
+		return nil, err
+	}
+	r, s, err := parseExternalSignature(sigBin)
+	if err != nil {
+		return nil, err
+	}
+
+	var sig Signature
+	r.FillBytes(sig.R[:sizeFr])
+	s.FillBytes(sig.S[:sizeFr])
+	res := sig.Bytes()
+
+	if ok, err := signer.publicKey.Verify(res, digest, nil); err != nil || !ok {
+		return nil, errExternalSignature
+	}
+	return res, nil
+}
+
+// Bytes returns nil: the key of an external signer can't be exported.
+func (signer *ExternalSigner) Bytes() []byte {
+	return nil
+}
+
+// SetBytes returns an error: the key of an external signer can't be imported.
+func (signer *ExternalSigner) SetBytes(buf []byte) (int, error) {
+	return 0, errExternalKey
+}
+
+// parseExternalSignature decodes a signature (r, s), ASN.1 DER encoded or as r || s.
+func parseExternalSignature(sigBin []byte) (r, s *big.Int, err error) {
+	var der struct {
+		R, S *big.Int
+	}
+	if rest, err := asn1.Unmarshal(sigBin, &der); err == nil && len(rest) == 0 {
+		r, s = der.R, der.S
+	} else if len(sigBin) == sizeSignature {
+		r = new(big.Int).SetBytes(sigBin[:sizeFr])
+		s = new(big.Int).SetBytes(sigBin[sizeFr:])
+	} else {
+		return nil, nil, errExternalSignature
+	}
+	if r.Sign() <= 0 || s.Sign() <= 0 || r.Cmp(order) >= 0 || s.Cmp(order) >= 0 {
+		return nil, nil, errExternalSignature
+	}
+	return r, s, nil
+}
@@ -17,7 +17,6 @@
 package fp
 
 import (
-	"crypto/rand"
 	"encoding/binary"
 	"errors"
 	"io"
@@ -30,6 +29,7 @@ import (
 	"github.com/bits-and-blooms/bitset"
 	"github.com/consensys/gnark-crypto/field/hash"
 	"github.com/consensys/gnark-crypto/field/pool"
+	"github.com/consensys/gnark-crypto/random"
 )
 
 // Element represents a field element stored on 5 words (uint64)
@@ -314,11 +314,20 @@ func (z *Element) LexicographicallyLargest() bool {
 	return b == 0
 }
 
-// SetRandom sets z to a uniform random value in [0, q).
+// SetRandom sets z to a uniform random value in [0, q), read from the package-level
+// source of randomness (crypto/rand.Reader unless set otherwise with random.SetReader).
 //
-// This might error only if reading from crypto/rand.Reader errors,
+// This might error only if reading from the source of randomness errors,
 // in which case, value of z is undefined.
 func (z *Element) SetRandom() (*Element, error) {
+	return z.SetRandomFrom(random.Reader())
+}
+
+// SetRandomFrom sets z to a uniform random value in [0, q), read from r.
+//
+// This might error only if reading from r errors,
+// in which case, value of z is undefined.
+func (z *Element) SetRandomFrom(r io.Reader) (*Element, error) {
 	// this code is generated for all modulus
 	// and derived from go/src/crypto/rand/util.go
 
@@ -341,7 +350,7 @@ func (z *Element) SetRandom() (*Element, error) {
 
 	for {
 		// note that bytes[k:l] is always 0
-		if _, err := io.ReadFull(rand.Reader, bytes[:k]); err != nil {
+		if _, err := io.ReadFull(r, bytes[:k]); err != nil {
 			return nil, err
 		}
 
@@ -1622,6 +1631,16 @@ func updateFactorsDecompose(c int64) (int64, int64) {
 	return f, g
 }
 
+// InverseConstantTime z = x⁻¹ (mod q), computed as x^(q-2) (Fermat's little theorem)
+//
+// if x == 0, sets and returns z = x
+//
+// Unlike Inverse, the sequence of operations doesn't depend on x: it is meant for the inversion
+// of secret values (e.g. the nonce of an ECDSA signature), and is several times slower.
+func (z *Element) InverseConstantTime(x *Element) *Element {
+	return z.expByInverseExp(*x)
+}
+
 // negL negates in place [x | xHi] and return the new most significant word xHi
 func negL(x *Element, xHi uint64) uint64 {
 	var b uint64
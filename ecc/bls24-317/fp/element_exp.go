@@ -1095,3 +1095,491 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	return z
 }
+
+// expByInverseExp is equivalent to z.Exp(x, 1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e8d512e565dab2aa9)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByInverseExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_100      = 2*_10
+	//	_110      = _10 + _100
+	//	_1100     = 2*_110
+	//	_1101     = 1 + _1100
+	//	_1110     = 1 + _1101
+	//	_11000    = 2*_1100
+	//	_11011    = _1101 + _1110
+	//	_11110    = _110 + _11000
+	//	_100001   = _110 + _11011
+	//	_101001   = _1110 + _11011
+	//	_101011   = _10 + _101001
+	//	_101101   = _10 + _101011
+	//	_111001   = _1100 + _101101
+	//	_111111   = _110 + _111001
+	//	_1001001  = _11110 + _101011
+	//	_1010001  = _11000 + _111001
+	//	_1010101  = _100 + _1010001
+	//	_1100011  = _1110 + _1010101
+	//	_1100101  = _10 + _1100011
+	//	_1100111  = _10 + _1100101
+	//	_1101101  = _110 + _1100111
+	//	_1101111  = _10 + _1101101
+	//	_1111001  = _1100 + _1101101
+	//	_1111101  = _100 + _1111001
+	//	_10001001 = _1100 + _1111101
+	//	_10010111 = _1110 + _10001001
+	//	_10011011 = _100 + _10010111
+	//	_10110011 = _11000 + _10011011
+	//	_10110111 = _100 + _10110011
+	//	_10111011 = _100 + _10110111
+	//	_11000111 = _1100 + _10111011
+	//	_11010001 = _11110 + _10110011
+	//	_11010011 = _10 + _11010001
+	//	_11010101 = _10 + _11010011
+	//	_11110011 = _11110 + _11010101
+	//	_11111001 = _110 + _11110011
+	//	i55       = ((_1001001 + _10111011) << 6 + _1100011) << 9 + _1010001
+	//	i86       = ((i55 << 11 + _10011011) << 5 + _11011) << 13
+	//	i106      = ((_10001001 + i86) << 10 + _10110011) << 7 + _1100101
+	//	i139      = ((i106 << 9 + _111111) << 9 + _101101) << 13
+	//	i154      = ((_10110111 + i139) << 8 + _11111001) << 4 + _1101
+	//	i187      = ((i154 << 12 + _111001) << 9 + _101101) << 10
+	//	i209      = ((_11010011 + i187) << 12 + _11110011) << 7 + _100001
+	//	i241      = ((i209 << 10 + _11000111) << 10 + _11010001) << 10
+	//	i261      = ((_1101101 + i241) << 8 + _1101111) << 9 + _1100111
+	//	i291      = ((i261 << 9 + _1111001) << 8 + _1101) << 11
+	//	i310      = ((_1001001 + i291) << 9 + _100 + _11111001) << 7
+	//	i335      = ((_1111101 + i310) << 11 + _11010101) << 11 + _10010111
+	//	i362      = ((i335 << 8 + _101011) << 10 + _10111011) << 7
+	//	return      ((_101011 + i362) << 9 + _1010101) << 7 + _101001
+	//
+	// Operations: 312 squares 69 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+		t15 = new(Element)
+		t16 = new(Element)
+		t17 = new(Element)
+		t18 = new(Element)
+		t19 = new(Element)
+		t20 = new(Element)
+		t21 = new(Element)
+		t22 = new(Element)
+		t23 = new(Element)
+		t24 = new(Element)
+		t25 = new(Element)
+		t26 = new(Element)
+		t27 = new(Element)
+		t28 = new(Element)
+		t29 = new(Element)
+		t30 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14,t15,t16,t17,t18,t19,t20,t21,t22,t23,t24,t25,t26,t27,t28,t29,t30 Element
+	// Step 1: t4 = x^0x2
+	t4.Square(&x)
+
+	// Step 2: t7 = x^0x4
+	t7.Square(t4)
+
+	// Step 3: t6 = x^0x6
+	t6.Mul(t4, t7)
+
+	// Step 4: t14 = x^0xc
+	t14.Square(t6)
+
+	// Step 5: t9 = x^0xd
+	t9.Mul(&x, t14)
+
+	// Step 6: t3 = x^0xe
+	t3.Mul(&x, t9)
+
+	// Step 7: t2 = x^0x18
+	t2.Square(t14)
+
+	// Step 8: t26 = x^0x1b
+	t26.Mul(t9, t3)
+
+	// Step 9: t17 = x^0x1e
+	t17.Mul(t6, t2)
+
+	// Step 10: t16 = x^0x21
+	t16.Mul(t6, t26)
+
+	// Step 11: z = x^0x29
+	z.Mul(t3, t26)
+
+	// Step 12: t1 = x^0x2b
+	t1.Mul(t4, z)
+
+	// Step 13: t19 = x^0x2d
+	t19.Mul(t4, t1)
+
+	// Step 14: t20 = x^0x39
+	t20.Mul(t14, t19)
+
+	// Step 15: t22 = x^0x3f
+	t22.Mul(t6, t20)
+
+	// Step 16: t8 = x^0x49
+	t8.Mul(t17, t1)
+
+	// Step 17: t28 = x^0x51
+	t28.Mul(t2, t20)
+
+	// Step 18: t0 = x^0x55
+	t0.Mul(t7, t28)
+
+	// Step 19: t29 = x^0x63
+	t29.Mul(t3, t0)
+
+	// Step 20: t23 = x^0x65
+	t23.Mul(t4, t29)
+
+	// Step 21: t11 = x^0x67
+	t11.Mul(t4, t23)
+
+	// Step 22: t13 = x^0x6d
+	t13.Mul(t6, t11)
+
+	// Step 23: t12 = x^0x6f
+	t12.Mul(t4, t13)
+
+	// Step 24: t10 = x^0x79
+	t10.Mul(t14, t13)
+
+	// Step 25: t5 = x^0x7d
+	t5.Mul(t7, t10)
+
+	// Step 26: t25 = x^0x89
+	t25.Mul(t14, t5)
+
+	// Step 27: t3 = x^0x97
+	t3.Mul(t3, t25)
+
+	// Step 28: t27 = x^0x9b
+	t27.Mul(t7, t3)
+
+	// Step 29: t24 = x^0xb3
+	t24.Mul(t2, t27)
+
+	// Step 30: t21 = x^0xb7
+	t21.Mul(t7, t24)
+
+	// Step 31: t2 = x^0xbb
+	t2.Mul(t7, t21)
+
+	// Step 32: t15 = x^0xc7
+	t15.Mul(t14, t2)
+
+	// Step 33: t14 = x^0xd1
+	t14.Mul(t17, t24)
+
+	// Step 34: t18 = x^0xd3
+	t18.Mul(t4, t14)
+
+	// Step 35: t4 = x^0xd5
+	t4.Mul(t4, t18)
+
+	// Step 36: t17 = x^0xf3
+	t17.Mul(t17, t4)
+
+	// Step 37: t6 = x^0xf9
+	t6.Mul(t6, t17)
+
+	// Step 38: t30 = x^0x104
+	t30.Mul(t8, t2)
+
+	// Step 44: t30 = x^0x4100
+	for s := 0; s < 6; s++ {
+		t30.Square(t30)
+	}
+
+	// Step 45: t29 = x^0x4163
+	t29.Mul(t29, t30)
+
+	// Step 54: t29 = x^0x82c600
+	for s := 0; s < 9; s++ {
+		t29.Square(t29)
+	}
+
+	// Step 55: t28 = x^0x82c651
+	t28.Mul(t28, t29)
+
+	// Step 66: t28 = x^0x416328800
+	for s := 0; s < 11; s++ {
+		t28.Square(t28)
+	}
+
+	// Step 67: t27 = x^0x41632889b
+	t27.Mul(t27, t28)
+
+	// Step 72: t27 = x^0x82c6511360
+	for s := 0; s < 5; s++ {
+		t27.Square(t27)
+	}
+
+	// Step 73: t26 = x^0x82c651137b
+	t26.Mul(t26, t27)
+
+	// Step 86: t26 = x^0x1058ca226f6000
+	for s := 0; s < 13; s++ {
+		t26.Square(t26)
+	}
+
+	// Step 87: t25 = x^0x1058ca226f6089
+	t25.Mul(t25, t26)
+
+	// Step 97: t25 = x^0x41632889bd822400
+	for s := 0; s < 10; s++ {
+		t25.Square(t25)
+	}
+
+	// Step 98: t24 = x^0x41632889bd8224b3
+	t24.Mul(t24, t25)
+
+	// Step 105: t24 = x^0x20b19444dec1125980
+	for s := 0; s < 7; s++ {
+		t24.Square(t24)
+	}
+
+	// Step 106: t23 = x^0x20b19444dec11259e5
+	t23.Mul(t23, t24)
+
+	// Step 115: t23 = x^0x41632889bd8224b3ca00
+	for s := 0; s < 9; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 116: t22 = x^0x41632889bd8224b3ca3f
+	t22.Mul(t22, t23)
+
+	// Step 125: t22 = x^0x82c651137b044967947e00
+	for s := 0; s < 9; s++ {
+		t22.Square(t22)
+	}
+
+	// Step 126: t22 = x^0x82c651137b044967947e2d
+	t22.Mul(t19, t22)
+
+	// Step 139: t22 = x^0x1058ca226f60892cf28fc5a000
+	for s := 0; s < 13; s++ {
+		t22.Square(t22)
+	}
+
+	// Step 140: t21 = x^0x1058ca226f60892cf28fc5a0b7
+	t21.Mul(t21, t22)
+
+	// Step 148: t21 = x^0x1058ca226f60892cf28fc5a0b700
+	for s := 0; s < 8; s++ {
+		t21.Square(t21)
+	}
+
+	// Step 149: t21 = x^0x1058ca226f60892cf28fc5a0b7f9
+	t21.Mul(t6, t21)
+
+	// Step 153: t21 = x^0x1058ca226f60892cf28fc5a0b7f90
+	for s := 0; s < 4; s++ {
+		t21.Square(t21)
+	}
+
+	// Step 154: t21 = x^0x1058ca226f60892cf28fc5a0b7f9d
+	t21.Mul(t9, t21)
+
+	// Step 166: t21 = x^0x1058ca226f60892cf28fc5a0b7f9d000
+	for s := 0; s < 12; s++ {
+		t21.Square(t21)
+	}
+
+	// Step 167: t20 = x^0x1058ca226f60892cf28fc5a0b7f9d039
+	t20.Mul(t20, t21)
+
+	// Step 176: t20 = x^0x20b19444dec11259e51f8b416ff3a07200
+	for s := 0; s < 9; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 177: t19 = x^0x20b19444dec11259e51f8b416ff3a0722d
+	t19.Mul(t19, t20)
+
+	// Step 187: t19 = x^0x82c651137b044967947e2d05bfce81c8b400
+	for s := 0; s < 10; s++ {
+		t19.Square(t19)
+	}
+
+	// Step 188: t18 = x^0x82c651137b044967947e2d05bfce81c8b4d3
+	t18.Mul(t18, t19)
+
+	// Step 200: t18 = x^0x82c651137b044967947e2d05bfce81c8b4d3000
+	for s := 0; s < 12; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 201: t17 = x^0x82c651137b044967947e2d05bfce81c8b4d30f3
+	t17.Mul(t17, t18)
+
+	// Step 208: t17 = x^0x41632889bd8224b3ca3f1682dfe740e45a6987980
+	for s := 0; s < 7; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 209: t16 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a1
+	t16.Mul(t16, t17)
+
+	// Step 219: t16 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e68400
+	for s := 0; s < 10; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 220: t15 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c7
+	t15.Mul(t15, t16)
+
+	// Step 230: t15 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131c00
+	for s := 0; s < 10; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 231: t14 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd1
+	t14.Mul(t14, t15)
+
+	// Step 241: t14 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c734400
+	for s := 0; s < 10; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 242: t13 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d
+	t13.Mul(t13, t14)
+
+	// Step 250: t13 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d00
+	for s := 0; s < 8; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 251: t12 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f
+	t12.Mul(t12, t13)
+
+	// Step 260: t12 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade00
+	for s := 0; s < 9; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 261: t11 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade67
+	t11.Mul(t11, t12)
+
+	// Step 270: t11 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce00
+	for s := 0; s < 9; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 271: t10 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce79
+	t10.Mul(t10, t11)
+
+	// Step 279: t10 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce7900
+	for s := 0; s < 8; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 280: t9 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d
+	t9.Mul(t9, t10)
+
+	// Step 291: t9 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c86800
+	for s := 0; s < 11; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 292: t8 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c86849
+	t8.Mul(t8, t9)
+
+	// Step 301: t8 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d09200
+	for s := 0; s < 9; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 302: t7 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d09204
+	t7.Mul(t7, t8)
+
+	// Step 303: t6 = x^0x41632889bd8224b3ca3f1682dfe740e45a69879a131cd11b5bcce790d092fd
+	t6.Mul(t6, t7)
+
+	// Step 310: t6 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497e80
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 311: t5 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efd
+	t5.Mul(t5, t6)
+
+	// Step 322: t5 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e800
+	for s := 0; s < 11; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 323: t4 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e8d5
+	t4.Mul(t4, t5)
+
+	// Step 334: t4 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a800
+	for s := 0; s < 11; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 335: t3 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a897
+	t3.Mul(t3, t4)
+
+	// Step 343: t3 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a89700
+	for s := 0; s < 8; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 344: t3 = x^0x82c651137b044967947e2d05bfce81c8b4d30f342639a236b799cf21a125fbf46a8972b
+	t3.Mul(t1, t3)
+
+	// Step 354: t3 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efd1aa25cac00
+	for s := 0; s < 10; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 355: t2 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efd1aa25cacbb
+	t2.Mul(t2, t3)
+
+	// Step 362: t2 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e8d512e565d80
+	for s := 0; s < 7; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 363: t1 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e8d512e565dab
+	t1.Mul(t1, t2)
+
+	// Step 372: t1 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efd1aa25cacbb5600
+	for s := 0; s < 9; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 373: t0 = x^0x20b19444dec11259e51f8b416ff3a0722d34c3cd098e688dade673c868497efd1aa25cacbb5655
+	t0.Mul(t0, t1)
+
+	// Step 380: t0 = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e8d512e565dab2a80
+	for s := 0; s < 7; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 381: z = x^0x1058ca226f60892cf28fc5a0b7f9d039169a61e684c73446d6f339e43424bf7e8d512e565dab2aa9
+	z.Mul(z, t0)
+
+	return z
+}
@@ -19,7 +19,9 @@ package fp
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"hash"
 	"io"
 	"runtime"
 	"strings"
@@ -170,6 +172,98 @@ func (vector *Vector) ReadFrom(r io.Reader) (int64, error) {
 	return n, nil
 }
 
+// vectorHashBlockSize is the number of elements Hash and MerkleRoot encode at once.
+const vectorHashBlockSize = 64
+
+// vectorHashBuffers holds the encoding buffers of Hash and MerkleRoot, so that
+// hashing a vector doesn't allocate.
+var vectorHashBuffers = sync.Pool{
+	New: func() interface{} {
+		return new([vectorHashBlockSize * Bytes]byte)
+	},
+}
+
+var errMerkleLeafSize = errors.New("leaf size must be positive")
+var errMerkleEmpty = errors.New("can't compute the merkle root of an empty vector")
+
+// Hash writes to h the same bytes as WriteTo: the length of the vector as a big endian
+// uint32, followed by the big endian encoding of the elements. The elements are
+// encoded and absorbed in blocks, without allocating.
+//
+// h is neither reset nor finalized, so that the vector can be bound along with
+// other data, e.g. in a Fiat-Shamir transcript.
+func (vector Vector) Hash(h hash.Hash) {
+	buf := vectorHashBuffers.Get().(*[vectorHashBlockSize * Bytes]byte)
+	defer vectorHashBuffers.Put(buf)
+
+	binary.BigEndian.PutUint32(buf[:4], uint32(len(vector)))
+	// the Hash interface specifies that Write never returns an error
+	_, _ = h.Write(buf[:4])
+	absorb(h, vector, buf)
+}
+
+// MerkleRoot returns the root of the Merkle tree whose leaves are the consecutive
+// chunks of leafSize elements of vector (the last one may be shorter).
+//
+// A leaf is the hash of the big endian encoding of its elements, a node the hash of the
+// concatenation of its children; when a level has an odd number of nodes, the last one
+// is promoted to the next level.
+func (vector Vector) MerkleRoot(h hash.Hash, leafSize int) ([]byte, error) {
+	if leafSize < 1 {
+		return nil, errMerkleLeafSize
+	}
+	if len(vector) == 0 {
+		return nil, errMerkleEmpty
+	}
+
+	buf := vectorHashBuffers.Get().(*[vectorHashBlockSize * Bytes]byte)
+	defer vectorHashBuffers.Put(buf)
+
+	nbLeaves := (len(vector) + leafSize - 1) / leafSize
+	nodes := make([][]byte, nbLeaves)
+	for i := range nodes {
+		end := (i + 1) * leafSize
+		if end > len(vector) {
+			end = len(vector)
+		}
+		h.Reset()
+		absorb(h, vector[i*leafSize:end], buf)
+		nodes[i] = h.Sum(nil)
+	}
+
+	for len(nodes) > 1 {
+		next := nodes[:(len(nodes)+1)/2]
+		for i := 0; i+1 < len(nodes); i += 2 {
+			h.Reset()
+			_, _ = h.Write(nodes[i])
+			_, _ = h.Write(nodes[i+1])
+			next[i/2] = h.Sum(nodes[i][:0])
+		}
+		if len(nodes)%2 == 1 {
+			next[len(next)-1] = nodes[len(nodes)-1]
+		}
+		nodes = next
+	}
+
+	return nodes[0], nil
+}
+
+// absorb writes the big endian encoding of the elements of v to h, in blocks, using buf.
+func absorb(h hash.Hash, v Vector, buf *[vectorHashBlockSize * Bytes]byte) {
+	for len(v) > 0 {
+		n := len(v)
+		if n > vectorHashBlockSize {
+			n = vectorHashBlockSize
+		}
+		for i := 0; i < n; i++ {
+			b := (*[Bytes]byte)(buf[i*Bytes : (i+1)*Bytes])
+			BigEndian.PutElement(b, v[i])
+		}
+		_, _ = h.Write(buf[:n*Bytes])
+		v = v[n:]
+	}
+}
+
 // String implements fmt.Stringer interface
 func (vector Vector) String() string {
 	var sbb strings.Builder
@@ -184,6 +278,29 @@ func (vector Vector) String() string {
 	return sbb.String()
 }
 
+// SetRandom sets the elements of vector to independent uniform random values in [0, q),
+// read from the package-level source of randomness (see random.SetReader).
+//
+// It returns an error only if reading the randomness fails, in which case the values of
+// vector are undefined.
+func (vector Vector) SetRandom() error {
+	for i := range vector {
+		if _, err := vector[i].SetRandom(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MustSetRandom is SetRandom, and panics if reading the randomness fails. It returns
+// vector, so that a random vector of size n is make(Vector, n).MustSetRandom().
+func (vector Vector) MustSetRandom() Vector {
+	if err := vector.SetRandom(); err != nil {
+		panic(err)
+	}
+	return vector
+}
+
 // Len is the number of elements in the collection.
 func (vector Vector) Len() int {
 	return len(vector)
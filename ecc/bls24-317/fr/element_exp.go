@@ -717,3 +717,432 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	return z
 }
+
+// expByInverseExp is equivalent to z.Exp(x, 443f917ea68dafc2d0b097f28d83cd491cd1e79196bf0e7aefffffffffffffff)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByInverseExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_100      = 2*_10
+	//	_101      = 1 + _100
+	//	_110      = 1 + _101
+	//	_111      = 1 + _110
+	//	_1001     = _10 + _111
+	//	_1011     = _10 + _1001
+	//	_1101     = _10 + _1011
+	//	_1111     = _10 + _1101
+	//	_10001    = _10 + _1111
+	//	_10111    = _110 + _10001
+	//	_11001    = _10 + _10111
+	//	_11011    = _10 + _11001
+	//	_110110   = 2*_11011
+	//	_111111   = _1001 + _110110
+	//	_1111110  = 2*_111111
+	//	_1111111  = 1 + _1111110
+	//	_10001000 = _1001 + _1111111
+	//	i42       = ((_10001000 << 8 + _1111111) << 7 + _10001) << 7
+	//	i55       = ((_111111 + i42) << 4 + _101) << 6 + _1101
+	//	i74       = ((i55 << 8 + _11011) << 2 + 1) << 7
+	//	i87       = ((_111111 + i74) << 8 + _1011) << 2 + 1
+	//	i113      = ((i87 << 8 + _1011) << 8 + _1001) << 8
+	//	i129      = ((_1111111 + i113) << 5 + _101) << 8 + _11011
+	//	i151      = ((i129 << 9 + _1111) << 6 + _1101) << 5
+	//	i166      = ((_1001 + i151) << 7 + _10001) << 5 + _11001
+	//	i184      = ((i166 << 3 + _101) << 7 + _1111) << 6
+	//	i198      = ((_1111 + i184) << 7 + _10001) << 4 + _1001
+	//	i219      = ((i198 << 5 + _1101) << 7 + _111111) << 7
+	//	i234      = ((_111 + i219) << 6 + _1111) << 6 + _10111
+	//	i258      = ((i234 << 8 + _1111111) << 7 + _1111111) << 7
+	//	i275      = ((_1111111 + i258) << 7 + _1111111) << 7 + _1111111
+	//	i298      = ((i275 << 7 + _1111111) << 7 + _1111111) << 7
+	//	return      (_1111111 + i298) << 4 + _1111
+	//
+	// Operations: 251 squares 53 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11 Element
+	// Step 1: t0 = x^0x2
+	t0.Square(&x)
+
+	// Step 2: z = x^0x4
+	z.Square(t0)
+
+	// Step 3: t7 = x^0x5
+	t7.Mul(&x, z)
+
+	// Step 4: t1 = x^0x6
+	t1.Mul(&x, t7)
+
+	// Step 5: t2 = x^0x7
+	t2.Mul(&x, t1)
+
+	// Step 6: t5 = x^0x9
+	t5.Mul(t0, t2)
+
+	// Step 7: t10 = x^0xb
+	t10.Mul(t0, t5)
+
+	// Step 8: t4 = x^0xd
+	t4.Mul(t0, t10)
+
+	// Step 9: z = x^0xf
+	z.Mul(t0, t4)
+
+	// Step 10: t6 = x^0x11
+	t6.Mul(t0, z)
+
+	// Step 11: t1 = x^0x17
+	t1.Mul(t1, t6)
+
+	// Step 12: t8 = x^0x19
+	t8.Mul(t0, t1)
+
+	// Step 13: t9 = x^0x1b
+	t9.Mul(t0, t8)
+
+	// Step 14: t0 = x^0x36
+	t0.Square(t9)
+
+	// Step 15: t3 = x^0x3f
+	t3.Mul(t5, t0)
+
+	// Step 16: t0 = x^0x7e
+	t0.Square(t3)
+
+	// Step 17: t0 = x^0x7f
+	t0.Mul(&x, t0)
+
+	// Step 18: t11 = x^0x88
+	t11.Mul(t5, t0)
+
+	// Step 26: t11 = x^0x8800
+	for s := 0; s < 8; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 27: t11 = x^0x887f
+	t11.Mul(t0, t11)
+
+	// Step 34: t11 = x^0x443f80
+	for s := 0; s < 7; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 35: t11 = x^0x443f91
+	t11.Mul(t6, t11)
+
+	// Step 42: t11 = x^0x221fc880
+	for s := 0; s < 7; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 43: t11 = x^0x221fc8bf
+	t11.Mul(t3, t11)
+
+	// Step 47: t11 = x^0x221fc8bf0
+	for s := 0; s < 4; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 48: t11 = x^0x221fc8bf5
+	t11.Mul(t7, t11)
+
+	// Step 54: t11 = x^0x887f22fd40
+	for s := 0; s < 6; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 55: t11 = x^0x887f22fd4d
+	t11.Mul(t4, t11)
+
+	// Step 63: t11 = x^0x887f22fd4d00
+	for s := 0; s < 8; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 64: t11 = x^0x887f22fd4d1b
+	t11.Mul(t9, t11)
+
+	// Step 66: t11 = x^0x221fc8bf5346c
+	for s := 0; s < 2; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 67: t11 = x^0x221fc8bf5346d
+	t11.Mul(&x, t11)
+
+	// Step 74: t11 = x^0x110fe45fa9a3680
+	for s := 0; s < 7; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 75: t11 = x^0x110fe45fa9a36bf
+	t11.Mul(t3, t11)
+
+	// Step 83: t11 = x^0x110fe45fa9a36bf00
+	for s := 0; s < 8; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 84: t11 = x^0x110fe45fa9a36bf0b
+	t11.Mul(t10, t11)
+
+	// Step 86: t11 = x^0x443f917ea68dafc2c
+	for s := 0; s < 2; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 87: t11 = x^0x443f917ea68dafc2d
+	t11.Mul(&x, t11)
+
+	// Step 95: t11 = x^0x443f917ea68dafc2d00
+	for s := 0; s < 8; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 96: t10 = x^0x443f917ea68dafc2d0b
+	t10.Mul(t10, t11)
+
+	// Step 104: t10 = x^0x443f917ea68dafc2d0b00
+	for s := 0; s < 8; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 105: t10 = x^0x443f917ea68dafc2d0b09
+	t10.Mul(t5, t10)
+
+	// Step 113: t10 = x^0x443f917ea68dafc2d0b0900
+	for s := 0; s < 8; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 114: t10 = x^0x443f917ea68dafc2d0b097f
+	t10.Mul(t0, t10)
+
+	// Step 119: t10 = x^0x887f22fd4d1b5f85a1612fe0
+	for s := 0; s < 5; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 120: t10 = x^0x887f22fd4d1b5f85a1612fe5
+	t10.Mul(t7, t10)
+
+	// Step 128: t10 = x^0x887f22fd4d1b5f85a1612fe500
+	for s := 0; s < 8; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 129: t9 = x^0x887f22fd4d1b5f85a1612fe51b
+	t9.Mul(t9, t10)
+
+	// Step 138: t9 = x^0x110fe45fa9a36bf0b42c25fca3600
+	for s := 0; s < 9; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 139: t9 = x^0x110fe45fa9a36bf0b42c25fca360f
+	t9.Mul(z, t9)
+
+	// Step 145: t9 = x^0x443f917ea68dafc2d0b097f28d83c0
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 146: t9 = x^0x443f917ea68dafc2d0b097f28d83cd
+	t9.Mul(t4, t9)
+
+	// Step 151: t9 = x^0x887f22fd4d1b5f85a1612fe51b079a0
+	for s := 0; s < 5; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 152: t9 = x^0x887f22fd4d1b5f85a1612fe51b079a9
+	t9.Mul(t5, t9)
+
+	// Step 159: t9 = x^0x443f917ea68dafc2d0b097f28d83cd480
+	for s := 0; s < 7; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 160: t9 = x^0x443f917ea68dafc2d0b097f28d83cd491
+	t9.Mul(t6, t9)
+
+	// Step 165: t9 = x^0x887f22fd4d1b5f85a1612fe51b079a9220
+	for s := 0; s < 5; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 166: t8 = x^0x887f22fd4d1b5f85a1612fe51b079a9239
+	t8.Mul(t8, t9)
+
+	// Step 169: t8 = x^0x443f917ea68dafc2d0b097f28d83cd491c8
+	for s := 0; s < 3; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 170: t7 = x^0x443f917ea68dafc2d0b097f28d83cd491cd
+	t7.Mul(t7, t8)
+
+	// Step 177: t7 = x^0x221fc8bf5346d7e168584bf946c1e6a48e680
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 178: t7 = x^0x221fc8bf5346d7e168584bf946c1e6a48e68f
+	t7.Mul(z, t7)
+
+	// Step 184: t7 = x^0x887f22fd4d1b5f85a1612fe51b079a9239a3c0
+	for s := 0; s < 6; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 185: t7 = x^0x887f22fd4d1b5f85a1612fe51b079a9239a3cf
+	t7.Mul(z, t7)
+
+	// Step 192: t7 = x^0x443f917ea68dafc2d0b097f28d83cd491cd1e780
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 193: t6 = x^0x443f917ea68dafc2d0b097f28d83cd491cd1e791
+	t6.Mul(t6, t7)
+
+	// Step 197: t6 = x^0x443f917ea68dafc2d0b097f28d83cd491cd1e7910
+	for s := 0; s < 4; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 198: t5 = x^0x443f917ea68dafc2d0b097f28d83cd491cd1e7919
+	t5.Mul(t5, t6)
+
+	// Step 203: t5 = x^0x887f22fd4d1b5f85a1612fe51b079a9239a3cf2320
+	for s := 0; s < 5; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 204: t4 = x^0x887f22fd4d1b5f85a1612fe51b079a9239a3cf232d
+	t4.Mul(t4, t5)
+
+	// Step 211: t4 = x^0x443f917ea68dafc2d0b097f28d83cd491cd1e7919680
+	for s := 0; s < 7; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 212: t3 = x^0x443f917ea68dafc2d0b097f28d83cd491cd1e79196bf
+	t3.Mul(t3, t4)
+
+	// Step 219: t3 = x^0x221fc8bf5346d7e168584bf946c1e6a48e68f3c8cb5f80
+	for s := 0; s < 7; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 220: t2 = x^0x221fc8bf5346d7e168584bf946c1e6a48e68f3c8cb5f87
+	t2.Mul(t2, t3)
+
+	// Step 226: t2 = x^0x887f22fd4d1b5f85a1612fe51b079a9239a3cf232d7e1c0
+	for s := 0; s < 6; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 227: t2 = x^0x887f22fd4d1b5f85a1612fe51b079a9239a3cf232d7e1cf
+	t2.Mul(z, t2)
+
+	// Step 233: t2 = x^0x221fc8bf5346d7e168584bf946c1e6a48e68f3c8cb5f873c0
+	for s := 0; s < 6; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 234: t1 = x^0x221fc8bf5346d7e168584bf946c1e6a48e68f3c8cb5f873d7
+	t1.Mul(t1, t2)
+
+	// Step 242: t1 = x^0x221fc8bf5346d7e168584bf946c1e6a48e68f3c8cb5f873d700
+	for s := 0; s < 8; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 243: t1 = x^0x221fc8bf5346d7e168584bf946c1e6a48e68f3c8cb5f873d77f
+	t1.Mul(t0, t1)
+
+	// Step 250: t1 = x^0x110fe45fa9a36bf0b42c25fca360f352473479e465afc39ebbf80
+	for s := 0; s < 7; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 251: t1 = x^0x110fe45fa9a36bf0b42c25fca360f352473479e465afc39ebbfff
+	t1.Mul(t0, t1)
+
+	// Step 258: t1 = x^0x887f22fd4d1b5f85a1612fe51b079a9239a3cf232d7e1cf5dfff80
+	for s := 0; s < 7; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 259: t1 = x^0x887f22fd4d1b5f85a1612fe51b079a9239a3cf232d7e1cf5dfffff
+	t1.Mul(t0, t1)
+
+	// Step 266: t1 = x^0x443f917ea68dafc2d0b097f28d83cd491cd1e79196bf0e7aefffff80
+	for s := 0; s < 7; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 267: t1 = x^0x443f917ea68dafc2d0b097f28d83cd491cd1e79196bf0e7aefffffff
+	t1.Mul(t0, t1)
+
+	// Step 274: t1 = x^0x221fc8bf5346d7e168584bf946c1e6a48e68f3c8cb5f873d77ffffff80
+	for s := 0; s < 7; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 275: t1 = x^0x221fc8bf5346d7e168584bf946c1e6a48e68f3c8cb5f873d77ffffffff
+	t1.Mul(t0, t1)
+
+	// Step 282: t1 = x^0x110fe45fa9a36bf0b42c25fca360f352473479e465afc39ebbffffffff80
+	for s := 0; s < 7; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 283: t1 = x^0x110fe45fa9a36bf0b42c25fca360f352473479e465afc39ebbffffffffff
+	t1.Mul(t0, t1)
+
+	// Step 290: t1 = x^0x887f22fd4d1b5f85a1612fe51b079a9239a3cf232d7e1cf5dffffffffff80
+	for s := 0; s < 7; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 291: t1 = x^0x887f22fd4d1b5f85a1612fe51b079a9239a3cf232d7e1cf5dffffffffffff
+	t1.Mul(t0, t1)
+
+	// Step 298: t1 = x^0x443f917ea68dafc2d0b097f28d83cd491cd1e79196bf0e7aeffffffffffff80
+	for s := 0; s < 7; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 299: t0 = x^0x443f917ea68dafc2d0b097f28d83cd491cd1e79196bf0e7aeffffffffffffff
+	t0.Mul(t0, t1)
+
+	// Step 303: t0 = x^0x443f917ea68dafc2d0b097f28d83cd491cd1e79196bf0e7aeffffffffffffff0
+	for s := 0; s < 4; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 304: z = x^0x443f917ea68dafc2d0b097f28d83cd491cd1e79196bf0e7aefffffffffffffff
+	z.Mul(z, t0)
+
+	return z
+}
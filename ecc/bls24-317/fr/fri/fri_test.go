@@ -19,10 +19,12 @@ package fri
 import (
 	"crypto/sha256"
 	"fmt"
+	"hash"
 	"math/big"
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/gen"
 	"github.com/leanovate/gopter/prop"
@@ -212,8 +214,147 @@ func TestFRI(t *testing.T) {
 
 }
 
+func TestBatchVerifyProofOfProximity(t *testing.T) {
+
+	const size = 256
+	const nbProofs = 4
+
+	prover := RADIX_2_FRI.New(uint64(size), sha256.New())
+	proofs := make([]ProofOfProximity, nbProofs)
+	for i := range proofs {
+		p := randomPolynomial(uint64(size), int32(i+2))
+		var err error
+		proofs[i], err = prover.BuildProofOfProximity(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for name, verifier := range map[string]Iopp{
+		"sequential": RADIX_2_FRI.New(uint64(size), sha256.New()),
+		"parallel":   RADIX_2_FRI.NewWithHashFunc(uint64(size), sha256.New),
+	} {
+		for i := range proofs {
+			if err := verifier.VerifyProofOfProximity(proofs[i]); err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+		}
+		if i, err := verifier.BatchVerifyProofOfProximity(proofs); err != nil || i != -1 {
+			t.Fatalf("%s: batch verification failed at index %d: %v", name, i, err)
+		}
+
+		// tamper with the third proof
+		tampered := make([]ProofOfProximity, nbProofs)
+		copy(tampered, proofs)
+		tampered[2].Rounds = []Round{proofs[2].Rounds[0]}
+		tampered[2].Rounds[0].Evaluation.SetOne()
+		if err := verifier.VerifyProofOfProximity(tampered[2]); err == nil {
+			t.Fatalf("%s: verifying a tampered proof should fail", name)
+		}
+		if i, err := verifier.BatchVerifyProofOfProximity(tampered); err == nil || i != 2 {
+			t.Fatalf("%s: batch verification should fail at index 2, got %d", name, i)
+		}
+	}
+}
+
+func TestChallengeBits(t *testing.T) {
+
+	const size = 256
+	newHash := func() hash.Hash {
+		return fiatshamir.WithChallengeBits(sha256.New(), 128)
+	}
+
+	p := randomPolynomial(uint64(size), 3)
+	proof, err := RADIX_2_FRI.New(uint64(size), newHash()).BuildProofOfProximity(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, verifier := range map[string]Iopp{
+		"sequential": RADIX_2_FRI.New(uint64(size), newHash()),
+		"parallel":   RADIX_2_FRI.NewWithHashFunc(uint64(size), newHash),
+	} {
+		if err := verifier.VerifyProofOfProximity(proof); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+	}
+
+	// a verifier with full challenges rejects the proof
+	if err := RADIX_2_FRI.New(uint64(size), sha256.New()).VerifyProofOfProximity(proof); err == nil {
+		t.Fatal("verifying with another challenge length should fail")
+	}
+}
+
+func TestFiberLeaves(t *testing.T) {
+
+	const size = 64
+
+	iop := RADIX_2_FRI.New(uint64(size), sha256.New())
+	p := randomPolynomial(uint64(size), 7)
+	proof, err := iop.BuildProofOfProximity(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a query is opened with a single Merkle path per step, whose leaf is a fiber
+	for _, round := range proof.Rounds {
+		for _, interaction := range round.Interactions {
+			if len(interaction.ProofSet[0]) != 2*fr.Bytes {
+				t.Fatal("the leaves of the Merkle trees should store a fiber")
+			}
+		}
+	}
+
+	// tampering with the sibling evaluation of the first fiber must be detected
+	tampered := proof
+	tampered.Rounds = []Round{proof.Rounds[0]}
+	tampered.Rounds[0].Interactions = make([]MerkleProof, len(proof.Rounds[0].Interactions))
+	copy(tampered.Rounds[0].Interactions, proof.Rounds[0].Interactions)
+	proofSet := make([][]byte, len(proof.Rounds[0].Interactions[0].ProofSet))
+	copy(proofSet, proof.Rounds[0].Interactions[0].ProofSet)
+	leaf := make([]byte, len(proofSet[0]))
+	copy(leaf, proofSet[0])
+	leaf[len(leaf)-1] ^= 1
+	proofSet[0] = leaf
+	tampered.Rounds[0].Interactions[0].ProofSet = proofSet
+	if err := iop.VerifyProofOfProximity(tampered); err == nil {
+		t.Fatal("verifying a proof with a tampered leaf should fail")
+	}
+	if err := iop.VerifyProofOfProximity(proof); err != nil {
+		t.Fatal(err)
+	}
+}
+
 // Benchmarks
 
+func BenchmarkBatchVerifyProofOfProximity(b *testing.B) {
+
+	const size = 1 << 12
+	const nbProofs = 16
+
+	iop := RADIX_2_FRI.NewWithHashFunc(uint64(size), sha256.New)
+	proofs := make([]ProofOfProximity, nbProofs)
+	for i := range proofs {
+		p := make([]fr.Element, size)
+		for k := 0; k < size; k++ {
+			p[k].SetRandom()
+		}
+		proofs[i], _ = iop.BuildProofOfProximity(p)
+	}
+
+	b.Run("sequential", func(b *testing.B) {
+		for l := 0; l < b.N; l++ {
+			for i := range proofs {
+				_ = iop.VerifyProofOfProximity(proofs[i])
+			}
+		}
+	})
+	b.Run("batch", func(b *testing.B) {
+		for l := 0; l < b.N; l++ {
+			_, _ = iop.BatchVerifyProofOfProximity(proofs)
+		}
+	})
+}
+
 func BenchmarkProximityVerification(b *testing.B) {
 
 	baseSize := 16
@@ -0,0 +1,175 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package iop
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr/fft"
+)
+
+func TestEvaluateDomain(t *testing.T) {
+
+	const size = 64
+	domain := fft.NewDomain(size)
+
+	check := func(p *Polynomial, name string) {
+		t.Helper()
+		// ToLagrange and ToLagrangeCoset expect a Regular layout if p has fewer
+		// coefficients than the domain
+		expectedLagrange := p.Clone().ToRegular().ToLagrange(domain).ToRegular()
+		expectedCoset := p.Clone().ToRegular().ToLagrangeCoset(domain).ToRegular()
+
+		for _, layout := range []Layout{Regular, BitReverse} {
+			// the second iteration reads the cache
+			for i := 0; i < 2; i++ {
+				l, err := p.EvaluateDomain(domain, layout)
+				if err != nil {
+					t.Fatal(err)
+				}
+				c, err := p.EvaluateCoset(domain, layout)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if l.Form != (Form{Basis: Lagrange, Layout: layout}) || c.Form != (Form{Basis: LagrangeCoset, Layout: layout}) {
+					t.Fatalf("%s: wrong form", name)
+				}
+				if l.Size() != p.Size() || c.BlindedSize() != p.BlindedSize() {
+					t.Fatalf("%s: wrong size", name)
+				}
+				for j := 0; j < size; j++ {
+					a, b := l.GetCoeff(j), expectedLagrange.GetCoeff(j)
+					if !a.Equal(&b) {
+						t.Fatalf("%s: wrong evaluation on the domain at index %d", name, j)
+					}
+					a, b = c.GetCoeff(j), expectedCoset.GetCoeff(j)
+					if !a.Equal(&b) {
+						t.Fatalf("%s: wrong evaluation on the coset at index %d", name, j)
+					}
+				}
+			}
+		}
+		if len(p.representations) != 2 {
+			t.Fatalf("%s: the evaluations should be cached", name)
+		}
+	}
+
+	// evaluated with an FFT
+	p := NewPolynomial(randomVector(size/4), canonicalRegular)
+	check(p.Clone(), "canonical regular")
+	check(p.Clone().ToBitReverse(), "canonical bit reversed")
+	check(p.Clone().ToLagrange(domain), "lagrange")
+	check(p.Clone().ToLagrangeCoset(domain).ToRegular(), "lagrange coset")
+
+	// evaluated point by point
+	p = NewPolynomial(randomVector(2), canonicalRegular)
+	check(p.Clone(), "small canonical")
+	check(p.Clone().ToLagrange(domain).ToBitReverse(), "small lagrange")
+
+	// the cache is cleared by Blind
+	p = NewPolynomial(randomVector(size/4), canonicalRegular)
+	if _, err := p.EvaluateCoset(domain, Regular); err != nil {
+		t.Fatal(err)
+	}
+	p.Blind(2)
+	if p.representations != nil {
+		t.Fatal("Blind should clear the cached evaluations")
+	}
+	check(p, "blinded")
+
+	// a polynomial in Lagrange basis on another domain
+	p = NewPolynomial(randomVector(size/2), lagrangeRegular)
+	if _, err := p.EvaluateDomain(domain, Regular); err != ErrInconsistentSizeDomain {
+		t.Fatal("expected ErrInconsistentSizeDomain")
+	}
+}
+
+func TestKeepRepresentations(t *testing.T) {
+
+	const size = 16
+	domain := fft.NewDomain(4 * size)
+
+	for _, layout := range []Layout{Regular, BitReverse} {
+		p := NewPolynomial(randomVector(size), canonicalRegular).KeepRepresentations()
+		p.ToLagrange(domain)
+		if layout == BitReverse {
+			p.ToBitReverse()
+		}
+		p.ToCanonical(domain)
+		// reference, without cache
+		ref := p.Clone()
+		ref.keepRepresentations = false
+
+		// alternate between the canonical and the coset representations
+		for i := 0; i < 3; i++ {
+			p.ToLagrangeCoset(domain)
+			ref.ToLagrangeCoset(domain)
+			if !equalPolynomials(p, ref) {
+				t.Fatalf("layout %d, round %d: wrong LagrangeCoset representation", layout, i)
+			}
+			p.ToCanonical(domain)
+			ref.ToCanonical(domain)
+			if !equalPolynomials(p, ref) {
+				t.Fatalf("layout %d, round %d: wrong Canonical representation", layout, i)
+			}
+			// Canonical, Lagrange and LagrangeCoset representations
+			if len(p.representations) != 3 {
+				t.Fatalf("layout %d, round %d: the representations should be cached", layout, i)
+			}
+		}
+
+		p.InvalidateRepresentations()
+		if p.representations != nil {
+			t.Fatal("the cache should be empty")
+		}
+	}
+}
+
+// equalPolynomials returns true if p and q have the same form and coefficients
+func equalPolynomials(p, q *Polynomial) bool {
+	if p.Form != q.Form || p.coefficients.Len() != q.coefficients.Len() {
+		return false
+	}
+	for i := range *p.coefficients {
+		if !(*p.coefficients)[i].Equal(&(*q.coefficients)[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func BenchmarkEvaluateCoset(b *testing.B) {
+	const size = 1 << 14
+	domain := fft.NewDomain(4 * size)
+	v := make([]fr.Element, size)
+	for i := range v {
+		v[i].SetRandom()
+	}
+	p := NewPolynomial(&v, canonicalRegular)
+
+	b.Run("EvaluateCoset", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = p.EvaluateCoset(domain, Regular)
+		}
+	})
+	b.Run("ToLagrangeCoset", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			p.Clone().ToLagrangeCoset(domain)
+		}
+	})
+}
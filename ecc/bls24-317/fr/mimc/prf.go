@@ -0,0 +1,63 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package mimc
+
+import (
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+)
+
+// prfDomainSeparationTag is the tag with which the domain of a PRF is hashed to fr
+const prfDomainSeparationTag = "gnark-crypto/mimc/prf"
+
+// PRF is a keyed pseudorandom function from vectors of fr.Element to vectors of fr.Element, built
+// on the MiMC permutation with the Miyaguchi–Preneel compression of NewMiMC.
+//
+// The key and the domain are absorbed first, then the number of inputs and outputs, then the
+// inputs; the i-th output is the compression of the resulting state with the counter i+1. The
+// outputs of different domains, or of different input or output lengths, are thus independent.
+type PRF struct {
+	iv fr.Element // state after absorbing the key and the domain
+}
+
+// NewPRF returns the PRF keyed with key, in the given domain (e.g. the name of the protocol
+// and of the use of the outputs).
+func NewPRF(key fr.Element, domain string) *PRF {
+	// the error is only returned for a too large number of elements
+	tag, _ := fr.Hash([]byte(domain), []byte(prfDomainSeparationTag), 1)
+
+	var d digest
+	d.data = []fr.Element{tag[0], key}
+	return &PRF{iv: d.checksum()}
+}
+
+// Evaluate returns nbOutputs pseudorandom elements derived from inputs, which may be empty.
+func (p *PRF) Evaluate(inputs []fr.Element, nbOutputs int) []fr.Element {
+	d := digest{h: p.iv, data: make([]fr.Element, 2, 2+len(inputs))}
+	d.data[0].SetUint64(uint64(len(inputs)))
+	d.data[1].SetUint64(uint64(nbOutputs))
+	d.data = append(d.data, inputs...)
+	d.checksum()
+
+	res := make([]fr.Element, nbOutputs)
+	var counter fr.Element
+	for i := range res {
+		counter.SetUint64(uint64(i + 1))
+		res[i] = d.encrypt(counter)
+		res[i].Add(&res[i], &d.h).Add(&res[i], &counter)
+	}
+	return res
+}
@@ -0,0 +1,65 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package pcs
+
+import (
+	"errors"
+	"hash"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+)
+
+var (
+	ErrCommitmentType = errors.New("commitment was not produced by this scheme")
+	ErrProofType      = errors.New("proof was not produced by this scheme")
+)
+
+// Commitment is a commitment to a polynomial. Its concrete type depends on the scheme.
+type Commitment interface{}
+
+// OpeningProof is a proof that a committed polynomial evaluates to Evaluation at a point.
+type OpeningProof interface {
+	// Evaluation returns the claimed value of the polynomial at the opened point
+	Evaluation() fr.Element
+}
+
+// BatchOpeningProof is a proof that committed polynomials evaluate to Evaluations at
+// the same point.
+type BatchOpeningProof interface {
+	// Evaluations returns the claimed values of the polynomials at the opened point
+	Evaluations() []fr.Element
+}
+
+// Scheme is a univariate polynomial commitment scheme. The polynomials are given by
+// their coefficients in canonical basis.
+type Scheme interface {
+	// Commit returns a commitment to p
+	Commit(p []fr.Element) (Commitment, error)
+
+	// Open returns a proof of the evaluation of p at point
+	Open(p []fr.Element, point fr.Element) (OpeningProof, error)
+
+	// Verify checks that proof is a valid opening of commitment at point
+	Verify(commitment Commitment, proof OpeningProof, point fr.Element) error
+
+	// BatchOpen returns a proof of the evaluations of polynomials at point. The
+	// commitments and dataTranscript are bound to the proof through hf.
+	BatchOpen(polynomials [][]fr.Element, commitments []Commitment, point fr.Element, hf hash.Hash, dataTranscript ...[]byte) (BatchOpeningProof, error)
+
+	// BatchVerify checks a proof returned by BatchOpen
+	BatchVerify(commitments []Commitment, proof BatchOpeningProof, point fr.Element, hf hash.Hash, dataTranscript ...[]byte) error
+}
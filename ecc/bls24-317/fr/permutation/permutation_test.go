@@ -17,7 +17,6 @@
 package permutation
 
 import (
-	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -28,7 +27,7 @@ import (
 
 func TestProof(t *testing.T) {
 
-	kzgSrs, err := kzg.NewSRS(64, big.NewInt(13))
+	kzgSrs, err := kzg.NewSRSInsecure(64, []byte("gnark-crypto tests"))
 	assert.NoError(t, err)
 
 	a := make([]fr.Element, 8)
@@ -75,7 +74,7 @@ func BenchmarkProver(b *testing.B) {
 	srsSize := 1 << 15
 	polySize := 1 << 14
 
-	kzgSrs, _ := kzg.NewSRS(uint64(srsSize), big.NewInt(13))
+	kzgSrs, _ := kzg.NewSRSInsecure(uint64(srsSize), []byte("gnark-crypto tests"))
 	a := make([]fr.Element, polySize)
 	c := make([]fr.Element, polySize)
 
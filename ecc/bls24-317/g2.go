@@ -17,12 +17,17 @@
 package bls24317
 
 import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"runtime"
+	"strings"
+
 	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
 	"github.com/consensys/gnark-crypto/ecc/bls24-317/internal/fptower"
 	"github.com/consensys/gnark-crypto/internal/parallel"
-	"math/big"
-	"runtime"
 )
 
 // G2Affine point in affine coordinates
@@ -62,7 +67,16 @@ func (p *G2Affine) setInfinity() *G2Affine {
 }
 
 // ScalarMultiplication computes and returns p = a ⋅ s
+//
+// It runs in variable time: it is ScalarMultiplicationVartime, the fastest path, for public
+// scalars. Secret scalars (keys, nonces) should go throughScalarMultiplicationBlinded instead.
 func (p *G2Affine) ScalarMultiplication(a *G2Affine, s *big.Int) *G2Affine {
+	return p.ScalarMultiplicationVartime(a, s)
+}
+
+// ScalarMultiplicationVartime computes and returns p = a ⋅ s. Its running time depends on s:
+// it is meant for public scalars, e.g. in verifiers.
+func (p *G2Affine) ScalarMultiplicationVartime(a *G2Affine, s *big.Int) *G2Affine {
 	var _p G2Jac
 	_p.FromAffine(a)
 	_p.mulGLV(&_p, s)
@@ -70,12 +84,27 @@ func (p *G2Affine) ScalarMultiplication(a *G2Affine, s *big.Int) *G2Affine {
 	return p
 }
 
-// ScalarMultiplicationBase computes and returns p = g ⋅ s where g is the prime subgroup generator
-func (p *G2Affine) ScalarMultiplicationBase(s *big.Int) *G2Affine {
+// ScalarMultiplicationBlinded computes and returns p = a ⋅ s, blinding the scalar with
+// a random multiple of the group order (see ecc.BlindScalar). a must be in the r-torsion subgroup.
+//
+// It is slower than ScalarMultiplication, and meant for secret scalars in environments
+// exposed to side-channel attacks.
+func (p *G2Affine) ScalarMultiplicationBlinded(a *G2Affine, s *big.Int) (*G2Affine, error) {
 	var _p G2Jac
-	_p.mulGLV(&g2Gen, s)
+	_p.FromAffine(a)
+	if _, err := _p.ScalarMultiplicationBlinded(&_p, s); err != nil {
+		return nil, err
+	}
 	p.FromJacobian(&_p)
-	return p
+	return p, nil
+}
+
+// ScalarMultiplicationBase computes and returns p = g ⋅ s where g is the prime subgroup generator
+//
+// It uses a FixedBaseTableG2 of g, built on the first call (see ScalarMultiplicationFixedBase).
+// The running time depends on s; it is not constant-time.
+func (p *G2Affine) ScalarMultiplicationBase(s *big.Int) *G2Affine {
+	return p.ScalarMultiplicationFixedBase(getG2BaseTable(), s)
 }
 
 // Add adds two point in affine coordinates.
@@ -149,6 +178,76 @@ func (p *G2Affine) String() string {
 	return "E([" + p.X.String() + "," + p.Y.String() + "])"
 }
 
+// Format implements fmt.Formatter:
+//
+//	%v, %s    p.String()
+//	%+v       p.Dump()
+//	%x, %X    hexadecimal of p.RawBytes(), prefixed by 0x with the # flag
+func (p *G2Affine) Format(s fmt.State, verb rune) {
+	if p == nil {
+		fmt.Fprint(s, "<nil>")
+		return
+	}
+	switch verb {
+	case 'v', 's':
+		if verb == 'v' && s.Flag('+') {
+			fmt.Fprint(s, p.Dump())
+			return
+		}
+		fmt.Fprint(s, p.String())
+	case 'x', 'X':
+		b := p.RawBytes()
+		text := hex.EncodeToString(b[:])
+		if verb == 'X' {
+			text = strings.ToUpper(text)
+		}
+		if s.Flag('#') {
+			text = "0x" + text
+		}
+		fmt.Fprint(s, text)
+	default:
+		fmt.Fprintf(s, "%%!%c(G2Affine=%s)", verb, p.String())
+	}
+}
+
+// Dump returns the coordinates of p in base field elements, one per line, each
+// in the form of fp.Element.Dump, or "O" if p is infinity.
+func (p *G2Affine) Dump() string {
+	if p.IsInfinity() {
+		return "O"
+	}
+	return strings.Join([]string{
+		"X.B0.A0 = " + p.X.B0.A0.Dump(),
+		"X.B0.A1 = " + p.X.B0.A1.Dump(),
+		"X.B1.A0 = " + p.X.B1.A0.Dump(),
+		"X.B1.A1 = " + p.X.B1.A1.Dump(),
+		"Y.B0.A0 = " + p.Y.B0.A0.Dump(),
+		"Y.B0.A1 = " + p.Y.B0.A1.Dump(),
+		"Y.B1.A0 = " + p.Y.B1.A0.Dump(),
+		"Y.B1.A1 = " + p.Y.B1.A1.Dump(),
+	}, "\n")
+}
+
+// SetText sets p from the hexadecimal of one of its encodings (see SetBytes),
+// with or without 0x prefix, as printed by fmt with %x. The point is checked to
+// be in the correct subgroup. If s can't be parsed, p is left unchanged.
+func (p *G2Affine) SetText(s string) (*G2Affine, error) {
+	buf, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(s), "0x"))
+	if err != nil {
+		return nil, errors.New("can't parse G2Affine: " + err.Error())
+	}
+	var res G2Affine
+	n, err := res.SetBytes(buf)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(buf) {
+		return nil, errors.New("can't parse G2Affine: trailing bytes")
+	}
+	*p = res
+	return p, nil
+}
+
 // IsInfinity checks if the point is infinity
 // in affine, it's encoded as (0,0)
 // (0,0) is never on the curve for j=0 curves
@@ -366,11 +465,34 @@ func (p *G2Jac) DoubleAssign() *G2Jac {
 }
 
 // ScalarMultiplication computes and returns p = a ⋅ s
-// see https://www.iacr.org/archive/crypto2001/21390189.pdf
+//
+// It runs in variable time: it is ScalarMultiplicationVartime, the fastest path, for public
+// scalars. Secret scalars (keys, nonces) should go throughScalarMultiplicationBlinded instead.
 func (p *G2Jac) ScalarMultiplication(a *G2Jac, s *big.Int) *G2Jac {
+	return p.ScalarMultiplicationVartime(a, s)
+}
+
+// ScalarMultiplicationVartime computes and returns p = a ⋅ s
+// (see https://www.iacr.org/archive/crypto2001/21390189.pdf).
+// Its running time depends on s: it is meant for public scalars, e.g. in verifiers.
+func (p *G2Jac) ScalarMultiplicationVartime(a *G2Jac, s *big.Int) *G2Jac {
 	return p.mulGLV(a, s)
 }
 
+// ScalarMultiplicationBlinded computes and returns p = a ⋅ s, blinding the scalar with
+// a random multiple of the group order (see ecc.BlindScalar). a must be in the r-torsion subgroup.
+//
+// The blinded scalar is not reduced nor split with the endomorphism: it uses
+// the 2-bits windowed exponentiation. It is slower than ScalarMultiplication, and meant for
+// secret scalars in environments exposed to side-channel attacks.
+func (p *G2Jac) ScalarMultiplicationBlinded(a *G2Jac, s *big.Int) (*G2Jac, error) {
+	k, err := ecc.BlindScalar(s, fr.Modulus())
+	if err != nil {
+		return nil, err
+	}
+	return p.mulWindowed(a, k), nil
+}
+
 // String returns canonical representation of the point in affine coordinates
 func (p *G2Jac) String() string {
 	_p := G2Affine{}
@@ -460,6 +582,21 @@ func (p *G2Jac) psi(a *G2Jac) *G2Jac {
 	return p
 }
 
+// Psi sets p to ψ(a) and returns p, where ψ = u o π o u⁻¹ is the untwist-Frobenius-twist
+// endomorphism (u:E'→E the isomorphism from the twist to E and π the Frobenius map of E).
+//
+// On the prime order subgroup, ψ acts as the multiplication by the characteristic p of 𝔽p.
+func (p *G2Jac) Psi(a *G2Jac) *G2Jac {
+	return p.psi(a)
+}
+
+// Psi sets p to ψ(a) and returns p, see G2Jac.Psi
+func (p *G2Affine) Psi(a *G2Affine) *G2Affine {
+	p.X.Frobenius(&a.X).Mul(&p.X, &endo.u)
+	p.Y.Frobenius(&a.Y).Mul(&p.Y, &endo.v)
+	return p
+}
+
 // ϕ assigns p to ϕ(a) where ϕ: (x,y) → (w x,y), and returns p
 // where w is a third root of unity in 𝔽p
 func (p *G2Jac) phi(a *G2Jac) *G2Jac {
@@ -468,6 +605,22 @@ func (p *G2Jac) phi(a *G2Jac) *G2Jac {
 	return p
 }
 
+// Phi sets p to ϕ(a) and returns p, where ϕ: (x,y) → (w x,y) is the GLV endomorphism
+// and w is a third root of unity in 𝔽p.
+//
+// On the prime order subgroup, ϕ acts as the multiplication by λ, a cube root of unity modulo
+// the order r, used by the GLV scalar multiplication.
+func (p *G2Jac) Phi(a *G2Jac) *G2Jac {
+	return p.phi(a)
+}
+
+// Phi sets p to ϕ(a) and returns p, see G2Jac.Phi
+func (p *G2Affine) Phi(a *G2Affine) *G2Affine {
+	p.Y.Set(&a.Y)
+	p.X.MulByElement(&a.X, &thirdRootOneG2)
+	return p
+}
+
 // mulGLV computes the scalar multiplication using a windowed-GLV method
 // see https://www.iacr.org/archive/crypto2001/21390189.pdf
 func (p *G2Jac) mulGLV(a *G2Jac, s *big.Int) *G2Jac {
@@ -550,16 +703,42 @@ func (p *G2Affine) ClearCofactor(a *G2Affine) *G2Affine {
 	return p
 }
 
-// ClearCofactor maps a point in curve to r-torsion
+// mulBySeed sets p to [x₀]a and returns p, where x₀ is the absolute value of the seed of the
+// curve (xGen). It uses a double-and-add over the NAF of x₀, which is short and sparse: this is
+// cheaper than the GLV scalar multiplication, whose cost depends on the size of r.
+func (p *G2Jac) mulBySeed(a *G2Jac) *G2Jac {
+	var naf [66]int8
+	n := ecc.NafDecomposition(&xGen, naf[:])
+
+	var res, neg G2Jac
+	neg.Neg(a)
+	res.Set(a)
+	for i := n - 2; i >= 0; i-- {
+		res.DoubleAssign()
+		switch naf[i] {
+		case 1:
+			res.AddAssign(a)
+		case -1:
+			res.AddAssign(&neg)
+		}
+	}
+	p.Set(&res)
+	return p
+}
+
+// ClearCofactor maps a point in curve to r-torsion.
+//
+// It uses the endomorphisms of the curve to multiply by an (equivalent) multiple of the
+// cofactor expressed in the seed x₀, see the references below.
 func (p *G2Jac) ClearCofactor(a *G2Jac) *G2Jac {
 	// https://eprint.iacr.org/2017/419.pdf, section 4.2
 	// multiply by (3x⁴-3)*cofacor
 
 	var xg, xxg, xxxg, xxxxg, res, t G2Jac
-	xg.ScalarMultiplication(a, &xGen).SubAssign(a)
-	xxg.ScalarMultiplication(&xg, &xGen)
-	xxxg.ScalarMultiplication(&xxg, &xGen)
-	xxxxg.ScalarMultiplication(&xxxg, &xGen)
+	xg.mulBySeed(a).SubAssign(a)
+	xxg.mulBySeed(&xg)
+	xxxg.mulBySeed(&xxg)
+	xxxxg.mulBySeed(&xxxg)
 
 	res.Set(&xxxxg).
 		SubAssign(a)
@@ -925,6 +1104,56 @@ func (p *g2Proj) FromAffine(Q *G2Affine) *g2Proj {
 	return p
 }
 
+// BatchJacobianToAffineG2 converts points in Jacobian coordinates to Affine coordinates
+// performing a single field inversion (Montgomery batch inversion trick).
+func BatchJacobianToAffineG2(points []G2Jac) []G2Affine {
+	result := make([]G2Affine, len(points))
+	zeroes := make([]bool, len(points))
+	var accumulator fptower.E4
+	accumulator.SetOne()
+
+	// batch invert all points[].Z coordinates with Montgomery batch inversion trick
+	// (stores points[].Z^-1 in result[i].X to avoid allocating a slice of fr.Elements)
+	for i := 0; i < len(points); i++ {
+		if points[i].Z.IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		result[i].X = accumulator
+		accumulator.Mul(&accumulator, &points[i].Z)
+	}
+
+	var accInverse fptower.E4
+	accInverse.Inverse(&accumulator)
+
+	for i := len(points) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			// do nothing, (X=0, Y=0) is infinity point in affine
+			continue
+		}
+		result[i].X.Mul(&result[i].X, &accInverse)
+		accInverse.Mul(&accInverse, &points[i].Z)
+	}
+
+	// batch convert to affine.
+	parallel.Execute(len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			if zeroes[i] {
+				// do nothing, (X=0, Y=0) is infinity point in affine
+				continue
+			}
+			var a, b fptower.E4
+			a = result[i].X
+			b.Square(&a)
+			result[i].X.Mul(&points[i].X, &b)
+			result[i].Y.Mul(&points[i].Y, &b).
+				Mul(&result[i].Y, &a)
+		}
+	})
+
+	return result
+}
+
 // BatchScalarMultiplicationG2 multiplies the same base by all scalars
 // and return resulting points in affine coordinates
 // uses a simple windowed-NAF like exponentiation algorithm
@@ -963,7 +1192,10 @@ func BatchScalarMultiplicationG2(base *G2Affine, scalars []fr.Element) []G2Affin
 		baseTable[i] = baseTable[i-1]
 		baseTable[i].AddMixed(base)
 	}
-	toReturn := make([]G2Affine, len(scalars))
+
+	// convert our base exp table into affine to use AddMixed
+	baseTableAff := BatchJacobianToAffineG2(baseTable)
+	toReturn := make([]G2Jac, len(scalars))
 
 	// partition the scalars into digits
 	digits, _ := partitionScalars(scalars, c, runtime.NumCPU())
@@ -989,21 +1221,22 @@ func BatchScalarMultiplicationG2(base *G2Affine, scalars []fr.Element) []G2Affin
 				// if msbWindow bit is set, we need to subtract
 				if digit&1 == 0 {
 					// add
-					p.AddAssign(&baseTable[(digit>>1)-1])
+					p.AddMixed(&baseTableAff[(digit>>1)-1])
 				} else {
 					// sub
-					t := baseTable[digit>>1]
+					t := baseTableAff[digit>>1]
 					t.Neg(&t)
-					p.AddAssign(&t)
+					p.AddMixed(&t)
 				}
 			}
 
 			// set our result point
-			toReturn[i].FromJacobian(&p)
-
+			toReturn[i] = p
 		}
 	})
-	return toReturn
+
+	toReturnAff := BatchJacobianToAffineG2(toReturn)
+	return toReturnAff
 }
 
 // batch add affine coordinates
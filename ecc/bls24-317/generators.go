@@ -0,0 +1,159 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls24317
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	errEmptyDomainTag       = errors.New("the domain tag must not be empty")
+	errNegativeNbGenerators = errors.New("the number of generators must be non-negative")
+	errWrongGenerators      = errors.New("the generators don't match their domain tag")
+)
+
+// seededGenerators caches the generators derived by GeneratorsFromSeed, by domain tag
+var seededGenerators = struct {
+	sync.Mutex
+	m map[string][]G1Affine
+}{m: make(map[string][]G1Affine)}
+
+// GeneratorsFromSeed returns n generators of G1 derived from domainTag, with no known discrete
+// logarithm relation between them ("nothing-up-my-sleeve"), e.g. for the bases of Pedersen
+// vector commitments or of inner product arguments.
+//
+// The i-th generator is HashToG1(i, domainTag), i being encoded on 8 bytes in big endian: the
+// first generators don't depend on n, and different protocols must use different tags. The
+// generators are cached, so that deriving them again (or a prefix of them) is free.
+func GeneratorsFromSeed(n int, domainTag []byte) ([]G1Affine, error) {
+	if len(domainTag) == 0 {
+		return nil, errEmptyDomainTag
+	}
+	if n < 0 {
+		return nil, errNegativeNbGenerators
+	}
+
+	seededGenerators.Lock()
+	defer seededGenerators.Unlock()
+
+	cached := seededGenerators.m[string(domainTag)]
+	if len(cached) < n {
+		extended := make([]G1Affine, n)
+		copy(extended, cached)
+		var err error
+		var errLock sync.Mutex
+		parallel.Execute(n-len(cached), func(start, end int) {
+			var msg [8]byte
+			for i := start + len(cached); i < end+len(cached); i++ {
+				binary.BigEndian.PutUint64(msg[:], uint64(i))
+				g, _err := HashToG1(msg[:], domainTag)
+				if _err != nil {
+					errLock.Lock()
+					err = _err
+					errLock.Unlock()
+					return
+				}
+				extended[i] = g
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		cached = extended
+		seededGenerators.m[string(domainTag)] = cached
+	}
+
+	res := make([]G1Affine, n)
+	copy(res, cached[:n])
+	return res, nil
+}
+
+// SeededGenerators are generators derived by GeneratorsFromSeed, along with their domain tag,
+// so that they can be written to disk and loaded back instead of being derived again.
+type SeededGenerators struct {
+	DomainTag []byte
+	Points    []G1Affine
+}
+
+// NewSeededGenerators returns the n generators derived from domainTag, see GeneratorsFromSeed.
+func NewSeededGenerators(n int, domainTag []byte) (*SeededGenerators, error) {
+	points, err := GeneratorsFromSeed(n, domainTag)
+	if err != nil {
+		return nil, err
+	}
+	tag := make([]byte, len(domainTag))
+	copy(tag, domainTag)
+	return &SeededGenerators{DomainTag: tag, Points: points}, nil
+}
+
+// Check derives the generators again from the domain tag, and returns an error if they don't
+// match the points. It should be called on generators read from an untrusted source.
+func (g *SeededGenerators) Check() error {
+	expected, err := GeneratorsFromSeed(len(g.Points), g.DomainTag)
+	if err != nil {
+		return err
+	}
+	for i := range expected {
+		if !expected[i].Equal(&g.Points[i]) {
+			return errWrongGenerators
+		}
+	}
+	return nil
+}
+
+// WriteTo writes the length of the domain tag on 4 bytes, the domain tag, and the points in
+// compressed form.
+func (g *SeededGenerators) WriteTo(w io.Writer) (int64, error) {
+	var buf [4]byte
+	binary.BigEndian.PutUint32(buf[:], uint32(len(g.DomainTag)))
+	n, err := w.Write(buf[:])
+	if err != nil {
+		return int64(n), err
+	}
+	m, err := w.Write(g.DomainTag)
+	n += m
+	if err != nil {
+		return int64(n), err
+	}
+	enc := NewEncoder(w)
+	err = enc.Encode(g.Points)
+	return int64(n) + enc.BytesWritten(), err
+}
+
+// ReadFrom reads generators written by WriteTo. The points are checked to be in G1, but not to
+// be derived from the domain tag, see Check.
+func (g *SeededGenerators) ReadFrom(r io.Reader) (int64, error) {
+	var buf [4]byte
+	n, err := io.ReadFull(r, buf[:])
+	if err != nil {
+		return int64(n), err
+	}
+	g.DomainTag = make([]byte, binary.BigEndian.Uint32(buf[:]))
+	m, err := io.ReadFull(r, g.DomainTag)
+	n += m
+	if err != nil {
+		return int64(n), err
+	}
+	dec := NewDecoder(r)
+	err = dec.Decode(&g.Points)
+	return int64(n) + dec.BytesRead(), err
+}
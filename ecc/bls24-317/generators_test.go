@@ -0,0 +1,136 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls24317
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestGeneratorsFromSeed(t *testing.T) {
+	t.Parallel()
+
+	tag := []byte("test generators")
+	generators, err := GeneratorsFromSeed(10, tag)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range generators {
+		if !generators[i].IsInSubGroup() || generators[i].IsInfinity() {
+			t.Fatal("the generators should be non trivial elements of G1")
+		}
+		for j := 0; j < i; j++ {
+			if generators[i].Equal(&generators[j]) {
+				t.Fatal("the generators should be distinct")
+			}
+		}
+	}
+
+	// the i-th generator is HashToG1(i, tag)
+	expected, err := HashToG1([]byte{0, 0, 0, 0, 0, 0, 0, 3}, tag)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !generators[3].Equal(&expected) {
+		t.Fatal("unexpected generator")
+	}
+
+	// prefixes (served by the cache) and extensions are consistent
+	prefix, err := GeneratorsFromSeed(4, tag)
+	if err != nil {
+		t.Fatal(err)
+	}
+	extended, err := GeneratorsFromSeed(20, tag)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range generators {
+		if (i < len(prefix) && !prefix[i].Equal(&generators[i])) || !extended[i].Equal(&generators[i]) {
+			t.Fatal("the generators should not depend on their number")
+		}
+	}
+
+	// the caller can't modify the cache
+	prefix[0].X.SetOne()
+	again, err := GeneratorsFromSeed(1, tag)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !again[0].Equal(&generators[0]) {
+		t.Fatal("the cache should not be modified by the caller")
+	}
+
+	// different tags give different generators
+	other, err := GeneratorsFromSeed(1, []byte("other generators"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other[0].Equal(&generators[0]) {
+		t.Fatal("different tags should give different generators")
+	}
+
+	if _, err := GeneratorsFromSeed(1, nil); err != errEmptyDomainTag {
+		t.Fatal("expected errEmptyDomainTag, got", err)
+	}
+	if _, err := GeneratorsFromSeed(-1, tag); err != errNegativeNbGenerators {
+		t.Fatal("expected errNegativeNbGenerators, got", err)
+	}
+}
+
+func TestSeededGeneratorsSerialization(t *testing.T) {
+	t.Parallel()
+
+	generators, err := NewSeededGenerators(5, []byte("serialization"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	written, err := generators.WriteTo(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if written != int64(buf.Len()) {
+		t.Fatal("wrong number of bytes written")
+	}
+
+	var read SeededGenerators
+	n, err := read.ReadFrom(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != written || !bytes.Equal(read.DomainTag, generators.DomainTag) || len(read.Points) != len(generators.Points) {
+		t.Fatal("the generators read don't match the ones written")
+	}
+	if err := read.Check(); err != nil {
+		t.Fatal(err)
+	}
+
+	// points that don't match the tag
+	read.Points[1], read.Points[2] = read.Points[2], read.Points[1]
+	if err := read.Check(); err != errWrongGenerators {
+		t.Fatal("expected errWrongGenerators, got", err)
+	}
+}
+
+func BenchmarkGeneratorsFromSeed(b *testing.B) {
+	var tag [8]byte
+	for i := 0; i < b.N; i++ {
+		tag[0] = byte(i)
+		tag[1] = byte(i >> 8)
+		_, _ = GeneratorsFromSeed(256, tag[:])
+	}
+}
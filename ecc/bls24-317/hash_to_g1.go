@@ -18,6 +18,7 @@ package bls24317
 
 import (
 	"github.com/consensys/gnark-crypto/ecc/bls24-317/fp"
+	"github.com/consensys/gnark-crypto/internal/parallel"
 
 	"math/big"
 )
@@ -82,7 +83,11 @@ func g1IsogenyYDenominator(dst *fp.Element, x *fp.Element) {
 		x)
 }
 
-func g1Isogeny(p *G1Affine) {
+// G1Isogeny maps, in place, a point of the curve E' isogenous to the G1 curve (the
+// target of MapToCurve1) to the G1 curve.
+// It can be used with MapToCurve1 and ClearCofactor to build custom
+// hash-to-curve constructions.
+func G1Isogeny(p *G1Affine) {
 
 	den := make([]fp.Element, 2)
 
@@ -98,11 +103,35 @@ func g1Isogeny(p *G1Affine) {
 	p.Y.Mul(&p.Y, &den[1])
 }
 
-// g1SqrtRatio computes the square root of u/v and returns 0 iff u/v was indeed a quadratic residue
+// g1BatchIsogeny applies G1Isogeny to all points, with a single batch inversion.
+func g1BatchIsogeny(points []G1Affine) {
+	den := make([]fp.Element, 2*len(points))
+	parallel.Execute(len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			p := &points[i]
+			g1IsogenyYDenominator(&den[2*i+1], &p.X)
+			g1IsogenyXDenominator(&den[2*i], &p.X)
+
+			g1IsogenyYNumerator(&p.Y, &p.X, &p.Y)
+			g1IsogenyXNumerator(&p.X, &p.X)
+		}
+	})
+
+	den = fp.BatchInvert(den)
+
+	parallel.Execute(len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			points[i].X.Mul(&points[i].X, &den[2*i])
+			points[i].Y.Mul(&points[i].Y, &den[2*i+1])
+		}
+	})
+}
+
+// G1SqrtRatio computes the square root of u/v and returns 0 iff u/v was indeed a quadratic residue
 // if not, we get sqrt(Z * u / v). Recall that Z is non-residue
 // If v = 0, u/v is meaningless and the output is unspecified, without raising an error.
 // The main idea is that since the computation of the square root involves taking large powers of u/v, the inversion of v can be avoided
-func g1SqrtRatio(z *fp.Element, u *fp.Element, v *fp.Element) uint64 {
+func G1SqrtRatio(z *fp.Element, u *fp.Element, v *fp.Element) uint64 {
 	// https://www.ietf.org/archive/id/draft-irtf-cfrg-hash-to-curve-16.html#name-optimized-sqrt_ratio-for-q- (3 mod 4)
 	var tv1 fp.Element
 	tv1.Square(v) // 1. tv1 = v²
@@ -148,6 +177,16 @@ func g1MulByZ(z *fp.Element, x *fp.Element) {
 // MapToCurve1 implements the SSWU map
 // No cofactor clearing or isogeny
 func MapToCurve1(u *fp.Element) G1Affine {
+	var res G1Affine
+	var den fp.Element
+	g1MapToCurveFraction(&res, &den, u)
+	res.X.Div(&res.X, &den) // 25.   x = x / tv4
+	return res
+}
+
+// g1MapToCurveFraction implements the SSWU map, without the final division:
+// the result is (p.X/den, p.Y), so that batch hashing can share the inversions.
+func g1MapToCurveFraction(p *G1Affine, den *fp.Element, u *fp.Element) {
 
 	var sswuIsoCurveCoeffA = fp.Element{2751493217506761890, 10508083672876982400, 9568653941102734201, 1934905759174260726, 590687129635764257}
 	var sswuIsoCurveCoeffB = fp.Element{14477170886729819615, 1154054877908840441, 13400991584556574205, 3277375072715511934, 979998381373634863}
@@ -196,7 +235,7 @@ func MapToCurve1(u *fp.Element) G1Affine {
 	x.Mul(&tv1, &tv3) // 17.   x = tv1 * tv3
 
 	var y1 fp.Element
-	gx1NSquare := g1SqrtRatio(&y1, &tv2, &tv6) // 18. (is_gx1_square, y1) = sqrt_ratio(tv2, tv6)
+	gx1NSquare := G1SqrtRatio(&y1, &tv2, &tv6) // 18. (is_gx1_square, y1) = sqrt_ratio(tv2, tv6)
 
 	var y fp.Element
 	y.Mul(&tv1, u) // 19.   y = tv1 * u
@@ -207,14 +246,14 @@ func MapToCurve1(u *fp.Element) G1Affine {
 	y.Select(int(gx1NSquare), &y1, &y)  // 22.   y = CMOV(y, y1, is_gx1_square)
 
 	y1.Neg(&y)
-	y.Select(int(g1Sgn0(u)^g1Sgn0(&y)), &y, &y1)
+	y.Select(int(G1Sgn0(u)^G1Sgn0(&y)), &y, &y1)
 
 	// 23.  e1 = sgn0(u) == sgn0(y)
 	// 24.   y = CMOV(-y, y, e1)
 
-	x.Div(&x, &tv4) // 25.   x = x / tv4
-
-	return G1Affine{x, y}
+	p.X = x
+	p.Y = y
+	*den = tv4
 }
 
 func g1EvalPolynomial(z *fp.Element, monic bool, coefficients []fp.Element, x *fp.Element) {
@@ -232,11 +271,11 @@ func g1EvalPolynomial(z *fp.Element, monic bool, coefficients []fp.Element, x *f
 	z.Set(&dst)
 }
 
-// g1Sgn0 is an algebraic substitute for the notion of sign in ordered fields
+// G1Sgn0 is an algebraic substitute for the notion of sign in ordered fields
 // Namely, every non-zero quadratic residue in a finite field of characteristic =/= 2 has exactly two square roots, one of each sign
 // https://www.ietf.org/archive/id/draft-irtf-cfrg-hash-to-curve-16.html#name-the-sgn0-function
 // The sign of an element is not obviously related to that of its Montgomery form
-func g1Sgn0(z *fp.Element) uint64 {
+func G1Sgn0(z *fp.Element) uint64 {
 
 	nonMont := z.Bits()
 
@@ -249,7 +288,7 @@ func g1Sgn0(z *fp.Element) uint64 {
 func MapToG1(u fp.Element) G1Affine {
 	res := MapToCurve1(&u)
 	//this is in an isogenous curve
-	g1Isogeny(&res)
+	G1Isogeny(&res)
 	res.ClearCofactor(&res)
 	return res
 }
@@ -269,7 +308,7 @@ func EncodeToG1(msg, dst []byte) (G1Affine, error) {
 	res = MapToCurve1(&u[0])
 
 	//this is in an isogenous curve
-	g1Isogeny(&res)
+	G1Isogeny(&res)
 	res.ClearCofactor(&res)
 	return res, nil
 }
@@ -288,8 +327,8 @@ func HashToG1(msg, dst []byte) (G1Affine, error) {
 	Q1 := MapToCurve1(&u[1])
 
 	//TODO (perf): Add in E' first, then apply isogeny
-	g1Isogeny(&Q0)
-	g1Isogeny(&Q1)
+	G1Isogeny(&Q0)
+	G1Isogeny(&Q1)
 
 	var _Q0, _Q1 G1Jac
 	_Q0.FromAffine(&Q0)
@@ -301,6 +340,55 @@ func HashToG1(msg, dst []byte) (G1Affine, error) {
 	return Q1, nil
 }
 
+// BatchHashToG1 hashes each message to a point on the G1 curve, as HashToG1.
+//
+// The field inversions of the maps, of the isogenies and of the conversions to affine
+// coordinates are shared across messages with batch inversions, and the cofactor clearing is done in
+// Jacobian coordinates.
+func BatchHashToG1(msgs [][]byte, dst []byte) ([]G1Affine, error) {
+	n := len(msgs)
+	u := make([]fp.Element, 2*n)
+	for i := range msgs {
+		e, err := fp.Hash(msgs[i], dst, 2*1)
+		if err != nil {
+			return nil, err
+		}
+
+		u[2*i], u[2*i+1] = e[0], e[1]
+
+	}
+
+	// Q[2i], Q[2i+1] are the images of the two field elements of message i
+	Q := make([]G1Affine, 2*n)
+	den := make([]fp.Element, 2*n)
+	parallel.Execute(2*n, func(start, end int) {
+		for i := start; i < end; i++ {
+			g1MapToCurveFraction(&Q[i], &den[i], &u[i])
+		}
+	})
+	den = fp.BatchInvert(den)
+	parallel.Execute(2*n, func(start, end int) {
+		for i := start; i < end; i++ {
+			Q[i].X.Mul(&Q[i].X, &den[i])
+		}
+	})
+	//these are in an isogenous curve
+	g1BatchIsogeny(Q)
+
+	res := make([]G1Jac, n)
+	parallel.Execute(n, func(start, end int) {
+		var q1 G1Jac
+		for i := start; i < end; i++ {
+			res[i].FromAffine(&Q[2*i])
+			q1.FromAffine(&Q[2*i+1])
+			res[i].AddAssign(&q1)
+			res[i].ClearCofactor(&res[i])
+		}
+	})
+
+	return BatchJacobianToAffineG1(res), nil
+}
+
 func g1NotZero(x *fp.Element) uint64 {
 
 	return x[0] | x[1] | x[2] | x[3] | x[4]
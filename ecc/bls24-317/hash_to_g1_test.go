@@ -41,7 +41,7 @@ func TestG1SqrtRatio(t *testing.T) {
 		func(u fp.Element, v fp.Element) bool {
 
 			var seen fp.Element
-			qr := g1SqrtRatio(&seen, &u, &v) == 0
+			qr := G1SqrtRatio(&seen, &u, &v) == 0
 
 			seen.
 				Square(&seen).
@@ -99,7 +99,7 @@ func TestMapToCurve1(t *testing.T) {
 				t.Log("Mapping output not on E' curve")
 				return false
 			}
-			g1Isogeny(&g)
+			G1Isogeny(&g)
 
 			if !g.IsOnCurve() {
 				t.Log("Isogeny∘SSWU output not on curve")
@@ -117,7 +117,7 @@ func TestMapToCurve1(t *testing.T) {
 		var u fp.Element
 		g1CoordSetString(&u, c.u)
 		q := MapToCurve1(&u)
-		g1Isogeny(&q)
+		G1Isogeny(&q)
 		g1TestMatchPoint(t, "Q", c.msg, c.Q, &q)
 	}
 
@@ -125,12 +125,12 @@ func TestMapToCurve1(t *testing.T) {
 		var u fp.Element
 		g1CoordSetString(&u, c.u0)
 		q := MapToCurve1(&u)
-		g1Isogeny(&q)
+		G1Isogeny(&q)
 		g1TestMatchPoint(t, "Q0", c.msg, c.Q0, &q)
 
 		g1CoordSetString(&u, c.u1)
 		q = MapToCurve1(&u)
-		g1Isogeny(&q)
+		G1Isogeny(&q)
 		g1TestMatchPoint(t, "Q1", c.msg, c.Q1, &q)
 	}
 }
@@ -188,6 +188,57 @@ func TestHashToG1(t *testing.T) {
 	}
 }
 
+func TestBatchHashToG1(t *testing.T) {
+	t.Parallel()
+	dst := hashToG1Vector.dst
+	msgs := make([][]byte, 0, len(hashToG1Vector.cases)+20)
+	for _, c := range hashToG1Vector.cases {
+		msgs = append(msgs, []byte(c.msg))
+	}
+	for i := 0; i < 20; i++ {
+		msg := make([]byte, i)
+		rand.Read(msg) //#nosec G404 weak rng is fine here
+		msgs = append(msgs, msg)
+	}
+
+	res, err := BatchHashToG1(msgs, dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != len(msgs) {
+		t.Fatal("wrong number of points")
+	}
+	for i := range msgs {
+		expected, err := HashToG1(msgs[i], dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !expected.Equal(&res[i]) {
+			t.Fatalf("message %d: BatchHashToG1 and HashToG1 differ", i)
+		}
+	}
+
+	if res, err = BatchHashToG1(nil, dst); err != nil || len(res) != 0 {
+		t.Fatal("hashing no message should return no point")
+	}
+}
+
+func BenchmarkBatchHashToG1(b *testing.B) {
+	const n = 1024
+	dst := hashToG1Vector.dst
+	msgs := make([][]byte, n)
+	for i := range msgs {
+		msgs[i] = make([]byte, 32)
+		rand.Read(msgs[i]) //#nosec G404 weak rng is fine here
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := BatchHashToG1(msgs, dst); err != nil {
+			b.Fail()
+		}
+	}
+}
+
 func BenchmarkEncodeToG1(b *testing.B) {
 	const size = 54
 	bytes := make([]byte, size)
@@ -0,0 +1,180 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"hash"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	"github.com/consensys/gnark-crypto/fiat-shamir"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// GeminiProof is an opening proof of a multilinear polynomial f in n variables at a point z,
+// following the tensor-product reduction of Gemini [BCHO22].
+//
+// Writing f₀ = ∑ᵢf(i)Xⁱ for the committed polynomial, the prover folds it n times,
+// fixing the variable of the least significant bit first:
+//
+//	fⱼ₊₁(X²) = (1-zₙ₋₁₋ⱼ)·(fⱼ(X)+fⱼ(-X))/2 + zₙ₋₁₋ⱼ·(fⱼ(X)-fⱼ(-X))/(2X)
+//
+// so that fₙ is the constant f(z). The prover commits to f₁, …, fₙ₋₁, and given a challenge β,
+// opens each fⱼ at rⱼ = β^{2ʲ} and -rⱼ. The verifier checks the folding relation at the rⱼ,
+// with fₙ(rₙ) = f(z). The 2n univariate openings are aggregated in a single
+// AggregatedOpeningProof.
+//
+// [BCHO22]: https://eprint.iacr.org/2022/420.pdf
+type GeminiProof struct {
+	// Folded commitments to the folded polynomials f₁, …, fₙ₋₁
+	Folded []Digest
+
+	// Openings aggregated openings of f₀, …, fₙ₋₁ at r₀, …, rₙ₋₁, then at -r₀, …, -rₙ₋₁
+	Openings AggregatedOpeningProof
+
+	// ClaimedValue purported value f(z)
+	ClaimedValue fr.Element
+}
+
+// GeminiOpen computes an opening proof of the multilinear polynomial given by its evaluations
+// on the hypercube, committed in digest, at point.
+//
+// * dataTranscript extra data that might be needed to derive the challenges
+func GeminiOpen(evaluations []fr.Element, digest Digest, point []fr.Element, hf hash.Hash, pk ProvingKey, dataTranscript ...[]byte) (GeminiProof, error) {
+	n, err := nbVariables(len(evaluations), len(point))
+	if err != nil {
+		return GeminiProof{}, err
+	}
+	if len(evaluations) > len(pk.G1) {
+		return GeminiProof{}, ErrInvalidPolynomialSize
+	}
+
+	// f₀, …, fₙ₋₁, and fₙ = f(z)
+	folded := make([][]fr.Element, n+1)
+	folded[0] = evaluations
+	for j := 0; j < n; j++ {
+		f := folded[j]
+		z := point[n-1-j]
+		next := make([]fr.Element, len(f)/2)
+		parallel.Execute(len(next), func(start, end int) {
+			var t fr.Element
+			for i := start; i < end; i++ {
+				t.Sub(&f[2*i+1], &f[2*i]).Mul(&t, &z)
+				next[i].Add(&f[2*i], &t)
+			}
+		})
+		folded[j+1] = next
+	}
+
+	res := GeminiProof{
+		Folded:       make([]Digest, n-1),
+		ClaimedValue: folded[n][0],
+	}
+	for j := range res.Folded {
+		if res.Folded[j], err = Commit(folded[j+1], pk); err != nil {
+			return GeminiProof{}, err
+		}
+	}
+
+	beta, err := deriveGeminiChallenge(&digest, point, &res, dataTranscript, hf)
+	if err != nil {
+		return GeminiProof{}, err
+	}
+
+	// open f₀, …, fₙ₋₁ at ±rⱼ
+	polynomials := make([][]fr.Element, 2*n)
+	copy(polynomials, folded[:n])
+	copy(polynomials[n:], folded[:n])
+	res.Openings, err = AggregateOpen(polynomials, geminiDigests(&digest, res.Folded), geminiPoints(beta, n), hf, pk, beta.Marshal())
+	if err != nil {
+		return GeminiProof{}, err
+	}
+
+	return res, nil
+}
+
+// GeminiVerify verifies a GeminiProof of the multilinear polynomial committed in digest, at point.
+//
+// * dataTranscript extra data that might be needed to derive the challenges
+func GeminiVerify(digest *Digest, proof *GeminiProof, point []fr.Element, hf hash.Hash, vk VerifyingKey, dataTranscript ...[]byte) error {
+	n := len(point)
+	if n == 0 {
+		return ErrInvalidMultilinearSize
+	}
+	if len(proof.Folded) != n-1 || len(proof.Openings.ClaimedValues) != 2*n {
+		return ErrVerifyOpeningProof
+	}
+
+	beta, err := deriveGeminiChallenge(digest, point, proof, dataTranscript, hf)
+	if err != nil {
+		return err
+	}
+	points := geminiPoints(beta, n)
+
+	// 2rⱼ·fⱼ₊₁(rⱼ²) = rⱼ(1-z)(fⱼ(rⱼ)+fⱼ(-rⱼ)) + z(fⱼ(rⱼ)-fⱼ(-rⱼ)), where z = zₙ₋₁₋ⱼ
+	values := proof.Openings.ClaimedValues
+	for j := 0; j < n; j++ {
+		next := &proof.ClaimedValue
+		if j != n-1 {
+			next = &values[j+1]
+		}
+		z := point[n-1-j]
+		var sum, diff, lhs, rhs, t fr.Element
+		sum.Add(&values[j], &values[n+j])
+		diff.Sub(&values[j], &values[n+j])
+		t.SetOne().Sub(&t, &z)
+		rhs.Mul(&sum, &t).Mul(&rhs, &points[j])
+		t.Mul(&diff, &z)
+		rhs.Add(&rhs, &t)
+		lhs.Double(&points[j]).Mul(&lhs, next)
+		if !lhs.Equal(&rhs) {
+			return ErrVerifyOpeningProof
+		}
+	}
+
+	return VerifyAggregated(geminiDigests(digest, proof.Folded), &proof.Openings, points, hf, vk, beta.Marshal())
+}
+
+// geminiDigests returns the digests of f₀, …, fₙ₋₁, twice
+func geminiDigests(digest *Digest, folded []Digest) []Digest {
+	n := len(folded) + 1
+	res := make([]Digest, 2*n)
+	res[0].Set(digest)
+	copy(res[1:], folded)
+	copy(res[n:], res[:n])
+	return res
+}
+
+// geminiPoints returns r₀, …, rₙ₋₁, -r₀, …, -rₙ₋₁, where rⱼ = β^{2ʲ}
+func geminiPoints(beta fr.Element, n int) []fr.Element {
+	res := make([]fr.Element, 2*n)
+	res[0] = beta
+	for j := 1; j < n; j++ {
+		res[j].Square(&res[j-1])
+	}
+	for j := 0; j < n; j++ {
+		res[n+j].Neg(&res[j])
+	}
+	return res
+}
+
+// deriveGeminiChallenge derives β, bound to the commitment, the point, the claimed value and the
+// commitments to the folded polynomials
+func deriveGeminiChallenge(digest *Digest, point []fr.Element, proof *GeminiProof, dataTranscript [][]byte, hf hash.Hash) (fr.Element, error) {
+	fs := fiatshamir.NewTranscript(hf, "beta")
+	return deriveAggregationChallenge(fs, "beta", bindMultilinearOpening(digest, point, &proof.ClaimedValue, proof.Folded, dataTranscript)...)
+}
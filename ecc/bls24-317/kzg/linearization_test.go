@@ -0,0 +1,150 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+)
+
+func TestLinearize(t *testing.T) {
+	t.Parallel()
+
+	const nbPolynomials = 5
+	polynomials := make([][]fr.Element, nbPolynomials)
+	digests := make([]Digest, nbPolynomials)
+	scalars := make([]fr.Element, nbPolynomials)
+	for i := range polynomials {
+		polynomials[i] = randomPolynomial(40 + i)
+		var err error
+		if digests[i], err = Commit(polynomials[i], testSrs.Pk); err != nil {
+			t.Fatal(err)
+		}
+		scalars[i].SetRandom()
+	}
+
+	// the linearized commitment is the commitment to the linear combination
+	l := linearCombination(polynomials, scalars, 40+nbPolynomials-1)
+	expected, err := Commit(l, testSrs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	linearized, err := Linearize(digests, scalars)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !linearized.Equal(&expected) {
+		t.Fatal("wrong linearized commitment")
+	}
+
+	if _, err := Linearize(digests, scalars[1:]); !errors.Is(err, ErrInvalidNbDigests) {
+		t.Fatalf("expected ErrInvalidNbDigests, got %v", err)
+	}
+	if _, err := Linearize(nil, nil); !errors.Is(err, ErrZeroNbDigests) {
+		t.Fatalf("expected ErrZeroNbDigests, got %v", err)
+	}
+}
+
+func TestPairingInputs(t *testing.T) {
+	t.Parallel()
+
+	// a PLONK-like verification: the linearized commitment is opened at ζ, along with
+	// another polynomial at ωζ
+	f := [][]fr.Element{randomPolynomial(60), randomPolynomial(60)}
+	g := randomPolynomial(50)
+	var a, b, zeta, omegaZeta fr.Element
+	a.SetRandom()
+	b.SetRandom()
+	zeta.SetRandom()
+	omegaZeta.SetRandom()
+
+	fDigests := make([]Digest, 2)
+	for i := range f {
+		var err error
+		if fDigests[i], err = Commit(f[i], testSrs.Pk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	gDigest, err := Commit(g, testSrs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	linearized, err := Linearize(fDigests, []fr.Element{a, b})
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := linearCombination(f, []fr.Element{a, b}, 60)
+	lProof, err := Open(l, zeta, testSrs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gProof, err := Open(g, omegaZeta, testSrs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	digests := []Digest{linearized, gDigest}
+	proofs := []OpeningProof{lProof, gProof}
+	points := []fr.Element{zeta, omegaZeta}
+	coefficients := make([]fr.Element, 2)
+	coefficients[0].SetOne()
+	coefficients[1].SetRandom()
+
+	inputs, err := PairingInputs(digests, proofs, points, coefficients, testSrs.Vk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckPairingInputs(inputs, testSrs.Vk); err != nil {
+		t.Fatal(err)
+	}
+
+	// the inputs can be checked against G₂ and [α]G₂ in a larger pairing check
+	ok, err := bls24317.PairingCheck(inputs[:], testSrs.Vk.G2[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("the pairing inputs must be checked against G₂ and [α]G₂")
+	}
+
+	// single proof, as Verify
+	single, err := PairingInputs(digests[:1], proofs[:1], points[:1], coefficients[:1], testSrs.Vk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckPairingInputs(single, testSrs.Vk); err != nil {
+		t.Fatal(err)
+	}
+
+	// wrong claimed value
+	proofs[1].ClaimedValue.Double(&proofs[1].ClaimedValue)
+	inputs, err = PairingInputs(digests, proofs, points, coefficients, testSrs.Vk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckPairingInputs(inputs, testSrs.Vk); !errors.Is(err, ErrVerifyOpeningProof) {
+		t.Fatalf("expected ErrVerifyOpeningProof, got %v", err)
+	}
+
+	if _, err := PairingInputs(digests, proofs, points, coefficients[:1], testSrs.Vk); !errors.Is(err, ErrInvalidNbDigests) {
+		t.Fatalf("expected ErrInvalidNbDigests, got %v", err)
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr/polynomial"
+)
+
+func TestGemini(t *testing.T) {
+	hf := sha256.New()
+	for nbVars := 1; nbVars <= 6; nbVars++ {
+		evaluations := randomPolynomial(1 << nbVars)
+		point := randomPolynomial(nbVars)
+		digest, err := Commit(evaluations, testSrs.Pk)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		proof, err := GeminiOpen(evaluations, digest, point, hf, testSrs.Pk, []byte("data"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := polynomial.MultiLin(evaluations).Evaluate(point, nil); !proof.ClaimedValue.Equal(&expected) {
+			t.Fatal("wrong claimed value")
+		}
+		if err := GeminiVerify(&digest, &proof, point, hf, testSrs.Vk, []byte("data")); err != nil {
+			t.Fatal(err)
+		}
+
+		// wrong claimed value
+		wrongProof := proof
+		wrongProof.ClaimedValue.SetRandom()
+		if err := GeminiVerify(&digest, &wrongProof, point, hf, testSrs.Vk, []byte("data")); err == nil {
+			t.Fatal("verifying a wrong claimed value should fail")
+		}
+
+		// wrong point
+		wrongPoint := make([]fr.Element, nbVars)
+		copy(wrongPoint, point)
+		wrongPoint[nbVars-1].SetRandom()
+		if err := GeminiVerify(&digest, &proof, wrongPoint, hf, testSrs.Vk, []byte("data")); err == nil {
+			t.Fatal("verifying at a wrong point should fail")
+		}
+
+		// wrong transcript data
+		if err := GeminiVerify(&digest, &proof, point, hf, testSrs.Vk); err == nil {
+			t.Fatal("verifying with different transcript data should fail")
+		}
+	}
+}
+
+func TestZeromorph(t *testing.T) {
+	hf := sha256.New()
+	for nbVars := 1; nbVars <= 6; nbVars++ {
+		// the degree check is sound with an SRS of exactly 2ⁿ powers
+		srs, err := NewSRSInsecure(1<<nbVars, testSeed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		evaluations := randomPolynomial(1 << nbVars)
+		point := randomPolynomial(nbVars)
+		digest, err := Commit(evaluations, srs.Pk)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		proof, err := ZeromorphOpen(evaluations, digest, point, hf, srs.Pk, []byte("data"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := polynomial.MultiLin(evaluations).Evaluate(point, nil); !proof.ClaimedValue.Equal(&expected) {
+			t.Fatal("wrong claimed value")
+		}
+		if err := ZeromorphVerify(&digest, &proof, point, hf, srs.Vk, []byte("data")); err != nil {
+			t.Fatal(err)
+		}
+
+		// wrong claimed value
+		wrongProof := proof
+		wrongProof.ClaimedValue.SetRandom()
+		if err := ZeromorphVerify(&digest, &wrongProof, point, hf, srs.Vk, []byte("data")); err != ErrVerifyOpeningProof {
+			t.Fatal("verifying a wrong claimed value should fail")
+		}
+
+		// wrong point
+		wrongPoint := make([]fr.Element, nbVars)
+		copy(wrongPoint, point)
+		wrongPoint[0].SetRandom()
+		if err := ZeromorphVerify(&digest, &proof, wrongPoint, hf, srs.Vk, []byte("data")); err != ErrVerifyOpeningProof {
+			t.Fatal("verifying at a wrong point should fail")
+		}
+
+		// wrong quotient
+		wrongProof = proof
+		wrongProof.Quotients = make([]Digest, nbVars)
+		copy(wrongProof.Quotients, proof.Quotients)
+		wrongProof.Quotients[0].Double(&wrongProof.Quotients[0])
+		if err := ZeromorphVerify(&digest, &wrongProof, point, hf, srs.Vk, []byte("data")); err != ErrVerifyOpeningProof {
+			t.Fatal("verifying a wrong quotient should fail")
+		}
+
+		// a larger SRS works too (see ZeromorphProof for the soundness of the degree check)
+		proof, err = ZeromorphOpen(evaluations, digest, point, hf, testSrs.Pk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ZeromorphVerify(&digest, &proof, point, hf, testSrs.Vk); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestMultilinearErrors(t *testing.T) {
+	hf := sha256.New()
+	evaluations := randomPolynomial(8)
+	digest, err := Commit(evaluations, testSrs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := GeminiOpen(evaluations[:6], digest, randomPolynomial(3), hf, testSrs.Pk); err != ErrInvalidMultilinearSize {
+		t.Fatal("expected ErrInvalidMultilinearSize, got", err)
+	}
+	if _, err := ZeromorphOpen(evaluations[:1], digest, nil, hf, testSrs.Pk); err != ErrInvalidMultilinearSize {
+		t.Fatal("expected ErrInvalidMultilinearSize, got", err)
+	}
+	if _, err := GeminiOpen(evaluations, digest, randomPolynomial(2), hf, testSrs.Pk); err != ErrInvalidNbVariables {
+		t.Fatal("expected ErrInvalidNbVariables, got", err)
+	}
+	if _, err := ZeromorphOpen(evaluations, digest, randomPolynomial(4), hf, testSrs.Pk); err != ErrInvalidNbVariables {
+		t.Fatal("expected ErrInvalidNbVariables, got", err)
+	}
+	if _, err := ZeromorphOpen(randomPolynomial(2*len(testSrs.Pk.G1)), digest, randomPolynomial(9), hf, testSrs.Pk); err != ErrInvalidPolynomialSize {
+		t.Fatal("expected ErrInvalidPolynomialSize, got", err)
+	}
+
+	// proofs of the wrong size
+	point := randomPolynomial(3)
+	geminiProof, err := GeminiOpen(evaluations, digest, point, hf, testSrs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	geminiProof.Folded = geminiProof.Folded[1:]
+	if err := GeminiVerify(&digest, &geminiProof, point, hf, testSrs.Vk); err != ErrVerifyOpeningProof {
+		t.Fatal("expected ErrVerifyOpeningProof, got", err)
+	}
+	zeromorphProof, err := ZeromorphOpen(evaluations, digest, point, hf, testSrs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ZeromorphVerify(&digest, &zeromorphProof, point[1:], hf, testSrs.Vk); err != ErrVerifyOpeningProof {
+		t.Fatal("expected ErrVerifyOpeningProof, got", err)
+	}
+}
+
+func BenchmarkMultilinearOpen(b *testing.B) {
+	const nbVars = 8
+	hf := sha256.New()
+	evaluations := randomPolynomial(1 << nbVars)
+	point := randomPolynomial(nbVars)
+	digest, err := Commit(evaluations, testSrs.Pk)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.Run("Gemini", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = GeminiOpen(evaluations, digest, point, hf, testSrs.Pk)
+		}
+	})
+	b.Run("Zeromorph", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = ZeromorphOpen(evaluations, digest, point, hf, testSrs.Pk)
+		}
+	})
+}
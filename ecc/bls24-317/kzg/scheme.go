@@ -0,0 +1,116 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"hash"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr/pcs"
+)
+
+// Scheme is the KZG commitment scheme with a given setup, as a pcs.Scheme.
+// A verifier only needs to set Vk.
+//
+// The commitments are of type Digest, and the proofs of type *OpeningProof
+// and *BatchOpeningProof.
+type Scheme struct {
+	Pk ProvingKey
+	Vk VerifyingKey
+}
+
+var _ pcs.Scheme = (*Scheme)(nil)
+
+// NewScheme returns the KZG commitment scheme using srs
+func NewScheme(srs *SRS) *Scheme {
+	return &Scheme{Pk: srs.Pk, Vk: srs.Vk}
+}
+
+// Evaluation returns the claimed value of the opening
+func (proof *OpeningProof) Evaluation() fr.Element {
+	return proof.ClaimedValue
+}
+
+// Evaluations returns the claimed values of the opening
+func (proof *BatchOpeningProof) Evaluations() []fr.Element {
+	return proof.ClaimedValues
+}
+
+// Commit see Commit
+func (s *Scheme) Commit(p []fr.Element) (pcs.Commitment, error) {
+	return Commit(p, s.Pk)
+}
+
+// Open see Open
+func (s *Scheme) Open(p []fr.Element, point fr.Element) (pcs.OpeningProof, error) {
+	proof, err := Open(p, point, s.Pk)
+	if err != nil {
+		return nil, err
+	}
+	return &proof, nil
+}
+
+// Verify see Verify
+func (s *Scheme) Verify(commitment pcs.Commitment, proof pcs.OpeningProof, point fr.Element) error {
+	digest, ok := commitment.(Digest)
+	if !ok {
+		return pcs.ErrCommitmentType
+	}
+	openingProof, ok := proof.(*OpeningProof)
+	if !ok {
+		return pcs.ErrProofType
+	}
+	return Verify(&digest, openingProof, point, s.Vk)
+}
+
+// BatchOpen see BatchOpenSinglePoint
+func (s *Scheme) BatchOpen(polynomials [][]fr.Element, commitments []pcs.Commitment, point fr.Element, hf hash.Hash, dataTranscript ...[]byte) (pcs.BatchOpeningProof, error) {
+	digests, err := toDigests(commitments)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := BatchOpenSinglePoint(polynomials, digests, point, hf, s.Pk, dataTranscript...)
+	if err != nil {
+		return nil, err
+	}
+	return &proof, nil
+}
+
+// BatchVerify see BatchVerifySinglePoint
+func (s *Scheme) BatchVerify(commitments []pcs.Commitment, proof pcs.BatchOpeningProof, point fr.Element, hf hash.Hash, dataTranscript ...[]byte) error {
+	digests, err := toDigests(commitments)
+	if err != nil {
+		return err
+	}
+	batchOpeningProof, ok := proof.(*BatchOpeningProof)
+	if !ok {
+		return pcs.ErrProofType
+	}
+	return BatchVerifySinglePoint(digests, batchOpeningProof, point, hf, s.Vk, dataTranscript...)
+}
+
+func toDigests(commitments []pcs.Commitment) ([]Digest, error) {
+	digests := make([]Digest, len(commitments))
+	for i := range commitments {
+		digest, ok := commitments[i].(Digest)
+		if !ok {
+			return nil, pcs.ErrCommitmentType
+		}
+		digests[i] = digest
+	}
+	return digests, nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls24317
+
+import (
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	"github.com/consensys/gnark-crypto/random"
+)
+
+// PairingTerm is a term e(P, Q) of a pairing product equation, or e(P, Q)⁻¹ = e(-P, Q) if Neg is set
+type PairingTerm struct {
+	P   G1Affine
+	Q   G2Affine
+	Neg bool
+}
+
+// PairingEquationOption configures VerifyPairingEquations
+type PairingEquationOption func(*pairingEquationConfig)
+
+type pairingEquationConfig struct {
+	randomness io.Reader
+}
+
+// WithPairingEquationRandomness samples the coefficients of the random linear combination
+// of the equations from r instead of the package-level source of randomness (see random.SetReader).
+// r must be unpredictable to the prover.
+func WithPairingEquationRandomness(r io.Reader) PairingEquationOption {
+	return func(c *pairingEquationConfig) {
+		c.randomness = r
+	}
+}
+
+// VerifyPairingEquation returns true if ∏ᵢ e(termsᵢ.P, termsᵢ.Q)^{±1} = 1.
+//
+// The terms are normalized before running a single multi-pairing: the terms with a point at
+// infinity are dropped and the terms sharing the same G2 point are merged, e.g.
+// e(P₁, Q)⋅e(P₂, Q)⁻¹ is computed as e(P₁-P₂, Q).
+//
+// This function doesn't check that the inputs are in the correct subgroup. See IsInSubGroup.
+func VerifyPairingEquation(terms []PairingTerm) (bool, error) {
+	return VerifyPairingEquations([][]PairingTerm{terms})
+}
+
+// VerifyPairingEquations returns true if all the pairing product equations hold, see
+// VerifyPairingEquation.
+//
+// The equations are batched with a random linear combination: it checks
+// ∏ₖ (∏ᵢ e(Pₖᵢ, Qₖᵢ)^{±1})^{ρₖ} = 1 with a single multi-pairing, where ρ₀ = 1 and the other
+// ρₖ are random (see WithPairingEquationRandomness). If one of the equations doesn't hold, it
+// returns false except with negligible probability.
+//
+// This function doesn't check that the inputs are in the correct subgroup. See IsInSubGroup.
+func VerifyPairingEquations(equations [][]PairingTerm, opts ...PairingEquationOption) (bool, error) {
+	cfg := pairingEquationConfig{randomness: random.Reader()}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	var (
+		P     []G1Jac
+		Q     []G2Affine
+		index = make(map[[SizeOfG2AffineCompressed]byte]int)
+		rho   fr.Element
+		k     big.Int
+	)
+	for e, terms := range equations {
+		if e != 0 {
+			if _, err := rho.SetRandomFrom(cfg.randomness); err != nil {
+				return false, err
+			}
+			rho.BigInt(&k)
+		}
+		for i := range terms {
+			if terms[i].P.IsInfinity() || terms[i].Q.IsInfinity() {
+				continue
+			}
+			var p G1Jac
+			p.FromAffine(&terms[i].P)
+			if terms[i].Neg {
+				p.Neg(&p)
+			}
+			if e != 0 {
+				p.ScalarMultiplication(&p, &k)
+			}
+			key := terms[i].Q.Bytes()
+			if j, ok := index[key]; ok {
+				P[j].AddAssign(&p)
+			} else {
+				index[key] = len(P)
+				P = append(P, p)
+				Q = append(Q, terms[i].Q)
+			}
+		}
+	}
+
+	// empty product
+	if len(P) == 0 {
+		return true, nil
+	}
+
+	return PairingCheck(BatchJacobianToAffineG1(P), Q)
+}
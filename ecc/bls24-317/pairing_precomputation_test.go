@@ -0,0 +1,213 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls24317
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+)
+
+// groth16LikeEquation returns a PairingPrecomputation for e(A, B)⋅e(C, -[δ]g₂)⋅e(D, -[γ]g₂) = e([α]g₁, [β]g₂)
+// and a random solution (A, B, C, D) of the equation
+func groth16LikeEquation(t testing.TB) (*PairingPrecomputation, G1Affine, G2Affine, G1Affine, G1Affine) {
+	t.Helper()
+	_, _, g1, g2 := Generators()
+	var alpha, beta, gamma, delta, a, b, c, d fr.Element
+	alpha.SetRandom()
+	beta.SetRandom()
+	gamma.SetRandom()
+	delta.SetRandom()
+	a.SetRandom()
+	b.SetRandom()
+	c.SetRandom()
+	// a⋅b - c⋅δ - d⋅γ = α⋅β
+	var ab, cDelta, alphaBeta fr.Element
+	ab.Mul(&a, &b)
+	cDelta.Mul(&c, &delta)
+	alphaBeta.Mul(&alpha, &beta)
+	d.Sub(&ab, &cDelta).Sub(&d, &alphaBeta).Div(&d, &gamma)
+
+	g1Mul := func(s *fr.Element) (p G1Affine) {
+		p.ScalarMultiplication(&g1, s.BigInt(new(big.Int)))
+		return
+	}
+	g2Mul := func(s *fr.Element) (q G2Affine) {
+		q.ScalarMultiplication(&g2, s.BigInt(new(big.Int)))
+		return
+	}
+	var negDelta, negGamma fr.Element
+	negDelta.Neg(&delta)
+	negGamma.Neg(&gamma)
+
+	pp, err := NewPairingPrecomputation(
+		[]G2Affine{g2Mul(&negDelta), g2Mul(&negGamma)},
+		[]G1Affine{g1Mul(&alpha)}, []G2Affine{g2Mul(&beta)},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pp, g1Mul(&a), g2Mul(&b), g1Mul(&c), g1Mul(&d)
+}
+
+func TestPairingPrecomputation(t *testing.T) {
+	t.Parallel()
+
+	pp, A, B, C, D := groth16LikeEquation(t)
+
+	// the lines must not be consumed by a check
+	for i := 0; i < 2; i++ {
+		ok, err := pp.Check([]G1Affine{A}, []G2Affine{B}, []G1Affine{C, D})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok {
+			t.Fatal("valid equation rejected")
+		}
+	}
+
+	var wrong G1Affine
+	wrong.Double(&C)
+	ok, err := pp.Check([]G1Affine{A}, []G2Affine{B}, []G1Affine{wrong, D})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Fatal("invalid equation accepted")
+	}
+
+	// Pair matches the pairing of all the points
+	f, err := pp.Pair([]G1Affine{A}, []G2Affine{B}, []G1Affine{C, D})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := Pair([]G1Affine{A, C, D}, []G2Affine{B, pp.Fixed[0], pp.Fixed[1]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !f.Equal(&expected) {
+		t.Fatal("PairingPrecomputation.Pair doesn't match Pair")
+	}
+
+	// without variable G2 points
+	f, err = pp.Pair(nil, nil, []G1Affine{C, D})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err = Pair([]G1Affine{C, D}, pp.Fixed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !f.Equal(&expected) {
+		t.Fatal("PairingPrecomputation.Pair doesn't match Pair without variable points")
+	}
+
+	if _, err := pp.Check([]G1Affine{A}, []G2Affine{B}, []G1Affine{C}); err != errPrecomputationLength {
+		t.Fatal("expected a length mismatch error, got", err)
+	}
+
+	// without fixed points, the target is 1
+	empty, err := NewPairingPrecomputation(nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var negB G2Affine
+	negB.Neg(&B)
+	ok, err = empty.Check([]G1Affine{A, A}, []G2Affine{B, negB}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("e(A, B)⋅e(A, -B) = 1 rejected")
+	}
+}
+
+func TestPairingPrecomputationSerialization(t *testing.T) {
+	t.Parallel()
+
+	pp, A, B, C, D := groth16LikeEquation(t)
+
+	var buf bytes.Buffer
+	n, err := pp.WriteTo(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var _pp PairingPrecomputation
+	m, err := _pp.ReadFrom(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != m || n != int64(buf.Len()) {
+		t.Fatal("bytes read don't match bytes written")
+	}
+	if !_pp.Target.Equal(&pp.Target) || len(_pp.Fixed) != len(pp.Fixed) {
+		t.Fatal("decoded precomputation doesn't match")
+	}
+	ok, err := _pp.Check([]G1Affine{A}, []G2Affine{B}, []G1Affine{C, D})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("valid equation rejected by the decoded precomputation")
+	}
+
+	// a target outside of GT is rejected
+	var notInGT GT
+	notInGT.SetRandom()
+	bad := *pp
+	bad.Target = notInGT
+	buf.Reset()
+	if _, err := bad.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := _pp.ReadFrom(bytes.NewReader(buf.Bytes())); err != errInvalidPrecomputation {
+		t.Fatal("expected errInvalidPrecomputation, got", err)
+	}
+
+	// another object can't be read
+	buf.Reset()
+	h := ecc.NewHeader(ecc.BLS24_317, ecc.ObjectAggregatedOpeningProof, 0)
+	if _, err := h.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := _pp.ReadFrom(bytes.NewReader(buf.Bytes())); err != ecc.ErrHeaderObject {
+		t.Fatal("expected ecc.ErrHeaderObject, got", err)
+	}
+}
+
+func BenchmarkPairingPrecomputation(b *testing.B) {
+	pp, A, B, C, D := groth16LikeEquation(b)
+	P, Q, fixedP := []G1Affine{A}, []G2Affine{B}, []G1Affine{C, D}
+
+	b.Run("Check", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = pp.Check(P, Q, fixedP)
+		}
+	})
+
+	b.Run("PairingCheck", func(b *testing.B) {
+		_, _, alpha, beta := Generators()
+		var negAlpha G1Affine
+		negAlpha.Neg(&alpha)
+		for i := 0; i < b.N; i++ {
+			_, _ = PairingCheck([]G1Affine{A, C, D, negAlpha}, []G2Affine{B, pp.Fixed[0], pp.Fixed[1], beta})
+		}
+	})
+}
@@ -50,6 +50,7 @@ type PrivateKey struct {
 	PublicKey PublicKey    // copy of the associated public key
 	scalar    [sizeFr]byte // secret scalar, in big Endian
 	randSrc   [32]byte     // source
+	blinding  bool         // see SetScalarBlinding
 }
 
 // Signature represents an eddsa signature
@@ -61,16 +62,64 @@ type Signature struct {
 
 // GenerateKey generates a public and private key pair.
 func GenerateKey(r io.Reader) (*PrivateKey, error) {
-	c := twistededwards.GetEdwardsCurve()
-
-	var pub PublicKey
-	var priv PrivateKey
-	// hash(h) = private_key || random_source, on 32 bytes each
 	seed := make([]byte, 32)
 	_, err := r.Read(seed)
 	if err != nil {
 		return nil, err
 	}
+	var priv PrivateKey
+	priv.setSeed(seed)
+
+	var bScalar big.Int
+	bScalar.SetBytes(priv.scalar[:])
+	priv.PublicKey.A.ScalarMultiplicationBase(&bScalar)
+
+	return &priv, nil
+}
+
+// BatchGenerateKeys generates n public and private key pairs.
+//
+// The keys are derived as in GenerateKey, but the public keys are computed
+// together with BatchGeneratePublicKeys.
+func BatchGenerateKeys(r io.Reader, n int) ([]*PrivateKey, error) {
+	seeds := make([]byte, 32*n)
+	if _, err := io.ReadFull(r, seeds); err != nil {
+		return nil, err
+	}
+	privs := make([]*PrivateKey, n)
+	scalars := make([]big.Int, n)
+	for i := range privs {
+		privs[i] = new(PrivateKey)
+		privs[i].setSeed(seeds[32*i : 32*(i+1)])
+		scalars[i].SetBytes(privs[i].scalar[:])
+	}
+	pubs := BatchGeneratePublicKeys(scalars)
+	for i := range privs {
+		privs[i].PublicKey = pubs[i]
+	}
+	return privs, nil
+}
+
+// BatchGeneratePublicKeys returns the public keys [secrets[i]]Base associated to the
+// secret scalars secrets, where Base is the generator of the twisted Edwards curve.
+//
+// The scalar multiplications use the precomputed table of Base and the results are
+// converted to affine coordinates with a single field inversion, which makes it much
+// faster than deriving the keys one by one when there are many of them.
+// The running time depends on the secrets; it is not constant-time.
+func BatchGeneratePublicKeys(secrets []big.Int) []PublicKey {
+	points := twistededwards.BatchScalarMultiplicationBase(secrets)
+	res := make([]PublicKey, len(points))
+	for i := range points {
+		res[i].A = points[i]
+	}
+	return res
+}
+
+// setSeed derives the secret scalar and the source of randomness of priv
+// from a 32 bytes seed.
+func (priv *PrivateKey) setSeed(seed []byte) {
+	// hash(h) = private_key || random_source, on 32 bytes each
 	h := blake2b.Sum512(seed[:])
 	for i := 0; i < 32; i++ {
 		priv.randSrc[i] = h[i+32]
@@ -87,14 +136,6 @@ func GenerateKey(r io.Reader) (*PrivateKey, error) {
 	for i, j := 0, sizeFr-1; i < sizeFr; i, j = i+1, j-1 {
 		priv.scalar[i] = h[j]
 	}
-
-	var bScalar big.Int
-	bScalar.SetBytes(priv.scalar[:])
-	pub.A.ScalarMultiplication(&c.Base, &bScalar)
-
-	priv.PublicKey = pub
-
-	return &priv, nil
 }
 
 // Equal compares 2 public keys
@@ -115,6 +156,16 @@ func (privKey *PrivateKey) Public() signature.PublicKey {
 	return &pub
 }
 
+// SetScalarBlinding enables or disables the blinding of the secret nonce in the
+// scalar multiplication of Sign (see twistededwards.PointAffine.ScalarMultiplicationBlinded).
+//
+// Blinding is a hardening against side-channel attacks for keys used in shared or
+// adversarial environments; it makes signing slower. It is disabled by default, and
+// doesn't change the signatures.
+func (privKey *PrivateKey) SetScalarBlinding(enabled bool) {
+	privKey.blinding = enabled
+}
+
 // Sign sign a sequence of field elements
 // For arbitrary strings use fr.Hash first
 // Pure Eddsa version (see https://tools.ietf.org/html/rfc8032#page-8)
@@ -145,7 +196,13 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 	blindingFactorBigInt.SetBytes(blindingFactorBytes[:sizeFr])
 
 	// compute R = randScalar*Base
-	res.R.ScalarMultiplication(&curveParams.Base, &blindingFactorBigInt)
+	if privKey.blinding {
+		if _, err := res.R.ScalarMultiplicationBlinded(&curveParams.Base, &blindingFactorBigInt); err != nil {
+			return nil, err
+		}
+	} else {
+		res.R.ScalarMultiplicationBase(&blindingFactorBigInt)
+	}
 	if !res.R.IsOnCurve() {
 		return nil, errNotOnCurve
 	}
@@ -232,7 +289,7 @@ func (pub *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, err
 	var bCofactor, bs big.Int
 	curveParams.Cofactor.BigInt(&bCofactor)
 	bs.SetBytes(sig.S[:])
-	lhs.ScalarMultiplication(&curveParams.Base, &bs).
+	lhs.ScalarMultiplicationBase(&bs).
 		ScalarMultiplication(&lhs, &bCofactor)
 
 	if !lhs.IsOnCurve() {
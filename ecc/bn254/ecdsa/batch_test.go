@@ -0,0 +1,126 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecdsa
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchVerify(t *testing.T) {
+	t.Parallel()
+	assert := require.New(t)
+
+	const n = 20
+	publicKeys := make([]PublicKey, n)
+	messages := make([][]byte, n)
+	sigs := make([][]byte, n)
+	v := make([]uint, n)
+	hFunc := sha256.New()
+	for i := 0; i < n; i++ {
+		privKey, err := GenerateKey(rand.Reader)
+		assert.NoError(err)
+		publicKeys[i] = privKey.PublicKey
+		messages[i] = []byte(fmt.Sprintf("testing ECDSA batch verification %d", i))
+
+		vi, r, s, err := privKey.SignForRecover(messages[i], hFunc)
+		assert.NoError(err)
+		var sig Signature
+		r.FillBytes(sig.R[:])
+		s.FillBytes(sig.S[:])
+		sigs[i] = sig.Bytes()
+		v[i] = vi
+	}
+
+	ok, err := BatchVerify(publicKeys, messages, sigs, v, hFunc)
+	assert.NoError(err)
+	assert.True(ok, "valid batch should verify")
+
+	ok, err = BatchVerify(nil, nil, nil, nil, hFunc)
+	assert.NoError(err)
+	assert.True(ok, "empty batch should verify")
+
+	_, err = BatchVerify(publicKeys, messages[1:], sigs, v, hFunc)
+	assert.Error(err, "mismatched batch sizes")
+
+	// wrong message
+	messages[3] = []byte("tampered")
+	ok, err = BatchVerify(publicKeys, messages, sigs, v, hFunc)
+	assert.NoError(err)
+	assert.False(ok, "batch with an invalid signature should not verify")
+	messages[3] = []byte(fmt.Sprintf("testing ECDSA batch verification %d", 3))
+
+	// swapped public keys
+	publicKeys[0], publicKeys[1] = publicKeys[1], publicKeys[0]
+	ok, err = BatchVerify(publicKeys, messages, sigs, v, hFunc)
+	assert.NoError(err)
+	assert.False(ok, "batch with swapped public keys should not verify")
+	publicKeys[0], publicKeys[1] = publicKeys[1], publicKeys[0]
+
+	// wrong recovery information
+	v[5] ^= 1
+	ok, err = BatchVerify(publicKeys, messages, sigs, v, hFunc)
+	assert.NoError(err)
+	assert.False(ok, "batch with wrong recovery information should not verify")
+	v[5] ^= 1
+
+	// pre-hashed messages
+	for i := range sigs {
+		privKey, err := GenerateKey(rand.Reader)
+		assert.NoError(err)
+		publicKeys[i] = privKey.PublicKey
+		vi, r, s, err := privKey.SignForRecover(messages[i], nil)
+		assert.NoError(err)
+		var sig Signature
+		r.FillBytes(sig.R[:])
+		s.FillBytes(sig.S[:])
+		sigs[i] = sig.Bytes()
+		v[i] = vi
+	}
+	ok, err = BatchVerify(publicKeys, messages, sigs, v, nil)
+	assert.NoError(err)
+	assert.True(ok, "valid batch of pre-hashed messages should verify")
+}
+
+func BenchmarkBatchVerify(b *testing.B) {
+	const n = 256
+	publicKeys := make([]PublicKey, n)
+	messages := make([][]byte, n)
+	sigs := make([][]byte, n)
+	v := make([]uint, n)
+	hFunc := sha256.New()
+	for i := 0; i < n; i++ {
+		privKey, _ := GenerateKey(rand.Reader)
+		publicKeys[i] = privKey.PublicKey
+		messages[i] = []byte(fmt.Sprintf("benchmarking ECDSA batch verification %d", i))
+		vi, r, s, _ := privKey.SignForRecover(messages[i], hFunc)
+		var sig Signature
+		r.FillBytes(sig.R[:])
+		s.FillBytes(sig.S[:])
+		sigs[i] = sig.Bytes()
+		v[i] = vi
+	}
+
+	b.ResetTimer()
+	for j := 0; j < b.N; j++ {
+		BatchVerify(publicKeys, messages, sigs, v, hFunc)
+	}
+}
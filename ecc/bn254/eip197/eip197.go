@@ -0,0 +1,132 @@
+// Package eip197 implements the input validation and the pairing check of the BN254
+// pairing precompile of Ethereum (address 0x08), specified by EIP-197.
+//
+// The input is a concatenation of pairs (P, Q) of 192 bytes, P in G₁ and Q in G₂. All the
+// coordinates are 32 bytes big-endian integers, which must be canonical (smaller than the
+// modulus of the base field):
+//
+//	P = x || y
+//	Q = x_im || x_re || y_im || y_re, where x = x_re + x_im·u and y = y_re + y_im·u
+//
+// The point at infinity is encoded with zero coordinates. P must be on the curve and Q on
+// the twist and in the subgroup of order r. The precompile fails on any input rejected by
+// ParsePairingInput, and returns the 32 bytes big-endian encoding of 1 if
+// ∏ᵢ e(Pᵢ, Qᵢ) = 1 and of 0 otherwise; an empty input succeeds.
+package eip197
+
+import (
+	"errors"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+)
+
+const (
+	// SizeOfG1 is the size of the encoding of a point of G₁
+	SizeOfG1 = 2 * fp.Bytes
+
+	// SizeOfG2 is the size of the encoding of a point of G₂
+	SizeOfG2 = 4 * fp.Bytes
+
+	// SizeOfPair is the size of the encoding of a pair (P, Q)
+	SizeOfPair = SizeOfG1 + SizeOfG2
+
+	// SizeOfResult is the size of the output of the precompile
+	SizeOfResult = 32
+)
+
+var (
+	ErrInputLength     = errors.New("pairing input length is not a multiple of 192")
+	ErrNonCanonical    = errors.New("coordinate is not smaller than the field modulus")
+	ErrG1NotOnCurve    = errors.New("G1 point is not on the curve")
+	ErrG2NotOnCurve    = errors.New("G2 point is not on the twist")
+	ErrG2NotInSubgroup = errors.New("G2 point is not in the subgroup of order r")
+)
+
+// ParsePairingInput validates the input of the pairing precompile and returns the points
+// of its pairs. It returns an error exactly when the precompile fails.
+func ParsePairingInput(input []byte) ([]bn254.G1Affine, []bn254.G2Affine, error) {
+	if len(input)%SizeOfPair != 0 {
+		return nil, nil, ErrInputLength
+	}
+	n := len(input) / SizeOfPair
+	P := make([]bn254.G1Affine, n)
+	Q := make([]bn254.G2Affine, n)
+	for i := 0; i < n; i++ {
+		pair := input[i*SizeOfPair : (i+1)*SizeOfPair]
+		if err := decodeG1(&P[i], pair[:SizeOfG1]); err != nil {
+			return nil, nil, err
+		}
+		if err := decodeG2(&Q[i], pair[SizeOfG1:]); err != nil {
+			return nil, nil, err
+		}
+	}
+	return P, Q, nil
+}
+
+// PairingCheck runs the pairing precompile on input. On success, it returns the 32 bytes
+// output of the precompile.
+func PairingCheck(input []byte) ([]byte, error) {
+	P, Q, err := ParsePairingInput(input)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]byte, SizeOfResult)
+	if len(P) == 0 {
+		res[SizeOfResult-1] = 1
+		return res, nil
+	}
+	ok, err := bn254.PairingCheck(P, Q)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		res[SizeOfResult-1] = 1
+	}
+	return res, nil
+}
+
+// AppendPair appends the encoding of the pair (P, Q) to dst and returns the result.
+func AppendPair(dst []byte, P *bn254.G1Affine, Q *bn254.G2Affine) []byte {
+	for _, e := range []*fp.Element{&P.X, &P.Y, &Q.X.A1, &Q.X.A0, &Q.Y.A1, &Q.Y.A0} {
+		b := e.Bytes()
+		dst = append(dst, b[:]...)
+	}
+	return dst
+}
+
+func decodeG1(p *bn254.G1Affine, buf []byte) error {
+	if err := decodeCoordinates(buf, &p.X, &p.Y); err != nil {
+		return err
+	}
+	if !p.IsInfinity() && !p.IsOnCurve() {
+		return ErrG1NotOnCurve
+	}
+	return nil
+}
+
+func decodeG2(q *bn254.G2Affine, buf []byte) error {
+	if err := decodeCoordinates(buf, &q.X.A1, &q.X.A0, &q.Y.A1, &q.Y.A0); err != nil {
+		return err
+	}
+	if q.IsInfinity() {
+		return nil
+	}
+	if !q.IsOnCurve() {
+		return ErrG2NotOnCurve
+	}
+	if !q.IsInSubGroup() {
+		return ErrG2NotInSubgroup
+	}
+	return nil
+}
+
+// decodeCoordinates sets the elements from the consecutive big-endian encodings in buf
+func decodeCoordinates(buf []byte, elements ...*fp.Element) error {
+	for i, e := range elements {
+		if err := e.SetBytesCanonical(buf[i*fp.Bytes : (i+1)*fp.Bytes]); err != nil {
+			return ErrNonCanonical
+		}
+	}
+	return nil
+}
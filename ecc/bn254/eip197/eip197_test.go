@@ -0,0 +1,120 @@
+package eip197
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark-crypto/ecc/bn254/internal/fptower"
+)
+
+var (
+	one  = append(make([]byte, SizeOfResult-1), 1)
+	zero = make([]byte, SizeOfResult)
+)
+
+func TestPairingCheck(t *testing.T) {
+	_, _, g1, g2 := bn254.Generators()
+
+	// e(aG₁, bG₂) · e(-abG₁, G₂) = 1
+	a, b := big.NewInt(12345), big.NewInt(67890)
+	var P1, P2 bn254.G1Affine
+	var Q1 bn254.G2Affine
+	P1.ScalarMultiplication(&g1, a)
+	Q1.ScalarMultiplication(&g2, b)
+	P2.ScalarMultiplication(&g1, new(big.Int).Mul(a, b))
+	P2.Neg(&P2)
+
+	var infinity1 bn254.G1Affine
+	var infinity2 bn254.G2Affine
+
+	for _, tc := range []struct {
+		name     string
+		input    []byte
+		expected []byte
+	}{
+		{"empty input", nil, one},
+		{"valid pairing equation", AppendPair(AppendPair(nil, &P1, &Q1), &P2, &g2), one},
+		{"invalid pairing equation", AppendPair(AppendPair(nil, &P1, &Q1), &P1, &g2), zero},
+		{"infinity in G1", AppendPair(nil, &infinity1, &g2), one},
+		{"infinity in G2", AppendPair(nil, &g1, &infinity2), one},
+		{"single pair", AppendPair(nil, &g1, &g2), zero},
+	} {
+		res, err := PairingCheck(tc.input)
+		if err != nil {
+			t.Fatal(tc.name, err)
+		}
+		if !bytes.Equal(res, tc.expected) {
+			t.Fatalf("%s: got %x", tc.name, res)
+		}
+	}
+
+	// the pairs are parsed back
+	P, Q, err := ParsePairingInput(AppendPair(AppendPair(nil, &P1, &Q1), &P2, &g2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(P) != 2 || !P[0].Equal(&P1) || !P[1].Equal(&P2) || !Q[0].Equal(&Q1) || !Q[1].Equal(&g2) {
+		t.Fatal("pairs are not parsed back")
+	}
+}
+
+func TestParsePairingInputErrors(t *testing.T) {
+	_, _, g1, g2 := bn254.Generators()
+	valid := AppendPair(nil, &g1, &g2)
+
+	modulus := fp.Modulus().FillBytes(make([]byte, fp.Bytes))
+	withCoordinate := func(i int, coordinate []byte) []byte {
+		res := append([]byte{}, valid...)
+		copy(res[i*fp.Bytes:], coordinate)
+		return res
+	}
+	increment := func(i int) []byte {
+		res := append([]byte{}, valid...)
+		res[(i+1)*fp.Bytes-1]++
+		return res
+	}
+
+	// a point of the twist out of the subgroup of order r
+	var notInSubgroup bn254.G2Affine
+	var b, x, y fptower.E2
+	b.Square(&g2.Y)
+	x.Square(&g2.X).Mul(&x, &g2.X)
+	b.Sub(&b, &x)
+	for {
+		if _, err := x.SetRandom(); err != nil {
+			t.Fatal(err)
+		}
+		y.Square(&x).Mul(&y, &x).Add(&y, &b)
+		if y.Legendre() == 1 {
+			notInSubgroup.X = x
+			notInSubgroup.Y.Sqrt(&y)
+			break
+		}
+	}
+
+	for _, tc := range []struct {
+		name     string
+		input    []byte
+		expected error
+	}{
+		{"truncated input", valid[:SizeOfPair-1], ErrInputLength},
+		{"extra byte", append(append([]byte{}, valid...), 0), ErrInputLength},
+		{"G1 x = p", withCoordinate(0, modulus), ErrNonCanonical},
+		{"G2 y_re = p", withCoordinate(5, modulus), ErrNonCanonical},
+		{"G1 not on curve", increment(1), ErrG1NotOnCurve},
+		{"G1 (0, y)", withCoordinate(0, make([]byte, fp.Bytes)), ErrG1NotOnCurve},
+		{"G2 not on twist", increment(3), ErrG2NotOnCurve},
+		{"G2 not in subgroup", AppendPair(nil, &g1, &notInSubgroup), ErrG2NotInSubgroup},
+		{"invalid second pair", append(append([]byte{}, valid...), increment(1)...), ErrG1NotOnCurve},
+	} {
+		if _, _, err := ParsePairingInput(tc.input); err != tc.expected {
+			t.Fatalf("%s: expected %v, got %v", tc.name, tc.expected, err)
+		}
+		if _, err := PairingCheck(tc.input); err != tc.expected {
+			t.Fatalf("%s: PairingCheck must fail with %v, got %v", tc.name, tc.expected, err)
+		}
+	}
+}
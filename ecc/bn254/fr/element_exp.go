@@ -817,3 +817,449 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	return z
 }
+
+// expByInverseExp is equivalent to z.Exp(x, 30644e72e131a029b85045b68181585d2833e84879b9709143e1f593efffffff)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByInverseExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10    = 2*1
+	//	_11    = 1 + _10
+	//	_101   = _10 + _11
+	//	_111   = _10 + _101
+	//	_1001  = _10 + _111
+	//	_1011  = _10 + _1001
+	//	_1101  = _10 + _1011
+	//	_1111  = _10 + _1101
+	//	_11000 = _1001 + _1111
+	//	_11111 = _111 + _11000
+	//	i26    = ((_11000 << 4 + _11) << 3 + 1) << 7
+	//	i36    = ((_1001 + i26) << 2 + _11) << 5 + _111
+	//	i53    = (2*(i36 << 6 + _1011) + 1) << 8
+	//	i64    = (2*(_1001 + i53) + 1) << 7 + _1101
+	//	i84    = ((i64 << 10 + _101) << 6 + _1101) << 2
+	//	i100   = ((_11 + i84) << 7 + _101) << 6 + 1
+	//	i117   = ((i100 << 7 + _1011) << 5 + _1101) << 3
+	//	i137   = ((_101 + i117) << 8 + _11) << 9 + _101
+	//	i153   = ((i137 << 3 + _11) << 8 + _1011) << 3
+	//	i168   = ((_101 + i153) << 5 + _101) << 7 + _11
+	//	i187   = ((i168 << 7 + _11111) << 2 + 1) << 8
+	//	i204   = ((_1001 + i187) << 8 + _1111) << 6 + _1101
+	//	i215   = 2*((i204 << 2 + _11) << 6 + _1011)
+	//	i232   = ((1 + i215) << 8 + _1001) << 6 + _101
+	//	i257   = ((i232 << 9 + _11111) << 9 + _11111) << 5
+	//	i270   = ((_1011 + i257) << 3 + 1) << 7 + _11111
+	//	i288   = ((i270 << 6 + _11111) << 5 + _11111) << 5
+	//	i301   = ((_11111 + i288) << 5 + _11111) << 5 + _11111
+	//	return   i301 << 3 + _111
+	//
+	// Operations: 250 squares 55 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0 = new(Element)
+		t1 = new(Element)
+		t2 = new(Element)
+		t3 = new(Element)
+		t4 = new(Element)
+		t5 = new(Element)
+		t6 = new(Element)
+		t7 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7 Element
+	// Step 1: t0 = x^0x2
+	t0.Square(&x)
+
+	// Step 2: t4 = x^0x3
+	t4.Mul(&x, t0)
+
+	// Step 3: t2 = x^0x5
+	t2.Mul(t0, t4)
+
+	// Step 4: z = x^0x7
+	z.Mul(t0, t2)
+
+	// Step 5: t3 = x^0x9
+	t3.Mul(t0, z)
+
+	// Step 6: t1 = x^0xb
+	t1.Mul(t0, t3)
+
+	// Step 7: t5 = x^0xd
+	t5.Mul(t0, t1)
+
+	// Step 8: t6 = x^0xf
+	t6.Mul(t0, t5)
+
+	// Step 9: t7 = x^0x18
+	t7.Mul(t3, t6)
+
+	// Step 10: t0 = x^0x1f
+	t0.Mul(z, t7)
+
+	// Step 14: t7 = x^0x180
+	for s := 0; s < 4; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 15: t7 = x^0x183
+	t7.Mul(t4, t7)
+
+	// Step 18: t7 = x^0xc18
+	for s := 0; s < 3; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 19: t7 = x^0xc19
+	t7.Mul(&x, t7)
+
+	// Step 26: t7 = x^0x60c80
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 27: t7 = x^0x60c89
+	t7.Mul(t3, t7)
+
+	// Step 29: t7 = x^0x183224
+	for s := 0; s < 2; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 30: t7 = x^0x183227
+	t7.Mul(t4, t7)
+
+	// Step 35: t7 = x^0x30644e0
+	for s := 0; s < 5; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 36: t7 = x^0x30644e7
+	t7.Mul(z, t7)
+
+	// Step 42: t7 = x^0xc19139c0
+	for s := 0; s < 6; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 43: t7 = x^0xc19139cb
+	t7.Mul(t1, t7)
+
+	// Step 44: t7 = x^0x183227396
+	t7.Square(t7)
+
+	// Step 45: t7 = x^0x183227397
+	t7.Mul(&x, t7)
+
+	// Step 53: t7 = x^0x18322739700
+	for s := 0; s < 8; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 54: t7 = x^0x18322739709
+	t7.Mul(t3, t7)
+
+	// Step 55: t7 = x^0x30644e72e12
+	t7.Square(t7)
+
+	// Step 56: t7 = x^0x30644e72e13
+	t7.Mul(&x, t7)
+
+	// Step 63: t7 = x^0x1832273970980
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 64: t7 = x^0x183227397098d
+	t7.Mul(t5, t7)
+
+	// Step 74: t7 = x^0x60c89ce5c263400
+	for s := 0; s < 10; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 75: t7 = x^0x60c89ce5c263405
+	t7.Mul(t2, t7)
+
+	// Step 81: t7 = x^0x183227397098d0140
+	for s := 0; s < 6; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 82: t7 = x^0x183227397098d014d
+	t7.Mul(t5, t7)
+
+	// Step 84: t7 = x^0x60c89ce5c26340534
+	for s := 0; s < 2; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 85: t7 = x^0x60c89ce5c26340537
+	t7.Mul(t4, t7)
+
+	// Step 92: t7 = x^0x30644e72e131a029b80
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 93: t7 = x^0x30644e72e131a029b85
+	t7.Mul(t2, t7)
+
+	// Step 99: t7 = x^0xc19139cb84c680a6e140
+	for s := 0; s < 6; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 100: t7 = x^0xc19139cb84c680a6e141
+	t7.Mul(&x, t7)
+
+	// Step 107: t7 = x^0x60c89ce5c263405370a080
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 108: t7 = x^0x60c89ce5c263405370a08b
+	t7.Mul(t1, t7)
+
+	// Step 113: t7 = x^0xc19139cb84c680a6e141160
+	for s := 0; s < 5; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 114: t7 = x^0xc19139cb84c680a6e14116d
+	t7.Mul(t5, t7)
+
+	// Step 117: t7 = x^0x60c89ce5c263405370a08b68
+	for s := 0; s < 3; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 118: t7 = x^0x60c89ce5c263405370a08b6d
+	t7.Mul(t2, t7)
+
+	// Step 126: t7 = x^0x60c89ce5c263405370a08b6d00
+	for s := 0; s < 8; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 127: t7 = x^0x60c89ce5c263405370a08b6d03
+	t7.Mul(t4, t7)
+
+	// Step 136: t7 = x^0xc19139cb84c680a6e14116da0600
+	for s := 0; s < 9; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 137: t7 = x^0xc19139cb84c680a6e14116da0605
+	t7.Mul(t2, t7)
+
+	// Step 140: t7 = x^0x60c89ce5c263405370a08b6d03028
+	for s := 0; s < 3; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 141: t7 = x^0x60c89ce5c263405370a08b6d0302b
+	t7.Mul(t4, t7)
+
+	// Step 149: t7 = x^0x60c89ce5c263405370a08b6d0302b00
+	for s := 0; s < 8; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 150: t7 = x^0x60c89ce5c263405370a08b6d0302b0b
+	t7.Mul(t1, t7)
+
+	// Step 153: t7 = x^0x30644e72e131a029b85045b681815858
+	for s := 0; s < 3; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 154: t7 = x^0x30644e72e131a029b85045b68181585d
+	t7.Mul(t2, t7)
+
+	// Step 159: t7 = x^0x60c89ce5c263405370a08b6d0302b0ba0
+	for s := 0; s < 5; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 160: t7 = x^0x60c89ce5c263405370a08b6d0302b0ba5
+	t7.Mul(t2, t7)
+
+	// Step 167: t7 = x^0x30644e72e131a029b85045b68181585d280
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 168: t7 = x^0x30644e72e131a029b85045b68181585d283
+	t7.Mul(t4, t7)
+
+	// Step 175: t7 = x^0x183227397098d014dc2822db40c0ac2e94180
+	for s := 0; s < 7; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 176: t7 = x^0x183227397098d014dc2822db40c0ac2e9419f
+	t7.Mul(t0, t7)
+
+	// Step 178: t7 = x^0x60c89ce5c263405370a08b6d0302b0ba5067c
+	for s := 0; s < 2; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 179: t7 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d
+	t7.Mul(&x, t7)
+
+	// Step 187: t7 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d00
+	for s := 0; s < 8; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 188: t7 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d09
+	t7.Mul(t3, t7)
+
+	// Step 196: t7 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d0900
+	for s := 0; s < 8; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 197: t6 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f
+	t6.Mul(t6, t7)
+
+	// Step 203: t6 = x^0x183227397098d014dc2822db40c0ac2e9419f4243c0
+	for s := 0; s < 6; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 204: t5 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cd
+	t5.Mul(t5, t6)
+
+	// Step 206: t5 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f34
+	for s := 0; s < 2; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 207: t4 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f37
+	t4.Mul(t4, t5)
+
+	// Step 213: t4 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdc0
+	for s := 0; s < 6; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 214: t4 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb
+	t4.Mul(t1, t4)
+
+	// Step 215: t4 = x^0x30644e72e131a029b85045b68181585d2833e84879b96
+	t4.Square(t4)
+
+	// Step 216: t4 = x^0x30644e72e131a029b85045b68181585d2833e84879b97
+	t4.Mul(&x, t4)
+
+	// Step 224: t4 = x^0x30644e72e131a029b85045b68181585d2833e84879b9700
+	for s := 0; s < 8; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 225: t3 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709
+	t3.Mul(t3, t4)
+
+	// Step 231: t3 = x^0xc19139cb84c680a6e14116da06056174a0cfa121e6e5c240
+	for s := 0; s < 6; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 232: t2 = x^0xc19139cb84c680a6e14116da06056174a0cfa121e6e5c245
+	t2.Mul(t2, t3)
+
+	// Step 241: t2 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a00
+	for s := 0; s < 9; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 242: t2 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f
+	t2.Mul(t0, t2)
+
+	// Step 251: t2 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e00
+	for s := 0; s < 9; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 252: t2 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f
+	t2.Mul(t0, t2)
+
+	// Step 257: t2 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f372e12287c3e0
+	for s := 0; s < 5; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 258: t1 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f372e12287c3eb
+	t1.Mul(t1, t2)
+
+	// Step 261: t1 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f58
+	for s := 0; s < 3; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 262: t1 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f59
+	t1.Mul(&x, t1)
+
+	// Step 269: t1 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f0fac80
+	for s := 0; s < 7; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 270: t1 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f0fac9f
+	t1.Mul(t0, t1)
+
+	// Step 276: t1 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f372e12287c3eb27c0
+	for s := 0; s < 6; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 277: t1 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f372e12287c3eb27df
+	t1.Mul(t0, t1)
+
+	// Step 282: t1 = x^0xc19139cb84c680a6e14116da06056174a0cfa121e6e5c2450f87d64fbe0
+	for s := 0; s < 5; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 283: t1 = x^0xc19139cb84c680a6e14116da06056174a0cfa121e6e5c2450f87d64fbff
+	t1.Mul(t0, t1)
+
+	// Step 288: t1 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f0fac9f7fe0
+	for s := 0; s < 5; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 289: t1 = x^0x183227397098d014dc2822db40c0ac2e9419f4243cdcb848a1f0fac9f7fff
+	t1.Mul(t0, t1)
+
+	// Step 294: t1 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f593efffe0
+	for s := 0; s < 5; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 295: t1 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f593efffff
+	t1.Mul(t0, t1)
+
+	// Step 300: t1 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f372e12287c3eb27dffffe0
+	for s := 0; s < 5; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 301: t0 = x^0x60c89ce5c263405370a08b6d0302b0ba5067d090f372e12287c3eb27dffffff
+	t0.Mul(t0, t1)
+
+	// Step 304: t0 = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f593effffff8
+	for s := 0; s < 3; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 305: z = x^0x30644e72e131a029b85045b68181585d2833e84879b9709143e1f593efffffff
+	z.Mul(z, t0)
+
+	return z
+}
@@ -71,3 +71,38 @@ func TestByteOrder(t *testing.T) {
 	}
 
 }
+
+func TestPRF(t *testing.T) {
+	assert := require.New(t)
+
+	var key, otherKey fr.Element
+	key.SetUint64(42)
+	otherKey.SetUint64(43)
+	inputs := make([]fr.Element, 3)
+	for i := range inputs {
+		inputs[i].SetUint64(uint64(i))
+	}
+
+	prf := mimc.NewPRF(key, "test")
+	out := prf.Evaluate(inputs, 4)
+	assert.Equal(4, len(out))
+	assert.Equal(out, mimc.NewPRF(key, "test").Evaluate(inputs, 4), "the PRF must be deterministic")
+	for i := range out {
+		for j := 0; j < i; j++ {
+			assert.False(out[i].Equal(&out[j]), "outputs must be distinct")
+		}
+	}
+
+	// the outputs depend on the key, the domain and the lengths
+	differs := func(other []fr.Element) bool {
+		return !other[0].Equal(&out[0])
+	}
+	assert.True(differs(mimc.NewPRF(otherKey, "test").Evaluate(inputs, 4)))
+	assert.True(differs(mimc.NewPRF(key, "test2").Evaluate(inputs, 4)))
+	assert.True(differs(prf.Evaluate(inputs[:2], 4)))
+	assert.True(differs(prf.Evaluate(inputs, 3)))
+	assert.True(differs(prf.Evaluate(append(inputs, fr.Element{}), 4)))
+
+	assert.Equal(0, len(prf.Evaluate(inputs, 0)))
+	assert.Equal(2, len(prf.Evaluate(nil, 2)))
+}
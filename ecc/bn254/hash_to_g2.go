@@ -19,6 +19,7 @@ package bn254
 import (
 	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
 	"github.com/consensys/gnark-crypto/ecc/bn254/internal/fptower"
+	"github.com/consensys/gnark-crypto/internal/parallel"
 )
 
 // MapToCurve2 implements the Shallue and van de Woestijne method, applicable to any elliptic curve in Weierstrass form
@@ -106,18 +107,25 @@ func MapToCurve2(u *fptower.E2) G2Affine {
 	gx.Add(&gx, &bTwistCurveCoeff) //    32.  gx = gx + B
 
 	y.Sqrt(&gx)                             //    33.   y = sqrt(gx)
-	signsNotEqual := g2Sgn0(u) ^ g2Sgn0(&y) //    34.  e3 = sgn0(u) == sgn0(y)
+	signsNotEqual := G2Sgn0(u) ^ G2Sgn0(&y) //    34.  e3 = sgn0(u) == sgn0(y)
 
 	tv1.Neg(&y)
 	y.Select(int(signsNotEqual), &y, &tv1) //    35.   y = CMOV(-y, y, e3)       # Select correct sign of y
 	return G2Affine{x, y}
 }
 
-// g2Sgn0 is an algebraic substitute for the notion of sign in ordered fields
+// g2MapToCurveFraction sets p to MapToCurve2(u) and den to 1.
+// Unlike SSWU, the SVDW map needs its inversion early, so it can't be deferred to a batch inversion.
+func g2MapToCurveFraction(p *G2Affine, den *fptower.E2, u *fptower.E2) {
+	*p = MapToCurve2(u)
+	den.SetOne()
+}
+
+// G2Sgn0 is an algebraic substitute for the notion of sign in ordered fields
 // Namely, every non-zero quadratic residue in a finite field of characteristic =/= 2 has exactly two square roots, one of each sign
 // https://www.ietf.org/archive/id/draft-irtf-cfrg-hash-to-curve-16.html#name-the-sgn0-function
 // The sign of an element is not obviously related to that of its Montgomery form
-func g2Sgn0(z *fptower.E2) uint64 {
+func G2Sgn0(z *fptower.E2) uint64 {
 
 	nonMont := z.Bits()
 
@@ -198,6 +206,60 @@ func HashToG2(msg, dst []byte) (G2Affine, error) {
 	return Q1, nil
 }
 
+// BatchHashToG2 hashes each message to a point on the G2 curve, as HashToG2.
+//
+// The field inversions of the maps and of the conversions to affine
+// coordinates are shared across messages with batch inversions, and the cofactor clearing is done in
+// Jacobian coordinates.
+func BatchHashToG2(msgs [][]byte, dst []byte) ([]G2Affine, error) {
+	n := len(msgs)
+	u := make([]fptower.E2, 2*n)
+	for i := range msgs {
+		e, err := fp.Hash(msgs[i], dst, 2*2)
+		if err != nil {
+			return nil, err
+		}
+
+		u[2*i] = fptower.E2{
+			A0: e[0],
+			A1: e[1],
+		}
+		u[2*i+1] = fptower.E2{
+			A0: e[2+0],
+			A1: e[2+1],
+		}
+
+	}
+
+	// Q[2i], Q[2i+1] are the images of the two field elements of message i
+	Q := make([]G2Affine, 2*n)
+	den := make([]fptower.E2, 2*n)
+	parallel.Execute(2*n, func(start, end int) {
+		for i := start; i < end; i++ {
+			g2MapToCurveFraction(&Q[i], &den[i], &u[i])
+		}
+	})
+	den = fptower.BatchInvertE2(den)
+	parallel.Execute(2*n, func(start, end int) {
+		for i := start; i < end; i++ {
+			Q[i].X.Mul(&Q[i].X, &den[i])
+		}
+	})
+
+	res := make([]G2Jac, n)
+	parallel.Execute(n, func(start, end int) {
+		var q1 G2Jac
+		for i := start; i < end; i++ {
+			res[i].FromAffine(&Q[2*i])
+			q1.FromAffine(&Q[2*i+1])
+			res[i].AddAssign(&q1)
+			res[i].ClearCofactor(&res[i])
+		}
+	})
+
+	return BatchJacobianToAffineG2(res), nil
+}
+
 func g2NotZero(x *fptower.E2) uint64 {
 	//Assuming G1 is over Fp and that if hashing is available for G2, it also is for G1
 	return g1NotZero(&x.A0) | g1NotZero(&x.A1)
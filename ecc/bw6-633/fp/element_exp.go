@@ -1960,3 +1960,974 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	return z
 }
+
+// expByInverseExp is equivalent to z.Exp(x, 126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b5ce7ab839c2253d369bd31147f73cd74916ea4570000b)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByInverseExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_11       = 1 + _10
+	//	_101      = _10 + _11
+	//	_111      = _10 + _101
+	//	_1001     = _10 + _111
+	//	_1011     = _10 + _1001
+	//	_1101     = _10 + _1011
+	//	_1111     = _10 + _1101
+	//	_10001    = _10 + _1111
+	//	_10011    = _10 + _10001
+	//	_10101    = _10 + _10011
+	//	_10111    = _10 + _10101
+	//	_11001    = _10 + _10111
+	//	_11011    = _10 + _11001
+	//	_11101    = _10 + _11011
+	//	_11111    = _10 + _11101
+	//	_111110   = 2*_11111
+	//	_111111   = 1 + _111110
+	//	_1111110  = 2*_111111
+	//	_1111111  = 1 + _1111110
+	//	_10010000 = _10001 + _1111111
+	//	i36       = ((_10010000 << 3 + _11001) << 5 + _10001) << 5
+	//	i45       = 2*((_10011 + i36) << 5 + _11001) + 1
+	//	i69       = ((i45 << 10 + _1111) << 6 + _1101) << 6
+	//	i85       = ((_11111 + i69) << 3 + _11) << 10 + _1111111
+	//	i106      = ((2*i85 + 1) << 9 + _1101) << 9
+	//	i117      = ((_10111 + i106) << 6 + _10011) << 2 + _11
+	//	i142      = ((i117 << 12 + _11101) << 4 + _111) << 7
+	//	i155      = ((_10101 + i142) << 5 + _1011) << 5 + _1011
+	//	i177      = ((i155 << 4 + _101) << 9 + _111111) << 7
+	//	i193      = ((_11011 + i177) << 5 + _11) << 8 + _11101
+	//	i213      = ((i193 << 6 + _11101) << 5 + _1111) << 7
+	//	i230      = ((_11101 + i213) << 6 + _1011) << 8 + _11101
+	//	i250      = ((i230 << 5 + _111) << 6 + _1011) << 7
+	//	i264      = ((_10111 + i250) << 5 + _1101) << 6 + _1101
+	//	i284      = ((i264 << 6 + _11011) << 7 + _11011) << 5
+	//	i297      = ((_10111 + i284) << 4 + _1011) << 6 + _10001
+	//	i323      = ((i297 << 6 + _11111) << 11 + _10101) << 7
+	//	i344      = ((_10101 + i323) << 7 + _11011) << 11 + _11111
+	//	i360      = ((i344 << 5 + _1011) << 5 + _1111) << 4
+	//	i372      = ((_101 + i360) << 6 + _1001) << 3 + 1
+	//	i395      = ((i372 << 9 + _10111) << 8 + _11011) << 4
+	//	i411      = ((_101 + i395) << 7 + _11111) << 6 + _10111
+	//	i433      = ((i411 << 3 + _101) << 11 + _111111) << 6
+	//	i447      = ((_10101 + i433) << 4 + _1111) << 7 + _1001
+	//	i469      = ((i447 << 3 + 1) << 7 + _111111) << 10
+	//	i483      = ((_11101 + i469) << 4 + 1) << 7 + _101
+	//	i503      = ((i483 << 9 + _11101) << 4 + _1011) << 5
+	//	i514      = ((_1111 + i503) << 5 + _1101) << 3 + 1
+	//	i538      = ((i514 << 12 + _11111) << 6 + _10111) << 4
+	//	i552      = ((_1011 + i538) << 6 + _10111) << 5 + _111
+	//	i568      = ((i552 << 6 + _1111) << 6 + _10101) << 2
+	//	i584      = ((_11 + i568) << 8 + _111) << 5 + _111
+	//	i606      = ((i584 << 9 + _10001) << 5 + _101) << 6
+	//	i620      = ((_1111 + i606) << 6 + _10011) << 5 + _1101
+	//	i637      = ((i620 << 7 + _11011) << 4 + _1101) << 4
+	//	i650      = ((_11 + i637) << 8 + _10001) << 2 + 1
+	//	i672      = ((i650 << 10 + _1111111) << 4 + _111) << 6
+	//	i687      = ((_1111 + i672) << 6 + _1101) << 6 + _11101
+	//	i708      = ((i687 << 6 + _1001) << 7 + _1011) << 6
+	//	i724      = ((_11101 + i708) << 5 + _1001) << 8 + _10101
+	//	return      (i724 << 2 + _11) << 20 + _1011
+	//
+	// Operations: 628 squares 120 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+		t15 = new(Element)
+		t16 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14,t15,t16 Element
+	// Step 1: t7 = x^0x2
+	t7.Square(&x)
+
+	// Step 2: t0 = x^0x3
+	t0.Mul(&x, t7)
+
+	// Step 3: t11 = x^0x5
+	t11.Mul(t7, t0)
+
+	// Step 4: t6 = x^0x7
+	t6.Mul(t7, t11)
+
+	// Step 5: t2 = x^0x9
+	t2.Mul(t7, t6)
+
+	// Step 6: z = x^0xb
+	z.Mul(t7, t2)
+
+	// Step 7: t4 = x^0xd
+	t4.Mul(t7, z)
+
+	// Step 8: t5 = x^0xf
+	t5.Mul(t7, t4)
+
+	// Step 9: t8 = x^0x11
+	t8.Mul(t7, t5)
+
+	// Step 10: t10 = x^0x13
+	t10.Mul(t7, t8)
+
+	// Step 11: t1 = x^0x15
+	t1.Mul(t7, t10)
+
+	// Step 12: t12 = x^0x17
+	t12.Mul(t7, t1)
+
+	// Step 13: t15 = x^0x19
+	t15.Mul(t7, t12)
+
+	// Step 14: t9 = x^0x1b
+	t9.Mul(t7, t15)
+
+	// Step 15: t3 = x^0x1d
+	t3.Mul(t7, t9)
+
+	// Step 16: t13 = x^0x1f
+	t13.Mul(t7, t3)
+
+	// Step 17: t7 = x^0x3e
+	t7.Square(t13)
+
+	// Step 18: t14 = x^0x3f
+	t14.Mul(&x, t7)
+
+	// Step 19: t7 = x^0x7e
+	t7.Square(t14)
+
+	// Step 20: t7 = x^0x7f
+	t7.Mul(&x, t7)
+
+	// Step 21: t16 = x^0x90
+	t16.Mul(t8, t7)
+
+	// Step 24: t16 = x^0x480
+	for s := 0; s < 3; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 25: t16 = x^0x499
+	t16.Mul(t15, t16)
+
+	// Step 30: t16 = x^0x9320
+	for s := 0; s < 5; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 31: t16 = x^0x9331
+	t16.Mul(t8, t16)
+
+	// Step 36: t16 = x^0x126620
+	for s := 0; s < 5; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 37: t16 = x^0x126633
+	t16.Mul(t10, t16)
+
+	// Step 42: t16 = x^0x24cc660
+	for s := 0; s < 5; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 43: t15 = x^0x24cc679
+	t15.Mul(t15, t16)
+
+	// Step 44: t15 = x^0x4998cf2
+	t15.Square(t15)
+
+	// Step 45: t15 = x^0x4998cf3
+	t15.Mul(&x, t15)
+
+	// Step 55: t15 = x^0x126633cc00
+	for s := 0; s < 10; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 56: t15 = x^0x126633cc0f
+	t15.Mul(t5, t15)
+
+	// Step 62: t15 = x^0x4998cf303c0
+	for s := 0; s < 6; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 63: t15 = x^0x4998cf303cd
+	t15.Mul(t4, t15)
+
+	// Step 69: t15 = x^0x126633cc0f340
+	for s := 0; s < 6; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 70: t15 = x^0x126633cc0f35f
+	t15.Mul(t13, t15)
+
+	// Step 73: t15 = x^0x93319e6079af8
+	for s := 0; s < 3; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 74: t15 = x^0x93319e6079afb
+	t15.Mul(t0, t15)
+
+	// Step 84: t15 = x^0x24cc67981e6bec00
+	for s := 0; s < 10; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 85: t15 = x^0x24cc67981e6bec7f
+	t15.Mul(t7, t15)
+
+	// Step 86: t15 = x^0x4998cf303cd7d8fe
+	t15.Square(t15)
+
+	// Step 87: t15 = x^0x4998cf303cd7d8ff
+	t15.Mul(&x, t15)
+
+	// Step 96: t15 = x^0x93319e6079afb1fe00
+	for s := 0; s < 9; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 97: t15 = x^0x93319e6079afb1fe0d
+	t15.Mul(t4, t15)
+
+	// Step 106: t15 = x^0x126633cc0f35f63fc1a00
+	for s := 0; s < 9; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 107: t15 = x^0x126633cc0f35f63fc1a17
+	t15.Mul(t12, t15)
+
+	// Step 113: t15 = x^0x4998cf303cd7d8ff0685c0
+	for s := 0; s < 6; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 114: t15 = x^0x4998cf303cd7d8ff0685d3
+	t15.Mul(t10, t15)
+
+	// Step 116: t15 = x^0x126633cc0f35f63fc1a174c
+	for s := 0; s < 2; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 117: t15 = x^0x126633cc0f35f63fc1a174f
+	t15.Mul(t0, t15)
+
+	// Step 129: t15 = x^0x126633cc0f35f63fc1a174f000
+	for s := 0; s < 12; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 130: t15 = x^0x126633cc0f35f63fc1a174f01d
+	t15.Mul(t3, t15)
+
+	// Step 134: t15 = x^0x126633cc0f35f63fc1a174f01d0
+	for s := 0; s < 4; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 135: t15 = x^0x126633cc0f35f63fc1a174f01d7
+	t15.Mul(t6, t15)
+
+	// Step 142: t15 = x^0x93319e6079afb1fe0d0ba780eb80
+	for s := 0; s < 7; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 143: t15 = x^0x93319e6079afb1fe0d0ba780eb95
+	t15.Mul(t1, t15)
+
+	// Step 148: t15 = x^0x126633cc0f35f63fc1a174f01d72a0
+	for s := 0; s < 5; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 149: t15 = x^0x126633cc0f35f63fc1a174f01d72ab
+	t15.Mul(z, t15)
+
+	// Step 154: t15 = x^0x24cc67981e6bec7f8342e9e03ae5560
+	for s := 0; s < 5; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 155: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b
+	t15.Mul(z, t15)
+
+	// Step 159: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b0
+	for s := 0; s < 4; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 160: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b5
+	t15.Mul(t11, t15)
+
+	// Step 169: t15 = x^0x4998cf303cd7d8ff0685d3c075caad6a00
+	for s := 0; s < 9; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 170: t15 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f
+	t15.Mul(t14, t15)
+
+	// Step 177: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f80
+	for s := 0; s < 7; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 178: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b
+	t15.Mul(t9, t15)
+
+	// Step 183: t15 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f360
+	for s := 0; s < 5; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 184: t15 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f363
+	t15.Mul(t0, t15)
+
+	// Step 192: t15 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f36300
+	for s := 0; s < 8; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 193: t15 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d
+	t15.Mul(t3, t15)
+
+	// Step 199: t15 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c740
+	for s := 0; s < 6; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 200: t15 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d
+	t15.Mul(t3, t15)
+
+	// Step 205: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18eba0
+	for s := 0; s < 5; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 206: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf
+	t15.Mul(t5, t15)
+
+	// Step 213: t15 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d780
+	for s := 0; s < 7; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 214: t15 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d
+	t15.Mul(t3, t15)
+
+	// Step 220: t15 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e740
+	for s := 0; s < 6; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 221: t15 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b
+	t15.Mul(z, t15)
+
+	// Step 229: t15 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b00
+	for s := 0; s < 8; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 230: t15 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d
+	t15.Mul(t3, t15)
+
+	// Step 235: t15 = x^0x93319e6079afb1fe0d0ba780eb955ad47e6c63aebce963a0
+	for s := 0; s < 5; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 236: t15 = x^0x93319e6079afb1fe0d0ba780eb955ad47e6c63aebce963a7
+	t15.Mul(t6, t15)
+
+	// Step 242: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9c0
+	for s := 0; s < 6; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 243: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb
+	t15.Mul(z, t15)
+
+	// Step 250: t15 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e580
+	for s := 0; s < 7; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 251: t15 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e597
+	t15.Mul(t12, t15)
+
+	// Step 256: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2e0
+	for s := 0; s < 5; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 257: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed
+	t15.Mul(t4, t15)
+
+	// Step 263: t15 = x^0x93319e6079afb1fe0d0ba780eb955ad47e6c63aebce963a72cbb40
+	for s := 0; s < 6; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 264: t15 = x^0x93319e6079afb1fe0d0ba780eb955ad47e6c63aebce963a72cbb4d
+	t15.Mul(t4, t15)
+
+	// Step 270: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed340
+	for s := 0; s < 6; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 271: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b
+	t15.Mul(t9, t15)
+
+	// Step 278: t15 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad80
+	for s := 0; s < 7; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 279: t15 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9b
+	t15.Mul(t9, t15)
+
+	// Step 284: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b360
+	for s := 0; s < 5; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 285: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377
+	t15.Mul(t12, t15)
+
+	// Step 289: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b3770
+	for s := 0; s < 4; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 290: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b
+	t15.Mul(z, t15)
+
+	// Step 296: t15 = x^0x93319e6079afb1fe0d0ba780eb955ad47e6c63aebce963a72cbb4d6cddec0
+	for s := 0; s < 6; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 297: t15 = x^0x93319e6079afb1fe0d0ba780eb955ad47e6c63aebce963a72cbb4d6cdded1
+	t15.Mul(t8, t15)
+
+	// Step 303: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b440
+	for s := 0; s < 6; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 304: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f
+	t15.Mul(t13, t15)
+
+	// Step 315: t15 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f800
+	for s := 0; s < 11; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 316: t15 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f815
+	t15.Mul(t1, t15)
+
+	// Step 323: t15 = x^0x93319e6079afb1fe0d0ba780eb955ad47e6c63aebce963a72cbb4d6cdded17c0a80
+	for s := 0; s < 7; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 324: t15 = x^0x93319e6079afb1fe0d0ba780eb955ad47e6c63aebce963a72cbb4d6cdded17c0a95
+	t15.Mul(t1, t15)
+
+	// Step 331: t15 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a80
+	for s := 0; s < 7; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 332: t15 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b
+	t15.Mul(t9, t15)
+
+	// Step 343: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d800
+	for s := 0; s < 11; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 344: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f
+	t15.Mul(t13, t15)
+
+	// Step 349: t15 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03e0
+	for s := 0; s < 5; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 350: t15 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb
+	t15.Mul(z, t15)
+
+	// Step 355: t15 = x^0x93319e6079afb1fe0d0ba780eb955ad47e6c63aebce963a72cbb4d6cdded17c0a953607d60
+	for s := 0; s < 5; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 356: t15 = x^0x93319e6079afb1fe0d0ba780eb955ad47e6c63aebce963a72cbb4d6cdded17c0a953607d6f
+	t15.Mul(t5, t15)
+
+	// Step 360: t15 = x^0x93319e6079afb1fe0d0ba780eb955ad47e6c63aebce963a72cbb4d6cdded17c0a953607d6f0
+	for s := 0; s < 4; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 361: t15 = x^0x93319e6079afb1fe0d0ba780eb955ad47e6c63aebce963a72cbb4d6cdded17c0a953607d6f5
+	t15.Mul(t11, t15)
+
+	// Step 367: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd40
+	for s := 0; s < 6; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 368: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd49
+	t15.Mul(t2, t15)
+
+	// Step 371: t15 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea48
+	for s := 0; s < 3; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 372: t15 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea49
+	t15.Mul(&x, t15)
+
+	// Step 381: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd49200
+	for s := 0; s < 9; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 382: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd49217
+	t15.Mul(t12, t15)
+
+	// Step 390: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd4921700
+	for s := 0; s < 8; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 391: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd492171b
+	t15.Mul(t9, t15)
+
+	// Step 395: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd492171b0
+	for s := 0; s < 4; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 396: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd492171b5
+	t15.Mul(t11, t15)
+
+	// Step 403: t15 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da80
+	for s := 0; s < 7; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 404: t15 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f
+	t15.Mul(t13, t15)
+
+	// Step 410: t15 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7c0
+	for s := 0; s < 6; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 411: t15 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7
+	t15.Mul(t12, t15)
+
+	// Step 414: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd492171b53eb8
+	for s := 0; s < 3; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 415: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd492171b53ebd
+	t15.Mul(t11, t15)
+
+	// Step 426: t15 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e800
+	for s := 0; s < 11; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 427: t15 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f
+	t15.Mul(t14, t15)
+
+	// Step 433: t15 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fc0
+	for s := 0; s < 6; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 434: t15 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fd5
+	t15.Mul(t1, t15)
+
+	// Step 438: t15 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fd50
+	for s := 0; s < 4; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 439: t15 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fd5f
+	t15.Mul(t5, t15)
+
+	// Step 446: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd492171b53ebd07eaf80
+	for s := 0; s < 7; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 447: t15 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd492171b53ebd07eaf89
+	t15.Mul(t2, t15)
+
+	// Step 450: t15 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c48
+	for s := 0; s < 3; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 451: t15 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c49
+	t15.Mul(&x, t15)
+
+	// Step 458: t15 = x^0x93319e6079afb1fe0d0ba780eb955ad47e6c63aebce963a72cbb4d6cdded17c0a953607d6f52485c6d4faf41fabe2480
+	for s := 0; s < 7; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 459: t14 = x^0x93319e6079afb1fe0d0ba780eb955ad47e6c63aebce963a72cbb4d6cdded17c0a953607d6f52485c6d4faf41fabe24bf
+	t14.Mul(t14, t15)
+
+	// Step 469: t14 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd492171b53ebd07eaf892fc00
+	for s := 0; s < 10; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 470: t14 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd492171b53ebd07eaf892fc1d
+	t14.Mul(t3, t14)
+
+	// Step 474: t14 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd492171b53ebd07eaf892fc1d0
+	for s := 0; s < 4; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 475: t14 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd492171b53ebd07eaf892fc1d1
+	t14.Mul(&x, t14)
+
+	// Step 482: t14 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e880
+	for s := 0; s < 7; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 483: t14 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e885
+	t14.Mul(t11, t14)
+
+	// Step 492: t14 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd492171b53ebd07eaf892fc1d10a00
+	for s := 0; s < 9; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 493: t14 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd492171b53ebd07eaf892fc1d10a1d
+	t14.Mul(t3, t14)
+
+	// Step 497: t14 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd492171b53ebd07eaf892fc1d10a1d0
+	for s := 0; s < 4; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 498: t14 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd492171b53ebd07eaf892fc1d10a1db
+	t14.Mul(z, t14)
+
+	// Step 503: t14 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fd5f125f83a2143b60
+	for s := 0; s < 5; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 504: t14 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fd5f125f83a2143b6f
+	t14.Mul(t5, t14)
+
+	// Step 509: t14 = x^0x93319e6079afb1fe0d0ba780eb955ad47e6c63aebce963a72cbb4d6cdded17c0a953607d6f52485c6d4faf41fabe24bf07442876de0
+	for s := 0; s < 5; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 510: t14 = x^0x93319e6079afb1fe0d0ba780eb955ad47e6c63aebce963a72cbb4d6cdded17c0a953607d6f52485c6d4faf41fabe24bf07442876ded
+	t14.Mul(t4, t14)
+
+	// Step 513: t14 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fd5f125f83a2143b6f68
+	for s := 0; s < 3; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 514: t14 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fd5f125f83a2143b6f69
+	t14.Mul(&x, t14)
+
+	// Step 526: t14 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fd5f125f83a2143b6f69000
+	for s := 0; s < 12; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 527: t13 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fd5f125f83a2143b6f6901f
+	t13.Mul(t13, t14)
+
+	// Step 533: t13 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407c0
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 534: t13 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7
+	t13.Mul(t12, t13)
+
+	// Step 538: t13 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d70
+	for s := 0; s < 4; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 539: t13 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b
+	t13.Mul(z, t13)
+
+	// Step 545: t13 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fd5f125f83a2143b6f6901f5ec0
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 546: t12 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fd5f125f83a2143b6f6901f5ed7
+	t12.Mul(t12, t13)
+
+	// Step 551: t12 = x^0x93319e6079afb1fe0d0ba780eb955ad47e6c63aebce963a72cbb4d6cdded17c0a953607d6f52485c6d4faf41fabe24bf07442876ded203ebdae0
+	for s := 0; s < 5; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 552: t12 = x^0x93319e6079afb1fe0d0ba780eb955ad47e6c63aebce963a72cbb4d6cdded17c0a953607d6f52485c6d4faf41fabe24bf07442876ded203ebdae7
+	t12.Mul(t6, t12)
+
+	// Step 558: t12 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd492171b53ebd07eaf892fc1d10a1db7b480faf6b9c0
+	for s := 0; s < 6; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 559: t12 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd492171b53ebd07eaf892fc1d10a1db7b480faf6b9cf
+	t12.Mul(t5, t12)
+
+	// Step 565: t12 = x^0x93319e6079afb1fe0d0ba780eb955ad47e6c63aebce963a72cbb4d6cdded17c0a953607d6f52485c6d4faf41fabe24bf07442876ded203ebdae73c0
+	for s := 0; s < 6; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 566: t12 = x^0x93319e6079afb1fe0d0ba780eb955ad47e6c63aebce963a72cbb4d6cdded17c0a953607d6f52485c6d4faf41fabe24bf07442876ded203ebdae73d5
+	t12.Mul(t1, t12)
+
+	// Step 568: t12 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd492171b53ebd07eaf892fc1d10a1db7b480faf6b9cf54
+	for s := 0; s < 2; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 569: t12 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd492171b53ebd07eaf892fc1d10a1db7b480faf6b9cf57
+	t12.Mul(t0, t12)
+
+	// Step 577: t12 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd492171b53ebd07eaf892fc1d10a1db7b480faf6b9cf5700
+	for s := 0; s < 8; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 578: t12 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd492171b53ebd07eaf892fc1d10a1db7b480faf6b9cf5707
+	t12.Mul(t6, t12)
+
+	// Step 583: t12 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fd5f125f83a2143b6f6901f5ed739eae0e0
+	for s := 0; s < 5; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 584: t12 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fd5f125f83a2143b6f6901f5ed739eae0e7
+	t12.Mul(t6, t12)
+
+	// Step 593: t12 = x^0x93319e6079afb1fe0d0ba780eb955ad47e6c63aebce963a72cbb4d6cdded17c0a953607d6f52485c6d4faf41fabe24bf07442876ded203ebdae73d5c1ce00
+	for s := 0; s < 9; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 594: t12 = x^0x93319e6079afb1fe0d0ba780eb955ad47e6c63aebce963a72cbb4d6cdded17c0a953607d6f52485c6d4faf41fabe24bf07442876ded203ebdae73d5c1ce11
+	t12.Mul(t8, t12)
+
+	// Step 599: t12 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b5ce7ab839c220
+	for s := 0; s < 5; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 600: t11 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b5ce7ab839c225
+	t11.Mul(t11, t12)
+
+	// Step 606: t11 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fd5f125f83a2143b6f6901f5ed739eae0e708940
+	for s := 0; s < 6; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 607: t11 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fd5f125f83a2143b6f6901f5ed739eae0e70894f
+	t11.Mul(t5, t11)
+
+	// Step 613: t11 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b5ce7ab839c2253c0
+	for s := 0; s < 6; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 614: t10 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b5ce7ab839c2253d3
+	t10.Mul(t10, t11)
+
+	// Step 619: t10 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd492171b53ebd07eaf892fc1d10a1db7b480faf6b9cf57073844a7a60
+	for s := 0; s < 5; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 620: t10 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd492171b53ebd07eaf892fc1d10a1db7b480faf6b9cf57073844a7a6d
+	t10.Mul(t4, t10)
+
+	// Step 627: t10 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b5ce7ab839c2253d3680
+	for s := 0; s < 7; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 628: t9 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b5ce7ab839c2253d369b
+	t9.Mul(t9, t10)
+
+	// Step 632: t9 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b5ce7ab839c2253d369b0
+	for s := 0; s < 4; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 633: t9 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b5ce7ab839c2253d369bd
+	t9.Mul(t4, t9)
+
+	// Step 637: t9 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b5ce7ab839c2253d369bd0
+	for s := 0; s < 4; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 638: t9 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b5ce7ab839c2253d369bd3
+	t9.Mul(t0, t9)
+
+	// Step 646: t9 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b5ce7ab839c2253d369bd300
+	for s := 0; s < 8; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 647: t8 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b5ce7ab839c2253d369bd311
+	t8.Mul(t8, t9)
+
+	// Step 649: t8 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fd5f125f83a2143b6f6901f5ed739eae0e70894f4da6f4c44
+	for s := 0; s < 2; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 650: t8 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fd5f125f83a2143b6f6901f5ed739eae0e70894f4da6f4c45
+	t8.Mul(&x, t8)
+
+	// Step 660: t8 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b5ce7ab839c2253d369bd311400
+	for s := 0; s < 10; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 661: t7 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b5ce7ab839c2253d369bd31147f
+	t7.Mul(t7, t8)
+
+	// Step 665: t7 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b5ce7ab839c2253d369bd31147f0
+	for s := 0; s < 4; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 666: t6 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b5ce7ab839c2253d369bd31147f7
+	t6.Mul(t6, t7)
+
+	// Step 672: t6 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fd5f125f83a2143b6f6901f5ed739eae0e70894f4da6f4c451fdc0
+	for s := 0; s < 6; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 673: t5 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fd5f125f83a2143b6f6901f5ed739eae0e70894f4da6f4c451fdcf
+	t5.Mul(t5, t6)
+
+	// Step 679: t5 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b5ce7ab839c2253d369bd31147f73c0
+	for s := 0; s < 6; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 680: t4 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b5ce7ab839c2253d369bd31147f73cd
+	t4.Mul(t4, t5)
+
+	// Step 686: t4 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fd5f125f83a2143b6f6901f5ed739eae0e70894f4da6f4c451fdcf340
+	for s := 0; s < 6; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 687: t4 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fd5f125f83a2143b6f6901f5ed739eae0e70894f4da6f4c451fdcf35d
+	t4.Mul(t3, t4)
+
+	// Step 693: t4 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b5ce7ab839c2253d369bd31147f73cd740
+	for s := 0; s < 6; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 694: t4 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b5ce7ab839c2253d369bd31147f73cd749
+	t4.Mul(t2, t4)
+
+	// Step 701: t4 = x^0x93319e6079afb1fe0d0ba780eb955ad47e6c63aebce963a72cbb4d6cdded17c0a953607d6f52485c6d4faf41fabe24bf07442876ded203ebdae73d5c1ce1129e9b4de988a3fb9e6ba480
+	for s := 0; s < 7; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 702: t4 = x^0x93319e6079afb1fe0d0ba780eb955ad47e6c63aebce963a72cbb4d6cdded17c0a953607d6f52485c6d4faf41fabe24bf07442876ded203ebdae73d5c1ce1129e9b4de988a3fb9e6ba48b
+	t4.Mul(z, t4)
+
+	// Step 708: t4 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd492171b53ebd07eaf892fc1d10a1db7b480faf6b9cf57073844a7a6d37a6228fee79ae922c0
+	for s := 0; s < 6; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 709: t3 = x^0x24cc67981e6bec7f8342e9e03ae556b51f9b18ebaf3a58e9cb2ed35b377b45f02a54d81f5bd492171b53ebd07eaf892fc1d10a1db7b480faf6b9cf57073844a7a6d37a6228fee79ae922dd
+	t3.Mul(t3, t4)
+
+	// Step 714: t3 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fd5f125f83a2143b6f6901f5ed739eae0e70894f4da6f4c451fdcf35d245ba0
+	for s := 0; s < 5; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 715: t2 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fd5f125f83a2143b6f6901f5ed739eae0e70894f4da6f4c451fdcf35d245ba9
+	t2.Mul(t2, t3)
+
+	// Step 723: t2 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fd5f125f83a2143b6f6901f5ed739eae0e70894f4da6f4c451fdcf35d245ba900
+	for s := 0; s < 8; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 724: t1 = x^0x4998cf303cd7d8ff0685d3c075caad6a3f3631d75e74b1d3965da6b66ef68be054a9b03eb7a9242e36a7d7a0fd5f125f83a2143b6f6901f5ed739eae0e70894f4da6f4c451fdcf35d245ba915
+	t1.Mul(t1, t2)
+
+	// Step 726: t1 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b5ce7ab839c2253d369bd31147f73cd74916ea454
+	for s := 0; s < 2; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 727: t0 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b5ce7ab839c2253d369bd31147f73cd74916ea457
+	t0.Mul(t0, t1)
+
+	// Step 747: t0 = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b5ce7ab839c2253d369bd31147f73cd74916ea45700000
+	for s := 0; s < 20; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 748: z = x^0x126633cc0f35f63fc1a174f01d72ab5a8fcd8c75d79d2c74e59769ad9bbda2f8152a6c0fadea490b8da9f5e83f57c497e0e8850edbda407d7b5ce7ab839c2253d369bd31147f73cd74916ea4570000b
+	z.Mul(z, t0)
+
+	return z
+}
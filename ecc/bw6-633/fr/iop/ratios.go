@@ -22,6 +22,7 @@ import (
 	"math/bits"
 	"runtime"
 	"sync"
+	"sync/atomic"
 
 	"github.com/consensys/gnark-crypto/internal/parallel"
 
@@ -40,6 +41,7 @@ var (
 	ErrSizeNotPowerOfTwo          = errors.New("the size of the polynomials must be a power of two")
 	ErrInconsistentSizeDomain     = errors.New("the size of the domain must be consistent with the size of the polynomials")
 	ErrIncorrectNumberOfVariables = errors.New("the number of variables is incorrect")
+	ErrZeroRowTerm                = errors.New("a term of the denominator is zero")
 )
 
 // Build an 'accumulating ratio' polynomial.
@@ -84,6 +86,66 @@ func BuildRatioShuffledVectors(numerator, denominator []*Polynomial, beta fr.Ele
 		denominator[i].ToLagrange(domain)
 	}
 
+	coeffs := buildRatioShuffled(numerator, denominator, beta)
+	res := NewPolynomial(&coeffs, expectedForm)
+
+	// at this stage the result is in Lagrange form, Regular layout
+	putInExpectedFormFromLagrangeRegular(res, domain, expectedForm)
+
+	return res, nil
+}
+
+// BuildRatioShuffledVectorsOnCoset is BuildRatioShuffledVectors, where the ratio is
+// accumulated over the evaluations of the polynomials on the coset g·<ω> instead of <ω>,
+// g being domain.FrMultiplicativeGen. The polynomials are put in LagrangeCoset form, so
+// that provers whose polynomials already live on the coset don't pay any FFT.
+//
+// The returned polynomial Z is defined by its evaluations on the coset:
+// Z(g·ωʲ) = Π_{k<j}Π_{i<m}(β-Pᵢ(g·ωᵏ))/(β-Qᵢ(g·ωᵏ))
+func BuildRatioShuffledVectorsOnCoset(numerator, denominator []*Polynomial, beta fr.Element, expectedForm Form, domain *fft.Domain) (*Polynomial, error) {
+
+	// check that len(numerator)=len(denominator)
+	if len(numerator) != len(denominator) {
+		return nil, ErrNumberPolynomials
+	}
+	nbPolynomials := len(numerator)
+
+	// check that the sizes are consistent
+	err := checkSize(numerator, denominator)
+	if err != nil {
+		return nil, err
+	}
+
+	// create the domain + some checks on the sizes of the polynomials
+	n := numerator[0].coefficients.Len()
+	domain, err = buildDomain(n, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	// put every polynomials in LagrangeCoset form (no-op for
+	// the polynomials already on the coset)
+	for i := 0; i < nbPolynomials; i++ {
+		numerator[i].ToLagrangeCoset(domain)
+		denominator[i].ToLagrangeCoset(domain)
+	}
+
+	coeffs := buildRatioShuffled(numerator, denominator, beta)
+	res := NewPolynomial(&coeffs, expectedForm)
+
+	// at this stage the result is in LagrangeCoset form, Regular layout
+	putInExpectedFormFromLagrangeCosetRegular(res, domain, expectedForm)
+
+	return res, nil
+}
+
+// buildRatioShuffled returns the accumulating ratio of the evaluations of numerator
+// and denominator, in Regular layout. The polynomials are expected to be in the same
+// Lagrange basis, in any layout.
+func buildRatioShuffled(numerator, denominator []*Polynomial, beta fr.Element) []fr.Element {
+	n := numerator[0].coefficients.Len()
+	nbPolynomials := len(numerator)
+
 	// build the ratio (careful with the indices of
 	// the polynomials which are bit reversed)
 	coeffs := make([]fr.Element, n)
@@ -129,12 +191,7 @@ func BuildRatioShuffledVectors(numerator, denominator []*Polynomial, beta fr.Ele
 		coeffs[i].Mul(&coeffs[i], &t[i])
 	}
 
-	res := NewPolynomial(&coeffs, expectedForm)
-
-	// at this stage the result is in Lagrange form, Regular layout
-	putInExpectedFormFromLagrangeRegular(res, domain, expectedForm)
-
-	return res, nil
+	return coeffs
 }
 
 // BuildRatioCopyConstraint builds the accumulating ratio polynomial to prove that
@@ -216,6 +273,21 @@ func BuildRatioCopyConstraint(
 		}
 	})
 
+	accumulateRatio(coeffs, t)
+
+	res := NewPolynomial(&coeffs, expectedForm)
+	// at this stage the result is in Lagrange form, Regular layout
+	putInExpectedFormFromLagrangeRegular(res, domain, expectedForm)
+
+	return res, nil
+
+}
+
+// accumulateRatio sets coeffs[i] to Π_{0<k≤i} coeffs[k]/t[k], in place, ignoring
+// coeffs[0] and t[0].
+func accumulateRatio(coeffs, t []fr.Element) {
+	n := len(coeffs)
+
 	chCoeffs := make(chan struct{}, 1)
 	go func() {
 		for i := 2; i < n; i++ {
@@ -247,13 +319,123 @@ func BuildRatioCopyConstraint(
 			coeffs[i].Mul(&coeffs[i], &tInv[i-start])
 		}
 	}, nbTasks)
+}
+
+// RowTerm computes a factor of the i-th row of a grand product or of a running sum, from the
+// values at ωⁱ of the columns, in the order in which the columns are given. It is called
+// concurrently on different rows, and must not retain values.
+type RowTerm func(i int, values []fr.Element) fr.Element
+
+// BuildRatioCustom builds an accumulating ratio polynomial, from factors of the rows computed
+// by the caller. It generalizes BuildRatioShuffledVectors and BuildRatioCopyConstraint to
+// arbitrary multiset arguments.
+// * columns list of polynomials of the same size, a power of 2, whose values are passed to
+// the row terms. The polynomials are put in Lagrange form.
+// * numerator, denominator terms of the rows; a nil denominator is the constant 1.
+// * expectedForm expected form of the resulting polynomial
+// * Return: the polynomial Z whose evaluation on the j-th root of unity is
+// Z(ωʲ) = Π_{i<j} numerator(i, columns(ωⁱ))/denominator(i, columns(ωⁱ))
+//
+// It returns ErrZeroRowTerm if a term of the denominator is zero.
+func BuildRatioCustom(columns []*Polynomial, numerator, denominator RowTerm, expectedForm Form, domain *fft.Domain) (*Polynomial, error) {
+	coeffs, t, domain, err := evaluateRowTerms(columns, numerator, denominator, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	coeffs[0].SetOne()
+	t[0].SetOne()
+	accumulateRatio(coeffs, t)
+
+	res := NewPolynomial(&coeffs, expectedForm)
+	// at this stage the result is in Lagrange form, Regular layout
+	putInExpectedFormFromLagrangeRegular(res, domain, expectedForm)
+
+	return res, nil
+}
+
+// BuildRunningSum builds a running sum polynomial, from terms of the rows computed by the
+// caller, as in logarithmic derivative (LogUp) lookup arguments.
+// The parameters are the ones of BuildRatioCustom.
+// * Return: the polynomial S whose evaluation on the j-th root of unity is
+// S(ωʲ) = Σ_{i<j} numerator(i, columns(ωⁱ))/denominator(i, columns(ωⁱ))
+//
+// It returns ErrZeroRowTerm if a term of the denominator is zero.
+func BuildRunningSum(columns []*Polynomial, numerator, denominator RowTerm, expectedForm Form, domain *fft.Domain) (*Polynomial, error) {
+	coeffs, t, domain, err := evaluateRowTerms(columns, numerator, denominator, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	t[0].SetOne()
+	t = fr.BatchInvert(t)
+	coeffs[0].SetZero()
+	for i := 1; i < len(coeffs); i++ {
+		coeffs[i].Mul(&coeffs[i], &t[i]).Add(&coeffs[i], &coeffs[i-1])
+	}
 
 	res := NewPolynomial(&coeffs, expectedForm)
 	// at this stage the result is in Lagrange form, Regular layout
 	putInExpectedFormFromLagrangeRegular(res, domain, expectedForm)
 
 	return res, nil
+}
 
+// evaluateRowTerms puts the columns in Lagrange form, and returns the terms of the rows
+// i < n-1 of numerator and denominator, at index i+1.
+func evaluateRowTerms(columns []*Polynomial, numerator, denominator RowTerm, domain *fft.Domain) ([]fr.Element, []fr.Element, *fft.Domain, error) {
+	if len(columns) == 0 {
+		return nil, nil, nil, ErrNumberPolynomials
+	}
+
+	// check that the sizes are consistent
+	err := checkSize(columns)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// create the domain + some checks on the sizes of the polynomials
+	n := columns[0].coefficients.Len()
+	domain, err = buildDomain(n, domain)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	for i := range columns {
+		columns[i].ToLagrange(domain)
+	}
+
+	num := make([]fr.Element, n)
+	den := make([]fr.Element, n)
+	var zeroDenominator uint32
+	parallel.Execute(n-1, func(start, end int) {
+		values := make([]fr.Element, len(columns))
+		nn := uint64(64 - bits.TrailingZeros(uint(n)))
+		for i := start; i < end; i++ {
+			iRev := int(bits.Reverse64(uint64(i)) >> nn)
+			for j, p := range columns {
+				if p.Layout == BitReverse {
+					values[j] = p.Coefficients()[iRev]
+				} else {
+					values[j] = p.Coefficients()[i]
+				}
+			}
+			num[i+1] = numerator(i, values)
+			if denominator == nil {
+				den[i+1].SetOne()
+				continue
+			}
+			den[i+1] = denominator(i, values)
+			if den[i+1].IsZero() {
+				atomic.StoreUint32(&zeroDenominator, 1)
+			}
+		}
+	})
+	if atomic.LoadUint32(&zeroDenominator) != 0 {
+		return nil, nil, nil, ErrZeroRowTerm
+	}
+
+	return num, den, domain, nil
 }
 
 func putInExpectedFormFromLagrangeRegular(p *Polynomial, domain *fft.Domain, expectedForm Form) {
@@ -283,6 +465,33 @@ func putInExpectedFormFromLagrangeRegular(p *Polynomial, domain *fft.Domain, exp
 
 }
 
+func putInExpectedFormFromLagrangeCosetRegular(p *Polynomial, domain *fft.Domain, expectedForm Form) {
+	p.Basis = expectedForm.Basis
+	p.Layout = expectedForm.Layout
+
+	if expectedForm.Basis == Canonical {
+		domain.FFTInverse(p.Coefficients(), fft.DIF, fft.OnCoset())
+		if expectedForm.Layout == Regular {
+			fft.BitReverse(p.Coefficients())
+		}
+		return
+	}
+
+	if expectedForm.Basis == Lagrange {
+		domain.FFTInverse(p.Coefficients(), fft.DIF, fft.OnCoset())
+		domain.FFT(p.Coefficients(), fft.DIT)
+		if expectedForm.Layout == BitReverse {
+			fft.BitReverse(p.Coefficients())
+		}
+		return
+	}
+
+	if expectedForm.Layout == BitReverse {
+		fft.BitReverse(p.Coefficients())
+	}
+
+}
+
 // check that the polynomials are of the same size.
 // It assumes that pols contains slices of the same size.
 func checkSize(pols ...[]*Polynomial) error {
@@ -291,7 +500,7 @@ func checkSize(pols ...[]*Polynomial) error {
 	m := len(pols)
 	n := pols[0][0].coefficients.Len()
 	for i := 0; i < m; i++ {
-		for j := 0; j < len(pols); j++ {
+		for j := 0; j < len(pols[i]); j++ {
 			if pols[i][j].coefficients.Len() != n {
 				return ErrInconsistentSize
 			}
@@ -336,14 +545,8 @@ func getSupportIdentityPermutation(nbCopies int, domain *fft.Domain) []fr.Elemen
 	res := make([]fr.Element, uint64(nbCopies)*domain.Cardinality)
 	sizePoly := int(domain.Cardinality)
 
-	// TODO @gbotrel check if we can reuse the pre-computed twiddles from the domain.
-	res[0].SetOne()
-	if len(res) > 1 {
-		res[1].Set(&domain.Generator)
-		for i := 2; i < len(res); i++ {
-			res[i].Mul(&res[i-1], &domain.Generator)
-		}
-	}
+	// the roots of unity are cached on the domain
+	copy(res, domain.RootsOfUnity())
 
 	if nbCopies <= 1 {
 		return res
@@ -353,8 +556,7 @@ func getSupportIdentityPermutation(nbCopies int, domain *fft.Domain) []fr.Elemen
 	for i := 1; i < nbCopies; i++ {
 		i := i
 
-		var coset fr.Element
-		coset.Exp(domain.FrMultiplicativeGen, big.NewInt(int64(i)))
+		coset := shiftPower(domain, i)
 
 		go func() {
 			parallel.Execute(sizePoly, func(start, end int) {
@@ -370,3 +572,38 @@ func getSupportIdentityPermutation(nbCopies int, domain *fft.Domain) []fr.Elemen
 
 	return res
 }
+
+// SupportIdentityPermutationCoset returns the k-th coset gᵏ*[1,ω,..,ωˢ⁻¹] of the support on
+// which the permutation acts (see BuildRatioCopyConstraint), where g is domain.FrMultiplicativeGen.
+//
+// It materializes only the requested coset, instead of the nbCopies first ones.
+func SupportIdentityPermutationCoset(k int, domain *fft.Domain) []fr.Element {
+	if k < 0 {
+		panic("SupportIdentityPermutationCoset: k must be non-negative")
+	}
+	roots := domain.RootsOfUnity()
+	res := make([]fr.Element, len(roots))
+	if k == 0 {
+		copy(res, roots)
+		return res
+	}
+
+	coset := shiftPower(domain, k)
+	parallel.Execute(len(roots), func(start, end int) {
+		for j := start; j < end; j++ {
+			res[j].Mul(&roots[j], &coset)
+		}
+	})
+	return res
+}
+
+// shiftPower returns gᵏ, where g is domain.FrMultiplicativeGen, reading it from the
+// coset table when the domain is precomputed.
+func shiftPower(domain *fft.Domain, k int) fr.Element {
+	if table, err := domain.CosetTable(); err == nil && k < len(table) {
+		return table[k]
+	}
+	var res fr.Element
+	res.Exp(domain.FrMultiplicativeGen, big.NewInt(int64(k)))
+	return res
+}
@@ -0,0 +1,31 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package ligero provides a transparent, hash-based commitment scheme for multilinear
+// polynomials, following Ligero [AHIV17] and the tensor-code commitments of Brakedown [GLSTW21].
+//
+// The evaluations of the polynomial on the hypercube are laid out as a matrix, whose rows are
+// encoded with a Reed-Solomon code. The commitment is the root of a Merkle tree whose leaves are
+// the hashes of the columns of the encoded matrix. An opening proof sends a random linear
+// combination of the rows (proximity test) and their combination by the tensor of the point
+// (evaluation test), which the verifier checks against randomly queried columns.
+//
+// The scheme relies only on hash functions: it needs no trusted setup, and no elliptic curve.
+// It is not hiding.
+//
+// [AHIV17]: https://eprint.iacr.org/2022/1608.pdf
+// [GLSTW21]: https://eprint.iacr.org/2021/1043.pdf
+package ligero
@@ -18,6 +18,7 @@ package bw6633
 
 import (
 	"github.com/consensys/gnark-crypto/ecc/bw6-633/fp"
+	"github.com/consensys/gnark-crypto/internal/parallel"
 
 	"math/big"
 )
@@ -70,7 +71,11 @@ func g2IsogenyYDenominator(dst *fp.Element, x *fp.Element) {
 		x)
 }
 
-func g2Isogeny(p *G2Affine) {
+// G2Isogeny maps, in place, a point of the curve E' isogenous to the G2 curve (the
+// target of MapToCurve2) to the G2 curve.
+// It can be used with MapToCurve2 and ClearCofactor to build custom
+// hash-to-curve constructions.
+func G2Isogeny(p *G2Affine) {
 
 	den := make([]fp.Element, 2)
 
@@ -86,11 +91,35 @@ func g2Isogeny(p *G2Affine) {
 	p.Y.Mul(&p.Y, &den[1])
 }
 
-// g2SqrtRatio computes the square root of u/v and returns 0 iff u/v was indeed a quadratic residue
+// g2BatchIsogeny applies G2Isogeny to all points, with a single batch inversion.
+func g2BatchIsogeny(points []G2Affine) {
+	den := make([]fp.Element, 2*len(points))
+	parallel.Execute(len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			p := &points[i]
+			g2IsogenyYDenominator(&den[2*i+1], &p.X)
+			g2IsogenyXDenominator(&den[2*i], &p.X)
+
+			g2IsogenyYNumerator(&p.Y, &p.X, &p.Y)
+			g2IsogenyXNumerator(&p.X, &p.X)
+		}
+	})
+
+	den = fp.BatchInvert(den)
+
+	parallel.Execute(len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			points[i].X.Mul(&points[i].X, &den[2*i])
+			points[i].Y.Mul(&points[i].Y, &den[2*i+1])
+		}
+	})
+}
+
+// G2SqrtRatio computes the square root of u/v and returns 0 iff u/v was indeed a quadratic residue
 // if not, we get sqrt(Z * u / v). Recall that Z is non-residue
 // If v = 0, u/v is meaningless and the output is unspecified, without raising an error.
 // The main idea is that since the computation of the square root involves taking large powers of u/v, the inversion of v can be avoided
-func g2SqrtRatio(z *fp.Element, u *fp.Element, v *fp.Element) uint64 {
+func G2SqrtRatio(z *fp.Element, u *fp.Element, v *fp.Element) uint64 {
 
 	// https://www.ietf.org/archive/id/draft-irtf-cfrg-hash-to-curve-16.html#name-optimized-sqrt_ratio-for-q-5 (mod 8)
 
@@ -146,6 +175,16 @@ func g2MulByZ(z *fp.Element, x *fp.Element) {
 // MapToCurve2 implements the SSWU map
 // No cofactor clearing or isogeny
 func MapToCurve2(u *fp.Element) G2Affine {
+	var res G2Affine
+	var den fp.Element
+	g2MapToCurveFraction(&res, &den, u)
+	res.X.Div(&res.X, &den) // 25.   x = x / tv4
+	return res
+}
+
+// g2MapToCurveFraction implements the SSWU map, without the final division:
+// the result is (p.X/den, p.Y), so that batch hashing can share the inversions.
+func g2MapToCurveFraction(p *G2Affine, den *fp.Element, u *fp.Element) {
 
 	var sswuIsoCurveCoeffA = fp.Element{13503940466125084703, 3000707982748310797, 1529397070312683242, 9240962296298654443, 4577258595340312235, 16046828875439788343, 7236093083337192433, 2860564553402019540, 5160479239841632821, 65394042426465165}
 	var sswuIsoCurveCoeffB = fp.Element{4170590011558214244, 9101648159034903675, 4256739633972552875, 7483080556638609334, 12430228215152656439, 9977400640742476476, 15847011074743951739, 17768582661138350292, 10869631430819016060, 64187107279947172}
@@ -194,7 +233,7 @@ func MapToCurve2(u *fp.Element) G2Affine {
 	x.Mul(&tv1, &tv3) // 17.   x = tv1 * tv3
 
 	var y1 fp.Element
-	gx1NSquare := g2SqrtRatio(&y1, &tv2, &tv6) // 18. (is_gx1_square, y1) = sqrt_ratio(tv2, tv6)
+	gx1NSquare := G2SqrtRatio(&y1, &tv2, &tv6) // 18. (is_gx1_square, y1) = sqrt_ratio(tv2, tv6)
 
 	var y fp.Element
 	y.Mul(&tv1, u) // 19.   y = tv1 * u
@@ -205,14 +244,14 @@ func MapToCurve2(u *fp.Element) G2Affine {
 	y.Select(int(gx1NSquare), &y1, &y)  // 22.   y = CMOV(y, y1, is_gx1_square)
 
 	y1.Neg(&y)
-	y.Select(int(g2Sgn0(u)^g2Sgn0(&y)), &y, &y1)
+	y.Select(int(G2Sgn0(u)^G2Sgn0(&y)), &y, &y1)
 
 	// 23.  e1 = sgn0(u) == sgn0(y)
 	// 24.   y = CMOV(-y, y, e1)
 
-	x.Div(&x, &tv4) // 25.   x = x / tv4
-
-	return G2Affine{x, y}
+	p.X = x
+	p.Y = y
+	*den = tv4
 }
 
 func g2EvalPolynomial(z *fp.Element, monic bool, coefficients []fp.Element, x *fp.Element) {
@@ -230,11 +269,11 @@ func g2EvalPolynomial(z *fp.Element, monic bool, coefficients []fp.Element, x *f
 	z.Set(&dst)
 }
 
-// g2Sgn0 is an algebraic substitute for the notion of sign in ordered fields
+// G2Sgn0 is an algebraic substitute for the notion of sign in ordered fields
 // Namely, every non-zero quadratic residue in a finite field of characteristic =/= 2 has exactly two square roots, one of each sign
 // https://www.ietf.org/archive/id/draft-irtf-cfrg-hash-to-curve-16.html#name-the-sgn0-function
 // The sign of an element is not obviously related to that of its Montgomery form
-func g2Sgn0(z *fp.Element) uint64 {
+func G2Sgn0(z *fp.Element) uint64 {
 
 	nonMont := z.Bits()
 
@@ -247,7 +286,7 @@ func g2Sgn0(z *fp.Element) uint64 {
 func MapToG2(u fp.Element) G2Affine {
 	res := MapToCurve2(&u)
 	//this is in an isogenous curve
-	g2Isogeny(&res)
+	G2Isogeny(&res)
 	res.ClearCofactor(&res)
 	return res
 }
@@ -267,7 +306,7 @@ func EncodeToG2(msg, dst []byte) (G2Affine, error) {
 	res = MapToCurve2(&u[0])
 
 	//this is in an isogenous curve
-	g2Isogeny(&res)
+	G2Isogeny(&res)
 	res.ClearCofactor(&res)
 	return res, nil
 }
@@ -286,8 +325,8 @@ func HashToG2(msg, dst []byte) (G2Affine, error) {
 	Q1 := MapToCurve2(&u[1])
 
 	//TODO (perf): Add in E' first, then apply isogeny
-	g2Isogeny(&Q0)
-	g2Isogeny(&Q1)
+	G2Isogeny(&Q0)
+	G2Isogeny(&Q1)
 
 	var _Q0, _Q1 G2Jac
 	_Q0.FromAffine(&Q0)
@@ -299,6 +338,55 @@ func HashToG2(msg, dst []byte) (G2Affine, error) {
 	return Q1, nil
 }
 
+// BatchHashToG2 hashes each message to a point on the G2 curve, as HashToG2.
+//
+// The field inversions of the maps, of the isogenies and of the conversions to affine
+// coordinates are shared across messages with batch inversions, and the cofactor clearing is done in
+// Jacobian coordinates.
+func BatchHashToG2(msgs [][]byte, dst []byte) ([]G2Affine, error) {
+	n := len(msgs)
+	u := make([]fp.Element, 2*n)
+	for i := range msgs {
+		e, err := fp.Hash(msgs[i], dst, 2*1)
+		if err != nil {
+			return nil, err
+		}
+
+		u[2*i], u[2*i+1] = e[0], e[1]
+
+	}
+
+	// Q[2i], Q[2i+1] are the images of the two field elements of message i
+	Q := make([]G2Affine, 2*n)
+	den := make([]fp.Element, 2*n)
+	parallel.Execute(2*n, func(start, end int) {
+		for i := start; i < end; i++ {
+			g2MapToCurveFraction(&Q[i], &den[i], &u[i])
+		}
+	})
+	den = fp.BatchInvert(den)
+	parallel.Execute(2*n, func(start, end int) {
+		for i := start; i < end; i++ {
+			Q[i].X.Mul(&Q[i].X, &den[i])
+		}
+	})
+	//these are in an isogenous curve
+	g2BatchIsogeny(Q)
+
+	res := make([]G2Jac, n)
+	parallel.Execute(n, func(start, end int) {
+		var q1 G2Jac
+		for i := start; i < end; i++ {
+			res[i].FromAffine(&Q[2*i])
+			q1.FromAffine(&Q[2*i+1])
+			res[i].AddAssign(&q1)
+			res[i].ClearCofactor(&res[i])
+		}
+	})
+
+	return BatchJacobianToAffineG2(res), nil
+}
+
 func g2NotZero(x *fp.Element) uint64 {
 
 	return x[0] | x[1] | x[2] | x[3] | x[4] | x[5] | x[6] | x[7] | x[8] | x[9]
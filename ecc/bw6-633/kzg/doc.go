@@ -15,4 +15,23 @@
 // Code generated by consensys/gnark-crypto DO NOT EDIT
 
 // Package kzg provides a KZG commitment scheme.
+//
+// Multilinear polynomials, given by their evaluations on the boolean hypercube, can be opened
+// with the Gemini (GeminiOpen) and Zeromorph (ZeromorphOpen) reductions to univariate openings.
+//
+// OpenHiding proves the value of a polynomial at a point without revealing it: the value is
+// only given as a Pedersen commitment (CommitValue).
+//
+// Linearize, PairingInputs and CheckPairingInputs implement the final steps of PLONK-like
+// verifiers: the linearized commitment, and the pairing check of the openings.
+//
+// AggregateOpen opens many polynomials, each at its own point, with the SHPLONK multi-point
+// opening. AggregateProofs aggregates existing opening proofs, which may come from different
+// provers, into a proof of logarithmic size, with an inner pairing product argument.
+//
+// Batch opening proofs with many zero claimed values can be serialized compactly
+// (WriteCompactTo), and lists of proofs at the same point with BatchOpeningProofs.
+//
+// The fflonk subpackage commits to several polynomials at once, and opens them all at a point
+// with a single proof.
 package kzg
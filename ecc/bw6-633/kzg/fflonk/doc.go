@@ -0,0 +1,28 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package fflonk commits to several polynomials with a single KZG commitment, and opens
+// them all at a point with a single proof, using the interleaving technique of fflonk
+// (https://eprint.iacr.org/2021/1167).
+//
+// The polynomials f₀, …, fₜ₋₁ are combined into g(X) = ∑ⱼ fⱼ(Xᵗ)Xʲ, whose commitment is a
+// regular kzg.Digest. Since Xᵗ maps the t roots of Xᵗ - z to z, opening g at those roots gives
+// the values fⱼ(z): the remainder of g modulo Xᵗ - z is ∑ⱼ fⱼ(z)Xʲ, so the proof is the
+// commitment to the quotient (g(X) - ∑ⱼ fⱼ(z)Xʲ)/(Xᵗ - z).
+//
+// The verifier needs [αᵗ]G₂, which is not in a regular verifying key: the SRS must have
+// its optional G₂ powers (see kzg.WithG2Powers).
+package fflonk
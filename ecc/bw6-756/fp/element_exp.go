@@ -1991,3 +1991,1081 @@ func (z *Element) expByLegendreExp(x Element) *Element {
 
 	return z
 }
+
+// expByInverseExp is equivalent to z.Exp(x, f76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb3d06e646e292cd15edb646a54302aa3c258de7ded0b685e868524ec033c7e63f8683ffffffffffffffffffff)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByInverseExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10      = 2*1
+	//	_11      = 1 + _10
+	//	_100     = 1 + _11
+	//	_101     = 1 + _100
+	//	_1001    = _100 + _101
+	//	_1011    = _10 + _1001
+	//	_1101    = _10 + _1011
+	//	_1111    = _10 + _1101
+	//	_10001   = _10 + _1111
+	//	_10101   = _100 + _10001
+	//	_10111   = _10 + _10101
+	//	_11001   = _10 + _10111
+	//	_11011   = _10 + _11001
+	//	_11101   = _10 + _11011
+	//	_11111   = _10 + _11101
+	//	_100001  = _10 + _11111
+	//	_100011  = _10 + _100001
+	//	_100101  = _10 + _100011
+	//	_100111  = _10 + _100101
+	//	_101001  = _10 + _100111
+	//	_101011  = _10 + _101001
+	//	_101101  = _10 + _101011
+	//	_101111  = _10 + _101101
+	//	_110001  = _10 + _101111
+	//	_110011  = _10 + _110001
+	//	_110101  = _10 + _110011
+	//	_110111  = _10 + _110101
+	//	_111001  = _10 + _110111
+	//	_111011  = _10 + _111001
+	//	_111101  = _10 + _111011
+	//	_111111  = _10 + _111101
+	//	_1111010 = _111011 + _111111
+	//	_1111111 = _101 + _1111010
+	//	i53      = ((_1111010 << 4 + _11011) << 7 + _101011) << 7
+	//	i68      = ((_110111 + i53) << 7 + _110101) << 5 + _10111
+	//	i88      = ((i68 << 7 + _111001) << 5 + _10001) << 6
+	//	i102     = ((_10111 + i88) << 8 + _10101) << 3 + _11
+	//	i129     = ((i102 << 9 + _1001) << 8 + _111111) << 8
+	//	i146     = ((_1111 + i129) << 9 + _110101) << 5 + _1101
+	//	i168     = ((i146 << 9 + _110011) << 6 + _110101) << 5
+	//	i188     = ((_11001 + i168) << 8 + _101111) << 9 + _110011
+	//	i206     = ((i188 << 7 + _100101) << 6 + _111101) << 3
+	//	i224     = ((_11 + i206) << 8 + _1011) << 7 + _11101
+	//	i245     = ((i224 << 9 + _100111) << 6 + _111011) << 4
+	//	i263     = ((_1111 + i245) << 8 + _100011) << 7 + _10001
+	//	i286     = ((i263 << 7 + _101) << 8 + _10101) << 6
+	//	i300     = ((_10001 + i286) << 7 + _110001) << 4 + _1101
+	//	i326     = ((i300 << 7 + _11011) << 8 + _110011) << 9
+	//	i342     = ((_110101 + i326) << 7 + _111001) << 6 + _110011
+	//	i367     = ((i342 << 6 + _110001) << 9 + _10101) << 8
+	//	i384     = ((_100011 + i367) << 6 + _11011) << 8 + _111101
+	//	i402     = ((i384 << 3 + _11) << 10 + _1011) << 3
+	//	i423     = ((1 + i402) << 12 + _100101) << 6 + _110101
+	//	i449     = ((i423 << 12 + _100111) << 6 + _110101) << 6
+	//	i468     = ((_10101 + i449) << 11 + _101001) << 5 + _11111
+	//	i491     = ((i468 << 5 + _1011) << 9 + _111001) << 7
+	//	i509     = ((_110011 + i491) << 4 + _1101) << 11 + _110111
+	//	i536     = ((i509 << 7 + _11001) << 9 + _110111) << 9
+	//	i551     = ((_101001 + i536) << 6 + _1011) << 6 + _1101
+	//	i573     = ((i551 << 9 + _101011) << 5 + _11011) << 6
+	//	i591     = ((_11011 + i573) << 6 + _11001) << 9 + _110101
+	//	i617     = ((i591 << 7 + _10101) << 6 + _11) << 11
+	//	i631     = ((_10101 + i617) << 4 + _101) << 7 + _1111
+	//	i654     = ((i631 << 10 + _100101) << 6 + _100011) << 5
+	//	i671     = ((_1111 + i654) << 7 + _11111) << 7 + _111101
+	//	i689     = ((i671 << 3 + _101) << 10 + _101101) << 3
+	//	i709     = ((_101 + i689) << 10 + _101111) << 7 + _100001
+	//	i732     = ((i709 << 3 + _101) << 10 + _101001) << 8
+	//	i752     = ((_100111 + i732) << 3 + _11) << 14 + _110011
+	//	i769     = ((i752 << 6 + _110001) << 5 + _11111) << 4
+	//	i790     = ((_11 + i769) << 10 + _1111111) << 8 + _1101
+	//	i818     = ((i790 << 12 + _1111111) << 7 + _1111111) << 7
+	//	i835     = ((_1111111 + i818) << 7 + _1111111) << 7 + _1111111
+	//	i858     = ((i835 << 7 + _1111111) << 7 + _1111111) << 7
+	//	i875     = ((_1111111 + i858) << 7 + _1111111) << 7 + _1111111
+	//	return     (i875 << 7 + _1111111) << 5 + _11111
+	//
+	// Operations: 750 squares 139 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+		t15 = new(Element)
+		t16 = new(Element)
+		t17 = new(Element)
+		t18 = new(Element)
+		t19 = new(Element)
+		t20 = new(Element)
+		t21 = new(Element)
+		t22 = new(Element)
+		t23 = new(Element)
+		t24 = new(Element)
+		t25 = new(Element)
+		t26 = new(Element)
+		t27 = new(Element)
+		t28 = new(Element)
+		t29 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14,t15,t16,t17,t18,t19,t20,t21,t22,t23,t24,t25,t26,t27,t28,t29 Element
+	// Step 1: t0 = x^0x2
+	t0.Square(&x)
+
+	// Step 2: t2 = x^0x3
+	t2.Mul(&x, t0)
+
+	// Step 3: z = x^0x4
+	z.Mul(&x, t2)
+
+	// Step 4: t7 = x^0x5
+	t7.Mul(&x, z)
+
+	// Step 5: t27 = x^0x9
+	t27.Mul(z, t7)
+
+	// Step 6: t20 = x^0xb
+	t20.Mul(t0, t27)
+
+	// Step 7: t1 = x^0xd
+	t1.Mul(t0, t20)
+
+	// Step 8: t12 = x^0xf
+	t12.Mul(t0, t1)
+
+	// Step 9: t23 = x^0x11
+	t23.Mul(t0, t12)
+
+	// Step 10: t15 = x^0x15
+	t15.Mul(z, t23)
+
+	// Step 11: t28 = x^0x17
+	t28.Mul(t0, t15)
+
+	// Step 12: t17 = x^0x19
+	t17.Mul(t0, t28)
+
+	// Step 13: t18 = x^0x1b
+	t18.Mul(t0, t17)
+
+	// Step 14: t25 = x^0x1d
+	t25.Mul(t0, t18)
+
+	// Step 15: z = x^0x1f
+	z.Mul(t0, t25)
+
+	// Step 16: t8 = x^0x21
+	t8.Mul(t0, z)
+
+	// Step 17: t13 = x^0x23
+	t13.Mul(t0, t8)
+
+	// Step 18: t14 = x^0x25
+	t14.Mul(t0, t13)
+
+	// Step 19: t5 = x^0x27
+	t5.Mul(t0, t14)
+
+	// Step 20: t6 = x^0x29
+	t6.Mul(t0, t5)
+
+	// Step 21: t19 = x^0x2b
+	t19.Mul(t0, t6)
+
+	// Step 22: t10 = x^0x2d
+	t10.Mul(t0, t19)
+
+	// Step 23: t9 = x^0x2f
+	t9.Mul(t0, t10)
+
+	// Step 24: t3 = x^0x31
+	t3.Mul(t0, t9)
+
+	// Step 25: t4 = x^0x33
+	t4.Mul(t0, t3)
+
+	// Step 26: t16 = x^0x35
+	t16.Mul(t0, t4)
+
+	// Step 27: t21 = x^0x37
+	t21.Mul(t0, t16)
+
+	// Step 28: t22 = x^0x39
+	t22.Mul(t0, t21)
+
+	// Step 29: t24 = x^0x3b
+	t24.Mul(t0, t22)
+
+	// Step 30: t11 = x^0x3d
+	t11.Mul(t0, t24)
+
+	// Step 31: t26 = x^0x3f
+	t26.Mul(t0, t11)
+
+	// Step 32: t29 = x^0x7a
+	t29.Mul(t24, t26)
+
+	// Step 33: t0 = x^0x7f
+	t0.Mul(t7, t29)
+
+	// Step 37: t29 = x^0x7a0
+	for s := 0; s < 4; s++ {
+		t29.Square(t29)
+	}
+
+	// Step 38: t29 = x^0x7bb
+	t29.Mul(t18, t29)
+
+	// Step 45: t29 = x^0x3dd80
+	for s := 0; s < 7; s++ {
+		t29.Square(t29)
+	}
+
+	// Step 46: t29 = x^0x3ddab
+	t29.Mul(t19, t29)
+
+	// Step 53: t29 = x^0x1eed580
+	for s := 0; s < 7; s++ {
+		t29.Square(t29)
+	}
+
+	// Step 54: t29 = x^0x1eed5b7
+	t29.Mul(t21, t29)
+
+	// Step 61: t29 = x^0xf76adb80
+	for s := 0; s < 7; s++ {
+		t29.Square(t29)
+	}
+
+	// Step 62: t29 = x^0xf76adbb5
+	t29.Mul(t16, t29)
+
+	// Step 67: t29 = x^0x1eed5b76a0
+	for s := 0; s < 5; s++ {
+		t29.Square(t29)
+	}
+
+	// Step 68: t29 = x^0x1eed5b76b7
+	t29.Mul(t28, t29)
+
+	// Step 75: t29 = x^0xf76adbb5b80
+	for s := 0; s < 7; s++ {
+		t29.Square(t29)
+	}
+
+	// Step 76: t29 = x^0xf76adbb5bb9
+	t29.Mul(t22, t29)
+
+	// Step 81: t29 = x^0x1eed5b76b7720
+	for s := 0; s < 5; s++ {
+		t29.Square(t29)
+	}
+
+	// Step 82: t29 = x^0x1eed5b76b7731
+	t29.Mul(t23, t29)
+
+	// Step 88: t29 = x^0x7bb56ddaddcc40
+	for s := 0; s < 6; s++ {
+		t29.Square(t29)
+	}
+
+	// Step 89: t28 = x^0x7bb56ddaddcc57
+	t28.Mul(t28, t29)
+
+	// Step 97: t28 = x^0x7bb56ddaddcc5700
+	for s := 0; s < 8; s++ {
+		t28.Square(t28)
+	}
+
+	// Step 98: t28 = x^0x7bb56ddaddcc5715
+	t28.Mul(t15, t28)
+
+	// Step 101: t28 = x^0x3ddab6ed6ee62b8a8
+	for s := 0; s < 3; s++ {
+		t28.Square(t28)
+	}
+
+	// Step 102: t28 = x^0x3ddab6ed6ee62b8ab
+	t28.Mul(t2, t28)
+
+	// Step 111: t28 = x^0x7bb56ddaddcc5715600
+	for s := 0; s < 9; s++ {
+		t28.Square(t28)
+	}
+
+	// Step 112: t27 = x^0x7bb56ddaddcc5715609
+	t27.Mul(t27, t28)
+
+	// Step 120: t27 = x^0x7bb56ddaddcc571560900
+	for s := 0; s < 8; s++ {
+		t27.Square(t27)
+	}
+
+	// Step 121: t26 = x^0x7bb56ddaddcc57156093f
+	t26.Mul(t26, t27)
+
+	// Step 129: t26 = x^0x7bb56ddaddcc57156093f00
+	for s := 0; s < 8; s++ {
+		t26.Square(t26)
+	}
+
+	// Step 130: t26 = x^0x7bb56ddaddcc57156093f0f
+	t26.Mul(t12, t26)
+
+	// Step 139: t26 = x^0xf76adbb5bb98ae2ac127e1e00
+	for s := 0; s < 9; s++ {
+		t26.Square(t26)
+	}
+
+	// Step 140: t26 = x^0xf76adbb5bb98ae2ac127e1e35
+	t26.Mul(t16, t26)
+
+	// Step 145: t26 = x^0x1eed5b76b77315c55824fc3c6a0
+	for s := 0; s < 5; s++ {
+		t26.Square(t26)
+	}
+
+	// Step 146: t26 = x^0x1eed5b76b77315c55824fc3c6ad
+	t26.Mul(t1, t26)
+
+	// Step 155: t26 = x^0x3ddab6ed6ee62b8ab049f878d5a00
+	for s := 0; s < 9; s++ {
+		t26.Square(t26)
+	}
+
+	// Step 156: t26 = x^0x3ddab6ed6ee62b8ab049f878d5a33
+	t26.Mul(t4, t26)
+
+	// Step 162: t26 = x^0xf76adbb5bb98ae2ac127e1e3568cc0
+	for s := 0; s < 6; s++ {
+		t26.Square(t26)
+	}
+
+	// Step 163: t26 = x^0xf76adbb5bb98ae2ac127e1e3568cf5
+	t26.Mul(t16, t26)
+
+	// Step 168: t26 = x^0x1eed5b76b77315c55824fc3c6ad19ea0
+	for s := 0; s < 5; s++ {
+		t26.Square(t26)
+	}
+
+	// Step 169: t26 = x^0x1eed5b76b77315c55824fc3c6ad19eb9
+	t26.Mul(t17, t26)
+
+	// Step 177: t26 = x^0x1eed5b76b77315c55824fc3c6ad19eb900
+	for s := 0; s < 8; s++ {
+		t26.Square(t26)
+	}
+
+	// Step 178: t26 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f
+	t26.Mul(t9, t26)
+
+	// Step 187: t26 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e00
+	for s := 0; s < 9; s++ {
+		t26.Square(t26)
+	}
+
+	// Step 188: t26 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e33
+	t26.Mul(t4, t26)
+
+	// Step 195: t26 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f1980
+	for s := 0; s < 7; s++ {
+		t26.Square(t26)
+	}
+
+	// Step 196: t26 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5
+	t26.Mul(t14, t26)
+
+	// Step 202: t26 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc66940
+	for s := 0; s < 6; s++ {
+		t26.Square(t26)
+	}
+
+	// Step 203: t26 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d
+	t26.Mul(t11, t26)
+
+	// Step 206: t26 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334be8
+	for s := 0; s < 3; s++ {
+		t26.Square(t26)
+	}
+
+	// Step 207: t26 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb
+	t26.Mul(t2, t26)
+
+	// Step 215: t26 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb00
+	for s := 0; s < 8; s++ {
+		t26.Square(t26)
+	}
+
+	// Step 216: t26 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b
+	t26.Mul(t20, t26)
+
+	// Step 223: t26 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f58580
+	for s := 0; s < 7; s++ {
+		t26.Square(t26)
+	}
+
+	// Step 224: t25 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d
+	t25.Mul(t25, t26)
+
+	// Step 233: t25 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a00
+	for s := 0; s < 9; s++ {
+		t25.Square(t25)
+	}
+
+	// Step 234: t25 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27
+	t25.Mul(t5, t25)
+
+	// Step 240: t25 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89c0
+	for s := 0; s < 6; s++ {
+		t25.Square(t25)
+	}
+
+	// Step 241: t24 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fb
+	t24.Mul(t24, t25)
+
+	// Step 245: t24 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fb0
+	for s := 0; s < 4; s++ {
+		t24.Square(t24)
+	}
+
+	// Step 246: t24 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf
+	t24.Mul(t12, t24)
+
+	// Step 254: t24 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf00
+	for s := 0; s < 8; s++ {
+		t24.Square(t24)
+	}
+
+	// Step 255: t24 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23
+	t24.Mul(t13, t24)
+
+	// Step 262: t24 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf9180
+	for s := 0; s < 7; s++ {
+		t24.Square(t24)
+	}
+
+	// Step 263: t24 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf9191
+	t24.Mul(t23, t24)
+
+	// Step 270: t24 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c880
+	for s := 0; s < 7; s++ {
+		t24.Square(t24)
+	}
+
+	// Step 271: t24 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c885
+	t24.Mul(t7, t24)
+
+	// Step 279: t24 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88500
+	for s := 0; s < 8; s++ {
+		t24.Square(t24)
+	}
+
+	// Step 280: t24 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515
+	t24.Mul(t15, t24)
+
+	// Step 286: t24 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf232214540
+	for s := 0; s < 6; s++ {
+		t24.Square(t24)
+	}
+
+	// Step 287: t23 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf232214551
+	t23.Mul(t23, t24)
+
+	// Step 294: t23 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a880
+	for s := 0; s < 7; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 295: t23 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1
+	t23.Mul(t3, t23)
+
+	// Step 299: t23 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b10
+	for s := 0; s < 4; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 300: t23 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d
+	t23.Mul(t1, t23)
+
+	// Step 307: t23 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e80
+	for s := 0; s < 7; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 308: t23 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b
+	t23.Mul(t18, t23)
+
+	// Step 316: t23 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b00
+	for s := 0; s < 8; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 317: t23 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b33
+	t23.Mul(t4, t23)
+
+	// Step 326: t23 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366600
+	for s := 0; s < 9; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 327: t23 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635
+	t23.Mul(t16, t23)
+
+	// Step 334: t23 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331a80
+	for s := 0; s < 7; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 335: t23 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9
+	t23.Mul(t22, t23)
+
+	// Step 341: t23 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae40
+	for s := 0; s < 6; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 342: t23 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73
+	t23.Mul(t4, t23)
+
+	// Step 348: t23 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cc0
+	for s := 0; s < 6; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 349: t23 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf1
+	t23.Mul(t3, t23)
+
+	// Step 358: t23 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e200
+	for s := 0; s < 9; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 359: t23 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215
+	t23.Mul(t15, t23)
+
+	// Step 367: t23 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e21500
+	for s := 0; s < 8; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 368: t23 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e21523
+	t23.Mul(t13, t23)
+
+	// Step 374: t23 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548c0
+	for s := 0; s < 6; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 375: t23 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db
+	t23.Mul(t18, t23)
+
+	// Step 383: t23 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db00
+	for s := 0; s < 8; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 384: t23 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d
+	t23.Mul(t11, t23)
+
+	// Step 387: t23 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9e8
+	for s := 0; s < 3; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 388: t23 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb
+	t23.Mul(t2, t23)
+
+	// Step 398: t23 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac00
+	for s := 0; s < 10; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 399: t23 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b
+	t23.Mul(t20, t23)
+
+	// Step 402: t23 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6058
+	for s := 0; s < 3; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 403: t23 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059
+	t23.Mul(&x, t23)
+
+	// Step 415: t23 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059000
+	for s := 0; s < 12; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 416: t23 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059025
+	t23.Mul(t14, t23)
+
+	// Step 422: t23 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640940
+	for s := 0; s < 6; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 423: t23 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975
+	t23.Mul(t16, t23)
+
+	// Step 435: t23 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975000
+	for s := 0; s < 12; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 436: t23 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027
+	t23.Mul(t5, t23)
+
+	// Step 442: t23 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059025d409c0
+	for s := 0; s < 6; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 443: t23 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059025d409f5
+	t23.Mul(t16, t23)
+
+	// Step 449: t23 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d40
+	for s := 0; s < 6; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 450: t23 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55
+	t23.Mul(t15, t23)
+
+	// Step 461: t23 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa800
+	for s := 0; s < 11; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 462: t23 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829
+	t23.Mul(t6, t23)
+
+	// Step 467: t23 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d550520
+	for s := 0; s < 5; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 468: t23 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f
+	t23.Mul(z, t23)
+
+	// Step 473: t23 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7e0
+	for s := 0; s < 5; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 474: t23 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb
+	t23.Mul(t20, t23)
+
+	// Step 483: t23 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059025d409f55414fd600
+	for s := 0; s < 9; s++ {
+		t23.Square(t23)
+	}
+
+	// Step 484: t22 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059025d409f55414fd639
+	t22.Mul(t22, t23)
+
+	// Step 491: t22 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1c80
+	for s := 0; s < 7; s++ {
+		t22.Square(t22)
+	}
+
+	// Step 492: t22 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb3
+	t22.Mul(t4, t22)
+
+	// Step 496: t22 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb30
+	for s := 0; s < 4; s++ {
+		t22.Square(t22)
+	}
+
+	// Step 497: t22 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb3d
+	t22.Mul(t1, t22)
+
+	// Step 508: t22 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e800
+	for s := 0; s < 11; s++ {
+		t22.Square(t22)
+	}
+
+	// Step 509: t22 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e837
+	t22.Mul(t21, t22)
+
+	// Step 516: t22 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b80
+	for s := 0; s < 7; s++ {
+		t22.Square(t22)
+	}
+
+	// Step 517: t22 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b99
+	t22.Mul(t17, t22)
+
+	// Step 526: t22 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373200
+	for s := 0; s < 9; s++ {
+		t22.Square(t22)
+	}
+
+	// Step 527: t21 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373237
+	t21.Mul(t21, t22)
+
+	// Step 536: t21 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb3d06e646e00
+	for s := 0; s < 9; s++ {
+		t21.Square(t21)
+	}
+
+	// Step 537: t21 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb3d06e646e29
+	t21.Mul(t6, t21)
+
+	// Step 543: t21 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a40
+	for s := 0; s < 6; s++ {
+		t21.Square(t21)
+	}
+
+	// Step 544: t20 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a4b
+	t20.Mul(t20, t21)
+
+	// Step 550: t20 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb3d06e646e292c0
+	for s := 0; s < 6; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 551: t20 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb3d06e646e292cd
+	t20.Mul(t1, t20)
+
+	// Step 560: t20 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059025d409f55414fd63967a0dcc8dc5259a00
+	for s := 0; s < 9; s++ {
+		t20.Square(t20)
+	}
+
+	// Step 561: t19 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059025d409f55414fd63967a0dcc8dc5259a2b
+	t19.Mul(t19, t20)
+
+	// Step 566: t19 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a4b34560
+	for s := 0; s < 5; s++ {
+		t19.Square(t19)
+	}
+
+	// Step 567: t19 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a4b3457b
+	t19.Mul(t18, t19)
+
+	// Step 573: t19 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb3d06e646e292cd15ec0
+	for s := 0; s < 6; s++ {
+		t19.Square(t19)
+	}
+
+	// Step 574: t18 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb3d06e646e292cd15edb
+	t18.Mul(t18, t19)
+
+	// Step 580: t18 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a4b3457b6c0
+	for s := 0; s < 6; s++ {
+		t18.Square(t18)
+	}
+
+	// Step 581: t17 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a4b3457b6d9
+	t17.Mul(t17, t18)
+
+	// Step 590: t17 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373237149668af6db200
+	for s := 0; s < 9; s++ {
+		t17.Square(t17)
+	}
+
+	// Step 591: t16 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373237149668af6db235
+	t16.Mul(t16, t17)
+
+	// Step 598: t16 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a4b3457b6d91a80
+	for s := 0; s < 7; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 599: t16 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a4b3457b6d91a95
+	t16.Mul(t15, t16)
+
+	// Step 605: t16 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb3d06e646e292cd15edb646a540
+	for s := 0; s < 6; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 606: t16 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb3d06e646e292cd15edb646a543
+	t16.Mul(t2, t16)
+
+	// Step 617: t16 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373237149668af6db2352a1800
+	for s := 0; s < 11; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 618: t15 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373237149668af6db2352a1815
+	t15.Mul(t15, t16)
+
+	// Step 622: t15 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373237149668af6db2352a18150
+	for s := 0; s < 4; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 623: t15 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373237149668af6db2352a18155
+	t15.Mul(t7, t15)
+
+	// Step 630: t15 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a4b3457b6d91a950c0aa80
+	for s := 0; s < 7; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 631: t15 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a4b3457b6d91a950c0aa8f
+	t15.Mul(t12, t15)
+
+	// Step 641: t15 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb3d06e646e292cd15edb646a54302aa3c00
+	for s := 0; s < 10; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 642: t14 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb3d06e646e292cd15edb646a54302aa3c25
+	t14.Mul(t14, t15)
+
+	// Step 648: t14 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a4b3457b6d91a950c0aa8f0940
+	for s := 0; s < 6; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 649: t13 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a4b3457b6d91a950c0aa8f0963
+	t13.Mul(t13, t14)
+
+	// Step 654: t13 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373237149668af6db2352a181551e12c60
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 655: t12 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373237149668af6db2352a181551e12c6f
+	t12.Mul(t12, t13)
+
+	// Step 662: t12 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a4b3457b6d91a950c0aa8f0963780
+	for s := 0; s < 7; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 663: t12 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a4b3457b6d91a950c0aa8f096379f
+	t12.Mul(z, t12)
+
+	// Step 670: t12 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059025d409f55414fd63967a0dcc8dc5259a2bdb6c8d4a860554784b1bcf80
+	for s := 0; s < 7; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 671: t11 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059025d409f55414fd63967a0dcc8dc5259a2bdb6c8d4a860554784b1bcfbd
+	t11.Mul(t11, t12)
+
+	// Step 674: t11 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb3d06e646e292cd15edb646a54302aa3c258de7de8
+	for s := 0; s < 3; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 675: t11 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb3d06e646e292cd15edb646a54302aa3c258de7ded
+	t11.Mul(t7, t11)
+
+	// Step 685: t11 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a4b3457b6d91a950c0aa8f096379f7b400
+	for s := 0; s < 10; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 686: t10 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a4b3457b6d91a950c0aa8f096379f7b42d
+	t10.Mul(t10, t11)
+
+	// Step 689: t10 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059025d409f55414fd63967a0dcc8dc5259a2bdb6c8d4a860554784b1bcfbda168
+	for s := 0; s < 3; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 690: t10 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059025d409f55414fd63967a0dcc8dc5259a2bdb6c8d4a860554784b1bcfbda16d
+	t10.Mul(t7, t10)
+
+	// Step 700: t10 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373237149668af6db2352a181551e12c6f3ef685b400
+	for s := 0; s < 10; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 701: t9 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373237149668af6db2352a181551e12c6f3ef685b42f
+	t9.Mul(t9, t10)
+
+	// Step 708: t9 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a4b3457b6d91a950c0aa8f096379f7b42da1780
+	for s := 0; s < 7; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 709: t8 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a4b3457b6d91a950c0aa8f096379f7b42da17a1
+	t8.Mul(t8, t9)
+
+	// Step 712: t8 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059025d409f55414fd63967a0dcc8dc5259a2bdb6c8d4a860554784b1bcfbda16d0bd08
+	for s := 0; s < 3; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 713: t7 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059025d409f55414fd63967a0dcc8dc5259a2bdb6c8d4a860554784b1bcfbda16d0bd0d
+	t7.Mul(t7, t8)
+
+	// Step 723: t7 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373237149668af6db2352a181551e12c6f3ef685b42f43400
+	for s := 0; s < 10; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 724: t6 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373237149668af6db2352a181551e12c6f3ef685b42f43429
+	t6.Mul(t6, t7)
+
+	// Step 732: t6 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373237149668af6db2352a181551e12c6f3ef685b42f4342900
+	for s := 0; s < 8; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 733: t5 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373237149668af6db2352a181551e12c6f3ef685b42f4342927
+	t5.Mul(t5, t6)
+
+	// Step 736: t5 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a4b3457b6d91a950c0aa8f096379f7b42da17a1a14938
+	for s := 0; s < 3; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 737: t5 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a4b3457b6d91a950c0aa8f096379f7b42da17a1a1493b
+	t5.Mul(t2, t5)
+
+	// Step 751: t5 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb3d06e646e292cd15edb646a54302aa3c258de7ded0b685e868524ec000
+	for s := 0; s < 14; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 752: t4 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb3d06e646e292cd15edb646a54302aa3c258de7ded0b685e868524ec033
+	t4.Mul(t4, t5)
+
+	// Step 758: t4 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a4b3457b6d91a950c0aa8f096379f7b42da17a1a1493b00cc0
+	for s := 0; s < 6; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 759: t3 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a4b3457b6d91a950c0aa8f096379f7b42da17a1a1493b00cf1
+	t3.Mul(t3, t4)
+
+	// Step 764: t3 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373237149668af6db2352a181551e12c6f3ef685b42f43429276019e20
+	for s := 0; s < 5; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 765: t3 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373237149668af6db2352a181551e12c6f3ef685b42f43429276019e3f
+	t3.Mul(z, t3)
+
+	// Step 769: t3 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373237149668af6db2352a181551e12c6f3ef685b42f43429276019e3f0
+	for s := 0; s < 4; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 770: t2 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373237149668af6db2352a181551e12c6f3ef685b42f43429276019e3f3
+	t2.Mul(t2, t3)
+
+	// Step 780: t2 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059025d409f55414fd63967a0dcc8dc5259a2bdb6c8d4a860554784b1bcfbda16d0bd0d0a49d80678fcc00
+	for s := 0; s < 10; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 781: t2 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059025d409f55414fd63967a0dcc8dc5259a2bdb6c8d4a860554784b1bcfbda16d0bd0d0a49d80678fcc7f
+	t2.Mul(t0, t2)
+
+	// Step 789: t2 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059025d409f55414fd63967a0dcc8dc5259a2bdb6c8d4a860554784b1bcfbda16d0bd0d0a49d80678fcc7f00
+	for s := 0; s < 8; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 790: t1 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059025d409f55414fd63967a0dcc8dc5259a2bdb6c8d4a860554784b1bcfbda16d0bd0d0a49d80678fcc7f0d
+	t1.Mul(t1, t2)
+
+	// Step 802: t1 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059025d409f55414fd63967a0dcc8dc5259a2bdb6c8d4a860554784b1bcfbda16d0bd0d0a49d80678fcc7f0d000
+	for s := 0; s < 12; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 803: t1 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059025d409f55414fd63967a0dcc8dc5259a2bdb6c8d4a860554784b1bcfbda16d0bd0d0a49d80678fcc7f0d07f
+	t1.Mul(t0, t1)
+
+	// Step 810: t1 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb3d06e646e292cd15edb646a54302aa3c258de7ded0b685e868524ec033c7e63f8683f80
+	for s := 0; s < 7; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 811: t1 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb3d06e646e292cd15edb646a54302aa3c258de7ded0b685e868524ec033c7e63f8683fff
+	t1.Mul(t0, t1)
+
+	// Step 818: t1 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373237149668af6db2352a181551e12c6f3ef685b42f43429276019e3f31fc341fff80
+	for s := 0; s < 7; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 819: t1 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373237149668af6db2352a181551e12c6f3ef685b42f43429276019e3f31fc341fffff
+	t1.Mul(t0, t1)
+
+	// Step 826: t1 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a4b3457b6d91a950c0aa8f096379f7b42da17a1a1493b00cf1f98fe1a0fffff80
+	for s := 0; s < 7; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 827: t1 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a4b3457b6d91a950c0aa8f096379f7b42da17a1a1493b00cf1f98fe1a0fffffff
+	t1.Mul(t0, t1)
+
+	// Step 834: t1 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059025d409f55414fd63967a0dcc8dc5259a2bdb6c8d4a860554784b1bcfbda16d0bd0d0a49d80678fcc7f0d07ffffff80
+	for s := 0; s < 7; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 835: t1 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059025d409f55414fd63967a0dcc8dc5259a2bdb6c8d4a860554784b1bcfbda16d0bd0d0a49d80678fcc7f0d07ffffffff
+	t1.Mul(t0, t1)
+
+	// Step 842: t1 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb3d06e646e292cd15edb646a54302aa3c258de7ded0b685e868524ec033c7e63f8683ffffffff80
+	for s := 0; s < 7; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 843: t1 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb3d06e646e292cd15edb646a54302aa3c258de7ded0b685e868524ec033c7e63f8683ffffffffff
+	t1.Mul(t0, t1)
+
+	// Step 850: t1 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373237149668af6db2352a181551e12c6f3ef685b42f43429276019e3f31fc341ffffffffff80
+	for s := 0; s < 7; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 851: t1 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373237149668af6db2352a181551e12c6f3ef685b42f43429276019e3f31fc341ffffffffffff
+	t1.Mul(t0, t1)
+
+	// Step 858: t1 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a4b3457b6d91a950c0aa8f096379f7b42da17a1a1493b00cf1f98fe1a0ffffffffffff80
+	for s := 0; s < 7; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 859: t1 = x^0x3ddab6ed6ee62b8ab049f878d5a33d725e334beb0b3a27efc8c88515458e9b331ab9cf10a91b67ac0b204ba813eaa829fac72cf41b991b8a4b3457b6d91a950c0aa8f096379f7b42da17a1a1493b00cf1f98fe1a0ffffffffffffff
+	t1.Mul(t0, t1)
+
+	// Step 866: t1 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059025d409f55414fd63967a0dcc8dc5259a2bdb6c8d4a860554784b1bcfbda16d0bd0d0a49d80678fcc7f0d07fffffffffffff80
+	for s := 0; s < 7; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 867: t1 = x^0x1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059025d409f55414fd63967a0dcc8dc5259a2bdb6c8d4a860554784b1bcfbda16d0bd0d0a49d80678fcc7f0d07fffffffffffffff
+	t1.Mul(t0, t1)
+
+	// Step 874: t1 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb3d06e646e292cd15edb646a54302aa3c258de7ded0b685e868524ec033c7e63f8683fffffffffffffff80
+	for s := 0; s < 7; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 875: t1 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb3d06e646e292cd15edb646a54302aa3c258de7ded0b685e868524ec033c7e63f8683fffffffffffffffff
+	t1.Mul(t0, t1)
+
+	// Step 882: t1 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373237149668af6db2352a181551e12c6f3ef685b42f43429276019e3f31fc341fffffffffffffffff80
+	for s := 0; s < 7; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 883: t0 = x^0x7bb56ddaddcc57156093f0f1ab467ae4bc6697d616744fdf91910a2a8b1d366635739e215236cf581640975027d55053f58e59e8373237149668af6db2352a181551e12c6f3ef685b42f43429276019e3f31fc341fffffffffffffffffff
+	t0.Mul(t0, t1)
+
+	// Step 888: t0 = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb3d06e646e292cd15edb646a54302aa3c258de7ded0b685e868524ec033c7e63f8683ffffffffffffffffffe0
+	for s := 0; s < 5; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 889: z = x^0xf76adbb5bb98ae2ac127e1e3568cf5c978cd2fac2ce89fbf23221455163a6ccc6ae73c42a46d9eb02c812ea04faaa0a7eb1cb3d06e646e292cd15edb646a54302aa3c258de7ded0b685e868524ec033c7e63f8683ffffffffffffffffffff
+	z.Mul(z, t0)
+
+	return z
+}
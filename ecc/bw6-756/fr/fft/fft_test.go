@@ -18,6 +18,7 @@ package fft
 
 import (
 	"math/big"
+	"reflect"
 	"strconv"
 	"testing"
 
@@ -250,6 +251,105 @@ func TestFFT(t *testing.T) {
 // --------------------------------------------------------------------
 // benches
 
+func TestFFTColumns(t *testing.T) {
+	const size, nbCols = 1 << 5, 5
+	domain := NewDomain(size)
+
+	for _, nbTasks := range []int{1, 3, 16} {
+		for _, coset := range []bool{false, true} {
+			opts := []Option{WithNbTasks(nbTasks)}
+			if coset {
+				opts = append(opts, OnCoset())
+			}
+
+			m := fr.NewMatrix(size, nbCols)
+			for j := 0; j < nbCols; j++ {
+				for i := range m.Column(j) {
+					m.Column(j)[i].SetRandom()
+				}
+			}
+			expected := m.Transpose().Transpose() // copy of m
+
+			domain.FFTColumns(&m, DIF, opts...)
+			for j := 0; j < nbCols; j++ {
+				domain.FFT(expected.Column(j), DIF, opts...)
+				if !reflect.DeepEqual(expected.Column(j), m.Column(j)) {
+					t.Fatalf("nbTasks=%d, coset=%v: wrong FFT of column %d", nbTasks, coset, j)
+				}
+			}
+
+			domain.FFTInverseColumns(&m, DIT, opts...)
+			for j := 0; j < nbCols; j++ {
+				domain.FFTInverse(expected.Column(j), DIT, opts...)
+				if !reflect.DeepEqual(expected.Column(j), m.Column(j)) {
+					t.Fatalf("nbTasks=%d, coset=%v: wrong inverse FFT of column %d", nbTasks, coset, j)
+				}
+			}
+		}
+	}
+}
+
+func TestFFTStrided(t *testing.T) {
+	const (
+		size     = 1 << 6
+		nbCols   = 3
+		nbExtras = 2 // elements before the matrix, that must be left untouched
+	)
+
+	for domainName, domain := range map[string]*Domain{
+		"with precompute":    NewDomain(size),
+		"without precompute": NewDomain(size, WithoutPrecompute()),
+	} {
+		for _, inverse := range []bool{false, true} {
+			for _, decimation := range []Decimation{DIF, DIT} {
+				for _, coset := range []bool{false, true} {
+					var opts []Option
+					if coset {
+						opts = append(opts, OnCoset())
+					}
+
+					// row-major matrix of size x nbCols, after nbExtras elements
+					a := make([]fr.Element, nbExtras+size*nbCols)
+					for i := range a {
+						a[i].SetRandom()
+					}
+					backup := make([]fr.Element, len(a))
+					copy(backup, a)
+
+					col := 1
+					if inverse {
+						domain.FFTInverseStrided(a, nbExtras+col, nbCols, decimation, opts...)
+					} else {
+						domain.FFTStrided(a, nbExtras+col, nbCols, decimation, opts...)
+					}
+
+					// same transform on a copy of the column
+					expected := make([]fr.Element, size)
+					for i := range expected {
+						expected[i] = backup[nbExtras+col+i*nbCols]
+					}
+					if inverse {
+						domain.FFTInverse(expected, decimation, opts...)
+					} else {
+						domain.FFT(expected, decimation, opts...)
+					}
+
+					for i := range a {
+						j := i - nbExtras - col
+						if j >= 0 && j%nbCols == 0 {
+							if !a[i].Equal(&expected[j/nbCols]) {
+								t.Fatalf("%s, inverse=%v, decimation=%d, coset=%v: wrong output at row %d", domainName, inverse, decimation, coset, j/nbCols)
+							}
+						} else if !a[i].Equal(&backup[i]) {
+							t.Fatalf("%s: element %d outside of the view was modified", domainName, i)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
 func BenchmarkFFT(b *testing.B) {
 
 	const maxSize = 1 << 20
@@ -71,17 +71,32 @@ type DomainOption func(*domainConfig)
 
 type domainConfig struct {
 	shift          *fr.Element
+	generator      *fr.Element
 	withPrecompute bool
 }
 
 // WithShift sets the FrMultiplicativeGen of the domain.
 // Default is generator of the largest 2-adic subgroup.
+//
+// The shift must be non-zero and the coset shift*<ω> must be disjoint from the
+// domain, NewDomain panics otherwise.
 func WithShift(shift fr.Element) DomainOption {
 	return func(opt *domainConfig) {
 		opt.shift = new(fr.Element).Set(&shift)
 	}
 }
 
+// WithGenerator sets the Generator ω of the domain, instead of the root of unity returned
+// by Generator; e.g. to match the ordering of the domains of another library.
+//
+// ω must be a primitive n-th root of unity, n being the cardinality of the domain,
+// NewDomain panics otherwise.
+func WithGenerator(generator fr.Element) DomainOption {
+	return func(opt *domainConfig) {
+		opt.generator = new(fr.Element).Set(&generator)
+	}
+}
+
 // WithoutPrecompute disables precomputation of twiddles in the domain.
 // When this option is set, FFTs will be slower, but will use less memory.
 func WithoutPrecompute() DomainOption {
@@ -15,4 +15,7 @@
 // Code generated by consensys/gnark-crypto DO NOT EDIT
 
 // Package mimc provides MiMC hash function using Miyaguchi–Preneel construction.
+//
+// It also provides PRF, a keyed pseudorandom function over field elements built on the same
+// construction, to derive field-native pseudorandomness.
 package mimc
@@ -164,6 +164,31 @@ func TestCommitFiveElements(t *testing.T) {
 	testCommit(t, randomFrSlice(t, 5)...)
 }
 
+func TestCommitPrecompute(t *testing.T) {
+	basis := randomG1Slice(t, 5)
+	values := interfaceSliceToFrSlice(t, randomFrSlice(t, 5)...)
+
+	pk, vk, err := Setup(basis)
+	assert.NoError(t, err)
+	commitment, err := pk[0].Commit(values)
+	assert.NoError(t, err)
+	pok, err := pk[0].ProveKnowledge(values)
+	assert.NoError(t, err)
+
+	pk[0].Precompute()
+	commitmentTable, err := pk[0].Commit(values)
+	assert.NoError(t, err)
+	pokTable, err := pk[0].ProveKnowledge(values)
+	assert.NoError(t, err)
+
+	assert.True(t, commitment.Equal(&commitmentTable))
+	assert.True(t, pok.Equal(&pokTable))
+	assert.NoError(t, vk.Verify(commitmentTable, pokTable))
+
+	_, err = pk[0].Commit(values[1:])
+	assert.Error(t, err)
+}
+
 func TestMarshal(t *testing.T) {
 	var pk ProvingKey
 	pk.basisExpSigma = randomG1Slice(t, 5)
@@ -0,0 +1,29 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package hyrax provides the Hyrax polynomial commitment scheme for multilinear
+// polynomials (https://eprint.iacr.org/2017/1132, section 6).
+//
+// The 2ⁿ evaluations of a polynomial on the boolean hypercube are arranged in a matrix, whose rows
+// are committed to with Pedersen vector commitments. An evaluation at a point z = (z_L, z_R) is
+// eq(z_L)ᵀ⋅M⋅eq(z_R): the verifier combines the row commitments with eq(z_L), and the prover shows
+// with an inner product argument (https://eprint.iacr.org/2017/1066, section 3) that the combined
+// row has the claimed inner product with eq(z_R).
+//
+// The scheme is transparent: the generators are derived with HashToG1, there is no trusted setup.
+// Commitments and proofs have O(√2ⁿ) and O(n) group elements, and verifying costs a multi-scalar
+// multiplication of size O(√2ⁿ). The commitments are not hiding.
+package hyrax
@@ -0,0 +1,23 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package twochain provides conversions between bls12-378 and bw6-756, which form a 2-chain:
+// the base field of bls12-378 is the scalar field of bw6-756.
+//
+// In a recursive verifier of bls12-378 proofs over bw6-756, the G1, G2 and GT elements of
+// bls12-378 are native: each coordinate over the base field is one bw6-756 fr element.
+// The scalars of bls12-378 are not native and are decomposed in limbs.
+package twochain
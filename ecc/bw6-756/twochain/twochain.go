@@ -0,0 +1,222 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package twochain
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378"
+	innerfp "github.com/consensys/gnark-crypto/ecc/bls12-378/fp"
+	innerfr "github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/consensys/gnark-crypto/ecc/bw6-756/fr"
+)
+
+const (
+	NbG1Coords = 2  // number of fr elements of a bls12-378 G1 point
+	NbG2Coords = 4  // number of fr elements of a bls12-378 G2 point
+	NbGTCoords = 12 // number of fr elements of a bls12-378 GT element
+)
+
+var (
+	ErrNotOnCurve    = errors.New("point is not on the curve")
+	ErrNotInSubGroup = errors.New("element is not in the prime order subgroup")
+	ErrLimbSize      = errors.New("invalid limb size")
+	ErrLimbOverflow  = errors.New("limb doesn't fit in the limb size")
+	ErrValueOverflow = errors.New("value doesn't fit in the limbs")
+)
+
+// FromFp returns x, an element of the base field of bls12-378, as an element of fr.
+// Both fields have the same modulus and Montgomery representation.
+func FromFp(x *innerfp.Element) fr.Element {
+	return fr.Element(*x)
+}
+
+// ToFp returns x as an element of the base field of bls12-378, see FromFp
+func ToFp(x *fr.Element) innerfp.Element {
+	return innerfp.Element(*x)
+}
+
+// FromG1 returns the affine coordinates (X, Y) of p.
+// The point at infinity is (0, 0).
+func FromG1(p *bls12378.G1Affine) [NbG1Coords]fr.Element {
+	return [NbG1Coords]fr.Element{FromFp(&p.X), FromFp(&p.Y)}
+}
+
+// ToG1 returns the point of affine coordinates (X, Y), see FromG1.
+// It returns an error if the point is not on the curve or not in the prime order subgroup.
+func ToG1(coords [NbG1Coords]fr.Element) (bls12378.G1Affine, error) {
+	var p bls12378.G1Affine
+	p.X = ToFp(&coords[0])
+	p.Y = ToFp(&coords[1])
+	if !p.IsOnCurve() {
+		return p, ErrNotOnCurve
+	}
+	if !p.IsInSubGroup() {
+		return p, ErrNotInSubGroup
+	}
+	return p, nil
+}
+
+// FromG2 returns the coordinates of X then Y over the base field of bls12-378, of the
+// affine point p. The point at infinity is (0, 0).
+func FromG2(p *bls12378.G2Affine) [NbG2Coords]fr.Element {
+	var res [NbG2Coords]fr.Element
+	res[0] = FromFp(&p.X.A0)
+	res[1] = FromFp(&p.X.A1)
+	res[2] = FromFp(&p.Y.A0)
+	res[3] = FromFp(&p.Y.A1)
+	return res
+}
+
+// ToG2 returns the affine point of coordinates coords, see FromG2.
+// It returns an error if the point is not on the curve or not in the prime order subgroup.
+func ToG2(coords [NbG2Coords]fr.Element) (bls12378.G2Affine, error) {
+	var p bls12378.G2Affine
+	p.X.A0 = ToFp(&coords[0])
+	p.X.A1 = ToFp(&coords[1])
+	p.Y.A0 = ToFp(&coords[2])
+	p.Y.A1 = ToFp(&coords[3])
+	if !p.IsOnCurve() {
+		return p, ErrNotOnCurve
+	}
+	if !p.IsInSubGroup() {
+		return p, ErrNotInSubGroup
+	}
+	return p, nil
+}
+
+// FromGT returns the coordinates of z over the base field of bls12-378, following the
+// tower of extensions of GT.
+func FromGT(z *bls12378.GT) [NbGTCoords]fr.Element {
+	var res [NbGTCoords]fr.Element
+	res[0] = FromFp(&z.C0.B0.A0)
+	res[1] = FromFp(&z.C0.B0.A1)
+	res[2] = FromFp(&z.C0.B1.A0)
+	res[3] = FromFp(&z.C0.B1.A1)
+	res[4] = FromFp(&z.C0.B2.A0)
+	res[5] = FromFp(&z.C0.B2.A1)
+	res[6] = FromFp(&z.C1.B0.A0)
+	res[7] = FromFp(&z.C1.B0.A1)
+	res[8] = FromFp(&z.C1.B1.A0)
+	res[9] = FromFp(&z.C1.B1.A1)
+	res[10] = FromFp(&z.C1.B2.A0)
+	res[11] = FromFp(&z.C1.B2.A1)
+	return res
+}
+
+// ToGT returns the element of GT of coordinates coords, see FromGT.
+// It returns an error if the element is not in the prime order subgroup of the multiplicative group.
+func ToGT(coords [NbGTCoords]fr.Element) (bls12378.GT, error) {
+	var z bls12378.GT
+	z.C0.B0.A0 = ToFp(&coords[0])
+	z.C0.B0.A1 = ToFp(&coords[1])
+	z.C0.B1.A0 = ToFp(&coords[2])
+	z.C0.B1.A1 = ToFp(&coords[3])
+	z.C0.B2.A0 = ToFp(&coords[4])
+	z.C0.B2.A1 = ToFp(&coords[5])
+	z.C1.B0.A0 = ToFp(&coords[6])
+	z.C1.B0.A1 = ToFp(&coords[7])
+	z.C1.B1.A0 = ToFp(&coords[8])
+	z.C1.B1.A1 = ToFp(&coords[9])
+	z.C1.B2.A0 = ToFp(&coords[10])
+	z.C1.B2.A1 = ToFp(&coords[11])
+	if !z.IsInSubGroup() {
+		return z, ErrNotInSubGroup
+	}
+	return z, nil
+}
+
+// NbFrLimbs returns the number of limbs of limbBits bits of a scalar of bls12-378
+func NbFrLimbs(limbBits int) int {
+	return (innerfr.Bits + limbBits - 1) / limbBits
+}
+
+// FromFr returns the NbFrLimbs(limbBits) limbs of limbBits bits of the scalar x of bls12-378,
+// least significant limb first. See DecomposeLimbs.
+func FromFr(x *innerfr.Element, limbBits int) ([]fr.Element, error) {
+	if err := checkLimbBits(limbBits); err != nil {
+		return nil, err
+	}
+	var v big.Int
+	x.BigInt(&v)
+	return DecomposeLimbs(&v, limbBits, NbFrLimbs(limbBits))
+}
+
+// ToFr returns the scalar of bls12-378 from its limbs of limbBits bits, see FromFr.
+// It returns an error if a limb doesn't fit in limbBits bits or if the value is not reduced modulo
+// the order of bls12-378.
+func ToFr(limbs []fr.Element, limbBits int) (innerfr.Element, error) {
+	var res innerfr.Element
+	v, err := RecomposeLimbs(limbs, limbBits)
+	if err != nil {
+		return res, err
+	}
+	if v.Cmp(innerfr.Modulus()) >= 0 {
+		return res, ErrValueOverflow
+	}
+	res.SetBigInt(v)
+	return res, nil
+}
+
+// DecomposeLimbs returns the nbLimbs limbs of limbBits bits of v, least significant limb first,
+// such that v = ∑ limbsᵢ 2^{limbBits*i}.
+//
+// limbBits must be in [1, fr.Bits-1] so that the limbs are reduced, and v must be in
+// [0, 2^{limbBits*nbLimbs}).
+func DecomposeLimbs(v *big.Int, limbBits, nbLimbs int) ([]fr.Element, error) {
+	if err := checkLimbBits(limbBits); err != nil {
+		return nil, err
+	}
+	if v.Sign() < 0 || v.BitLen() > limbBits*nbLimbs {
+		return nil, ErrValueOverflow
+	}
+	mask := new(big.Int).Lsh(big.NewInt(1), uint(limbBits))
+	mask.Sub(mask, big.NewInt(1))
+
+	limbs := make([]fr.Element, nbLimbs)
+	var limb big.Int
+	for i := range limbs {
+		limb.Rsh(v, uint(limbBits*i)).And(&limb, mask)
+		limbs[i].SetBigInt(&limb)
+	}
+	return limbs, nil
+}
+
+// RecomposeLimbs returns v = ∑ limbsᵢ 2^{limbBits*i}, see DecomposeLimbs.
+// It returns an error if a limb doesn't fit in limbBits bits.
+func RecomposeLimbs(limbs []fr.Element, limbBits int) (*big.Int, error) {
+	if err := checkLimbBits(limbBits); err != nil {
+		return nil, err
+	}
+	var v, limb big.Int
+	for i := len(limbs) - 1; i >= 0; i-- {
+		limbs[i].BigInt(&limb)
+		if limb.BitLen() > limbBits {
+			return nil, ErrLimbOverflow
+		}
+		v.Lsh(&v, uint(limbBits)).Or(&v, &limb)
+	}
+	return &v, nil
+}
+
+func checkLimbBits(limbBits int) error {
+	if limbBits < 1 || limbBits >= fr.Bits {
+		return ErrLimbSize
+	}
+	return nil
+}
@@ -33,8 +33,10 @@ const maxSizeBitReverse = 1 << 23
 
 var bitReverse = []bitReverseVariant{
 	{name: "bitReverseNaive", buf: make([]fr.Element, maxSizeBitReverse), fn: bitReverseNaive},
-	{name: "BitReverse", buf: make([]fr.Element, maxSizeBitReverse), fn: BitReverse},
+	{name: "BitReverse", buf: make([]fr.Element, maxSizeBitReverse), fn: func(v []fr.Element) { BitReverse(v) }},
 	{name: "bitReverseCobraInPlace", buf: make([]fr.Element, maxSizeBitReverse), fn: bitReverseCobraInPlace},
+	{name: "bitReverseNaiveParallel", buf: make([]fr.Element, maxSizeBitReverse), fn: func(v []fr.Element) { bitReverseNaiveParallel(v, 4) }},
+	{name: "bitReverseCobraParallel", buf: make([]fr.Element, maxSizeBitReverse), fn: func(v []fr.Element) { bitReverseCobraParallel(v, 4) }},
 }
 
 func TestBitReverse(t *testing.T) {
@@ -18,6 +18,7 @@ package fr
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"github.com/stretchr/testify/require"
 	"reflect"
 	"sort"
@@ -37,6 +38,18 @@ func TestVectorSort(t *testing.T) {
 	assert.Equal("[1,2,3]", v.String())
 }
 
+func TestVectorSetRandom(t *testing.T) {
+	v := make(Vector, 4).MustSetRandom()
+	if v.Len() != 4 {
+		t.Fatal("MustSetRandom should return the vector")
+	}
+	for i := 1; i < len(v); i++ {
+		if v[i].Equal(&v[0]) {
+			t.Fatal("the elements should be independent")
+		}
+	}
+}
+
 func TestVectorRoundTrip(t *testing.T) {
 	assert := require.New(t)
 
@@ -80,6 +93,84 @@ func TestVectorEmptyRoundTrip(t *testing.T) {
 	assert.True(reflect.DeepEqual(v3, v2))
 }
 
+func TestVectorHash(t *testing.T) {
+	assert := require.New(t)
+
+	// sizes around the block size
+	for _, size := range []int{0, 1, vectorHashBlockSize, 3*vectorHashBlockSize + 5} {
+		v := make(Vector, size)
+		for i := range v {
+			v[i].SetRandom()
+		}
+
+		h := sha256.New()
+		v.Hash(h)
+
+		var buf bytes.Buffer
+		_, err := v.WriteTo(&buf)
+		assert.NoError(err)
+		expected := sha256.Sum256(buf.Bytes())
+		assert.Equal(expected[:], h.Sum(nil), "size %d", size)
+
+		allocs := testing.AllocsPerRun(10, func() {
+			h.Reset()
+			v.Hash(h)
+		})
+		assert.Equal(0.0, allocs, "Hash must not allocate")
+	}
+}
+
+func TestVectorMerkleRoot(t *testing.T) {
+	assert := require.New(t)
+
+	h := sha256.New()
+	leafHash := func(v Vector) []byte {
+		var buf bytes.Buffer
+		for i := range v {
+			b := v[i].Bytes()
+			buf.Write(b[:])
+		}
+		res := sha256.Sum256(buf.Bytes())
+		return res[:]
+	}
+	nodeHash := func(a, b []byte) []byte {
+		res := sha256.Sum256(append(append([]byte{}, a...), b...))
+		return res[:]
+	}
+
+	v := make(Vector, 2*vectorHashBlockSize+3)
+	for i := range v {
+		v[i].SetRandom()
+	}
+
+	// a single leaf
+	root, err := v.MerkleRoot(h, len(v))
+	assert.NoError(err)
+	assert.Equal(leafHash(v), root)
+
+	// 3 leaves, the last one shorter and promoted
+	const leafSize = vectorHashBlockSize + 1
+	root, err = v.MerkleRoot(h, leafSize)
+	assert.NoError(err)
+	expected := nodeHash(nodeHash(leafHash(v[:leafSize]), leafHash(v[leafSize:2*leafSize])), leafHash(v[2*leafSize:]))
+	assert.Equal(expected, root)
+
+	// 5 leaves
+	root, err = v[:5].MerkleRoot(h, 1)
+	assert.NoError(err)
+	l := make([][]byte, 5)
+	for i := range l {
+		l[i] = leafHash(v[i : i+1])
+	}
+	expected = nodeHash(nodeHash(nodeHash(l[0], l[1]), nodeHash(l[2], l[3])), l[4])
+	assert.Equal(expected, root)
+
+	_, err = v.MerkleRoot(h, 0)
+	assert.Error(err)
+	_, err = Vector{}.MerkleRoot(h, 1)
+	assert.Error(err)
+}
+
 func (vector *Vector) unmarshalBinaryAsync(data []byte) error {
 	r := bytes.NewReader(data)
 	_, err, chErr := vector.AsyncReadFrom(r)
@@ -709,6 +709,22 @@ func TestOps(t *testing.T) {
 		genS1,
 	))
 
+	properties.Property("blinded scalar multiplication should match the scalar multiplication", prop.ForAll(
+		func(s big.Int) bool {
+
+			params := GetEdwardsCurve()
+
+			var p1, p2 PointAffine
+			p1.ScalarMultiplication(&params.Base, &s)
+			if _, err := p2.ScalarMultiplicationBlinded(&params.Base, &s); err != nil {
+				return false
+			}
+
+			return p1.Equal(&p2)
+		},
+		genS1,
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 
 }
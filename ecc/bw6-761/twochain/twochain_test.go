@@ -0,0 +1,202 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package twochain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377"
+	innerfp "github.com/consensys/gnark-crypto/ecc/bls12-377/fp"
+	innerfr "github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/ecc/bw6-761/fr"
+)
+
+// randomPoints returns random points of G1 and G2 of bls12-377
+func randomPoints() (bls12377.G1Affine, bls12377.G2Affine) {
+	_, _, g1, g2 := bls12377.Generators()
+	var s innerfr.Element
+	s.SetRandom()
+	var k big.Int
+	s.BigInt(&k)
+	g1.ScalarMultiplication(&g1, &k)
+	g2.ScalarMultiplication(&g2, &k)
+	return g1, g2
+}
+
+func TestFp(t *testing.T) {
+	t.Parallel()
+
+	if innerfp.Modulus().Cmp(fr.Modulus()) != 0 {
+		t.Fatal("the base field of bls12-377 is not the scalar field of bw6-761")
+	}
+	for i := 0; i < 10; i++ {
+		var x innerfp.Element
+		x.SetRandom()
+		y := FromFp(&x)
+		if x.String() != y.String() {
+			t.Fatal("FromFp doesn't preserve the value")
+		}
+		if z := ToFp(&y); !z.Equal(&x) {
+			t.Fatal("ToFp(FromFp(x)) != x")
+		}
+	}
+}
+
+func TestG1(t *testing.T) {
+	t.Parallel()
+
+	p, _ := randomPoints()
+	q, err := ToG1(FromG1(&p))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !q.Equal(&p) {
+		t.Fatal("ToG1(FromG1(p)) != p")
+	}
+
+	var infinity bls12377.G1Affine
+	if q, err = ToG1(FromG1(&infinity)); err != nil || !q.IsInfinity() {
+		t.Fatal("the point at infinity doesn't round trip")
+	}
+
+	coords := FromG1(&p)
+	coords[0].Add(&coords[0], new(fr.Element).SetOne())
+	if _, err := ToG1(coords); err != ErrNotOnCurve {
+		t.Fatal("expected ErrNotOnCurve, got", err)
+	}
+}
+
+func TestG2(t *testing.T) {
+	t.Parallel()
+
+	_, p := randomPoints()
+	q, err := ToG2(FromG2(&p))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !q.Equal(&p) {
+		t.Fatal("ToG2(FromG2(p)) != p")
+	}
+
+	var infinity bls12377.G2Affine
+	if q, err = ToG2(FromG2(&infinity)); err != nil || !q.IsInfinity() {
+		t.Fatal("the point at infinity doesn't round trip")
+	}
+
+	coords := FromG2(&p)
+	coords[0].Add(&coords[0], new(fr.Element).SetOne())
+	if _, err := ToG2(coords); err != ErrNotOnCurve {
+		t.Fatal("expected ErrNotOnCurve, got", err)
+	}
+}
+
+func TestGT(t *testing.T) {
+	t.Parallel()
+
+	p, q := randomPoints()
+	z, err := bls12377.Pair([]bls12377.G1Affine{p}, []bls12377.G2Affine{q})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_z, err := ToGT(FromGT(&z))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !_z.Equal(&z) {
+		t.Fatal("ToGT(FromGT(z)) != z")
+	}
+
+	var r bls12377.GT
+	r.SetRandom()
+	if _, err := ToGT(FromGT(&r)); err != ErrNotInSubGroup {
+		t.Fatal("expected ErrNotInSubGroup, got", err)
+	}
+}
+
+func TestFr(t *testing.T) {
+	t.Parallel()
+
+	for _, limbBits := range []int{1, 64, 120, fr.Bits - 1} {
+		for i := 0; i < 10; i++ {
+			var x innerfr.Element
+			x.SetRandom()
+			limbs, err := FromFr(&x, limbBits)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(limbs) != NbFrLimbs(limbBits) {
+				t.Fatal("wrong number of limbs")
+			}
+			y, err := ToFr(limbs, limbBits)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !y.Equal(&x) {
+				t.Fatalf("ToFr(FromFr(x)) != x with %d-bit limbs", limbBits)
+			}
+		}
+	}
+
+	// the order of bls12-377 is not a scalar
+	limbs, err := DecomposeLimbs(innerfr.Modulus(), 64, NbFrLimbs(64))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ToFr(limbs, 64); err != ErrValueOverflow {
+		t.Fatal("expected ErrValueOverflow, got", err)
+	}
+}
+
+func TestLimbs(t *testing.T) {
+	t.Parallel()
+
+	v := new(big.Int).Lsh(big.NewInt(1), 100)
+	v.Sub(v, big.NewInt(1))
+	limbs, err := DecomposeLimbs(v, 10, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range limbs {
+		if !limbs[i].IsUint64() || limbs[i].Uint64() != 1<<10-1 {
+			t.Fatal("wrong limb")
+		}
+	}
+	w, err := RecomposeLimbs(limbs, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.Cmp(v) != 0 {
+		t.Fatal("RecomposeLimbs(DecomposeLimbs(v)) != v")
+	}
+
+	if _, err := DecomposeLimbs(v, 10, 9); err != ErrValueOverflow {
+		t.Fatal("expected ErrValueOverflow, got", err)
+	}
+	if _, err := DecomposeLimbs(big.NewInt(-1), 10, 10); err != ErrValueOverflow {
+		t.Fatal("expected ErrValueOverflow, got", err)
+	}
+	for _, limbBits := range []int{0, fr.Bits} {
+		if _, err := DecomposeLimbs(v, limbBits, 10); err != ErrLimbSize {
+			t.Fatal("expected ErrLimbSize, got", err)
+		}
+	}
+	limbs[3].SetUint64(1 << 10)
+	if _, err := RecomposeLimbs(limbs, 10); err != ErrLimbOverflow {
+		t.Fatal("expected ErrLimbOverflow, got", err)
+	}
+}
@@ -0,0 +1,75 @@
+package ecc
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/consensys/gnark-crypto/field/codepath"
+	"golang.org/x/sys/cpu"
+)
+
+// Features is a report of the CPU features detected at runtime and of the code paths
+// they select in gnark-crypto. It helps checking that a deployed binary runs the
+// accelerated paths, and should be attached to performance bug reports.
+type Features struct {
+	GOARCH string // runtime.GOARCH
+
+	// amd64
+	ADX     bool // ADCX / ADOX
+	BMI2    bool // MULX
+	AVX512F bool
+
+	// arm64
+	NEON bool // Advanced SIMD (ASIMD)
+
+	// build tags
+	PureGo bool // built with the purego tag: no assembly is used
+	NoAdx  bool // built with the noadx tag: the field assembly doesn't use ADX / BMI2
+
+	// CodePaths maps the import paths of the packages linked in the binary which have
+	// alternative implementations (the fields, field/goldilocks/fft) to the one they selected
+	CodePaths map[string]string
+}
+
+// CPUFeatures returns the CPU features detected at runtime and the code paths selected
+// accordingly. The code paths are the ones the packages registered when they selected them
+// at init time (see field/codepath): a field without assembly, e.g. curve25519/fp, reports
+// generic Go on every architecture, and the packages which aren't linked in the binary are
+// absent.
+func CPUFeatures() Features {
+	return Features{
+		GOARCH:    runtime.GOARCH,
+		ADX:       cpu.X86.HasADX,
+		BMI2:      cpu.X86.HasBMI2,
+		AVX512F:   cpu.X86.HasAVX512F,
+		NEON:      cpu.ARM64.HasASIMD,
+		PureGo:    buildPureGo,
+		NoAdx:     buildNoAdx,
+		CodePaths: codepath.All(),
+	}
+}
+
+// String returns a human readable version of the report, one item per line.
+func (f Features) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "GOARCH: %s\n", f.GOARCH)
+	switch f.GOARCH {
+	case "amd64":
+		fmt.Fprintf(&sb, "ADX: %t\nBMI2: %t\nAVX-512F: %t\n", f.ADX, f.BMI2, f.AVX512F)
+	case "arm64":
+		fmt.Fprintf(&sb, "NEON: %t\n", f.NEON)
+	}
+	fmt.Fprintf(&sb, "purego: %t\nnoadx: %t\n", f.PureGo, f.NoAdx)
+
+	packages := make([]string, 0, len(f.CodePaths))
+	for p := range f.CodePaths {
+		packages = append(packages, p)
+	}
+	sort.Strings(packages)
+	for _, p := range packages {
+		fmt.Fprintf(&sb, "%s: %s\n", p, f.CodePaths[p])
+	}
+	return sb.String()
+}
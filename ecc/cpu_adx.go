@@ -0,0 +1,6 @@
+//go:build !noadx
+// +build !noadx
+
+package ecc
+
+const buildNoAdx = false
@@ -0,0 +1,6 @@
+//go:build purego
+// +build purego
+
+package ecc
+
+const buildPureGo = true
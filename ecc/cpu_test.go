@@ -0,0 +1,54 @@
+package ecc
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+
+	_ "github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	_ "github.com/consensys/gnark-crypto/ecc/curve25519/fp"
+	_ "github.com/consensys/gnark-crypto/field/goldilocks/fft"
+)
+
+func TestCPUFeatures(t *testing.T) {
+	t.Parallel()
+
+	const (
+		bn254Fp      = "github.com/consensys/gnark-crypto/ecc/bn254/fp"
+		curve25519Fp = "github.com/consensys/gnark-crypto/ecc/curve25519/fp"
+		goldilocks   = "github.com/consensys/gnark-crypto/field/goldilocks/fft"
+	)
+
+	f := CPUFeatures()
+	if f.GOARCH != runtime.GOARCH {
+		t.Fatal("unexpected GOARCH")
+	}
+	for _, p := range []string{bn254Fp, curve25519Fp, goldilocks} {
+		path, ok := f.CodePaths[p]
+		if !ok {
+			t.Fatalf("missing code path for %s", p)
+		}
+		if f.GOARCH != "amd64" || f.PureGo {
+			if path != "generic Go" {
+				t.Fatalf("%s: assembly can't be selected on %s (purego: %t)", p, f.GOARCH, f.PureGo)
+			}
+		}
+	}
+
+	// curve25519/fp has no assembly
+	if f.CodePaths[curve25519Fp] != "generic Go" {
+		t.Fatal("curve25519/fp should run generic Go")
+	}
+	if f.GOARCH == "amd64" && !f.PureGo {
+		expected := "amd64 assembly (without ADX)"
+		if !f.NoAdx && f.ADX && f.BMI2 {
+			expected = "amd64 assembly (ADX, BMI2)"
+		}
+		if f.CodePaths[bn254Fp] != expected {
+			t.Fatalf("bn254/fp: expected %s, got %s", expected, f.CodePaths[bn254Fp])
+		}
+	}
+	if !strings.Contains(f.String(), bn254Fp+": ") {
+		t.Fatal("report should list the code paths")
+	}
+}
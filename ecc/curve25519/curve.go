@@ -0,0 +1,124 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package curve25519
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fp"
+)
+
+// CurveParams curve parameters: ax^2 + y^2 = 1 + d*x^2*y^2
+type CurveParams struct {
+	A, D     fp.Element
+	Cofactor fp.Element
+	Order    big.Int
+	Base     PointAffine
+}
+
+// GetEdwardsCurve returns the twisted Edwards form of Curve25519
+func GetEdwardsCurve() CurveParams {
+	initOnce.Do(initCurveParams)
+	// copy to keep Order private
+	var res CurveParams
+
+	res.A.Set(&curveParams.A)
+	res.D.Set(&curveParams.D)
+	res.Cofactor.Set(&curveParams.Cofactor)
+	res.Order.Set(&curveParams.Order)
+	res.Base.Set(&curveParams.Base)
+
+	return res
+}
+
+var (
+	initOnce    sync.Once
+	curveParams CurveParams
+
+	// sqrtM1 is a square root of -1 in fp, the positive one (see IsNegative)
+	sqrtM1 fp.Element
+)
+
+func initCurveParams() {
+	curveParams.A.SetString("-1")
+	curveParams.D.SetString("37095705934669439343138083508754565189542113879843219016388785533085940283555")
+	curveParams.Cofactor.SetString("8")
+	curveParams.Order.SetString("7237005577332262213973186563042994240857116359379907606001950938285454250989", 10)
+
+	curveParams.Base.X.SetString("15112221349535400772501151409588531511454012693041857206046113283949847762202")
+	curveParams.Base.Y.SetString("46316835694926478169428394003475163141307993866256225615783033603165251855960")
+
+	sqrtM1.SetString("19681161376707505956807079304988542015446066515923890162744021073123829784752")
+}
+
+// mulByA multiplies fp.Element by curveParams.A
+func mulByA(x *fp.Element) {
+	x.Neg(x)
+}
+
+// IsNegative returns true if the canonical little-endian encoding of x is odd,
+// following https://www.rfc-editor.org/rfc/rfc9496#section-4.1
+func IsNegative(x *fp.Element) bool {
+	return x.Bits()[0]&1 == 1
+}
+
+// SqrtM1 returns the positive square root of -1 in fp
+func SqrtM1() fp.Element {
+	initOnce.Do(initCurveParams)
+	return sqrtM1
+}
+
+// SqrtRatio returns (true, +√(u/v)) if u/v is a square, (true, 0) if u is zero,
+// (false, 0) if v is zero and u is not, and (false, +√(√-1⋅u/v)) otherwise,
+// where +√ denotes the non-negative root (see IsNegative).
+//
+// This is SQRT_RATIO_M1 from https://www.rfc-editor.org/rfc/rfc9496#section-4.2,
+// which computes the result with a single exponentiation.
+func SqrtRatio(u, v *fp.Element) (bool, fp.Element) {
+	initOnce.Do(initCurveParams)
+	var v3, v7, r, check, uNeg, uNegI, rPrime fp.Element
+	v3.Square(v).Mul(&v3, v)
+	v7.Square(&v3).Mul(&v7, v)
+
+	// r = (u⋅v³)⋅(u⋅v⁷)^((p-5)/8)
+	r.Mul(u, &v7)
+	r.Exp(r, sqrtRatioExponent)
+	r.Mul(&r, u).Mul(&r, &v3)
+
+	check.Square(&r).Mul(&check, v)
+	uNeg.Neg(u)
+	uNegI.Mul(&uNeg, &sqrtM1)
+
+	correctSign := check.Equal(u)
+	flippedSign := check.Equal(&uNeg)
+	flippedSignI := check.Equal(&uNegI)
+
+	rPrime.Mul(&r, &sqrtM1)
+	if flippedSign || flippedSignI {
+		r = rPrime
+	}
+	if IsNegative(&r) {
+		r.Neg(&r)
+	}
+	return correctSign || flippedSign, r
+}
+
+// sqrtRatioExponent = (p-5)/8
+var sqrtRatioExponent = func() *big.Int {
+	e := fp.Modulus()
+	e.Sub(e, big.NewInt(5)).Rsh(e, 3)
+	return e
+}()
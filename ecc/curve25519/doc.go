@@ -0,0 +1,30 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package curve25519 provides the twisted Edwards form of Curve25519 (edwards25519):
+//
+//	-x² + y² = 1 + d⋅x²⋅y², d = -121665/121666
+//
+// defined over GF(2²⁵⁵-19). The curve has order 8⋅ℓ, where ℓ is the modulus of the
+// scalar field fr.
+//
+// The arithmetic mirrors the twisted Edwards companion curves of gnark-crypto (affine and
+// extended coordinates); the prime-order group ristretto255 is built on top of it, in the
+// ristretto255 sub-package, and the Ed25519 signature scheme in the ed25519 sub-package.
+//
+// # Warning
+//
+// This code has not been audited and is provided as-is. In particular, there is no security
+// guarantees such as constant time implementation or side-channel attack resistance.
+package curve25519
@@ -0,0 +1,83 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/curve25519"
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fr"
+)
+
+var errBatchSize = errors.New("the number of public keys, messages and signatures differ")
+
+// BatchVerify verifies the signatures sigs[i] of messages[i] under publicKeys[i].
+//
+// For random weights zᵢ, it checks with a single multi-scalar multiplication that
+//
+//	[8](∑ zᵢ(Rᵢ + [kᵢ]Aᵢ) - [∑ zᵢSᵢ]B) = 0
+//
+// It returns false if one of the signatures is invalid: as Verify checks the cofactored
+// equation, BatchVerify accepts a batch if and only if Verify accepts each of its signatures
+// (up to the negligible probability of the random weights).
+func BatchVerify(publicKeys []PublicKey, messages, sigs [][]byte) (bool, error) {
+	n := len(publicKeys)
+	if len(messages) != n || len(sigs) != n {
+		return false, errBatchSize
+	}
+	if n == 0 {
+		return true, nil
+	}
+
+	// points = [B, R₁, …, Rₙ, A₁, …, Aₙ]
+	points := make([]curve25519.PointAffine, 2*n+1)
+	scalars := make([]*big.Int, 2*n+1)
+	points[0] = base
+
+	var sumZS fr.Element
+	for i := range sigs {
+		var sig Signature
+		if _, err := sig.SetBytes(sigs[i]); err != nil {
+			return false, err
+		}
+		S, err := fr.LittleEndian.Element(&sig.S)
+		if err != nil {
+			return false, err
+		}
+		k := challenge(&sig.R, &publicKeys[i].A, messages[i])
+
+		var z, tmp fr.Element
+		if _, err := z.SetRandom(); err != nil {
+			return false, err
+		}
+		points[1+i] = sig.R
+		points[1+n+i] = publicKeys[i].A
+		scalars[1+i] = z.BigInt(new(big.Int))
+		scalars[1+n+i] = tmp.Mul(&z, &k).BigInt(new(big.Int))
+
+		tmp.Mul(&z, &S)
+		sumZS.Add(&sumZS, &tmp)
+	}
+	sumZS.Neg(&sumZS)
+	scalars[0] = sumZS.BigInt(new(big.Int))
+
+	var res curve25519.PointExtended
+	if _, err := res.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return false, err
+	}
+	return clearCofactor(&res).IsZero(), nil
+}
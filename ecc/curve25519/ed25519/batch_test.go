@@ -0,0 +1,128 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519
+
+import (
+	stded25519 "crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/curve25519"
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fr"
+)
+
+func batch(t testing.TB, n int) ([]PublicKey, [][]byte, [][]byte) {
+	publicKeys := make([]PublicKey, n)
+	messages := make([][]byte, n)
+	sigs := make([][]byte, n)
+	for i := 0; i < n; i++ {
+		privKey, err := GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		publicKeys[i] = privKey.PublicKey
+		messages[i] = []byte(fmt.Sprintf("message %d", i))
+		if sigs[i], err = privKey.Sign(messages[i], nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return publicKeys, messages, sigs
+}
+
+func TestBatchVerify(t *testing.T) {
+	t.Parallel()
+
+	publicKeys, messages, sigs := batch(t, 10)
+	if ok, err := BatchVerify(publicKeys, messages, sigs); err != nil || !ok {
+		t.Fatal("valid batch rejected")
+	}
+	if ok, err := BatchVerify(nil, nil, nil); err != nil || !ok {
+		t.Fatal("empty batch rejected")
+	}
+	if _, err := BatchVerify(publicKeys, messages[1:], sigs); err != errBatchSize {
+		t.Fatal("expected errBatchSize, got", err)
+	}
+
+	// wrong message
+	messages[3] = []byte("another message")
+	if ok, _ := BatchVerify(publicKeys, messages, sigs); ok {
+		t.Fatal("invalid batch accepted")
+	}
+}
+
+func TestBatchVerifySmallOrder(t *testing.T) {
+	t.Parallel()
+
+	// a signature whose R has a component of order 2 (R' = R + (0, -1)) is rejected by the
+	// cofactorless check of crypto/ed25519, but accepted by Verify, and so by BatchVerify
+	publicKeys, messages, sigs := batch(t, 3)
+
+	privKey, err := GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := []byte("small order component")
+
+	var torsion, R curve25519.PointAffine
+	torsion.Y.SetOne().Neg(&torsion.Y)
+	var r fr.Element
+	r.SetRandom()
+	var rBig big.Int
+	R.ScalarMultiplication(&base, r.BigInt(&rBig))
+	var rExt, tExt curve25519.PointExtended
+	rExt.FromAffine(&R)
+	tExt.FromAffine(&torsion)
+	R.FromExtended(rExt.Add(&rExt, &tExt))
+
+	k := challenge(&R, &privKey.PublicKey.A, message)
+	var sig Signature
+	sig.R = R
+	var S fr.Element
+	S.Mul(&k, &privKey.scalar).Add(&S, &r)
+	fr.LittleEndian.PutElement(&sig.S, S)
+
+	if ok, err := privKey.PublicKey.Verify(sig.Bytes(), message, nil); err != nil || !ok {
+		t.Fatal("Verify should accept the signature")
+	}
+	if stded25519.Verify(privKey.PublicKey.Bytes(), message, sig.Bytes()) {
+		t.Fatal("crypto/ed25519 should reject the signature")
+	}
+	publicKeys = append(publicKeys, privKey.PublicKey)
+	messages = append(messages, message)
+	sigs = append(sigs, sig.Bytes())
+	if ok, err := BatchVerify(publicKeys, messages, sigs); err != nil || !ok {
+		t.Fatal("BatchVerify should accept the batch")
+	}
+}
+
+func BenchmarkBatchVerify(b *testing.B) {
+	const n = 64
+	publicKeys, messages, sigs := batch(b, n)
+
+	b.Run("BatchVerify", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = BatchVerify(publicKeys, messages, sigs)
+		}
+	})
+	b.Run("Verify", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for j := range sigs {
+				_, _ = publicKeys[j].Verify(sigs[j], messages[j], nil)
+			}
+		}
+	})
+}
@@ -0,0 +1,32 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ed25519 implements the Ed25519 signature scheme of
+// https://www.rfc-editor.org/rfc/rfc8032#section-5.1, on the twisted Edwards form of
+// Curve25519 with SHA-512.
+//
+// Keys and signatures use the encodings of RFC 8032, and are interoperable with the standard
+// library's crypto/ed25519: a private key is the 32-byte seed followed by the public key.
+//
+// Verify and BatchVerify check the cofactored equation [8][S]B = [8]R + [8][k]A, so that a batch
+// of signatures is accepted if and only if each of its signatures is (see
+// https://eprint.iacr.org/2020/1244). crypto/ed25519 checks the cofactorless equation; both
+// agree on the signatures produced by Sign, and only differ on adversarial signatures whose R or
+// A have a small-order component.
+//
+// # Warning
+//
+// This code has not been audited and is provided as-is. In particular, there is no security
+// guarantees such as constant time implementation or side-channel attack resistance.
+package ed25519
@@ -0,0 +1,222 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519
+
+import (
+	"crypto/sha512"
+	"crypto/subtle"
+	"errors"
+	"hash"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/curve25519"
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fr"
+	"github.com/consensys/gnark-crypto/signature"
+)
+
+const (
+	// SeedSize is the size in bytes of the seed a private key is derived from
+	SeedSize = 32
+
+	// PublicKeySize is the size in bytes of an encoded public key
+	PublicKeySize = curve25519.SizePointCompressed
+
+	// PrivateKeySize is the size in bytes of an encoded private key: seed||publicKey
+	PrivateKeySize = SeedSize + PublicKeySize
+
+	// SignatureSize is the size in bytes of an encoded signature: R||S
+	SignatureSize = curve25519.SizePointCompressed + fr.Bytes
+)
+
+var (
+	errHashFunc          = errors.New("Ed25519 hashes with SHA-512: hFunc must be nil")
+	errWrongSize         = errors.New("wrong size buffer")
+	errSBiggerThanRMod   = errors.New("s >= r_mod")
+	errPublicKeyMismatch = errors.New("the public key doesn't match the seed")
+)
+
+// PublicKey represents an Ed25519 public key
+type PublicKey struct {
+	A curve25519.PointAffine
+}
+
+// PrivateKey represents an Ed25519 private key
+type PrivateKey struct {
+	PublicKey PublicKey
+	seed      [SeedSize]byte
+	scalar    fr.Element // secret scalar s, derived from the seed
+	prefix    [32]byte   // second half of SHA-512(seed), to derive the nonces
+}
+
+// Signature represents an Ed25519 signature
+type Signature struct {
+	R curve25519.PointAffine
+	S [fr.Bytes]byte // in little endian
+}
+
+// GenerateKey generates a public and private key pair from a random seed read from r.
+func GenerateKey(r io.Reader) (*PrivateKey, error) {
+	var seed [SeedSize]byte
+	if _, err := io.ReadFull(r, seed[:]); err != nil {
+		return nil, err
+	}
+	return NewKeyFromSeed(seed[:])
+}
+
+// NewKeyFromSeed derives the private key from a 32-byte seed, following
+// https://www.rfc-editor.org/rfc/rfc8032#section-5.1.5
+func NewKeyFromSeed(seed []byte) (*PrivateKey, error) {
+	if len(seed) != SeedSize {
+		return nil, errWrongSize
+	}
+	var priv PrivateKey
+	copy(priv.seed[:], seed)
+
+	h := sha512.Sum512(seed)
+	copy(priv.prefix[:], h[32:])
+
+	// prune the key
+	h[0] &= 0xF8
+	h[31] &= 0x7F
+	h[31] |= 0x40
+
+	// the pruned scalar is a multiple of the cofactor smaller than 2²⁵⁵; as the base point is in
+	// the prime subgroup, it can be reduced modulo ℓ
+	priv.scalar = scalarFromLE(h[:32])
+
+	var s big.Int
+	priv.scalar.BigInt(&s)
+	priv.PublicKey.A.ScalarMultiplication(&base, &s)
+
+	return &priv, nil
+}
+
+// Seed returns the seed the private key is derived from
+func (privKey *PrivateKey) Seed() []byte {
+	res := make([]byte, SeedSize)
+	copy(res, privKey.seed[:])
+	return res
+}
+
+// Equal compares 2 public keys
+func (pub *PublicKey) Equal(x signature.PublicKey) bool {
+	xx, ok := x.(*PublicKey)
+	if !ok {
+		return false
+	}
+	bpk := pub.Bytes()
+	bxx := xx.Bytes()
+	return subtle.ConstantTimeCompare(bpk, bxx) == 1
+}
+
+// Public returns the public key associated to the private key.
+func (privKey *PrivateKey) Public() signature.PublicKey {
+	var pub PublicKey
+	pub.A.Set(&privKey.PublicKey.A)
+	return &pub
+}
+
+// Sign signs message with the private key, following
+// https://www.rfc-editor.org/rfc/rfc8032#section-5.1.6
+//
+// r = SHA-512(prefix||M) (mod ℓ)
+// R = [r]B
+// k = SHA-512(R||A||M) (mod ℓ)
+// S = r + k⋅s (mod ℓ)
+// signature = R||S
+//
+// Ed25519 hashes the message with SHA-512: hFunc must be nil.
+func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error) {
+	if hFunc != nil {
+		return nil, errHashFunc
+	}
+
+	h := sha512.New()
+	h.Write(privKey.prefix[:])
+	h.Write(message)
+	r := scalarFromLE(h.Sum(nil))
+
+	var sig Signature
+	var rBig big.Int
+	sig.R.ScalarMultiplication(&base, r.BigInt(&rBig))
+
+	k := challenge(&sig.R, &privKey.PublicKey.A, message)
+	var S fr.Element
+	S.Mul(&k, &privKey.scalar).Add(&S, &r)
+	fr.LittleEndian.PutElement(&sig.S, S)
+
+	return sig.Bytes(), nil
+}
+
+// Verify verifies the signature sigBin of message under the public key, following
+// https://www.rfc-editor.org/rfc/rfc8032#section-5.1.7 with the cofactored equation
+//
+// [8][S]B = [8]R + [8][k]A, k = SHA-512(R||A||M) (mod ℓ)
+//
+// Ed25519 hashes the message with SHA-512: hFunc must be nil.
+func (pub *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, error) {
+	if hFunc != nil {
+		return false, errHashFunc
+	}
+	var sig Signature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		return false, err
+	}
+
+	k := challenge(&sig.R, &pub.A, message)
+	S, err := fr.LittleEndian.Element(&sig.S)
+	if err != nil {
+		return false, err
+	}
+
+	// [S]B - [k]A - R
+	var sB, kA, R curve25519.PointExtended
+	var bs big.Int
+	sB.FromAffine(&base).ScalarMultiplication(&sB, S.BigInt(&bs))
+	kA.FromAffine(&pub.A).ScalarMultiplication(&kA, k.BigInt(&bs))
+	R.FromAffine(&sig.R)
+	sB.Add(&sB, kA.Neg(&kA)).Add(&sB, R.Neg(&R))
+
+	return clearCofactor(&sB).IsZero(), nil
+}
+
+var base = curve25519.GetEdwardsCurve().Base
+
+// challenge returns k = SHA-512(R||A||message) (mod ℓ)
+func challenge(R, A *curve25519.PointAffine, message []byte) fr.Element {
+	bR, bA := R.Bytes(), A.Bytes()
+	h := sha512.New()
+	h.Write(bR[:])
+	h.Write(bA[:])
+	h.Write(message)
+	return scalarFromLE(h.Sum(nil))
+}
+
+// scalarFromLE returns the little-endian integer b reduced modulo ℓ
+func scalarFromLE(b []byte) fr.Element {
+	be := make([]byte, len(b))
+	for i := range b {
+		be[len(b)-1-i] = b[i]
+	}
+	var res fr.Element
+	res.SetBytes(be)
+	return res
+}
+
+// clearCofactor sets p to [8]p and returns it
+func clearCofactor(p *curve25519.PointExtended) *curve25519.PointExtended {
+	return p.Double(p).Double(p).Double(p)
+}
@@ -0,0 +1,243 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519
+
+import (
+	"bytes"
+	stded25519 "crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/curve25519"
+)
+
+// test vectors of https://www.rfc-editor.org/rfc/rfc8032#section-7.1
+var rfc8032Vectors = []struct {
+	seed, publicKey, message, signature string
+}{
+	{
+		seed:      "9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60",
+		publicKey: "d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a",
+		message:   "",
+		signature: "e5564300c360ac729086e2cc806e828a84877f1eb8e5d974d873e065224901555fb8821590a33bacc61e39701cf9b46bd25bf5f0595bbe24655141438e7a100b",
+	},
+	{
+		seed:      "4ccd089b28ff96da9db6c346ec114e0f5b8a319f35aba624da8cf6ed4fb8a6fb",
+		publicKey: "3d4017c3e843895a92b70aa74d1b7ebc9c982ccf2ec4968cc0cd55f12af4660c",
+		message:   "72",
+		signature: "92a009a9f0d4cab8720e820b5f642540a2b27b5416503f8fb3762223ebdb69da085ac1e43e15996e458f3613d0f11d8c387b2eaeb4302aeeb00d291612bb0c00",
+	},
+	{
+		seed:      "c5aa8df43f9f837bedb7442f31dcb7b166d38535076f094b85ce3a2e0b4458f7",
+		publicKey: "fc51cd8e6218a1a38da47ed00230f0580816ed13ba3303ac5deb911548908025",
+		message:   "af82",
+		signature: "6291d657deec24024827e69c3abe01a30ce548a284743a445e3680d7db5ac3ac18ff9b538d16f290ae67f760984dc6594a7c15e9716ed28dc027beceea1ec40a",
+	},
+	{
+		seed:      "833fe62409237b9d62ec77587520911e9a759cec1d19755b7da901b96dca3d42",
+		publicKey: "ec172b93ad5e563bf4932c70e1245034c35467ef2efd4d64ebf819683467e2bf",
+		message:   "ddaf35a193617abacc417349ae20413112e6fa4e89a97ea20a9eeee64b55d39a2192992a274fc1a836ba3c23a3feebbd454d4423643ce80e2a9ac94fa54ca49f",
+		signature: "dc2a4459e7369633a52b1bf277839a00201009a3efbf3ecb69bea2186c26b58909351fc9ac90b3ecfdfbc7c66431e0303dca179c138ac17ad9bef1177331a704",
+	},
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestRFC8032Vectors(t *testing.T) {
+	t.Parallel()
+
+	for i, v := range rfc8032Vectors {
+		seed, publicKey := decodeHex(t, v.seed), decodeHex(t, v.publicKey)
+		message, expectedSig := decodeHex(t, v.message), decodeHex(t, v.signature)
+
+		privKey, err := NewKeyFromSeed(seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(privKey.PublicKey.Bytes(), publicKey) {
+			t.Fatalf("vector %d: wrong public key", i)
+		}
+		sig, err := privKey.Sign(message, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(sig, expectedSig) {
+			t.Fatalf("vector %d: wrong signature", i)
+		}
+
+		var pub PublicKey
+		if _, err := pub.SetBytes(publicKey); err != nil {
+			t.Fatal(err)
+		}
+		if ok, err := pub.Verify(expectedSig, message, nil); err != nil || !ok {
+			t.Fatalf("vector %d: the signature is rejected", i)
+		}
+		message = append(message, 0)
+		if ok, _ := pub.Verify(expectedSig, message, nil); ok {
+			t.Fatalf("vector %d: the signature of another message is accepted", i)
+		}
+	}
+}
+
+func TestStdlib(t *testing.T) {
+	t.Parallel()
+
+	for i := 0; i < 10; i++ {
+		stdPub, stdPriv, err := stded25519.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var privKey PrivateKey
+		if _, err := privKey.SetBytes(stdPriv); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(privKey.Bytes(), stdPriv) || !bytes.Equal(privKey.PublicKey.Bytes(), stdPub) {
+			t.Fatal("keys don't match crypto/ed25519")
+		}
+
+		message := make([]byte, i*17)
+		rand.Read(message)
+		sig, err := privKey.Sign(message, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Ed25519 signatures are deterministic
+		if !bytes.Equal(sig, stded25519.Sign(stdPriv, message)) {
+			t.Fatal("signature doesn't match crypto/ed25519")
+		}
+		if !stded25519.Verify(stdPub, message, sig) {
+			t.Fatal("crypto/ed25519 rejects the signature")
+		}
+	}
+}
+
+func TestVerifyErrors(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := []byte("testing Ed25519")
+	if _, err := privKey.Sign(message, sha256.New()); err != errHashFunc {
+		t.Fatal("expected errHashFunc, got", err)
+	}
+	sig, err := privKey.Sign(message, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub := privKey.PublicKey
+	if _, err := pub.Verify(sig, message, sha256.New()); err != errHashFunc {
+		t.Fatal("expected errHashFunc, got", err)
+	}
+	if _, err := pub.Verify(sig[:SignatureSize-1], message, nil); err != errWrongSize {
+		t.Fatal("expected errWrongSize, got", err)
+	}
+
+	// S + ℓ is a malleated signature, it must be rejected
+	var s Signature
+	if _, err := s.SetBytes(sig); err != nil {
+		t.Fatal(err)
+	}
+	malleated := append([]byte{}, sig...)
+	carry := 0
+	order := curve25519.GetEdwardsCurve().Order
+	ell := order.FillBytes(make([]byte, 32))
+	for i := 0; i < 32; i++ {
+		v := int(malleated[32+i]) + int(ell[31-i]) + carry
+		malleated[32+i], carry = byte(v), v>>8
+	}
+	if _, err := pub.Verify(malleated, message, nil); err != errSBiggerThanRMod {
+		t.Fatal("expected errSBiggerThanRMod, got", err)
+	}
+
+	// tampered signature
+	sig[40] ^= 1
+	if ok, _ := pub.Verify(sig, message, nil); ok {
+		t.Fatal("a tampered signature is accepted")
+	}
+
+	// the public key must match the seed
+	b := privKey.Bytes()
+	b[SeedSize] ^= 1
+	var _privKey PrivateKey
+	if _, err := _privKey.SetBytes(b); err != errPublicKeyMismatch {
+		t.Fatal("expected errPublicKeyMismatch, got", err)
+	}
+}
+
+func TestSerialization(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var _privKey PrivateKey
+	if n, err := _privKey.SetBytes(privKey.Bytes()); err != nil || n != PrivateKeySize {
+		t.Fatal("private key serialization failed")
+	}
+	if !bytes.Equal(_privKey.Seed(), privKey.Seed()) || !_privKey.PublicKey.Equal(privKey.Public()) {
+		t.Fatal("private key doesn't round trip")
+	}
+
+	var pub PublicKey
+	if n, err := pub.SetBytes(privKey.PublicKey.Bytes()); err != nil || n != PublicKeySize {
+		t.Fatal("public key serialization failed")
+	}
+	if !pub.Equal(&privKey.PublicKey) {
+		t.Fatal("public key doesn't round trip")
+	}
+
+	sig, err := privKey.Sign([]byte("testing Ed25519"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s Signature
+	if n, err := s.SetBytes(sig); err != nil || n != SignatureSize {
+		t.Fatal("signature serialization failed")
+	}
+	if !bytes.Equal(s.Bytes(), sig) {
+		t.Fatal("signature doesn't round trip")
+	}
+}
+
+func BenchmarkSign(b *testing.B) {
+	privKey, _ := GenerateKey(rand.Reader)
+	message := []byte("benchmarking Ed25519")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = privKey.Sign(message, nil)
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	privKey, _ := GenerateKey(rand.Reader)
+	message := []byte("benchmarking Ed25519")
+	sig, _ := privKey.Sign(message, nil)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = privKey.PublicKey.Verify(sig, message, nil)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ed25519
+
+import (
+	"crypto/subtle"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fr"
+)
+
+// Bytes returns the binary representation of the public key, the encoding of the point A
+// (https://www.rfc-editor.org/rfc/rfc8032#section-5.1.2)
+func (pk *PublicKey) Bytes() []byte {
+	res := pk.A.Bytes()
+	return res[:]
+}
+
+// SetBytes sets pk from buf[:PublicKeySize] (see Bytes), and returns the number of bytes read.
+// The point is not checked to be in the prime subgroup, see the package documentation.
+func (pk *PublicKey) SetBytes(buf []byte) (int, error) {
+	if len(buf) < PublicKeySize {
+		return 0, io.ErrShortBuffer
+	}
+	return pk.A.SetBytes(buf[:PublicKeySize])
+}
+
+// Bytes returns the binary representation of the private key seed||publicKey, as
+// crypto/ed25519.PrivateKey
+func (privKey *PrivateKey) Bytes() []byte {
+	var res [PrivateKeySize]byte
+	pubkBin := privKey.PublicKey.A.Bytes()
+	subtle.ConstantTimeCopy(1, res[:SeedSize], privKey.seed[:])
+	copy(res[SeedSize:], pubkBin[:])
+	return res[:]
+}
+
+// SetBytes sets privKey from buf[:PrivateKeySize], interpreted as seed||publicKey, and returns
+// the number of bytes read. The public key is derived from the seed and must match.
+func (privKey *PrivateKey) SetBytes(buf []byte) (int, error) {
+	if len(buf) < PrivateKeySize {
+		return 0, io.ErrShortBuffer
+	}
+	priv, err := NewKeyFromSeed(buf[:SeedSize])
+	if err != nil {
+		return 0, err
+	}
+	pubkBin := priv.PublicKey.A.Bytes()
+	if subtle.ConstantTimeCompare(pubkBin[:], buf[SeedSize:PrivateKeySize]) != 1 {
+		return 0, errPublicKeyMismatch
+	}
+	*privKey = *priv
+	return PrivateKeySize, nil
+}
+
+// Bytes returns the binary representation of sig, R||S where S is in little endian
+// (https://www.rfc-editor.org/rfc/rfc8032#section-5.1.6)
+func (sig *Signature) Bytes() []byte {
+	var res [SignatureSize]byte
+	bR := sig.R.Bytes()
+	copy(res[:len(bR)], bR[:])
+	copy(res[len(bR):], sig.S[:])
+	return res[:]
+}
+
+// SetBytes sets sig from a buffer of SignatureSize bytes, and returns the number of bytes read.
+// It rejects S ≥ ℓ and non-canonical encodings of R.
+func (sig *Signature) SetBytes(buf []byte) (int, error) {
+	if len(buf) != SignatureSize {
+		return 0, errWrongSize
+	}
+	n, err := sig.R.SetBytes(buf)
+	if err != nil {
+		return 0, err
+	}
+	copy(sig.S[:], buf[n:])
+	if _, err := fr.LittleEndian.Element(&sig.S); err != nil {
+		return 0, errSBiggerThanRMod
+	}
+	return SignatureSize, nil
+}
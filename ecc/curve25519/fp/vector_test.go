@@ -0,0 +1,181 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fp
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"github.com/stretchr/testify/require"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestVectorSort(t *testing.T) {
+	assert := require.New(t)
+
+	v := make(Vector, 3)
+	v[0].SetUint64(2)
+	v[1].SetUint64(3)
+	v[2].SetUint64(1)
+
+	sort.Sort(v)
+
+	assert.Equal("[1,2,3]", v.String())
+}
+
+func TestVectorSetRandom(t *testing.T) {
+	v := make(Vector, 4).MustSetRandom()
+	if v.Len() != 4 {
+		t.Fatal("MustSetRandom should return the vector")
+	}
+	for i := 1; i < len(v); i++ {
+		if v[i].Equal(&v[0]) {
+			t.Fatal("the elements should be independent")
+		}
+	}
+}
+
+func TestVectorRoundTrip(t *testing.T) {
+	assert := require.New(t)
+
+	v1 := make(Vector, 3)
+	v1[0].SetUint64(2)
+	v1[1].SetUint64(3)
+	v1[2].SetUint64(1)
+
+	b, err := v1.MarshalBinary()
+	assert.NoError(err)
+
+	var v2, v3 Vector
+
+	err = v2.UnmarshalBinary(b)
+	assert.NoError(err)
+
+	err = v3.unmarshalBinaryAsync(b)
+	assert.NoError(err)
+
+	assert.True(reflect.DeepEqual(v1, v2))
+	assert.True(reflect.DeepEqual(v3, v2))
+}
+
+func TestVectorEmptyRoundTrip(t *testing.T) {
+	assert := require.New(t)
+
+	v1 := make(Vector, 0)
+
+	b, err := v1.MarshalBinary()
+	assert.NoError(err)
+
+	var v2, v3 Vector
+
+	err = v2.UnmarshalBinary(b)
+	assert.NoError(err)
+
+	err = v3.unmarshalBinaryAsync(b)
+	assert.NoError(err)
+
+	assert.True(reflect.DeepEqual(v1, v2))
+	assert.True(reflect.DeepEqual(v3, v2))
+}
+
+func TestVectorHash(t *testing.T) {
+	assert := require.New(t)
+
+	// sizes around the block size
+	for _, size := range []int{0, 1, vectorHashBlockSize, 3*vectorHashBlockSize + 5} {
+		v := make(Vector, size)
+		for i := range v {
+			v[i].SetRandom()
+		}
+
+		h := sha256.New()
+		v.Hash(h)
+
+		var buf bytes.Buffer
+		_, err := v.WriteTo(&buf)
+		assert.NoError(err)
+		expected := sha256.Sum256(buf.Bytes())
+		assert.Equal(expected[:], h.Sum(nil), "size %d", size)
+
+		allocs := testing.AllocsPerRun(10, func() {
+			h.Reset()
+			v.Hash(h)
+		})
+		assert.Equal(0.0, allocs, "Hash must not allocate")
+	}
+}
+
+func TestVectorMerkleRoot(t *testing.T) {
+	assert := require.New(t)
+
+	h := sha256.New()
+	leafHash := func(v Vector) []byte {
+		var buf bytes.Buffer
+		for i := range v {
+			b := v[i].Bytes()
+			buf.Write(b[:])
+		}
+		res := sha256.Sum256(buf.Bytes())
+		return res[:]
+	}
+	nodeHash := func(a, b []byte) []byte {
+		res := sha256.Sum256(append(append([]byte{}, a...), b...))
+		return res[:]
+	}
+
+	v := make(Vector, 2*vectorHashBlockSize+3)
+	for i := range v {
+		v[i].SetRandom()
+	}
+
+	// a single leaf
+	root, err := v.MerkleRoot(h, len(v))
+	assert.NoError(err)
+	assert.Equal(leafHash(v), root)
+
+	// 3 leaves, the last one shorter and promoted
+	const leafSize = vectorHashBlockSize + 1
+	root, err = v.MerkleRoot(h, leafSize)
+	assert.NoError(err)
+	expected := nodeHash(nodeHash(leafHash(v[:leafSize]), leafHash(v[leafSize:2*leafSize])), leafHash(v[2*leafSize:]))
+	assert.Equal(expected, root)
+
+	// 5 leaves
+	root, err = v[:5].MerkleRoot(h, 1)
+	assert.NoError(err)
+	l := make([][]byte, 5)
+	for i := range l {
+		l[i] = leafHash(v[i : i+1])
+	}
+	expected = nodeHash(nodeHash(nodeHash(l[0], l[1]), nodeHash(l[2], l[3])), l[4])
+	assert.Equal(expected, root)
+
+	_, err = v.MerkleRoot(h, 0)
+	assert.Error(err)
+	_, err = Vector{}.MerkleRoot(h, 1)
+	assert.Error(err)
+}
+
+func (vector *Vector) unmarshalBinaryAsync(data []byte) error {
+	r := bytes.NewReader(data)
+	_, err, chErr := vector.AsyncReadFrom(r)
+	if err != nil {
+		return err
+	}
+	return <-chErr
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/field/generator"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+//go:generate go run main.go
+func main() {
+	// base field GF(2²⁵⁵-19) and scalar field (order of the prime subgroup) of Curve25519
+	fields := []struct{ name, modulus string }{
+		{"fp", "57896044618658097711785492504343953926634992332820282019728792003956564819949"},
+		{"fr", "7237005577332262213973186563042994240857116359379907606001950938285454250989"},
+	}
+	for _, f := range fields {
+		conf, err := config.NewFieldConfig(f.name, "Element", f.modulus, true)
+		if err != nil {
+			panic(err)
+		}
+		if err := generator.GenerateFF(conf, "../"+f.name); err != nil {
+			panic(err)
+		}
+	}
+	fmt.Println("successfully generated curve25519 fields")
+}
@@ -0,0 +1,77 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package curve25519
+
+import (
+	"errors"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fp"
+)
+
+// SizePointCompressed is the size in bytes of the encoding of a point
+const SizePointCompressed = fp.Bytes
+
+var errInvalidEncoding = errors.New("invalid edwards25519 point encoding")
+
+// Bytes returns the encoding of p of https://www.rfc-editor.org/rfc/rfc8032#section-5.1.2:
+// the y coordinate in little endian, whose most significant bit is set to the sign of x
+// (see IsNegative).
+func (p *PointAffine) Bytes() (res [SizePointCompressed]byte) {
+	fp.LittleEndian.PutElement(&res, p.Y)
+	if IsNegative(&p.X) {
+		res[SizePointCompressed-1] |= 0x80
+	}
+	return
+}
+
+// SetBytes sets p from its encoding in buf[:SizePointCompressed] (see Bytes), and returns the
+// number of bytes read, following https://www.rfc-editor.org/rfc/rfc8032#section-5.1.3.
+//
+// Non-canonical encodings (y ≥ p, or x = 0 with the sign bit set) are rejected. The point is on
+// the curve, but it is not checked to be in the prime subgroup.
+func (p *PointAffine) SetBytes(buf []byte) (int, error) {
+	initOnce.Do(initCurveParams)
+	if len(buf) < SizePointCompressed {
+		return 0, io.ErrShortBuffer
+	}
+	var b [SizePointCompressed]byte
+	copy(b[:], buf[:SizePointCompressed])
+	negative := b[SizePointCompressed-1]>>7 == 1
+	b[SizePointCompressed-1] &= 0x7f
+
+	y, err := fp.LittleEndian.Element(&b)
+	if err != nil {
+		return 0, errInvalidEncoding
+	}
+
+	// x² = (y²-1)/(d⋅y²+1)
+	var u, v, one fp.Element
+	one.SetOne()
+	u.Square(&y)
+	v.Mul(&u, &curveParams.D).Add(&v, &one)
+	u.Sub(&u, &one)
+	wasSquare, x := SqrtRatio(&u, &v)
+	if !wasSquare || (x.IsZero() && negative) {
+		return 0, errInvalidEncoding
+	}
+	if negative {
+		x.Neg(&x)
+	}
+
+	p.X = x
+	p.Y = y
+	return SizePointCompressed, nil
+}
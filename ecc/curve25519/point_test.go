@@ -0,0 +1,181 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package curve25519
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fp"
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fr"
+)
+
+func TestCurveParams(t *testing.T) {
+	t.Parallel()
+
+	params := GetEdwardsCurve()
+	if params.Order.Cmp(fr.Modulus()) != 0 {
+		t.Fatal("the order of the prime subgroup doesn't match fr")
+	}
+
+	// d = -121665/121666
+	var d, den fp.Element
+	d.SetUint64(121665).Neg(&d)
+	den.SetUint64(121666)
+	d.Div(&d, &den)
+	if !d.Equal(&params.D) {
+		t.Fatal("wrong d")
+	}
+
+	if !params.Base.IsOnCurve() || !params.Base.IsInSubGroup() {
+		t.Fatal("the base point is not in the prime subgroup")
+	}
+	if params.Base.IsZero() {
+		t.Fatal("the base point is 0")
+	}
+
+	var m1 fp.Element
+	m1.SetOne().Neg(&m1)
+	s := SqrtM1()
+	if s.Square(&s); !s.Equal(&m1) || IsNegative(&sqrtM1) {
+		t.Fatal("wrong square root of -1")
+	}
+}
+
+func TestSqrtRatio(t *testing.T) {
+	t.Parallel()
+
+	var zero, one, u, v fp.Element
+	one.SetOne()
+
+	if ok, r := SqrtRatio(&zero, &one); !ok || !r.IsZero() {
+		t.Fatal("SqrtRatio(0, 1) != (true, 0)")
+	}
+	if ok, r := SqrtRatio(&one, &zero); ok || !r.IsZero() {
+		t.Fatal("SqrtRatio(1, 0) != (false, 0)")
+	}
+
+	for i := 0; i < 20; i++ {
+		u.SetRandom()
+		v.SetRandom()
+		ok, r := SqrtRatio(&u, &v)
+		if IsNegative(&r) {
+			t.Fatal("SqrtRatio returned a negative root")
+		}
+		// r²⋅v = u, or r²⋅v = √-1⋅u if u/v is not a square
+		var lhs, rhs fp.Element
+		lhs.Square(&r).Mul(&lhs, &v)
+		rhs.Set(&u)
+		if !ok {
+			rhs.Mul(&rhs, &sqrtM1)
+		}
+		if !lhs.Equal(&rhs) {
+			t.Fatal("wrong square root")
+		}
+		var ratio fp.Element
+		ratio.Div(&u, &v)
+		if ok != (ratio.Legendre() == 1) {
+			t.Fatal("SqrtRatio doesn't match the Legendre symbol")
+		}
+	}
+}
+
+func TestPointArithmetic(t *testing.T) {
+	t.Parallel()
+
+	params := GetEdwardsCurve()
+	var base, p, q PointExtended
+	base.FromAffine(&params.Base)
+
+	// [3]B = B + B + B
+	p.Double(&base).Add(&p, &base)
+	q.ScalarMultiplication(&base, big.NewInt(3))
+	if !p.Equal(&q) {
+		t.Fatal("[3]B != B + [2]B")
+	}
+	var pAff PointAffine
+	if !pAff.FromExtended(&p).IsOnCurve() {
+		t.Fatal("[3]B is not on the curve")
+	}
+
+	// [-3]B + [3]B = 0
+	q.ScalarMultiplication(&base, big.NewInt(-3))
+	if !q.Add(&q, &p).IsZero() {
+		t.Fatal("[-3]B + [3]B != 0")
+	}
+
+	// [ℓ]B = 0 and [8] kills the torsion points, e.g. (0, -1) of order 2
+	var torsion PointAffine
+	torsion.Y.SetOne().Neg(&torsion.Y)
+	if !torsion.IsOnCurve() || torsion.IsInSubGroup() {
+		t.Fatal("(0, -1) should be a point outside of the prime subgroup")
+	}
+	var tExt PointExtended
+	tExt.FromAffine(&torsion)
+	if !tExt.Double(&tExt).IsZero() {
+		t.Fatal("(0, -1) should have order 2")
+	}
+}
+
+func TestPointEncoding(t *testing.T) {
+	t.Parallel()
+
+	// the encoding of the base point is 0x58 followed by 31 times 0x66 (RFC 8032)
+	params := GetEdwardsCurve()
+	b := params.Base.Bytes()
+	if b[0] != 0x58 || b[31] != 0x66 {
+		t.Fatal("wrong encoding of the base point")
+	}
+
+	var p, q PointAffine
+	for i := int64(1); i < 20; i++ {
+		p.ScalarMultiplication(&params.Base, big.NewInt(i))
+		if i%2 == 0 {
+			p.Neg(&p)
+		}
+		b := p.Bytes()
+		if _, err := q.SetBytes(b[:]); err != nil {
+			t.Fatal(err)
+		}
+		if !p.Equal(&q) {
+			t.Fatal("SetBytes(Bytes()) doesn't round trip")
+		}
+	}
+
+	// identity with the sign bit set: x = 0 can't be negative
+	var id PointAffine
+	id.Y.SetOne()
+	b = id.Bytes()
+	b[31] |= 0x80
+	if _, err := q.SetBytes(b[:]); err != errInvalidEncoding {
+		t.Fatal("expected errInvalidEncoding for a negative 0, got", err)
+	}
+
+	// y = p: non-canonical encoding of y = 0
+	b = [SizePointCompressed]byte{0xed}
+	for i := 1; i < 31; i++ {
+		b[i] = 0xff
+	}
+	b[31] = 0x7f
+	if _, err := q.SetBytes(b[:]); err != errInvalidEncoding {
+		t.Fatal("expected errInvalidEncoding for y ≥ p, got", err)
+	}
+
+	// y = 2 isn't the y coordinate of a point of the curve
+	b = [SizePointCompressed]byte{2}
+	if _, err := q.SetBytes(b[:]); err != errInvalidEncoding {
+		t.Fatal("expected errInvalidEncoding for y = 2, got", err)
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ristretto255 implements the ristretto255 prime-order group, following
+// https://www.rfc-editor.org/rfc/rfc9496.
+//
+// ristretto255 is a group of prime order ℓ (the modulus of curve25519/fr) built from
+// Curve25519: elements are classes of points of the twisted Edwards curve, with a canonical
+// 32-byte encoding. Protocols designed for prime-order groups (OPRFs, Schnorr proofs,
+// anonymous credentials...) can use it without the cofactor pitfalls of Curve25519.
+//
+// Elements are hashed to the group with HashToGroup, the hash_to_ristretto255 suite of
+// https://www.rfc-editor.org/rfc/rfc9380#appendix-B.
+//
+// # Warning
+//
+// This code has not been audited and is provided as-is. In particular, there is no security
+// guarantees such as constant time implementation or side-channel attack resistance.
+package ristretto255
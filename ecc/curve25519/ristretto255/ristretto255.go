@@ -0,0 +1,320 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ristretto255
+
+import (
+	"crypto/sha512"
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/curve25519"
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fp"
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fr"
+	"github.com/consensys/gnark-crypto/field/hash"
+)
+
+const (
+	// SizeOfElement is the size in bytes of the encoding of an element
+	SizeOfElement = 32
+
+	// SizeOfUniformBytes is the number of uniform bytes mapped to an element by SetUniformBytes
+	SizeOfUniformBytes = 64
+)
+
+var (
+	// ErrInvalidEncoding is returned when decoding a non-canonical or invalid encoding
+	ErrInvalidEncoding  = errors.New("invalid ristretto255 encoding")
+	errUniformBytesSize = errors.New("SetUniformBytes expects 64 bytes")
+)
+
+// Element is an element of the ristretto255 group.
+//
+// It is represented by a point of the twisted Edwards form of Curve25519 (in extended
+// coordinates); distinct points may represent the same element, Equal and Bytes account
+// for it. The zero value is not a valid element, use SetIdentity.
+type Element struct {
+	p curve25519.PointExtended
+}
+
+var (
+	initOnce sync.Once
+
+	// constants of https://www.rfc-editor.org/rfc/rfc9496#section-4.1
+	sqrtADMinusOne, invSqrtAMinusD, oneMinusDSq, dMinusOneSq fp.Element
+	sqrtM1, d                                                fp.Element
+	generator                                                Element
+)
+
+func initConstants() {
+	sqrtM1 = curve25519.SqrtM1()
+	params := curve25519.GetEdwardsCurve()
+	d = params.D
+	sqrtADMinusOne.SetString("25063068953384623474111414158702152701244531502492656460079210482610430750235")
+	invSqrtAMinusD.SetString("54469307008909316920995813868745141605393597292927456921205312896311721017578")
+	oneMinusDSq.SetString("1159843021668779879193775521855586647937357759715417654439879720876111806838")
+	dMinusOneSq.SetString("40440834346308536858101042469323190826248399146238708352240133220865137265952")
+	generator.p.FromAffine(&params.Base)
+}
+
+// SetIdentity sets e to the identity element and returns it
+func (e *Element) SetIdentity() *Element {
+	e.p.X.SetZero()
+	e.p.Y.SetOne()
+	e.p.Z.SetOne()
+	e.p.T.SetZero()
+	return e
+}
+
+// SetGenerator sets e to the canonical generator of the group and returns it
+func (e *Element) SetGenerator() *Element {
+	initOnce.Do(initConstants)
+	return e.Set(&generator)
+}
+
+// Set sets e to e1 and returns it
+func (e *Element) Set(e1 *Element) *Element {
+	e.p.Set(&e1.p)
+	return e
+}
+
+// Equal returns true if e and e1 are the same element
+func (e *Element) Equal(e1 *Element) bool {
+	// X₁⋅Y₂ = Y₁⋅X₂ or Y₁⋅Y₂ = X₁⋅X₂, see https://www.rfc-editor.org/rfc/rfc9496#section-4.5
+	var lhs, rhs fp.Element
+	lhs.Mul(&e.p.X, &e1.p.Y)
+	rhs.Mul(&e.p.Y, &e1.p.X)
+	if lhs.Equal(&rhs) {
+		return true
+	}
+	lhs.Mul(&e.p.Y, &e1.p.Y)
+	rhs.Mul(&e.p.X, &e1.p.X)
+	return lhs.Equal(&rhs)
+}
+
+// IsIdentity returns true if e is the identity element
+func (e *Element) IsIdentity() bool {
+	var id Element
+	id.SetIdentity()
+	return e.Equal(&id)
+}
+
+// Add sets e to e1 + e2 and returns it
+func (e *Element) Add(e1, e2 *Element) *Element {
+	e.p.Add(&e1.p, &e2.p)
+	return e
+}
+
+// Sub sets e to e1 - e2 and returns it
+func (e *Element) Sub(e1, e2 *Element) *Element {
+	var neg curve25519.PointExtended
+	neg.Neg(&e2.p)
+	e.p.Add(&e1.p, &neg)
+	return e
+}
+
+// Neg sets e to -e1 and returns it
+func (e *Element) Neg(e1 *Element) *Element {
+	e.p.Neg(&e1.p)
+	return e
+}
+
+// ScalarMultiplication sets e to [s]e1 and returns it
+func (e *Element) ScalarMultiplication(e1 *Element, s *fr.Element) *Element {
+	var k big.Int
+	s.BigInt(&k)
+	e.p.ScalarMultiplication(&e1.p, &k)
+	return e
+}
+
+// ScalarMultiplicationBase sets e to [s]g, where g is the generator (see SetGenerator), and
+// returns it
+func (e *Element) ScalarMultiplicationBase(s *fr.Element) *Element {
+	initOnce.Do(initConstants)
+	return e.ScalarMultiplication(&generator, s)
+}
+
+// Bytes returns the canonical encoding of e,
+// see https://www.rfc-editor.org/rfc/rfc9496#section-4.3.2
+func (e *Element) Bytes() (res [SizeOfElement]byte) {
+	initOnce.Do(initConstants)
+	x0, y0, z0, t0 := &e.p.X, &e.p.Y, &e.p.Z, &e.p.T
+
+	var u1, u2, tmp, one fp.Element
+	u1.Add(z0, y0)
+	tmp.Sub(z0, y0)
+	u1.Mul(&u1, &tmp)
+	u2.Mul(x0, y0)
+
+	one.SetOne()
+	tmp.Square(&u2).Mul(&tmp, &u1)
+	_, invSqrt := curve25519.SqrtRatio(&one, &tmp)
+
+	var den1, den2, zInv fp.Element
+	den1.Mul(&invSqrt, &u1)
+	den2.Mul(&invSqrt, &u2)
+	zInv.Mul(&den1, &den2).Mul(&zInv, t0)
+
+	var x, y, denInv fp.Element
+	tmp.Mul(t0, &zInv)
+	if curve25519.IsNegative(&tmp) {
+		// rotate
+		x.Mul(y0, &sqrtM1)
+		y.Mul(x0, &sqrtM1)
+		denInv.Mul(&den1, &invSqrtAMinusD)
+	} else {
+		x.Set(x0)
+		y.Set(y0)
+		denInv.Set(&den2)
+	}
+
+	tmp.Mul(&x, &zInv)
+	if curve25519.IsNegative(&tmp) {
+		y.Neg(&y)
+	}
+
+	var s fp.Element
+	s.Sub(z0, &y).Mul(&s, &denInv)
+	if curve25519.IsNegative(&s) {
+		s.Neg(&s)
+	}
+	fp.LittleEndian.PutElement(&res, s)
+	return
+}
+
+// SetBytes sets e from the canonical encoding in buf[:SizeOfElement], and returns the
+// number of bytes read. It returns ErrInvalidEncoding if the encoding is not canonical or
+// doesn't encode an element, see https://www.rfc-editor.org/rfc/rfc9496#section-4.3.1
+func (e *Element) SetBytes(buf []byte) (int, error) {
+	initOnce.Do(initConstants)
+	if len(buf) < SizeOfElement {
+		return 0, ErrInvalidEncoding
+	}
+	s, err := fp.LittleEndian.Element((*[SizeOfElement]byte)(buf[:SizeOfElement]))
+	if err != nil || curve25519.IsNegative(&s) {
+		return 0, ErrInvalidEncoding
+	}
+
+	var ss, u1, u2, u2Sq, v, tmp, one fp.Element
+	one.SetOne()
+	ss.Square(&s)
+	u1.Sub(&one, &ss)
+	u2.Add(&one, &ss)
+	u2Sq.Square(&u2)
+
+	// v = -(D⋅u₁²) - u₂²
+	v.Square(&u1).Mul(&v, &d).Neg(&v).Sub(&v, &u2Sq)
+
+	tmp.Mul(&v, &u2Sq)
+	wasSquare, invSqrt := curve25519.SqrtRatio(&one, &tmp)
+
+	var denX, denY fp.Element
+	denX.Mul(&invSqrt, &u2)
+	denY.Mul(&invSqrt, &denX).Mul(&denY, &v)
+
+	var p curve25519.PointExtended
+	p.X.Double(&s).Mul(&p.X, &denX)
+	if curve25519.IsNegative(&p.X) {
+		p.X.Neg(&p.X)
+	}
+	p.Y.Mul(&u1, &denY)
+	p.Z.SetOne()
+	p.T.Mul(&p.X, &p.Y)
+
+	if !wasSquare || curve25519.IsNegative(&p.T) || p.Y.IsZero() {
+		return 0, ErrInvalidEncoding
+	}
+	e.p = p
+	return SizeOfElement, nil
+}
+
+// SetUniformBytes sets e to the element derived from 64 uniformly random bytes, and returns it.
+// The map is one-way, and the distribution of the result is indistinguishable from uniform,
+// see https://www.rfc-editor.org/rfc/rfc9496#section-4.3.4
+func (e *Element) SetUniformBytes(b []byte) (*Element, error) {
+	initOnce.Do(initConstants)
+	if len(b) != SizeOfUniformBytes {
+		return nil, errUniformBytesSize
+	}
+	var p1, p2 curve25519.PointExtended
+	mapToPoint(&p1, b[:32])
+	mapToPoint(&p2, b[32:])
+	e.p.Add(&p1, &p2)
+	return e, nil
+}
+
+// HashToGroup hashes msg to an element, following the hash_to_ristretto255 suite
+// of https://www.rfc-editor.org/rfc/rfc9380#appendix-B: SetUniformBytes on 64 bytes of
+// expand_message_xmd with SHA-512.
+func HashToGroup(msg, dst []byte) (Element, error) {
+	uniform, err := hash.ExpandMsgXmdWithHash(sha512.New, msg, dst, SizeOfUniformBytes)
+	if err != nil {
+		return Element{}, err
+	}
+	var e Element
+	_, err = e.SetUniformBytes(uniform)
+	return e, err
+}
+
+// mapToPoint is the MAP function of https://www.rfc-editor.org/rfc/rfc9496#section-4.3.4,
+// applied to the field element encoded by the 255 least significant bits of b (little-endian).
+func mapToPoint(p *curve25519.PointExtended, b []byte) {
+	// big-endian copy of b, with the most significant bit masked
+	var be [32]byte
+	for i := range be {
+		be[i] = b[31-i]
+	}
+	be[0] &= 0x7f
+	var t fp.Element
+	t.SetBytes(be[:]) // reduced modulo p
+
+	var r, u, v, tmp, one fp.Element
+	one.SetOne()
+	r.Square(&t).Mul(&r, &sqrtM1)
+	u.Add(&r, &one).Mul(&u, &oneMinusDSq)
+	// v = (-1 - r⋅D)⋅(r + D)
+	v.Mul(&r, &d).Add(&v, &one).Neg(&v)
+	tmp.Add(&r, &d)
+	v.Mul(&v, &tmp)
+
+	wasSquare, s := curve25519.SqrtRatio(&u, &v)
+	var c fp.Element
+	if wasSquare {
+		c.Neg(&one)
+	} else {
+		// s = -|s⋅t|
+		s.Mul(&s, &t)
+		if !curve25519.IsNegative(&s) {
+			s.Neg(&s)
+		}
+		c.Set(&r)
+	}
+
+	// N = c⋅(r - 1)⋅(D - 1)² - v
+	var n fp.Element
+	n.Sub(&r, &one).Mul(&n, &c).Mul(&n, &dMinusOneSq).Sub(&n, &v)
+
+	var w0, w1, w2, w3, sSq fp.Element
+	sSq.Square(&s)
+	w0.Double(&s).Mul(&w0, &v)
+	w1.Mul(&n, &sqrtADMinusOne)
+	w2.Sub(&one, &sSq)
+	w3.Add(&one, &sSq)
+
+	p.X.Mul(&w0, &w3)
+	p.Y.Mul(&w2, &w1)
+	p.Z.Mul(&w1, &w3)
+	p.T.Mul(&w0, &w2)
+}
@@ -0,0 +1,242 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ristretto255
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fp"
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fr"
+)
+
+// encodings of [i]g for i = 0..15, from https://www.rfc-editor.org/rfc/rfc9496#appendix-A.1
+var multiplesOfGenerator = []string{
+	"0000000000000000000000000000000000000000000000000000000000000000",
+	"e2f2ae0a6abc4e71a884a961c500515f58e30b6aa582dd8db6a65945e08d2d76",
+	"6a493210f7499cd17fecb510ae0cea23a110e8d5b901f8acadd3095c73a3b919",
+	"94741f5d5d52755ece4f23f044ee27d5d1ea1e2bd196b462166b16152a9d0259",
+	"da80862773358b466ffadfe0b3293ab3d9fd53c5ea6c955358f568322daf6a57",
+	"e882b131016b52c1d3337080187cf768423efccbb517bb495ab812c4160ff44e",
+	"f64746d3c92b13050ed8d80236a7f0007c3b3f962f5ba793d19a601ebb1df403",
+	"44f53520926ec81fbd5a387845beb7df85a96a24ece18738bdcfa6a7822a176d",
+	"903293d8f2287ebe10e2374dc1a53e0bc887e592699f02d077d5263cdd55601c",
+	"02622ace8f7303a31cafc63f8fc48fdc16e1c8c8d234b2f0d6685282a9076031",
+	"20706fd788b2720a1ed2a5dad4952b01f413bcf0e7564de8cdc816689e2db95f",
+	"bce83f8ba5dd2fa572864c24ba1810f9522bc6004afe95877ac73241cafdab42",
+	"e4549ee16b9aa03099ca208c67adafcafa4c3f3e4e5303de6026e3ca8ff84460",
+	"aa52e000df2e16f55fb1032fc33bc42742dad6bd5a8fc0be0167436c5948501f",
+	"46376b80f409b29dc2b5f6f0c52591990896e5716f41477cd30085ab7f10301e",
+	"e0c418f7c8d9c4cdd7395b93ea124f3ad99021bb681dfc3302a9d99a2e53e64e",
+}
+
+func TestMultiplesOfGenerator(t *testing.T) {
+	t.Parallel()
+
+	var g, acc Element
+	g.SetGenerator()
+	acc.SetIdentity()
+	for i, expected := range multiplesOfGenerator {
+		b := acc.Bytes()
+		if hex.EncodeToString(b[:]) != expected {
+			t.Fatalf("wrong encoding of [%d]g: %x", i, b)
+		}
+
+		var decoded Element
+		if _, err := decoded.SetBytes(b[:]); err != nil {
+			t.Fatal(err)
+		}
+		if !decoded.Equal(&acc) {
+			t.Fatalf("decoding of [%d]g doesn't match", i)
+		}
+		if decoded.Bytes() != b {
+			t.Fatalf("encoding of the decoding of [%d]g doesn't match", i)
+		}
+
+		var s fr.Element
+		s.SetUint64(uint64(i))
+		var res Element
+		if !res.ScalarMultiplicationBase(&s).Equal(&acc) {
+			t.Fatalf("ScalarMultiplicationBase doesn't match [%d]g", i)
+		}
+
+		acc.Add(&acc, &g)
+	}
+}
+
+func TestInvalidEncodings(t *testing.T) {
+	t.Parallel()
+
+	var p [32]byte
+	fp.LittleEndian.PutElement(&p, fp.Element{}) // 0
+	invalid := [][]byte{
+		// non-canonical field encodings: p, p+1 and 2²⁵⁵-1
+		{0xed, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f},
+		{0xee, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f},
+		{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+		// negative field element
+		{0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+		// too short
+		p[:31],
+	}
+	for _, b := range invalid {
+		var e Element
+		if _, err := e.SetBytes(b); err != ErrInvalidEncoding {
+			t.Fatalf("%x: expected ErrInvalidEncoding, got %v", b, err)
+		}
+	}
+
+	// about half of the non-negative field elements don't encode an element
+	nbInvalid := 0
+	for i := 0; i < 64; i++ {
+		var s fp.Element
+		s.SetUint64(uint64(2 * i))
+		var b [32]byte
+		fp.LittleEndian.PutElement(&b, s)
+		var e Element
+		if _, err := e.SetBytes(b[:]); err != nil {
+			nbInvalid++
+		}
+	}
+	if nbInvalid == 0 || nbInvalid == 64 {
+		t.Fatal("unexpected number of invalid encodings", nbInvalid)
+	}
+}
+
+func TestGroupLaw(t *testing.T) {
+	t.Parallel()
+
+	var a, b fr.Element
+	a.SetRandom()
+	b.SetRandom()
+
+	var ga, gb, sum, expected Element
+	ga.ScalarMultiplicationBase(&a)
+	gb.ScalarMultiplicationBase(&b)
+
+	var ab fr.Element
+	ab.Add(&a, &b)
+	sum.Add(&ga, &gb)
+	if !sum.Equal(expected.ScalarMultiplicationBase(&ab)) {
+		t.Fatal("[a]g + [b]g != [a+b]g")
+	}
+	ab.Sub(&a, &b)
+	sum.Sub(&ga, &gb)
+	if !sum.Equal(expected.ScalarMultiplicationBase(&ab)) {
+		t.Fatal("[a]g - [b]g != [a-b]g")
+	}
+	ab.Mul(&a, &b)
+	sum.ScalarMultiplication(&ga, &b)
+	if !sum.Equal(expected.ScalarMultiplicationBase(&ab)) {
+		t.Fatal("[b]([a]g) != [ab]g")
+	}
+	sum.Neg(&ga).Add(&sum, &ga)
+	if !sum.IsIdentity() {
+		t.Fatal("-[a]g + [a]g != 0")
+	}
+	if ga.IsIdentity() {
+		t.Fatal("[a]g = 0")
+	}
+
+	// the encoding doesn't depend on the representative
+	sum.Add(&ga, &gb).Sub(&sum, &gb)
+	if sum.Bytes() != ga.Bytes() {
+		t.Fatal("equal elements have different encodings")
+	}
+}
+
+func TestSetUniformBytes(t *testing.T) {
+	t.Parallel()
+
+	// from https://www.rfc-editor.org/rfc/rfc9496#appendix-A.3
+	input, _ := hex.DecodeString("5d1be09e3d0c82fc538112490e35701979d99e06ca3e2b5b54bffe8b4dc772c14d98b696a1bbfb5ca32c436cc61c16563790306c79eaca7705668b47dffe5bb6")
+	expected, _ := hex.DecodeString("3066f82a1a747d45120d1740f14358531a8f04bbffe6a819f86dfe50f44a0a46")
+
+	var e Element
+	if _, err := e.SetUniformBytes(input); err != nil {
+		t.Fatal(err)
+	}
+	if b := e.Bytes(); !bytes.Equal(b[:], expected) {
+		t.Fatalf("wrong element: %x", b)
+	}
+
+	if _, err := e.SetUniformBytes(input[:32]); err != errUniformBytesSize {
+		t.Fatal("expected errUniformBytesSize, got", err)
+	}
+}
+
+func TestHashToGroup(t *testing.T) {
+	t.Parallel()
+
+	dst := []byte("QUUX-V01-CS02-with-ristretto255_XMD:SHA-512_R255MAP_RO_")
+	e1, err := HashToGroup([]byte("abc"), dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e2, err := HashToGroup([]byte("abc"), dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !e1.Equal(&e2) {
+		t.Fatal("HashToGroup is not deterministic")
+	}
+	e2, err = HashToGroup([]byte("abd"), dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e1.Equal(&e2) || e1.IsIdentity() {
+		t.Fatal("unexpected collision")
+	}
+
+	// the result is a valid element
+	b := e1.Bytes()
+	var decoded Element
+	if _, err := decoded.SetBytes(b[:]); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Equal(&e1) {
+		t.Fatal("round trip failed")
+	}
+}
+
+func BenchmarkElement(b *testing.B) {
+	var s fr.Element
+	s.SetRandom()
+	var e Element
+	e.ScalarMultiplicationBase(&s)
+	enc := e.Bytes()
+
+	b.Run("ScalarMultiplication", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			e.ScalarMultiplication(&e, &s)
+		}
+	})
+	b.Run("Bytes", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			e.Bytes()
+		}
+	})
+	b.Run("SetBytes", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = e.SetBytes(enc[:])
+		}
+	})
+	b.Run("HashToGroup", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = HashToGroup(enc[:], []byte("bench"))
+		}
+	})
+}
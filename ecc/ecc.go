@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-// Package ecc provides bls12-381, bls12-377, bls12-378, bn254, bw6-761, bls24-315, bls24-317, bw6-633, bls12-378, bw6-756, secp256k1 and stark-curve elliptic curves implementation (+pairing).
+// Package ecc provides bls12-381, bls12-377, bls12-378, bn254, bw6-761, bls24-315, bls24-317, bw6-633, bls12-378, bw6-756, secp256k1, secp256r1 and stark-curve elliptic curves implementation (+pairing).
 //
 // Also
 //
@@ -51,11 +51,12 @@ const (
 	BW6_756
 	STARK_CURVE
 	SECP256K1
+	SECP256R1
 )
 
 // Implemented return the list of curves fully implemented in gnark-crypto
 func Implemented() []ID {
-	return []ID{BN254, BLS12_377, BLS12_381, BW6_761, BLS24_315, BW6_633, BLS12_378, BW6_756, BLS24_317, STARK_CURVE, SECP256K1}
+	return []ID{BN254, BLS12_377, BLS12_381, BW6_761, BLS24_315, BW6_633, BLS12_378, BW6_756, BLS24_317, STARK_CURVE, SECP256K1, SECP256R1}
 }
 
 func IDFromString(s string) (ID, error) {
@@ -110,6 +111,8 @@ func (id ID) config() *config.Curve {
 		return &config.STARK_CURVE
 	case SECP256K1:
 		return &config.SECP256K1
+	case SECP256R1:
+		return &config.SECP256R1
 	default:
 		panic("unimplemented ecc ID")
 	}
@@ -126,4 +129,50 @@ func modulus(c *config.Curve, scalarField bool) *big.Int {
 // MultiExpConfig enables to set optional configuration attribute to a call to MultiExp
 type MultiExpConfig struct {
 	NbTasks int // go routines to be used in the multiexp. can be larger than num cpus.
+
+	// ScalarBits, if set, is a bound on the bit length of the scalars (e.g. counters or multiplicities).
+	// MultiExp then uses fewer windows; it returns an error if a scalar doesn't fit on ScalarBits bits.
+	ScalarBits int
+
+	// DeduplicateScalars, if set, sums the points sharing the same scalar before running the MultiExp.
+	// It is worth it when many scalars are identical (e.g. selector columns with 0/1 values).
+	DeduplicateScalars bool
+
+	// ScalarsCanonical, if set, indicates that the scalars are in regular (non-Montgomery) form, as
+	// the entries of a fr.CanonicalVector. MultiExp then reads their limbs as is, instead of
+	// converting each of them; the scalars must be reduced modulo the order of the curve.
+	ScalarsCanonical bool
+}
+
+// MultiExpShard is the range [Start, End) of the bases and scalars of a
+// multi-exponentiation assigned to a worker of a distributed computation.
+type MultiExpShard struct {
+	Start, End uint64
+}
+
+// Len returns the number of bases and scalars of the shard
+func (s MultiExpShard) Len() int {
+	return int(s.End - s.Start)
+}
+
+// PartitionMultiExp splits a multi-exponentiation of size n into nbShards contiguous
+// shards, whose sizes differ by at most one, in order. The partition only depends on n
+// and nbShards, so that the workers and the party combining their results can compute
+// it independently.
+func PartitionMultiExp(n, nbShards int) ([]MultiExpShard, error) {
+	if n < 0 || nbShards < 1 {
+		return nil, errors.New("invalid multi-exponentiation partition")
+	}
+	res := make([]MultiExpShard, nbShards)
+	size, extra := n/nbShards, n%nbShards
+	start := 0
+	for i := range res {
+		end := start + size
+		if i < extra {
+			end++
+		}
+		res[i] = MultiExpShard{Start: uint64(start), End: uint64(end)}
+		start = end
+	}
+	return res, nil
 }
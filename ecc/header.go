@@ -0,0 +1,164 @@
+package ecc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// Header is prepended to the binary encoding of long-lived objects (SRS, opening proofs, FFT domains,
+// precomputed tables) so that readers can detect a curve or a format mismatch instead of decoding
+// garbage points.
+//
+// Its binary encoding is
+//
+//	magic (4 bytes) | version (1 byte) | curve ID (2 bytes) | object type (1 byte) | flags (1 byte)
+//
+// The first byte of the magic (0xff) can't start a legacy (header-less) encoding: it is an invalid
+// point encoding on every curve, and an implausible slice length or domain cardinality. Readers use
+// this to stay backward compatible with objects serialized without a header.
+type Header struct {
+	Version uint8
+	Curve   ID
+	Object  ObjectType
+	Flags   HeaderFlag
+}
+
+// ObjectType identifies the kind of object following a Header
+type ObjectType uint8
+
+// do not modify the order of this enum
+const (
+	ObjectUnknown ObjectType = iota
+	ObjectSRS
+	ObjectProvingKey
+	ObjectVerifyingKey
+	ObjectOpeningProof
+	ObjectBatchOpeningProof
+	ObjectDomain
+	ObjectMultiExpTableG1
+	ObjectMultiExpTableG2
+	ObjectFixedBaseTableG1
+	ObjectFixedBaseTableG2
+	ObjectFixedBaseTableEdwards
+	ObjectAggregatedOpeningProof
+	ObjectPairingPrecomputation
+	ObjectSRSSlice
+	ObjectPartialMultiExpG1
+	ObjectPartialMultiExpG2
+	ObjectBatchOpeningProofs
+	ObjectAggregatedProofs
+)
+
+// HeaderFlag describes how the object following a Header is encoded
+type HeaderFlag uint8
+
+const (
+	// FlagRawEncoding is set when the points are encoded without point compression
+	FlagRawEncoding HeaderFlag = 1 << iota
+
+	// FlagExtendedG2 is set when a KZG proving key (or SRS) encoding includes the optional
+	// powers [αⁱ]G₂, right after the powers [αⁱ]G₁
+	FlagExtendedG2
+
+	// FlagCompactEncoding is set when the field elements of a KZG batch opening proof
+	// are encoded with the compact (sparse) encoding
+	FlagCompactEncoding
+)
+
+const (
+	// HeaderVersion is the version of the Header written by this library
+	HeaderVersion uint8 = 1
+
+	// SizeOfHeader is the size in bytes of the binary encoding of a Header
+	SizeOfHeader = 9
+)
+
+var headerMagic = [4]byte{0xff, 'g', 'n', 'c'}
+
+var (
+	ErrHeaderVersion = errors.New("unsupported header version")
+	ErrHeaderCurve   = errors.New("header curve ID mismatch")
+	ErrHeaderObject  = errors.New("header object type mismatch")
+)
+
+// NewHeader returns a Header of the current version
+func NewHeader(curve ID, object ObjectType, flags HeaderFlag) Header {
+	return Header{
+		Version: HeaderVersion,
+		Curve:   curve,
+		Object:  object,
+		Flags:   flags,
+	}
+}
+
+// Bytes returns the binary encoding of h
+func (h *Header) Bytes() (res [SizeOfHeader]byte) {
+	copy(res[:4], headerMagic[:])
+	res[4] = h.Version
+	binary.BigEndian.PutUint16(res[5:7], uint16(h.Curve))
+	res[7] = byte(h.Object)
+	res[8] = byte(h.Flags)
+	return
+}
+
+// WriteTo writes the binary encoding of h to w
+func (h *Header) WriteTo(w io.Writer) (int64, error) {
+	buf := h.Bytes()
+	n, err := w.Write(buf[:])
+	return int64(n), err
+}
+
+// Check returns an error if h doesn't describe an object of type object on the given curve,
+// or if its version is not supported.
+//
+// A nil Header (legacy encoding) always passes the check.
+func (h *Header) Check(curve ID, object ObjectType) error {
+	if h == nil {
+		return nil
+	}
+	if h.Version == 0 || h.Version > HeaderVersion {
+		return ErrHeaderVersion
+	}
+	if h.Curve != curve {
+		return ErrHeaderCurve
+	}
+	if h.Object != object {
+		return ErrHeaderObject
+	}
+	return nil
+}
+
+// ReadHeader reads a Header from r.
+//
+// If r doesn't start with a Header (legacy encoding), the returned Header is nil, and the
+// returned reader replays the bytes consumed while looking for the magic.
+// In all cases, the rest of the object must be read from the returned reader.
+//
+// The returned int64 is the number of bytes of the Header (0 for a legacy encoding).
+func ReadHeader(r io.Reader) (*Header, io.Reader, int64, error) {
+	var buf [SizeOfHeader]byte
+	read, err := io.ReadFull(r, buf[:len(headerMagic)])
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, r, int64(read), err
+	}
+	if read < len(headerMagic) || !bytes.Equal(buf[:len(headerMagic)], headerMagic[:]) {
+		// legacy encoding, the decoder of the object will account for the replayed bytes
+		return nil, io.MultiReader(bytes.NewReader(buf[:read]), r), 0, nil
+	}
+
+	n, err := io.ReadFull(r, buf[len(headerMagic):])
+	read += n
+	if err != nil {
+		return nil, r, int64(read), err
+	}
+
+	h := &Header{
+		Version: buf[4],
+		Curve:   ID(binary.BigEndian.Uint16(buf[5:7])),
+		Object:  ObjectType(buf[7]),
+		Flags:   HeaderFlag(buf[8]),
+	}
+	return h, r, int64(read), nil
+}
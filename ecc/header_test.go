@@ -0,0 +1,52 @@
+package ecc
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestHeader(t *testing.T) {
+	t.Parallel()
+
+	// round trip
+	var buf bytes.Buffer
+	h := NewHeader(BLS12_381, ObjectSRS, FlagRawEncoding)
+	if n, err := h.WriteTo(&buf); err != nil || n != SizeOfHeader {
+		t.Fatal("couldn't write header")
+	}
+	buf.WriteString("payload")
+	_h, r, n, err := ReadHeader(&buf)
+	if err != nil || n != SizeOfHeader || _h == nil || *_h != h {
+		t.Fatal("couldn't read header")
+	}
+	if err := _h.Check(BLS12_381, ObjectSRS); err != nil {
+		t.Fatal(err)
+	}
+	if _h.Check(BN254, ObjectSRS) != ErrHeaderCurve || _h.Check(BLS12_381, ObjectDomain) != ErrHeaderObject {
+		t.Fatal("header check should fail")
+	}
+	if payload, _ := io.ReadAll(r); string(payload) != "payload" {
+		t.Fatal("unexpected payload")
+	}
+
+	// legacy encodings, the bytes consumed looking for the magic are replayed
+	for _, legacy := range []string{"", "ab", "legacy payload"} {
+		_h, r, n, err = ReadHeader(bytes.NewBufferString(legacy))
+		if err != nil || n != 0 || _h != nil {
+			t.Fatal("legacy encoding should be read without header")
+		}
+		if err := _h.Check(BN254, ObjectSRS); err != nil {
+			t.Fatal(err)
+		}
+		if payload, _ := io.ReadAll(r); string(payload) != legacy {
+			t.Fatal("legacy payload not replayed")
+		}
+	}
+
+	// unsupported version
+	h.Version = HeaderVersion + 1
+	if h.Check(BLS12_381, ObjectSRS) != ErrHeaderVersion {
+		t.Fatal("header check should fail on unsupported version")
+	}
+}
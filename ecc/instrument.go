@@ -0,0 +1,109 @@
+package ecc
+
+import (
+	"context"
+	"runtime/trace"
+	"sync/atomic"
+	"time"
+)
+
+// Operation identifies an instrumented operation (see SetHook)
+type Operation uint8
+
+const (
+	OpMultiExpG1 Operation = iota
+	OpMultiExpG2
+	OpFFT
+	OpFFTInverse
+	OpPairing
+	OpCommit
+)
+
+var operationNames = [...]string{
+	OpMultiExpG1: "MultiExpG1",
+	OpMultiExpG2: "MultiExpG2",
+	OpFFT:        "FFT",
+	OpFFTInverse: "FFTInverse",
+	OpPairing:    "Pairing",
+	OpCommit:     "Commit",
+}
+
+func (op Operation) String() string {
+	if int(op) < len(operationNames) {
+		return operationNames[op]
+	}
+	return "unknown"
+}
+
+// Event describes a completed instrumented operation
+type Event struct {
+	Operation Operation
+	Curve     ID
+	// Size is the size of the input: number of points of a MultiExp, number of
+	// elements of an FFT, number of pairs of a pairing, number of coefficients of a
+	// committed polynomial.
+	Size     int
+	Duration time.Duration
+}
+
+// Hook is called at the end of each instrumented operation. It may be called
+// concurrently, and from nested operations (a Commit runs a MultiExp).
+type Hook func(Event)
+
+var hook atomic.Value // Hook
+
+// SetHook installs h to be called at the end of the MSM, FFT, pairing and KZG commit
+// calls, so that provers can attribute time across phases. A nil h removes the hook.
+//
+// Independently of the hook, these calls are annotated with runtime/trace regions
+// when a trace is being collected.
+func SetHook(h Hook) {
+	hook.Store(h)
+}
+
+// Region is an instrumented operation in progress. The zero value is a no-op.
+type Region struct {
+	op    Operation
+	curve ID
+	size  int
+	start time.Time
+	hook  Hook
+	trace *trace.Region
+}
+
+// StartRegion starts an instrumented operation; the caller must call End on the
+// same goroutine once the operation is done:
+//
+//	defer ecc.StartRegion(ecc.BN254, ecc.OpFFT, len(a)).End()
+//
+// When no hook is installed and no trace is being collected, it only costs an atomic load.
+func StartRegion(curve ID, op Operation, size int) Region {
+	h, _ := hook.Load().(Hook)
+	tracing := trace.IsEnabled()
+	if h == nil && !tracing {
+		return Region{}
+	}
+	r := Region{op: op, curve: curve, size: size, hook: h}
+	if tracing {
+		r.trace = trace.StartRegion(context.Background(), curve.String()+"/"+op.String())
+	}
+	if h != nil {
+		r.start = time.Now()
+	}
+	return r
+}
+
+// End ends the region and reports it to the hook, if any.
+func (r Region) End() {
+	if r.trace != nil {
+		r.trace.End()
+	}
+	if r.hook != nil {
+		r.hook(Event{
+			Operation: r.op,
+			Curve:     r.curve,
+			Size:      r.size,
+			Duration:  time.Since(r.start),
+		})
+	}
+}
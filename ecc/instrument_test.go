@@ -0,0 +1,30 @@
+package ecc
+
+import (
+	"testing"
+)
+
+func TestInstrumentationHook(t *testing.T) {
+	// no hook: no-op
+	StartRegion(BN254, OpFFT, 8).End()
+
+	var events []Event
+	SetHook(func(e Event) {
+		events = append(events, e)
+	})
+	defer SetHook(nil)
+
+	StartRegion(BLS12_381, OpMultiExpG2, 42).End()
+	if len(events) != 1 {
+		t.Fatal("hook should have been called once")
+	}
+	if e := events[0]; e.Operation != OpMultiExpG2 || e.Curve != BLS12_381 || e.Size != 42 || e.Duration < 0 {
+		t.Fatalf("unexpected event %+v", e)
+	}
+
+	SetHook(nil)
+	StartRegion(BN254, OpPairing, 2).End()
+	if len(events) != 1 {
+		t.Fatal("hook should have been removed")
+	}
+}
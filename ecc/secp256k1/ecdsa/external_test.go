@@ -0,0 +1,134 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecdsa
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/random"
+	"github.com/consensys/gnark-crypto/signature"
+)
+
+// hsm simulates a key held by an HSM: it signs digests with a private key the
+// ExternalSigner never sees.
+type hsm struct {
+	key *PrivateKey
+	der bool // ASN.1 DER encoded signatures, r || s otherwise
+
+	rand io.Reader // the source of randomness of the last signature
+}
+
+func (h *hsm) Public() crypto.PublicKey {
+	return &h.key.PublicKey
+}
+
+func (h *hsm) Sign(rand io.Reader, digest []byte, _ crypto.SignerOpts) ([]byte, error) {
+	h.rand = rand
+	sigBin, err := h.key.Sign(digest, nil)
+	if err != nil || !h.der {
+		return sigBin, err
+	}
+	var sig Signature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(struct{ R, S *big.Int }{new(big.Int).SetBytes(sig.R[:]), new(big.Int).SetBytes(sig.S[:])})
+}
+
+func TestExternalSigner(t *testing.T) {
+	t.Parallel()
+
+	key, err := GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := []byte("testing ECDSA with an external key")
+
+	for _, der := range []bool{true, false} {
+		var signer signature.Signer
+		signer, err = NewExternalSigner(&hsm{key: key, der: der}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !signer.Public().Equal(key.Public()) {
+			t.Fatal("wrong public key")
+		}
+		sig, err := signer.Sign(msg, sha256.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok, err := key.PublicKey.Verify(sig, msg, sha256.New()); err != nil || !ok {
+			t.Fatal("signature of the external signer doesn't verify")
+		}
+		if signer.Bytes() != nil {
+			t.Fatal("the external key must not be exported")
+		}
+	}
+
+	// a handle signing with another key is detected
+	other, err := GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := NewExternalSigner(&hsm{key: other}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer.publicKey = key.PublicKey
+	if _, err := signer.Sign(msg, sha256.New()); err != errExternalSignature {
+		t.Fatal("expected errExternalSignature, got", err)
+	}
+}
+
+func TestExternalSignerRandomness(t *testing.T) {
+	key, err := GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &hsm{key: key}
+	signer, err := NewExternalSigner(h, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := []byte("testing the source of randomness of an external key")
+
+	// the package-level source of randomness, by default
+	source := &struct{ io.Reader }{rand.Reader}
+	random.SetReader(source)
+	defer random.SetReader(nil)
+	if _, err := signer.Sign(msg, sha256.New()); err != nil {
+		t.Fatal(err)
+	}
+	if h.rand != io.Reader(source) {
+		t.Fatal("the external signer should use random.Reader()")
+	}
+
+	other := &struct{ io.Reader }{rand.Reader}
+	signer.SetRandomness(other)
+	if _, err := signer.Sign(msg, sha256.New()); err != nil {
+		t.Fatal(err)
+	}
+	if h.rand != io.Reader(other) {
+		t.Fatal("the external signer should use the source set by SetRandomness")
+	}
+}
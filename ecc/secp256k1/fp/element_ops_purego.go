@@ -16,7 +16,16 @@
 
 package fp
 
-import "math/bits"
+import (
+	"math/bits"
+	"reflect"
+
+	"github.com/consensys/gnark-crypto/field/codepath"
+)
+
+func init() {
+	codepath.Register(reflect.TypeOf(Element{}).PkgPath(), "generic Go")
+}
 
 // MulBy3 x *= 3 (mod q)
 func MulBy3(x *Element) {
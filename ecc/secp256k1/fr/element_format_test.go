@@ -0,0 +1,89 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fr
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestElementFormat(t *testing.T) {
+	assert := require.New(t)
+
+	var a Element
+	a.SetRandom()
+	var b big.Int
+	a.BigInt(&b)
+
+	assert.Equal(a.String(), fmt.Sprintf("%v", &a))
+	assert.Equal(a.String(), fmt.Sprintf("%s", &a))
+	assert.Equal(a.Dump(), fmt.Sprintf("%+v", &a))
+	assert.Equal(b.Text(10), fmt.Sprintf("%d", &a))
+	assert.Equal(b.Text(16), fmt.Sprintf("%x", &a))
+	assert.Equal("0x"+b.Text(16), fmt.Sprintf("%#x", &a))
+
+	// -1 is printed as such by String (except for small moduli), not by %d
+	var minusOne Element
+	minusOne.SetOne().Neg(&minusOne)
+	assert.Equal("-1", fmt.Sprintf("%v", &minusOne))
+	assert.Equal(Modulus().Sub(Modulus(), big.NewInt(1)).Text(10), fmt.Sprintf("%d", &minusOne))
+}
+
+func TestElementSetText(t *testing.T) {
+	assert := require.New(t)
+
+	var a Element
+	a.SetRandom()
+	var b big.Int
+	a.BigInt(&b)
+
+	dump := a.Dump()
+	montgomery := dump[strings.IndexByte(dump, '['):]
+	for _, s := range []string{
+		dump,
+		b.Text(10),
+		"0x" + b.Text(16),
+		montgomery,
+		"montgomery=" + montgomery,
+		"0x" + b.Text(16) + " " + montgomery,
+	} {
+		var c Element
+		_, err := c.SetText(s)
+		assert.NoError(err, s)
+		assert.True(c.Equal(&a), s)
+	}
+
+	var one Element
+	one.SetOne()
+	for _, s := range []string{
+		"",
+		"not a number",
+		"0x" + b.Text(16) + " (1)",
+		"1 " + montgomery,
+		"[" + strings.Repeat("0x1, ", Limbs) + "0x1]",
+		montgomery[:len(montgomery)-1],
+	} {
+		c := one
+		_, err := c.SetText(s)
+		assert.Error(err, s)
+		assert.True(c.IsOne(), "z must be unchanged on error")
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecdsa
+
+import (
+	"errors"
+	"hash"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/secp256r1"
+	"github.com/consensys/gnark-crypto/ecc/secp256r1/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var errBatchSize = errors.New("the number of public keys, messages, signatures and recovery information differ")
+
+// BatchVerify verifies the signatures sigs[i] of messages[i] under publicKeys[i], where v[i]
+// is the recovery information returned by SignForRecover along with the signature.
+// If hFunc is nil, the messages are considered to be pre-hashed.
+//
+// For random weights zᵢ, it checks with a single multi-scalar multiplication that
+//
+//	∑ zᵢ(u1ᵢ ⋅ g1Gen + u2ᵢ ⋅ Qᵢ - Pᵢ) = 0
+//
+// where u1ᵢ = mᵢ ⋅ sᵢ⁻¹, u2ᵢ = rᵢ ⋅ sᵢ⁻¹ and Pᵢ = RecoverP(v[i], rᵢ) is the signer's commitment.
+//
+// It returns false if one of the signatures is invalid, or if one of the recovery information
+// is wrong, in which case the signatures can still be verified one by one with Verify.
+func BatchVerify(publicKeys []PublicKey, messages, sigs [][]byte, v []uint, hFunc hash.Hash) (bool, error) {
+	n := len(publicKeys)
+	if len(messages) != n || len(sigs) != n || len(v) != n {
+		return false, errBatchSize
+	}
+	if n == 0 {
+		return true, nil
+	}
+
+	// the hash function is not safe for concurrent use
+	m := make([]*big.Int, n)
+	for i := range messages {
+		if hFunc != nil {
+			hFunc.Reset()
+			if _, err := hFunc.Write(messages[i]); err != nil {
+				return false, err
+			}
+			m[i] = HashToInt(hFunc.Sum(nil))
+		} else {
+			m[i] = HashToInt(messages[i])
+		}
+	}
+
+	r := make([]fr.Element, n)
+	sInv := make([]fr.Element, n)
+	for i := range sigs {
+		var sig Signature
+		if _, err := sig.SetBytes(sigs[i]); err != nil {
+			return false, err
+		}
+		r[i].SetBytes(sig.R[:])
+		sInv[i].SetBytes(sig.S[:])
+	}
+	sInv = fr.BatchInvert(sInv)
+
+	// points = [g1Gen, Q₁, …, Qₙ, P₁, …, Pₙ]
+	points := make([]secp256r1.G1Affine, 2*n+1)
+	scalars := make([]fr.Element, 2*n+1)
+	_, points[0] = secp256r1.Generators()
+
+	z := make([]fr.Element, n)
+	for i := range z {
+		if _, err := z[i].SetRandom(); err != nil {
+			return false, err
+		}
+	}
+
+	// zᵢ ⋅ u1ᵢ are summed in scalars[0]
+	u1 := make([]fr.Element, n)
+	var invalid uint32
+	parallel.Execute(n, func(start, end int) {
+		var br big.Int
+		for i := start; i < end; i++ {
+			P, err := RecoverP(v[i], r[i].BigInt(&br))
+			if err != nil {
+				atomic.StoreUint32(&invalid, 1)
+				return
+			}
+			points[1+i] = publicKeys[i].A
+			points[1+n+i] = *P
+
+			var zsInv fr.Element
+			zsInv.Mul(&z[i], &sInv[i])
+			u1[i].SetBigInt(m[i]).Mul(&u1[i], &zsInv)
+			scalars[1+i].Mul(&r[i], &zsInv)
+			scalars[1+n+i].Neg(&z[i])
+		}
+	})
+	if invalid != 0 {
+		return false, nil
+	}
+	for i := range u1 {
+		scalars[0].Add(&scalars[0], &u1[i])
+	}
+
+	var res secp256r1.G1Jac
+	if _, err := res.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return false, err
+	}
+	return res.Z.IsZero(), nil
+}
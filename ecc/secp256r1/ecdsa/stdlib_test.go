@@ -0,0 +1,99 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecdsa
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+)
+
+func TestECDSAStdlib(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("testing ECDSA")
+	digest := sha256.Sum256(msg)
+
+	for i := 0; i < 10; i++ {
+		// signed here, verified by crypto/ecdsa
+		privKey, err := GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sigBin, err := privKey.Sign(msg, sha256.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+		var sig Signature
+		if _, err := sig.SetBytes(sigBin); err != nil {
+			t.Fatal(err)
+		}
+		x, y := elliptic.Unmarshal(elliptic.P256(), privKey.PublicKey.Bytes())
+		if x == nil {
+			t.Fatal("crypto/elliptic can't decode the public key")
+		}
+		stdPub := ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+		r, s := new(big.Int).SetBytes(sig.R[:]), new(big.Int).SetBytes(sig.S[:])
+		if !ecdsa.Verify(&stdPub, digest[:], r, s) {
+			t.Fatal("crypto/ecdsa rejects the signature")
+		}
+
+		// signed by crypto/ecdsa, verified here
+		stdPriv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, s, err = ecdsa.Sign(rand.Reader, stdPriv, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		var publicKey PublicKey
+		if _, err := publicKey.SetBytes(elliptic.Marshal(elliptic.P256(), stdPriv.X, stdPriv.Y)); err != nil {
+			t.Fatal(err)
+		}
+		r.FillBytes(sig.R[:])
+		s.FillBytes(sig.S[:])
+		if ok, err := publicKey.Verify(sig.Bytes(), msg, sha256.New()); err != nil || !ok {
+			t.Fatal("the signature of crypto/ecdsa is rejected")
+		}
+	}
+}
+
+func TestExternalSignerStdlib(t *testing.T) {
+	t.Parallel()
+
+	// a crypto/ecdsa key stands for the external key
+	stdPriv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := NewExternalSigner(stdPriv, crypto.SHA256)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg := []byte("testing ECDSA")
+	sigBin, err := signer.Sign(msg, sha256.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := signer.Public().Verify(sigBin, msg, sha256.New()); err != nil || !ok {
+		t.Fatal("the signature of the external signer is rejected")
+	}
+}
@@ -0,0 +1,19 @@
+package secp256r1
+
+func init() {
+	hashToG1Vector = hashTestVector{
+		dst: []byte("QUUX-V01-CS02-with-P256_XMD:SHA-256_SSWU_RO_"),
+		cases: []hashTestCase{
+			{
+				msg: "", P: point{"0x2c15230b26dbc6fc9a37051158c95b79656e17a1a920b11394ca91c44247d3e4", "0x8a7a74985cc5c776cdfe4b1f19884970453912e9d31528c060be9ab5c43e8415"},
+				Q0: point{"0xab640a12220d3ff283510ff3f4b1953d09fad35795140b1c5d64f313967934d5", "0xdccb558863804a881d4fff3455716c836cef230e5209594ddd33d85c565b19b1"},
+				Q1: point{"0x51cce63c50d972a6e51c61334f0f4875c9ac1cd2d3238412f84e31da7d980ef5", "0xb45d1a36d00ad90e5ec7840a60a4de411917fbe7c82c3949a6e699e5a1b66aac"},
+				u0: "0xad5342c66a6dd0ff080df1da0ea1c04b96e0330dd89406465eeba11582515009", u1: "0x8c0f1d43204bd6f6ea70ae8013070a1518b43873bcd850aafa0a9e220e2eea5a",
+			}, {
+				msg: "abc", P: point{"0x0bb8b87485551aa43ed54f009230450b492fead5f1cc91658775dac4a3388a0f", "0x5c41b3d0731a27a7b14bc0bf0ccded2d8751f83493404c84a88e71ffd424212e"},
+				Q0: point{"0x5219ad0ddef3cc49b714145e91b2f7de6ce0a7a7dc7406c7726c7e373c58cb48", "0x7950144e52d30acbec7b624c203b1996c99617d0b61c2442354301b191d93ecf"},
+				Q1: point{"0x019b7cb4efcfeaf39f738fe638e31d375ad6837f58a852d032ff60c69ee3875f", "0x589a62d2b22357fed5449bc38065b760095ebe6aeac84b01156ee4252715446e"},
+				u0: "0xafe47f2ea2b10465cc26ac403194dfb68b7f5ee865cda61e9f3e07a537220af1", u1: "0x379a27833b0bfe6f7bdca08e1e83c760bf9a338ab335542704edcd69ce9e46e0",
+			},
+		}}
+}
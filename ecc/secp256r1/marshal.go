@@ -0,0 +1,140 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secp256r1
+
+import (
+	"errors"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256r1/fp"
+)
+
+// The points are encoded following SEC 1, v-2, section 2.3.3 (https://www.secg.org/sec1-v2.pdf),
+// as in X.509 certificates and TLS: p has no spare bit to store the metadata of the encoding,
+// so it is stored in a leading byte.
+const (
+	mInfinity         byte = 0x00
+	mCompressedEven   byte = 0x02
+	mCompressedOdd    byte = 0x03
+	mUncompressed     byte = 0x04
+	sizeFpWithPrefix       = 1 + fp.Bytes
+	sizeFpsWithPrefix      = 1 + 2*fp.Bytes
+)
+
+// SizeOfG1AffineCompressed represents the size in bytes that a G1Affine need in binary form, compressed
+const SizeOfG1AffineCompressed = sizeFpWithPrefix
+
+// SizeOfG1AffineUncompressed represents the size in bytes that a G1Affine need in binary form, uncompressed
+const SizeOfG1AffineUncompressed = sizeFpsWithPrefix
+
+var (
+	errInvalidPrefix       = errors.New("invalid point encoding prefix")
+	errInvalidCompressedX  = errors.New("invalid compressed coordinate: square root doesn't exist")
+	errSubGroupCheckFailed = errors.New("invalid point: subgroup check failed")
+)
+
+// Bytes returns the compressed binary representation of p: 0x02 (resp. 0x03) if Y is even
+// (resp. odd), followed by the X coordinate in big endian.
+//
+// The point at infinity is encoded as 0x00 followed by zeroes.
+func (p *G1Affine) Bytes() (res [SizeOfG1AffineCompressed]byte) {
+	if p.IsInfinity() {
+		return
+	}
+	res[0] = mCompressedEven | byte(p.Y.Bits()[0]&1)
+	fp.BigEndian.PutElement((*[fp.Bytes]byte)(res[1:1+fp.Bytes]), p.X)
+	return
+}
+
+// RawBytes returns the uncompressed binary representation of p: 0x04 followed by the X and Y
+// coordinates in big endian.
+//
+// The point at infinity is encoded as 0x00 followed by zeroes.
+func (p *G1Affine) RawBytes() (res [SizeOfG1AffineUncompressed]byte) {
+	if p.IsInfinity() {
+		return
+	}
+	res[0] = mUncompressed
+	fp.BigEndian.PutElement((*[fp.Bytes]byte)(res[1:1+fp.Bytes]), p.X)
+	fp.BigEndian.PutElement((*[fp.Bytes]byte)(res[1+fp.Bytes:1+2*fp.Bytes]), p.Y)
+	return
+}
+
+// SetBytes sets p from binary representation in buf and returns number of consumed bytes
+//
+// bytes in buf must match either RawBytes() or Bytes() output. As in SEC 1, the point at
+// infinity is read from its first byte (0x00) only.
+//
+// if buf is too short io.ErrShortBuffer is returned
+//
+// if buf contains compressed representation (output from Bytes()) and we're unable to compute
+// the Y coordinate (i.e the square root doesn't exist) this function returns an error
+//
+// this check if the resulting point is on the curve and in the correct subgroup
+func (p *G1Affine) SetBytes(buf []byte) (int, error) {
+	return p.setBytes(buf, true)
+}
+
+func (p *G1Affine) setBytes(buf []byte, subGroupCheck bool) (int, error) {
+	if len(buf) == 0 {
+		return 0, io.ErrShortBuffer
+	}
+
+	switch buf[0] {
+	case mInfinity:
+		p.X.SetZero()
+		p.Y.SetZero()
+		return 1, nil
+	case mUncompressed:
+		if len(buf) < SizeOfG1AffineUncompressed {
+			return 0, io.ErrShortBuffer
+		}
+		if err := p.X.SetBytesCanonical(buf[1 : 1+fp.Bytes]); err != nil {
+			return 0, err
+		}
+		if err := p.Y.SetBytesCanonical(buf[1+fp.Bytes : 1+2*fp.Bytes]); err != nil {
+			return 0, err
+		}
+		// the curve has a prime order, the subgroup check is the curve equation
+		if subGroupCheck && !p.IsInSubGroup() {
+			return 0, errSubGroupCheckFailed
+		}
+		return SizeOfG1AffineUncompressed, nil
+	case mCompressedEven, mCompressedOdd:
+		if len(buf) < SizeOfG1AffineCompressed {
+			return 0, io.ErrShortBuffer
+		}
+		if err := p.X.SetBytesCanonical(buf[1 : 1+fp.Bytes]); err != nil {
+			return 0, err
+		}
+
+		// y²=x³-3x+b
+		var YSquared, Y, tmp fp.Element
+		YSquared.Square(&p.X).Mul(&YSquared, &p.X)
+		tmp.Mul(&p.X, &aCurveCoeff)
+		YSquared.Add(&YSquared, &tmp).
+			Add(&YSquared, &bCurveCoeff)
+		if Y.Sqrt(&YSquared) == nil {
+			return 0, errInvalidCompressedX
+		}
+		if byte(Y.Bits()[0]&1) != buf[0]&1 {
+			Y.Neg(&Y)
+		}
+		p.Y = Y
+		return SizeOfG1AffineCompressed, nil
+	default:
+		return 0, errInvalidPrefix
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secp256r1
+
+import (
+	"crypto/ecdh"
+	"crypto/elliptic"
+	"crypto/rand"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256r1/fp"
+)
+
+func TestG1AffineSerialization(t *testing.T) {
+	t.Parallel()
+	// test round trip serialization of infinity
+	{
+		var p1, p2 G1Affine
+		p2.X.SetRandom()
+		p2.Y.SetRandom()
+		buf := p1.RawBytes()
+		n, err := p2.SetBytes(buf[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != 1 {
+			t.Fatal("invalid number of bytes consumed in buffer")
+		}
+		if !p2.IsInfinity() {
+			t.Fatal("deserialization of uncompressed infinity point is not infinity")
+		}
+		b := p1.Bytes()
+		if b != [SizeOfG1AffineCompressed]byte{} {
+			t.Fatal("compressed infinity should be all zeroes")
+		}
+	}
+
+	// invalid encodings
+	{
+		var p G1Affine
+		buf := g1GenAff.RawBytes()
+		if _, err := p.SetBytes(buf[:SizeOfG1AffineUncompressed-1]); err != io.ErrShortBuffer {
+			t.Fatal("expected io.ErrShortBuffer, got", err)
+		}
+		buf[0] = 0x05
+		if _, err := p.SetBytes(buf[:]); err != errInvalidPrefix {
+			t.Fatal("expected errInvalidPrefix, got", err)
+		}
+		buf = g1GenAff.RawBytes()
+		buf[len(buf)-1] ^= 1
+		if _, err := p.SetBytes(buf[:]); err != errSubGroupCheckFailed {
+			t.Fatal("expected errSubGroupCheckFailed, got", err)
+		}
+	}
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[G1] Affine SetBytes(RawBytes) should stay the same", prop.ForAll(
+		func(a fp.Element) bool {
+			var start, end G1Affine
+			var ab big.Int
+			a.BigInt(&ab)
+			start.ScalarMultiplication(&g1GenAff, &ab)
+
+			buf := start.RawBytes()
+			n, err := end.SetBytes(buf[:])
+			if err != nil {
+				return false
+			}
+			if n != SizeOfG1AffineUncompressed {
+				return false
+			}
+			return start.X.Equal(&end.X) && start.Y.Equal(&end.Y)
+		},
+		GenFp(),
+	))
+
+	properties.Property("[G1] Affine SetBytes(Bytes()) should stay the same", prop.ForAll(
+		func(a fp.Element) bool {
+			var start, end G1Affine
+			var ab big.Int
+			a.BigInt(&ab)
+			start.ScalarMultiplication(&g1GenAff, &ab)
+
+			buf := start.Bytes()
+			n, err := end.SetBytes(buf[:])
+			if err != nil {
+				return false
+			}
+			if n != SizeOfG1AffineCompressed {
+				return false
+			}
+			return start.X.Equal(&end.X) && start.Y.Equal(&end.Y)
+		},
+		GenFp(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestG1AffineSerializationStdlib(t *testing.T) {
+	t.Parallel()
+
+	// the public keys of crypto/ecdh are [d]G, encoded as SEC 1 uncompressed points
+	for i := 0; i < 10; i++ {
+		sk, err := ecdh.P256().GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var d big.Int
+		d.SetBytes(sk.Bytes())
+		var expected, p G1Affine
+		expected.ScalarMultiplication(&g1GenAff, &d)
+
+		if _, err := p.SetBytes(sk.PublicKey().Bytes()); err != nil {
+			t.Fatal(err)
+		}
+		if !p.Equal(&expected) {
+			t.Fatal("public key of crypto/ecdh doesn't match [d]G")
+		}
+		raw := expected.RawBytes()
+		if string(raw[:]) != string(sk.PublicKey().Bytes()) {
+			t.Fatal("RawBytes doesn't match the encoding of crypto/ecdh")
+		}
+
+		// compressed encoding
+		x, y := elliptic.Unmarshal(elliptic.P256(), raw[:])
+		compressed := expected.Bytes()
+		if string(compressed[:]) != string(elliptic.MarshalCompressed(elliptic.P256(), x, y)) {
+			t.Fatal("Bytes doesn't match the compressed encoding of crypto/elliptic")
+		}
+	}
+}
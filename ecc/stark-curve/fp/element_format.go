@@ -0,0 +1,141 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fp
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var errFormattedText = errors.New("can't parse Element")
+
+// Format implements fmt.Formatter, to print z in the forms needed to compare it
+// with other libraries:
+//
+//	%v, %s    z.String()
+//	%+v       z.Dump()
+//	%d        canonical value in decimal
+//	%x, %X    canonical value in hexadecimal, prefixed by 0x with the # flag
+func (z *Element) Format(s fmt.State, verb rune) {
+	if z == nil {
+		fmt.Fprint(s, "<nil>")
+		return
+	}
+	var b big.Int
+	switch verb {
+	case 'v', 's':
+		if verb == 'v' && s.Flag('+') {
+			fmt.Fprint(s, z.Dump())
+			return
+		}
+		fmt.Fprint(s, z.String())
+	case 'd':
+		fmt.Fprint(s, z.BigInt(&b).Text(10))
+	case 'x', 'X':
+		text := z.BigInt(&b).Text(16)
+		if verb == 'X' {
+			text = strings.ToUpper(text)
+		}
+		if s.Flag('#') {
+			text = "0x" + text
+		}
+		fmt.Fprint(s, text)
+	default:
+		fmt.Fprintf(s, "%%!%c(fp.Element=%s)", verb, z.String())
+	}
+}
+
+// Dump returns z as "0x<hex> (<decimal>) montgomery=[<limbs>]", where hex and decimal
+// are the canonical value of z and limbs are the words of its Montgomery form, least
+// significant first, as stored in memory. SetText parses it back.
+func (z *Element) Dump() string {
+	var b big.Int
+	z.BigInt(&b)
+	var sb strings.Builder
+	sb.WriteString("0x")
+	sb.WriteString(b.Text(16))
+	sb.WriteString(" (")
+	sb.WriteString(b.Text(10))
+	sb.WriteString(") montgomery=[")
+	for i := 0; i < Limbs; i++ {
+		if i != 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("0x%016x", z[i]))
+	}
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// SetText sets z from one or several of the forms printed by Dump:
+//
+//	a number in decimal or hexadecimal (0x prefix), as accepted by SetString
+//	the words of the Montgomery form, least significant first: [w₀, w₁, ...]
+//	the output of Dump, or of fmt with %+v
+//
+// When several forms are given, they must agree. If s can't be parsed, z is
+// left unchanged and an error is returned.
+func (z *Element) SetText(s string) (*Element, error) {
+	var res Element
+	parsed := false
+
+	// Montgomery words
+	if i := strings.IndexByte(s, '['); i >= 0 {
+		j := strings.LastIndexByte(s, ']')
+		if j < i {
+			return nil, errFormattedText
+		}
+		words := strings.Split(s[i+1:j], ",")
+		if len(words) != Limbs {
+			return nil, errFormattedText
+		}
+		for k := range words {
+			w, err := strconv.ParseUint(strings.TrimSpace(words[k]), 0, 64)
+			if err != nil {
+				return nil, errFormattedText
+			}
+			res[k] = w
+		}
+		if !res.smallerThanModulus() {
+			return nil, errFormattedText
+		}
+		s = strings.TrimSuffix(strings.TrimSpace(s[:i]+s[j+1:]), "montgomery=")
+		parsed = true
+	}
+
+	// canonical values
+	for _, field := range strings.Fields(s) {
+		field = strings.TrimSuffix(strings.TrimPrefix(field, "("), ")")
+		var v Element
+		if _, err := v.SetString(field); err != nil {
+			return nil, errFormattedText
+		}
+		if parsed && !v.Equal(&res) {
+			return nil, errFormattedText
+		}
+		res, parsed = v, true
+	}
+
+	if !parsed {
+		return nil, errFormattedText
+	}
+	*z = res
+	return z, nil
+}
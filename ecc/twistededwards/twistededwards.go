@@ -1,6 +1,8 @@
 // Package twistededwards define unique identifier for twisted edwards curves implemented in gnark-crypto
 package twistededwards
 
+import "math/big"
+
 // ID represent a unique ID for a twisted edwards curve
 type ID uint16
 
@@ -17,3 +19,82 @@ const (
 	BW6_756
 	BW6_633
 )
+
+// Curve is a twisted Edwards curve ax² + y² = 1 + dx²y² defined over the scalar field of
+// the curve it is embedded in.
+//
+// It is implemented by each twisted Edwards package of gnark-crypto (see for example
+// bn254/twistededwards.Curve()) so that EdDSA, Pedersen hashes, ... can target the
+// embedded curve of a given curve without depending on its package.
+type Curve interface {
+	// ID returns the ID of the curve
+	ID() ID
+
+	// Params returns the parameters of the curve
+	Params() Params
+
+	// BaseFieldModulus returns the modulus of the field the curve is defined over,
+	// that is the modulus of the scalar field of the curve it is embedded in.
+	BaseFieldModulus() *big.Int
+
+	// Base returns a new point set to the generator of the prime subgroup
+	Base() Point
+
+	// NewPoint returns a new point set to the identity (0, 1)
+	NewPoint() Point
+}
+
+// Params are the parameters of a twisted Edwards curve ax² + y² = 1 + dx²y²
+type Params struct {
+	A, D     *big.Int
+	Cofactor *big.Int
+	Order    *big.Int // order of the prime subgroup, i.e. modulus of the scalar field
+}
+
+// Point is a point of a twisted Edwards curve, in affine coordinates.
+//
+// Points of different curves must not be mixed; methods panic if an operand doesn't belong
+// to the same curve as the receiver.
+type Point interface {
+	// Set sets p to p1 and returns it
+	Set(p1 Point) Point
+
+	// Equal returns true if p = p1
+	Equal(p1 Point) bool
+
+	// IsZero returns true if p is the identity (0, 1)
+	IsZero() bool
+
+	// IsOnCurve returns true if p is on the curve
+	IsOnCurve() bool
+
+	// IsInSubGroup returns true if p is on the curve and in the prime subgroup
+	IsInSubGroup() bool
+
+	// Neg sets p to -p1 and returns it
+	Neg(p1 Point) Point
+
+	// Add sets p to p1 + p2 and returns it
+	Add(p1, p2 Point) Point
+
+	// Double sets p to [2]p1 and returns it
+	Double(p1 Point) Point
+
+	// ScalarMultiplication sets p to [scalar]p1 and returns it
+	ScalarMultiplication(p1 Point, scalar *big.Int) Point
+
+	// ClearCofactor sets p to [h]p1, where h is the cofactor of the curve, and returns it
+	ClearCofactor(p1 Point) Point
+
+	// Coordinates returns the coordinates of p as big integers
+	Coordinates() (x, y *big.Int)
+
+	// SetCoordinates sets p to (x, y) and returns an error if it is not on the curve
+	SetCoordinates(x, y *big.Int) error
+
+	// Bytes returns the compressed encoding of p (https://tools.ietf.org/html/rfc8032#section-3.1)
+	Bytes() []byte
+
+	// SetBytes sets p from its compressed encoding and returns the number of bytes read
+	SetBytes(buf []byte) (int, error)
+}
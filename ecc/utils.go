@@ -3,6 +3,8 @@ package ecc
 import (
 	"math/big"
 	"math/bits"
+
+	"github.com/consensys/gnark-crypto/random"
 )
 
 //-------------------------------------------------------
@@ -193,3 +195,26 @@ func NextPowerOfTwo(n uint64) uint64 {
 	}
 	return uint64(1) << (64 - t)
 }
+
+// ScalarBlindingBits is the bit size of the random multiplier used by BlindScalar
+const ScalarBlindingBits = 64
+
+// BlindScalar returns (s mod order) + m⋅order, for a random m of ScalarBlindingBits bits
+// read from the package-level source of randomness (see random.SetReader).
+//
+// For any point P of order dividing order, [BlindScalar(s, order)]P = [s]P, but the bits
+// of the blinded scalar change at each call. Scalar multiplications by a secret scalar
+// (e.g. a signing nonce) use it to decorrelate their sequence of operations from the
+// secret, as a hardening against side-channel attacks.
+func BlindScalar(s, order *big.Int) (*big.Int, error) {
+	var buf [ScalarBlindingBits / 8]byte
+	if _, err := random.Read(buf[:]); err != nil {
+		return nil, err
+	}
+	var m big.Int
+	m.SetBytes(buf[:])
+
+	res := new(big.Int).Mod(s, order)
+	m.Mul(&m, order)
+	return res.Add(res, &m), nil
+}
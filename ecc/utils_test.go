@@ -43,6 +43,36 @@ func TestSplitting(t *testing.T) {
 
 }
 
+func TestBlindScalar(t *testing.T) {
+	t.Parallel()
+
+	var r big.Int
+	r.SetString("21888242871839275222246405745257275088548364400416034343698204186575808495617", 10)
+
+	for _, s := range []*big.Int{big.NewInt(0), big.NewInt(42), big.NewInt(-42), new(big.Int).Lsh(&r, 2)} {
+		k1, err := BlindScalar(s, &r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		k2, err := BlindScalar(s, &r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if k1.Sign() < 0 || k1.BitLen() > r.BitLen()+ScalarBlindingBits {
+			t.Fatal("blinded scalar out of range")
+		}
+		if k1.Cmp(k2) == 0 {
+			t.Fatal("two blindings of the same scalar should differ")
+		}
+		var d1, d2 big.Int
+		d1.Sub(k1, s).Mod(&d1, &r)
+		d2.Sub(k2, s).Mod(&d2, &r)
+		if d1.Sign() != 0 || d2.Sign() != 0 {
+			t.Fatal("blinded scalar should be equal to the scalar modulo r")
+		}
+	}
+}
+
 func BenchmarkSplitting256(b *testing.B) {
 
 	var lambda, r, s big.Int
@@ -59,3 +89,39 @@ func BenchmarkSplitting256(b *testing.B) {
 	}
 
 }
+
+func TestPartitionMultiExp(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{0, 1, 10, 1001} {
+		for _, nbShards := range []int{1, 3, 16} {
+			shards, err := PartitionMultiExp(n, nbShards)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(shards) != nbShards {
+				t.Fatal("wrong number of shards")
+			}
+			var next uint64
+			for _, s := range shards {
+				if s.Start != next || s.End < s.Start {
+					t.Fatal("shards must be contiguous and in order")
+				}
+				if d := s.Len() - n/nbShards; d != 0 && d != 1 {
+					t.Fatal("shards must be balanced")
+				}
+				next = s.End
+			}
+			if next != uint64(n) {
+				t.Fatal("shards must cover the multi-exponentiation")
+			}
+		}
+	}
+
+	if _, err := PartitionMultiExp(10, 0); err == nil {
+		t.Fatal("expected an error for 0 shards")
+	}
+	if _, err := PartitionMultiExp(-1, 2); err == nil {
+		t.Fatal("expected an error for a negative size")
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fiatshamir
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/big"
+)
+
+var (
+	errNegativeValue   = errors.New("cannot bind a negative value")
+	errValueTooLarge   = errors.New("the value doesn't fit in the requested number of bytes")
+	errInvalidByteSize = errors.New("the number of bytes must be positive")
+	errInvalidModulus  = errors.New("the modulus must be greater than 1")
+)
+
+// The bound values of a challenge are hashed one after the other, without separators: binding
+// variable length values (e.g. the Marshal() outputs of several elements of different sizes)
+// is ambiguous, since ("ab", "c") and ("a", "bc") give the same challenge. The helpers below
+// encode the values on a fixed number of bytes, or prefix them with their length.
+
+// BindUint32 binds the challenge to v, encoded on 4 bytes in big endian.
+func (t *Transcript) BindUint32(challengeID string, v uint32) error {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], v)
+	return t.Bind(challengeID, b[:])
+}
+
+// BindUint64 binds the challenge to v, encoded on 8 bytes in big endian.
+func (t *Transcript) BindUint64(challengeID string, v uint64) error {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], v)
+	return t.Bind(challengeID, b[:])
+}
+
+// BindLength binds the challenge to a length (of a vector, of a message...), encoded on 8 bytes
+// in big endian.
+func (t *Transcript) BindLength(challengeID string, n int) error {
+	if n < 0 {
+		return errNegativeValue
+	}
+	return t.BindUint64(challengeID, uint64(n))
+}
+
+// BindBigInt binds the challenge to the non-negative integer v, encoded on exactly size bytes
+// in big endian.
+func (t *Transcript) BindBigInt(challengeID string, v *big.Int, size int) error {
+	if size <= 0 {
+		return errInvalidByteSize
+	}
+	if v.Sign() < 0 {
+		return errNegativeValue
+	}
+	if (v.BitLen()+7)/8 > size {
+		return errValueTooLarge
+	}
+	b := make([]byte, size)
+	v.FillBytes(b)
+	return t.Bind(challengeID, b)
+}
+
+// BindBytes binds the challenge to b, prefixed with its length encoded on 8 bytes, so that
+// consecutive values of variable length can't be shifted from one to the other.
+func (t *Transcript) BindBytes(challengeID string, b []byte) error {
+	buf := make([]byte, 8+len(b))
+	binary.BigEndian.PutUint64(buf, uint64(len(b)))
+	copy(buf[8:], b)
+	return t.Bind(challengeID, buf)
+}
+
+// BindVersion binds the challenge to a protocol name or version tag, for domain separation
+// between protocols (or versions of a protocol) sharing the same challenge names. It should be
+// bound to the first challenge of the transcript, before any other value.
+func (t *Transcript) BindVersion(challengeID string, tag string) error {
+	return t.BindBytes(challengeID, []byte(tag))
+}
+
+// ComputeChallengeElement computes the challenge (see ComputeChallenge) and returns it as an
+// element of [0, modulus), e.g. of the scalar field of a curve: the challenge bytes are read as a
+// big endian integer, and reduced modulo modulus. This is the conversion of fr.Element.SetBytes,
+// so that the challenge is the one a verifier computes from the same bytes, in or out of a
+// circuit.
+//
+// When the hash output is not much longer than the modulus (e.g. SHA-256 or Keccak-256 and
+// a 255 bits modulus), the reduction is slightly biased towards small values; this is the
+// usual trade-off of the Fiat-Shamir challenges of the repository.
+func (t *Transcript) ComputeChallengeElement(challengeID string, modulus *big.Int) (*big.Int, error) {
+	if modulus.Cmp(big.NewInt(1)) <= 0 {
+		return nil, errInvalidModulus
+	}
+	b, err := t.ComputeChallenge(challengeID)
+	if err != nil {
+		return nil, err
+	}
+	res := new(big.Int).SetBytes(b)
+	return res.Mod(res, modulus), nil
+}
@@ -0,0 +1,141 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fiatshamir
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+)
+
+func TestBindFixedWidth(t *testing.T) {
+	t.Parallel()
+
+	expected := [][]byte{
+		{0, 0, 0, 5},
+		{0, 0, 0, 0, 0, 0, 1, 0},
+		{0, 0, 0, 0, 0, 0, 0, 3},
+		{0, 0, 0, 0x01, 0x02},
+		{0, 0, 0, 0, 0, 0, 0, 2, 'a', 'b'},
+	}
+
+	fs := NewTranscript(sha256.New(), "alpha")
+	if err := fs.BindUint32("alpha", 5); err != nil {
+		t.Fatal(err)
+	}
+	if err := fs.BindUint64("alpha", 256); err != nil {
+		t.Fatal(err)
+	}
+	if err := fs.BindLength("alpha", 3); err != nil {
+		t.Fatal(err)
+	}
+	if err := fs.BindBigInt("alpha", big.NewInt(0x0102), 5); err != nil {
+		t.Fatal(err)
+	}
+	if err := fs.BindBytes("alpha", []byte("ab")); err != nil {
+		t.Fatal(err)
+	}
+
+	bindings := fs.challenges["alpha"].bindings
+	if len(bindings) != len(expected) {
+		t.Fatal("wrong number of bindings")
+	}
+	for i := range expected {
+		if !bytes.Equal(bindings[i], expected[i]) {
+			t.Fatalf("binding %d: expected %x, got %x", i, expected[i], bindings[i])
+		}
+	}
+}
+
+func TestBindBytesUnambiguous(t *testing.T) {
+	t.Parallel()
+
+	challenge := func(values ...string) []byte {
+		fs := NewTranscript(sha256.New(), "alpha")
+		if err := fs.BindVersion("alpha", "protocol-v1"); err != nil {
+			t.Fatal(err)
+		}
+		for _, v := range values {
+			if err := fs.BindBytes("alpha", []byte(v)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		res, err := fs.ComputeChallenge("alpha")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+
+	if bytes.Equal(challenge("ab", "c"), challenge("a", "bc")) {
+		t.Fatal("shifting bytes from one value to the next should change the challenge")
+	}
+	if !bytes.Equal(challenge("ab", "c"), challenge("ab", "c")) {
+		t.Fatal("the challenge should be deterministic")
+	}
+}
+
+func TestBindErrors(t *testing.T) {
+	t.Parallel()
+
+	fs := NewTranscript(sha256.New(), "alpha")
+	if err := fs.BindLength("alpha", -1); err != errNegativeValue {
+		t.Fatal("expected errNegativeValue, got", err)
+	}
+	if err := fs.BindBigInt("alpha", big.NewInt(-1), 8); err != errNegativeValue {
+		t.Fatal("expected errNegativeValue, got", err)
+	}
+	if err := fs.BindBigInt("alpha", big.NewInt(256), 1); err != errValueTooLarge {
+		t.Fatal("expected errValueTooLarge, got", err)
+	}
+	if err := fs.BindBigInt("alpha", big.NewInt(1), 0); err != errInvalidByteSize {
+		t.Fatal("expected errInvalidByteSize, got", err)
+	}
+	if err := fs.BindUint64("beta", 1); err != errChallengeNotFound {
+		t.Fatal("expected errChallengeNotFound, got", err)
+	}
+}
+
+func TestComputeChallengeElement(t *testing.T) {
+	t.Parallel()
+
+	modulus := big.NewInt(1000003)
+
+	fs := NewTranscript(sha256.New(), "alpha", "beta")
+	if err := fs.Bind("alpha", []byte("data")); err != nil {
+		t.Fatal(err)
+	}
+	b, err := fs.ComputeChallenge("alpha")
+	if err != nil {
+		t.Fatal(err)
+	}
+	alpha, err := fs.ComputeChallengeElement("alpha", modulus)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := new(big.Int).SetBytes(b)
+	expected.Mod(expected, modulus)
+	if alpha.Cmp(expected) != 0 {
+		t.Fatal("the challenge should be the reduction of the challenge bytes")
+	}
+
+	if _, err := fs.ComputeChallengeElement("beta", big.NewInt(1)); err != errInvalidModulus {
+		t.Fatal("expected errInvalidModulus, got", err)
+	}
+	if _, err := fs.ComputeChallengeElement("gamma", modulus); err != errChallengeNotFound {
+		t.Fatal("expected errChallengeNotFound, got", err)
+	}
+}
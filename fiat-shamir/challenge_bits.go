@@ -0,0 +1,70 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fiatshamir
+
+import (
+	"fmt"
+	"hash"
+)
+
+// challengeBitsHash is a hash function marked with the bit length of the challenges of the
+// transcripts created from it. It behaves as the underlying hash otherwise.
+type challengeBitsHash struct {
+	hash.Hash
+	nbBits int
+}
+
+// WithChallengeBits returns h, marked so that the challenges of the transcripts created from it
+// with NewTranscript are truncated to nbBits bits, e.g. 128 or 248 (31 bytes) for challenges
+// which are cheaper to handle in a circuit verifying the transcript.
+//
+// A truncated challenge is made of the ⌈nbBits/8⌉ last bytes of the hash output, whose top bits
+// beyond nbBits are cleared: read as a big endian integer, it is the hash output modulo 2^nbBits.
+// The next challenge of the transcript is bound to the truncated value.
+//
+// The returned hash function behaves as h otherwise: the sub-protocols which take a hash.Hash
+// for their transcripts (e.g. KZG, FRI) truncate their challenges the same way when given it, and
+// those which also hash other data with it (e.g. the Merkle trees of FRI) are not affected.
+//
+// It panics if nbBits is not positive.
+func WithChallengeBits(h hash.Hash, nbBits int) hash.Hash {
+	if nbBits <= 0 {
+		panic(fmt.Sprintf("invalid challenge bit length %d", nbBits))
+	}
+	if m, ok := h.(*challengeBitsHash); ok {
+		h = m.Hash
+	}
+	return &challengeBitsHash{Hash: h, nbBits: nbBits}
+}
+
+// ChallengeBits returns the bit length the challenges of t are truncated to, or 0 if they aren't
+// truncated (see WithChallengeBits).
+func (t *Transcript) ChallengeBits() int {
+	return t.challengeBits
+}
+
+// truncateChallenge returns the last ⌈nbBits/8⌉ bytes of b, with the bits beyond nbBits cleared.
+// b is returned as is if it is shorter.
+func truncateChallenge(b []byte, nbBits int) []byte {
+	nbBytes := (nbBits + 7) / 8
+	if nbBytes >= len(b) {
+		return b
+	}
+	res := b[len(b)-nbBytes:]
+	if r := nbBits % 8; r != 0 {
+		res[0] &= byte(1)<<r - 1
+	}
+	return res
+}
@@ -0,0 +1,68 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fiatshamir
+
+import (
+	"errors"
+	"hash"
+	"math/big"
+)
+
+var (
+	errNotFieldTranscript = errors.New("the transcript is not over a field, see NewFieldTranscript")
+	errNotFieldElement    = errors.New("the value is not a canonical element of the transcript field")
+)
+
+// NewFieldTranscript returns a transcript over the field of the given modulus, whose
+// challenges are computed with h, a hash over this field such as MiMC (see the mimc
+// subpackage for the MiMC transcripts of the scalar fields of the curves): the challenges are
+// field elements, and a verifier can recompute them in a circuit over the same field.
+//
+// The values should be bound with BindElement and BindPoint, which encode them as field
+// elements; the challenge names must be shorter than a field element. The transcript is then
+// byte for byte the one of an in-circuit transcript with h binding the same elements.
+func NewFieldTranscript(h hash.Hash, modulus *big.Int, challengesID ...string) *Transcript {
+	t := NewTranscript(h, challengesID...)
+	t.modulus = new(big.Int).Set(modulus)
+	return t
+}
+
+// BindElement binds the challenge to v, which must be an element of the field of a transcript
+// returned by NewFieldTranscript, in [0, modulus). It is encoded on the size of a field element,
+// in big endian.
+func (t *Transcript) BindElement(challengeID string, v *big.Int) error {
+	if t.modulus == nil {
+		return errNotFieldTranscript
+	}
+	if v.Sign() < 0 || v.Cmp(t.modulus) >= 0 {
+		return errNotFieldElement
+	}
+	b := make([]byte, (t.modulus.BitLen()+7)/8)
+	v.FillBytes(b)
+	return t.Bind(challengeID, b)
+}
+
+// BindPoint binds the challenge to a point given by its coordinates, which must be elements of
+// the field of the transcript (see BindElement), e.g. the X and Y coordinates of a point of
+// the inner curve of a 2-chain, such as BLS12-377 for BW6-761. Points with coordinates in an
+// extension are given by all the coordinates of the extension, in the order of the circuit.
+func (t *Transcript) BindPoint(challengeID string, coordinates ...*big.Int) error {
+	for _, c := range coordinates {
+		if err := t.BindElement(challengeID, c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fiatshamir
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"testing"
+)
+
+func TestFieldTranscriptErrors(t *testing.T) {
+	t.Parallel()
+
+	modulus := big.NewInt(101)
+	fs := NewFieldTranscript(sha256.New(), modulus, "alpha")
+	if err := fs.BindElement("alpha", modulus); err != errNotFieldElement {
+		t.Fatalf("expected errNotFieldElement, got %v", err)
+	}
+	if err := fs.BindPoint("alpha", big.NewInt(1), big.NewInt(-1)); err != errNotFieldElement {
+		t.Fatalf("expected errNotFieldElement, got %v", err)
+	}
+	if err := fs.BindElement("alpha", big.NewInt(100)); err != nil {
+		t.Fatal(err)
+	}
+
+	fs = NewTranscript(sha256.New(), "alpha")
+	if err := fs.BindElement("alpha", big.NewInt(1)); err != errNotFieldTranscript {
+		t.Fatalf("expected errNotFieldTranscript, got %v", err)
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mimc provides Fiat-Shamir transcripts over the scalar fields of the curves, with the
+// MiMC hash of these fields, so that a verifier in a circuit can recompute the challenges.
+//
+// It is kept out of the fiat-shamir package, which would otherwise import the MiMC hashes of
+// all the curves.
+package mimc
+
+import (
+	"errors"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/hash"
+)
+
+var ErrUnsupportedCurve = errors.New("no MiMC hash for this curve")
+
+// field-native hashes, by curve: the MiMC hash over the scalar field of the curve
+var hashes = map[ecc.ID]hash.Hash{
+	ecc.BN254:     hash.MIMC_BN254,
+	ecc.BLS12_381: hash.MIMC_BLS12_381,
+	ecc.BLS12_377: hash.MIMC_BLS12_377,
+	ecc.BLS12_378: hash.MIMC_BLS12_378,
+	ecc.BW6_761:   hash.MIMC_BW6_761,
+	ecc.BLS24_315: hash.MIMC_BLS24_315,
+	ecc.BLS24_317: hash.MIMC_BLS24_317,
+	ecc.BW6_633:   hash.MIMC_BW6_633,
+	ecc.BW6_756:   hash.MIMC_BW6_756,
+}
+
+// NewTranscript returns a transcript over the scalar field of curve, whose challenges are
+// computed with the MiMC hash of this field (see fiatshamir.NewFieldTranscript).
+func NewTranscript(curve ecc.ID, challengesID ...string) (*fiatshamir.Transcript, error) {
+	h, ok := hashes[curve]
+	if !ok {
+		return nil, ErrUnsupportedCurve
+	}
+	return fiatshamir.NewFieldTranscript(h.New(), curve.ScalarField(), challengesID...), nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mimc
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/hash"
+)
+
+func TestTranscript(t *testing.T) {
+	t.Parallel()
+
+	fs, err := NewTranscript(ecc.BW6_761, "alpha", "beta")
+	if err != nil {
+		t.Fatal(err)
+	}
+	x, y := big.NewInt(3), new(big.Int).Sub(ecc.BW6_761.ScalarField(), big.NewInt(1))
+	if err := fs.BindPoint("alpha", x, y); err != nil {
+		t.Fatal(err)
+	}
+	alpha, err := fs.ComputeChallenge("alpha")
+	if err != nil {
+		t.Fatal(err)
+	}
+	beta, err := fs.ComputeChallenge("beta")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the same transcript, with the elements written one by one in the MiMC hash
+	size := (ecc.BW6_761.ScalarField().BitLen() + 7) / 8
+	h := hash.MIMC_BW6_761.New()
+	_, _ = h.Write([]byte("alpha"))
+	for _, v := range []*big.Int{x, y} {
+		_, _ = h.Write(v.FillBytes(make([]byte, size)))
+	}
+	if expected := h.Sum(nil); !bytes.Equal(alpha, expected) {
+		t.Fatal("wrong challenge alpha")
+	}
+	h.Reset()
+	_, _ = h.Write([]byte("beta"))
+	_, _ = h.Write(alpha)
+	if expected := h.Sum(nil); !bytes.Equal(beta, expected) {
+		t.Fatal("wrong challenge beta")
+	}
+}
+
+func TestUnsupportedCurve(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewTranscript(ecc.SECP256K1, "alpha"); err != ErrUnsupportedCurve {
+		t.Fatalf("expected ErrUnsupportedCurve, got %v", err)
+	}
+}
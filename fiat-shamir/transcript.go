@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"hash"
+	"math/big"
 )
 
 // errChallengeNotFound is returned when a wrong challenge name is provided.
@@ -34,6 +35,12 @@ type Transcript struct {
 
 	challenges map[string]challenge
 	previous   *challenge
+
+	// challengeBits, if not zero, is the bit length the challenges are truncated to
+	challengeBits int
+
+	// modulus of the field of the transcripts returned by NewFieldTranscript, nil otherwise
+	modulus *big.Int
 }
 
 type challenge struct {
@@ -46,6 +53,7 @@ type challenge struct {
 // NewTranscript returns a new transcript.
 // h is the hash function that is used to compute the challenges.
 // challenges are the name of the challenges. The order of the challenges IDs matters.
+// If h is returned by WithChallengeBits, the challenges are truncated accordingly.
 func NewTranscript(h hash.Hash, challengesID ...string) *Transcript {
 	challenges := make(map[string]challenge)
 	for i := range challengesID {
@@ -55,6 +63,9 @@ func NewTranscript(h hash.Hash, challengesID ...string) *Transcript {
 		challenges: challenges,
 		h:          h,
 	}
+	if m, ok := h.(*challengeBitsHash); ok {
+		t.challengeBits = m.nbBits
+	}
 	return t
 }
 
@@ -86,6 +97,7 @@ func (t *Transcript) Bind(challengeID string, bValue []byte) error {
 // The challenge is:
 // * H(name || previous_challenge || binded_values...) if the challenge is not the first one
 // * H(name || binded_values... ) if it is the first challenge
+// truncated if the transcript was created with a hash returned by WithChallengeBits.
 func (t *Transcript) ComputeChallenge(challengeID string) ([]byte, error) {
 
 	challenge, ok := t.challenges[challengeID]
@@ -125,6 +137,9 @@ func (t *Transcript) ComputeChallenge(challengeID string) ([]byte, error) {
 
 	// compute the hash of the accumulated values
 	res := t.h.Sum(nil)
+	if t.challengeBits != 0 {
+		res = truncateChallenge(res, t.challengeBits)
+	}
 
 	challenge.value = make([]byte, len(res))
 	copy(challenge.value, res)
@@ -139,3 +139,51 @@ func TestBindToComputedChallenge(t *testing.T) {
 	}
 
 }
+
+func TestChallengeBits(t *testing.T) {
+	t.Parallel()
+
+	for _, nbBits := range []int{128, 248, 125} {
+		h := WithChallengeBits(sha256.New(), nbBits)
+		fs := NewTranscript(h, "alpha", "beta")
+		if fs.ChallengeBits() != nbBits {
+			t.Fatal("wrong challenge bit length")
+		}
+		if err := fs.Bind("alpha", []byte("v1")); err != nil {
+			t.Fatal(err)
+		}
+		alpha, err := fs.ComputeChallenge("alpha")
+		if err != nil {
+			t.Fatal(err)
+		}
+		beta, err := fs.ComputeChallenge("beta")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the challenges are the truncated hashes, chained with the truncated values
+		nbBytes := (nbBits + 7) / 8
+		hAlpha := sha256.Sum256([]byte("alphav1"))
+		expected := hAlpha[32-nbBytes:]
+		expected[0] &= byte(0xff >> (8*nbBytes - nbBits))
+		if !bytes.Equal(alpha, expected) {
+			t.Fatalf("%d bits: wrong truncated challenge", nbBits)
+		}
+		hBeta := sha256.Sum256(append([]byte("beta"), alpha...))
+		expected = hBeta[32-nbBytes:]
+		expected[0] &= byte(0xff >> (8*nbBytes - nbBits))
+		if !bytes.Equal(beta, expected) {
+			t.Fatalf("%d bits: the next challenge must be bound to the truncated value", nbBits)
+		}
+
+		// the hash itself isn't truncated
+		h.Reset()
+		if len(h.Sum(nil)) != sha256.Size {
+			t.Fatal("the marked hash must behave as the underlying hash")
+		}
+	}
+
+	if NewTranscript(sha256.New()).ChallengeBits() != 0 {
+		t.Fatal("challenges must not be truncated by default")
+	}
+}
@@ -0,0 +1,31 @@
+// Package codepath records the implementation selected by the packages with alternative
+// code paths (assembly or generic Go), so that a binary can report the ones it runs.
+//
+// The packages register their selection at init time, with the variables they dispatch on:
+// only the packages linked in the binary are reported.
+package codepath
+
+import "sync"
+
+var (
+	lock  sync.RWMutex
+	paths = make(map[string]string)
+)
+
+// Register records that the package of import path pkg selected the implementation path.
+func Register(pkg, path string) {
+	lock.Lock()
+	defer lock.Unlock()
+	paths[pkg] = path
+}
+
+// All returns the implementations selected by the registered packages, keyed by import path.
+func All() map[string]string {
+	lock.RLock()
+	defer lock.RUnlock()
+	res := make(map[string]string, len(paths))
+	for pkg, path := range paths {
+		res[pkg] = path
+	}
+	return res
+}
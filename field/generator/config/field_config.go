@@ -18,6 +18,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"go/token"
 	"math"
 	"math/big"
 	"strconv"
@@ -29,6 +30,8 @@ import (
 
 var (
 	errParseModulus = errors.New("can't parse modulus")
+	errExpName      = errors.New("fixed exponent name must be an exported identifier, distinct from the ones already registered")
+	errExpValue     = errors.New("fixed exponent must be positive")
 )
 
 // FieldConfig precomputed values used in template for code generation of field element APIs
@@ -55,6 +58,7 @@ type FieldConfig struct {
 	RSquare                   []uint64
 	One, Thirteen             []uint64
 	LegendreExponent          string // big.Int to base16 string
+	InverseExponent           string // big.Int to base16 string
 	NoCarry                   bool
 	NoCarrySquare             bool // used if NoCarry is set, but some op may overflow in square optimization
 	SqrtQ3Mod4                bool
@@ -68,10 +72,19 @@ type FieldConfig struct {
 	SqrtG                     []uint64 // NonResidue ^  SqrtR (montgomery form)
 	NonResidue                big.Int  // (montgomery form)
 	LegendreExponentData      *addchain.AddChainData
+	InverseExponentData       *addchain.AddChainData
 	SqrtAtkinExponentData     *addchain.AddChainData
 	SqrtSMinusOneOver2Data    *addchain.AddChainData
 	SqrtQ3Mod4ExponentData    *addchain.AddChainData
 	UseAddChain               bool
+	FixedExponents            []FixedExponent // user-specified exponents, see AddFixedExponent
+}
+
+// FixedExponent is a user-specified exponent, for which an ExpBy<Name> method is generated
+type FixedExponent struct {
+	Name     string                 // suffix of the generated method name
+	Exponent string                 // big.Int to base16 string
+	Data     *addchain.AddChainData // addition chain computing the exponent
 }
 
 // NewFieldConfig returns a data structure with needed information to generate apis for field element
@@ -183,6 +196,15 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 		F.LegendreExponentData = addchain.GetAddChain(&legendreExponent)
 	}
 
+	// Inverse exponent (p-2), for the constant time inversion
+	var inverseExponent big.Int
+	inverseExponent.SetUint64(2)
+	inverseExponent.Sub(&bModulus, &inverseExponent)
+	F.InverseExponent = inverseExponent.Text(16)
+	if F.UseAddChain {
+		F.InverseExponentData = addchain.GetAddChain(&inverseExponent)
+	}
+
 	// Sqrt pre computes
 	var qMod big.Int
 	qMod.SetUint64(4)
@@ -329,6 +351,34 @@ func (f *FieldConfig) ToMont(nonMont big.Int) big.Int {
 	return mont
 }
 
+// AddFixedExponent registers an exponent, for which a method
+//
+//	func (z *Element) ExpBy<name>(x Element) *Element
+//
+// computing z = xᵉ with a short addition chain is generated, instead of the generic
+// square-and-multiply of Element.Exp. Typical exponents are (q+1)/4, (q-1)/2 or sub-factors of
+// a final exponentiation; the search for a chain can take a while for large exponents, and its
+// result is cached on disk (see the addchain directory).
+func (f *FieldConfig) AddFixedExponent(name string, exponent *big.Int) error {
+	if !token.IsIdentifier(name) || !token.IsExported(name) {
+		return errExpName
+	}
+	for _, e := range f.FixedExponents {
+		if e.Name == name {
+			return errExpName
+		}
+	}
+	if exponent.Sign() <= 0 {
+		return errExpValue
+	}
+	f.FixedExponents = append(f.FixedExponents, FixedExponent{
+		Name:     name,
+		Exponent: exponent.Text(16),
+		Data:     addchain.GetAddChain(exponent),
+	})
+	return nil
+}
+
 func (f *FieldConfig) FromMont(nonMont *big.Int, mont *big.Int) *FieldConfig {
 
 	if f.NbWords == 0 {
@@ -1,8 +1,11 @@
 package generator
 
 import (
+	"errors"
 	"fmt"
+	"go/token"
 	"io"
+	"math/big"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -16,6 +19,116 @@ import (
 	"github.com/consensys/gnark-crypto/field/generator/internal/templates/element"
 )
 
+var (
+	errNotPrime       = errors.New("modulus is not a prime")
+	errParseModulus   = errors.New("can't parse modulus")
+	errInvalidPackage = errors.New("invalid package name")
+	errInvalidElement = errors.New("invalid element name")
+)
+
+// Option customizes the package generated by GenerateField
+type Option func(*fieldOptions)
+
+type fieldOptions struct {
+	elementName    string
+	addChain       bool
+	noASM          bool
+	fixedExponents []fixedExponent
+}
+
+type fixedExponent struct {
+	name     string
+	exponent *big.Int
+}
+
+// WithElementName sets the name of the generated field element type (default: "Element")
+func WithElementName(name string) Option {
+	return func(opt *fieldOptions) {
+		opt.elementName = name
+	}
+}
+
+// WithAddChain generates the fixed exponentiations (square roots, Legendre symbol) with
+// addition chains. It is faster, but the generation takes longer.
+func WithAddChain() Option {
+	return func(opt *fieldOptions) {
+		opt.addChain = true
+	}
+}
+
+// WithFixedExponent generates a method
+//
+//	func (z *Element) ExpBy<name>(x Element) *Element
+//
+// computing z = x^exponent with a short addition chain, instead of the generic square-and-multiply
+// of Element.Exp. It can be set several times, with distinct names.
+func WithFixedExponent(name string, exponent *big.Int) Option {
+	return func(opt *fieldOptions) {
+		opt.fixedExponents = append(opt.fixedExponents, fixedExponent{name, new(big.Int).Set(exponent)})
+	}
+}
+
+// WithoutASM generates a pure Go package, without assembly.
+func WithoutASM() Option {
+	return func(opt *fieldOptions) {
+		opt.noASM = true
+	}
+}
+
+// GenerateField generates in outputDir a Go package named packageName implementing the
+// arithmetic of the prime field of the given modulus (in base 10, or in base 16 with a 0x prefix).
+// outputDir is created if needed.
+//
+// This is the stable entry point of the field code generator; it is the one used to generate
+// the fp and fr packages of the curves of gnark-crypto.
+//
+// Assembly is emitted for amd64 only, when the modulus spans 2 to 12 words of 64 bits and
+// its most significant word leaves a spare bit. Other targets (including arm64), and the purego
+// build tag, use the generated pure Go code. Formatting the assembly requires asmfmt
+// (github.com/klauspost/asmfmt) in the PATH; it is skipped otherwise.
+//
+// Example usage
+//
+//	generator.GenerateField("0xffffffff00000001", "goldilocks", "./goldilocks")
+func GenerateField(modulus, packageName, outputDir string, opts ...Option) error {
+	opt := fieldOptions{elementName: "Element"}
+	for _, o := range opts {
+		o(&opt)
+	}
+
+	if !token.IsIdentifier(packageName) || strings.ToLower(packageName) != packageName {
+		return errInvalidPackage
+	}
+	if !token.IsIdentifier(opt.elementName) || !token.IsExported(opt.elementName) {
+		return errInvalidElement
+	}
+	var q big.Int
+	if _, ok := q.SetString(modulus, 0); !ok {
+		return errParseModulus
+	}
+	if !q.ProbablyPrime(20) {
+		return errNotPrime
+	}
+
+	F, err := config.NewFieldConfig(packageName, opt.elementName, modulus, opt.addChain)
+	if err != nil {
+		return err
+	}
+	if opt.noASM {
+		F.ASM = false
+	}
+	for _, e := range opt.fixedExponents {
+		if err := F.AddFixedExponent(e.name, e.exponent); err != nil {
+			return err
+		}
+	}
+
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return err
+	}
+	return GenerateFF(F, outputDir)
+}
+
 // GenerateFF will generate go (and .s) files in outputDir for modulus (in base 10)
 //
 // Example usage
@@ -54,6 +167,12 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 	pathSrcArith := filepath.Join(outputDir, "arith.go")
 	pathTest := filepath.Join(outputDir, eName+"_test.go")
 	pathTestVector := filepath.Join(outputDir, "vector_test.go")
+	pathSrcMatrix := filepath.Join(outputDir, "matrix.go")
+	pathTestMatrix := filepath.Join(outputDir, "matrix_test.go")
+	pathSrcFormat := filepath.Join(outputDir, eName+"_format.go")
+	pathTestFormat := filepath.Join(outputDir, eName+"_format_test.go")
+	pathSrcCanonicalVector := filepath.Join(outputDir, "vector_canonical.go")
+	pathTestCanonicalVector := filepath.Join(outputDir, "vector_canonical_test.go")
 
 	// remove old format generated files
 	oldFiles := []string{"_mul.go", "_mul_amd64.go",
@@ -75,7 +194,7 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 	_ = os.Remove(filepath.Join(outputDir, "asm_noadx.go"))
 
 	funcs := template.FuncMap{}
-	if F.UseAddChain {
+	if F.UseAddChain || len(F.FixedExponents) != 0 {
 		for _, f := range addchain.Functions {
 			funcs[f.Name] = f.Func
 		}
@@ -103,13 +222,28 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		return err
 	}
 
+	// generate canonical form vector
+	if err := bavard.GenerateFromString(pathSrcCanonicalVector, []string{element.CanonicalVector}, F, bavardOpts...); err != nil {
+		return err
+	}
+
+	// generate matrix
+	if err := bavard.GenerateFromString(pathSrcMatrix, []string{element.Matrix}, F, bavardOpts...); err != nil {
+		return err
+	}
+
+	// generate formatting
+	if err := bavard.GenerateFromString(pathSrcFormat, []string{element.Format}, F, bavardOpts...); err != nil {
+		return err
+	}
+
 	// generate arithmetics source file
 	if err := bavard.GenerateFromString(pathSrcArith, []string{element.Arith}, F, bavardOpts...); err != nil {
 		return err
 	}
 
 	// generate fixed exp source file
-	if F.UseAddChain {
+	if F.UseAddChain || len(F.FixedExponents) != 0 {
 		if err := bavard.GenerateFromString(pathSrcFixedExp, []string{element.FixedExp}, F, bavardOpts...); err != nil {
 			return err
 		}
@@ -124,6 +258,18 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		return err
 	}
 
+	if err := bavard.GenerateFromString(pathTestCanonicalVector, []string{element.TestCanonicalVector}, F, bavardOpts...); err != nil {
+		return err
+	}
+
+	if err := bavard.GenerateFromString(pathTestMatrix, []string{element.TestMatrix}, F, bavardOpts...); err != nil {
+		return err
+	}
+
+	if err := bavard.GenerateFromString(pathTestFormat, []string{element.TestFormat}, F, bavardOpts...); err != nil {
+		return err
+	}
+
 	// if we generate assembly code
 	if F.ASM {
 		// generate ops.s
@@ -143,12 +289,7 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 			}
 			_ = f.Close()
 
-			// run asmfmt
-			// run go fmt on whole directory
-			cmd := exec.Command("asmfmt", "-w", pathSrc)
-			cmd.Stdout = os.Stdout
-			cmd.Stderr = os.Stderr
-			if err := cmd.Run(); err != nil {
+			if err := runAsmfmt(pathSrc); err != nil {
 				return err
 			}
 		}
@@ -169,12 +310,7 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 			}
 			_ = f.Close()
 
-			// run asmfmt
-			// run go fmt on whole directory
-			cmd := exec.Command("asmfmt", "-w", pathSrc)
-			cmd.Stdout = os.Stdout
-			cmd.Stderr = os.Stderr
-			if err := cmd.Run(); err != nil {
+			if err := runAsmfmt(pathSrc); err != nil {
 				return err
 			}
 		}
@@ -267,6 +403,17 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 	return nil
 }
 
+// runAsmfmt formats the assembly file at path with asmfmt, if it is installed
+func runAsmfmt(path string) error {
+	if _, err := exec.LookPath("asmfmt"); err != nil {
+		return nil
+	}
+	cmd := exec.Command("asmfmt", "-w", path)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
 func shorten(input string) string {
 	const maxLen = 15
 	if len(input) > maxLen {
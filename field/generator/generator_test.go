@@ -102,3 +102,38 @@ func TestIntegration(t *testing.T) {
 	}
 
 }
+
+func TestGenerateField(t *testing.T) {
+	dir := t.TempDir()
+
+	// invalid inputs
+	if err := GenerateField("42", "fp", dir); err != errNotPrime {
+		t.Fatal("expected errNotPrime, got", err)
+	}
+	if err := GenerateField("0xzz", "fp", dir); err != errParseModulus {
+		t.Fatal("expected errParseModulus, got", err)
+	}
+	if err := GenerateField("101", "my-field", dir); err != errInvalidPackage {
+		t.Fatal("expected errInvalidPackage, got", err)
+	}
+	if err := GenerateField("101", "fp", dir, WithElementName("element")); err != errInvalidElement {
+		t.Fatal("expected errInvalidElement, got", err)
+	}
+	if err := GenerateField("101", "fp", dir, WithFixedExponent("sqrt", big.NewInt(26))); err == nil {
+		t.Fatal("expected an error, fixed exponent name is not exported")
+	}
+	if err := GenerateField("101", "fp", dir, WithFixedExponent("Zero", big.NewInt(0))); err == nil {
+		t.Fatal("expected an error, fixed exponent is not positive")
+	}
+
+	// goldilocks, in a directory that doesn't exist yet
+	outputDir := filepath.Join(dir, "goldilocks")
+	if err := GenerateField("0xffffffff00000001", "goldilocks", outputDir, WithElementName("Felt")); err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range []string{"felt.go", "arith.go", "doc.go", "felt_test.go"} {
+		if _, err := os.Stat(filepath.Join(outputDir, file)); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -2,23 +2,31 @@ package element
 
 const FixedExp = `
 
+{{- if .UseAddChain}}
 {{- if .SqrtQ3Mod4}}
-	{{expByAddChain "SqrtExp" .SqrtQ3Mod4ExponentData .ElementName}}
+	{{expByAddChain "expBySqrtExp" .SqrtQ3Mod4ExponentData .ElementName}}
 {{- else if .SqrtAtkin}}
-	{{expByAddChain "SqrtExp" .SqrtAtkinExponentData .ElementName}}
+	{{expByAddChain "expBySqrtExp" .SqrtAtkinExponentData .ElementName}}
 {{- else if .SqrtTonelliShanks}}
-	{{expByAddChain "SqrtExp" .SqrtSMinusOneOver2Data .ElementName}}
+	{{expByAddChain "expBySqrtExp" .SqrtSMinusOneOver2Data .ElementName}}
 {{- end }}
 
-{{expByAddChain "LegendreExp" .LegendreExponentData .ElementName}}
+{{expByAddChain "expByLegendreExp" .LegendreExponentData .ElementName}}
+
+{{expByAddChain "expByInverseExp" .InverseExponentData .ElementName}}
+{{- end}}
+
+{{- range .FixedExponents}}
+	{{expByAddChain (print "ExpBy" .Name) .Data $.ElementName}}
+{{- end}}
 
 
 {{define "expByAddChain name data eName"}}
 	
-// expBy{{.name}} is equivalent to z.Exp(x, {{ .data.N }})
+// {{.name}} is equivalent to z.Exp(x, {{ .data.N }})
 // 
 // uses {{ .data.Meta.Module }} {{ .data.Meta.ReleaseTag }} to generate a shorter addition chain
-func (z *{{.eName}}) expBy{{$.name}}(x {{.eName}}) *{{.eName}} {
+func (z *{{.eName}}) {{$.name}}(x {{.eName}}) *{{.eName}} {
 	// addition chain:
 	//
 	{{- range lines_ (format_ .data.Script) }}
@@ -418,4 +418,27 @@ func updateFactorsDecompose(c int64) (int64, int64) {
 
 {{ end }}
 
+{{ if not .UseAddChain}}
+var _bInverseExponent{{.ElementName}} *big.Int
+
+func init() {
+	_bInverseExponent{{.ElementName}}, _ = new(big.Int).SetString("{{.InverseExponent}}", 16)
+}
+{{ end }}
+
+// InverseConstantTime z = x⁻¹ (mod q), computed as x^(q-2) (Fermat's little theorem)
+//
+// if x == 0, sets and returns z = x
+//
+// Unlike Inverse, the sequence of operations doesn't depend on x: it is meant for the inversion
+// of secret values (e.g. the nonce of an ECDSA signature), and is several times slower.
+func (z *{{.ElementName}}) InverseConstantTime(x *{{.ElementName}}) *{{.ElementName}} {
+	{{- if .UseAddChain}}
+	return z.expByInverseExp(*x)
+	{{- else}}
+	// the exponent is public, the square-and-multiply of Exp only branches on its bits
+	return z.Exp(*x, _bInverseExponent{{.ElementName}})
+	{{- end}}
+}
+
 `
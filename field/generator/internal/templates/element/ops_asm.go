@@ -5,6 +5,21 @@ const OpsAMD64 = `
 
 {{if .ASM}}
 
+import (
+	"reflect"
+
+	"github.com/consensys/gnark-crypto/field/codepath"
+)
+
+// the multiplication is selected at init time, see supportAdx
+func init() {
+	path := "amd64 assembly (ADX, BMI2)"
+	if !supportAdx {
+		path = "amd64 assembly (without ADX)"
+	}
+	codepath.Register(reflect.TypeOf({{.ElementName}}{}).PkgPath(), path)
+}
+
 //go:noescape
 func MulBy3(x *{{.ElementName}})
 
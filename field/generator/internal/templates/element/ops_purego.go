@@ -2,7 +2,16 @@ package element
 
 const OpsNoAsm = `
 
-import "math/bits"
+import (
+	"math/bits"
+	"reflect"
+
+	"github.com/consensys/gnark-crypto/field/codepath"
+)
+
+func init() {
+	codepath.Register(reflect.TypeOf({{.ElementName}}{}).PkgPath(), "generic Go")
+}
 
 {{ $mulConsts := list 3 5 13 }}
 {{- range $i := $mulConsts }}
@@ -4,6 +4,7 @@ const Test = `
 
 
 import (
+	"bytes"
 	"crypto/rand"
 	"encoding/json"
 	"math/big"
@@ -98,6 +99,17 @@ func Benchmark{{toTitle .ElementName}}Inverse(b *testing.B) {
 
 }
 
+func Benchmark{{toTitle .ElementName}}InverseConstantTime(b *testing.B) {
+	var x {{.ElementName}}
+	x.SetRandom()
+	benchRes{{.ElementName}}.SetRandom()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.InverseConstantTime(&x)
+	}
+}
+
 func Benchmark{{toTitle .ElementName}}Butterfly(b *testing.B) {
 	var x {{.ElementName}}
 	x.SetRandom()
@@ -251,6 +263,36 @@ func Test{{toTitle .ElementName}}Cmp(t *testing.T) {
 	}
 }
 
+func Test{{toTitle .ElementName}}SetRandomFrom(t *testing.T) {
+	// the same stream of randomness gives the same elements
+	// (with enough bytes for the rejection sampling)
+	seed := make([]byte, 1<<14)
+	if _, err := rand.Read(seed); err != nil {
+		t.Fatal(err)
+	}
+	var x, y {{.ElementName}}
+	r1, r2 := bytes.NewReader(seed), bytes.NewReader(seed)
+	for i := 0; i < 10; i++ {
+		if _, err := x.SetRandomFrom(r1); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := y.SetRandomFrom(r2); err != nil {
+			t.Fatal(err)
+		}
+		if !x.Equal(&y) {
+			t.Fatal("SetRandomFrom isn't deterministic")
+		}
+		if !x.smallerThanModulus() {
+			t.Fatal("SetRandomFrom returned a value larger than the modulus")
+		}
+	}
+
+	// errors of the reader are returned
+	if _, err := x.SetRandomFrom(bytes.NewReader(nil)); err == nil {
+		t.Fatal("expected an error, the reader is empty")
+	}
+}
+
 {{- if gt .NbWords 1}}
 func Test{{toTitle .ElementName}}IsRandom(t *testing.T) {
 	for i := 0; i < 50; i++ {
@@ -511,6 +553,33 @@ func Test{{toTitle .ElementName}}InverseExp(t *testing.T) {
 
 }
 
+func Test{{toTitle .ElementName}}InverseConstantTime(t *testing.T) {
+	invCTMatchInv := func(a testPair{{.ElementName}}) bool {
+		var b {{.ElementName}}
+		b.InverseConstantTime(&a.element)
+		a.element.Inverse(&a.element)
+
+		return a.element.Equal(&b)
+	}
+
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+	properties := gopter.NewProperties(parameters)
+	genA := gen()
+	properties.Property("InverseConstantTime == Inverse", prop.ForAll(invCTMatchInv, genA))
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	parameters.MinSuccessfulTests = 1
+	properties = gopter.NewProperties(parameters)
+	properties.Property("InverseConstantTime(0) == 0", prop.ForAll(invCTMatchInv, ggen.OneConstOf(testPair{{.ElementName}}{})))
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
 
 func mulByConstant(z *{{.ElementName}}, c uint8) {
 	var y {{.ElementName}}
@@ -1114,6 +1183,40 @@ func Test{{toTitle .ElementName}}FixedExp(t *testing.T) {
 
 {{ end }}
 
+{{ if .FixedExponents}}
+func Test{{toTitle .ElementName}}ExpBy(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	{{- range .FixedExponents}}
+
+	properties.Property("ExpBy{{.Name}} must match Exp({{.Exponent}})", prop.ForAll(
+		func(a testPair{{$.ElementName}}) bool {
+			e, _ := new(big.Int).SetString("{{.Exponent}}", 16)
+			c := a.element
+			d := a.element
+			c.ExpBy{{.Name}}(c)
+			d.Exp(d, e)
+			return c.Equal(&d)
+		},
+		genA,
+	))
+	{{- end}}
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+{{ end }}
+
 
 
 
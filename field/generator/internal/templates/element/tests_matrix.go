@@ -0,0 +1,74 @@
+package element
+
+const TestMatrix = `
+
+import (
+	"testing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatrixColumns(t *testing.T) {
+	assert := require.New(t)
+
+	columns := make([][]{{.ElementName}}, 3)
+	for j := range columns {
+		columns[j] = make([]{{.ElementName}}, 5)
+		for i := range columns[j] {
+			columns[j][i].SetUint64(uint64(10*i + j))
+		}
+	}
+	m, err := NewMatrixFromColumns(columns...)
+	assert.NoError(err)
+	assert.Equal(5, m.NbRows())
+	assert.Equal(3, m.NbCols())
+
+	for j := range columns {
+		assert.Equal(Vector(columns[j]), m.Column(j))
+		for i := range columns[j] {
+			assert.True(m.At(i, j).Equal(&columns[j][i]))
+		}
+	}
+	for i := 0; i < m.NbRows(); i++ {
+		row := m.Row(i)
+		for j := range row {
+			assert.True(row[j].Equal(&columns[j][i]))
+		}
+	}
+
+	// columns are views on the matrix
+	m.Column(1)[2].SetUint64(42)
+	assert.Equal(uint64(42), m.At(2, 1).Uint64())
+	assert.Equal(uint64(42), m.Columns()[1][2].Uint64())
+	assert.Equal(uint64(21), columns[1][2].Uint64(), "the input columns must be copied")
+
+	// appending to a column must not overwrite the next one
+	_ = append(m.Column(0), One())
+	assert.Equal(uint64(1), m.At(0, 1).Uint64())
+
+	_, err = NewMatrixFromColumns(columns[0], columns[1][:4])
+	assert.Error(err)
+}
+
+func TestMatrixTranspose(t *testing.T) {
+	assert := require.New(t)
+
+	// sizes around the tile size
+	for _, size := range [][2]int{ {1, 1}, {5, 3}, {matrixTileSize, matrixTileSize}, {3*matrixTileSize + 1, 7}, {2, 2*matrixTileSize + 5} } {
+		m := NewMatrix(size[0], size[1])
+		for i := 0; i < m.NbRows(); i++ {
+			for j := 0; j < m.NbCols(); j++ {
+				m.At(i, j).SetRandom()
+			}
+		}
+		mt := m.Transpose()
+		assert.Equal(m.NbRows(), mt.NbCols())
+		assert.Equal(m.NbCols(), mt.NbRows())
+		for i := 0; i < m.NbRows(); i++ {
+			assert.Equal(m.Row(i), mt.Column(i))
+		}
+		mtt := mt.Transpose()
+		assert.Equal(m, mtt)
+	}
+}
+
+`
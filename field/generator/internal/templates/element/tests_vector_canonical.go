@@ -0,0 +1,99 @@
+package element
+
+const TestCanonicalVector = `
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalVector(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 67
+	a, b := make(Vector, n), make(Vector, n)
+	for i := 0; i < n; i++ {
+		a[i].SetRandom()
+		b[i].SetRandom()
+	}
+	var s {{.ElementName}}
+	s.SetRandom()
+
+	// expected results, in Montgomery form
+	sum, diff, prod, scaled := make(Vector, n), make(Vector, n), make(Vector, n), make(Vector, n)
+	for i := 0; i < n; i++ {
+		sum[i].Add(&a[i], &b[i])
+		diff[i].Sub(&a[i], &b[i])
+		prod[i].Mul(&a[i], &b[i])
+		scaled[i].Mul(&a[i], &s)
+	}
+
+	ca := append(Vector(nil), a...).IntoCanonical()
+	cb := append(Vector(nil), b...).IntoCanonical()
+	for i := 0; i < n; i++ {
+		assert.Equal(a[i].Bits(), [Limbs]uint64(ca[i]), "IntoCanonical must give the regular form")
+	}
+
+	check := func(name string, v CanonicalVector, expected Vector) {
+		t.Helper()
+		got := append(CanonicalVector(nil), v...).IntoMont()
+		assert.Equal(expected, got, name)
+	}
+	res := make(CanonicalVector, n)
+	check("Add", res.Add(ca, cb), sum)
+	check("Sub", res.Sub(ca, cb), diff)
+	check("Mul", res.Mul(ca, cb), prod)
+	check("ScalarMul", res.ScalarMul(ca, &s), scaled)
+
+	// the encoding matches Vector's
+	data, err := ca.MarshalBinary()
+	assert.NoError(err)
+	expected, err := a.MarshalBinary()
+	assert.NoError(err)
+	assert.Equal(expected, data)
+
+	var _ca CanonicalVector
+	assert.NoError(_ca.UnmarshalBinary(data))
+	assert.Equal(ca, _ca)
+
+	var empty CanonicalVector
+	data, err = empty.MarshalBinary()
+	assert.NoError(err)
+	assert.NoError(_ca.UnmarshalBinary(data))
+	assert.Equal(0, len(_ca))
+
+	// non canonical encoding
+	bad := []byte{0, 0, 0, 1}
+	bad = append(bad, bytes.Repeat([]byte{0xff}, Bytes)...)
+	assert.Error(_ca.UnmarshalBinary(bad))
+
+	// mismatched lengths
+	assert.Panics(func() { res.Add(ca, cb[1:]) })
+}
+
+func BenchmarkCanonicalVectorRoundTrip(b *testing.B) {
+	const n = 1 << 14
+	v := make(Vector, n)
+	for i := range v {
+		v[i].SetRandom()
+	}
+	data, _ := v.MarshalBinary()
+
+	b.Run("Vector", func(b *testing.B) {
+		var w Vector
+		for i := 0; i < b.N; i++ {
+			_ = w.UnmarshalBinary(data)
+			_, _ = w.MarshalBinary()
+		}
+	})
+	b.Run("CanonicalVector", func(b *testing.B) {
+		var w CanonicalVector
+		for i := 0; i < b.N; i++ {
+			_ = w.UnmarshalBinary(data)
+			_, _ = w.MarshalBinary()
+		}
+	})
+}
+`
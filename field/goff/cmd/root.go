@@ -23,7 +23,6 @@ import (
 	"strings"
 
 	"github.com/consensys/gnark-crypto/field/generator"
-	field "github.com/consensys/gnark-crypto/field/generator/config"
 	"github.com/spf13/cobra"
 )
 
@@ -66,12 +65,7 @@ func cmdGenerate(cmd *cobra.Command, args []string) {
 	}
 
 	// generate code
-	F, err := field.NewFieldConfig(fPackageName, fElementName, fModulus, false)
-	if err != nil {
-		fmt.Printf("\n%s\n", err.Error())
-		os.Exit(-1)
-	}
-	if err := generator.GenerateFF(F, fOutputDir); err != nil {
+	if err := generator.GenerateField(fModulus, fPackageName, fOutputDir, generator.WithElementName(fElementName)); err != nil {
 		fmt.Printf("\n%s\n", err.Error())
 		os.Exit(-1)
 	}
@@ -0,0 +1,109 @@
+// Package fft provides number theoretic transforms over the goldilocks field.
+//
+// The transforms are iterative radix-2 FFTs; on amd64 CPUs supporting AVX-512, the butterflies
+// process 8 elements per instruction.
+package fft
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+const (
+	// maxOrderRoot is the 2-adicity of the multiplicative group of the goldilocks field
+	maxOrderRoot = 32
+
+	// multiplicativeGen generates the multiplicative group of the goldilocks field
+	multiplicativeGen = 7
+)
+
+// Domain is the subgroup of the roots of unity of a given cardinality, along with the
+// precomputed twiddle factors of the FFTs.
+type Domain struct {
+	Cardinality    uint64
+	CardinalityInv goldilocks.Element
+	Generator      goldilocks.Element
+	GeneratorInv   goldilocks.Element
+
+	// twiddles[s][j] = ω^(j*2^s) for j < n/2^(s+1), in canonical (regular) form: the butterflies
+	// multiply the elements (in Montgomery form) by the twiddles and reduce the product modulo q,
+	// which yields the product in Montgomery form.
+	twiddles    [][]uint64
+	twiddlesInv [][]uint64
+
+	// cardinalityInv is CardinalityInv in canonical form
+	cardinalityInv uint64
+}
+
+// Generator returns a generator of the roots of unity of order the smallest power of 2 ≥ m,
+// or an error if m > 2³².
+func Generator(m uint64) (goldilocks.Element, error) {
+	x := nextPowerOfTwo(m)
+	logx := uint64(bits.TrailingZeros64(x))
+	if logx > maxOrderRoot {
+		return goldilocks.Element{}, errors.New("m is too big: the required root of unity does not exist")
+	}
+
+	// ω = g^((q-1)/x)
+	var res goldilocks.Element
+	res.SetUint64(multiplicativeGen)
+	expo := new(big.Int).Sub(goldilocks.Modulus(), big.NewInt(1))
+	expo.Rsh(expo, uint(logx))
+	res.Exp(res, expo)
+	return res, nil
+}
+
+// NewDomain returns the subgroup of the roots of unity of cardinality the smallest power of 2 ≥ m.
+// It panics if m > 2³².
+func NewDomain(m uint64) *Domain {
+	domain := &Domain{Cardinality: nextPowerOfTwo(m)}
+
+	var err error
+	domain.Generator, err = Generator(m)
+	if err != nil {
+		panic(err)
+	}
+	domain.GeneratorInv.Inverse(&domain.Generator)
+	domain.CardinalityInv.SetUint64(domain.Cardinality).Inverse(&domain.CardinalityInv)
+	domain.cardinalityInv = domain.CardinalityInv.Bits()[0]
+
+	nbStages := bits.TrailingZeros64(domain.Cardinality)
+	domain.twiddles = buildTwiddles(domain.Generator, nbStages)
+	domain.twiddlesInv = buildTwiddles(domain.GeneratorInv, nbStages)
+
+	return domain
+}
+
+// buildTwiddles returns the twiddle factors of the nbStages stages of the FFT using ω
+func buildTwiddles(omega goldilocks.Element, nbStages int) [][]uint64 {
+	res := make([][]uint64, nbStages)
+	if nbStages == 0 {
+		return res
+	}
+
+	// first stage: ω^j for j < n/2, the next stages are strided views of the first one
+	res[0] = make([]uint64, 1<<(nbStages-1))
+	var w goldilocks.Element
+	w.SetOne()
+	for j := range res[0] {
+		res[0][j] = w.Bits()[0]
+		w.Mul(&w, &omega)
+	}
+	for s := 1; s < nbStages; s++ {
+		res[s] = make([]uint64, 1<<(nbStages-s-1))
+		for j := range res[s] {
+			res[s][j] = res[0][j<<s]
+		}
+	}
+	return res
+}
+
+func nextPowerOfTwo(n uint64) uint64 {
+	if n <= 1 {
+		return 1
+	}
+	return 1 << (64 - bits.LeadingZeros64(n-1))
+}
@@ -0,0 +1,211 @@
+package fft
+
+import (
+	"math/bits"
+	"runtime"
+
+	"github.com/consensys/gnark-crypto/field/codepath"
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// the butterflies are selected at init time, see supportAvx512
+func init() {
+	path := "generic Go"
+	if supportAvx512 {
+		path = "amd64 assembly (AVX-512)"
+	}
+	codepath.Register("github.com/consensys/gnark-crypto/field/goldilocks/fft", path)
+}
+
+// Decimation is used to specify the decimation of the FFT
+type Decimation uint8
+
+const (
+	DIT Decimation = iota
+	DIF
+)
+
+// Option defines option for altering the behavior of FFT methods.
+type Option func(*fftConfig)
+
+type fftConfig struct {
+	nbTasks int
+}
+
+// WithNbTasks sets the max number of task (go routine) to spawn. Must be between 1 and 512.
+func WithNbTasks(nbTasks int) Option {
+	if nbTasks < 1 {
+		nbTasks = 1
+	} else if nbTasks > 512 {
+		nbTasks = 512
+	}
+	return func(opt *fftConfig) {
+		opt.nbTasks = nbTasks
+	}
+}
+
+// minSizeParallel is the size under which the stages of the FFT are not parallelized
+const minSizeParallel = 1 << 12
+
+func fftOptions(size int, opts ...Option) fftConfig {
+	opt := fftConfig{nbTasks: runtime.NumCPU()}
+	for _, option := range opts {
+		option(&opt)
+	}
+	if size < minSizeParallel {
+		opt.nbTasks = 1
+	}
+	return opt
+}
+
+// FFT computes (recursively) the discrete Fourier transform of a and stores the result in a.
+// if decimation == DIT (decimation in time), the input must be in bit-reversed order
+// if decimation == DIF (decimation in frequency), the output will be in bit-reversed order
+// len(a) must be the cardinality of the domain.
+func (domain *Domain) FFT(a []goldilocks.Element, decimation Decimation, opts ...Option) {
+	domain.transform(a, decimation, domain.twiddles, fftOptions(len(a), opts...))
+}
+
+// FFTInverse computes (recursively) the inverse discrete Fourier transform of a and stores the result in a.
+// if decimation == DIT (decimation in time), the input must be in bit-reversed order
+// if decimation == DIF (decimation in frequency), the output will be in bit-reversed order
+// len(a) must be the cardinality of the domain.
+func (domain *Domain) FFTInverse(a []goldilocks.Element, decimation Decimation, opts ...Option) {
+	opt := fftOptions(len(a), opts...)
+	domain.transform(a, decimation, domain.twiddlesInv, opt)
+
+	parallel.Execute(len(a), func(start, end int) {
+		for i := start; i < end; i++ {
+			a[i][0] = mulCanonical(a[i][0], domain.cardinalityInv)
+		}
+	}, opt.nbTasks)
+}
+
+func (domain *Domain) transform(a []goldilocks.Element, decimation Decimation, twiddles [][]uint64, opt fftConfig) {
+	if uint64(len(a)) != domain.Cardinality {
+		panic("len(a) must be the cardinality of the domain")
+	}
+
+	nbStages := len(twiddles)
+	if decimation == DIF {
+		for s := 0; s < nbStages; s++ {
+			stage(a, len(a)>>(s+1), twiddles[s], butterfliesDIF, opt.nbTasks)
+		}
+	} else {
+		for s := nbStages - 1; s >= 0; s-- {
+			stage(a, len(a)>>(s+1), twiddles[s], butterfliesDIT, opt.nbTasks)
+		}
+	}
+}
+
+// stage applies the butterflies between a[k+j] and a[k+j+m] with the twiddle tw[j],
+// for each block a[k:k+2m]
+func stage(a []goldilocks.Element, m int, tw []uint64, butterflies func(a, b []goldilocks.Element, tw []uint64), nbTasks int) {
+	nbBlocks := len(a) / (2 * m)
+	if nbBlocks >= nbTasks {
+		parallel.Execute(nbBlocks, func(start, end int) {
+			for k := start * 2 * m; k < end*2*m; k += 2 * m {
+				butterflies(a[k:k+m], a[k+m:k+2*m], tw)
+			}
+		}, nbTasks)
+		return
+	}
+	// few large blocks: the butterflies of each block are split between the tasks
+	for k := 0; k < len(a); k += 2 * m {
+		parallel.Execute(m, func(start, end int) {
+			butterflies(a[k+start:k+end], a[k+m+start:k+m+end], tw[start:end])
+		}, nbTasks)
+	}
+}
+
+// butterfliesDIFGeneric sets (aᵢ, bᵢ) to (aᵢ+bᵢ, (aᵢ-bᵢ)*twᵢ)
+func butterfliesDIFGeneric(a, b []goldilocks.Element, tw []uint64) {
+	for i := range a {
+		s := add(a[i][0], b[i][0])
+		b[i][0] = mulCanonical(sub(a[i][0], b[i][0]), tw[i])
+		a[i][0] = s
+	}
+}
+
+// butterfliesDITGeneric sets (aᵢ, bᵢ) to (aᵢ+bᵢ*twᵢ, aᵢ-bᵢ*twᵢ)
+func butterfliesDITGeneric(a, b []goldilocks.Element, tw []uint64) {
+	for i := range a {
+		v := mulCanonical(b[i][0], tw[i])
+		b[i][0] = sub(a[i][0], v)
+		a[i][0] = add(a[i][0], v)
+	}
+}
+
+// q is the goldilocks modulus 2⁶⁴-2³²+1, and 2⁶⁴ = epsilon mod q
+const (
+	q       = 0xffffffff00000001
+	epsilon = 0xffffffff
+)
+
+// add returns a+b mod q, for a, b < q
+func add(a, b uint64) uint64 {
+	s, carry := bits.Add64(a, b, 0)
+	if carry != 0 {
+		// a+b = s + 2⁶⁴ = s + epsilon mod q, and s + epsilon < q
+		return s + epsilon
+	}
+	if s >= q {
+		s -= q
+	}
+	return s
+}
+
+// sub returns a-b mod q, for a, b < q
+func sub(a, b uint64) uint64 {
+	d, borrow := bits.Sub64(a, b, 0)
+	if borrow != 0 {
+		d += q
+	}
+	return d
+}
+
+// mulCanonical returns a*b mod q. When a is in Montgomery form and b in canonical form,
+// this is the Montgomery form of their product.
+func mulCanonical(a, b uint64) uint64 {
+	return reduce128(bits.Mul64(a, b))
+}
+
+// reduce128 returns hi*2⁶⁴+lo mod q, using 2⁶⁴ = 2³²-1 and 2⁹⁶ = -1 mod q
+func reduce128(hi, lo uint64) uint64 {
+	hiHi, hiLo := hi>>32, hi&epsilon
+
+	t0, borrow := bits.Sub64(lo, hiHi, 0)
+	if borrow != 0 {
+		// t0 ≥ 2⁶⁴-2³², no underflow
+		t0 -= epsilon
+	}
+	t1 := hiLo * epsilon // < 2⁶⁴-2³³+1
+
+	t2, carry := bits.Add64(t0, t1, 0)
+	if carry != 0 {
+		// t2 < 2⁶⁴-2³³+1, no overflow
+		t2 += epsilon
+	}
+	if t2 >= q {
+		t2 -= q
+	}
+	return t2
+}
+
+// BitReverse applies the bit-reversal permutation to a.
+// len(a) must be a power of 2
+func BitReverse(a []goldilocks.Element) {
+	n := uint64(len(a))
+	if bits.OnesCount64(n) != 1 {
+		panic("len(a) must be a power of 2")
+	}
+	nn := uint64(64 - bits.TrailingZeros64(n))
+
+	for i := uint64(0); i < n; i++ {
+		iRev := bits.Reverse64(i) >> nn
+		if iRev > i {
+			a[i], a[iRev] = a[iRev], a[i]
+		}
+	}
+}
@@ -0,0 +1,35 @@
+//go:build !purego
+// +build !purego
+
+package fft
+
+import (
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+	"golang.org/x/sys/cpu"
+)
+
+var supportAvx512 = cpu.X86.HasAVX512F
+
+//go:noescape
+func butterfliesDIFAVX512(a, b *goldilocks.Element, tw *uint64, n uint64)
+
+//go:noescape
+func butterfliesDITAVX512(a, b *goldilocks.Element, tw *uint64, n uint64)
+
+// butterfliesDIF sets (aᵢ, bᵢ) to (aᵢ+bᵢ, (aᵢ-bᵢ)*twᵢ), 8 butterflies at a time with AVX-512
+func butterfliesDIF(a, b []goldilocks.Element, tw []uint64) {
+	if n := len(a) &^ 7; supportAvx512 && n != 0 {
+		butterfliesDIFAVX512(&a[0], &b[0], &tw[0], uint64(n))
+		a, b, tw = a[n:], b[n:], tw[n:]
+	}
+	butterfliesDIFGeneric(a, b, tw)
+}
+
+// butterfliesDIT sets (aᵢ, bᵢ) to (aᵢ+bᵢ*twᵢ, aᵢ-bᵢ*twᵢ), 8 butterflies at a time with AVX-512
+func butterfliesDIT(a, b []goldilocks.Element, tw []uint64) {
+	if n := len(a) &^ 7; supportAvx512 && n != 0 {
+		butterfliesDITAVX512(&a[0], &b[0], &tw[0], uint64(n))
+		a, b, tw = a[n:], b[n:], tw[n:]
+	}
+	butterfliesDITGeneric(a, b, tw)
+}
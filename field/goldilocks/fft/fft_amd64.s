@@ -0,0 +1,125 @@
+// +build !purego
+
+#include "textflag.h"
+
+// Packed arithmetic modulo q = 2⁶⁴-2³²+1 on 8 lanes, for inputs < q.
+// Z31 holds q, Z30 holds epsilon = 2³²-1 = 2⁶⁴ mod q in each lane.
+
+// dst = a + b mod q
+#define ADDQ_MOD(a, b, dst) \
+	VPADDQ   b, a, dst         \
+	VPCMPUQ  $1, a, dst, K1    \ // carry: dst < a
+	VPADDQ   Z30, dst, K1, dst \ // 2⁶⁴ = epsilon
+	VPCMPUQ  $5, Z31, dst, K2  \ // dst >= q
+	VPSUBQ   Z31, dst, K2, dst
+
+// dst = a - b mod q
+#define SUBQ_MOD(a, b, dst) \
+	VPSUBQ  b, a, dst        \
+	VPCMPUQ $1, b, a, K1     \ // borrow: a < b
+	VPADDQ  Z31, dst, K1, dst
+
+// dst = a * b mod q, clobbers Z20-Z29
+#define MULQ_MOD(a, b, dst) \
+	VPSRLQ   $32, a, Z20  \ // a₁
+	VPSRLQ   $32, b, Z21  \ // b₁
+	VPMULUDQ b, a, Z22    \ // a₀b₀
+	VPMULUDQ Z21, a, Z23  \ // a₀b₁
+	VPMULUDQ b, Z20, Z24  \ // a₁b₀
+	VPMULUDQ Z21, Z20, Z25 \ // a₁b₁
+	                      \
+	VPSRLQ $32, Z22, Z26  \ // t = a₀b₀>>32 + low(a₀b₁) + low(a₁b₀)
+	VPANDQ Z30, Z23, Z27  \
+	VPADDQ Z27, Z26, Z26  \
+	VPANDQ Z30, Z24, Z27  \
+	VPADDQ Z27, Z26, Z26  \
+	                      \
+	VPANDQ Z30, Z22, Z28  \ // lo = low(a₀b₀) | t<<32
+	VPSLLQ $32, Z26, Z27  \
+	VPORQ  Z27, Z28, Z28  \
+	                      \
+	VPSRLQ $32, Z23, Z27  \ // hi = a₁b₁ + a₀b₁>>32 + a₁b₀>>32 + t>>32
+	VPADDQ Z27, Z25, Z29  \
+	VPSRLQ $32, Z24, Z27  \
+	VPADDQ Z27, Z29, Z29  \
+	VPSRLQ $32, Z26, Z27  \
+	VPADDQ Z27, Z29, Z29  \
+	                      \
+	VPSRLQ  $32, Z29, Z27  \ // t0 = lo - hi>>32 (2⁹⁶ = -1)
+	VPSUBQ  Z27, Z28, Z26  \
+	VPCMPUQ $1, Z27, Z28, K1 \ // borrow: lo < hi>>32
+	VPSUBQ  Z30, Z26, K1, Z26 \
+	VPANDQ  Z30, Z29, Z27  \ // t1 = low(hi) * epsilon (2⁶⁴ = epsilon)
+	VPSLLQ  $32, Z27, Z28  \
+	VPSUBQ  Z27, Z28, Z28  \
+	VPADDQ  Z28, Z26, dst  \ // t0 + t1
+	VPCMPUQ $1, Z26, dst, K1 \ // carry: dst < t0
+	VPADDQ  Z30, dst, K1, dst \
+	VPCMPUQ $5, Z31, dst, K2 \ // dst >= q
+	VPSUBQ  Z31, dst, K2, dst
+
+#define LOAD_CONSTANTS() \
+	MOVQ         $0xffffffff00000001, AX \
+	VPBROADCASTQ AX, Z31                 \
+	MOVQ         $0xffffffff, AX         \
+	VPBROADCASTQ AX, Z30
+
+// func butterfliesDIFAVX512(a, b *goldilocks.Element, tw *uint64, n uint64)
+// (aᵢ, bᵢ) = (aᵢ+bᵢ, (aᵢ-bᵢ)*twᵢ), n must be a multiple of 8
+TEXT ·butterfliesDIFAVX512(SB), NOSPLIT, $0-32
+	MOVQ a+0(FP), R8
+	MOVQ b+8(FP), R9
+	MOVQ tw+16(FP), R10
+	MOVQ n+24(FP), CX
+	LOAD_CONSTANTS()
+
+loop_dif:
+	TESTQ CX, CX
+	JEQ   done_dif
+	VMOVDQU64 0(R8), Z0
+	VMOVDQU64 0(R9), Z1
+	VMOVDQU64 0(R10), Z2
+	ADDQ_MOD(Z0, Z1, Z3)
+	SUBQ_MOD(Z0, Z1, Z4)
+	MULQ_MOD(Z4, Z2, Z5)
+	VMOVDQU64 Z3, 0(R8)
+	VMOVDQU64 Z5, 0(R9)
+	ADDQ $64, R8
+	ADDQ $64, R9
+	ADDQ $64, R10
+	SUBQ $8, CX
+	JMP  loop_dif
+
+done_dif:
+	VZEROUPPER
+	RET
+
+// func butterfliesDITAVX512(a, b *goldilocks.Element, tw *uint64, n uint64)
+// (aᵢ, bᵢ) = (aᵢ+bᵢ*twᵢ, aᵢ-bᵢ*twᵢ), n must be a multiple of 8
+TEXT ·butterfliesDITAVX512(SB), NOSPLIT, $0-32
+	MOVQ a+0(FP), R8
+	MOVQ b+8(FP), R9
+	MOVQ tw+16(FP), R10
+	MOVQ n+24(FP), CX
+	LOAD_CONSTANTS()
+
+loop_dit:
+	TESTQ CX, CX
+	JEQ   done_dit
+	VMOVDQU64 0(R8), Z0
+	VMOVDQU64 0(R9), Z1
+	VMOVDQU64 0(R10), Z2
+	MULQ_MOD(Z1, Z2, Z4)
+	ADDQ_MOD(Z0, Z4, Z3)
+	SUBQ_MOD(Z0, Z4, Z5)
+	VMOVDQU64 Z3, 0(R8)
+	VMOVDQU64 Z5, 0(R9)
+	ADDQ $64, R8
+	ADDQ $64, R9
+	ADDQ $64, R10
+	SUBQ $8, CX
+	JMP  loop_dit
+
+done_dit:
+	VZEROUPPER
+	RET
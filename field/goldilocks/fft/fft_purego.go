@@ -0,0 +1,11 @@
+//go:build !amd64 || purego
+// +build !amd64 purego
+
+package fft
+
+var supportAvx512 = false
+
+var (
+	butterfliesDIF = butterfliesDIFGeneric
+	butterfliesDIT = butterfliesDITGeneric
+)
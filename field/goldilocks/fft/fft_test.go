@@ -0,0 +1,186 @@
+package fft
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+func randomVector(n int) []goldilocks.Element {
+	res := make([]goldilocks.Element, n)
+	for i := range res {
+		res[i].SetRandom()
+	}
+	return res
+}
+
+// naiveDFT returns the evaluations of the polynomial of coefficients p on the domain
+func naiveDFT(p []goldilocks.Element, omega goldilocks.Element) []goldilocks.Element {
+	res := make([]goldilocks.Element, len(p))
+	var x goldilocks.Element
+	x.SetOne()
+	for i := range res {
+		for j := len(p) - 1; j >= 0; j-- {
+			res[i].Mul(&res[i], &x).Add(&res[i], &p[j])
+		}
+		x.Mul(&x, &omega)
+	}
+	return res
+}
+
+func TestGenerator(t *testing.T) {
+	for _, m := range []uint64{1, 2, 3, 1 << 10, 1 << 32} {
+		omega, err := Generator(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		n := nextPowerOfTwo(m)
+		var e goldilocks.Element
+		if e.Exp(omega, new(big.Int).SetUint64(n)); !e.IsOne() {
+			t.Fatalf("ω^%d != 1", n)
+		}
+		if n > 1 {
+			if e.Exp(omega, new(big.Int).SetUint64(n/2)); e.IsOne() {
+				t.Fatalf("ω is not a primitive %d-th root of unity", n)
+			}
+		}
+	}
+	if _, err := Generator(1<<32 + 1); err == nil {
+		t.Fatal("expected an error, the root of unity doesn't exist")
+	}
+}
+
+func TestFFT(t *testing.T) {
+	for _, avx512 := range []bool{false, true} {
+		if avx512 && !supportAvx512 {
+			continue
+		}
+		restore := supportAvx512
+		supportAvx512 = avx512
+
+		for _, n := range []int{1, 2, 8, 32, 1 << 10, 1 << 13} {
+			domain := NewDomain(uint64(n))
+			p := randomVector(n)
+			var expected []goldilocks.Element
+			if n <= 1<<10 {
+				expected = naiveDFT(p, domain.Generator)
+			}
+
+			for _, decimation := range []Decimation{DIF, DIT} {
+				for _, nbTasks := range []int{1, 4} {
+					a := make([]goldilocks.Element, n)
+					copy(a, p)
+					if decimation == DIT {
+						BitReverse(a)
+					}
+					domain.FFT(a, decimation, WithNbTasks(nbTasks))
+					if decimation == DIF {
+						BitReverse(a)
+					}
+					for i := range expected {
+						if !a[i].Equal(&expected[i]) {
+							t.Fatalf("avx512=%v n=%d decimation=%d: FFT doesn't match the DFT", avx512, n, decimation)
+						}
+					}
+
+					// and back
+					if decimation == DIF {
+						BitReverse(a)
+						domain.FFTInverse(a, DIT, WithNbTasks(nbTasks))
+					} else {
+						domain.FFTInverse(a, DIF, WithNbTasks(nbTasks))
+						BitReverse(a)
+					}
+					for i := range a {
+						if !a[i].Equal(&p[i]) {
+							t.Fatalf("avx512=%v n=%d decimation=%d: FFTInverse(FFT) isn't the identity", avx512, n, decimation)
+						}
+					}
+				}
+			}
+		}
+		supportAvx512 = restore
+	}
+}
+
+func TestButterflies(t *testing.T) {
+	if !supportAvx512 {
+		t.Skip("AVX-512 not supported")
+	}
+
+	// edge cases: 0, 1, q-1, q-2, 2³²-1, 2³², and random values
+	const n = 64
+	var edges []uint64
+	for _, v := range []uint64{0, 1, q - 1, q - 2, epsilon, 1 << 32, 1 << 63} {
+		edges = append(edges, v)
+	}
+	a, b, tw := randomVector(n), randomVector(n), make([]uint64, n)
+	for i := range tw {
+		var e goldilocks.Element
+		e.SetRandom()
+		tw[i] = e[0]
+	}
+	for i, v := range edges {
+		for j, w := range edges {
+			k := (i*len(edges) + j) % n
+			a[k][0], b[k][0] = v, w
+			tw[(k+1)%n] = v
+		}
+	}
+
+	for _, variant := range []struct {
+		name     string
+		asm, ref func(a, b []goldilocks.Element, tw []uint64)
+	}{
+		{"DIF", butterfliesDIF, butterfliesDIFGeneric},
+		{"DIT", butterfliesDIT, butterfliesDITGeneric},
+	} {
+		a1, b1 := append([]goldilocks.Element{}, a...), append([]goldilocks.Element{}, b...)
+		a2, b2 := append([]goldilocks.Element{}, a...), append([]goldilocks.Element{}, b...)
+		// a length that isn't a multiple of 8 exercises the generic tail
+		variant.asm(a1[:n-3], b1[:n-3], tw)
+		variant.ref(a2[:n-3], b2[:n-3], tw)
+		for i := range a1 {
+			if a1[i] != a2[i] || b1[i] != b2[i] {
+				t.Fatalf("%s: AVX-512 and generic butterflies differ at index %d", variant.name, i)
+			}
+		}
+	}
+}
+
+func TestMulCanonical(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		var x, y, expected goldilocks.Element
+		x.SetRandom()
+		y.SetRandom()
+		expected.Mul(&x, &y)
+		// x in Montgomery form times y in canonical form
+		if got := mulCanonical(x[0], y.Bits()[0]); got != expected[0] {
+			t.Fatal("mulCanonical doesn't match goldilocks.Element.Mul")
+		}
+	}
+}
+
+func BenchmarkFFT(b *testing.B) {
+	for _, logN := range []int{16, 20} {
+		n := 1 << logN
+		domain := NewDomain(uint64(n))
+		a := randomVector(n)
+		for _, avx512 := range []bool{false, true} {
+			if avx512 && !supportAvx512 {
+				continue
+			}
+			b.Run(fmt.Sprintf("size=2^%d/avx512=%v", logN, avx512), func(b *testing.B) {
+				restore := supportAvx512
+				supportAvx512 = avx512
+				defer func() { supportAvx512 = restore }()
+				b.ResetTimer()
+				for j := 0; j < b.N; j++ {
+					domain.FFT(a, DIF)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package goldilocks
+
+import (
+	"errors"
+)
+
+var errColumnLength = errors.New("columns must have the same length")
+
+// matrixTileSize is the side of the square tiles a Matrix is transposed by, small
+// enough for a tile of the source and one of the destination to stay in cache.
+const matrixTileSize = 32
+
+// Matrix is a matrix of Element stored column by column: column j is the
+// contiguous slice of the j-th NbRows elements of the underlying storage. This is the
+// layout of the columns of a witness, so that each column can be handed to polynomial
+// routines without a copy.
+type Matrix struct {
+	nbRows, nbCols int
+	data           []Element
+}
+
+// NewMatrix returns a zero matrix of nbRows rows and nbCols columns.
+func NewMatrix(nbRows, nbCols int) Matrix {
+	return Matrix{
+		nbRows: nbRows,
+		nbCols: nbCols,
+		data:   make([]Element, nbRows*nbCols),
+	}
+}
+
+// NewMatrixFromColumns returns a matrix whose columns are copies of columns, which must
+// have the same length.
+func NewMatrixFromColumns(columns ...[]Element) (Matrix, error) {
+	if len(columns) == 0 {
+		return Matrix{}, nil
+	}
+	m := NewMatrix(len(columns[0]), len(columns))
+	for j := range columns {
+		if len(columns[j]) != m.nbRows {
+			return Matrix{}, errColumnLength
+		}
+		copy(m.Column(j), columns[j])
+	}
+	return m, nil
+}
+
+// NbRows returns the number of rows of m.
+func (m Matrix) NbRows() int {
+	return m.nbRows
+}
+
+// NbCols returns the number of columns of m.
+func (m Matrix) NbCols() int {
+	return m.nbCols
+}
+
+// At returns a pointer to the element of m at row i and column j.
+func (m Matrix) At(i, j int) *Element {
+	return &m.data[j*m.nbRows+i]
+}
+
+// Column returns the j-th column of m. It shares its memory with m: it is not copied,
+// and modifying it modifies m.
+func (m Matrix) Column(j int) Vector {
+	return Vector(m.data[j*m.nbRows : (j+1)*m.nbRows : (j+1)*m.nbRows])
+}
+
+// Columns returns the columns of m, sharing their memory with m (see Column).
+func (m Matrix) Columns() []Vector {
+	res := make([]Vector, m.nbCols)
+	for j := range res {
+		res[j] = m.Column(j)
+	}
+	return res
+}
+
+// Row returns a copy of the i-th row of m.
+func (m Matrix) Row(i int) Vector {
+	res := make(Vector, m.nbCols)
+	for j := range res {
+		res[j] = m.data[j*m.nbRows+i]
+	}
+	return res
+}
+
+// Transpose returns the transpose of m, whose columns are the rows of m.
+//
+// The matrix is processed by square tiles, so that both the reads and the writes stay
+// in cache, and the tiles are shared among the available CPUs.
+func (m Matrix) Transpose() Matrix {
+	res := NewMatrix(m.nbCols, m.nbRows)
+
+	nbRowTiles := (m.nbRows + matrixTileSize - 1) / matrixTileSize
+	nbColTiles := (m.nbCols + matrixTileSize - 1) / matrixTileSize
+	execute(nbRowTiles*nbColTiles, func(start, end int) {
+		for t := start; t < end; t++ {
+			iStart, jStart := (t/nbColTiles)*matrixTileSize, (t%nbColTiles)*matrixTileSize
+			iEnd, jEnd := iStart+matrixTileSize, jStart+matrixTileSize
+			if iEnd > m.nbRows {
+				iEnd = m.nbRows
+			}
+			if jEnd > m.nbCols {
+				jEnd = m.nbCols
+			}
+			for j := jStart; j < jEnd; j++ {
+				column := m.data[j*m.nbRows : (j+1)*m.nbRows]
+				for i := iStart; i < iEnd; i++ {
+					res.data[i*res.nbRows+j] = column[i]
+				}
+			}
+		}
+	})
+
+	return res
+}
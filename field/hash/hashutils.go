@@ -3,14 +3,22 @@ package hash
 import (
 	"crypto/sha256"
 	"errors"
+	stdhash "hash"
 )
 
-// ExpandMsgXmd expands msg to a slice of lenInBytes bytes.
+// ExpandMsgXmd expands msg to a slice of lenInBytes bytes, using SHA-256.
 // https://datatracker.ietf.org/doc/html/rfc9380#name-expand_message_xmd
 // https://datatracker.ietf.org/doc/html/rfc9380#name-utility-functions (I2OSP/O2ISP)
 func ExpandMsgXmd(msg, dst []byte, lenInBytes int) ([]byte, error) {
+	return ExpandMsgXmdWithHash(sha256.New, msg, dst, lenInBytes)
+}
+
+// ExpandMsgXmdWithHash expands msg to a slice of lenInBytes bytes, using the hash function
+// returned by newHash (e.g. sha512.New for ristretto255 or Ed25519 suites).
+// https://datatracker.ietf.org/doc/html/rfc9380#name-expand_message_xmd
+func ExpandMsgXmdWithHash(newHash func() stdhash.Hash, msg, dst []byte, lenInBytes int) ([]byte, error) {
 
-	h := sha256.New()
+	h := newHash()
 	ell := (lenInBytes + h.Size() - 1) / h.Size() // ceil(len_in_bytes / b_in_bytes)
 	if ell > 255 {
 		return nil, errors.New("invalid lenInBytes")
@@ -59,7 +67,7 @@ func ExpandMsgXmd(msg, dst []byte, lenInBytes int) ([]byte, error) {
 	b1 := h.Sum(nil)
 
 	res := make([]byte, lenInBytes)
-	copy(res[:h.Size()], b1)
+	copy(res, b1)
 
 	for i := 2; i <= ell; i++ {
 		// b_i = H(strxor(b₀, b_(i - 1)) ∥ I2OSP(i, 1) ∥ DST_prime)
@@ -1,6 +1,6 @@
 module github.com/consensys/gnark-crypto
 
-go 1.19
+go 1.21
 
 require (
 	github.com/bits-and-blooms/bitset v1.7.0
@@ -0,0 +1,26 @@
+package bbs
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+	// BBS+ signatures
+	conf.Package = "bbs"
+	baseDir = filepath.Join(baseDir, conf.Package)
+
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "bbs.go"), Templates: []string{"bbs.go.tmpl"}},
+		{File: filepath.Join(baseDir, "bbs_test.go"), Templates: []string{"bbs.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "proof.go"), Templates: []string{"proof.go.tmpl"}},
+		{File: filepath.Join(baseDir, "proof_test.go"), Templates: []string{"proof.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
+		{File: filepath.Join(baseDir, "marshal_test.go"), Templates: []string{"marshal.test.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./bbs/template", entries...)
+
+}
@@ -2,6 +2,7 @@ package ecc
 
 import (
 	"fmt"
+	"math/big"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -33,6 +34,12 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 
 		funcs := make(template.FuncMap)
 		funcs["asElement"] = hashConf.Field.Base.WriteElement
+		// bits of a negative big.Int are those of its two's complement
+		funcs["abs"] = func(z big.Int) big.Int {
+			var res big.Int
+			res.Abs(&z)
+			return res
+		}
 		bavardOpts := []func(*bavard.Bavard) error{bavard.Funcs(funcs)}
 
 		return bgen.GenerateWithOptions(hashConf, packageName, "./ecc/template", bavardOpts, entries...)
@@ -50,14 +57,21 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "g1.go"), Templates: []string{"point.go.tmpl"}},
 		{File: filepath.Join(baseDir, "g1_test.go"), Templates: []string{"tests/point.go.tmpl"}},
 	}
-	// if not secp256k1, generate the lagrange transform
-	if conf.Name != config.SECP256K1.Name {
+	// if not secp256k1 or secp256r1, generate the lagrange transform
+	if conf.Name != config.SECP256K1.Name && conf.Name != config.SECP256R1.Name {
 		os.Remove(filepath.Join(baseDir, "g1_lagrange.go"))
 		os.Remove(filepath.Join(baseDir, "g1_lagrange_test.go"))
 		// entries = append(entries, bavard.Entry{File: filepath.Join(baseDir, "g1_lagrange.go"), Templates: []string{"lagrange.go.tmpl"}})
 		// entries = append(entries, bavard.Entry{File: filepath.Join(baseDir, "g1_lagrange_test.go"), Templates: []string{"tests/lagrange.go.tmpl"}})
 	}
 
+	// constant-time scalar multiplication with the complete formulas for a = 0
+	// (secp256r1 has its own, for a = -3)
+	if conf.Name != config.SECP256R1.Name {
+		entries = append(entries, bavard.Entry{File: filepath.Join(baseDir, "constant_time.go"), Templates: []string{"constant_time.go.tmpl"}})
+		entries = append(entries, bavard.Entry{File: filepath.Join(baseDir, "constant_time_test.go"), Templates: []string{"tests/constant_time.go.tmpl"}})
+	}
+
 	g1 := pconf{conf, conf.G1}
 	if err := bgen.Generate(g1, packageName, "./ecc/template", entries...); err != nil {
 		return err
@@ -173,8 +187,8 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		return err
 	}
 
-	// No G2 for secp256k1
-	if conf.Equal(config.SECP256K1) {
+	// No G2 for secp256k1 and secp256r1
+	if conf.Equal(config.SECP256K1) || conf.Equal(config.SECP256R1) {
 		return nil
 	}
 
@@ -182,6 +196,14 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 	entries = []bavard.Entry{
 		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
 		{File: filepath.Join(baseDir, "marshal_test.go"), Templates: []string{"tests/marshal.go.tmpl"}},
+		{File: filepath.Join(baseDir, "multiexp_table.go"), Templates: []string{"multiexp_table.go.tmpl"}},
+		{File: filepath.Join(baseDir, "multiexp_table_test.go"), Templates: []string{"tests/multiexp_table.go.tmpl"}},
+		{File: filepath.Join(baseDir, "multiexp_distributed.go"), Templates: []string{"multiexp_distributed.go.tmpl"}},
+		{File: filepath.Join(baseDir, "multiexp_distributed_test.go"), Templates: []string{"tests/multiexp_distributed.go.tmpl"}},
+		{File: filepath.Join(baseDir, "fixedbase.go"), Templates: []string{"fixedbase.go.tmpl"}},
+		{File: filepath.Join(baseDir, "fixedbase_test.go"), Templates: []string{"tests/fixedbase.go.tmpl"}},
+		{File: filepath.Join(baseDir, "generators.go"), Templates: []string{"generators.go.tmpl"}},
+		{File: filepath.Join(baseDir, "generators_test.go"), Templates: []string{"tests/generators.go.tmpl"}},
 	}
 
 	marshal := []func(*bavard.Bavard) error{bavard.Funcs(funcs)}
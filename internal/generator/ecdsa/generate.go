@@ -16,9 +16,18 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
 		{File: filepath.Join(baseDir, "ecdsa.go"), Templates: []string{"ecdsa.go.tmpl"}},
 		{File: filepath.Join(baseDir, "ecdsa_test.go"), Templates: []string{"ecdsa.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "external.go"), Templates: []string{"external.go.tmpl"}},
+		{File: filepath.Join(baseDir, "external_test.go"), Templates: []string{"external.test.go.tmpl"}},
 		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
 		{File: filepath.Join(baseDir, "marshal_test.go"), Templates: []string{"marshal.test.go.tmpl"}},
 	}
+	if conf.Name == "secp256k1" || conf.Name == "secp256r1" || conf.Name == "bn254" {
+		// batch verification relies on the recovery information and the multi-exponentiation
+		entries = append(entries,
+			bavard.Entry{File: filepath.Join(baseDir, "batch.go"), Templates: []string{"batch.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(baseDir, "batch_test.go"), Templates: []string{"batch.test.go.tmpl"}},
+		)
+	}
 	return bgen.Generate(conf, conf.Package, "./ecdsa/template", entries...)
 
 }
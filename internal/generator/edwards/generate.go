@@ -13,6 +13,14 @@ func Generate(conf config.TwistedEdwardsCurve, baseDir string, bgen *bavard.Batc
 		{File: filepath.Join(baseDir, "point_test.go"), Templates: []string{"tests/point.go.tmpl"}},
 		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
 		{File: filepath.Join(baseDir, "curve.go"), Templates: []string{"curve.go.tmpl"}},
+		{File: filepath.Join(baseDir, "generic.go"), Templates: []string{"generic.go.tmpl"}},
+		{File: filepath.Join(baseDir, "generic_test.go"), Templates: []string{"tests/generic.go.tmpl"}},
+		{File: filepath.Join(baseDir, "fixedbase.go"), Templates: []string{"fixedbase.go.tmpl"}},
+		{File: filepath.Join(baseDir, "fixedbase_test.go"), Templates: []string{"tests/fixedbase.go.tmpl"}},
+		{File: filepath.Join(baseDir, "multiexp.go"), Templates: []string{"multiexp.go.tmpl"}},
+		{File: filepath.Join(baseDir, "multiexp_test.go"), Templates: []string{"tests/multiexp.go.tmpl"}},
+		{File: filepath.Join(baseDir, "pedersen.go"), Templates: []string{"pedersen.go.tmpl"}},
+		{File: filepath.Join(baseDir, "pedersen_test.go"), Templates: []string{"tests/pedersen.go.tmpl"}},
 	}
 
 	return bgen.Generate(conf, conf.Package, "./edwards/template", entries...)
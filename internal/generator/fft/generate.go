@@ -19,6 +19,7 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "fft_test.go"), Templates: []string{"tests/fft.go.tmpl", "imports.go.tmpl"}},
 		{File: filepath.Join(baseDir, "bitreverse_test.go"), Templates: []string{"tests/bitreverse.go.tmpl", "imports.go.tmpl"}},
 		{File: filepath.Join(baseDir, "fft.go"), Templates: []string{"fft.go.tmpl", "imports.go.tmpl"}},
+		{File: filepath.Join(baseDir, "fft_strided.go"), Templates: []string{"fft_strided.go.tmpl", "imports.go.tmpl"}},
 		{File: filepath.Join(baseDir, "bitreverse.go"), Templates: []string{"bitreverse.go.tmpl", "imports.go.tmpl"}},
 		{File: filepath.Join(baseDir, "options.go"), Templates: []string{"options.go.tmpl", "imports.go.tmpl"}},
 	}
@@ -54,11 +55,12 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		return err
 	}
 
-	// put the generator in the parent dir (fr)
+	// put the generator in the parent dir (fr), formatted: the template output isn't gofmt-clean
 	frDir := filepath.Dir(baseDir)
 	entries = []bavard.Entry{
 		{File: filepath.Join(frDir, "generator.go"), Templates: []string{"fr.generator.go.tmpl"}},
 	}
+	bavardOpts = append(bavardOpts, bavard.Format(true))
 	return bgen.GenerateWithOptions(conf, "fr", "./fft/template/", bavardOpts, entries...)
 }
 
@@ -0,0 +1,21 @@
+package fieldtree
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+
+	// merkle tree over field elements
+	conf.Package = "fieldtree"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "tree.go"), Templates: []string{"tree.go.tmpl"}},
+		{File: filepath.Join(baseDir, "tree_test.go"), Templates: []string{"tree.test.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./fieldtree/template/", entries...)
+
+}
@@ -0,0 +1,21 @@
+package hyrax
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+
+	// hyrax commitment scheme
+	conf.Package = "hyrax"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "hyrax.go"), Templates: []string{"hyrax.go.tmpl"}},
+		{File: filepath.Join(baseDir, "hyrax_test.go"), Templates: []string{"hyrax.test.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./hyrax/template/", entries...)
+
+}
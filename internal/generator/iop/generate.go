@@ -19,12 +19,32 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "ratios.go"), Templates: []string{"ratios.go.tmpl"}},
 		{File: filepath.Join(baseDir, "ratios_test.go"), Templates: []string{"ratios.test.go.tmpl"}},
 
+		{File: filepath.Join(baseDir, "permutation.go"), Templates: []string{"permutation.go.tmpl"}},
+		{File: filepath.Join(baseDir, "permutation_test.go"), Templates: []string{"permutation.test.go.tmpl"}},
+
+		{File: filepath.Join(baseDir, "barycentric.go"), Templates: []string{"barycentric.go.tmpl"}},
+		{File: filepath.Join(baseDir, "barycentric_test.go"), Templates: []string{"barycentric.test.go.tmpl"}},
+
 		{File: filepath.Join(baseDir, "quotient.go"), Templates: []string{"quotient.go.tmpl"}},
 		{File: filepath.Join(baseDir, "quotient_test.go"), Templates: []string{"quotient.test.go.tmpl"}},
 
 		{File: filepath.Join(baseDir, "expressions.go"), Templates: []string{"expressions.go.tmpl"}},
 		{File: filepath.Join(baseDir, "expressions_test.go"), Templates: []string{"expressions.test.go.tmpl"}},
 
+		{File: filepath.Join(baseDir, "vanishing.go"), Templates: []string{"vanishing.go.tmpl"}},
+		{File: filepath.Join(baseDir, "vanishing_test.go"), Templates: []string{"vanishing.test.go.tmpl"}},
+
+		{File: filepath.Join(baseDir, "sumcheck.go"), Templates: []string{"sumcheck.go.tmpl"}},
+		{File: filepath.Join(baseDir, "sumcheck_test.go"), Templates: []string{"sumcheck.test.go.tmpl"}},
+
+		{File: filepath.Join(baseDir, "representations.go"), Templates: []string{"representations.go.tmpl"}},
+		{File: filepath.Join(baseDir, "representations_test.go"), Templates: []string{"representations.test.go.tmpl"}},
+
+		{File: filepath.Join(baseDir, "validate.go"), Templates: []string{"validate.go.tmpl"}},
+		{File: filepath.Join(baseDir, "validate_test.go"), Templates: []string{"validate.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "debug.go"), Templates: []string{"debug.enabled.go.tmpl"}, BuildTag: "iopdebug"},
+		{File: filepath.Join(baseDir, "nodebug.go"), Templates: []string{"debug.disabled.go.tmpl"}, BuildTag: "!iopdebug"},
+
 		{File: filepath.Join(baseDir, "utils.go"), Templates: []string{"utils.go.tmpl"}},
 	}
 
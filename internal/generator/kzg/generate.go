@@ -12,12 +12,42 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 	// kzg commitment scheme
 	conf.Package = "kzg"
 	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "aggregate.go"), Templates: []string{"aggregate.go.tmpl"}},
+		{File: filepath.Join(baseDir, "aggregate_test.go"), Templates: []string{"aggregate.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "aggregate_proofs.go"), Templates: []string{"aggregate_proofs.go.tmpl"}},
+		{File: filepath.Join(baseDir, "aggregate_proofs_test.go"), Templates: []string{"aggregate_proofs.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "ceremony.go"), Templates: []string{"ceremony.go.tmpl"}},
+		{File: filepath.Join(baseDir, "ceremony_test.go"), Templates: []string{"ceremony.test.go.tmpl"}},
 		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "gemini.go"), Templates: []string{"gemini.go.tmpl"}},
+		{File: filepath.Join(baseDir, "hiding.go"), Templates: []string{"hiding.go.tmpl"}},
+		{File: filepath.Join(baseDir, "hiding_test.go"), Templates: []string{"hiding.test.go.tmpl"}},
 		{File: filepath.Join(baseDir, "kzg.go"), Templates: []string{"kzg.go.tmpl"}},
 		{File: filepath.Join(baseDir, "kzg_test.go"), Templates: []string{"kzg.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "linearization.go"), Templates: []string{"linearization.go.tmpl"}},
+		{File: filepath.Join(baseDir, "linearization_test.go"), Templates: []string{"linearization.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "compact.go"), Templates: []string{"compact.go.tmpl"}},
+		{File: filepath.Join(baseDir, "compact_test.go"), Templates: []string{"compact.test.go.tmpl"}},
 		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
+		{File: filepath.Join(baseDir, "multilinear.go"), Templates: []string{"multilinear.go.tmpl"}},
+		{File: filepath.Join(baseDir, "multilinear_test.go"), Templates: []string{"multilinear.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "scheme.go"), Templates: []string{"scheme.go.tmpl"}},
+		{File: filepath.Join(baseDir, "scheme_test.go"), Templates: []string{"scheme.test.go.tmpl"}},
 		{File: filepath.Join(baseDir, "utils.go"), Templates: []string{"utils.go.tmpl"}},
+		{File: filepath.Join(baseDir, "zeromorph.go"), Templates: []string{"zeromorph.go.tmpl"}},
 	}
-	return bgen.Generate(conf, conf.Package, "./kzg/template/", entries...)
+	if err := bgen.Generate(conf, conf.Package, "./kzg/template/", entries...); err != nil {
+		return err
+	}
+
+	// fflonk combined commitments, on top of kzg
+	conf.Package = "fflonk"
+	fflonkDir := filepath.Join(baseDir, "fflonk")
+	entries = []bavard.Entry{
+		{File: filepath.Join(fflonkDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(fflonkDir, "fflonk.go"), Templates: []string{"fflonk.go.tmpl"}},
+		{File: filepath.Join(fflonkDir, "fflonk_test.go"), Templates: []string{"fflonk.test.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./kzg/template/fflonk/", entries...)
 
 }
@@ -0,0 +1,21 @@
+package ligero
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+
+	// ligero commitment scheme
+	conf.Package = "ligero"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "ligero.go"), Templates: []string{"ligero.go.tmpl"}},
+		{File: filepath.Join(baseDir, "ligero_test.go"), Templates: []string{"ligero.test.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./ligero/template/", entries...)
+
+}
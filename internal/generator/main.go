@@ -10,6 +10,7 @@ import (
 	"github.com/consensys/bavard"
 	"github.com/consensys/gnark-crypto/field/generator"
 	field "github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/bbs"
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 	"github.com/consensys/gnark-crypto/internal/generator/crypto/hash/mimc"
 	"github.com/consensys/gnark-crypto/internal/generator/ecc"
@@ -17,12 +18,16 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/edwards"
 	"github.com/consensys/gnark-crypto/internal/generator/edwards/eddsa"
 	"github.com/consensys/gnark-crypto/internal/generator/fft"
+	"github.com/consensys/gnark-crypto/internal/generator/fieldtree"
 	fri "github.com/consensys/gnark-crypto/internal/generator/fri/template"
 	"github.com/consensys/gnark-crypto/internal/generator/gkr"
 	"github.com/consensys/gnark-crypto/internal/generator/hash_to_field"
+	"github.com/consensys/gnark-crypto/internal/generator/hyrax"
 	"github.com/consensys/gnark-crypto/internal/generator/iop"
 	"github.com/consensys/gnark-crypto/internal/generator/kzg"
+	"github.com/consensys/gnark-crypto/internal/generator/ligero"
 	"github.com/consensys/gnark-crypto/internal/generator/pairing"
+	"github.com/consensys/gnark-crypto/internal/generator/pcs"
 	"github.com/consensys/gnark-crypto/internal/generator/pedersen"
 	"github.com/consensys/gnark-crypto/internal/generator/permutation"
 	"github.com/consensys/gnark-crypto/internal/generator/plookup"
@@ -31,6 +36,8 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/sumcheck"
 	"github.com/consensys/gnark-crypto/internal/generator/test_vector_utils"
 	"github.com/consensys/gnark-crypto/internal/generator/tower"
+	"github.com/consensys/gnark-crypto/internal/generator/twochain"
+	"github.com/consensys/gnark-crypto/internal/generator/whir"
 )
 
 const (
@@ -76,7 +83,19 @@ func main() {
 			// generate G1, G2, multiExp, ...
 			assertNoError(ecc.Generate(conf, curveDir, bgen))
 
-			if conf.Equal(config.SECP256K1) {
+			frInfo := config.FieldDependency{
+				FieldPackagePath: "github.com/consensys/gnark-crypto/ecc/" + conf.Name + "/fr",
+				FieldPackageName: "fr",
+				ElementType:      "fr.Element",
+			}
+
+			// generate polynomial on fr (used by hyrax)
+			assertNoError(polynomial.Generate(frInfo, filepath.Join(curveDir, "fr", "polynomial"), true, bgen))
+
+			// generate hyrax (transparent, doesn't need a pairing)
+			assertNoError(hyrax.Generate(conf, filepath.Join(curveDir, "hyrax"), bgen))
+
+			if conf.Equal(config.SECP256K1) || conf.Equal(config.SECP256R1) {
 				return
 			}
 
@@ -86,9 +105,18 @@ func main() {
 			// generate pairing tests
 			assertNoError(pairing.Generate(conf, curveDir, bgen))
 
+			// generate the merkle tree over fr
+			assertNoError(fieldtree.Generate(conf, filepath.Join(curveDir, "fr", "fieldtree"), bgen))
+
 			// generate fri on fr
 			assertNoError(fri.Generate(conf, filepath.Join(curveDir, "fr", "fri"), bgen))
 
+			// generate ligero on fr
+			assertNoError(ligero.Generate(conf, filepath.Join(curveDir, "fr", "ligero"), bgen))
+
+			// generate whir on fr
+			assertNoError(whir.Generate(conf, filepath.Join(curveDir, "fr", "whir"), bgen))
+
 			// generate fft on fr
 			assertNoError(fft.Generate(conf, filepath.Join(curveDir, "fr", "fft"), bgen))
 
@@ -96,12 +124,20 @@ func main() {
 				assertNoError(sis.Generate(conf, filepath.Join(curveDir, "fr", "sis"), bgen))
 			}
 
+			// generate the polynomial commitment scheme interface
+			assertNoError(pcs.Generate(conf, filepath.Join(curveDir, "fr", "pcs"), bgen))
+
 			// generate kzg on fr
 			assertNoError(kzg.Generate(conf, filepath.Join(curveDir, "kzg"), bgen))
 
 			// generate pedersen on fr
 			assertNoError(pedersen.Generate(conf, filepath.Join(curveDir, "fr", "pedersen"), bgen))
 
+			if conf.Equal(config.BLS12_381) {
+				// generate BBS+ signatures
+				assertNoError(bbs.Generate(conf, curveDir, bgen))
+			}
+
 			// generate plookup on fr
 			assertNoError(plookup.Generate(conf, filepath.Join(curveDir, "fr", "plookup"), bgen))
 
@@ -111,15 +147,6 @@ func main() {
 			// generate mimc on fr
 			assertNoError(mimc.Generate(conf, filepath.Join(curveDir, "fr", "mimc"), bgen))
 
-			frInfo := config.FieldDependency{
-				FieldPackagePath: "github.com/consensys/gnark-crypto/ecc/" + conf.Name + "/fr",
-				FieldPackageName: "fr",
-				ElementType:      "fr.Element",
-			}
-
-			// generate polynomial on fr
-			assertNoError(polynomial.Generate(frInfo, filepath.Join(curveDir, "fr", "polynomial"), true, bgen))
-
 			// generate eddsa on companion curves
 			assertNoError(fri.Generate(conf, filepath.Join(curveDir, "fr", "fri"), bgen))
 
@@ -152,6 +179,16 @@ func main() {
 			assertNoError(hash_to_field.Generate(frInfo, filepath.Join(curveDir, "fr", "hash_to_field"), bgen))
 			assertNoError(hash_to_field.Generate(fpInfo, filepath.Join(curveDir, "fp", "hash_to_field"), bgen))
 
+			// generate the conversions from the inner curve of the 2-chains
+			switch {
+			case conf.Equal(config.BW6_761):
+				assertNoError(twochain.Generate(conf, config.BLS12_377, filepath.Join(curveDir, "twochain"), bgen))
+			case conf.Equal(config.BW6_756):
+				assertNoError(twochain.Generate(conf, config.BLS12_378, filepath.Join(curveDir, "twochain"), bgen))
+			case conf.Equal(config.BW6_633):
+				assertNoError(twochain.Generate(conf, config.BLS24_315, filepath.Join(curveDir, "twochain"), bgen))
+			}
+
 		}(conf)
 
 	}
@@ -11,8 +11,15 @@ import (
 func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
 
 	packageName := strings.ReplaceAll(conf.Name, "-", "")
-	return bgen.Generate(conf, packageName, "./pairing/template", bavard.Entry{
-		File: filepath.Join(baseDir, "pairing_test.go"), Templates: []string{"tests/pairing.go.tmpl"},
-	})
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "pairing_test.go"), Templates: []string{"tests/pairing.go.tmpl"}},
+		{File: filepath.Join(baseDir, "gt.go"), Templates: []string{"gt.go.tmpl"}},
+		{File: filepath.Join(baseDir, "gt_test.go"), Templates: []string{"tests/gt.go.tmpl"}},
+		{File: filepath.Join(baseDir, "pairing_equation.go"), Templates: []string{"pairing_equation.go.tmpl"}},
+		{File: filepath.Join(baseDir, "pairing_equation_test.go"), Templates: []string{"tests/pairing_equation.go.tmpl"}},
+		{File: filepath.Join(baseDir, "pairing_precomputation.go"), Templates: []string{"pairing_precomputation.go.tmpl"}},
+		{File: filepath.Join(baseDir, "pairing_precomputation_test.go"), Templates: []string{"tests/pairing_precomputation.go.tmpl"}},
+	}
+	return bgen.Generate(conf, packageName, "./pairing/template", entries...)
 
 }
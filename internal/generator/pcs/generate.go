@@ -0,0 +1,20 @@
+package pcs
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+
+	// polynomial commitment scheme interface
+	conf.Package = "pcs"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "pcs.go"), Templates: []string{"pcs.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./pcs/template/", entries...)
+
+}
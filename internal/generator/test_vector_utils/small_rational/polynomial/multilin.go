@@ -184,6 +184,15 @@ func (m *MultiLin) Eq(q []small_rational.SmallRational) {
 	}
 }
 
+// EqVector returns the evaluations on {0,1}ⁿ of Eq(q₁, ..., qₙ, *, ..., *), where q₁
+// corresponds to the most significant bit of the index
+func EqVector(q []small_rational.SmallRational) MultiLin {
+	m := make(MultiLin, 1<<len(q))
+	m[0].SetOne()
+	m.Eq(q)
+	return m
+}
+
 func (m MultiLin) NumVars() int {
 	return bits.TrailingZeros(uint(len(m)))
 }
@@ -0,0 +1,80 @@
+package twochain
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+// Config is the configuration of the conversions between the inner curve of a 2-chain and the
+// outer BW6 curve, whose scalar field is the base field of the inner curve.
+type Config struct {
+	config.Curve
+	Inner config.Curve
+
+	// G2Paths (resp. GTPaths) are the paths of the base field coordinates of the G2 (resp. GT)
+	// elements of the inner curve, in the order of the flattened representation
+	G2Paths []string
+	GTPaths []string
+}
+
+func Generate(conf, inner config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+	conf.Package = "twochain"
+
+	// coordinates of the G2 points, in Fp^{CoordExtDegree}
+	var g2Coords []string
+	switch inner.G2.CoordExtDegree {
+	case 2:
+		g2Coords = ext(nil, "A", 2)
+	case 4:
+		g2Coords = ext(ext(nil, "B", 2), "A", 2)
+	}
+	var g2Paths []string
+	for _, p := range []string{"X", "Y"} {
+		for _, c := range g2Coords {
+			g2Paths = append(g2Paths, p+"."+c)
+		}
+	}
+
+	// coordinates of the GT elements, in Fp^{12} = ((Fp^2)^3)^2 or Fp^{24} = (((Fp^2)^2)^3)^2
+	var gtPaths []string
+	switch inner.G2.CoordExtDegree {
+	case 2:
+		gtPaths = ext(ext(ext(nil, "C", 2), "B", 3), "A", 2)
+	case 4:
+		gtPaths = ext(ext(ext(ext(nil, "D", 2), "C", 3), "B", 2), "A", 2)
+	}
+
+	c := Config{
+		Curve:   conf,
+		Inner:   inner,
+		G2Paths: g2Paths,
+		GTPaths: gtPaths,
+	}
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "twochain.go"), Templates: []string{"twochain.go.tmpl"}},
+		{File: filepath.Join(baseDir, "twochain_test.go"), Templates: []string{"tests/twochain.go.tmpl"}},
+	}
+	return bgen.Generate(c, conf.Package, "./twochain/template/", entries...)
+}
+
+// ext returns the paths of the coordinates of a degree n extension whose coordinates are named
+// prefix0, ..., prefix{n-1}, over the coordinates paths (nil for the base field)
+func ext(paths []string, prefix string, n int) []string {
+	if paths == nil {
+		paths = []string{""}
+	}
+	var res []string
+	for _, p := range paths {
+		for i := 0; i < n; i++ {
+			if p == "" {
+				res = append(res, prefix+string(rune('0'+i)))
+			} else {
+				res = append(res, p+"."+prefix+string(rune('0'+i)))
+			}
+		}
+	}
+	return res
+}
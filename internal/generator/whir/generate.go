@@ -0,0 +1,21 @@
+package whir
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+
+	// whir commitment scheme
+	conf.Package = "whir"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "whir.go"), Templates: []string{"whir.go.tmpl"}},
+		{File: filepath.Join(baseDir, "whir_test.go"), Templates: []string{"whir.test.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./whir/template/", entries...)
+
+}
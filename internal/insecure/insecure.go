@@ -0,0 +1,16 @@
+// Package insecure guards the helpers generating setups from a known secret,
+// which must never end up in production code.
+//
+// The helpers are allowed in test binaries, detected with testing.Testing (Go 1.21), and
+// in binaries built with the insecure_srs build tag. They are refused everywhere else,
+// including in a regular binary named like a test binary.
+package insecure
+
+// BuildTag is the build tag enabling the insecure helpers outside of tests.
+const BuildTag = "insecure_srs"
+
+// Allowed reports whether the insecure helpers may run, that is if the binary
+// is a test binary or was built with the insecure_srs tag.
+func Allowed() bool {
+	return buildTagSet || isTestBinary()
+}
@@ -0,0 +1,38 @@
+package insecure
+
+import (
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestAllowed(t *testing.T) {
+	if !isTestBinary() {
+		t.Fatal("test binary not detected")
+	}
+	if !Allowed() {
+		t.Fatal("insecure helpers must be allowed in tests")
+	}
+}
+
+func TestNotAllowedInBinaryNamedLikeTest(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds a binary")
+	}
+	if buildTagSet {
+		t.Skip("built with the " + BuildTag + " tag")
+	}
+
+	// a regular binary named as go test names its binaries
+	bin := filepath.Join(t.TempDir(), "foo.test")
+	if out, err := exec.Command("go", "build", "-o", bin, "./testdata/allowed").CombinedOutput(); err != nil {
+		t.Fatal(err, string(out))
+	}
+	out, err := exec.Command(bin, "-test.v").Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != "false" {
+		t.Fatal("insecure helpers must not be allowed in a regular binary")
+	}
+}
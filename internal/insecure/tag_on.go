@@ -0,0 +1,6 @@
+//go:build insecure_srs
+// +build insecure_srs
+
+package insecure
+
+const buildTagSet = true
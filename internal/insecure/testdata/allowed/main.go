@@ -0,0 +1,12 @@
+// Command allowed prints whether the insecure helpers are allowed in a regular binary.
+package main
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/internal/insecure"
+)
+
+func main() {
+	fmt.Print(insecure.Allowed())
+}
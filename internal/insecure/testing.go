@@ -0,0 +1,9 @@
+package insecure
+
+import "testing"
+
+// isTestBinary reports whether the binary was built by go test. Importing testing has no
+// side effect: its flags are only registered when a test binary runs its tests.
+func isTestBinary() bool {
+	return testing.Testing()
+}
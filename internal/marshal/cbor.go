@@ -0,0 +1,186 @@
+package marshal
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// minimal CBOR (RFC 8949) support: definite length byte strings, text strings,
+// arrays and maps, which is all we need to encode proofs and keys.
+
+const (
+	cborByteString byte = 2
+	cborTextString byte = 3
+	cborArray      byte = 4
+	cborMap        byte = 5
+)
+
+var ErrInvalidCBOR = errors.New("invalid or unsupported CBOR encoding")
+
+// CBOREncoder appends CBOR data items to a buffer
+type CBOREncoder struct {
+	buf []byte
+}
+
+// Bytes returns the CBOR encoding of the items written so far
+func (e *CBOREncoder) Bytes() []byte {
+	return e.buf
+}
+
+func (e *CBOREncoder) writeHead(major byte, n uint64) {
+	major <<= 5
+	switch {
+	case n < 24:
+		e.buf = append(e.buf, major|byte(n))
+	case n <= 0xff:
+		e.buf = append(e.buf, major|24, byte(n))
+	case n <= 0xffff:
+		e.buf = append(e.buf, major|25)
+		e.buf = binary.BigEndian.AppendUint16(e.buf, uint16(n))
+	case n <= 0xffffffff:
+		e.buf = append(e.buf, major|26)
+		e.buf = binary.BigEndian.AppendUint32(e.buf, uint32(n))
+	default:
+		e.buf = append(e.buf, major|27)
+		e.buf = binary.BigEndian.AppendUint64(e.buf, n)
+	}
+}
+
+// WriteBytes writes b as a byte string
+func (e *CBOREncoder) WriteBytes(b []byte) {
+	e.writeHead(cborByteString, uint64(len(b)))
+	e.buf = append(e.buf, b...)
+}
+
+// WriteText writes s as a text string
+func (e *CBOREncoder) WriteText(s string) {
+	e.writeHead(cborTextString, uint64(len(s)))
+	e.buf = append(e.buf, s...)
+}
+
+// WriteArrayHeader starts an array of n items
+func (e *CBOREncoder) WriteArrayHeader(n int) {
+	e.writeHead(cborArray, uint64(n))
+}
+
+// WriteMapHeader starts a map of n key/value pairs
+func (e *CBOREncoder) WriteMapHeader(n int) {
+	e.writeHead(cborMap, uint64(n))
+}
+
+// CBORDecoder reads CBOR data items from a buffer
+type CBORDecoder struct {
+	buf []byte
+}
+
+// NewCBORDecoder returns a decoder reading data
+func NewCBORDecoder(data []byte) *CBORDecoder {
+	return &CBORDecoder{buf: data}
+}
+
+// Done returns an error if the decoder didn't consume all the data
+func (d *CBORDecoder) Done() error {
+	if len(d.buf) != 0 {
+		return ErrInvalidCBOR
+	}
+	return nil
+}
+
+func (d *CBORDecoder) readHead(major byte) (uint64, error) {
+	if len(d.buf) == 0 || d.buf[0]>>5 != major {
+		return 0, ErrInvalidCBOR
+	}
+	info := d.buf[0] & 0x1f
+	d.buf = d.buf[1:]
+	var size int
+	switch {
+	case info < 24:
+		return uint64(info), nil
+	case info == 24:
+		size = 1
+	case info == 25:
+		size = 2
+	case info == 26:
+		size = 4
+	case info == 27:
+		size = 8
+	default:
+		// indefinite lengths are not supported
+		return 0, ErrInvalidCBOR
+	}
+	if len(d.buf) < size {
+		return 0, ErrInvalidCBOR
+	}
+	var n uint64
+	for i := 0; i < size; i++ {
+		n = n<<8 | uint64(d.buf[i])
+	}
+	d.buf = d.buf[size:]
+	return n, nil
+}
+
+func (d *CBORDecoder) readString(major byte) ([]byte, error) {
+	n, err := d.readHead(major)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(d.buf)) < n {
+		return nil, ErrInvalidCBOR
+	}
+	res := d.buf[:n:n]
+	d.buf = d.buf[n:]
+	return res, nil
+}
+
+// ReadBytes reads a byte string
+func (d *CBORDecoder) ReadBytes() ([]byte, error) {
+	return d.readString(cborByteString)
+}
+
+// ReadText reads a text string
+func (d *CBORDecoder) ReadText() (string, error) {
+	b, err := d.readString(cborTextString)
+	return string(b), err
+}
+
+// ReadArrayHeader reads the number of items of an array
+func (d *CBORDecoder) ReadArrayHeader() (int, error) {
+	n, err := d.readHead(cborArray)
+	if err != nil {
+		return 0, err
+	}
+	// each item is at least one byte long
+	if n > uint64(len(d.buf)) {
+		return 0, ErrInvalidCBOR
+	}
+	return int(n), nil
+}
+
+// ReadMapHeader reads the number of key/value pairs of a map
+func (d *CBORDecoder) ReadMapHeader() (int, error) {
+	n, err := d.readHead(cborMap)
+	if err != nil {
+		return 0, err
+	}
+	if n > uint64(len(d.buf)) {
+		return 0, ErrInvalidCBOR
+	}
+	return int(n), nil
+}
+
+// MarshalCBORBytes returns the CBOR encoding of b as a byte string
+func MarshalCBORBytes(b []byte) []byte {
+	var e CBOREncoder
+	e.WriteBytes(b)
+	return e.Bytes()
+}
+
+// UnmarshalCBORBytes decodes a CBOR byte string
+func UnmarshalCBORBytes(data []byte) ([]byte, error) {
+	d := NewCBORDecoder(data)
+	b, err := d.ReadBytes()
+	if err != nil {
+		return nil, err
+	}
+	return b, d.Done()
+}
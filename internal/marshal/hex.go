@@ -0,0 +1,44 @@
+// Package marshal provides the helpers used by the JSON and CBOR marshallers of
+// proofs, signatures and keys.
+//
+// Binary values are encoded in JSON as 0x-prefixed, lowercase hex strings, and in
+// CBOR as byte strings.
+package marshal
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+var ErrInvalidHex = errors.New("invalid hex string")
+
+// HexString returns the 0x-prefixed hex encoding of b
+func HexString(b []byte) string {
+	return "0x" + hex.EncodeToString(b)
+}
+
+// ParseHexString decodes a hex string, with or without 0x prefix
+func ParseHexString(s string) ([]byte, error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidHex
+	}
+	return b, nil
+}
+
+// MarshalHexJSON returns the JSON encoding of b as a 0x-prefixed hex string
+func MarshalHexJSON(b []byte) ([]byte, error) {
+	return json.Marshal(HexString(b))
+}
+
+// UnmarshalHexJSON decodes a JSON hex string, with or without 0x prefix
+func UnmarshalHexJSON(data []byte) ([]byte, error) {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+	return ParseHexString(s)
+}
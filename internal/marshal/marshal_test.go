@@ -0,0 +1,69 @@
+package marshal
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestCBOR(t *testing.T) {
+	t.Parallel()
+
+	// test vectors from RFC 8949, Appendix A
+	var e CBOREncoder
+	e.WriteBytes([]byte{1, 2, 3, 4})
+	e.WriteText("a")
+	e.WriteArrayHeader(0)
+	e.WriteMapHeader(0)
+	e.WriteArrayHeader(25)
+	if got := hex.EncodeToString(e.Bytes()); got != "4401020304616180a09819" {
+		t.Fatalf("unexpected encoding %s", got)
+	}
+
+	d := NewCBORDecoder(e.Bytes())
+	if b, err := d.ReadBytes(); err != nil || !bytes.Equal(b, []byte{1, 2, 3, 4}) {
+		t.Fatal("couldn't decode byte string")
+	}
+	if s, err := d.ReadText(); err != nil || s != "a" {
+		t.Fatal("couldn't decode text string")
+	}
+	if n, err := d.ReadArrayHeader(); err != nil || n != 0 {
+		t.Fatal("couldn't decode array")
+	}
+	if n, err := d.ReadMapHeader(); err != nil || n != 0 {
+		t.Fatal("couldn't decode map")
+	}
+	// 25 items announced, but no data follows
+	if _, err := d.ReadArrayHeader(); err != ErrInvalidCBOR {
+		t.Fatal("truncated array should fail")
+	}
+
+	// long byte strings
+	long := make([]byte, 300)
+	long[299] = 42
+	b, err := UnmarshalCBORBytes(MarshalCBORBytes(long))
+	if err != nil || !bytes.Equal(b, long) {
+		t.Fatal("couldn't round trip byte string")
+	}
+	if _, err := UnmarshalCBORBytes(append(MarshalCBORBytes(long), 0)); err != ErrInvalidCBOR {
+		t.Fatal("trailing data should fail")
+	}
+}
+
+func TestHex(t *testing.T) {
+	t.Parallel()
+
+	data, err := MarshalHexJSON([]byte{0xca, 0xfe})
+	if err != nil || string(data) != `"0xcafe"` {
+		t.Fatal("unexpected hex encoding")
+	}
+	for _, s := range []string{`"0xcafe"`, `"cafe"`, `"0XCAFE"`} {
+		b, err := UnmarshalHexJSON([]byte(s))
+		if err != nil || !bytes.Equal(b, []byte{0xca, 0xfe}) {
+			t.Fatalf("couldn't decode %s", s)
+		}
+	}
+	if _, err := UnmarshalHexJSON([]byte(`"0xcaf"`)); err != ErrInvalidHex {
+		t.Fatal("odd length hex should fail")
+	}
+}
@@ -0,0 +1,368 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oprf
+
+import (
+	"io"
+	"math/big"
+)
+
+// DLEQStatement is the statement that log_A(B) = log_Cᵢ(Dᵢ) for all i: B and the Dᵢ are the
+// multiples of A and the Cᵢ by the same scalar. With A the generator and B a public key, the
+// Dᵢ are e.g. decryption shares or VRF outputs of the inputs Cᵢ.
+type DLEQStatement struct {
+	A, B Element
+	C, D []Element
+}
+
+// DLEQProof is a batched Chaum–Pedersen proof of a DLEQStatement. The pairs (Cᵢ, Dᵢ) are
+// combined into a single pair (M, Z) as in the verifiable mode, so that the size of the proof
+// doesn't depend on their number.
+//
+// Unlike the proofs of BlindEvaluate, which hold the challenge, it holds the commitments
+// T2 = [r]A and T3 = [r]M of the prover, so that many proofs can be verified at once with
+// BatchVerifyDLEQ.
+type DLEQProof struct {
+	group  Group
+	T2, T3 Element
+	S      *big.Int
+}
+
+// Bytes returns the encoding of the proof, T2 || T3 || S
+func (p *DLEQProof) Bytes() []byte {
+	res := append(p.T2.Bytes(), p.T3.Bytes()...)
+	return append(res, p.group.SerializeScalar(p.S)...)
+}
+
+// NewDLEQProof decodes a proof
+func (s *Suite) NewDLEQProof(b []byte) (*DLEQProof, error) {
+	sizeElement := len(s.group.Generator().Bytes())
+	sizeScalar := len(s.group.SerializeScalar(new(big.Int)))
+	if len(b) != 2*sizeElement+sizeScalar {
+		return nil, ErrDeserialize
+	}
+	res := &DLEQProof{group: s.group}
+	var err error
+	if res.T2, err = s.group.DeserializeElement(b[:sizeElement]); err != nil {
+		return nil, err
+	}
+	if res.T3, err = s.group.DeserializeElement(b[sizeElement : 2*sizeElement]); err != nil {
+		return nil, err
+	}
+	if res.S, err = s.group.DeserializeScalar(b[2*sizeElement:]); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// check returns an error if the statement is nil, has nil elements or no pair (Cᵢ, Dᵢ)
+func (st *DLEQStatement) check() error {
+	if st == nil || st.A == nil || st.B == nil {
+		return ErrNilInput
+	}
+	if len(st.C) == 0 || len(st.C) != len(st.D) {
+		return ErrNbElements
+	}
+	for i := range st.C {
+		if st.C[i] == nil || st.D[i] == nil {
+			return ErrNilInput
+		}
+	}
+	return nil
+}
+
+// check returns an error if the proof is nil or incomplete
+func (p *DLEQProof) check() error {
+	if p == nil || p.T2 == nil || p.T3 == nil || p.S == nil {
+		return ErrNilInput
+	}
+	return nil
+}
+
+// ProveDLEQ proves statement, where k is the common discrete logarithm: B = [k]A and
+// Dᵢ = [k]Cᵢ for all i. statement must have at least one pair (Cᵢ, Dᵢ). The randomness is
+// read from r, or from random.Reader if r is nil.
+func (s *Suite) ProveDLEQ(k *big.Int, statement *DLEQStatement, r io.Reader) (*DLEQProof, error) {
+	if k == nil {
+		return nil, ErrNilInput
+	}
+	if err := statement.check(); err != nil {
+		return nil, err
+	}
+	rnd, err := s.randomScalar(r)
+	if err != nil {
+		return nil, err
+	}
+	M, _, err := s.computeComposites(statement.B, statement.C, statement.D, false)
+	if err != nil {
+		return nil, err
+	}
+	Z := M.ScalarMultiplication(k)
+
+	res := &DLEQProof{
+		group: s.group,
+		T2:    statement.A.ScalarMultiplication(rnd),
+		T3:    M.ScalarMultiplication(rnd),
+	}
+	c, err := s.dleqChallenge(statement.A, statement.B, M, Z, res.T2, res.T3)
+	if err != nil {
+		return nil, err
+	}
+
+	// s = r - c⋅k
+	res.S = new(big.Int).Mul(c, k)
+	res.S.Sub(rnd, res.S).Mod(res.S, s.group.Order())
+	return res, nil
+}
+
+// VerifyDLEQ verifies a proof of ProveDLEQ. It returns ErrVerify if the proof is invalid.
+func (s *Suite) VerifyDLEQ(statement *DLEQStatement, proof *DLEQProof) error {
+	if statement == nil {
+		return ErrNilInput
+	}
+	return s.BatchVerifyDLEQ([]DLEQStatement{*statement}, []*DLEQProof{proof}, nil)
+}
+
+// BatchVerifyDLEQ verifies the proofs of ProveDLEQ of independent statements at once. It returns
+// ErrVerify if one of them is invalid.
+//
+// The 2 equations of each proof, [S]A + [c]B = T2 and [S]M + [c]Z = T3, are combined with random
+// weights drawn from r into a single one, where the terms sharing the same element (e.g. A when it
+// is the generator, or B when the statements are about the same key) are merged. If r is nil, the
+// weights are drawn from random.Reader; with a single proof, there is nothing to randomize.
+func (s *Suite) BatchVerifyDLEQ(statements []DLEQStatement, proofs []*DLEQProof, r io.Reader) error {
+	if len(statements) != len(proofs) {
+		return ErrNbElements
+	}
+	for i := range statements {
+		if err := statements[i].check(); err != nil {
+			return err
+		}
+		if err := proofs[i].check(); err != nil {
+			return err
+		}
+	}
+	if len(statements) == 1 {
+		return s.verifyDLEQ(&statements[0], proofs[0])
+	}
+
+	order := s.group.Order()
+	var lc linearCombination
+	var w big.Int
+	for i := range statements {
+		st, proof := &statements[i], proofs[i]
+		M, Z, err := s.computeComposites(st.B, st.C, st.D, true)
+		if err != nil {
+			return err
+		}
+		c, err := s.dleqChallenge(st.A, st.B, M, Z, proof.T2, proof.T3)
+		if err != nil {
+			return err
+		}
+		rho, err := s.randomScalar(r)
+		if err != nil {
+			return err
+		}
+		sigma, err := s.randomScalar(r)
+		if err != nil {
+			return err
+		}
+
+		// ρ([S]A + [c]B - T2) + σ([S]M + [c]Z - T3)
+		lc.add(st.A, w.Mul(rho, proof.S), order)
+		lc.add(st.B, w.Mul(rho, c), order)
+		lc.add(proof.T2, w.Neg(rho), order)
+		lc.add(M, w.Mul(sigma, proof.S), order)
+		lc.add(Z, w.Mul(sigma, c), order)
+		lc.add(proof.T3, w.Neg(sigma), order)
+	}
+
+	if !lc.eval(s.group).IsIdentity() {
+		return ErrVerify
+	}
+	return nil
+}
+
+// verifyDLEQ verifies a single proof, without randomization. The statement and the proof
+// are checked by the caller.
+func (s *Suite) verifyDLEQ(statement *DLEQStatement, proof *DLEQProof) error {
+	M, Z, err := s.computeComposites(statement.B, statement.C, statement.D, true)
+	if err != nil {
+		return err
+	}
+	c, err := s.dleqChallenge(statement.A, statement.B, M, Z, proof.T2, proof.T3)
+	if err != nil {
+		return err
+	}
+	t2 := statement.A.ScalarMultiplication(proof.S).Add(statement.B.ScalarMultiplication(c))
+	t3 := M.ScalarMultiplication(proof.S).Add(Z.ScalarMultiplication(c))
+	if !t2.Equal(proof.T2) || !t3.Equal(proof.T3) {
+		return ErrVerify
+	}
+	return nil
+}
+
+// linearCombination is a sum of multiples of elements, where the coefficients of the
+// same element are merged
+type linearCombination struct {
+	index    map[string]int
+	elements []Element
+	coeffs   []*big.Int
+}
+
+// add adds [coeff]e to the combination
+func (lc *linearCombination) add(e Element, coeff *big.Int, order *big.Int) {
+	if lc.index == nil {
+		lc.index = make(map[string]int)
+	}
+	key := string(e.Bytes())
+	i, ok := lc.index[key]
+	if !ok {
+		i = len(lc.elements)
+		lc.index[key] = i
+		lc.elements = append(lc.elements, e)
+		lc.coeffs = append(lc.coeffs, new(big.Int))
+	}
+	lc.coeffs[i].Add(lc.coeffs[i], coeff).Mod(lc.coeffs[i], order)
+}
+
+// eval returns the sum of the combination
+func (lc *linearCombination) eval(group Group) Element {
+	res := group.Identity()
+	for i := range lc.elements {
+		if lc.coeffs[i].Sign() != 0 {
+			res = res.Add(lc.elements[i].ScalarMultiplication(lc.coeffs[i]))
+		}
+	}
+	return res
+}
+
+// generateProof proves that log_A(B) = log_Cᵢ(Dᵢ) = k for all i (DLEQ proof), with the
+// randomness rnd, see https://www.rfc-editor.org/rfc/rfc9497#section-2.2.1
+//
+// The proof is c || s, serialized scalars.
+func (s *Suite) generateProof(k *big.Int, A, B Element, C, D []Element, rnd *big.Int) ([]byte, error) {
+	M, _, err := s.computeComposites(B, C, D, false)
+	if err != nil {
+		return nil, err
+	}
+	Z := M.ScalarMultiplication(k)
+
+	t2 := A.ScalarMultiplication(rnd)
+	t3 := M.ScalarMultiplication(rnd)
+	c, err := s.challenge(B, M, Z, t2, t3)
+	if err != nil {
+		return nil, err
+	}
+
+	// s = r - c⋅k
+	var _s big.Int
+	_s.Mul(c, k).Sub(rnd, &_s).Mod(&_s, s.group.Order())
+
+	proof := s.group.SerializeScalar(c)
+	return append(proof, s.group.SerializeScalar(&_s)...), nil
+}
+
+// verifyProof verifies a proof of generateProof, see
+// https://www.rfc-editor.org/rfc/rfc9497#section-2.2.2
+func (s *Suite) verifyProof(A, B Element, C, D []Element, proof []byte) error {
+	if len(proof)%2 != 0 {
+		return ErrDeserialize
+	}
+	c, err := s.group.DeserializeScalar(proof[:len(proof)/2])
+	if err != nil {
+		return err
+	}
+	_s, err := s.group.DeserializeScalar(proof[len(proof)/2:])
+	if err != nil {
+		return err
+	}
+
+	M, Z, err := s.computeComposites(B, C, D, true)
+	if err != nil {
+		return err
+	}
+
+	t2 := A.ScalarMultiplication(_s).Add(B.ScalarMultiplication(c))
+	t3 := M.ScalarMultiplication(_s).Add(Z.ScalarMultiplication(c))
+	expectedC, err := s.challenge(B, M, Z, t2, t3)
+	if err != nil {
+		return err
+	}
+	if expectedC.Cmp(c) != 0 {
+		return ErrVerify
+	}
+	return nil
+}
+
+// computeComposites returns M = ∑ dᵢ⋅Cᵢ and, if computeZ is set, Z = ∑ dᵢ⋅Dᵢ, where the dᵢ are
+// derived from B, C and D, see https://www.rfc-editor.org/rfc/rfc9497#section-2.2.1
+func (s *Suite) computeComposites(B Element, C, D []Element, computeZ bool) (M, Z Element, err error) {
+	if len(C) != len(D) {
+		return nil, nil, ErrNbElements
+	}
+
+	// seed = Hash(I2OSP(len(Bm), 2) || Bm || I2OSP(len(seedDST), 2) || seedDST)
+	var buf []byte
+	buf = appendWithLength(buf, B.Bytes())
+	buf = appendWithLength(buf, append([]byte("Seed-"), s.contextString...))
+	h := s.group.NewHash()
+	h.Write(buf)
+	seed := h.Sum(nil)
+
+	M = s.group.Identity()
+	if computeZ {
+		Z = s.group.Identity()
+	}
+	for i := range C {
+		// h2Input = I2OSP(len(seed), 2) || seed || I2OSP(i, 2) ||
+		//           I2OSP(len(Ci), 2) || Ci || I2OSP(len(Di), 2) || Di || "Composite"
+		buf = appendWithLength(buf[:0], seed)
+		buf = append(buf, byte(i>>8), byte(i))
+		buf = appendWithLength(buf, C[i].Bytes())
+		buf = appendWithLength(buf, D[i].Bytes())
+		buf = append(buf, "Composite"...)
+		d, err := s.hashToScalar(buf)
+		if err != nil {
+			return nil, nil, err
+		}
+		M = M.Add(C[i].ScalarMultiplication(d))
+		if computeZ {
+			Z = Z.Add(D[i].ScalarMultiplication(d))
+		}
+	}
+	return M, Z, nil
+}
+
+// challenge returns the challenge of the DLEQ proof
+func (s *Suite) challenge(B, M, Z, t2, t3 Element) (*big.Int, error) {
+	var buf []byte
+	for _, e := range []Element{B, M, Z, t2, t3} {
+		buf = appendWithLength(buf, e.Bytes())
+	}
+	buf = append(buf, "Challenge"...)
+	return s.hashToScalar(buf)
+}
+
+// dleqChallenge returns the challenge of a DLEQProof. Unlike challenge, it binds the base A,
+// which is not the generator of the group in general.
+func (s *Suite) dleqChallenge(A, B, M, Z, t2, t3 Element) (*big.Int, error) {
+	var buf []byte
+	for _, e := range []Element{A, B, M, Z, t2, t3} {
+		buf = appendWithLength(buf, e.Bytes())
+	}
+	buf = append(buf, "DLEQChallenge"...)
+	return s.hashToScalar(buf)
+}
@@ -0,0 +1,44 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package oprf implements the oblivious pseudorandom functions of
+// https://www.rfc-editor.org/rfc/rfc9497: OPRF, verifiable OPRF (VOPRF) and partially-oblivious
+// verifiable OPRF (POPRF).
+//
+// A client learns F(sk, input) for a key sk held by a server, without the server learning
+// input nor the output. In the verifiable mode, the server also proves (with a DLEQ proof)
+// that it used the key matching its public key. In the partially-oblivious mode, the PRF has a
+// public input, the info, known to both the client and the server (see BlindWithInfo,
+// BlindEvaluateWithInfo and EvaluateWithInfo).
+//
+// The protocol runs on a prime-order Group; the ristretto255-SHA512 and P256-SHA256 suites are
+// provided by Ristretto255SHA512 and P256SHA256.
+//
+//	suite, _ := oprf.NewSuite(oprf.Ristretto255SHA512(), oprf.ModeVOPRF)
+//	sk, _ := suite.GenerateKey(rand.Reader)
+//	// client
+//	blinded, _ := suite.Blind([][]byte{input}, rand.Reader)
+//	// server, on blinded.Elements
+//	evaluation, _ := suite.BlindEvaluate(sk, blinded.Elements, rand.Reader)
+//	// client, on evaluation
+//	outputs, _ := suite.Finalize(&sk.PublicKey, blinded, evaluation)
+//
+// The DLEQ proofs are also available on their own (ProveDLEQ, BatchVerifyDLEQ), to prove that
+// elements share a discrete logarithm with a public key, e.g. for threshold decryption shares.
+//
+// # Warning
+//
+// This code has not been audited and is provided as-is. In particular, there is no security
+// guarantees such as constant time implementation or side-channel attack resistance.
+package oprf
@@ -0,0 +1,309 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oprf
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"hash"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fr"
+	"github.com/consensys/gnark-crypto/ecc/curve25519/ristretto255"
+	"github.com/consensys/gnark-crypto/ecc/secp256r1"
+	p256fr "github.com/consensys/gnark-crypto/ecc/secp256r1/fr"
+	fieldhash "github.com/consensys/gnark-crypto/field/hash"
+)
+
+// Group is a prime-order group with the hash functions of an OPRF suite,
+// see https://www.rfc-editor.org/rfc/rfc9497#section-2.1
+type Group interface {
+	// Identifier returns the identifier of the suite, e.g. "ristretto255-SHA512"
+	Identifier() string
+
+	// Order returns the order of the group
+	Order() *big.Int
+
+	// Identity returns the identity element
+	Identity() Element
+
+	// Generator returns the generator of the group
+	Generator() Element
+
+	// HashToGroup deterministically maps msg to an element of the group
+	HashToGroup(msg, dst []byte) (Element, error)
+
+	// HashToScalar deterministically maps msg to a scalar in [0, Order())
+	HashToScalar(msg, dst []byte) (*big.Int, error)
+
+	// NewHash returns the hash function of the suite
+	NewHash() hash.Hash
+
+	// DeserializeElement decodes an element, it returns an error if b doesn't encode an element
+	// or encodes the identity
+	DeserializeElement(b []byte) (Element, error)
+
+	// SerializeScalar encodes a scalar in [0, Order())
+	SerializeScalar(s *big.Int) []byte
+
+	// DeserializeScalar decodes a scalar, it returns an error if b is not the canonical encoding
+	// of a scalar
+	DeserializeScalar(b []byte) (*big.Int, error)
+}
+
+// Element is an element of a Group. Elements of different groups must not be mixed.
+type Element interface {
+	// Add returns e + e1
+	Add(e1 Element) Element
+
+	// ScalarMultiplication returns [s]e
+	ScalarMultiplication(s *big.Int) Element
+
+	// Equal returns true if e = e1
+	Equal(e1 Element) bool
+
+	// IsIdentity returns true if e is the identity element
+	IsIdentity() bool
+
+	// Bytes returns the canonical encoding of e
+	Bytes() []byte
+}
+
+// Ristretto255SHA512 returns the ristretto255 group of the ristretto255-SHA512 suite,
+// see https://www.rfc-editor.org/rfc/rfc9497#section-4.1
+func Ristretto255SHA512() Group {
+	return ristrettoGroup{}
+}
+
+type ristrettoGroup struct{}
+
+type ristrettoElement struct {
+	e ristretto255.Element
+}
+
+func (ristrettoGroup) Identifier() string {
+	return "ristretto255-SHA512"
+}
+
+func (ristrettoGroup) Order() *big.Int {
+	return fr.Modulus()
+}
+
+func (ristrettoGroup) Identity() Element {
+	var res ristrettoElement
+	res.e.SetIdentity()
+	return &res
+}
+
+func (ristrettoGroup) Generator() Element {
+	var res ristrettoElement
+	res.e.SetGenerator()
+	return &res
+}
+
+func (ristrettoGroup) HashToGroup(msg, dst []byte) (Element, error) {
+	e, err := ristretto255.HashToGroup(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+	return &ristrettoElement{e: e}, nil
+}
+
+func (ristrettoGroup) HashToScalar(msg, dst []byte) (*big.Int, error) {
+	uniform, err := fieldhash.ExpandMsgXmdWithHash(sha512.New, msg, dst, 64)
+	if err != nil {
+		return nil, err
+	}
+	// little-endian integer modulo the order
+	reverse(uniform)
+	s := new(big.Int).SetBytes(uniform)
+	return s.Mod(s, fr.Modulus()), nil
+}
+
+func (ristrettoGroup) NewHash() hash.Hash {
+	return sha512.New()
+}
+
+func (ristrettoGroup) DeserializeElement(b []byte) (Element, error) {
+	var res ristrettoElement
+	if len(b) != ristretto255.SizeOfElement {
+		return nil, ErrDeserialize
+	}
+	if _, err := res.e.SetBytes(b); err != nil || res.e.IsIdentity() {
+		return nil, ErrDeserialize
+	}
+	return &res, nil
+}
+
+func (ristrettoGroup) SerializeScalar(s *big.Int) []byte {
+	var e fr.Element
+	e.SetBigInt(s)
+	var b [fr.Bytes]byte
+	fr.LittleEndian.PutElement(&b, e)
+	return b[:]
+}
+
+func (ristrettoGroup) DeserializeScalar(b []byte) (*big.Int, error) {
+	if len(b) != fr.Bytes {
+		return nil, ErrDeserialize
+	}
+	e, err := fr.LittleEndian.Element((*[fr.Bytes]byte)(b))
+	if err != nil {
+		return nil, ErrDeserialize
+	}
+	return e.BigInt(new(big.Int)), nil
+}
+
+func (e *ristrettoElement) Add(e1 Element) Element {
+	var res ristrettoElement
+	res.e.Add(&e.e, &e1.(*ristrettoElement).e)
+	return &res
+}
+
+func (e *ristrettoElement) ScalarMultiplication(s *big.Int) Element {
+	var k fr.Element
+	k.SetBigInt(s)
+	var res ristrettoElement
+	res.e.ScalarMultiplication(&e.e, &k)
+	return &res
+}
+
+func (e *ristrettoElement) Equal(e1 Element) bool {
+	return e.e.Equal(&e1.(*ristrettoElement).e)
+}
+
+func (e *ristrettoElement) IsIdentity() bool {
+	return e.e.IsIdentity()
+}
+
+func (e *ristrettoElement) Bytes() []byte {
+	b := e.e.Bytes()
+	return b[:]
+}
+
+// P256SHA256 returns the P-256 (secp256r1) group of the P256-SHA256 suite,
+// see https://www.rfc-editor.org/rfc/rfc9497#section-4.3
+//
+// HashToGroup is hash_to_curve with the suite P256_XMD:SHA-256_SSWU_RO_ of RFC 9380, and the
+// elements are encoded compressed, as in SEC 1.
+func P256SHA256() Group {
+	return p256Group{}
+}
+
+type p256Group struct{}
+
+type p256Element struct {
+	e secp256r1.G1Affine
+}
+
+func (p256Group) Identifier() string {
+	return "P256-SHA256"
+}
+
+func (p256Group) Order() *big.Int {
+	return p256fr.Modulus()
+}
+
+func (p256Group) Identity() Element {
+	// the point at infinity is (0, 0)
+	return &p256Element{}
+}
+
+func (p256Group) Generator() Element {
+	_, g := secp256r1.Generators()
+	return &p256Element{e: g}
+}
+
+func (p256Group) HashToGroup(msg, dst []byte) (Element, error) {
+	e, err := secp256r1.HashToG1(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+	return &p256Element{e: e}, nil
+}
+
+func (p256Group) HashToScalar(msg, dst []byte) (*big.Int, error) {
+	// hash_to_field with L = 48
+	uniform, err := fieldhash.ExpandMsgXmdWithHash(sha256.New, msg, dst, 48)
+	if err != nil {
+		return nil, err
+	}
+	s := new(big.Int).SetBytes(uniform)
+	return s.Mod(s, p256fr.Modulus()), nil
+}
+
+func (p256Group) NewHash() hash.Hash {
+	return sha256.New()
+}
+
+func (p256Group) DeserializeElement(b []byte) (Element, error) {
+	var res p256Element
+	if len(b) != secp256r1.SizeOfG1AffineCompressed || b[0] == 0 {
+		return nil, ErrDeserialize
+	}
+	if _, err := res.e.SetBytes(b); err != nil {
+		return nil, ErrDeserialize
+	}
+	return &res, nil
+}
+
+func (p256Group) SerializeScalar(s *big.Int) []byte {
+	var e p256fr.Element
+	e.SetBigInt(s)
+	b := e.Bytes()
+	return b[:]
+}
+
+func (p256Group) DeserializeScalar(b []byte) (*big.Int, error) {
+	if len(b) != p256fr.Bytes {
+		return nil, ErrDeserialize
+	}
+	var e p256fr.Element
+	if err := e.SetBytesCanonical(b); err != nil {
+		return nil, ErrDeserialize
+	}
+	return e.BigInt(new(big.Int)), nil
+}
+
+func (e *p256Element) Add(e1 Element) Element {
+	var res p256Element
+	res.e.Add(&e.e, &e1.(*p256Element).e)
+	return &res
+}
+
+func (e *p256Element) ScalarMultiplication(s *big.Int) Element {
+	var res p256Element
+	res.e.ScalarMultiplication(&e.e, s)
+	return &res
+}
+
+func (e *p256Element) Equal(e1 Element) bool {
+	return e.e.Equal(&e1.(*p256Element).e)
+}
+
+func (e *p256Element) IsIdentity() bool {
+	return e.e.IsInfinity()
+}
+
+func (e *p256Element) Bytes() []byte {
+	b := e.e.Bytes()
+	return b[:]
+}
+
+func reverse(b []byte) {
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+}
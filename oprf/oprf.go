@@ -0,0 +1,507 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oprf
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/random"
+)
+
+// Mode is the mode of the protocol
+type Mode byte
+
+const (
+	// ModeOPRF is the base mode, see https://www.rfc-editor.org/rfc/rfc9497#section-3.3.1
+	ModeOPRF Mode = 0x00
+	// ModeVOPRF is the verifiable mode, where the server proves that the evaluations use the
+	// key of its public key, see https://www.rfc-editor.org/rfc/rfc9497#section-3.3.2
+	ModeVOPRF Mode = 0x01
+	// ModePOPRF is the partially-oblivious verifiable mode, where the client and the server
+	// agree on a public info, which is an input of the PRF, see
+	// https://www.rfc-editor.org/rfc/rfc9497#section-3.3.3
+	ModePOPRF Mode = 0x02
+)
+
+// errors returned by the protocol
+var (
+	ErrMode           = errors.New("unsupported mode")
+	ErrDeserialize    = errors.New("invalid encoding")
+	ErrInvalidInput   = errors.New("the input hashes to the identity element")
+	ErrDeriveKeyPair  = errors.New("key derivation failed")
+	ErrVerify         = errors.New("the proof is invalid")
+	ErrNbElements     = errors.New("the number of elements doesn't match")
+	ErrMissingProof   = errors.New("a proof is required in the verifiable mode")
+	ErrMissingKey     = errors.New("a public key is required in the verifiable mode")
+	ErrNilInput       = errors.New("nil statement, proof or element")
+	ErrInvalidInfo    = errors.New("the public info cancels the key")
+	errInfoTooLarge   = errors.New("the info is larger than 2¹⁶-1 bytes")
+	errInputTooLarge  = errors.New("the input is larger than 2¹⁶-1 bytes")
+	errZeroBlind      = errors.New("zero blind")
+	errNoBlindedInput = errors.New("no input to evaluate")
+)
+
+// Suite is an OPRF suite: a Group used in a given Mode.
+type Suite struct {
+	group         Group
+	mode          Mode
+	contextString []byte
+}
+
+// NewSuite returns the suite of group in the given mode
+func NewSuite(group Group, mode Mode) (*Suite, error) {
+	if mode != ModeOPRF && mode != ModeVOPRF && mode != ModePOPRF {
+		return nil, ErrMode
+	}
+	// contextString = "OPRFV1-" || I2OSP(mode, 1) || "-" || identifier
+	contextString := append([]byte("OPRFV1-"), byte(mode), '-')
+	contextString = append(contextString, group.Identifier()...)
+	return &Suite{group: group, mode: mode, contextString: contextString}, nil
+}
+
+// Group returns the group of the suite
+func (s *Suite) Group() Group {
+	return s.group
+}
+
+// Mode returns the mode of the suite
+func (s *Suite) Mode() Mode {
+	return s.mode
+}
+
+// PublicKey is the public key of a server, used by clients in the verifiable mode
+type PublicKey struct {
+	group   Group
+	element Element
+}
+
+// PrivateKey is the private key of a server
+type PrivateKey struct {
+	PublicKey
+	scalar *big.Int
+}
+
+// Bytes returns the encoding of the public key
+func (pk *PublicKey) Bytes() []byte {
+	return pk.element.Bytes()
+}
+
+// Bytes returns the encoding of the private key
+func (sk *PrivateKey) Bytes() []byte {
+	return sk.group.SerializeScalar(sk.scalar)
+}
+
+// NewPublicKey decodes a public key
+func (s *Suite) NewPublicKey(b []byte) (*PublicKey, error) {
+	e, err := s.group.DeserializeElement(b)
+	if err != nil {
+		return nil, err
+	}
+	return &PublicKey{group: s.group, element: e}, nil
+}
+
+// NewPrivateKey decodes a private key
+func (s *Suite) NewPrivateKey(b []byte) (*PrivateKey, error) {
+	k, err := s.group.DeserializeScalar(b)
+	if err != nil {
+		return nil, err
+	}
+	if k.Sign() == 0 {
+		return nil, ErrDeserialize
+	}
+	return s.newPrivateKey(k), nil
+}
+
+func (s *Suite) newPrivateKey(k *big.Int) *PrivateKey {
+	return &PrivateKey{
+		PublicKey: PublicKey{group: s.group, element: s.group.Generator().ScalarMultiplication(k)},
+		scalar:    k,
+	}
+}
+
+// GenerateKey returns a random private key
+func (s *Suite) GenerateKey(r io.Reader) (*PrivateKey, error) {
+	k, err := s.randomScalar(r)
+	if err != nil {
+		return nil, err
+	}
+	return s.newPrivateKey(k), nil
+}
+
+// DeriveKey deterministically derives a private key from a seed and a public info,
+// see https://www.rfc-editor.org/rfc/rfc9497#section-3.2.1
+func (s *Suite) DeriveKey(seed, info []byte) (*PrivateKey, error) {
+	if len(info) > 0xffff {
+		return nil, errInfoTooLarge
+	}
+	// deriveInput = seed || I2OSP(len(info), 2) || info
+	deriveInput := append([]byte{}, seed...)
+	deriveInput = appendWithLength(deriveInput, info)
+	dst := append([]byte("DeriveKeyPair"), s.contextString...)
+
+	for counter := 0; counter < 256; counter++ {
+		k, err := s.group.HashToScalar(append(deriveInput, byte(counter)), dst)
+		if err != nil {
+			return nil, err
+		}
+		if k.Sign() != 0 {
+			return s.newPrivateKey(k), nil
+		}
+	}
+	return nil, ErrDeriveKeyPair
+}
+
+// BlindedInputs is the state of a client between Blind and Finalize
+type BlindedInputs struct {
+	// Elements are the serialized blinded elements, sent to the server
+	Elements [][]byte
+
+	inputs   [][]byte
+	blinds   []*big.Int
+	elements []Element
+
+	// partially-oblivious mode
+	info       []byte
+	tweakedKey Element
+}
+
+// Evaluation is the response of the server to the blinded elements of a client
+type Evaluation struct {
+	// Elements are the serialized evaluated elements
+	Elements [][]byte
+
+	// Proof is the serialized DLEQ proof of the evaluations, in the verifiable modes
+	Proof []byte
+}
+
+// Blind blinds the inputs of a client, see https://www.rfc-editor.org/rfc/rfc9497#section-3.3.1
+//
+// The returned state is kept by the client to finalize the evaluation; its Elements are sent
+// to the server. In the partially-oblivious mode, use BlindWithInfo.
+func (s *Suite) Blind(inputs [][]byte, r io.Reader) (*BlindedInputs, error) {
+	if s.mode == ModePOPRF {
+		return nil, ErrMode
+	}
+	blinds, err := s.randomBlinds(len(inputs), r)
+	if err != nil {
+		return nil, err
+	}
+	return s.blind(inputs, blinds)
+}
+
+// BlindWithInfo blinds the inputs of a client in the partially-oblivious mode, for the public
+// info and the public key pk of the server, see https://www.rfc-editor.org/rfc/rfc9497#section-3.3.3
+func (s *Suite) BlindWithInfo(pk *PublicKey, inputs [][]byte, info []byte, r io.Reader) (*BlindedInputs, error) {
+	if s.mode != ModePOPRF {
+		return nil, ErrMode
+	}
+	if pk == nil {
+		return nil, ErrMissingKey
+	}
+	blinds, err := s.randomBlinds(len(inputs), r)
+	if err != nil {
+		return nil, err
+	}
+	return s.blindWithInfo(pk, inputs, info, blinds)
+}
+
+func (s *Suite) blindWithInfo(pk *PublicKey, inputs [][]byte, info []byte, blinds []*big.Int) (*BlindedInputs, error) {
+	m, err := s.infoScalar(info)
+	if err != nil {
+		return nil, err
+	}
+	// tweakedKey = [m]G + pk
+	tweakedKey := s.group.Generator().ScalarMultiplication(m).Add(pk.element)
+	if tweakedKey.IsIdentity() {
+		return nil, ErrInvalidInfo
+	}
+	res, err := s.blind(inputs, blinds)
+	if err != nil {
+		return nil, err
+	}
+	res.info = info
+	res.tweakedKey = tweakedKey
+	return res, nil
+}
+
+func (s *Suite) randomBlinds(n int, r io.Reader) ([]*big.Int, error) {
+	blinds := make([]*big.Int, n)
+	for i := range blinds {
+		var err error
+		if blinds[i], err = s.randomScalar(r); err != nil {
+			return nil, err
+		}
+	}
+	return blinds, nil
+}
+
+func (s *Suite) blind(inputs [][]byte, blinds []*big.Int) (*BlindedInputs, error) {
+	if len(inputs) == 0 {
+		return nil, errNoBlindedInput
+	}
+	res := &BlindedInputs{
+		Elements: make([][]byte, len(inputs)),
+		inputs:   inputs,
+		blinds:   blinds,
+		elements: make([]Element, len(inputs)),
+	}
+	for i := range inputs {
+		if blinds[i].Sign() == 0 {
+			return nil, errZeroBlind
+		}
+		inputElement, err := s.hashInput(inputs[i])
+		if err != nil {
+			return nil, err
+		}
+		res.elements[i] = inputElement.ScalarMultiplication(blinds[i])
+		res.Elements[i] = res.elements[i].Bytes()
+	}
+	return res, nil
+}
+
+// BlindEvaluate evaluates the blinded elements of a client with the private key sk, and in the
+// verifiable mode, proves that the evaluations use sk, see
+// https://www.rfc-editor.org/rfc/rfc9497#section-3.3.2
+//
+// In the partially-oblivious mode, use BlindEvaluateWithInfo.
+func (s *Suite) BlindEvaluate(sk *PrivateKey, blindedElements [][]byte, r io.Reader) (*Evaluation, error) {
+	if s.mode == ModePOPRF {
+		return nil, ErrMode
+	}
+	return s.blindEvaluate(sk.scalar, sk.scalar, sk.element, blindedElements, r)
+}
+
+// BlindEvaluateWithInfo evaluates the blinded elements of a client in the partially-oblivious
+// mode, with the private key sk and the public info, and proves that the evaluations use the
+// key tweaked by info, see https://www.rfc-editor.org/rfc/rfc9497#section-3.3.3
+func (s *Suite) BlindEvaluateWithInfo(sk *PrivateKey, blindedElements [][]byte, info []byte, r io.Reader) (*Evaluation, error) {
+	if s.mode != ModePOPRF {
+		return nil, ErrMode
+	}
+	t, tInv, err := s.tweakKey(sk, info)
+	if err != nil {
+		return nil, err
+	}
+	return s.blindEvaluate(t, tInv, s.group.Generator().ScalarMultiplication(t), blindedElements, r)
+}
+
+// blindEvaluate multiplies the blinded elements by e, and in the verifiable modes, proves that
+// they use the key k of the public key pk = [k]G: e = k in the verifiable mode, and e = 1/k in
+// the partially-oblivious mode.
+func (s *Suite) blindEvaluate(k, e *big.Int, pk Element, blindedElements [][]byte, r io.Reader) (*Evaluation, error) {
+	if len(blindedElements) == 0 {
+		return nil, errNoBlindedInput
+	}
+	blinded := make([]Element, len(blindedElements))
+	evaluated := make([]Element, len(blindedElements))
+	res := &Evaluation{Elements: make([][]byte, len(blindedElements))}
+	for i := range blindedElements {
+		var err error
+		if blinded[i], err = s.group.DeserializeElement(blindedElements[i]); err != nil {
+			return nil, err
+		}
+		evaluated[i] = blinded[i].ScalarMultiplication(e)
+		res.Elements[i] = evaluated[i].Bytes()
+	}
+	if s.mode == ModeOPRF {
+		return res, nil
+	}
+
+	rnd, err := s.randomScalar(r)
+	if err != nil {
+		return nil, err
+	}
+	C, D := blinded, evaluated
+	if s.mode == ModePOPRF {
+		// blinded = [k]evaluated
+		C, D = evaluated, blinded
+	}
+	if res.Proof, err = s.generateProof(k, s.group.Generator(), pk, C, D, rnd); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Finalize unblinds the evaluations of the server and returns the outputs of the PRF on the
+// inputs of the client. In the verifiable mode, it first checks the proof of the server
+// against its public key pk. pk is ignored in the base mode, and in the partially-oblivious
+// mode, where the proof is checked against the key given to BlindWithInfo.
+// See https://www.rfc-editor.org/rfc/rfc9497#section-3.3.1
+func (s *Suite) Finalize(pk *PublicKey, blinded *BlindedInputs, evaluation *Evaluation) ([][]byte, error) {
+	if len(evaluation.Elements) != len(blinded.inputs) {
+		return nil, ErrNbElements
+	}
+	evaluated := make([]Element, len(evaluation.Elements))
+	for i := range evaluated {
+		var err error
+		if evaluated[i], err = s.group.DeserializeElement(evaluation.Elements[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	switch s.mode {
+	case ModeVOPRF:
+		if pk == nil {
+			return nil, ErrMissingKey
+		}
+		if evaluation.Proof == nil {
+			return nil, ErrMissingProof
+		}
+		if err := s.verifyProof(s.group.Generator(), pk.element, blinded.elements, evaluated, evaluation.Proof); err != nil {
+			return nil, err
+		}
+	case ModePOPRF:
+		if blinded.tweakedKey == nil {
+			return nil, ErrMissingKey
+		}
+		if evaluation.Proof == nil {
+			return nil, ErrMissingProof
+		}
+		if err := s.verifyProof(s.group.Generator(), blinded.tweakedKey, evaluated, blinded.elements, evaluation.Proof); err != nil {
+			return nil, err
+		}
+	}
+
+	order := s.group.Order()
+	outputs := make([][]byte, len(evaluated))
+	for i := range evaluated {
+		var inv big.Int
+		inv.ModInverse(blinded.blinds[i], order)
+		n := evaluated[i].ScalarMultiplication(&inv)
+		outputs[i] = s.finalizeHash(blinded.inputs[i], blinded.info, n.Bytes())
+	}
+	return outputs, nil
+}
+
+// Evaluate returns the output of the PRF on input with the private key sk, without the
+// interaction with a client; the output matches the one of Finalize.
+// See https://www.rfc-editor.org/rfc/rfc9497#section-3.3.1
+//
+// In the partially-oblivious mode, use EvaluateWithInfo.
+func (s *Suite) Evaluate(sk *PrivateKey, input []byte) ([]byte, error) {
+	if s.mode == ModePOPRF {
+		return nil, ErrMode
+	}
+	inputElement, err := s.hashInput(input)
+	if err != nil {
+		return nil, err
+	}
+	evaluated := inputElement.ScalarMultiplication(sk.scalar)
+	return s.finalizeHash(input, nil, evaluated.Bytes()), nil
+}
+
+// EvaluateWithInfo returns the output of the PRF on input and the public info with the private
+// key sk, in the partially-oblivious mode; the output matches the one of Finalize.
+// See https://www.rfc-editor.org/rfc/rfc9497#section-3.3.3
+func (s *Suite) EvaluateWithInfo(sk *PrivateKey, input, info []byte) ([]byte, error) {
+	if s.mode != ModePOPRF {
+		return nil, ErrMode
+	}
+	_, tInv, err := s.tweakKey(sk, info)
+	if err != nil {
+		return nil, err
+	}
+	inputElement, err := s.hashInput(input)
+	if err != nil {
+		return nil, err
+	}
+	evaluated := inputElement.ScalarMultiplication(tInv)
+	return s.finalizeHash(input, info, evaluated.Bytes()), nil
+}
+
+// infoScalar returns the scalar m = HashToScalar("Info" || I2OSP(len(info), 2) || info) of the
+// partially-oblivious mode
+func (s *Suite) infoScalar(info []byte) (*big.Int, error) {
+	if len(info) > 0xffff {
+		return nil, errInfoTooLarge
+	}
+	return s.hashToScalar(appendWithLength([]byte("Info"), info))
+}
+
+// tweakKey returns the key t = sk + m tweaked by the public info, and its inverse
+func (s *Suite) tweakKey(sk *PrivateKey, info []byte) (t, tInv *big.Int, err error) {
+	m, err := s.infoScalar(info)
+	if err != nil {
+		return nil, nil, err
+	}
+	order := s.group.Order()
+	t = new(big.Int).Add(sk.scalar, m)
+	t.Mod(t, order)
+	if t.Sign() == 0 {
+		return nil, nil, ErrInvalidInfo
+	}
+	return t, new(big.Int).ModInverse(t, order), nil
+}
+
+// hashInput maps an input to the group, and rejects the identity element
+func (s *Suite) hashInput(input []byte) (Element, error) {
+	if len(input) > 0xffff {
+		return nil, errInputTooLarge
+	}
+	e, err := s.group.HashToGroup(input, append([]byte("HashToGroup-"), s.contextString...))
+	if err != nil {
+		return nil, err
+	}
+	if e.IsIdentity() {
+		return nil, ErrInvalidInput
+	}
+	return e, nil
+}
+
+// finalizeHash returns Hash(I2OSP(len(input), 2) || input ||
+// I2OSP(len(unblindedElement), 2) || unblindedElement || "Finalize"), where the public info,
+// I2OSP(len(info), 2) || info, follows the input in the partially-oblivious mode
+func (s *Suite) finalizeHash(input, info, unblindedElement []byte) []byte {
+	var buf []byte
+	buf = appendWithLength(buf, input)
+	if s.mode == ModePOPRF {
+		buf = appendWithLength(buf, info)
+	}
+	buf = appendWithLength(buf, unblindedElement)
+	buf = append(buf, "Finalize"...)
+	h := s.group.NewHash()
+	h.Write(buf)
+	return h.Sum(nil)
+}
+
+// hashToScalar maps msg to a scalar with the domain separation tag of the suite
+func (s *Suite) hashToScalar(msg []byte) (*big.Int, error) {
+	return s.group.HashToScalar(msg, append([]byte("HashToScalar-"), s.contextString...))
+}
+
+// randomScalar returns a uniformly random scalar in [1, order), read from r or from
+// random.Reader if r is nil
+func (s *Suite) randomScalar(r io.Reader) (*big.Int, error) {
+	if r == nil {
+		r = random.Reader()
+	}
+	var max big.Int
+	max.Sub(s.group.Order(), big.NewInt(1))
+	k, err := rand.Int(r, &max)
+	if err != nil {
+		return nil, err
+	}
+	return k.Add(k, big.NewInt(1)), nil
+}
+
+// appendWithLength appends I2OSP(len(b), 2) || b to buf
+func appendWithLength(buf, b []byte) []byte {
+	buf = binary.BigEndian.AppendUint16(buf, uint16(len(b)))
+	return append(buf, b...)
+}
@@ -0,0 +1,656 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oprf
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"math/big"
+	"testing"
+)
+
+type oprfTestVector struct {
+	input, blind, blindedElement, evaluationElement, proof, proofRandomScalar, output string
+}
+
+type suiteTestVectors struct {
+	mode    Mode
+	skSm    string
+	pkSm    string
+	vectors []oprfTestVector
+}
+
+// the public info of the test vectors in the partially-oblivious mode
+const testVectorsInfo = "test info"
+
+// test vectors of https://www.rfc-editor.org/rfc/rfc9497#appendix-A.1
+var ristretto255TestVectors = []suiteTestVectors{
+	{
+		mode: ModeOPRF,
+		skSm: "5ebcea5ee37023ccb9fc2d2019f9d7737be85591ae8652ffa9ef0f4d37063b0e",
+		vectors: []oprfTestVector{
+			{
+				input:             "00",
+				blind:             "64d37aed22a27f5191de1c1d69fadb899d8862b58eb4220029e036ec4c1f6706",
+				blindedElement:    "609a0ae68c15a3cf6903766461307e5c8bb2f95e7e6550e1ffa2dc99e412803c",
+				evaluationElement: "7ec6578ae5120958eb2db1745758ff379e77cb64fe77b0b2d8cc917ea0869c7e",
+				output:            "527759c3d9366f277d8c6020418d96bb393ba2afb20ff90df23fb7708264e2f3ab9135e3bd69955851de4b1f9fe8a0973396719b7912ba9ee8aa7d0b5e24bcf6",
+			},
+			{
+				input:             "5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a",
+				blind:             "64d37aed22a27f5191de1c1d69fadb899d8862b58eb4220029e036ec4c1f6706",
+				blindedElement:    "da27ef466870f5f15296299850aa088629945a17d1f5b7f5ff043f76b3c06418",
+				evaluationElement: "b4cbf5a4f1eeda5a63ce7b77c7d23f461db3fcab0dd28e4e17cecb5c90d02c25",
+				output:            "f4a74c9c592497375e796aa837e907b1a045d34306a749db9f34221f7e750cb4f2a6413a6bf6fa5e19ba6348eb673934a722a7ede2e7621306d18951e7cf2c73",
+			},
+		},
+	},
+	{
+		mode: ModeVOPRF,
+		skSm: "e6f73f344b79b379f1a0dd37e07ff62e38d9f71345ce62ae3a9bc60b04ccd909",
+		pkSm: "c803e2cc6b05fc15064549b5920659ca4a77b2cca6f04f6b357009335476ad4e",
+		vectors: []oprfTestVector{
+			{
+				input:             "00",
+				blind:             "64d37aed22a27f5191de1c1d69fadb899d8862b58eb4220029e036ec4c1f6706",
+				blindedElement:    "863f330cc1a1259ed5a5998a23acfd37fb4351a793a5b3c090b642ddc439b945",
+				evaluationElement: "aa8fa048764d5623868679402ff6108d2521884fa138cd7f9c7669a9a014267e",
+				proof:             "ddef93772692e535d1a53903db24367355cc2cc78de93b3be5a8ffcc6985dd066d4346421d17bf5117a2a1ff0fcb2a759f58a539dfbe857a40bce4cf49ec600d",
+				proofRandomScalar: "222a5e897cf59db8145db8d16e597e8facb80ae7d4e26d9881aa6f61d645fc0e",
+				output:            "b58cfbe118e0cb94d79b5fd6a6dafb98764dff49c14e1770b566e42402da1a7da4d8527693914139caee5bd03903af43a491351d23b430948dd50cde10d32b3c",
+			},
+		},
+	},
+	{
+		mode: ModePOPRF,
+		skSm: "145c79c108538421ac164ecbe131942136d5570b16d8bf41a24d4337da981e07",
+		pkSm: "c647bef38497bc6ec077c22af65b696efa43bff3b4a1975a3e8e0a1c5a79d631",
+		vectors: []oprfTestVector{
+			{
+				input:             "00",
+				blind:             "64d37aed22a27f5191de1c1d69fadb899d8862b58eb4220029e036ec4c1f6706",
+				blindedElement:    "c8713aa89241d6989ac142f22dba30596db635c772cbf25021fdd8f3d461f715",
+				evaluationElement: "1a4b860d808ff19624731e67b5eff20ceb2df3c3c03b906f5693e2078450d874",
+				proof:             "41ad1a291aa02c80b0915fbfbb0c0afa15a57e2970067a602ddb9e8fd6b7100de32e1ecff943a36f0b10e3dae6bd266cdeb8adf825d86ef27dbc6c0e30c52206",
+				proofRandomScalar: "222a5e897cf59db8145db8d16e597e8facb80ae7d4e26d9881aa6f61d645fc0e",
+				output:            "ca688351e88afb1d841fde4401c79efebb2eb75e7998fa9737bd5a82a152406d38bd29f680504e54fd4587eddcf2f37a2617ac2fbd2993f7bdf45442ace7d221",
+			},
+		},
+	},
+}
+
+// test vectors of https://www.rfc-editor.org/rfc/rfc9497#appendix-A.3
+var p256TestVectors = []suiteTestVectors{
+	{
+		mode: ModeOPRF,
+		skSm: "159749d750713afe245d2d39ccfaae8381c53ce92d098a9375ee70739c7ac0bf",
+		vectors: []oprfTestVector{
+			{
+				input:             "00",
+				blind:             "3338fa65ec36e0290022b48eb562889d89dbfa691d1cde91517fa222ed7ad364",
+				blindedElement:    "03723a1e5c09b8b9c18d1dcbca29e8007e95f14f4732d9346d490ffc195110368d",
+				evaluationElement: "030de02ffec47a1fd53efcdd1c6faf5bdc270912b8749e783c7ca75bb412958832",
+				output:            "a0b34de5fa4c5b6da07e72af73cc507cceeb48981b97b7285fc375345fe495dd",
+			},
+			{
+				input:             "5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a",
+				blind:             "3338fa65ec36e0290022b48eb562889d89dbfa691d1cde91517fa222ed7ad364",
+				blindedElement:    "03cc1df781f1c2240a64d1c297b3f3d16262ef5d4cf102734882675c26231b0838",
+				evaluationElement: "03a0395fe3828f2476ffcd1f4fe540e5a8489322d398be3c4e5a869db7fcb7c52c",
+				output:            "c748ca6dd327f0ce85f4ae3a8cd6d4d5390bbb804c9e12dcf94f853fece3dcce",
+			},
+		},
+	},
+	{
+		mode: ModeVOPRF,
+		skSm: "ca5d94c8807817669a51b196c34c1b7f8442fde4334a7121ae4736364312fca6",
+		pkSm: "03e17e70604bcabe198882c0a1f27a92441e774224ed9c702e51dd17038b102462",
+		vectors: []oprfTestVector{
+			{
+				input:             "00",
+				blind:             "3338fa65ec36e0290022b48eb562889d89dbfa691d1cde91517fa222ed7ad364",
+				blindedElement:    "02dd05901038bb31a6fae01828fd8d0e49e35a486b5c5d4b4994013648c01277da",
+				evaluationElement: "0209f33cab60cf8fe69239b0afbcfcd261af4c1c5632624f2e9ba29b90ae83e4a2",
+				proof:             "e7c2b3c5c954c035949f1f74e6bce2ed539a3be267d1481e9ddb178533df4c2664f69d065c604a4fd953e100b856ad83804eb3845189babfa5a702090d6fc5fa",
+				proofRandomScalar: "f9db001266677f62c095021db018cd8cbb55941d4073698ce45c405d1348b7b1",
+				output:            "0412e8f78b02c415ab3a288e228978376f99927767ff37c5718d420010a645a1",
+			},
+			{
+				input:             "5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a",
+				blind:             "3338fa65ec36e0290022b48eb562889d89dbfa691d1cde91517fa222ed7ad364",
+				blindedElement:    "03cd0f033e791c4d79dfa9c6ed750f2ac009ec46cd4195ca6fd3800d1e9b887dbd",
+				evaluationElement: "030d2985865c693bf7af47ba4d3a3813176576383d19aff003ef7b0784a0d83cf1",
+				proof:             "2787d729c57e3d9512d3aa9e8708ad226bc48e0f1750b0767aaff73482c44b8d2873d74ec88aebd3504961acea16790a05c542d9fbff4fe269a77510db00abab",
+				proofRandomScalar: "f9db001266677f62c095021db018cd8cbb55941d4073698ce45c405d1348b7b1",
+				output:            "771e10dcd6bcd3664e23b8f2a710cfaaa8357747c4a8cbba03133967b5c24f18",
+			},
+		},
+	},
+	{
+		mode: ModePOPRF,
+		skSm: "6ad2173efa689ef2c27772566ad7ff6e2d59b3b196f00219451fb2c89ee4dae2",
+		pkSm: "030d7ff077fddeec965db14b794f0cc1ba9019b04a2f4fcc1fa525dedf72e2a3e3",
+		vectors: []oprfTestVector{
+			{
+				input:             "00",
+				blind:             "3338fa65ec36e0290022b48eb562889d89dbfa691d1cde91517fa222ed7ad364",
+				blindedElement:    "031563e127099a8f61ed51eeede05d747a8da2be329b40ba1f0db0b2bd9dd4e2c0",
+				evaluationElement: "02c5e5300c2d9e6ba7f3f4ad60500ad93a0157e6288eb04b67e125db024a2c74d2",
+				proof:             "f8a33690b87736c854eadfcaab58a59b8d9c03b569110b6f31f8bf7577f3fbb85a8a0c38468ccde1ba942be501654adb106167c8eb178703ccb42bccffb9231a",
+				proofRandomScalar: "f9db001266677f62c095021db018cd8cbb55941d4073698ce45c405d1348b7b1",
+				output:            "193a92520bd8fd1f37accb918040a57108daa110dc4f659abe212636d245c592",
+			},
+			{
+				input:             "5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a",
+				blind:             "3338fa65ec36e0290022b48eb562889d89dbfa691d1cde91517fa222ed7ad364",
+				blindedElement:    "021a440ace8ca667f261c10ac7686adc66a12be31e3520fca317643a1eee9dcd4d",
+				evaluationElement: "0208ca109cbae44f4774fc0bdd2783efdcb868cb4523d52196f700210e777c5de3",
+				proof:             "043a8fb7fc7fd31e35770cabda4753c5bf0ecc1e88c68d7d35a62bf2631e875af4613641be2d1875c31d1319d191c4bbc0d04875f4fd03c31d3d17dd8e069b69",
+				proofRandomScalar: "f9db001266677f62c095021db018cd8cbb55941d4073698ce45c405d1348b7b1",
+				output:            "1e6d164cfd835d88a31401623549bf6b9b306628ef03a7962921d62bc5ffce8c",
+			},
+		},
+	},
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func testVectors(t *testing.T, group Group, testVectors []suiteTestVectors) {
+	seed := bytes.Repeat([]byte{0xa3}, 32)
+	info := []byte("test key")
+
+	for _, tv := range testVectors {
+		suite, err := NewSuite(group, tv.mode)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sk, err := suite.DeriveKey(seed, info)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(sk.Bytes()) != tv.skSm {
+			t.Fatalf("mode %d: wrong derived key %x", tv.mode, sk.Bytes())
+		}
+		if tv.pkSm != "" && hex.EncodeToString(sk.PublicKey.Bytes()) != tv.pkSm {
+			t.Fatalf("mode %d: wrong public key %x", tv.mode, sk.PublicKey.Bytes())
+		}
+
+		for _, v := range tv.vectors {
+			input := mustDecodeHex(t, v.input)
+			blind, err := group.DeserializeScalar(mustDecodeHex(t, v.blind))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var blinded *BlindedInputs
+			if tv.mode == ModePOPRF {
+				blinded, err = suite.blindWithInfo(&sk.PublicKey, [][]byte{input}, []byte(testVectorsInfo), []*big.Int{blind})
+			} else {
+				blinded, err = suite.blind([][]byte{input}, []*big.Int{blind})
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hex.EncodeToString(blinded.Elements[0]) != v.blindedElement {
+				t.Fatalf("mode %d: wrong blinded element %x", tv.mode, blinded.Elements[0])
+			}
+
+			var evaluation *Evaluation
+			if tv.mode == ModePOPRF {
+				evaluation, err = suite.BlindEvaluateWithInfo(sk, blinded.Elements, []byte(testVectorsInfo), rand.Reader)
+			} else {
+				evaluation, err = suite.BlindEvaluate(sk, blinded.Elements, rand.Reader)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hex.EncodeToString(evaluation.Elements[0]) != v.evaluationElement {
+				t.Fatalf("mode %d: wrong evaluated element %x", tv.mode, evaluation.Elements[0])
+			}
+
+			if tv.mode != ModeOPRF {
+				// deterministic proof with the randomness of the test vector
+				rnd, err := group.DeserializeScalar(mustDecodeHex(t, v.proofRandomScalar))
+				if err != nil {
+					t.Fatal(err)
+				}
+				evaluated, err := group.DeserializeElement(evaluation.Elements[0])
+				if err != nil {
+					t.Fatal(err)
+				}
+				k, B, C, D := sk.scalar, sk.element, blinded.elements, []Element{evaluated}
+				if tv.mode == ModePOPRF {
+					if k, _, err = suite.tweakKey(sk, []byte(testVectorsInfo)); err != nil {
+						t.Fatal(err)
+					}
+					B, C, D = group.Generator().ScalarMultiplication(k), D, C
+				}
+				proof, err := suite.generateProof(k, group.Generator(), B, C, D, rnd)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if hex.EncodeToString(proof) != v.proof {
+					t.Fatalf("mode %d: wrong proof %x", tv.mode, proof)
+				}
+			}
+
+			outputs, err := suite.Finalize(&sk.PublicKey, blinded, evaluation)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hex.EncodeToString(outputs[0]) != v.output {
+				t.Fatalf("mode %d: wrong output %x", tv.mode, outputs[0])
+			}
+			var output []byte
+			if tv.mode == ModePOPRF {
+				output, err = suite.EvaluateWithInfo(sk, input, []byte(testVectorsInfo))
+			} else {
+				output, err = suite.Evaluate(sk, input)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(output, outputs[0]) {
+				t.Fatalf("mode %d: Evaluate doesn't match Finalize", tv.mode)
+			}
+		}
+	}
+}
+
+func TestRistretto255TestVectors(t *testing.T) {
+	testVectors(t, Ristretto255SHA512(), ristretto255TestVectors)
+}
+
+func TestP256TestVectors(t *testing.T) {
+	testVectors(t, P256SHA256(), p256TestVectors)
+}
+
+func TestVOPRF(t *testing.T) {
+	t.Parallel()
+
+	for _, group := range []Group{Ristretto255SHA512(), P256SHA256()} {
+		suite, err := NewSuite(group, ModeVOPRF)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sk, err := suite.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inputs := [][]byte{[]byte("alice"), []byte("bob"), {}}
+		blinded, err := suite.Blind(inputs, rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		evaluation, err := suite.BlindEvaluate(sk, blinded.Elements, rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		outputs, err := suite.Finalize(&sk.PublicKey, blinded, evaluation)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range inputs {
+			expected, err := suite.Evaluate(sk, inputs[i])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(outputs[i], expected) {
+				t.Fatalf("%s: batched Finalize doesn't match Evaluate", group.Identifier())
+			}
+		}
+
+		// the proof doesn't verify with another key
+		other, err := suite.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := suite.Finalize(&other.PublicKey, blinded, evaluation); err != ErrVerify {
+			t.Fatalf("%s: expected ErrVerify with the wrong key, got %v", group.Identifier(), err)
+		}
+
+		// nor with evaluations of another key
+		forged, err := suite.BlindEvaluate(other, blinded.Elements, rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		forged.Proof = evaluation.Proof
+		if _, err := suite.Finalize(&sk.PublicKey, blinded, forged); err != ErrVerify {
+			t.Fatalf("%s: expected ErrVerify with forged evaluations, got %v", group.Identifier(), err)
+		}
+
+		evaluation.Proof = nil
+		if _, err := suite.Finalize(&sk.PublicKey, blinded, evaluation); err != ErrMissingProof {
+			t.Fatalf("%s: expected ErrMissingProof, got %v", group.Identifier(), err)
+		}
+		evaluation.Elements = evaluation.Elements[1:]
+		if _, err := suite.Finalize(&sk.PublicKey, blinded, evaluation); err != ErrNbElements {
+			t.Fatalf("%s: expected ErrNbElements, got %v", group.Identifier(), err)
+		}
+
+		// keys serialization
+		_sk, err := suite.NewPrivateKey(sk.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		pk, err := suite.NewPublicKey(sk.PublicKey.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !_sk.element.Equal(sk.element) || !pk.element.Equal(sk.element) {
+			t.Fatalf("%s: keys serialization round trip failed", group.Identifier())
+		}
+	}
+}
+
+func TestPOPRF(t *testing.T) {
+	t.Parallel()
+
+	for _, group := range []Group{Ristretto255SHA512(), P256SHA256()} {
+		suite, err := NewSuite(group, ModePOPRF)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sk, err := suite.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inputs := [][]byte{[]byte("alice"), []byte("bob")}
+		info := []byte("epoch 42")
+		blinded, err := suite.BlindWithInfo(&sk.PublicKey, inputs, info, rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		evaluation, err := suite.BlindEvaluateWithInfo(sk, blinded.Elements, info, rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		outputs, err := suite.Finalize(nil, blinded, evaluation)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range inputs {
+			expected, err := suite.EvaluateWithInfo(sk, inputs[i], info)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(outputs[i], expected) {
+				t.Fatalf("%s: batched Finalize doesn't match EvaluateWithInfo", group.Identifier())
+			}
+		}
+
+		// the info is an input of the PRF
+		other, err := suite.EvaluateWithInfo(sk, inputs[0], []byte("epoch 43"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bytes.Equal(other, outputs[0]) {
+			t.Fatalf("%s: the output doesn't depend on the info", group.Identifier())
+		}
+
+		// the proof doesn't verify if the server evaluates with another info
+		forged, err := suite.BlindEvaluateWithInfo(sk, blinded.Elements, []byte("epoch 43"), rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := suite.Finalize(nil, blinded, forged); err != ErrVerify {
+			t.Fatalf("%s: expected ErrVerify with another info, got %v", group.Identifier(), err)
+		}
+
+		// the methods without info are for the other modes, and conversely
+		if _, err := suite.Blind(inputs, rand.Reader); err != ErrMode {
+			t.Fatalf("%s: expected ErrMode, got %v", group.Identifier(), err)
+		}
+		if _, err := suite.BlindEvaluate(sk, blinded.Elements, rand.Reader); err != ErrMode {
+			t.Fatalf("%s: expected ErrMode, got %v", group.Identifier(), err)
+		}
+		if _, err := suite.Evaluate(sk, inputs[0]); err != ErrMode {
+			t.Fatalf("%s: expected ErrMode, got %v", group.Identifier(), err)
+		}
+		voprf, err := NewSuite(group, ModeVOPRF)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := voprf.BlindWithInfo(&sk.PublicKey, inputs, info, rand.Reader); err != ErrMode {
+			t.Fatalf("%s: expected ErrMode, got %v", group.Identifier(), err)
+		}
+		if _, err := suite.BlindWithInfo(nil, inputs, info, rand.Reader); err != ErrMissingKey {
+			t.Fatalf("%s: expected ErrMissingKey, got %v", group.Identifier(), err)
+		}
+	}
+}
+
+func TestInvalidInputs(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewSuite(Ristretto255SHA512(), Mode(3)); err != ErrMode {
+		t.Fatal("expected ErrMode, got", err)
+	}
+	for _, group := range []Group{Ristretto255SHA512(), P256SHA256()} {
+		suite, err := NewSuite(group, ModeOPRF)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sk, err := suite.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the identity and invalid encodings are rejected by the server
+		identity := suite.Group().Identity().Bytes()
+		if _, err := suite.BlindEvaluate(sk, [][]byte{identity}, rand.Reader); err != ErrDeserialize {
+			t.Fatalf("%s: expected ErrDeserialize, got %v", group.Identifier(), err)
+		}
+		if _, err := suite.BlindEvaluate(sk, [][]byte{{1, 2, 3}}, rand.Reader); err != ErrDeserialize {
+			t.Fatalf("%s: expected ErrDeserialize, got %v", group.Identifier(), err)
+		}
+		if _, err := suite.NewPrivateKey(make([]byte, 32)); err != ErrDeserialize {
+			t.Fatalf("%s: expected ErrDeserialize for a zero key, got %v", group.Identifier(), err)
+		}
+		order := group.Order().Bytes()
+		if _, err := suite.NewPrivateKey(order); err != ErrDeserialize {
+			t.Fatalf("%s: expected ErrDeserialize for a non canonical key, got %v", group.Identifier(), err)
+		}
+	}
+}
+
+func TestDLEQ(t *testing.T) {
+	t.Parallel()
+
+	suite, err := NewSuite(Ristretto255SHA512(), ModeVOPRF)
+	if err != nil {
+		t.Fatal(err)
+	}
+	group := suite.Group()
+
+	// statements about several keys, with the generator or a random base
+	newStatement := func(k *big.Int, A Element, nbPairs int) DLEQStatement {
+		st := DLEQStatement{A: A, B: A.ScalarMultiplication(k)}
+		for i := 0; i < nbPairs; i++ {
+			C, err := suite.hashInput([]byte{byte(i), byte(nbPairs)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			st.C = append(st.C, C)
+			st.D = append(st.D, C.ScalarMultiplication(k))
+		}
+		return st
+	}
+	var statements []DLEQStatement
+	var proofs []*DLEQProof
+	base, err := suite.hashInput([]byte("base"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 5; i++ {
+		k, err := suite.randomScalar(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		A := group.Generator()
+		if i == 3 {
+			A = base
+		}
+		st := newStatement(k, A, i+1)
+		proof, err := suite.ProveDLEQ(k, &st, rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := suite.VerifyDLEQ(&st, proof); err != nil {
+			t.Fatal(err)
+		}
+
+		// serialization round trip
+		_proof, err := suite.NewDLEQProof(proof.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := suite.VerifyDLEQ(&st, _proof); err != nil {
+			t.Fatal(err)
+		}
+		statements = append(statements, st)
+		proofs = append(proofs, proof)
+	}
+	if err := suite.BatchVerifyDLEQ(statements, proofs, rand.Reader); err != nil {
+		t.Fatal(err)
+	}
+
+	// a pair with another discrete log
+	k, err := suite.randomScalar(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bad := newStatement(k, group.Generator(), 3)
+	bad.D[1] = bad.D[1].Add(group.Generator())
+	proof, err := suite.ProveDLEQ(k, &bad, rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := suite.VerifyDLEQ(&bad, proof); err != ErrVerify {
+		t.Fatal("expected ErrVerify for a false statement, got", err)
+	}
+	if err := suite.BatchVerifyDLEQ(append(statements, bad), append(proofs, proof), rand.Reader); err != ErrVerify {
+		t.Fatal("expected ErrVerify for a batch with a false statement, got", err)
+	}
+
+	// a proof of another statement
+	if err := suite.BatchVerifyDLEQ(statements[:2], []*DLEQProof{proofs[1], proofs[0]}, rand.Reader); err != ErrVerify {
+		t.Fatal("expected ErrVerify for swapped proofs, got", err)
+	}
+
+	if err := suite.BatchVerifyDLEQ(statements, proofs[1:], rand.Reader); err != ErrNbElements {
+		t.Fatal("expected ErrNbElements, got", err)
+	}
+	if _, err := suite.ProveDLEQ(k, &DLEQStatement{A: bad.A, B: bad.B}, rand.Reader); err != ErrNbElements {
+		t.Fatal("expected ErrNbElements for a statement without pairs, got", err)
+	}
+	if _, err := suite.NewDLEQProof(proof.Bytes()[1:]); err != ErrDeserialize {
+		t.Fatal("expected ErrDeserialize, got", err)
+	}
+}
+
+func TestDLEQNilInputs(t *testing.T) {
+	t.Parallel()
+
+	suite, err := NewSuite(Ristretto255SHA512(), ModeVOPRF)
+	if err != nil {
+		t.Fatal(err)
+	}
+	group := suite.Group()
+
+	statements := make([]DLEQStatement, 3)
+	proofs := make([]*DLEQProof, len(statements))
+	for i := range statements {
+		// nil sources of randomness default to random.Reader
+		k, err := suite.randomScalar(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		C, err := suite.hashInput([]byte{byte(i)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		statements[i] = DLEQStatement{
+			A: group.Generator(),
+			B: group.Generator().ScalarMultiplication(k),
+			C: []Element{C},
+			D: []Element{C.ScalarMultiplication(k)},
+		}
+		if proofs[i], err = suite.ProveDLEQ(k, &statements[i], nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := suite.BatchVerifyDLEQ(statements, proofs, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// nil proofs, statements and elements are rejected
+	withNilProof := append([]*DLEQProof{}, proofs...)
+	withNilProof[1] = nil
+	if err := suite.BatchVerifyDLEQ(statements, withNilProof, nil); err != ErrNilInput {
+		t.Fatal("expected ErrNilInput for a nil proof, got", err)
+	}
+	if err := suite.VerifyDLEQ(&statements[0], nil); err != ErrNilInput {
+		t.Fatal("expected ErrNilInput for a nil proof, got", err)
+	}
+	if err := suite.VerifyDLEQ(&statements[0], &DLEQProof{}); err != ErrNilInput {
+		t.Fatal("expected ErrNilInput for an empty proof, got", err)
+	}
+	if err := suite.VerifyDLEQ(nil, proofs[0]); err != ErrNilInput {
+		t.Fatal("expected ErrNilInput for a nil statement, got", err)
+	}
+	withNilElement := append([]DLEQStatement{}, statements...)
+	withNilElement[2].D = []Element{nil}
+	if err := suite.BatchVerifyDLEQ(withNilElement, proofs, nil); err != ErrNilInput {
+		t.Fatal("expected ErrNilInput for a nil element, got", err)
+	}
+	withNilElement[2] = DLEQStatement{C: statements[2].C, D: statements[2].D}
+	if err := suite.BatchVerifyDLEQ(withNilElement, proofs, nil); err != ErrNilInput {
+		t.Fatal("expected ErrNilInput for a statement without A and B, got", err)
+	}
+	if _, err := suite.ProveDLEQ(big.NewInt(1), nil, nil); err != ErrNilInput {
+		t.Fatal("expected ErrNilInput for a nil statement, got", err)
+	}
+}
+
+func BenchmarkOPRF(b *testing.B) {
+	suite, _ := NewSuite(Ristretto255SHA512(), ModeVOPRF)
+	sk, _ := suite.GenerateKey(rand.Reader)
+	blinded, _ := suite.Blind([][]byte{[]byte("input")}, rand.Reader)
+	evaluation, _ := suite.BlindEvaluate(sk, blinded.Elements, rand.Reader)
+
+	b.Run("Blind", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = suite.Blind([][]byte{[]byte("input")}, rand.Reader)
+		}
+	})
+	b.Run("BlindEvaluate", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = suite.BlindEvaluate(sk, blinded.Elements, rand.Reader)
+		}
+	})
+	b.Run("Finalize", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = suite.Finalize(&sk.PublicKey, blinded, evaluation)
+		}
+	})
+}
@@ -0,0 +1,47 @@
+// Package random holds the source of randomness used across gnark-crypto.
+//
+// By default, randomness is read from crypto/rand.Reader. SetReader replaces it for the whole
+// library (e.g. with a DRBG, an HSM-backed source of entropy, or a deterministic stream in
+// tests); the functions and methods consuming randomness also accept a per-call io.Reader
+// where it makes sense (e.g. fr.Element.SetRandomFrom, kzg.WithRandomness).
+package random
+
+import (
+	"crypto/rand"
+	"io"
+	"sync/atomic"
+)
+
+// source wraps the io.Reader, since an atomic.Value must always hold the same concrete type
+type source struct {
+	io.Reader
+}
+
+var reader atomic.Value
+
+func init() {
+	reader.Store(source{rand.Reader})
+}
+
+// SetReader sets the package-level source of randomness to r.
+// If r is nil, the default crypto/rand.Reader is restored.
+//
+// r must be safe for concurrent use, and should be a cryptographically secure source
+// of randomness: among others, it is used to sample the random coefficients of batch
+// verifications, and the entropy added to the nonces of ECDSA signatures.
+func SetReader(r io.Reader) {
+	if r == nil {
+		r = rand.Reader
+	}
+	reader.Store(source{r})
+}
+
+// Reader returns the package-level source of randomness
+func Reader() io.Reader {
+	return reader.Load().(source).Reader
+}
+
+// Read is a helper that calls io.ReadFull on the package-level source of randomness.
+func Read(b []byte) (n int, err error) {
+	return io.ReadFull(Reader(), b)
+}
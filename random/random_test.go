@@ -0,0 +1,50 @@
+package random_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/random"
+)
+
+func TestSetReader(t *testing.T) {
+	if random.Reader() != rand.Reader {
+		t.Fatal("the default source of randomness should be crypto/rand.Reader")
+	}
+
+	seed := make([]byte, 64)
+	if _, err := rand.Read(seed); err != nil {
+		t.Fatal(err)
+	}
+
+	// SetRandom reads from the package-level source of randomness
+	random.SetReader(bytes.NewReader(seed))
+	defer random.SetReader(nil)
+	var x, y fr.Element
+	if _, err := x.SetRandom(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := y.SetRandomFrom(bytes.NewReader(seed)); err != nil {
+		t.Fatal(err)
+	}
+	if !x.Equal(&y) {
+		t.Fatal("SetRandom doesn't read from random.Reader")
+	}
+
+	// errors of the source are returned
+	random.SetReader(bytes.NewReader(nil))
+	if _, err := x.SetRandom(); err == nil {
+		t.Fatal("expected an error, the source of randomness is empty")
+	}
+	var buf [8]byte
+	if _, err := random.Read(buf[:]); err == nil {
+		t.Fatal("expected an error, the source of randomness is empty")
+	}
+
+	random.SetReader(nil)
+	if random.Reader() != rand.Reader {
+		t.Fatal("SetReader(nil) should restore crypto/rand.Reader")
+	}
+}
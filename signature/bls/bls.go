@@ -0,0 +1,45 @@
+/*
+Copyright © 2020 ConsenSys
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bls
+
+import (
+	"io"
+
+	bls_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/bls"
+	"github.com/consensys/gnark-crypto/signature"
+)
+
+// The names of the BLS schemes in the signature registry: the proof of possession schemes
+// on BLS12-381, with public keys in G1 (MinPk) or in G2 (MinSig).
+const (
+	MinPkBLS12381  signature.Scheme = "bls_minpk-bls12_381"
+	MinSigBLS12381 signature.Scheme = "bls_minsig-bls12_381"
+)
+
+// the BLS schemes are registered with their public and private keys
+func init() {
+	register(MinPkBLS12381, bls_bls12381.MinPkPoP)
+	register(MinSigBLS12381, bls_bls12381.MinSigPoP)
+}
+
+func register(name signature.Scheme, s *bls_bls12381.Scheme) {
+	signature.Register(name, signature.Constructors{
+		GenerateKey:  func(r io.Reader) (signature.Signer, error) { return s.GenerateKey(r) },
+		NewSigner:    func() signature.Signer { return s.NewPrivateKey() },
+		NewPublicKey: func() signature.PublicKey { return s.NewPublicKey() },
+	})
+}
@@ -30,6 +30,7 @@ import (
 	ecdsa_bw6756 "github.com/consensys/gnark-crypto/ecc/bw6-756/ecdsa"
 	ecdsa_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/ecdsa"
 	ecdsa_secp256k1 "github.com/consensys/gnark-crypto/ecc/secp256k1/ecdsa"
+	ecdsa_secp256r1 "github.com/consensys/gnark-crypto/ecc/secp256r1/ecdsa"
 	ecdsa_starkcurve "github.com/consensys/gnark-crypto/ecc/stark-curve/ecdsa"
 	"github.com/consensys/gnark-crypto/signature"
 )
@@ -57,9 +58,81 @@ func New(ss ecc.ID, r io.Reader) (signature.Signer, error) {
 		return ecdsa_bw6633.GenerateKey(r)
 	case ecc.SECP256K1:
 		return ecdsa_secp256k1.GenerateKey(r)
+	case ecc.SECP256R1:
+		return ecdsa_secp256r1.GenerateKey(r)
 	case ecc.STARK_CURVE:
 		return ecdsa_starkcurve.GenerateKey(r)
 	default:
 		panic("not implemented")
 	}
 }
+
+// Scheme returns the name of ECDSA on the curve ss in the signature registry,
+// for instance "ecdsa-secp256k1".
+func Scheme(ss ecc.ID) signature.Scheme {
+	return signature.Scheme("ecdsa-" + ss.String())
+}
+
+// the ECDSA schemes are registered with their public and private keys
+func init() {
+	signature.Register(Scheme(ecc.BN254), signature.Constructors{
+		GenerateKey:  func(r io.Reader) (signature.Signer, error) { return New(ecc.BN254, r) },
+		NewSigner:    func() signature.Signer { return new(ecdsa_bn254.PrivateKey) },
+		NewPublicKey: func() signature.PublicKey { return new(ecdsa_bn254.PublicKey) },
+	})
+	signature.Register(Scheme(ecc.BLS12_381), signature.Constructors{
+		GenerateKey:  func(r io.Reader) (signature.Signer, error) { return New(ecc.BLS12_381, r) },
+		NewSigner:    func() signature.Signer { return new(ecdsa_bls12381.PrivateKey) },
+		NewPublicKey: func() signature.PublicKey { return new(ecdsa_bls12381.PublicKey) },
+	})
+	signature.Register(Scheme(ecc.BLS12_377), signature.Constructors{
+		GenerateKey:  func(r io.Reader) (signature.Signer, error) { return New(ecc.BLS12_377, r) },
+		NewSigner:    func() signature.Signer { return new(ecdsa_bls12377.PrivateKey) },
+		NewPublicKey: func() signature.PublicKey { return new(ecdsa_bls12377.PublicKey) },
+	})
+	signature.Register(Scheme(ecc.BLS12_378), signature.Constructors{
+		GenerateKey:  func(r io.Reader) (signature.Signer, error) { return New(ecc.BLS12_378, r) },
+		NewSigner:    func() signature.Signer { return new(ecdsa_bls12378.PrivateKey) },
+		NewPublicKey: func() signature.PublicKey { return new(ecdsa_bls12378.PublicKey) },
+	})
+	signature.Register(Scheme(ecc.BW6_761), signature.Constructors{
+		GenerateKey:  func(r io.Reader) (signature.Signer, error) { return New(ecc.BW6_761, r) },
+		NewSigner:    func() signature.Signer { return new(ecdsa_bw6761.PrivateKey) },
+		NewPublicKey: func() signature.PublicKey { return new(ecdsa_bw6761.PublicKey) },
+	})
+	signature.Register(Scheme(ecc.BW6_756), signature.Constructors{
+		GenerateKey:  func(r io.Reader) (signature.Signer, error) { return New(ecc.BW6_756, r) },
+		NewSigner:    func() signature.Signer { return new(ecdsa_bw6756.PrivateKey) },
+		NewPublicKey: func() signature.PublicKey { return new(ecdsa_bw6756.PublicKey) },
+	})
+	signature.Register(Scheme(ecc.BLS24_315), signature.Constructors{
+		GenerateKey:  func(r io.Reader) (signature.Signer, error) { return New(ecc.BLS24_315, r) },
+		NewSigner:    func() signature.Signer { return new(ecdsa_bls24315.PrivateKey) },
+		NewPublicKey: func() signature.PublicKey { return new(ecdsa_bls24315.PublicKey) },
+	})
+	signature.Register(Scheme(ecc.BLS24_317), signature.Constructors{
+		GenerateKey:  func(r io.Reader) (signature.Signer, error) { return New(ecc.BLS24_317, r) },
+		NewSigner:    func() signature.Signer { return new(ecdsa_bls24317.PrivateKey) },
+		NewPublicKey: func() signature.PublicKey { return new(ecdsa_bls24317.PublicKey) },
+	})
+	signature.Register(Scheme(ecc.BW6_633), signature.Constructors{
+		GenerateKey:  func(r io.Reader) (signature.Signer, error) { return New(ecc.BW6_633, r) },
+		NewSigner:    func() signature.Signer { return new(ecdsa_bw6633.PrivateKey) },
+		NewPublicKey: func() signature.PublicKey { return new(ecdsa_bw6633.PublicKey) },
+	})
+	signature.Register(Scheme(ecc.SECP256K1), signature.Constructors{
+		GenerateKey:  func(r io.Reader) (signature.Signer, error) { return New(ecc.SECP256K1, r) },
+		NewSigner:    func() signature.Signer { return new(ecdsa_secp256k1.PrivateKey) },
+		NewPublicKey: func() signature.PublicKey { return new(ecdsa_secp256k1.PublicKey) },
+	})
+	signature.Register(Scheme(ecc.SECP256R1), signature.Constructors{
+		GenerateKey:  func(r io.Reader) (signature.Signer, error) { return New(ecc.SECP256R1, r) },
+		NewSigner:    func() signature.Signer { return new(ecdsa_secp256r1.PrivateKey) },
+		NewPublicKey: func() signature.PublicKey { return new(ecdsa_secp256r1.PublicKey) },
+	})
+	signature.Register(Scheme(ecc.STARK_CURVE), signature.Constructors{
+		GenerateKey:  func(r io.Reader) (signature.Signer, error) { return New(ecc.STARK_CURVE, r) },
+		NewSigner:    func() signature.Signer { return new(ecdsa_starkcurve.PrivateKey) },
+		NewPublicKey: func() signature.PublicKey { return new(ecdsa_starkcurve.PublicKey) },
+	})
+}
@@ -29,6 +29,7 @@ import (
 	eddsa_bw6633 "github.com/consensys/gnark-crypto/ecc/bw6-633/twistededwards/eddsa"
 	eddsa_bw6756 "github.com/consensys/gnark-crypto/ecc/bw6-756/twistededwards/eddsa"
 	eddsa_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/twistededwards/eddsa"
+	"github.com/consensys/gnark-crypto/ecc/curve25519/ed25519"
 	"github.com/consensys/gnark-crypto/ecc/twistededwards"
 	"github.com/consensys/gnark-crypto/signature"
 )
@@ -60,3 +61,95 @@ func New(ss twistededwards.ID, r io.Reader) (signature.Signer, error) {
 		panic("not implemented")
 	}
 }
+
+// Scheme returns the name of EdDSA on the twisted Edwards curve ss in the
+// signature registry, for instance "eddsa-bn254".
+func Scheme(ss twistededwards.ID) signature.Scheme {
+	switch ss {
+	case twistededwards.BN254:
+		return "eddsa-bn254"
+	case twistededwards.BLS12_381:
+		return "eddsa-bls12_381"
+	case twistededwards.BLS12_381_BANDERSNATCH:
+		return "eddsa-bls12_381_bandersnatch"
+	case twistededwards.BLS12_377:
+		return "eddsa-bls12_377"
+	case twistededwards.BLS12_378:
+		return "eddsa-bls12_378"
+	case twistededwards.BW6_761:
+		return "eddsa-bw6_761"
+	case twistededwards.BW6_756:
+		return "eddsa-bw6_756"
+	case twistededwards.BLS24_315:
+		return "eddsa-bls24_315"
+	case twistededwards.BLS24_317:
+		return "eddsa-bls24_317"
+	case twistededwards.BW6_633:
+		return "eddsa-bw6_633"
+	default:
+		panic("not implemented")
+	}
+}
+
+// Ed25519 is the name of Ed25519 (RFC 8032) in the signature registry. Its keys hash the
+// messages with SHA-512, so they must be used with a nil hFunc.
+const Ed25519 signature.Scheme = "eddsa-ed25519"
+
+// the EdDSA schemes are registered with their public and private keys
+func init() {
+	signature.Register(Ed25519, signature.Constructors{
+		GenerateKey:  func(r io.Reader) (signature.Signer, error) { return ed25519.GenerateKey(r) },
+		NewSigner:    func() signature.Signer { return new(ed25519.PrivateKey) },
+		NewPublicKey: func() signature.PublicKey { return new(ed25519.PublicKey) },
+	})
+	signature.Register(Scheme(twistededwards.BN254), signature.Constructors{
+		GenerateKey:  func(r io.Reader) (signature.Signer, error) { return New(twistededwards.BN254, r) },
+		NewSigner:    func() signature.Signer { return new(eddsa_bn254.PrivateKey) },
+		NewPublicKey: func() signature.PublicKey { return new(eddsa_bn254.PublicKey) },
+	})
+	signature.Register(Scheme(twistededwards.BLS12_381), signature.Constructors{
+		GenerateKey:  func(r io.Reader) (signature.Signer, error) { return New(twistededwards.BLS12_381, r) },
+		NewSigner:    func() signature.Signer { return new(eddsa_bls12381.PrivateKey) },
+		NewPublicKey: func() signature.PublicKey { return new(eddsa_bls12381.PublicKey) },
+	})
+	signature.Register(Scheme(twistededwards.BLS12_381_BANDERSNATCH), signature.Constructors{
+		GenerateKey:  func(r io.Reader) (signature.Signer, error) { return New(twistededwards.BLS12_381_BANDERSNATCH, r) },
+		NewSigner:    func() signature.Signer { return new(eddsa_bls12381_bandersnatch.PrivateKey) },
+		NewPublicKey: func() signature.PublicKey { return new(eddsa_bls12381_bandersnatch.PublicKey) },
+	})
+	signature.Register(Scheme(twistededwards.BLS12_377), signature.Constructors{
+		GenerateKey:  func(r io.Reader) (signature.Signer, error) { return New(twistededwards.BLS12_377, r) },
+		NewSigner:    func() signature.Signer { return new(eddsa_bls12377.PrivateKey) },
+		NewPublicKey: func() signature.PublicKey { return new(eddsa_bls12377.PublicKey) },
+	})
+	signature.Register(Scheme(twistededwards.BLS12_378), signature.Constructors{
+		GenerateKey:  func(r io.Reader) (signature.Signer, error) { return New(twistededwards.BLS12_378, r) },
+		NewSigner:    func() signature.Signer { return new(eddsa_bls12378.PrivateKey) },
+		NewPublicKey: func() signature.PublicKey { return new(eddsa_bls12378.PublicKey) },
+	})
+	signature.Register(Scheme(twistededwards.BW6_761), signature.Constructors{
+		GenerateKey:  func(r io.Reader) (signature.Signer, error) { return New(twistededwards.BW6_761, r) },
+		NewSigner:    func() signature.Signer { return new(eddsa_bw6761.PrivateKey) },
+		NewPublicKey: func() signature.PublicKey { return new(eddsa_bw6761.PublicKey) },
+	})
+	signature.Register(Scheme(twistededwards.BW6_756), signature.Constructors{
+		GenerateKey:  func(r io.Reader) (signature.Signer, error) { return New(twistededwards.BW6_756, r) },
+		NewSigner:    func() signature.Signer { return new(eddsa_bw6756.PrivateKey) },
+		NewPublicKey: func() signature.PublicKey { return new(eddsa_bw6756.PublicKey) },
+	})
+	signature.Register(Scheme(twistededwards.BLS24_315), signature.Constructors{
+		GenerateKey:  func(r io.Reader) (signature.Signer, error) { return New(twistededwards.BLS24_315, r) },
+		NewSigner:    func() signature.Signer { return new(eddsa_bls24315.PrivateKey) },
+		NewPublicKey: func() signature.PublicKey { return new(eddsa_bls24315.PublicKey) },
+	})
+	signature.Register(Scheme(twistededwards.BLS24_317), signature.Constructors{
+		GenerateKey:  func(r io.Reader) (signature.Signer, error) { return New(twistededwards.BLS24_317, r) },
+		NewSigner:    func() signature.Signer { return new(eddsa_bls24317.PrivateKey) },
+		NewPublicKey: func() signature.PublicKey { return new(eddsa_bls24317.PublicKey) },
+	})
+	signature.Register(Scheme(twistededwards.BW6_633), signature.Constructors{
+		GenerateKey:  func(r io.Reader) (signature.Signer, error) { return New(twistededwards.BW6_633, r) },
+		NewSigner:    func() signature.Signer { return new(eddsa_bw6633.PrivateKey) },
+		NewPublicKey: func() signature.PublicKey { return new(eddsa_bw6633.PublicKey) },
+	})
+}
@@ -0,0 +1,275 @@
+/*
+Copyright © 2020 ConsenSys
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package keystore provides an encrypted-at-rest format for the private keys of the
+// signature schemes registered in the signature package.
+//
+// A keystore is a versioned JSON document, similar to the keystores of Ethereum
+// clients. The private key, in the encoding of signature.MarshalSigner, is encrypted
+// with AES-256-GCM under a key derived from a passphrase with argon2id (default) or
+// scrypt. The scheme, public key and key derivation parameters are authenticated as
+// additional data, so that none of them can be changed without detection.
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/consensys/gnark-crypto/random"
+	"github.com/consensys/gnark-crypto/signature"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Version is the version of the keystore format produced by Encrypt
+const Version = 1
+
+const (
+	kdfArgon2id = "argon2id"
+	kdfScrypt   = "scrypt"
+	cipherName  = "aes-256-gcm"
+	keySize     = 32
+	saltSize    = 32
+)
+
+// Bounds of the key derivation parameters. The parameters of a keystore are not trusted:
+// they are checked before deriving the key, so that a crafted keystore can't make Decrypt
+// use an unbounded amount of memory or time.
+const (
+	maxArgon2Time   = 16
+	maxArgon2Memory = 4 * 1024 * 1024 // 4GiB, in KiB
+	maxScryptN      = 1 << 20
+	maxScryptRP     = 1 << 30 // r·p < 2³⁰, as required by scrypt
+)
+
+var (
+	ErrVersion    = errors.New("unsupported keystore version")
+	ErrKDF        = errors.New("unsupported or invalid key derivation parameters")
+	ErrCipher     = errors.New("unsupported keystore cipher")
+	ErrDecryption = errors.New("could not decrypt the keystore: wrong passphrase or corrupted keystore")
+	ErrNoKey      = errors.New("the signer doesn't export its private key")
+)
+
+// Keystore is the JSON representation of an encrypted private key
+type Keystore struct {
+	Version   int              `json:"version"`
+	Scheme    signature.Scheme `json:"scheme"`
+	PublicKey string           `json:"publicKey"` // hex encoded, as signature.MarshalPublicKey
+	Crypto    Crypto           `json:"crypto"`
+}
+
+// Crypto holds the encryption parameters and the encrypted private key
+type Crypto struct {
+	KDF        string    `json:"kdf"`
+	KDFParams  KDFParams `json:"kdfparams"`
+	Cipher     string    `json:"cipher"`
+	Nonce      string    `json:"nonce"`      // hex encoded
+	Ciphertext string    `json:"ciphertext"` // hex encoded
+}
+
+// KDFParams are the parameters of the key derivation function. Only the ones of
+// the function in use are set.
+type KDFParams struct {
+	Salt string `json:"salt"` // hex encoded
+
+	// argon2id
+	Time    uint32 `json:"time,omitempty"`
+	Memory  uint32 `json:"memory,omitempty"` // in KiB
+	Threads uint8  `json:"threads,omitempty"`
+
+	// scrypt
+	N int `json:"n,omitempty"`
+	R int `json:"r,omitempty"`
+	P int `json:"p,omitempty"`
+}
+
+// Option customizes the key derivation of Encrypt
+type Option func(*config)
+
+type config struct {
+	kdf    string
+	params KDFParams
+	rand   io.Reader
+}
+
+// WithArgon2id derives the encryption key with argon2id, using memory KiB of memory.
+// This is the default, with time=3, memory=64MiB and threads=4. time must be at most 16,
+// and memory at most 4GiB.
+func WithArgon2id(time, memory uint32, threads uint8) Option {
+	return func(cfg *config) {
+		cfg.kdf = kdfArgon2id
+		cfg.params = KDFParams{Time: time, Memory: memory, Threads: threads}
+	}
+}
+
+// WithScrypt derives the encryption key with scrypt; N must be a power of two, at most 2²⁰,
+// and r·p must be less than 2³⁰.
+func WithScrypt(N, r, p int) Option {
+	return func(cfg *config) {
+		cfg.kdf = kdfScrypt
+		cfg.params = KDFParams{N: N, R: r, P: p}
+	}
+}
+
+// WithRandomness sets the source of the salt and nonce. It defaults to random.Reader().
+func WithRandomness(r io.Reader) Option {
+	return func(cfg *config) {
+		cfg.rand = r
+	}
+}
+
+// Encrypt returns the JSON keystore of signer, a private key of the given scheme,
+// encrypted under passphrase. It returns ErrNoKey if signer doesn't export its private
+// key (its Bytes are empty), as signers backed by an HSM or a KMS.
+func Encrypt(scheme signature.Scheme, signer signature.Signer, passphrase []byte, opts ...Option) ([]byte, error) {
+	if len(signer.Bytes()) == 0 {
+		return nil, ErrNoKey
+	}
+	cfg := config{rand: random.Reader()}
+	WithArgon2id(3, 64*1024, 4)(&cfg)
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	salt := make([]byte, saltSize)
+	if _, err := io.ReadFull(cfg.rand, salt); err != nil {
+		return nil, err
+	}
+	cfg.params.Salt = hex.EncodeToString(salt)
+
+	ks := Keystore{
+		Version:   Version,
+		Scheme:    scheme,
+		PublicKey: hex.EncodeToString(signature.MarshalPublicKey(scheme, signer.Public())),
+		Crypto: Crypto{
+			KDF:       cfg.kdf,
+			KDFParams: cfg.params,
+			Cipher:    cipherName,
+		},
+	}
+
+	aead, err := ks.aead(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(cfg.rand, nonce); err != nil {
+		return nil, err
+	}
+	ad, err := ks.additionalData()
+	if err != nil {
+		return nil, err
+	}
+	ciphertext := aead.Seal(nil, nonce, signature.MarshalSigner(scheme, signer), ad)
+
+	ks.Crypto.Nonce = hex.EncodeToString(nonce)
+	ks.Crypto.Ciphertext = hex.EncodeToString(ciphertext)
+	return json.MarshalIndent(&ks, "", "  ")
+}
+
+// Decrypt returns the private key stored in the JSON keystore, and its scheme.
+// The scheme must be registered, see signature.Register.
+func Decrypt(keystore, passphrase []byte) (signature.Scheme, signature.Signer, error) {
+	var ks Keystore
+	if err := json.Unmarshal(keystore, &ks); err != nil {
+		return "", nil, err
+	}
+	if ks.Version != Version {
+		return "", nil, ErrVersion
+	}
+
+	aead, err := ks.aead(passphrase)
+	if err != nil {
+		return "", nil, err
+	}
+	nonce, err := hex.DecodeString(ks.Crypto.Nonce)
+	if err != nil || len(nonce) != aead.NonceSize() {
+		return "", nil, ErrDecryption
+	}
+	ciphertext, err := hex.DecodeString(ks.Crypto.Ciphertext)
+	if err != nil {
+		return "", nil, ErrDecryption
+	}
+	ad, err := ks.additionalData()
+	if err != nil {
+		return "", nil, err
+	}
+	plaintext, err := aead.Open(nil, nonce, ciphertext, ad)
+	if err != nil {
+		return "", nil, ErrDecryption
+	}
+
+	scheme, signer, err := signature.UnmarshalSigner(plaintext)
+	if err != nil {
+		return "", nil, err
+	}
+	if scheme != ks.Scheme {
+		return "", nil, ErrDecryption
+	}
+	return scheme, signer, nil
+}
+
+// aead returns the cipher keyed by the key derived from passphrase
+func (ks *Keystore) aead(passphrase []byte) (cipher.AEAD, error) {
+	if ks.Crypto.Cipher != cipherName {
+		return nil, ErrCipher
+	}
+	params := ks.Crypto.KDFParams
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil || len(salt) == 0 {
+		return nil, ErrKDF
+	}
+
+	var key []byte
+	switch ks.Crypto.KDF {
+	case kdfArgon2id:
+		// threads is an uint8, so it is at most 255
+		if params.Time == 0 || params.Time > maxArgon2Time ||
+			params.Memory == 0 || params.Memory > maxArgon2Memory ||
+			params.Threads == 0 {
+			return nil, ErrKDF
+		}
+		key = argon2.IDKey(passphrase, salt, params.Time, params.Memory, params.Threads, keySize)
+	case kdfScrypt:
+		if params.N <= 1 || params.N > maxScryptN || params.N&(params.N-1) != 0 ||
+			params.R <= 0 || params.P <= 0 || params.R > (maxScryptRP-1)/params.P {
+			return nil, ErrKDF
+		}
+		if key, err = scrypt.Key(passphrase, salt, params.N, params.R, params.P, keySize); err != nil {
+			return nil, ErrKDF
+		}
+	default:
+		return nil, ErrKDF
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// additionalData returns the authenticated fields of the keystore: everything but
+// the nonce and the ciphertext.
+func (ks *Keystore) additionalData() ([]byte, error) {
+	header := *ks
+	header.Crypto.Nonce, header.Crypto.Ciphertext = "", ""
+	return json.Marshal(&header)
+}
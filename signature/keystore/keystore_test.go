@@ -0,0 +1,191 @@
+/*
+Copyright © 2020 ConsenSys
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keystore
+
+import (
+	"bytes"
+	"crypto"
+	stdecdsa "crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	ecdsa_secp256r1 "github.com/consensys/gnark-crypto/ecc/secp256r1/ecdsa"
+	"github.com/consensys/gnark-crypto/ecc/twistededwards"
+	"github.com/consensys/gnark-crypto/signature"
+	"github.com/consensys/gnark-crypto/signature/ecdsa"
+	"github.com/consensys/gnark-crypto/signature/eddsa"
+)
+
+// light parameters, to keep the tests fast
+var testOptions = [][]Option{
+	{WithArgon2id(1, 1024, 1)},
+	{WithScrypt(1<<10, 8, 1)},
+}
+
+func TestKeystore(t *testing.T) {
+	passphrase := []byte("correct horse battery staple")
+	for _, scheme := range []signature.Scheme{eddsa.Scheme(twistededwards.BN254), ecdsa.Scheme(ecc.SECP256K1), ecdsa.Scheme(ecc.BLS12_381)} {
+		signer, err := signature.GenerateKey(scheme, rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, opts := range testOptions {
+			ks, err := Encrypt(scheme, signer, passphrase, opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			decodedScheme, decoded, err := Decrypt(ks, passphrase)
+			if err != nil {
+				t.Fatal(scheme, err)
+			}
+			if decodedScheme != scheme || !bytes.Equal(decoded.Bytes(), signer.Bytes()) {
+				t.Fatalf("%s: decrypted key differs", scheme)
+			}
+
+			if _, _, err := Decrypt(ks, []byte("wrong passphrase")); err != ErrDecryption {
+				t.Fatal("expected ErrDecryption, got", err)
+			}
+		}
+	}
+}
+
+func TestKeystoreTampering(t *testing.T) {
+	passphrase := []byte("passphrase")
+	scheme := ecdsa.Scheme(ecc.BN254)
+	signer, err := signature.GenerateKey(scheme, rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks, err := Encrypt(scheme, signer, passphrase, testOptions[0]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tamper := func(f func(*Keystore)) []byte {
+		var k Keystore
+		if err := json.Unmarshal(ks, &k); err != nil {
+			t.Fatal(err)
+		}
+		f(&k)
+		res, err := json.Marshal(&k)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+
+	other, err := signature.GenerateKey(scheme, rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, tampered := range map[string][]byte{
+		"public key": tamper(func(k *Keystore) { k.PublicKey = "00" + k.PublicKey[2:] }),
+		"kdf time":   tamper(func(k *Keystore) { k.Crypto.KDFParams.Time++ }),
+		"scheme":     tamper(func(k *Keystore) { k.Scheme = ecdsa.Scheme(ecc.BLS12_377) }),
+		"signer": tamper(func(k *Keystore) {
+			k.PublicKey = hex.EncodeToString(signature.MarshalPublicKey(scheme, other.Public()))
+		}),
+	} {
+		if _, _, err := Decrypt(tampered, passphrase); err != ErrDecryption {
+			t.Fatalf("%s: expected ErrDecryption, got %v", name, err)
+		}
+	}
+
+	if _, _, err := Decrypt(tamper(func(k *Keystore) { k.Version = 2 }), passphrase); err != ErrVersion {
+		t.Fatal("expected ErrVersion, got", err)
+	}
+	if _, _, err := Decrypt(tamper(func(k *Keystore) { k.Crypto.KDF = "pbkdf2" }), passphrase); err != ErrKDF {
+		t.Fatal("expected ErrKDF, got", err)
+	}
+}
+
+func TestKeystoreKDFBounds(t *testing.T) {
+	passphrase := []byte("passphrase")
+	scheme := ecdsa.Scheme(ecc.BN254)
+	signer, err := signature.GenerateKey(scheme, rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// oversized parameters in a crafted keystore are rejected before deriving the key
+	crafted := map[string]map[string]func(*KDFParams){
+		kdfArgon2id: {
+			"time":    func(p *KDFParams) { p.Time = 1 << 20 },
+			"memory":  func(p *KDFParams) { p.Memory = 1<<32 - 1 },
+			"threads": func(p *KDFParams) { p.Threads = 0 },
+		},
+		kdfScrypt: {
+			"N":           func(p *KDFParams) { p.N = 1 << 30 },
+			"N not pow 2": func(p *KDFParams) { p.N = 1<<10 + 1 },
+			"r·p":         func(p *KDFParams) { p.R, p.P = 1<<15, 1<<15 },
+			"p":           func(p *KDFParams) { p.P = -1 },
+		},
+	}
+	for _, opts := range testOptions {
+		ks, err := Encrypt(scheme, signer, passphrase, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var k Keystore
+		if err := json.Unmarshal(ks, &k); err != nil {
+			t.Fatal(err)
+		}
+		for name, f := range crafted[k.Crypto.KDF] {
+			c := k
+			f(&c.Crypto.KDFParams)
+			tampered, err := json.Marshal(&c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, _, err := Decrypt(tampered, passphrase); err != ErrKDF {
+				t.Fatalf("%s %s: expected ErrKDF, got %v", k.Crypto.KDF, name, err)
+			}
+		}
+	}
+
+	// and by Encrypt
+	for _, opt := range []Option{
+		WithArgon2id(maxArgon2Time+1, 1024, 1),
+		WithArgon2id(1, maxArgon2Memory+1, 1),
+		WithScrypt(maxScryptN<<1, 8, 1),
+		WithScrypt(3<<10, 8, 1),
+		WithScrypt(1<<10, maxScryptRP, 1),
+	} {
+		if _, err := Encrypt(scheme, signer, passphrase, opt); err != ErrKDF {
+			t.Fatal("expected ErrKDF, got", err)
+		}
+	}
+}
+
+func TestKeystoreExternalSigner(t *testing.T) {
+	handle, err := stdecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := ecdsa_secp256r1.NewExternalSigner(handle, crypto.SHA256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Encrypt(ecdsa.Scheme(ecc.SECP256R1), signer, []byte("passphrase"), testOptions[0]...); err != ErrNoKey {
+		t.Fatal("expected ErrNoKey, got", err)
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright © 2020 ConsenSys
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signature
+
+import (
+	"errors"
+	"io"
+	"sort"
+	"sync"
+)
+
+var (
+	ErrUnknownScheme   = errors.New("unknown signature scheme")
+	ErrInvalidEncoding = errors.New("invalid key encoding")
+)
+
+// Scheme identifies a signature scheme on a given curve, as "<algorithm>-<curve>",
+// for instance "eddsa-bn254" or "ecdsa-secp256k1".
+type Scheme string
+
+// Verifier is the verifying side of a signature scheme, that is its public key.
+type Verifier = PublicKey
+
+// Constructors are the functions a signature scheme registers.
+type Constructors struct {
+	// GenerateKey returns a new key pair, using r as source of randomness
+	GenerateKey func(r io.Reader) (Signer, error)
+
+	// NewSigner returns an empty private key, to be set with SetBytes
+	NewSigner func() Signer
+
+	// NewPublicKey returns an empty public key, to be set with SetBytes
+	NewPublicKey func() PublicKey
+}
+
+var registry = struct {
+	sync.RWMutex
+	schemes map[Scheme]Constructors
+}{schemes: make(map[Scheme]Constructors)}
+
+// Register makes a signature scheme available through the registry. The
+// schemes of gnark-crypto are registered by the signature/eddsa and
+// signature/ecdsa packages.
+//
+// It panics if the scheme is already registered or a constructor is missing.
+func Register(scheme Scheme, constructors Constructors) {
+	if len(scheme) == 0 || len(scheme) > 255 {
+		panic("signature: invalid scheme name " + string(scheme))
+	}
+	if constructors.GenerateKey == nil || constructors.NewSigner == nil || constructors.NewPublicKey == nil {
+		panic("signature: missing constructor for " + string(scheme))
+	}
+	registry.Lock()
+	defer registry.Unlock()
+	if _, ok := registry.schemes[scheme]; ok {
+		panic("signature: scheme " + string(scheme) + " registered twice")
+	}
+	registry.schemes[scheme] = constructors
+}
+
+// Schemes returns the registered signature schemes, in lexicographic order.
+func Schemes() []Scheme {
+	registry.RLock()
+	defer registry.RUnlock()
+	res := make([]Scheme, 0, len(registry.schemes))
+	for scheme := range registry.schemes {
+		res = append(res, scheme)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+	return res
+}
+
+func lookup(scheme Scheme) (Constructors, error) {
+	registry.RLock()
+	defer registry.RUnlock()
+	constructors, ok := registry.schemes[scheme]
+	if !ok {
+		return Constructors{}, ErrUnknownScheme
+	}
+	return constructors, nil
+}
+
+// GenerateKey returns a new key pair of the given scheme, using r as source of
+// randomness.
+func GenerateKey(scheme Scheme, r io.Reader) (Signer, error) {
+	constructors, err := lookup(scheme)
+	if err != nil {
+		return nil, err
+	}
+	return constructors.GenerateKey(r)
+}
+
+// MarshalPublicKey returns the encoding of a public key of the given scheme, as
+// len(scheme) || scheme || publicKey.Bytes(), where len(scheme) is one byte.
+// The encoding is self-describing: UnmarshalPublicKey recovers the scheme.
+func MarshalPublicKey(scheme Scheme, publicKey PublicKey) []byte {
+	return marshal(scheme, publicKey.Bytes())
+}
+
+// UnmarshalPublicKey decodes a public key encoded by MarshalPublicKey.
+func UnmarshalPublicKey(buf []byte) (Scheme, PublicKey, error) {
+	scheme, key, err := unmarshal(buf)
+	if err != nil {
+		return "", nil, err
+	}
+	constructors, err := lookup(scheme)
+	if err != nil {
+		return "", nil, err
+	}
+	publicKey := constructors.NewPublicKey()
+	n, err := publicKey.SetBytes(key)
+	if err != nil {
+		return "", nil, err
+	}
+	if n != len(key) {
+		return "", nil, ErrInvalidEncoding
+	}
+	return scheme, publicKey, nil
+}
+
+// MarshalSigner returns the encoding of a private key of the given scheme, as
+// len(scheme) || scheme || signer.Bytes(), where len(scheme) is one byte.
+func MarshalSigner(scheme Scheme, signer Signer) []byte {
+	return marshal(scheme, signer.Bytes())
+}
+
+// UnmarshalSigner decodes a private key encoded by MarshalSigner.
+func UnmarshalSigner(buf []byte) (Scheme, Signer, error) {
+	scheme, key, err := unmarshal(buf)
+	if err != nil {
+		return "", nil, err
+	}
+	constructors, err := lookup(scheme)
+	if err != nil {
+		return "", nil, err
+	}
+	signer := constructors.NewSigner()
+	n, err := signer.SetBytes(key)
+	if err != nil {
+		return "", nil, err
+	}
+	if n != len(key) {
+		return "", nil, ErrInvalidEncoding
+	}
+	return scheme, signer, nil
+}
+
+func marshal(scheme Scheme, key []byte) []byte {
+	res := make([]byte, 0, 1+len(scheme)+len(key))
+	res = append(res, byte(len(scheme)))
+	res = append(res, scheme...)
+	return append(res, key...)
+}
+
+func unmarshal(buf []byte) (Scheme, []byte, error) {
+	if len(buf) == 0 || len(buf) < 1+int(buf[0]) {
+		return "", nil, ErrInvalidEncoding
+	}
+	n := 1 + int(buf[0])
+	return Scheme(buf[1:n]), buf[n:], nil
+}
@@ -0,0 +1,130 @@
+/*
+Copyright © 2020 ConsenSys
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signature_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"hash"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/twistededwards"
+	"github.com/consensys/gnark-crypto/signature"
+	"github.com/consensys/gnark-crypto/signature/bls"
+	"github.com/consensys/gnark-crypto/signature/ecdsa"
+	"github.com/consensys/gnark-crypto/signature/eddsa"
+)
+
+func TestRegistry(t *testing.T) {
+	schemes := signature.Schemes()
+	registered := make(map[signature.Scheme]bool, len(schemes))
+	for _, scheme := range schemes {
+		registered[scheme] = true
+	}
+	for _, scheme := range []signature.Scheme{eddsa.Scheme(twistededwards.BN254), ecdsa.Scheme(ecc.SECP256K1), "eddsa-bls12_381_bandersnatch", "ecdsa-secp256r1", bls.MinPkBLS12381, bls.MinSigBLS12381, eddsa.Ed25519} {
+		if !registered[scheme] {
+			t.Fatalf("%s is not registered", scheme)
+		}
+	}
+
+	msg := []byte("registry test message")
+	for _, scheme := range schemes {
+		signer, err := signature.GenerateKey(scheme, rand.Reader)
+		if err != nil {
+			t.Fatal(scheme, err)
+		}
+
+		// the keys go through the uniform encoding
+		decodedScheme, decodedSigner, err := signature.UnmarshalSigner(signature.MarshalSigner(scheme, signer))
+		if err != nil {
+			t.Fatal(scheme, err)
+		}
+		if decodedScheme != scheme {
+			t.Fatalf("%s: decoded scheme %s", scheme, decodedScheme)
+		}
+		decodedScheme, verifier, err := signature.UnmarshalPublicKey(signature.MarshalPublicKey(scheme, signer.Public()))
+		if err != nil {
+			t.Fatal(scheme, err)
+		}
+		if decodedScheme != scheme || !verifier.Equal(signer.Public()) {
+			t.Fatalf("%s: public key round trip failed", scheme)
+		}
+
+		var hFunc hash.Hash
+		if scheme != eddsa.Ed25519 {
+			hFunc = sha256.New()
+		}
+		sig, err := decodedSigner.Sign(msg, hFunc)
+		if err != nil {
+			t.Fatal(scheme, err)
+		}
+		ok, err := verifier.Verify(sig, msg, hFunc)
+		if err != nil || !ok {
+			t.Fatalf("%s: signature doesn't verify: %v", scheme, err)
+		}
+	}
+}
+
+func TestRegistryEd25519(t *testing.T) {
+	signer, err := signature.GenerateKey(eddsa.Ed25519, rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scheme, decodedSigner, err := signature.UnmarshalSigner(signature.MarshalSigner(eddsa.Ed25519, signer))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scheme != eddsa.Ed25519 {
+		t.Fatal("decoded scheme", scheme)
+	}
+
+	// the keys and signatures are the ones of crypto/ed25519
+	msg := []byte("registry test message")
+	sig, err := decodedSigner.Sign(msg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ed25519.Verify(ed25519.PublicKey(signer.Public().Bytes()), msg, sig) {
+		t.Fatal("crypto/ed25519 doesn't verify the signature")
+	}
+	if _, err := decodedSigner.Sign(msg, sha256.New()); err == nil {
+		t.Fatal("Ed25519 should reject a hash function")
+	}
+}
+
+func TestRegistryErrors(t *testing.T) {
+	if _, err := signature.GenerateKey("unknown-scheme", rand.Reader); err != signature.ErrUnknownScheme {
+		t.Fatal("expected ErrUnknownScheme, got", err)
+	}
+	for _, buf := range [][]byte{nil, {5, 'a'}} {
+		if _, _, err := signature.UnmarshalPublicKey(buf); err != signature.ErrInvalidEncoding {
+			t.Fatal("expected ErrInvalidEncoding, got", err)
+		}
+	}
+
+	scheme := ecdsa.Scheme(ecc.BN254)
+	signer, err := signature.GenerateKey(scheme, rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := signature.MarshalPublicKey(scheme, signer.Public())
+	if _, _, err := signature.UnmarshalPublicKey(append(buf, 0)); err != signature.ErrInvalidEncoding {
+		t.Fatal("expected ErrInvalidEncoding for trailing bytes, got", err)
+	}
+}
@@ -15,6 +15,9 @@ limitations under the License.
 */
 
 // Package signature defines interfaces for a Signer and a PublicKey similarly to go/crypto standard package.
+//
+// The schemes implementing them register in a registry keyed by scheme and curve (see Register),
+// so that applications can support several schemes, and serialize their keys uniformly.
 package signature
 
 import (